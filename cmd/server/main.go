@@ -6,8 +6,11 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
+	_ "time/tzdata" // IANA timezones for user settings, even where the image has no zoneinfo
 
 	"github.com/joho/godotenv"
 	"github.com/rxtech-lab/invoice-management/internal/api"
@@ -42,10 +45,25 @@ func main() {
 	tagService := services.NewTagService(db)
 	invoiceService := services.NewInvoiceService(db, fxService)
 	uploadService := initUploadService()
-	fileUploadService := services.NewFileUploadService(db)
-	analyticsService := services.NewAnalyticsService(db)
 	fileUnlinkService := initFileUnlinkService()
-	pdfService := initPDFService()
+	fileUploadService := services.NewFileUploadService(db, fileUnlinkService)
+	services.MaxStatisticsRangeDays = getEnvIntOrDefault("STATISTICS_MAX_RANGE_DAYS", services.DefaultMaxStatisticsRangeDays)
+	services.MaxUploadBytes = int64(getEnvIntOrDefault("UPLOAD_MAX_SIZE_MB", services.DefaultMaxUploadBytes>>20)) << 20
+	analyticsService := services.NewAnalyticsService(db)
+	settingsService := services.NewSettingsService(db)
+	budgetService := services.NewBudgetService(db, analyticsService, fxService)
+	templateService := services.NewTemplateService(db)
+	backupService := services.NewBackupService(db)
+	// Background jobs are drained on shutdown for up to JOB_DRAIN_TIMEOUT_SECONDS
+	jobs := services.NewJobManager(time.Duration(getEnvIntOrDefault("JOB_DRAIN_TIMEOUT_SECONDS", int(services.DefaultJobDrainTimeout/time.Second))) * time.Second)
+	exportService := services.NewExportService(db, backupService, uploadService, jobs)
+	if err := exportService.FailInterruptedJobs(); err != nil {
+		log.Printf("Warning: Failed to fail interrupted export jobs: %v", err)
+	}
+	pdfService := initPDFService(uploadService)
+	healthService := services.NewHealthService(dbService, fxService, uploadService)
+	organizationService := services.NewOrganizationService(db)
+	notificationService, smtpConfigured := initNotificationService(settingsService)
 
 	// Initialize MCP server
 	mcpSrv := mcpserver.NewMCPServer(
@@ -57,6 +75,9 @@ func main() {
 		uploadService,
 		analyticsService,
 		tagService,
+		budgetService,
+		templateService,
+		pdfService,
 	)
 
 	// Initialize API server
@@ -71,10 +92,17 @@ func main() {
 		uploadService,
 		fileUploadService,
 		analyticsService,
+		settingsService,
+		budgetService,
+		backupService,
+		exportService,
 		fileUnlinkService,
 		pdfService,
+		healthService,
+		organizationService,
 		mcpSrv.GetServer(),
 	)
+	apiServer.SetJobManager(jobs)
 
 	// Enable authentication if configured (must be before routes)
 	if os.Getenv("OAUTH_SERVER_URL") != "" || os.Getenv("MCPROUTER_SERVER_URL") != "" {
@@ -85,6 +113,11 @@ func main() {
 		}
 	}
 
+	// Enable rate limiting (after authentication so requests are keyed by user)
+	if perMinute := getEnvIntOrDefault("RATE_LIMIT_PER_MINUTE", 120); perMinute > 0 {
+		apiServer.EnableRateLimit(perMinute)
+	}
+
 	// Setup routes (after authentication middleware)
 	apiServer.SetupRoutes()
 
@@ -95,15 +128,34 @@ func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	// Fail exports of instances that stopped and delete bundles after EXPORT_BUNDLE_TTL_HOURS
+	bundleTTL := time.Duration(getEnvIntOrDefault("EXPORT_BUNDLE_TTL_HOURS", int(services.DefaultExportBundleTTL/time.Hour))) * time.Hour
+	if bundleTTL <= 0 {
+		bundleTTL = services.DefaultExportBundleTTL
+	}
+	jobs.Go(func(ctx context.Context) { exportService.StartSweeper(ctx, bundleTTL) })
+
+	// Email overdue invoice digests when SMTP is configured
+	if smtpConfigured {
+		interval := time.Duration(getEnvIntOrDefault("OVERDUE_REMINDER_INTERVAL_HOURS", 24)) * time.Hour
+		if interval <= 0 {
+			interval = services.DefaultOverdueReminderInterval
+		}
+		reminderJob := services.NewOverdueReminderJob(db, invoiceService, notificationService)
+		jobs.Go(func(ctx context.Context) { reminderJob.Start(ctx, interval) })
+		log.Printf("Overdue reminder job started (interval: %s)", interval)
+	}
+
 	go func() {
 		sigCh := make(chan os.Signal, 1)
 		signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
 		<-sigCh
 		log.Println("Shutting down server...")
-		cancel()
 		if err := apiServer.Shutdown(); err != nil {
 			log.Printf("Error during shutdown: %v", err)
 		}
+		// Only exit once requests and background jobs are drained
+		cancel()
 	}()
 
 	// Start server
@@ -182,16 +234,30 @@ func getEnvOrDefault(key, defaultValue string) string {
 	return defaultValue
 }
 
-func initPDFService() services.PDFService {
+func getEnvIntOrDefault(key string, defaultValue int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		log.Printf("Warning: invalid %s %q, using default %d", key, value, defaultValue)
+		return defaultValue
+	}
+	return parsed
+}
+
+func initPDFService(uploadService services.UploadService) services.PDFService {
 	chromeURL := os.Getenv("CHROME_URL")
 	if chromeURL == "" {
 		log.Println("Warning: CHROME_URL not configured, HTML to PDF will not work")
-		return nil
+	} else {
+		log.Printf("PDF service initialized (Chrome URL: %s)", chromeURL)
 	}
 
-	log.Printf("PDF service initialized (Chrome URL: %s)", chromeURL)
 	return services.NewPDFService(services.PDFServiceConfig{
 		ChromeURL: chromeURL,
+		Storage:   uploadService,
 	})
 }
 
@@ -213,6 +279,34 @@ func initRedisService() services.RedisService {
 }
 
 func initFXService(redis services.RedisService) services.FXService {
+	names := services.DefaultFXProviders
+	if order := os.Getenv("FX_PROVIDERS"); order != "" {
+		names = strings.Split(order, ",")
+	}
+	providers, err := services.NewFXProviders(names, &http.Client{Timeout: 10 * time.Second})
+	if err != nil {
+		log.Fatalf("Invalid FX_PROVIDERS: %v", err)
+	}
+	log.Printf("FX providers: %s", strings.Join(names, ", "))
+
 	// FX service with 1-minute cache TTL
-	return services.NewFXService(redis, &http.Client{Timeout: 10 * time.Second}, time.Minute)
+	return services.NewFXService(redis, providers, time.Minute)
+}
+
+func initNotificationService(settingsService services.SettingsService) (services.NotificationService, bool) {
+	cfg := services.SMTPConfig{
+		Host:     os.Getenv("SMTP_HOST"),
+		Port:     getEnvIntOrDefault("SMTP_PORT", services.DefaultSMTPPort),
+		Username: os.Getenv("SMTP_USERNAME"),
+		Password: os.Getenv("SMTP_PASSWORD"),
+		From:     os.Getenv("SMTP_FROM"),
+	}
+
+	if !cfg.Configured() {
+		log.Println("SMTP_HOST/SMTP_FROM not configured, overdue email reminders disabled")
+	} else {
+		log.Printf("Notification service initialized (SMTP host: %s)", cfg.Host)
+	}
+
+	return services.NewNotificationService(cfg, settingsService), cfg.Configured()
 }
@@ -0,0 +1,70 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type AmountInWordsTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *AmountInWordsTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *AmountInWordsTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// TestBoundaryValues covers zero, sub-cent, negative, and large amounts
+func (s *AmountInWordsTestSuite) TestBoundaryValues() {
+	tests := []struct {
+		amount   float64
+		currency string
+		want     string
+	}{
+		{123.45, "USD", "One Hundred Twenty Three Dollars and 45/100"},
+		{0, "USD", "Zero Dollars and 00/100"},
+		{0.01, "USD", "Zero Dollars and 01/100"},
+		{1, "usd", "One Dollar and 00/100"},
+		{1000000, "EUR", "One Million Euros and 00/100"},
+		{1001.1, "GBP", "One Thousand One Pounds and 10/100"},
+		{-250.5, "USD", "Minus Two Hundred Fifty Dollars and 50/100"},
+		{-0.001, "USD", "Zero Dollars and 00/100"},
+		{0.999, "USD", "One Dollar and 00/100"},
+		{1234567890123.4, "USD", "One Trillion Two Hundred Thirty Four Billion Five Hundred Sixty Seven Million Eight Hundred Ninety Thousand One Hundred Twenty Three Dollars and 40/100"},
+		{1500, "JPY", "One Thousand Five Hundred Yen"},
+		{12.345, "KWD", "Twelve KWD and 345/1000"},
+		{1e18, "USD", ""},
+	}
+	for _, tt := range tests {
+		s.Equal(tt.want, utils.AmountInWords(tt.amount, tt.currency), "%v %s", tt.amount, tt.currency)
+	}
+}
+
+func (s *AmountInWordsTestSuite) TestGetInvoiceIncludeAmountInWords() {
+	invoiceID, err := s.setup.CreateTestInvoiceWithStatus("Consulting", nil, nil, "unpaid", 123.45)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("GET", "/api/invoices/"+uintToString(invoiceID), nil)
+	s.Require().NoError(err)
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.NotContains(invoice, "amount_in_words")
+
+	resp, err = s.setup.MakeRequest("GET", "/api/invoices/"+uintToString(invoiceID)+"?include_amount_in_words=true", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	invoice, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("One Hundred Twenty Three Dollars and 45/100", invoice["amount_in_words"])
+}
+
+func TestAmountInWordsSuite(t *testing.T) {
+	suite.Run(t, new(AmountInWordsTestSuite))
+}
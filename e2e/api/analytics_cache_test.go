@@ -0,0 +1,199 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type AnalyticsCacheTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *AnalyticsCacheTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *AnalyticsCacheTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+func (s *AnalyticsCacheTestSuite) summary() *services.AnalyticsSummary {
+	summary, err := s.setup.AnalyticsService.GetSummary(s.setup.TestUserID, services.Period1Month)
+	s.Require().NoError(err)
+	return summary
+}
+
+func (s *AnalyticsCacheTestSuite) TestRepeatedCallsHitCache() {
+	_, err := s.setup.CreateTestInvoiceWithStatus("Rent", nil, nil, "paid", 1000)
+	s.Require().NoError(err)
+
+	first := s.summary()
+	s.Equal(1000.0, first.TotalAmount)
+	s.Same(first, s.summary())
+
+	// Keys include the period, the method, and the user
+	yearly, err := s.setup.AnalyticsService.GetSummary(s.setup.TestUserID, services.Period1Year)
+	s.Require().NoError(err)
+	s.NotSame(first, yearly)
+	other, err := s.setup.AnalyticsService.GetSummary("other-user", services.Period1Month)
+	s.Require().NoError(err)
+	s.Zero(other.InvoiceCount)
+
+	byCategory, err := s.setup.AnalyticsService.GetByCategory(s.setup.TestUserID, services.Period1Month)
+	s.Require().NoError(err)
+	again, err := s.setup.AnalyticsService.GetByCategory(s.setup.TestUserID, services.Period1Month)
+	s.Require().NoError(err)
+	s.Same(byCategory, again)
+
+	uncached, err := s.setup.AnalyticsService.WithoutCache().GetSummary(s.setup.TestUserID, services.Period1Month)
+	s.Require().NoError(err)
+	s.NotSame(first, uncached)
+	s.Equal(first.TotalAmount, uncached.TotalAmount)
+}
+
+func (s *AnalyticsCacheTestSuite) TestWritesInvalidate() {
+	s.Zero(s.summary().InvoiceCount)
+
+	invoiceID, err := s.setup.CreateTestInvoiceWithStatus("Rent", nil, nil, "unpaid", 1000)
+	s.Require().NoError(err)
+	summary := s.summary()
+	s.Equal(int64(1), summary.InvoiceCount)
+	s.Equal(1000.0, summary.UnpaidAmount)
+
+	resp, err := s.setup.MakeRequest("PATCH", "/api/invoices/"+uintToString(invoiceID)+"/status", map[string]interface{}{"status": "paid"})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	s.Equal(1000.0, s.summary().PaidAmount)
+
+	// Raw statements invalidate too
+	s.Require().NoError(s.setup.DBService.GetDB().Exec("UPDATE invoices SET deleted_at = CURRENT_TIMESTAMP WHERE id = ?", invoiceID).Error)
+	s.Zero(s.summary().InvoiceCount)
+}
+
+// TestWritesInvalidateOnlyTheirOwner verifies a write drops only the cached results of the user
+// owning the changed rows, and that services on the same database share one cache
+func (s *AnalyticsCacheTestSuite) TestWritesInvalidateOnlyTheirOwner() {
+	invoiceID, err := s.setup.CreateTestInvoiceWithStatus("Rent", nil, nil, "unpaid", 1000)
+	s.Require().NoError(err)
+	first := s.summary()
+	other, err := s.setup.AnalyticsService.GetSummary("other-user", services.Period1Month)
+	s.Require().NoError(err)
+
+	// Another service on the same database serves the same cached results
+	shared := services.NewAnalyticsService(s.setup.DBService.GetDB())
+	again, err := shared.GetSummary(s.setup.TestUserID, services.Period1Month)
+	s.Require().NoError(err)
+	s.Same(first, again)
+
+	// Adding an item to the user's invoice drops their results, not the other user's
+	_, err = s.setup.CreateTestInvoiceItem(invoiceID, "Deposit", 1, 500)
+	s.Require().NoError(err)
+	s.NotSame(first, s.summary())
+	s.Equal(1500.0, s.summary().TotalAmount)
+	otherAgain, err := s.setup.AnalyticsService.GetSummary("other-user", services.Period1Month)
+	s.Require().NoError(err)
+	s.Same(other, otherAgain)
+
+	// A write by the other user leaves the user's results cached
+	current := s.summary()
+	resp, err := s.setup.MakeAuthenticatedRequest("POST", "/api/invoices", map[string]interface{}{
+		"title":    "Groceries",
+		"currency": "USD",
+	}, "other-user")
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	s.Same(current, s.summary())
+	otherAgain, err = s.setup.AnalyticsService.GetSummary("other-user", services.Period1Month)
+	s.Require().NoError(err)
+	s.Equal(int64(1), otherAgain.InvoiceCount)
+}
+
+func (s *AnalyticsCacheTestSuite) TestNoCacheParam() {
+	_, err := s.setup.CreateTestInvoiceWithStatus("Rent", nil, nil, "paid", 1000)
+	s.Require().NoError(err)
+
+	for _, path := range []string{
+		"/api/analytics/summary?no_cache=true",
+		"/api/analytics/by-category?no_cache=true",
+		"/api/dashboard?no_cache=true",
+	} {
+		resp, err := s.setup.MakeRequest("GET", path, nil)
+		s.Require().NoError(err)
+		s.Equal(http.StatusOK, resp.StatusCode, path)
+	}
+
+	resp, err := s.setup.MakeRequest("GET", "/api/analytics/summary?no_cache=true", nil)
+	s.Require().NoError(err)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(1000.0, result["paid_amount"])
+}
+
+func TestAnalyticsCacheSuite(t *testing.T) {
+	suite.Run(t, new(AnalyticsCacheTestSuite))
+}
+
+// BenchmarkGetSummary compares repeated summary calls served from the analytics cache against
+// recomputing them, reporting the number of SQL queries issued per call
+func BenchmarkGetSummary(b *testing.B) {
+	dbService, err := services.NewSqliteDBService(":memory:")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer dbService.Close()
+	db := dbService.GetDB()
+
+	var queries int64
+	if err := db.Callback().Query().After("gorm:query").Register("bench:count_queries", func(*gorm.DB) {
+		atomic.AddInt64(&queries, 1)
+	}); err != nil {
+		b.Fatal(err)
+	}
+	if err := db.Callback().Row().After("gorm:row").Register("bench:count_rows", func(*gorm.DB) {
+		atomic.AddInt64(&queries, 1)
+	}); err != nil {
+		b.Fatal(err)
+	}
+
+	invoiceService := services.NewInvoiceService(db, nil)
+	for i := 0; i < 50; i++ {
+		if _, err := invoiceService.CreateInvoice("bench-user", &models.Invoice{
+			Title:    fmt.Sprintf("Invoice %d", i),
+			Currency: "USD",
+			Status:   models.InvoiceStatusPaid,
+			Items: []models.InvoiceItem{
+				{Description: "Service", Quantity: 1, UnitPrice: float64(100 + i)},
+			},
+		}); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	analyticsService := services.NewAnalyticsService(db)
+	for _, bc := range []struct {
+		name    string
+		service services.AnalyticsService
+	}{
+		{"Cached", analyticsService},
+		{"NoCache", analyticsService.WithoutCache()},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			atomic.StoreInt64(&queries, 0)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := bc.service.GetSummary("bench-user", services.Period1Month); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(atomic.LoadInt64(&queries))/float64(b.N), "queries/op")
+		})
+	}
+}
@@ -386,6 +386,56 @@ func (s *AnalyticsCurrencyTestSuite) TestAnalyticsSummary_MixedStatusMultiCurren
 	s.InDelta(50.0, summary.OverdueAmount, 0.01)
 }
 
+// TestCurrencyExposure verifies spending is grouped by invoice currency, including
+// currencies whose invoices are all paid or all unpaid
+func (s *AnalyticsCurrencyTestSuite) TestCurrencyExposure() {
+	// USD: 100 USD, paid
+	usdInvoiceID, err := s.setup.CreateTestInvoiceWithCurrency("USD Invoice", "USD")
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceItem(usdInvoiceID, "USD Item", 1, 100.00)
+	s.Require().NoError(err)
+
+	// EUR: 100 EUR -> 110 USD, paid
+	eurInvoiceID, err := s.setup.CreateTestInvoiceWithCurrency("EUR Invoice", "EUR")
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceItem(eurInvoiceID, "EUR Item", 1, 100.00)
+	s.Require().NoError(err)
+
+	// HKD: 1000 HKD -> 128 USD, unpaid
+	hkdInvoiceID, err := s.setup.CreateTestInvoiceWithCurrency("HKD Invoice", "HKD")
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceItem(hkdInvoiceID, "HKD Item", 1, 1000.00)
+	s.Require().NoError(err)
+
+	for _, id := range []uint{usdInvoiceID, eurInvoiceID} {
+		s.Require().NoError(s.setup.InvoiceService.UpdateInvoiceStatus(s.setup.TestUserID, id, "paid"))
+	}
+
+	exposure, err := s.setup.AnalyticsService.GetCurrencyExposure(s.setup.TestUserID, services.Period1Month)
+	s.Require().NoError(err)
+	s.Equal("USD", exposure.Currency)
+	s.InDelta(338.0, exposure.TotalTargetAmount, 0.01)
+	s.Require().Len(exposure.Items, 3)
+
+	hkd, eur, usd := exposure.Items[0], exposure.Items[1], exposure.Items[2]
+	s.Equal("HKD", hkd.Currency)
+	s.Equal(1000.0, hkd.Amount)
+	s.InDelta(128.0, hkd.TargetAmount, 0.01)
+	s.Equal(0.0, hkd.PaidAmount)
+	s.InDelta(128.0, hkd.UnpaidAmount, 0.01)
+	s.Equal(int64(1), hkd.InvoiceCount)
+	s.Equal(37.87, hkd.Percentage)
+
+	s.Equal("EUR", eur.Currency)
+	s.Equal(100.0, eur.Amount)
+	s.InDelta(110.0, eur.PaidAmount, 0.01)
+	s.Equal(0.0, eur.UnpaidAmount)
+	s.Equal(32.54, eur.Percentage)
+
+	s.Equal("USD", usd.Currency)
+	s.Equal(29.59, usd.Percentage)
+}
+
 func TestAnalyticsCurrencySuite(t *testing.T) {
 	suite.Run(t, new(AnalyticsCurrencyTestSuite))
 }
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/rxtech-lab/invoice-management/internal/tools"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type AnomalyTestSuite struct {
+	suite.Suite
+	setup       *TestSetup
+	utilitiesID uint
+	spikeID     uint
+}
+
+func (s *AnomalyTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+
+	utilitiesID, err := s.setup.CreateTestCategory("Utilities")
+	s.Require().NoError(err)
+	s.utilitiesID = utilitiesID
+
+	// Seven ordinary electricity bills and one spike
+	for i := 0; i < 7; i++ {
+		_, err := s.setup.CreateTestInvoiceOnDate("Electricity", &utilitiesID, nil, "paid", 100, DaysAgo(10+i*30))
+		s.Require().NoError(err)
+	}
+	s.spikeID, err = s.setup.CreateTestInvoiceOnDate("Electricity heatwave", &utilitiesID, nil, "paid", 500, DaysAgo(5))
+	s.Require().NoError(err)
+
+	// Too few travel invoices to judge the large one
+	travelID, err := s.setup.CreateTestCategory("Travel")
+	s.Require().NoError(err)
+	for _, amount := range []float64{50, 60, 2000} {
+		_, err := s.setup.CreateTestInvoiceOnDate("Trip", &travelID, nil, "paid", amount, DaysAgo(20))
+		s.Require().NoError(err)
+	}
+}
+
+func (s *AnomalyTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+func (s *AnomalyTestSuite) TestDetectAnomalies() {
+	result, err := s.setup.AnalyticsService.DetectAnomalies(s.setup.TestUserID, services.Period1Year, services.AnomalyOptions{})
+	s.Require().NoError(err)
+
+	s.Equal(services.AnomalyGroupByCategory, result.GroupBy)
+	s.Equal(services.DefaultAnomalyThreshold, result.K)
+	s.Equal(1, result.SkippedGroups)
+
+	s.Require().Len(result.Baselines, 1)
+	baseline := result.Baselines[0]
+	s.Equal(s.utilitiesID, baseline.ID)
+	s.Equal("Utilities", baseline.Name)
+	s.Equal(8, baseline.SampleSize)
+	s.InDelta(150.0, baseline.Mean, 0.01)
+	s.InDelta(132.29, baseline.StdDev, 0.01)
+
+	s.Require().Len(result.Anomalies, 1)
+	anomaly := result.Anomalies[0]
+	s.Equal(s.spikeID, anomaly.InvoiceID)
+	s.Equal("Utilities", anomaly.GroupName)
+	s.InDelta(500.0, anomaly.Amount, 0.01)
+	s.InDelta(2.65, anomaly.ZScore, 0.01)
+}
+
+func (s *AnomalyTestSuite) TestThresholdAndSampleSize() {
+	// A higher k flags nothing
+	result, err := s.setup.AnalyticsService.DetectAnomalies(s.setup.TestUserID, services.Period1Year, services.AnomalyOptions{K: 3})
+	s.Require().NoError(err)
+	s.Empty(result.Anomalies)
+
+	// Lowering the minimum sample size evaluates the travel invoices too
+	result, err = s.setup.AnalyticsService.DetectAnomalies(s.setup.TestUserID, services.Period1Year, services.AnomalyOptions{K: 1, MinSamples: 3})
+	s.Require().NoError(err)
+	s.Equal(0, result.SkippedGroups)
+	s.Len(result.Baselines, 2)
+	s.Len(result.Anomalies, 2)
+
+	_, err = s.setup.AnalyticsService.DetectAnomalies(s.setup.TestUserID, services.Period1Year, services.AnomalyOptions{GroupBy: "tag"})
+	s.Error(err)
+}
+
+// TestToolRejectsZeroK verifies the tool rejects an explicit k of 0 instead of using the default
+func (s *AnomalyTestSuite) TestToolRejectsZeroK() {
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: s.setup.TestUserID})
+	handler := tools.NewDetectSpendingAnomaliesTool(s.setup.AnalyticsService).GetHandler()
+	for _, args := range []map[string]interface{}{
+		{"k": float64(0)},
+		{"k": float64(-1)},
+		{"min_samples": float64(0)},
+	} {
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = args
+		result, err := handler(ctx, request)
+		s.Require().NoError(err)
+		s.True(result.IsError, "%v", args)
+	}
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{"k": float64(2)}
+	result, err := handler(ctx, request)
+	s.Require().NoError(err)
+	s.False(result.IsError, result.Content[0].(mcp.TextContent).Text)
+}
+
+func (s *AnomalyTestSuite) TestRefundsAreIgnored() {
+	refundID, err := s.setup.CreateTestInvoiceOnDate("Electricity refund", &s.utilitiesID, nil, "paid", 5000, DaysAgo(3))
+	s.Require().NoError(err)
+	s.Require().NoError(s.setup.InvoiceService.LinkInvoices(s.setup.TestUserID, refundID, s.spikeID, models.InvoiceRelationRefund))
+
+	result, err := s.setup.AnalyticsService.DetectAnomalies(s.setup.TestUserID, services.Period1Year, services.AnomalyOptions{})
+	s.Require().NoError(err)
+	s.Require().Len(result.Baselines, 1)
+	s.Equal(8, result.Baselines[0].SampleSize)
+	s.Require().Len(result.Anomalies, 1)
+	s.Equal(s.spikeID, result.Anomalies[0].InvoiceID)
+}
+
+func TestAnomalySuite(t *testing.T) {
+	suite.Run(t, new(AnomalyTestSuite))
+}
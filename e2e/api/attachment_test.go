@@ -0,0 +1,168 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/stretchr/testify/suite"
+)
+
+type AttachmentTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *AttachmentTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *AttachmentTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// createTestFileUpload registers an uploaded file for the given test setup
+func createTestFileUpload(setup *TestSetup, key, filename string) {
+	setup.t.Helper()
+	fileUpload := &models.FileUpload{
+		UserID:      setup.TestUserID,
+		Key:         key,
+		Filename:    filename,
+		ContentType: "application/pdf",
+		Size:        1024,
+	}
+	if err := setup.DBService.GetDB().Create(fileUpload).Error; err != nil {
+		setup.t.Fatalf("failed to create file upload: %v", err)
+	}
+}
+
+func (s *AttachmentTestSuite) TestAddListAndRemoveAttachments() {
+	invoiceID, err := s.setup.CreateTestInvoice("Hotel stay", nil, nil)
+	s.Require().NoError(err)
+
+	createTestFileUpload(s.setup, "uploads/invoice.pdf", "invoice.pdf")
+	createTestFileUpload(s.setup, "uploads/receipt.pdf", "receipt.pdf")
+
+	// Attach both files
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices/"+uintToString(invoiceID)+"/attachments", map[string]interface{}{
+		"key": "uploads/invoice.pdf",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+
+	attachment, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("uploads/invoice.pdf", attachment["s3_key"])
+	s.Equal("invoice.pdf", attachment["filename"])
+	s.Equal("application/pdf", attachment["content_type"])
+	s.Equal(float64(1024), attachment["size"])
+
+	resp, err = s.setup.MakeRequest("POST", "/api/invoices/"+uintToString(invoiceID)+"/attachments", map[string]interface{}{
+		"key": "uploads/receipt.pdf",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+
+	// List attachments
+	resp, err = s.setup.MakeRequest("GET", "/api/invoices/"+uintToString(invoiceID)+"/attachments", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Len(result["data"], 2)
+
+	// Invoice response includes attachments
+	resp, err = s.setup.MakeRequest("GET", "/api/invoices/"+uintToString(invoiceID), nil)
+	s.Require().NoError(err)
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Len(invoice["attachments"], 2)
+
+	// Remove the first attachment
+	attachmentID := uint(attachment["id"].(float64))
+	resp, err = s.setup.MakeRequest("DELETE", "/api/invoices/"+uintToString(invoiceID)+"/attachments/"+uintToString(attachmentID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNoContent, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", "/api/invoices/"+uintToString(invoiceID)+"/attachments", nil)
+	s.Require().NoError(err)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	data := result["data"].([]interface{})
+	s.Require().Len(data, 1)
+	s.Equal("uploads/receipt.pdf", data[0].(map[string]interface{})["s3_key"])
+}
+
+func (s *AttachmentTestSuite) TestAddAttachmentUnknownFile() {
+	invoiceID, err := s.setup.CreateTestInvoice("Hotel stay", nil, nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices/"+uintToString(invoiceID)+"/attachments", map[string]interface{}{
+		"key": "uploads/missing.pdf",
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *AttachmentTestSuite) TestRemoveAttachmentNotFound() {
+	invoiceID, err := s.setup.CreateTestInvoice("Hotel stay", nil, nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("DELETE", "/api/invoices/"+uintToString(invoiceID)+"/attachments/99999", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+// TestDeleteInvoiceUnlinksAttachments verifies that deleting an invoice unlinks every attachment
+func (s *AttachmentTestSuite) TestDeleteInvoiceUnlinksAttachments() {
+	var mu sync.Mutex
+	var unlinkedKeys []string
+
+	mockFileServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Equal(http.MethodDelete, r.Method)
+		if r.URL.Path == "/api/files" {
+			mu.Lock()
+			unlinkedKeys = append(unlinkedKeys, r.URL.Query().Get("key"))
+			mu.Unlock()
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer mockFileServer.Close()
+
+	setup := NewTestSetupWithFileServer(s.T(), mockFileServer.URL, true)
+	defer setup.Cleanup()
+
+	invoiceID, err := setup.CreateTestInvoice("Hotel stay", nil, nil)
+	s.Require().NoError(err)
+
+	createTestFileUpload(setup.TestSetup, "uploads/invoice.pdf", "invoice.pdf")
+	createTestFileUpload(setup.TestSetup, "uploads/receipt.pdf", "receipt.pdf")
+
+	for _, key := range []string{"uploads/invoice.pdf", "uploads/receipt.pdf"} {
+		resp, err := setup.MakeRequest("POST", "/api/invoices/"+uintToString(invoiceID)+"/attachments", map[string]interface{}{
+			"key": key,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	}
+
+	resp, err := setup.MakeRequestWithOAuth("DELETE", "/api/invoices/"+uintToString(invoiceID), nil, "test-token-789")
+	s.Require().NoError(err)
+	s.Equal(http.StatusNoContent, resp.StatusCode)
+
+	mu.Lock()
+	s.ElementsMatch([]string{"uploads/invoice.pdf", "uploads/receipt.pdf"}, unlinkedKeys)
+	mu.Unlock()
+
+	// Attachments are removed along with the invoice
+	var count int64
+	setup.DBService.GetDB().Model(&models.InvoiceAttachment{}).Where("invoice_id = ?", invoiceID).Count(&count)
+	s.Equal(int64(0), count)
+}
+
+func TestAttachmentSuite(t *testing.T) {
+	suite.Run(t, new(AttachmentTestSuite))
+}
@@ -0,0 +1,263 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type AuditTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *AuditTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *AuditTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// trail fetches the audit trail of an invoice, newest first
+func (s *AuditTestSuite) trail(invoiceID uint) []map[string]interface{} {
+	resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/invoices/%d/audit", invoiceID), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	var entries []map[string]interface{}
+	for _, entry := range result["data"].([]interface{}) {
+		entries = append(entries, entry.(map[string]interface{}))
+	}
+	return entries
+}
+
+func (s *AuditTestSuite) TestRecordsInvoiceAndItemChanges() {
+	invoiceID, err := s.setup.CreateTestInvoice("Office Supplies", nil, nil)
+	s.Require().NoError(err)
+	itemID, err := s.setup.CreateTestInvoiceItem(invoiceID, "Paper", 2, 10)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("PUT", fmt.Sprintf("/api/invoices/%d/items/%d", invoiceID, itemID), map[string]interface{}{
+		"description": "Paper",
+		"quantity":    3,
+		"unit_price":  10,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("PUT", fmt.Sprintf("/api/invoices/%d", invoiceID), map[string]interface{}{
+		"title": "Office Supplies Q1",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("PATCH", fmt.Sprintf("/api/invoices/%d/status", invoiceID), map[string]string{"status": "paid"})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	// Setting the same status again changes nothing and is not recorded
+	resp, err = s.setup.MakeRequest("PATCH", fmt.Sprintf("/api/invoices/%d/status", invoiceID), map[string]string{"status": "paid"})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("DELETE", fmt.Sprintf("/api/invoices/%d/items/%d", invoiceID, itemID), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusNoContent, resp.StatusCode)
+
+	entries := s.trail(invoiceID)
+	s.Require().Len(entries, 6)
+
+	var actions []string
+	for _, entry := range entries {
+		actions = append(actions, fmt.Sprintf("%s:%s", entry["entity_type"], entry["action"]))
+		s.Equal(s.setup.TestUserID, entry["actor_sub"])
+		s.Equal(float64(invoiceID), entry["invoice_id"])
+	}
+	s.Equal([]string{
+		"invoice_item:delete",
+		"invoice:status_change",
+		"invoice:update",
+		"invoice_item:update",
+		"invoice_item:create",
+		"invoice:create",
+	}, actions)
+
+	// Item delete keeps the last values
+	itemDelete := entries[0]["diff"].(map[string]interface{})
+	s.Equal(map[string]interface{}{"from": "Paper"}, itemDelete["description"])
+
+	status := entries[1]["diff"].(map[string]interface{})
+	s.Equal(map[string]interface{}{"from": "unpaid", "to": "paid"}, status["status"])
+
+	update := entries[2]["diff"].(map[string]interface{})
+	s.Equal(map[string]interface{}{"from": "Office Supplies", "to": "Office Supplies Q1"}, update["title"])
+
+	// Only the changed fields are reported
+	itemUpdate := entries[3]["diff"].(map[string]interface{})
+	s.Equal(map[string]interface{}{"from": 2.0, "to": 3.0}, itemUpdate["quantity"])
+	s.Equal(map[string]interface{}{"from": 20.0, "to": 30.0}, itemUpdate["amount"])
+	s.NotContains(itemUpdate, "description")
+	s.NotContains(itemUpdate, "updated_at")
+
+	create := entries[5]["diff"].(map[string]interface{})
+	s.Equal(map[string]interface{}{"to": "Office Supplies"}, create["title"])
+	s.NotContains(create, "user_id")
+}
+
+func (s *AuditTestSuite) TestTrailSurvivesDeletion() {
+	invoiceID, err := s.setup.CreateTestInvoice("Temporary", nil, nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("DELETE", fmt.Sprintf("/api/invoices/%d", invoiceID), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusNoContent, resp.StatusCode)
+
+	entries := s.trail(invoiceID)
+	s.Require().Len(entries, 2)
+	s.Equal("delete", entries[0]["action"])
+	s.Equal(map[string]interface{}{"from": "Temporary"}, entries[0]["diff"].(map[string]interface{})["title"])
+	s.Equal("create", entries[1]["action"])
+}
+
+func (s *AuditTestSuite) TestTrailIsScopedToOwner() {
+	invoiceID, err := s.setup.CreateTestInvoice("Private", nil, nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeAuthenticatedRequest("GET", fmt.Sprintf("/api/invoices/%d/audit", invoiceID), nil, "other-user")
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", "/api/invoices/999999/audit", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+// page fetches a page of an invoice's audit listing at path ("audit" or "status-history") and
+// returns the status code, the entry IDs, and the pagination metadata
+func (s *AuditTestSuite) page(invoiceID uint, path string, query url.Values) (int, []uint, map[string]interface{}) {
+	resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/invoices/%d/%s?%s", invoiceID, path, query.Encode()), nil)
+	s.Require().NoError(err)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	if resp.StatusCode != http.StatusOK {
+		return resp.StatusCode, nil, nil
+	}
+	var ids []uint
+	for _, entry := range result["data"].([]interface{}) {
+		ids = append(ids, uint(entry.(map[string]interface{})["id"].(float64)))
+	}
+	return resp.StatusCode, ids, result["pagination"].(map[string]interface{})
+}
+
+func (s *AuditTestSuite) TestPagedTrail() {
+	invoiceID, err := s.setup.CreateTestInvoice("Busy", nil, nil)
+	s.Require().NoError(err)
+	for i := 0; i < 24; i++ {
+		status := models.InvoiceStatusPaid
+		if i%2 == 1 {
+			status = models.InvoiceStatusUnpaid
+		}
+		s.Require().NoError(s.setup.InvoiceService.UpdateInvoiceStatus(s.setup.TestUserID, invoiceID, status))
+	}
+	_, err = s.setup.CreateTestInvoiceItem(invoiceID, "Not a status change", 1, 10)
+	s.Require().NoError(err)
+
+	// Spread the entries an hour apart, in the order they were recorded
+	var entries []models.AuditLog
+	s.Require().NoError(s.setup.DBService.GetDB().Where("invoice_id = ?", invoiceID).Order("id").Find(&entries).Error)
+	s.Require().Len(entries, 26)
+	base := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	newestFirst := make([]uint, len(entries))
+	for i, entry := range entries {
+		s.Require().NoError(s.setup.DBService.GetDB().Model(&entry).Update("created_at", base.Add(time.Duration(i)*time.Hour)).Error)
+		newestFirst[len(entries)-1-i] = entry.ID
+	}
+
+	status, ids, pagination := s.page(invoiceID, "audit", url.Values{"limit": {"10"}, "offset": {"10"}})
+	s.Require().Equal(http.StatusOK, status)
+	s.Equal(newestFirst[10:20], ids)
+	s.Equal(map[string]interface{}{"limit": 10.0, "offset": 10.0, "total": 26.0, "total_pages": 3.0, "has_more": true}, pagination)
+
+	_, ids, pagination = s.page(invoiceID, "audit", url.Values{"action": {"status_change"}, "limit": {"100"}})
+	s.Len(ids, 24)
+	s.Equal(24.0, pagination["total"])
+
+	_, ids, _ = s.page(invoiceID, "audit", url.Values{
+		"start": {base.Add(5 * time.Hour).Format(time.RFC3339)},
+		"end":   {base.Add(9 * time.Hour).Format(time.RFC3339)},
+	})
+	s.Equal(newestFirst[16:21], ids)
+
+	// The status history has the creation and every status change, but not the item
+	_, ids, pagination = s.page(invoiceID, "status-history", url.Values{"limit": {"5"}, "offset": {"20"}})
+	s.Equal(newestFirst[21:26], ids)
+	s.Equal(25.0, pagination["total"])
+	s.Equal(false, pagination["has_more"])
+	_, ids, _ = s.page(invoiceID, "status-history", url.Values{"action": {"create"}})
+	s.Equal([]uint{entries[0].ID}, ids)
+
+	// Filters matching nothing are not a missing invoice
+	status, ids, pagination = s.page(invoiceID, "status-history", url.Values{"action": {"delete"}})
+	s.Equal(http.StatusOK, status)
+	s.Empty(ids)
+	s.Equal(0.0, pagination["total"])
+
+	status, _, _ = s.page(invoiceID, "audit", url.Values{"action": {"rename"}})
+	s.Equal(http.StatusBadRequest, status)
+	status, _, _ = s.page(invoiceID, "status-history", url.Values{
+		"start": {base.Add(time.Hour).Format(time.RFC3339)},
+		"end":   {base.Format(time.RFC3339)},
+	})
+	s.Equal(http.StatusBadRequest, status)
+	status, _, _ = s.page(999999, "status-history", url.Values{})
+	s.Equal(http.StatusNotFound, status)
+
+	// Without a limit the whole trail is returned, as before it was paged
+	_, ids, pagination = s.page(invoiceID, "audit", url.Values{})
+	s.Equal(newestFirst, ids)
+	s.Equal(0.0, pagination["limit"])
+	s.Equal(false, pagination["has_more"])
+}
+
+// TestStatusHistoryBackfill verifies entries recorded before status changes were flagged join the
+// status history once the database is migrated
+func (s *AuditTestSuite) TestStatusHistoryBackfill() {
+	path := filepath.Join(s.T().TempDir(), "audit.db")
+	dbService, err := services.NewSqliteDBService(path)
+	s.Require().NoError(err)
+	db := dbService.GetDB()
+
+	invoiceService := services.NewInvoiceService(db, nil)
+	invoice := &models.Invoice{Title: "Legacy", Items: []models.InvoiceItem{{Description: "Service", Quantity: 1, UnitPrice: 10}}}
+	_, err = invoiceService.CreateInvoice(s.setup.TestUserID, invoice)
+	s.Require().NoError(err)
+	s.Require().NoError(invoiceService.UpdateInvoiceStatus(s.setup.TestUserID, invoice.ID, models.InvoiceStatusPaid))
+
+	s.Require().NoError(db.Migrator().DropColumn(&models.AuditLog{}, "status_changed"))
+	sqlDB, err := db.DB()
+	s.Require().NoError(err)
+	s.Require().NoError(sqlDB.Close())
+
+	dbService, err = services.NewSqliteDBService(path)
+	s.Require().NoError(err)
+	entries, total, err := services.NewAuditService(dbService.GetDB()).ListStatusHistory(s.setup.TestUserID, invoice.ID, services.AuditTrailOptions{})
+	s.Require().NoError(err)
+	s.Equal(int64(2), total)
+	s.Equal(models.AuditActionStatusChange, entries[0].Action)
+	s.Equal(models.AuditActionCreate, entries[1].Action)
+}
+
+func TestAuditTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditTestSuite))
+}
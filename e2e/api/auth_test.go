@@ -1,10 +1,17 @@
 package api
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/rxtech-lab/invoice-management/internal/tools"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -101,6 +108,157 @@ func (s *AuthSuite) TestUserIsolation() {
 	s.Equal(http.StatusNotFound, resp.StatusCode, "User2 should not access User1's category")
 }
 
+// requestWithScopes makes a request as the test user with the given space-separated scopes
+func (s *AuthSuite) requestWithScopes(method, path, scopes string, body string) *http.Response {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Test-User-ID", s.setup.TestUserID)
+	if scopes != "" {
+		req.Header.Set("X-Test-Scopes", scopes)
+	}
+	resp, err := s.setup.App.Test(req, -1)
+	s.Require().NoError(err)
+	return resp
+}
+
+// TestInvoiceScopes verifies invoices:read and invoices:write are enforced on invoice routes
+func (s *AuthSuite) TestInvoiceScopes() {
+	createBody := `{"title": "Scoped invoice", "currency": "USD"}`
+
+	// Read-only token
+	s.Equal(http.StatusOK, s.requestWithScopes("GET", "/api/invoices", "invoices:read", "").StatusCode)
+	s.Equal(http.StatusForbidden, s.requestWithScopes("POST", "/api/invoices", "invoices:read", createBody).StatusCode)
+	s.Equal(http.StatusForbidden, s.requestWithScopes("DELETE", "/api/invoices/1", "invoices:read", "").StatusCode)
+
+	// Write-only token
+	s.Equal(http.StatusForbidden, s.requestWithScopes("GET", "/api/invoices", "invoices:write", "").StatusCode)
+	s.Equal(http.StatusCreated, s.requestWithScopes("POST", "/api/invoices", "invoices:write", createBody).StatusCode)
+
+	// Conversion previews are read-only despite being POSTs
+	s.Equal(http.StatusOK, s.requestWithScopes("POST", "/api/invoices/1/convert/preview", "invoices:read", `{"currency": "EUR"}`).StatusCode)
+
+	// Reference data is read with invoices:read too
+	s.Equal(http.StatusOK, s.requestWithScopes("GET", "/api/categories", "invoices:read", "").StatusCode)
+
+	// Tokens without scopes keep full access
+	s.Equal(http.StatusOK, s.requestWithScopes("GET", "/api/invoices", "", "").StatusCode)
+}
+
+// TestWriteRoutesNeedWriteScope verifies a read-only token is refused on every route that changes data
+func (s *AuthSuite) TestWriteRoutesNeedWriteScope() {
+	routes := []struct{ method, path string }{
+		{"POST", "/api/categories"},
+		{"PUT", "/api/categories/1"},
+		{"DELETE", "/api/categories/1?force=true"},
+		{"POST", "/api/companies"},
+		{"PUT", "/api/companies/1"},
+		{"DELETE", "/api/companies/1"},
+		{"POST", "/api/receivers"},
+		{"PUT", "/api/receivers/1"},
+		{"DELETE", "/api/receivers/1?force=true"},
+		{"POST", "/api/receivers/merge"},
+		{"POST", "/api/tags"},
+		{"PUT", "/api/tags/1"},
+		{"DELETE", "/api/tags/1"},
+		{"POST", "/api/organizations"},
+		{"PUT", "/api/organizations/1/members/someone"},
+		{"DELETE", "/api/organizations/1/members/someone"},
+		{"POST", "/api/invoices"},
+		{"POST", "/api/invoices/import"},
+		{"PUT", "/api/invoices/1"},
+		{"DELETE", "/api/invoices/1"},
+		{"POST", "/api/invoices/1/clone"},
+		{"PATCH", "/api/invoices/1/status"},
+		{"POST", "/api/invoices/1/finalize"},
+		{"POST", "/api/invoices/1/recalculate"},
+		{"POST", "/api/invoices/1/items"},
+		{"POST", "/api/invoices/1/items/batch"},
+		{"PUT", "/api/invoices/1/items/order"},
+		{"PUT", "/api/invoices/1/items/1"},
+		{"DELETE", "/api/invoices/1/items/1"},
+		{"POST", "/api/invoices/1/tags"},
+		{"DELETE", "/api/invoices/1/tags/1"},
+		{"POST", "/api/invoices/1/attachments"},
+		{"DELETE", "/api/invoices/1/attachments/1"},
+		{"POST", "/api/upload/confirm"},
+		{"POST", "/api/upload/html-to-pdf"},
+		{"PUT", "/api/settings"},
+		{"POST", "/api/budgets"},
+		{"DELETE", "/api/budgets/1"},
+		{"POST", "/api/import"},
+	}
+	for _, route := range routes {
+		resp := s.requestWithScopes(route.method, route.path, "invoices:read", "{}")
+		s.Equal(http.StatusForbidden, resp.StatusCode, "%s %s", route.method, route.path)
+	}
+}
+
+// TestDataRoutesNeedInvoiceScopes verifies a token without invoice scopes can't read analytics
+// or export and import data
+func (s *AuthSuite) TestDataRoutesNeedInvoiceScopes() {
+	routes := []struct{ method, path string }{
+		{"GET", "/api/analytics/summary"},
+		{"GET", "/api/analytics/by-category"},
+		{"GET", "/api/analytics/by-tag"},
+		{"GET", "/api/analytics/trend"},
+		{"GET", "/api/dashboard"},
+		{"GET", "/api/companies/1/breakdown"},
+		{"GET", "/api/receivers/1/statistics"},
+		{"GET", "/api/export"},
+		{"POST", "/api/exports"},
+		{"GET", "/api/exports/1"},
+		{"POST", "/api/import"},
+		{"GET", "/api/organizations"},
+	}
+	for _, route := range routes {
+		resp := s.requestWithScopes(route.method, route.path, "profile", "{}")
+		s.Equal(http.StatusForbidden, resp.StatusCode, "%s %s", route.method, route.path)
+	}
+
+	// The health check stays open
+	s.Equal(http.StatusOK, s.requestWithScopes("GET", "/health", "profile", "").StatusCode)
+}
+
+// TestToolScopes verifies MCP tools check invoices:read before reading and invoices:write before changing data
+func (s *AuthSuite) TestToolScopes() {
+	tagService := services.NewTagService(s.setup.DBService.GetDB())
+	readTools := map[string]server.ToolHandlerFunc{
+		"list_invoices":             tools.NewListInvoicesTool(s.setup.InvoiceService).GetHandler(),
+		"get_invoice":               tools.NewGetInvoiceTool(s.setup.InvoiceService).GetHandler(),
+		"lookup_invoice":            tools.NewLookupInvoiceTool(s.setup.InvoiceService).GetHandler(),
+		"search_invoices":           tools.NewSearchInvoicesTool(s.setup.InvoiceService).GetHandler(),
+		"detect_spending_anomalies": tools.NewDetectSpendingAnomaliesTool(s.setup.AnalyticsService).GetHandler(),
+		"monthly_trend":             tools.NewMonthlyTrendTool(s.setup.AnalyticsService).GetHandler(),
+		"spending_by_weekday":       tools.NewSpendingByWeekdayTool(s.setup.AnalyticsService).GetHandler(),
+		"company_breakdown":         tools.NewCompanyBreakdownTool(s.setup.AnalyticsService).GetHandler(),
+		"tag_usage":                 tools.NewTagUsageTool(tagService).GetHandler(),
+	}
+	noInvoiceScopes := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: s.setup.TestUserID, Scopes: []string{"profile"}})
+	for name, handler := range readTools {
+		result, err := handler(noInvoiceScopes, mcp.CallToolRequest{})
+		s.Require().NoError(err)
+		s.Require().True(result.IsError, name)
+		s.Contains(result.Content[0].(mcp.TextContent).Text, "Missing required scope: invoices:read", name)
+	}
+
+	readOnly := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: s.setup.TestUserID, Scopes: []string{"invoices:read"}})
+	result, err := tools.NewCleanupUnusedTagsTool(tagService).GetHandler()(readOnly, mcp.CallToolRequest{})
+	s.Require().NoError(err)
+	s.Require().True(result.IsError)
+	s.Contains(result.Content[0].(mcp.TextContent).Text, "Missing required scope: invoices:write")
+	result, err = tools.NewTagUsageTool(tagService).GetHandler()(readOnly, mcp.CallToolRequest{})
+	s.Require().NoError(err)
+	s.False(result.IsError)
+}
+
+// TestStrictScopes verifies tokens without scopes are denied in strict mode
+func (s *AuthSuite) TestStrictScopes() {
+	s.T().Setenv(utils.StrictScopesEnvVar, "true")
+
+	s.Equal(http.StatusForbidden, s.requestWithScopes("GET", "/api/invoices", "", "").StatusCode)
+	s.Equal(http.StatusOK, s.requestWithScopes("GET", "/api/invoices", "invoices:read", "").StatusCode)
+}
+
 func TestAuthSuite(t *testing.T) {
 	suite.Run(t, new(AuthSuite))
 }
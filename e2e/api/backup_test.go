@@ -0,0 +1,361 @@
+package api
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/stretchr/testify/suite"
+)
+
+type BackupTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *BackupTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *BackupTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// createBackupFixture creates an invoice with a category, company, receiver, tag, and two items
+func (s *BackupTestSuite) createBackupFixture() {
+	categoryID, err := s.setup.CreateTestCategory("Utilities")
+	s.Require().NoError(err)
+	companyID, err := s.setup.CreateTestCompany("Power Co")
+	s.Require().NoError(err)
+	receiverID, err := s.setup.CreateTestReceiver("John Doe", false)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", "/api/tags", map[string]interface{}{"name": "monthly"})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	tag, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	resp, err = s.setup.MakeRequest("POST", "/api/invoices", map[string]interface{}{
+		"title":       "Electricity",
+		"currency":    "USD",
+		"category_id": categoryID,
+		"company_id":  companyID,
+		"receiver_id": receiverID,
+		"tag_ids":     []interface{}{tag["id"]},
+		"items": []map[string]interface{}{
+			{"description": "Usage", "quantity": 1, "unit_price": 80},
+			{"description": "Service fee", "quantity": 1, "unit_price": 20},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+}
+
+func (s *BackupTestSuite) export() map[string]interface{} {
+	resp, err := s.setup.MakeRequest("GET", "/api/export", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	doc, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	return doc
+}
+
+func (s *BackupTestSuite) importAs(userID string, doc map[string]interface{}) (int, map[string]interface{}) {
+	resp, err := s.setup.MakeAuthenticatedRequest("POST", "/api/import", doc, userID)
+	s.Require().NoError(err)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	return resp.StatusCode, result
+}
+
+func (s *BackupTestSuite) TestExport() {
+	s.createBackupFixture()
+
+	doc := s.export()
+	s.Equal(float64(1), doc["schema_version"])
+	s.Len(doc["categories"], 1)
+	s.Len(doc["companies"], 1)
+	s.Len(doc["receivers"], 1)
+	s.Len(doc["tags"], 1)
+	s.Require().Len(doc["invoices"], 1)
+
+	invoice := doc["invoices"].([]interface{})[0].(map[string]interface{})
+	s.Len(invoice["items"], 2)
+	s.Len(invoice["tags"], 1)
+}
+
+func (s *BackupTestSuite) TestImportIntoAnotherAccount() {
+	s.createBackupFixture()
+	doc := s.export()
+
+	otherUserID := "other-user"
+	status, result := s.importAs(otherUserID, doc)
+	s.Require().Equal(http.StatusOK, status)
+	for _, kind := range []string{"categories", "companies", "receivers", "tags", "invoices"} {
+		counts := result[kind].(map[string]interface{})
+		s.Equal(float64(1), counts["created"], kind)
+		s.Equal(float64(0), counts["skipped"], kind)
+	}
+
+	// Relationships are remapped to the new account's records
+	resp, err := s.setup.MakeAuthenticatedRequest("GET", "/api/invoices", nil, otherUserID)
+	s.Require().NoError(err)
+	list, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Require().Equal(float64(1), list["total"])
+
+	original := doc["invoices"].([]interface{})[0].(map[string]interface{})
+	imported := list["data"].([]interface{})[0].(map[string]interface{})
+	s.NotEqual(original["id"], imported["id"])
+	s.NotEqual(original["category_id"], imported["category_id"])
+	s.Equal("Utilities", imported["category"].(map[string]interface{})["name"])
+	s.Equal("Power Co", imported["company"].(map[string]interface{})["name"])
+	s.Equal("John Doe", imported["receiver"].(map[string]interface{})["name"])
+	s.Equal(float64(100), imported["amount"])
+
+	items := imported["items"].([]interface{})
+	s.Require().Len(items, 2)
+	s.Equal("Usage", items[0].(map[string]interface{})["description"])
+	s.Equal("Service fee", items[1].(map[string]interface{})["description"])
+
+	tags := imported["tags"].([]interface{})
+	s.Require().Len(tags, 1)
+	s.Equal("monthly", tags[0].(map[string]interface{})["name"])
+
+	// The exporting account is untouched
+	resp, err = s.setup.MakeRequest("GET", "/api/invoices", nil)
+	s.Require().NoError(err)
+	list, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(1), list["total"])
+
+	// Importing again reuses existing records and skips duplicate invoices
+	status, result = s.importAs(otherUserID, doc)
+	s.Require().Equal(http.StatusOK, status)
+	for _, kind := range []string{"categories", "companies", "receivers", "tags", "invoices"} {
+		counts := result[kind].(map[string]interface{})
+		s.Equal(float64(0), counts["created"], kind)
+		s.Equal(float64(1), counts["skipped"], kind)
+	}
+}
+
+// TestImportKeepsInvoiceNumbers verifies imported invoices keep their numbers, later numbers follow
+// them, and a number the user already has is replaced
+func (s *BackupTestSuite) TestImportKeepsInvoiceNumbers() {
+	s.createBackupFixture()
+	doc := s.export()
+	prefix := fmt.Sprintf("INV-%d-", time.Now().Year())
+	invoice := doc["invoices"].([]interface{})[0].(map[string]interface{})
+	s.Equal(prefix+"0001", invoice["invoice_number"])
+
+	createAs := func(userID, title string, amount float64) map[string]interface{} {
+		resp, err := s.setup.MakeAuthenticatedRequest("POST", "/api/invoices", map[string]interface{}{
+			"title":    title,
+			"currency": "USD",
+			"items":    []map[string]interface{}{{"description": title, "quantity": 1, "unit_price": amount}},
+		}, userID)
+		s.Require().NoError(err)
+		s.Require().Equal(http.StatusCreated, resp.StatusCode)
+		created, err := s.setup.ReadResponseBody(resp)
+		s.Require().NoError(err)
+		return created
+	}
+
+	otherUserID := "other-user"
+	s.Equal(prefix+"0001", createAs(otherUserID, "Rent", 1200)["invoice_number"])
+	invoice["invoice_number"] = prefix + "0007"
+	status, _ := s.importAs(otherUserID, doc)
+	s.Require().Equal(http.StatusOK, status)
+	s.Equal(prefix+"0008", createAs(otherUserID, "Water", 40)["invoice_number"])
+
+	// Importing an invoice under a number the user already has gives it a new one
+	invoice["invoice_number"] = prefix + "0001"
+	invoice["items"].([]interface{})[0].(map[string]interface{})["unit_price"] = 90
+	status, result := s.importAs(s.setup.TestUserID, doc)
+	s.Require().Equal(http.StatusOK, status)
+	s.Equal(float64(1), result["invoices"].(map[string]interface{})["created"])
+
+	var numbers []string
+	s.Require().NoError(s.setup.DBService.GetDB().Table("invoices").
+		Where("user_id = ?", s.setup.TestUserID).Order("id ASC").Pluck("invoice_number", &numbers).Error)
+	s.Equal([]string{prefix + "0001", prefix + "0002"}, numbers)
+
+	// The database rejects a duplicate number
+	err := s.setup.DBService.GetDB().Exec("UPDATE invoices SET invoice_number = ? WHERE user_id = ? AND invoice_number = ?",
+		prefix+"0001", s.setup.TestUserID, prefix+"0002").Error
+	s.Error(err)
+}
+
+func (s *BackupTestSuite) TestImportRejectsInvalidDocument() {
+	status, _ := s.importAs("other-user", map[string]interface{}{"schema_version": 99})
+	s.Equal(http.StatusBadRequest, status)
+
+	// An invoice referencing a category missing from the document aborts the whole import
+	status, _ = s.importAs("other-user", map[string]interface{}{
+		"schema_version": 1,
+		"companies":      []map[string]interface{}{{"id": 1, "name": "Power Co"}},
+		"invoices": []map[string]interface{}{
+			{"id": 1, "title": "Electricity", "currency": "USD", "category_id": 42},
+		},
+	})
+	s.Equal(http.StatusBadRequest, status)
+
+	resp, err := s.setup.MakeAuthenticatedRequest("GET", "/api/companies", nil, "other-user")
+	s.Require().NoError(err)
+	list, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(0), list["total"])
+}
+
+// TestImportRejectsTagCycle verifies tags whose parents form a cycle abort the import
+func (s *BackupTestSuite) TestImportRejectsTagCycle() {
+	status, body := s.importAs("other-user", map[string]interface{}{
+		"schema_version": 1,
+		"tags": []map[string]interface{}{
+			{"id": 1, "name": "Home", "parent_id": 2},
+			{"id": 2, "name": "Utilities", "parent_id": 1},
+		},
+	})
+	s.Equal(http.StatusBadRequest, status)
+	s.Contains(body["error"], "nested under itself")
+
+	resp, err := s.setup.MakeAuthenticatedRequest("GET", "/api/tags", nil, "other-user")
+	s.Require().NoError(err)
+	list, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(0), list["total"])
+}
+
+// TestImportKeepsInvoiceLinks verifies links between invoices survive a round trip, a link to an
+// invoice missing from the document is dropped, and linked invoices forming a cycle abort the import
+func (s *BackupTestSuite) TestImportKeepsInvoiceLinks() {
+	s.createBackupFixture()
+	refundID, err := s.setup.CreateTestInvoice("Refund", nil, nil)
+	s.Require().NoError(err)
+	var electricityID uint
+	s.Require().NoError(s.setup.DBService.GetDB().Table("invoices").
+		Where("user_id = ? AND title = ?", s.setup.TestUserID, "Electricity").Pluck("id", &electricityID).Error)
+	s.Require().NoError(s.setup.InvoiceService.LinkInvoices(s.setup.TestUserID, refundID, electricityID, models.InvoiceRelationRefund))
+
+	doc := s.export()
+	invoices := doc["invoices"].([]interface{})
+	s.Require().Len(invoices, 2)
+	s.Equal(float64(electricityID), invoices[1].(map[string]interface{})["related_invoice_id"])
+	doc["invoices"] = append(invoices, map[string]interface{}{
+		"id": 999, "title": "Correction", "currency": "USD", "related_invoice_id": 998, "relation_type": "correction",
+		"items": []map[string]interface{}{{"description": "Adjustment", "quantity": 1, "unit_price": 15}},
+	})
+
+	otherUserID := "other-user"
+	status, body := s.importAs(otherUserID, doc)
+	s.Require().Equal(http.StatusOK, status, body)
+	s.Equal(float64(3), body["invoices"].(map[string]interface{})["created"])
+
+	resp, err := s.setup.MakeAuthenticatedRequest("GET", "/api/invoices", nil, otherUserID)
+	s.Require().NoError(err)
+	list, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	imported := make(map[string]map[string]interface{})
+	for _, invoice := range list["data"].([]interface{}) {
+		invoice := invoice.(map[string]interface{})
+		imported[invoice["title"].(string)] = invoice
+	}
+	s.Require().Len(imported, 3)
+	s.Equal(imported["Electricity"]["id"], imported["Refund"]["related_invoice_id"])
+	s.NotEqual(float64(electricityID), imported["Refund"]["related_invoice_id"])
+	s.Equal("refund", imported["Refund"]["relation_type"])
+	s.Nil(imported["Correction"]["related_invoice_id"])
+	s.Nil(imported["Electricity"]["related_invoice_id"])
+
+	status, body = s.importAs("third-user", map[string]interface{}{
+		"schema_version": 1,
+		"invoices": []map[string]interface{}{
+			{
+				"id": 1, "title": "Credit note", "currency": "USD", "related_invoice_id": 2, "relation_type": "credit_note",
+				"items": []map[string]interface{}{{"description": "Credit", "quantity": 1, "unit_price": 10}},
+			},
+			{
+				"id": 2, "title": "Refund", "currency": "USD", "related_invoice_id": 1, "relation_type": "refund",
+				"items": []map[string]interface{}{{"description": "Refund", "quantity": 1, "unit_price": 20}},
+			},
+		},
+	})
+	s.Equal(http.StatusBadRequest, status)
+	s.Contains(body["error"], "cycle")
+}
+
+// TestExportJob verifies a requested export completes in the background with a bundle
+// whose export.json can be imported
+func (s *BackupTestSuite) TestExportJob() {
+	s.createBackupFixture()
+
+	resp, err := s.setup.MakeRequest("POST", "/api/exports", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusAccepted, resp.StatusCode)
+	job, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("pending", job["status"])
+	jobPath := "/api/exports/" + fmt.Sprint(job["id"])
+
+	s.Require().Eventually(func() bool {
+		resp, err := s.setup.MakeRequest("GET", jobPath, nil)
+		if err != nil {
+			return false
+		}
+		job, err = s.setup.ReadResponseBody(resp)
+		return err == nil && (job["status"] == "completed" || job["status"] == "failed")
+	}, 5*time.Second, 20*time.Millisecond)
+	s.Require().Equal("completed", job["status"], job["error"])
+	s.NotNil(job["completed_at"])
+
+	// The mock storage puts the key in the download URL
+	downloadURL, err := url.Parse(job["download_url"].(string))
+	s.Require().NoError(err)
+	bundle, contentType, err := s.setup.UploadService.DownloadFile(context.Background(), strings.TrimPrefix(downloadURL.Path, "/"), 10<<20)
+	s.Require().NoError(err)
+	s.Equal("application/zip", contentType)
+
+	archive, err := zip.NewReader(bytes.NewReader(bundle), int64(len(bundle)))
+	s.Require().NoError(err)
+	files := make(map[string][]byte)
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		s.Require().NoError(err)
+		files[file.Name], err = io.ReadAll(reader)
+		s.Require().NoError(err)
+		reader.Close()
+	}
+
+	rows, err := csv.NewReader(bytes.NewReader(files["invoices.csv"])).ReadAll()
+	s.Require().NoError(err)
+	s.Require().Len(rows, 2)
+	s.Equal("Electricity", rows[1][2])
+	s.Equal("Utilities", rows[1][6])
+	s.Equal("John Doe", rows[1][8])
+
+	var doc map[string]interface{}
+	s.Require().NoError(json.Unmarshal(files["export.json"], &doc))
+	status, result := s.importAs("other-user", doc)
+	s.Require().Equal(http.StatusOK, status)
+	s.Equal(float64(1), result["invoices"].(map[string]interface{})["created"])
+
+	// Jobs are only visible to the user who requested them
+	resp, err = s.setup.MakeAuthenticatedRequest("GET", jobPath, nil, "other-user")
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func TestBackupSuite(t *testing.T) {
+	suite.Run(t, new(BackupTestSuite))
+}
@@ -0,0 +1,162 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+// driftingFXService counts the rate requests per currency pair and moves the rate on every
+// request, like a live provider between calls. JPY has no rate.
+type driftingFXService struct {
+	*services.MockFXService
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func (f *driftingFXService) GetExchangeRate(ctx context.Context, fromCurrency, toCurrency string) (*services.ExchangeRate, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	key := fromCurrency + ":" + toCurrency
+	f.calls[key]++
+	if fromCurrency == "JPY" {
+		return nil, services.ErrFXRateUnavailable
+	}
+	rate, err := f.MockFXService.GetExchangeRate(ctx, fromCurrency, toCurrency)
+	if err != nil {
+		return nil, err
+	}
+	rate.Rate += 0.01 * float64(f.calls[key])
+	return rate, nil
+}
+
+type BatchCreateTestSuite struct {
+	suite.Suite
+	setup     *TestSetup
+	fxService *driftingFXService
+}
+
+func (s *BatchCreateTestSuite) SetupTest() {
+	s.fxService = &driftingFXService{MockFXService: services.NewMockFXService(), calls: make(map[string]int)}
+	s.fxService.SetRate("EUR", "USD", 1.1)
+	s.fxService.SetRate("GBP", "USD", 1.25)
+	s.setup = NewTestSetupWithFXService(s.T(), s.fxService)
+}
+
+func (s *BatchCreateTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// invoiceWithItem builds an invoice in currency with a single item of the given price
+func invoiceWithItem(title, currency string, unitPrice float64) *models.Invoice {
+	return &models.Invoice{
+		Title:    title,
+		Currency: currency,
+		Status:   models.InvoiceStatusUnpaid,
+		Items:    []models.InvoiceItem{{Description: title, Quantity: 1, UnitPrice: unitPrice}},
+	}
+}
+
+func (s *BatchCreateTestSuite) TestRatesResolvedOncePerCurrency() {
+	invoices := []*models.Invoice{
+		invoiceWithItem("EUR 1", "EUR", 100),
+		invoiceWithItem("EUR 2", "EUR", 200),
+		invoiceWithItem("GBP", "GBP", 150),
+		invoiceWithItem("USD", "USD", 50),
+		invoiceWithItem("EUR 3", "EUR", 300),
+	}
+	// An item in its own currency shares the batch's rate for it
+	invoices[3].Items = append(invoices[3].Items, models.InvoiceItem{Description: "Fee", Quantity: 1, UnitPrice: 10, Currency: "EUR"})
+
+	result, err := s.setup.InvoiceService.BatchCreateInvoices(s.setup.TestUserID, invoices)
+	s.Require().NoError(err)
+	s.Equal(5, result.Created)
+	s.Zero(result.Failed)
+	s.Equal(map[string]int{"EUR:USD": 1, "GBP:USD": 1}, s.fxService.calls)
+	s.InDelta(1.11, result.Rates["EUR:USD"], 1e-9)
+	s.InDelta(1.26, result.Rates["GBP:USD"], 1e-9)
+
+	for i, entry := range result.Invoices {
+		s.Equal(i, entry.Index)
+		s.Equal(services.BatchInvoiceCreated, entry.Status)
+		s.Require().NotNil(entry.Invoice)
+		s.NotEmpty(entry.Invoice.InvoiceNumber)
+	}
+	for _, i := range []int{0, 1, 4} {
+		s.InDelta(1.11, result.Invoices[i].Invoice.Items[0].FXRateUsed, 1e-9)
+	}
+	s.InDelta(1.11, result.Invoices[3].Invoice.Items[1].FXRateUsed, 1e-9)
+	s.InDelta(111.0, result.Invoices[0].Invoice.Items[0].TargetAmount, 0.001)
+	s.InDelta(11.1, result.Invoices[3].Invoice.Items[1].TargetAmount, 0.001)
+
+	stored, err := s.setup.InvoiceService.GetInvoiceByID(s.setup.TestUserID, result.Invoices[4].Invoice.ID)
+	s.Require().NoError(err)
+	s.InDelta(333.0, stored.Items[0].TargetAmount, 0.001)
+}
+
+func (s *BatchCreateTestSuite) TestPerInvoiceOutcomes() {
+	existing, err := s.setup.InvoiceService.CreateInvoice(s.setup.TestUserID, invoiceWithItem("Existing", "USD", 70))
+	s.Require().NoError(err)
+
+	invalid := invoiceWithItem("Bad discount", "USD", 10)
+	invalid.DiscountType = models.DiscountTypePercent
+	invalid.DiscountValue = 150
+	result, err := s.setup.InvoiceService.BatchCreateInvoices(s.setup.TestUserID, []*models.Invoice{
+		invoiceWithItem("First", "USD", 40),
+		invoiceWithItem("Existing again", "USD", 70),
+		invoiceWithItem("First again", "USD", 40),
+		invoiceWithItem("Yen 1", "JPY", 1000),
+		invalid,
+		invoiceWithItem("Yen 2", "JPY", 2000),
+		nil,
+		invoiceWithItem("Last", "USD", 80),
+	})
+	s.Require().NoError(err)
+	s.Equal(2, result.Created)
+	s.Equal(2, result.Duplicates)
+	s.Equal(4, result.Failed)
+
+	statuses := make([]string, len(result.Invoices))
+	for i, entry := range result.Invoices {
+		statuses[i] = entry.Status
+	}
+	s.Equal([]string{
+		services.BatchInvoiceCreated,
+		services.BatchInvoiceDuplicate,
+		services.BatchInvoiceDuplicate,
+		services.BatchInvoiceError,
+		services.BatchInvoiceError,
+		services.BatchInvoiceError,
+		services.BatchInvoiceError,
+		services.BatchInvoiceCreated,
+	}, statuses)
+	s.Equal(existing.Invoice.ID, result.Invoices[1].Invoice.ID)
+	s.Equal(result.Invoices[0].Invoice.ID, result.Invoices[2].Invoice.ID)
+	s.Contains(result.Invoices[3].Error, "exchange rate unavailable")
+	s.Equal(1, s.fxService.calls["JPY:USD"])
+
+	// Failed invoices leave nothing behind, and the rest are committed
+	invoices, total, err := s.setup.InvoiceService.ListInvoices(s.setup.TestUserID, services.InvoiceListOptions{})
+	s.Require().NoError(err)
+	s.Equal(int64(3), total)
+	var titles []string
+	for _, invoice := range invoices {
+		titles = append(titles, invoice.Title)
+	}
+	s.ElementsMatch([]string{"Existing", "First", "Last"}, titles)
+}
+
+func (s *BatchCreateTestSuite) TestBatchTooLarge() {
+	invoices := make([]*models.Invoice, services.MaxBatchCreateInvoices+1)
+	_, err := s.setup.InvoiceService.BatchCreateInvoices(s.setup.TestUserID, invoices)
+	s.Error(err)
+	s.Empty(s.fxService.calls)
+}
+
+func TestBatchCreateSuite(t *testing.T) {
+	suite.Run(t, new(BatchCreateTestSuite))
+}
@@ -0,0 +1,178 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type BudgetTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *BudgetTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *BudgetTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+func (s *BudgetTestSuite) createBudget(categoryID uint, amount float64, periodType string) map[string]interface{} {
+	resp, err := s.setup.MakeRequest("POST", "/api/budgets", map[string]interface{}{
+		"category_id": categoryID,
+		"amount":      amount,
+		"period_type": periodType,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+
+	budget, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	return budget
+}
+
+func (s *BudgetTestSuite) getBudgetStatus(asOf string) []interface{} {
+	resp, err := s.setup.MakeRequest("GET", "/api/budgets/status?as_of="+asOf, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	return result["data"].([]interface{})
+}
+
+func (s *BudgetTestSuite) TestCreateAndListBudgets() {
+	categoryID, err := s.setup.CreateTestCategory("Travel")
+	s.Require().NoError(err)
+
+	budget := s.createBudget(categoryID, 500, "monthly")
+	s.Equal(float64(categoryID), budget["category_id"])
+	s.Equal("monthly", budget["period_type"])
+	s.Equal("USD", budget["currency"])
+
+	resp, err := s.setup.MakeRequest("GET", "/api/budgets", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Len(result["data"], 1)
+}
+
+func (s *BudgetTestSuite) TestCreateBudgetUnknownCategory() {
+	resp, err := s.setup.MakeRequest("POST", "/api/budgets", map[string]interface{}{
+		"category_id": 99999,
+		"amount":      100,
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *BudgetTestSuite) TestBudgetStatus() {
+	travelID, err := s.setup.CreateTestCategory("Travel")
+	s.Require().NoError(err)
+	foodID, err := s.setup.CreateTestCategory("Food")
+	s.Require().NoError(err)
+
+	s.createBudget(travelID, 100, "monthly")
+	s.createBudget(foodID, 200, "monthly")
+
+	march := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+	_, err = s.setup.CreateTestInvoiceOnDate("Flight", &travelID, nil, "paid", 90, march)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceOnDate("Hotel", &travelID, nil, "unpaid", 60, march.AddDate(0, 0, 10))
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceOnDate("Groceries", &foodID, nil, "paid", 50, march)
+	s.Require().NoError(err)
+	// Previous month spending does not count towards March
+	_, err = s.setup.CreateTestInvoiceOnDate("Old flight", &travelID, nil, "paid", 500, march.AddDate(0, -1, 0))
+	s.Require().NoError(err)
+
+	statuses := s.getBudgetStatus("2024-03-31T12:00:00Z")
+	s.Require().Len(statuses, 2)
+
+	byCategory := map[string]map[string]interface{}{}
+	for _, status := range statuses {
+		statusMap := status.(map[string]interface{})
+		byCategory[statusMap["category_name"].(string)] = statusMap
+	}
+
+	travel := byCategory["Travel"]
+	s.Equal(float64(150), travel["spent"])
+	s.Equal(float64(-50), travel["remaining"])
+	s.Equal(true, travel["over_budget"])
+
+	food := byCategory["Food"]
+	s.Equal(float64(50), food["spent"])
+	s.Equal(float64(150), food["remaining"])
+	s.Equal(false, food["over_budget"])
+}
+
+// TestBudgetStatusSkipsDeletedCategory verifies budgets without a matching category are skipped
+func (s *BudgetTestSuite) TestBudgetStatusSkipsDeletedCategory() {
+	travelID, err := s.setup.CreateTestCategory("Travel")
+	s.Require().NoError(err)
+	foodID, err := s.setup.CreateTestCategory("Food")
+	s.Require().NoError(err)
+
+	s.createBudget(travelID, 100, "monthly")
+	s.createBudget(foodID, 200, "yearly")
+
+	resp, err := s.setup.MakeRequest("DELETE", "/api/categories/"+uintToString(travelID), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusNoContent, resp.StatusCode)
+
+	statuses := s.getBudgetStatus("2024-03-31T12:00:00Z")
+	s.Require().Len(statuses, 1)
+	s.Equal("Food", statuses[0].(map[string]interface{})["category_name"])
+}
+
+func (s *BudgetTestSuite) TestDeleteBudget() {
+	categoryID, err := s.setup.CreateTestCategory("Travel")
+	s.Require().NoError(err)
+
+	budget := s.createBudget(categoryID, 100, "quarterly")
+	budgetID := uint(budget["id"].(float64))
+
+	resp, err := s.setup.MakeRequest("DELETE", "/api/budgets/"+uintToString(budgetID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNoContent, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("DELETE", "/api/budgets/"+uintToString(budgetID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+// TestUnconvertibleSpendingFails verifies spending that can't be converted to the budget's
+// currency fails the status instead of being compared at 1:1
+func (s *BudgetTestSuite) TestUnconvertibleSpendingFails() {
+	s.setup.Cleanup()
+	s.setup = NewTestSetupWithFXService(s.T(), &unavailableFXService{MockFXService: services.NewMockFXService()})
+
+	categoryID, err := s.setup.CreateTestCategory("Travel")
+	s.Require().NoError(err)
+	resp, err := s.setup.MakeRequest("POST", "/api/budgets", map[string]interface{}{
+		"category_id": categoryID,
+		"amount":      50000,
+		"currency":    "JPY",
+		"period_type": "monthly",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", "/api/budgets/status", nil)
+	s.Require().NoError(err)
+	s.NotEqual(http.StatusOK, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Contains(result["error"], "exchange rate unavailable")
+}
+
+func TestBudgetSuite(t *testing.T) {
+	suite.Run(t, new(BudgetTestSuite))
+}
@@ -5,6 +5,8 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -70,6 +72,35 @@ func (s *CategoryTestSuite) TestListCategories() {
 	s.GreaterOrEqual(len(data), 2)
 }
 
+func (s *CategoryTestSuite) TestListCategoriesPagination() {
+	for i := 1; i <= 3; i++ {
+		_, err := s.setup.CreateTestCategory(fmt.Sprintf("Category %d", i))
+		s.Require().NoError(err)
+	}
+
+	pagination := func(query string) map[string]interface{} {
+		resp, err := s.setup.MakeRequest("GET", "/api/categories"+query, nil)
+		s.Require().NoError(err)
+		s.Require().Equal(http.StatusOK, resp.StatusCode)
+		result, err := s.setup.ReadResponseBody(resp)
+		s.Require().NoError(err)
+		return result["pagination"].(map[string]interface{})
+	}
+
+	page := pagination("?limit=2&offset=0")
+	s.Equal(float64(3), page["total"])
+	s.Equal(float64(2), page["total_pages"])
+	s.Equal(true, page["has_more"])
+
+	page = pagination("?limit=2&offset=2")
+	s.Equal(false, page["has_more"])
+
+	// A limit of 0 returns everything on one page
+	page = pagination("?limit=0")
+	s.Equal(float64(1), page["total_pages"])
+	s.Equal(false, page["has_more"])
+}
+
 func (s *CategoryTestSuite) TestListCategoriesWithKeyword() {
 	_, err := s.setup.CreateTestCategory("Office Supplies")
 	s.Require().NoError(err)
@@ -141,6 +172,301 @@ func (s *CategoryTestSuite) TestDeleteCategory() {
 	s.Equal(http.StatusNotFound, resp.StatusCode)
 }
 
+func (s *CategoryTestSuite) TestDeleteCategoryInUse() {
+	categoryID, err := s.setup.CreateTestCategory("Travel")
+	s.Require().NoError(err)
+	invoiceID, err := s.setup.CreateTestInvoice("Flight", &categoryID, nil)
+	s.Require().NoError(err)
+
+	// An item in the category counts even when its invoice is not
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices", map[string]interface{}{
+		"title": "Conference",
+		"items": []map[string]interface{}{{"description": "Hotel", "unit_price": 300, "category_id": categoryID}},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	splitInvoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	splitID := uint(splitInvoice["id"].(float64))
+
+	resp, err = s.setup.MakeRequest("DELETE", "/api/categories/"+uintToString(categoryID), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusConflict, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(2), result["invoice_count"])
+
+	resp, err = s.setup.MakeRequest("GET", "/api/categories/"+uintToString(categoryID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("DELETE", "/api/categories/"+uintToString(categoryID)+"?force=true", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusNoContent, resp.StatusCode)
+
+	invoice, err := s.setup.InvoiceService.GetInvoiceByID(s.setup.TestUserID, invoiceID)
+	s.Require().NoError(err)
+	s.Nil(invoice.CategoryID)
+	split, err := s.setup.InvoiceService.GetInvoiceByID(s.setup.TestUserID, splitID)
+	s.Require().NoError(err)
+	s.Nil(split.Items[0].CategoryID)
+
+	// Clearing the references bumps the versions and is audited
+	s.Equal(2, invoice.Version)
+	s.Equal(2, split.Version)
+	trail, _, err := s.setup.InvoiceService.GetAuditTrail(s.setup.TestUserID, invoiceID, services.AuditTrailOptions{})
+	s.Require().NoError(err)
+	s.Equal(models.AuditActionUpdate, trail[0].Action)
+	s.Contains(trail[0].Diff, "category_id")
+	trail, _, err = s.setup.InvoiceService.GetAuditTrail(s.setup.TestUserID, splitID, services.AuditTrailOptions{})
+	s.Require().NoError(err)
+	s.Equal(models.AuditEntityInvoiceItem, trail[0].EntityType)
+	s.Contains(trail[0].Diff, "category_id")
+}
+
+func (s *CategoryTestSuite) TestDeleteCategoryWithBudgetsAndTemplates() {
+	categoryID, err := s.setup.CreateTestCategory("Travel")
+	s.Require().NoError(err)
+	resp, err := s.setup.MakeRequest("POST", "/api/budgets", map[string]interface{}{
+		"category_id": categoryID,
+		"amount":      500,
+		"period_type": "monthly",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+
+	db := s.setup.DBService.GetDB()
+	template := &models.InvoiceTemplate{
+		Name:       "Trip",
+		CategoryID: &categoryID,
+		Items:      []models.InvoiceTemplateItem{{Description: "Hotel", UnitPrice: 100, CategoryID: &categoryID}},
+	}
+	s.Require().NoError(services.NewTemplateService(db).CreateTemplate(s.setup.TestUserID, template))
+
+	// Budgets don't block the deletion, they are deleted with the category
+	resp, err = s.setup.MakeRequest("DELETE", "/api/categories/"+uintToString(categoryID), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusNoContent, resp.StatusCode)
+
+	var budgets int64
+	s.Require().NoError(db.Model(&models.Budget{}).Where("category_id = ?", categoryID).Count(&budgets).Error)
+	s.Zero(budgets)
+
+	stored, err := services.NewTemplateService(db).GetTemplateByID(s.setup.TestUserID, template.ID)
+	s.Require().NoError(err)
+	s.Nil(stored.CategoryID)
+	s.Require().Len(stored.Items, 1)
+	s.Nil(stored.Items[0].CategoryID)
+}
+
+func (s *CategoryTestSuite) TestMergeCategories() {
+	targetID, err := s.setup.CreateTestCategory("Target")
+	s.Require().NoError(err)
+	sourceAID, err := s.setup.CreateTestCategory("Source A")
+	s.Require().NoError(err)
+	sourceBID, err := s.setup.CreateTestCategory("Source B")
+	s.Require().NoError(err)
+
+	_, err = s.setup.CreateTestInvoiceWithStatus("Invoice A", &sourceAID, nil, "unpaid", 100)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceWithStatus("Invoice B", &sourceBID, nil, "unpaid", 200)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceWithStatus("Invoice C", &targetID, nil, "unpaid", 300)
+	s.Require().NoError(err)
+	budget := &models.Budget{CategoryID: sourceAID, Amount: 500}
+	s.Require().NoError(services.NewBudgetService(s.setup.DBService.GetDB(), nil, nil).CreateBudget(s.setup.TestUserID, budget))
+
+	merge, err := s.setup.CategoryService.MergeCategories(s.setup.TestUserID, targetID, []uint{sourceAID, sourceBID}, false)
+	s.Require().NoError(err)
+	s.Equal(targetID, merge.Target.ID)
+	s.Equal(int64(2), merge.InvoicesAffected)
+	s.Equal(int64(1), merge.BudgetsMoved)
+	s.False(merge.DryRun)
+
+	// The source's budget now applies to the target
+	var moved models.Budget
+	s.Require().NoError(s.setup.DBService.GetDB().First(&moved, budget.ID).Error)
+	s.Equal(targetID, moved.CategoryID)
+
+	// Sources are deleted
+	resp, err := s.setup.MakeRequest("GET", "/api/categories/"+uintToString(sourceAID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+
+	// All invoices now belong to the target
+	resp, err = s.setup.MakeRequest("GET", "/api/invoices?category_id="+uintToString(targetID), nil)
+	s.Require().NoError(err)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(3), result["total"])
+}
+
+func (s *CategoryTestSuite) TestMergeCategoriesDryRun() {
+	targetID, err := s.setup.CreateTestCategory("Target")
+	s.Require().NoError(err)
+	sourceID, err := s.setup.CreateTestCategory("Source")
+	s.Require().NoError(err)
+
+	_, err = s.setup.CreateTestInvoiceWithStatus("Invoice A", &sourceID, nil, "unpaid", 100)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceWithStatus("Invoice B", &sourceID, nil, "unpaid", 200)
+	s.Require().NoError(err)
+	budget := &models.Budget{CategoryID: sourceID, Amount: 500}
+	s.Require().NoError(services.NewBudgetService(s.setup.DBService.GetDB(), nil, nil).CreateBudget(s.setup.TestUserID, budget))
+
+	merge, err := s.setup.CategoryService.MergeCategories(s.setup.TestUserID, targetID, []uint{sourceID}, true)
+	s.Require().NoError(err)
+	s.True(merge.DryRun)
+	s.Equal(targetID, merge.Target.ID)
+	s.Equal(int64(2), merge.InvoicesAffected)
+	s.Equal(int64(1), merge.BudgetsMoved)
+	s.Require().Len(merge.Sources, 1)
+	s.Equal("Source", merge.Sources[0].Name)
+
+	// Nothing was moved or deleted
+	resp, err := s.setup.MakeRequest("GET", "/api/categories/"+uintToString(sourceID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", "/api/invoices?category_id="+uintToString(sourceID), nil)
+	s.Require().NoError(err)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(2), result["total"])
+
+	var kept models.Budget
+	s.Require().NoError(s.setup.DBService.GetDB().First(&kept, budget.ID).Error)
+	s.Equal(sourceID, kept.CategoryID)
+}
+
+func (s *CategoryTestSuite) TestMergeCategoriesRejectsTargetInSources() {
+	targetID, err := s.setup.CreateTestCategory("Target")
+	s.Require().NoError(err)
+	sourceID, err := s.setup.CreateTestCategory("Source")
+	s.Require().NoError(err)
+
+	_, err = s.setup.CategoryService.MergeCategories(s.setup.TestUserID, targetID, []uint{sourceID, targetID}, false)
+	s.Error(err)
+
+	// Nothing was deleted
+	resp, err := s.setup.MakeRequest("GET", "/api/categories/"+uintToString(sourceID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+}
+
+func (s *CategoryTestSuite) TestDefaultCurrency() {
+	resp, err := s.setup.MakeRequest("POST", "/api/categories", map[string]interface{}{
+		"name":             "EU Suppliers",
+		"default_currency": " eur",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	category, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("EUR", category["default_currency"])
+	categoryID := uint(category["id"].(float64))
+
+	// Distinct prices keep the invoices from being detected as duplicates
+	createInvoice := func(body map[string]interface{}, unitPrice float64) map[string]interface{} {
+		body["items"] = []map[string]interface{}{{"description": "Parts", "unit_price": unitPrice}}
+		resp, err := s.setup.MakeRequest("POST", "/api/invoices", body)
+		s.Require().NoError(err)
+		s.Require().Equal(http.StatusCreated, resp.StatusCode)
+		invoice, err := s.setup.ReadResponseBody(resp)
+		s.Require().NoError(err)
+		return invoice
+	}
+
+	// The category default only fills in a missing currency
+	s.Equal("EUR", createInvoice(map[string]interface{}{"title": "Defaulted", "category_id": categoryID}, 10)["currency"])
+	s.Equal("GBP", createInvoice(map[string]interface{}{"title": "Explicit", "category_id": categoryID, "currency": "GBP"}, 20)["currency"])
+	s.Equal("USD", createInvoice(map[string]interface{}{"title": "No category"}, 30)["currency"])
+
+	// Omitting the default on update keeps it, an empty one clears it
+	path := "/api/categories/" + uintToString(categoryID)
+	resp, err = s.setup.MakeRequest("PUT", path, map[string]interface{}{"name": "EU Vendors"})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	category, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("EUR", category["default_currency"])
+
+	resp, err = s.setup.MakeRequest("PUT", path, map[string]interface{}{"default_currency": "euro"})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("PUT", path, map[string]interface{}{"default_currency": ""})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	category, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.NotContains(category, "default_currency")
+}
+
+// TestDefaultTaxRate verifies the category tax rate only fills in invoices without one: an explicit
+// rate, 0 included, takes precedence
+func (s *CategoryTestSuite) TestDefaultTaxRate() {
+	resp, err := s.setup.MakeRequest("POST", "/api/categories", map[string]interface{}{
+		"name":             "EU Suppliers",
+		"default_currency": "EUR",
+		"default_tax_rate": 20,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	category, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(20.0, category["default_tax_rate"])
+	categoryID := uint(category["id"].(float64))
+
+	// Distinct prices keep the invoices from being detected as duplicates
+	createInvoice := func(body map[string]interface{}, unitPrice float64) map[string]interface{} {
+		body["items"] = []map[string]interface{}{{"description": "Parts", "unit_price": unitPrice}}
+		resp, err := s.setup.MakeRequest("POST", "/api/invoices", body)
+		s.Require().NoError(err)
+		s.Require().Equal(http.StatusCreated, resp.StatusCode)
+		invoice, err := s.setup.ReadResponseBody(resp)
+		s.Require().NoError(err)
+		return invoice
+	}
+
+	defaulted := createInvoice(map[string]interface{}{"title": "Defaulted", "category_id": categoryID}, 10)
+	s.Equal("EUR", defaulted["currency"])
+	s.Equal(20.0, defaulted["tax_rate"])
+	s.Equal(10.0, defaulted["amount"])
+
+	explicit := createInvoice(map[string]interface{}{"title": "Reduced", "category_id": categoryID, "currency": "GBP", "tax_rate": 5}, 20)
+	s.Equal("GBP", explicit["currency"])
+	s.Equal(5.0, explicit["tax_rate"])
+
+	s.Equal(0.0, createInvoice(map[string]interface{}{"title": "Zero-rated", "category_id": categoryID, "tax_rate": 0}, 30)["tax_rate"])
+	s.NotContains(createInvoice(map[string]interface{}{"title": "No category"}, 40), "tax_rate")
+
+	resp, err = s.setup.MakeRequest("POST", "/api/invoices", map[string]interface{}{"title": "Typo", "tax_rate": 200})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	// Omitting the default on update keeps it, 0 clears it, and out-of-range rates are rejected
+	path := "/api/categories/" + uintToString(categoryID)
+	resp, err = s.setup.MakeRequest("PUT", path, map[string]interface{}{"name": "EU Vendors"})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	category, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(20.0, category["default_tax_rate"])
+
+	resp, err = s.setup.MakeRequest("PUT", path, map[string]interface{}{"default_tax_rate": -1})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("PUT", path, map[string]interface{}{"default_tax_rate": 0})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	category, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.NotContains(category, "default_tax_rate")
+	s.NotContains(createInvoice(map[string]interface{}{"title": "Cleared", "category_id": categoryID}, 50), "tax_rate")
+}
+
 func TestCategorySuite(t *testing.T) {
 	suite.Run(t, new(CategoryTestSuite))
 }
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type CleanupTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *CleanupTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *CleanupTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// createBrokenData leaves one of each kind of orphan for the user
+func (s *CleanupTestSuite) createBrokenData(userID string) (keptID, uncategorizedID uint) {
+	invoiceService := s.setup.InvoiceService
+	db := s.setup.DBService.GetDB()
+
+	create := func(title string, amount float64, categoryID *uint) uint {
+		result, err := invoiceService.CreateInvoice(userID, &models.Invoice{
+			Title:      title,
+			Currency:   "USD",
+			CategoryID: categoryID,
+			Items:      []models.InvoiceItem{{Description: title, Quantity: 1, UnitPrice: amount}},
+		})
+		s.Require().NoError(err)
+		return result.Invoice.ID
+	}
+
+	// Deleting an invoice keeps its tag mappings
+	taggedID := create("Tagged", 10, nil)
+	s.Require().NoError(invoiceService.SetInvoiceTags(userID, taggedID, []string{"travel"}))
+	s.Require().NoError(invoiceService.DeleteInvoice(userID, taggedID))
+
+	// An invoice deleted without its items
+	s.Require().NoError(db.Delete(&models.Invoice{}, create("Half deleted", 20, nil)).Error)
+
+	// Deleting a category keeps the invoices referencing it
+	category := &models.InvoiceCategory{UserID: userID, Name: "Old category"}
+	s.Require().NoError(db.Create(category).Error)
+	uncategorizedID = create("Categorized", 30, &category.ID)
+	s.Require().NoError(db.Delete(category).Error)
+
+	keptID = create("Healthy", 40, nil)
+	s.Require().NoError(invoiceService.SetInvoiceTags(userID, keptID, []string{"travel"}))
+	return keptID, uncategorizedID
+}
+
+func (s *CleanupTestSuite) TestDryRunChangesNothing() {
+	s.createBrokenData(s.setup.TestUserID)
+
+	report, err := s.setup.InvoiceService.CleanupOrphans(s.setup.TestUserID, true)
+	s.Require().NoError(err)
+	s.Equal(services.CleanupReport{
+		DryRun:            true,
+		OrphanTagMappings: 1,
+		OrphanItems:       1,
+		MissingCategories: 1,
+	}, *report)
+
+	again, err := s.setup.InvoiceService.CleanupOrphans(s.setup.TestUserID, true)
+	s.Require().NoError(err)
+	s.Equal(report, again)
+}
+
+func (s *CleanupTestSuite) TestCleanup() {
+	keptID, uncategorizedID := s.createBrokenData(s.setup.TestUserID)
+	s.createBrokenData("other-user")
+
+	report, err := s.setup.InvoiceService.CleanupOrphans(s.setup.TestUserID, false)
+	s.Require().NoError(err)
+	s.False(report.DryRun)
+	s.Equal(int64(1), report.OrphanTagMappings)
+	s.Equal(int64(1), report.OrphanItems)
+	s.Equal(int64(1), report.MissingCategories)
+
+	after, err := s.setup.InvoiceService.CleanupOrphans(s.setup.TestUserID, true)
+	s.Require().NoError(err)
+	s.Equal(services.CleanupReport{DryRun: true}, *after)
+
+	uncategorized, err := s.setup.InvoiceService.GetInvoiceByID(s.setup.TestUserID, uncategorizedID)
+	s.Require().NoError(err)
+	s.Nil(uncategorized.CategoryID)
+	s.Equal(2, uncategorized.Version)
+
+	// Every row deleted or cleared is audited on its invoice
+	trail, _, err := s.setup.InvoiceService.GetAuditTrail(s.setup.TestUserID, uncategorizedID, services.AuditTrailOptions{})
+	s.Require().NoError(err)
+	s.Equal(models.AuditActionUpdate, trail[0].Action)
+	s.Contains(trail[0].Diff, "category_id")
+	db := s.setup.DBService.GetDB()
+	var count int64
+	s.Require().NoError(db.Model(&models.AuditLog{}).
+		Where("user_id = ? AND entity_type = ? AND action = ?", s.setup.TestUserID, models.AuditEntityInvoiceItem, models.AuditActionDelete).
+		Count(&count).Error)
+	s.Equal(int64(1), count)
+	s.Require().NoError(db.Model(&models.AuditLog{}).
+		Where("user_id = ? AND diff LIKE ?", s.setup.TestUserID, "%tag_id%").
+		Count(&count).Error)
+	s.Equal(int64(1), count)
+
+	kept, err := s.setup.InvoiceService.GetInvoiceByID(s.setup.TestUserID, keptID)
+	s.Require().NoError(err)
+	s.Len(kept.Tags, 1)
+	s.Len(kept.Items, 1)
+
+	// Another user's orphans are left alone
+	other, err := s.setup.InvoiceService.CleanupOrphans("other-user", true)
+	s.Require().NoError(err)
+	s.Equal(int64(1), other.OrphanTagMappings)
+	s.Equal(int64(1), other.OrphanItems)
+	s.Equal(int64(1), other.MissingCategories)
+}
+
+func TestCleanupSuite(t *testing.T) {
+	suite.Run(t, new(CleanupTestSuite))
+}
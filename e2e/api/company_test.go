@@ -149,6 +149,77 @@ func (s *CompanyTestSuite) TestDeleteCompany() {
 	s.Equal(http.StatusNotFound, resp.StatusCode)
 }
 
+func (s *CompanyTestSuite) TestDeleteCompanyInUse() {
+	companyID, err := s.setup.CreateTestCompany("Acme")
+	s.Require().NoError(err)
+	invoiceID, err := s.setup.CreateTestInvoice("Consulting", nil, &companyID)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("DELETE", "/api/companies/"+uintToString(companyID), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusConflict, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(1), result["invoice_count"])
+
+	resp, err = s.setup.MakeRequest("DELETE", "/api/companies/"+uintToString(companyID)+"?force=true", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusNoContent, resp.StatusCode)
+
+	invoice, err := s.setup.InvoiceService.GetInvoiceByID(s.setup.TestUserID, invoiceID)
+	s.Require().NoError(err)
+	s.Nil(invoice.CompanyID)
+}
+
+func (s *CompanyTestSuite) TestMergeCompanies() {
+	targetID, err := s.setup.CreateTestCompany("Target")
+	s.Require().NoError(err)
+	sourceAID, err := s.setup.CreateTestCompany("Source A")
+	s.Require().NoError(err)
+	sourceBID, err := s.setup.CreateTestCompany("Source B")
+	s.Require().NoError(err)
+
+	_, err = s.setup.CreateTestInvoiceWithStatus("Invoice A", nil, &sourceAID, "unpaid", 100)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceWithStatus("Invoice B", nil, &sourceBID, "unpaid", 200)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceWithStatus("Invoice C", nil, &targetID, "unpaid", 300)
+	s.Require().NoError(err)
+
+	merge, err := s.setup.CompanyService.MergeCompanies(s.setup.TestUserID, targetID, []uint{sourceAID, sourceBID}, false)
+	s.Require().NoError(err)
+	s.Equal(targetID, merge.Target.ID)
+	s.Equal(int64(2), merge.InvoicesAffected)
+	s.False(merge.DryRun)
+
+	// Sources are deleted
+	resp, err := s.setup.MakeRequest("GET", "/api/companies/"+uintToString(sourceAID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+
+	// All invoices now belong to the target
+	resp, err = s.setup.MakeRequest("GET", "/api/invoices?company_id="+uintToString(targetID), nil)
+	s.Require().NoError(err)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(3), result["total"])
+}
+
+func (s *CompanyTestSuite) TestMergeCompaniesRejectsTargetInSources() {
+	targetID, err := s.setup.CreateTestCompany("Target")
+	s.Require().NoError(err)
+	sourceID, err := s.setup.CreateTestCompany("Source")
+	s.Require().NoError(err)
+
+	_, err = s.setup.CompanyService.MergeCompanies(s.setup.TestUserID, targetID, []uint{sourceID, targetID}, false)
+	s.Error(err)
+
+	// Nothing was deleted
+	resp, err := s.setup.MakeRequest("GET", "/api/companies/"+uintToString(sourceID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+}
+
 func TestCompanySuite(t *testing.T) {
 	suite.Run(t, new(CompanyTestSuite))
 }
@@ -0,0 +1,101 @@
+package api
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/api/middleware"
+	"github.com/stretchr/testify/suite"
+)
+
+// CompressionTestSuite tests response compression and its COMPRESSION_* configuration
+type CompressionTestSuite struct {
+	suite.Suite
+}
+
+// get sends an authenticated GET request with the given Accept-Encoding
+func (s *CompressionTestSuite) get(setup *TestSetup, path, acceptEncoding string) *http.Response {
+	req := httptest.NewRequest("GET", path, nil)
+	req.Header.Set("X-Test-User-ID", setup.TestUserID)
+	if acceptEncoding != "" {
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+	}
+	resp, err := setup.App.Test(req, -1)
+	s.Require().NoError(err)
+	return resp
+}
+
+// createInvoices creates enough invoices for the invoice list to exceed the compression threshold
+func (s *CompressionTestSuite) createInvoices(setup *TestSetup) {
+	for i := 0; i < 20; i++ {
+		resp, err := setup.MakeRequest("POST", "/api/invoices", map[string]interface{}{
+			"title": fmt.Sprintf("Invoice %d", i),
+			"items": []map[string]interface{}{{"description": "Item", "unit_price": i + 1}},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	}
+}
+
+func (s *CompressionTestSuite) TestGzipLargeResponse() {
+	setup := NewTestSetup(s.T())
+	defer setup.Cleanup()
+	s.createInvoices(setup)
+
+	resp := s.get(setup, "/api/invoices?limit=100", "gzip")
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	s.Equal("gzip", resp.Header.Get("Content-Encoding"))
+
+	reader, err := gzip.NewReader(resp.Body)
+	s.Require().NoError(err)
+	var result map[string]interface{}
+	s.Require().NoError(json.NewDecoder(reader).Decode(&result))
+	s.Len(result["data"], 20)
+
+	// Clients that don't ask for compression get plain JSON
+	resp = s.get(setup, "/api/invoices?limit=100", "")
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	s.Empty(resp.Header.Get("Content-Encoding"))
+
+	// Small responses are left alone
+	resp = s.get(setup, "/api/categories", "gzip")
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	s.Empty(resp.Header.Get("Content-Encoding"))
+}
+
+func (s *CompressionTestSuite) TestConfiguration() {
+	s.T().Setenv(middleware.CompressionLevelEnvVar, "disabled")
+	setup := NewTestSetup(s.T())
+	defer setup.Cleanup()
+	s.createInvoices(setup)
+
+	resp := s.get(setup, "/api/invoices?limit=100", "gzip")
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	s.Empty(resp.Header.Get("Content-Encoding"))
+
+	s.T().Setenv(middleware.CompressionLevelEnvVar, "BEST_SPEED")
+	s.T().Setenv(middleware.CompressionMinSizeEnvVar, "0")
+	config, err := middleware.CompressionConfigFromEnv()
+	s.Require().NoError(err)
+	s.Equal(middleware.CompressionBestSpeed, config.Level)
+	s.Equal(0, config.MinSize)
+
+	for _, tt := range []struct{ level, minSize string }{
+		{"fastest", ""},
+		{"", "-1"},
+		{"", "1kb"},
+	} {
+		s.T().Setenv(middleware.CompressionLevelEnvVar, tt.level)
+		s.T().Setenv(middleware.CompressionMinSizeEnvVar, tt.minSize)
+		_, err := middleware.CompressionConfigFromEnv()
+		s.Error(err, "level %q min size %q", tt.level, tt.minSize)
+	}
+}
+
+func TestCompressionSuite(t *testing.T) {
+	suite.Run(t, new(CompressionTestSuite))
+}
@@ -0,0 +1,81 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/api/middleware"
+	"github.com/stretchr/testify/suite"
+)
+
+// CORSTestSuite tests the CORS_* environment configuration
+type CORSTestSuite struct {
+	suite.Suite
+}
+
+// preflight sends a CORS preflight request for GET /api/categories from origin
+func (s *CORSTestSuite) preflight(setup *TestSetup, origin string) *http.Response {
+	req := httptest.NewRequest("OPTIONS", "/api/categories", nil)
+	req.Header.Set("Origin", origin)
+	req.Header.Set("Access-Control-Request-Method", "GET")
+	resp, err := setup.App.Test(req, -1)
+	s.Require().NoError(err)
+	return resp
+}
+
+func (s *CORSTestSuite) TestDefaultAllowsAnyOrigin() {
+	setup := NewTestSetup(s.T())
+	defer setup.Cleanup()
+
+	resp := s.preflight(setup, "https://anywhere.example.com")
+	s.Equal("*", resp.Header.Get("Access-Control-Allow-Origin"))
+	s.Empty(resp.Header.Get("Access-Control-Allow-Credentials"))
+}
+
+func (s *CORSTestSuite) TestAllowlist() {
+	s.T().Setenv(middleware.CORSAllowOriginsEnvVar, "https://app.example.com, https://*.example.org")
+	s.T().Setenv(middleware.CORSAllowCredentialsEnvVar, "true")
+	s.T().Setenv(middleware.CORSAllowMethodsEnvVar, "get,post")
+	s.T().Setenv(middleware.CORSAllowHeadersEnvVar, "Authorization,Content-Type")
+	setup := NewTestSetup(s.T())
+	defer setup.Cleanup()
+
+	resp := s.preflight(setup, "https://app.example.com")
+	s.Equal("https://app.example.com", resp.Header.Get("Access-Control-Allow-Origin"))
+	s.Equal("true", resp.Header.Get("Access-Control-Allow-Credentials"))
+	s.Equal("GET,POST", resp.Header.Get("Access-Control-Allow-Methods"))
+	s.Equal("Authorization,Content-Type", resp.Header.Get("Access-Control-Allow-Headers"))
+
+	resp = s.preflight(setup, "https://admin.example.org")
+	s.Equal("https://admin.example.org", resp.Header.Get("Access-Control-Allow-Origin"))
+
+	// A disallowed origin gets no CORS headers, so browsers block the response
+	resp = s.preflight(setup, "https://evil.example.net")
+	s.Empty(resp.Header.Get("Access-Control-Allow-Origin"))
+	s.Empty(resp.Header.Get("Access-Control-Allow-Credentials"))
+}
+
+func (s *CORSTestSuite) TestInvalidConfig() {
+	tests := []struct {
+		origins     string
+		credentials string
+	}{
+		{"*", "true"},
+		{"", "true"},
+		{"https://app.example.com", "yes"},
+		{"app.example.com", ""},
+		{"https://app.example.com/path", ""},
+		{"*,https://app.example.com", ""},
+	}
+	for _, tt := range tests {
+		s.T().Setenv(middleware.CORSAllowOriginsEnvVar, tt.origins)
+		s.T().Setenv(middleware.CORSAllowCredentialsEnvVar, tt.credentials)
+		_, err := middleware.CORSConfigFromEnv()
+		s.Error(err, "origins %q credentials %q", tt.origins, tt.credentials)
+	}
+}
+
+func TestCORSSuite(t *testing.T) {
+	suite.Run(t, new(CORSTestSuite))
+}
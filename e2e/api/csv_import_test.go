@@ -0,0 +1,193 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type CSVImportTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *CSVImportTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *CSVImportTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// importCSV uploads content to the CSV import endpoint with the given query string
+func (s *CSVImportTestSuite) importCSV(query, content string) (int, map[string]interface{}) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", "invoices.csv")
+	s.Require().NoError(err)
+	_, err = part.Write([]byte(content))
+	s.Require().NoError(err)
+	s.Require().NoError(writer.Close())
+
+	req := httptest.NewRequest("POST", "/api/invoices/import"+query, body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("X-Test-User-ID", s.setup.TestUserID)
+	resp, err := s.setup.App.Test(req, -1)
+	s.Require().NoError(err)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	return resp.StatusCode, result
+}
+
+// rows returns the per-row results of an import response
+func rows(result map[string]interface{}) []map[string]interface{} {
+	var rows []map[string]interface{}
+	for _, row := range result["rows"].([]interface{}) {
+		rows = append(rows, row.(map[string]interface{}))
+	}
+	return rows
+}
+
+func (s *CSVImportTestSuite) TestImport() {
+	categoryID, err := s.setup.CreateTestCategory("Utilities")
+	s.Require().NoError(err)
+
+	content := "id,invoice_number,title,status,currency,amount,category,company,receiver,due_date,created_at\n" +
+		"1,INV-1,Electricity,paid,USD,120.5,Utilities,,,2026-01-31T00:00:00Z,2026-01-05T10:00:00Z\n" +
+		"2,INV-2,Laptop,unpaid,usd,1500,Hardware,Acme,,2026-02-15,\n" +
+		"3,INV-3,Broken,weird,USD,10,,,,,\n" +
+		"4,INV-4,\"Rent, March\",,USD,not-a-number,,,,,\n"
+
+	// Without create_missing, unknown names fail their row
+	status, result := s.importCSV("?format=csv", content)
+	s.Require().Equal(http.StatusOK, status)
+	s.Equal(1.0, result["created"])
+	s.Equal(3.0, result["failed"])
+	results := rows(result)
+	s.Require().Len(results, 4)
+	s.Equal(2.0, results[0]["line"])
+	s.Equal("created", results[0]["status"])
+	s.Equal("error", results[1]["status"])
+	s.Contains(results[1]["error"], `category "Hardware" not found`)
+	s.Contains(results[2]["error"], "invalid status")
+	s.Equal(5.0, results[3]["line"])
+	s.Contains(results[3]["error"], "invalid amount")
+
+	invoice, err := s.setup.InvoiceService.GetInvoiceByID(s.setup.TestUserID, uint(results[0]["invoice_id"].(float64)))
+	s.Require().NoError(err)
+	s.Equal("Electricity", invoice.Title)
+	s.Equal(120.5, invoice.Amount)
+	s.Equal(categoryID, *invoice.CategoryID)
+	s.Equal("2026-01-05", invoice.CreatedAt.UTC().Format("2006-01-02"))
+	s.Equal("2026-01-31", invoice.DueDate.UTC().Format("2006-01-02"))
+
+	// The failed row left no category or company behind
+	var count int64
+	s.Require().NoError(s.setup.DBService.GetDB().Table("invoice_categories").Where("name = ?", "Hardware").Count(&count).Error)
+	s.Zero(count)
+
+	// Importing again skips the rows that were created and creates missing names on request
+	status, result = s.importCSV("?create_missing=true", content)
+	s.Require().Equal(http.StatusOK, status)
+	s.Equal(1.0, result["created"])
+	s.Equal(1.0, result["skipped"])
+	s.Equal(2.0, result["failed"])
+	results = rows(result)
+	s.Equal("skipped", results[0]["status"])
+	s.Equal(float64(invoice.ID), results[0]["invoice_id"])
+	s.Equal("created", results[1]["status"])
+
+	laptop, err := s.setup.InvoiceService.GetInvoiceByID(s.setup.TestUserID, uint(results[1]["invoice_id"].(float64)))
+	s.Require().NoError(err)
+	s.Equal("USD", laptop.Currency)
+	s.Require().NotNil(laptop.Category)
+	s.Equal("Hardware", laptop.Category.Name)
+	s.Require().NotNil(laptop.Company)
+	s.Equal("Acme", laptop.Company.Name)
+}
+
+// TestImportPaidAtAndAliases verifies paid rows keep when they were paid and receivers are found
+// by their aliases
+func (s *CSVImportTestSuite) TestImportPaidAtAndAliases() {
+	resp, err := s.setup.MakeRequest("POST", "/api/receivers", map[string]interface{}{"name": "Acme Corporation"})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	receiver, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	resp, err = s.setup.MakeRequest("PUT", fmt.Sprintf("/api/receivers/%d", int(receiver["id"].(float64))), map[string]interface{}{
+		"other_names": []string{"ACME Corp"},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	content := "title,status,amount,receiver,created_at,paid_at\n" +
+		"Hosting,paid,20,acme corp,2026-01-05,2026-01-20T08:00:00Z\n" +
+		"Domain,paid,12,,2026-02-03,\n" +
+		"Support,paid,50,,,\n" +
+		"Backup,unpaid,5,,2026-02-03,2026-02-04\n"
+	status, result := s.importCSV("", content)
+	s.Require().Equal(http.StatusOK, status)
+	s.Equal(4.0, result["created"])
+	results := rows(result)
+
+	invoice := func(row int) *models.Invoice {
+		invoice, err := s.setup.InvoiceService.GetInvoiceByID(s.setup.TestUserID, uint(results[row]["invoice_id"].(float64)))
+		s.Require().NoError(err)
+		return invoice
+	}
+
+	hosting := invoice(0)
+	s.Require().NotNil(hosting.ReceiverID)
+	s.Equal(uint(receiver["id"].(float64)), *hosting.ReceiverID)
+	s.Require().NotNil(hosting.PaidAt)
+	s.Equal("2026-01-20T08:00:00Z", hosting.PaidAt.UTC().Format(time.RFC3339))
+
+	// Without paid_at, the invoice date; without either, the import
+	domain := invoice(1)
+	s.Require().NotNil(domain.PaidAt)
+	s.Equal("2026-02-03", domain.PaidAt.UTC().Format("2006-01-02"))
+	support := invoice(2)
+	s.Require().NotNil(support.PaidAt)
+	s.WithinDuration(time.Now(), *support.PaidAt, time.Minute)
+	s.Nil(invoice(3).PaidAt)
+
+	// No receiver was created for the alias
+	var count int64
+	s.Require().NoError(s.setup.DBService.GetDB().Model(&models.InvoiceReceiver{}).Count(&count).Error)
+	s.Equal(int64(1), count)
+}
+
+func (s *CSVImportTestSuite) TestRejectedFiles() {
+	for _, tc := range []struct {
+		query, content, message string
+	}{
+		{"", "title,amount,color\nRent,10,red\n", `unknown CSV column "color"`},
+		{"", "title,status\nRent,paid\n", `CSV column "amount" is required`},
+		{"", "", "CSV file is empty"},
+		{"?format=xlsx", "title,amount\nRent,10\n", "Unsupported format"},
+		{"", "title,amount\n" + strings.Repeat("Rent,10\n", services.MaxCSVImportRows+1), fmt.Sprintf("exceeds %d rows", services.MaxCSVImportRows)},
+		{"", strings.Repeat("x", services.MaxCSVImportBytes+1), fmt.Sprintf("exceeds %d bytes", services.MaxCSVImportBytes)},
+	} {
+		status, result := s.importCSV(tc.query, tc.content)
+		s.Equal(http.StatusBadRequest, status, tc.message)
+		s.Contains(result["error"], tc.message)
+	}
+
+	// Nothing was imported from the oversized files
+	invoices, _, err := s.setup.InvoiceService.ListInvoices(s.setup.TestUserID, services.InvoiceListOptions{Limit: 10})
+	s.Require().NoError(err)
+	s.Empty(invoices)
+}
+
+func TestCSVImportSuite(t *testing.T) {
+	suite.Run(t, new(CSVImportTestSuite))
+}
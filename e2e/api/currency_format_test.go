@@ -0,0 +1,95 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type CurrencyFormatTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *CurrencyFormatTestSuite) SetupTest() {
+	// HKD -> USD: 1 HKD = 0.125 USD (i.e., 1 USD = 8 HKD)
+	fxService := services.NewMockFXService()
+	fxService.SetRate("HKD", "USD", 0.125)
+
+	s.setup = NewTestSetupWithFXService(s.T(), fxService)
+}
+
+func (s *CurrencyFormatTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+func (s *CurrencyFormatTestSuite) TestFormatCurrency() {
+	tests := []struct {
+		amount   float64
+		currency string
+		locale   string
+		want     string
+	}{
+		{1234567.891, "EUR", "en-US", "€1,234,567.89"},
+		{1234567.891, "EUR", "de-DE", "1.234.567,89 €"},
+		{1234567.891, "EUR", "ja-JP", "€1,234,567.89"},
+		{1234567.891, "JPY", "en-US", "¥1,234,568"},
+		{1234567.891, "JPY", "de-DE", "1.234.568 ¥"},
+		{1234567.891, "JPY", "ja-JP", "￥1,234,568"},
+		{1234.5678, "BHD", "en-US", "BHD 1,234.568"},
+		{1234.5678, "bhd", "de-DE", "1.234,568 BHD"},
+		{-250.5, "USD", "en-US", "-$250.50"},
+		{-250.5, "USD", "de-DE", "-250,50 $"},
+		{-0.001, "USD", "en-US", "$0.00"},
+		{99, "XYZ", "en-US", "XYZ 99.00"},
+		{99, "USD", "", "$99.00"},
+	}
+	for _, tt := range tests {
+		s.Equal(tt.want, utils.FormatCurrency(tt.amount, tt.currency, tt.locale), "%v %s %s", tt.amount, tt.currency, tt.locale)
+	}
+}
+
+func (s *CurrencyFormatTestSuite) TestInvoiceFormattedAmounts() {
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices", map[string]interface{}{
+		"title":    "Hong Kong office",
+		"currency": "HKD",
+		"items": []map[string]interface{}{
+			{"description": "Rent", "quantity": 1, "unit_price": 12345.6},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.NotContains(invoice, "amount_formatted")
+
+	path := "/api/invoices/" + uintToString(uint(invoice["id"].(float64)))
+	resp, err = s.setup.MakeRequest("GET", path+"?locale=de-DE", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	invoice, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("12.345,60 HK$", invoice["amount_formatted"])
+	s.Equal("1.543,20 $", invoice["target_amount_formatted"])
+
+	resp, err = s.setup.MakeRequest("GET", "/api/invoices?locale=en-US", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	list, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	data := list["data"].([]interface{})
+	s.Require().Len(data, 1)
+	s.Equal("HK$12,345.60", data[0].(map[string]interface{})["amount_formatted"])
+	s.Equal("$1,543.20", data[0].(map[string]interface{})["target_amount_formatted"])
+
+	resp, err = s.setup.MakeRequest("GET", path+"?locale=english", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestCurrencyFormatSuite(t *testing.T) {
+	suite.Run(t, new(CurrencyFormatTestSuite))
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DashboardTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *DashboardTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *DashboardTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// dashboard fetches the dashboard for a query string
+func (s *DashboardTestSuite) dashboard(query string) map[string]interface{} {
+	resp, err := s.setup.MakeRequest("GET", "/api/dashboard"+query, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	return result
+}
+
+func (s *DashboardTestSuite) TestReturnsAllSections() {
+	categoryID, err := s.setup.CreateTestCategory("Utilities")
+	s.Require().NoError(err)
+	companyID, err := s.setup.CreateTestCompany("Power Co")
+	s.Require().NoError(err)
+
+	_, err = s.setup.CreateTestInvoiceWithStatus("Paid bill", &categoryID, &companyID, "paid", 100)
+	s.Require().NoError(err)
+	overdueID, err := s.setup.CreateTestInvoiceWithStatus("Late bill", &categoryID, nil, "unpaid", 40)
+	s.Require().NoError(err)
+	s.Require().NoError(s.setup.DBService.GetDB().Exec("UPDATE invoices SET due_date = ? WHERE id = ?", DaysAgo(3), overdueID).Error)
+
+	result := s.dashboard("?period=1y")
+	s.Equal("1y", result["period"])
+	s.NotContains(result, "errors")
+
+	summary := result["summary"].(map[string]interface{})
+	s.Equal(float64(2), summary["invoice_count"])
+	s.Equal("1y", summary["period"])
+
+	byCategory := result["by_category"].(map[string]interface{})
+	s.Len(byCategory["items"], 1)
+	byCompany := result["by_company"].(map[string]interface{})
+	s.Len(byCompany["items"], 1)
+
+	overdue := result["overdue"].([]interface{})
+	s.Require().Len(overdue, 1)
+	s.Equal("Late bill", overdue[0].(map[string]interface{})["title"])
+
+	recent := result["recent"].([]interface{})
+	s.Require().Len(recent, 2)
+	s.Equal("Late bill", recent[0].(map[string]interface{})["title"])
+}
+
+func (s *DashboardTestSuite) TestDefaultsToOneMonth() {
+	result := s.dashboard("")
+	s.Equal("1m", result["period"])
+	s.Empty(result["recent"])
+}
+
+// TestPartialFailure verifies sections that load are still returned when others fail
+func (s *DashboardTestSuite) TestPartialFailure() {
+	_, err := s.setup.CreateTestInvoiceWithStatus("Paid bill", nil, nil, "paid", 100)
+	s.Require().NoError(err)
+
+	// Breakdowns by company and invoice lists preloading companies fail without the table
+	s.Require().NoError(s.setup.DBService.GetDB().Exec("DROP TABLE invoice_companies").Error)
+
+	result := s.dashboard("")
+	errors := result["errors"].(map[string]interface{})
+	s.Contains(errors, "by_company")
+	s.NotContains(errors, "summary")
+	s.NotContains(result, "by_company")
+
+	summary := result["summary"].(map[string]interface{})
+	s.Equal(float64(1), summary["invoice_count"])
+}
+
+func TestDashboardSuite(t *testing.T) {
+	suite.Run(t, new(DashboardTestSuite))
+}
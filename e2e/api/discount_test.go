@@ -0,0 +1,289 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+// unavailableFXService has no rate to or from JPY, like a provider that is down for that pair
+type unavailableFXService struct {
+	*services.MockFXService
+}
+
+func (f *unavailableFXService) GetExchangeRate(ctx context.Context, fromCurrency, toCurrency string) (*services.ExchangeRate, error) {
+	if fromCurrency == "JPY" || toCurrency == "JPY" {
+		return nil, services.ErrFXRateUnavailable
+	}
+	return f.MockFXService.GetExchangeRate(ctx, fromCurrency, toCurrency)
+}
+
+func (f *unavailableFXService) ConvertAmount(ctx context.Context, amount float64, fromCurrency, toCurrency string) (float64, float64, error) {
+	rate, err := f.GetExchangeRate(ctx, fromCurrency, toCurrency)
+	if err != nil {
+		return 0, 0, err
+	}
+	return amount * rate.Rate, rate.Rate, nil
+}
+
+type DiscountTestSuite struct {
+	suite.Suite
+	setup     *TestSetup
+	fxService *services.MockFXService
+}
+
+func (s *DiscountTestSuite) SetupTest() {
+	// HKD -> USD: 1 HKD = 0.125 USD (i.e., 1 USD = 8 HKD)
+	s.fxService = services.NewMockFXService()
+	s.fxService.SetRate("HKD", "USD", 0.125)
+
+	s.setup = NewTestSetupWithFXService(s.T(), s.fxService)
+}
+
+func (s *DiscountTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// createInvoice creates an invoice through the API and returns the response body
+func (s *DiscountTestSuite) createInvoice(body map[string]interface{}) map[string]interface{} {
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices", body)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	return invoice
+}
+
+// getInvoice fetches an invoice with its items
+func (s *DiscountTestSuite) getInvoice(id float64) map[string]interface{} {
+	resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/invoices/%d", int(id)), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	return invoice
+}
+
+// itemField returns a field of every item of an invoice, in order
+func itemField(invoice map[string]interface{}, field string) []interface{} {
+	var values []interface{}
+	for _, item := range invoice["items"].([]interface{}) {
+		values = append(values, item.(map[string]interface{})[field])
+	}
+	return values
+}
+
+func (s *DiscountTestSuite) TestItemDiscountPercentVsFixed() {
+	invoice := s.createInvoice(map[string]interface{}{
+		"title":    "Office Supplies",
+		"currency": "USD",
+		"items": []map[string]interface{}{
+			{"description": "Paper", "quantity": 2, "unit_price": 50, "discount_type": "percent", "discount_value": 10},
+			{"description": "Toner", "quantity": 1, "unit_price": 100, "discount_type": "fixed", "discount_value": 15},
+			{"description": "Sample", "quantity": 1, "unit_price": 5, "discount_type": "fixed", "discount_value": 20},
+			{"description": "Pens", "quantity": 1, "unit_price": 10},
+		},
+	})
+
+	s.Equal([]interface{}{90.0, 85.0, 0.0, 10.0}, itemField(invoice, "amount"))
+	s.Equal([]interface{}{90.0, 85.0, 0.0, 10.0}, itemField(invoice, "target_amount"))
+	s.Equal([]interface{}{"percent", "fixed", "fixed", nil}, itemField(invoice, "discount_type"))
+	// Unit prices keep the original price
+	s.Equal([]interface{}{50.0, 100.0, 5.0, 10.0}, itemField(invoice, "unit_price"))
+	s.Equal(185.0, invoice["amount"])
+	s.Equal(185.0, invoice["target_amount"])
+}
+
+// TestStackedItemAndInvoiceDiscount verifies the invoice discount applies after the item discounts,
+// converts through FX, and is what analytics report
+func (s *DiscountTestSuite) TestStackedItemAndInvoiceDiscount() {
+	invoice := s.createInvoice(map[string]interface{}{
+		"title":          "Hong Kong Hotel",
+		"currency":       "HKD",
+		"discount_type":  "fixed",
+		"discount_value": 200,
+		"items": []map[string]interface{}{
+			{"description": "Room", "quantity": 1, "unit_price": 800, "discount_type": "percent", "discount_value": 25},
+			{"description": "Breakfast", "quantity": 1, "unit_price": 400},
+		},
+	})
+
+	// Items: 600 + 400 = 1000 HKD, less 200 HKD = 800 HKD
+	s.Equal([]interface{}{600.0, 400.0}, itemField(invoice, "amount"))
+	s.Equal(800.0, invoice["amount"])
+	s.Equal("fixed", invoice["discount_type"])
+
+	// 125 USD before the invoice discount, less 25 USD, spread over the items
+	s.Equal([]interface{}{60.0, 40.0}, itemField(invoice, "target_amount"))
+	s.Equal(100.0, invoice["target_amount"])
+
+	resp, err := s.setup.MakeRequest("GET", "/api/analytics/summary?period=1m", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	summary, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(100.0, summary["total_amount"])
+}
+
+// TestInvoiceDiscountFollowsChanges verifies the invoice discount is reapplied as items and the discount change
+func (s *DiscountTestSuite) TestInvoiceDiscountFollowsChanges() {
+	invoice := s.createInvoice(map[string]interface{}{
+		"title":          "Subscription",
+		"currency":       "USD",
+		"discount_type":  "percent",
+		"discount_value": 10,
+		"items": []map[string]interface{}{
+			{"description": "Seat", "quantity": 1, "unit_price": 100},
+		},
+	})
+	id := invoice["id"].(float64)
+	s.Equal(90.0, invoice["amount"])
+
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/invoices/%d/items", int(id)), map[string]interface{}{
+		"description": "Seat",
+		"quantity":    1,
+		"unit_price":  100,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	item, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(90.0, item["target_amount"])
+
+	invoice = s.getInvoice(id)
+	s.Equal(180.0, invoice["amount"])
+	s.Equal(180.0, invoice["target_amount"])
+
+	resp, err = s.setup.MakeRequest("PUT", fmt.Sprintf("/api/invoices/%d", int(id)), map[string]interface{}{
+		"discount_type":  "fixed",
+		"discount_value": 50,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	invoice = s.getInvoice(id)
+	s.Equal(150.0, invoice["amount"])
+	s.Equal([]interface{}{75.0, 75.0}, itemField(invoice, "target_amount"))
+
+	resp, err = s.setup.MakeRequest("PUT", fmt.Sprintf("/api/invoices/%d", int(id)), map[string]interface{}{
+		"discount_value": 0,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	invoice = s.getInvoice(id)
+	s.Equal(200.0, invoice["amount"])
+	s.Equal(200.0, invoice["target_amount"])
+}
+
+// TestFixedDiscountInNonUSDBaseCurrency verifies a fixed invoice discount stays in the owner's
+// base currency when items change, not the USD default
+func (s *DiscountTestSuite) TestFixedDiscountInNonUSDBaseCurrency() {
+	resp, err := s.setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{"base_currency": "HKD"})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	invoice := s.createInvoice(map[string]interface{}{
+		"title":          "Office Rent",
+		"currency":       "HKD",
+		"discount_type":  "fixed",
+		"discount_value": 100,
+		"items": []map[string]interface{}{
+			{"description": "Desk", "quantity": 1, "unit_price": 1000},
+		},
+	})
+	id := invoice["id"].(float64)
+	s.Equal([]interface{}{900.0}, itemField(invoice, "target_amount"))
+
+	resp, err = s.setup.MakeRequest("POST", fmt.Sprintf("/api/invoices/%d/items", int(id)), map[string]interface{}{
+		"description": "Desk",
+		"quantity":    1,
+		"unit_price":  1000,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+
+	invoice = s.getInvoice(id)
+	s.Equal(1900.0, invoice["amount"])
+	s.Equal([]interface{}{950.0, 950.0}, itemField(invoice, "target_amount"))
+	s.Equal(1900.0, invoice["target_amount"])
+}
+
+// TestManualTargetAmountKeepsOverride verifies a target amount set by hand is final: the invoice
+// discount is spread over the other items only
+func (s *DiscountTestSuite) TestManualTargetAmountKeepsOverride() {
+	invoice := s.createInvoice(map[string]interface{}{
+		"title":          "Team Offsite",
+		"currency":       "HKD",
+		"discount_type":  "percent",
+		"discount_value": 10,
+		"items": []map[string]interface{}{
+			{"description": "Venue", "quantity": 1, "unit_price": 800},
+			{"description": "Catering", "quantity": 1, "unit_price": 400},
+		},
+	})
+	id := invoice["id"].(float64)
+	s.Equal([]interface{}{90.0, 45.0}, itemField(invoice, "target_amount"))
+	catering := invoice["items"].([]interface{})[1].(map[string]interface{})["id"].(float64)
+
+	resp, err := s.setup.MakeRequest("PUT", fmt.Sprintf("/api/invoices/%d/items/%d", int(id), int(catering)), map[string]interface{}{
+		"target_amount": 52,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	s.Equal([]interface{}{90.0, 52.0}, itemField(s.getInvoice(id), "target_amount"))
+
+	resp, err = s.setup.MakeRequest("PUT", fmt.Sprintf("/api/invoices/%d", int(id)), map[string]interface{}{
+		"discount_value": 20,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	s.Equal([]interface{}{80.0, 52.0}, itemField(s.getInvoice(id), "target_amount"))
+}
+
+func (s *DiscountTestSuite) TestRejectsInvalidDiscounts() {
+	for _, body := range []map[string]interface{}{
+		{"title": "Too much", "discount_type": "percent", "discount_value": 120},
+		{"title": "Negative", "discount_type": "fixed", "discount_value": -5},
+		{"title": "No type", "discount_value": 5},
+		{"title": "Bad item", "items": []map[string]interface{}{
+			{"description": "Seat", "unit_price": 100, "discount_type": "percent", "discount_value": 101},
+		}},
+	} {
+		resp, err := s.setup.MakeRequest("POST", "/api/invoices", body)
+		s.Require().NoError(err)
+		s.Equal(http.StatusBadRequest, resp.StatusCode, body["title"])
+	}
+}
+
+// TestUnconvertibleDiscountFails verifies a fixed discount without a rate to the base currency
+// fails the invoice instead of being applied at 1:1
+func (s *DiscountTestSuite) TestUnconvertibleDiscountFails() {
+	s.setup.Cleanup()
+	s.setup = NewTestSetupWithFXService(s.T(), &unavailableFXService{MockFXService: s.fxService})
+
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices", map[string]interface{}{
+		"title":          "Tokyo Hotel",
+		"currency":       "JPY",
+		"discount_type":  "fixed",
+		"discount_value": 1000,
+		"items": []map[string]interface{}{
+			{"description": "Room", "unit_price": 800, "currency": "HKD"},
+		},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Contains(result["error"], "exchange rate unavailable")
+}
+
+func TestDiscountSuite(t *testing.T) {
+	suite.Run(t, new(DiscountTestSuite))
+}
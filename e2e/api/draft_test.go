@@ -0,0 +1,139 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type DraftInvoiceTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *DraftInvoiceTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *DraftInvoiceTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// createInvoice creates a paid invoice with a single item of the given price and returns it
+func (s *DraftInvoiceTestSuite) createInvoice(title string, unitPrice float64, isDraft bool) map[string]interface{} {
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices", map[string]interface{}{
+		"title":    title,
+		"status":   "paid",
+		"is_draft": isDraft,
+		"items":    []map[string]interface{}{{"description": "Item", "unit_price": unitPrice}},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	return invoice
+}
+
+// statistics returns last month's invoice count and total
+func (s *DraftInvoiceTestSuite) statistics() (int64, float64) {
+	stats, err := s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, services.StatisticsOptions{
+		Period:  services.PeriodLastMonth,
+		GroupBy: services.GroupByCategory,
+	})
+	s.Require().NoError(err)
+	return stats.InvoiceCount, stats.TotalAmount
+}
+
+// listTotal lists invoices through the API and returns the number of matches
+func (s *DraftInvoiceTestSuite) listTotal(query string) float64 {
+	resp, err := s.setup.MakeRequest("GET", "/api/invoices"+query, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	return result["total"].(float64)
+}
+
+func (s *DraftInvoiceTestSuite) TestDraftsInvisibleUntilFinalized() {
+	s.createInvoice("Rent", 1000, false)
+	draft := s.createInvoice("Maybe a new laptop", 2000, true)
+	s.Equal(true, draft["is_draft"])
+	draftID := uint(draft["id"].(float64))
+
+	count, total := s.statistics()
+	s.Equal(int64(1), count)
+	s.Equal(1000.0, total)
+
+	summary, err := s.setup.AnalyticsService.GetSummary(s.setup.TestUserID, services.Period1Month)
+	s.Require().NoError(err)
+	s.Equal(int64(1), summary.InvoiceCount)
+	s.Equal(1000.0, summary.PaidAmount)
+
+	s.Equal(1.0, s.listTotal(""))
+	s.Equal(2.0, s.listTotal("?include_drafts=true"))
+
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices/"+uintToString(draftID)+"/finalize", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	finalized, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(false, finalized["is_draft"])
+
+	count, total = s.statistics()
+	s.Equal(int64(2), count)
+	s.Equal(3000.0, total)
+	s.Equal(2.0, s.listTotal(""))
+
+	// Only drafts can be finalized
+	resp, err = s.setup.MakeRequest("POST", "/api/invoices/"+uintToString(draftID)+"/finalize", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("POST", "/api/invoices/9999/finalize", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (s *DraftInvoiceTestSuite) TestDuplicateDetection() {
+	invoice := s.createInvoice("Internet", 50, false)
+
+	// A draft with the same amount is not a duplicate of the finalized invoice
+	draft := s.createInvoice("Internet", 50, true)
+	s.NotEqual(invoice["id"], draft["id"])
+	s.Equal(true, draft["is_draft"])
+
+	// but another such draft is a duplicate of the first one
+	s.Equal(draft["id"], s.createInvoice("Internet", 50, true)["id"])
+	s.Equal(invoice["id"], s.createInvoice("Internet", 50, false)["id"])
+}
+
+// TestDraftsLeftOutOfTagSearch verifies invoices found by tag leave out drafts until finalized
+func (s *DraftInvoiceTestSuite) TestDraftsLeftOutOfTagSearch() {
+	tagService := services.NewTagService(s.setup.DBService.GetDB())
+	tag := &models.InvoiceTag{Name: "travel"}
+	s.Require().NoError(tagService.CreateTag(s.setup.TestUserID, tag))
+	invoiceID := uint(s.createInvoice("Flight", 300, false)["id"].(float64))
+	draftID := uint(s.createInvoice("Hotel", 200, true)["id"].(float64))
+	s.Require().NoError(tagService.AddTagToInvoice(s.setup.TestUserID, invoiceID, tag.ID))
+	s.Require().NoError(tagService.AddTagToInvoice(s.setup.TestUserID, draftID, tag.ID))
+
+	invoices, total, err := tagService.GetInvoicesByTagID(s.setup.TestUserID, tag.ID, 50, 0)
+	s.Require().NoError(err)
+	s.Equal(int64(1), total)
+	s.Require().Len(invoices, 1)
+	s.Equal(invoiceID, invoices[0].ID)
+
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices/"+uintToString(draftID)+"/finalize", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	_, total, err = tagService.GetInvoicesByTagID(s.setup.TestUserID, tag.ID, 50, 0)
+	s.Require().NoError(err)
+	s.Equal(int64(2), total)
+}
+
+func TestDraftInvoiceSuite(t *testing.T) {
+	suite.Run(t, new(DraftInvoiceTestSuite))
+}
@@ -0,0 +1,133 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/api"
+	"github.com/rxtech-lab/invoice-management/internal/assets"
+	"github.com/stretchr/testify/suite"
+)
+
+// ETagTestSuite tests ETags and conditional GETs on the invoice, dashboard, and OpenAPI spec endpoints
+type ETagTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *ETagTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *ETagTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// get sends an authenticated GET request with the given If-None-Match
+func (s *ETagTestSuite) get(path, ifNoneMatch string) *http.Response {
+	req := httptest.NewRequest("GET", path, nil)
+	req.Header.Set("X-Test-User-ID", s.setup.TestUserID)
+	if ifNoneMatch != "" {
+		req.Header.Set("If-None-Match", ifNoneMatch)
+	}
+	resp, err := s.setup.App.Test(req, -1)
+	s.Require().NoError(err)
+	return resp
+}
+
+// assertNotModified checks that a conditional GET with ifNoneMatch gets an empty 304 tagged etag
+func (s *ETagTestSuite) assertNotModified(path, ifNoneMatch, etag string) {
+	resp := s.get(path, ifNoneMatch)
+	s.Equal(http.StatusNotModified, resp.StatusCode, path)
+	s.Equal(etag, resp.Header.Get("ETag"))
+	body, err := io.ReadAll(resp.Body)
+	s.Require().NoError(err)
+	s.Empty(body)
+}
+
+func (s *ETagTestSuite) TestInvoice() {
+	invoiceID, err := s.setup.CreateTestInvoiceWithStatus("Rent", nil, nil, "unpaid", 1000)
+	s.Require().NoError(err)
+	path := "/api/invoices/" + uintToString(invoiceID)
+
+	resp := s.get(path, "")
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	etag := resp.Header.Get("ETag")
+	s.Regexp(`^W/"[0-9a-f]{16}"$`, etag)
+
+	s.assertNotModified(path, etag, etag)
+	// A strong form of the tag and a list of tags match too
+	s.assertNotModified(path, `"other", `+etag[2:], etag)
+
+	resp = s.get(path, `W/"0000000000000000"`)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	// Changing the invoice changes its tag
+	resp, err = s.setup.MakeRequest("PATCH", path+"/status", map[string]interface{}{"status": "paid"})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	resp = s.get(path, etag)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	s.NotEqual(etag, resp.Header.Get("ETag"))
+
+	// Errors have no tag
+	resp = s.get("/api/invoices/9999", "")
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+	s.Empty(resp.Header.Get("ETag"))
+}
+
+func (s *ETagTestSuite) TestListAndDashboard() {
+	_, err := s.setup.CreateTestInvoiceWithStatus("Rent", nil, nil, "unpaid", 1000)
+	s.Require().NoError(err)
+
+	for _, path := range []string{"/api/invoices?limit=10", "/api/dashboard"} {
+		resp := s.get(path, "")
+		s.Require().Equal(http.StatusOK, resp.StatusCode)
+		etag := resp.Header.Get("ETag")
+		s.Require().NotEmpty(etag, path)
+		s.assertNotModified(path, etag, etag)
+	}
+
+	resp := s.get("/api/invoices?limit=10", "")
+	etag := resp.Header.Get("ETag")
+	_, err = s.setup.CreateTestInvoiceWithStatus("Internet", nil, nil, "unpaid", 50)
+	s.Require().NoError(err)
+	resp = s.get("/api/invoices?limit=10", etag)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	// Other endpoints are not tagged
+	resp = s.get("/api/categories", "")
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	s.Empty(resp.Header.Get("ETag"))
+}
+
+func (s *ETagTestSuite) TestOpenAPISpec() {
+	for _, path := range []string{"/openapi", "/openapi.yaml"} {
+		resp := s.get(path, "")
+		s.Require().Equal(http.StatusOK, resp.StatusCode)
+		etag := resp.Header.Get("ETag")
+		s.Equal(assets.OpenAPISpecETag, etag)
+		s.Regexp(`^"[0-9a-f]{16}"$`, etag)
+		s.Equal(api.OpenAPICacheControl, resp.Header.Get("Cache-Control"))
+		body, err := io.ReadAll(resp.Body)
+		s.Require().NoError(err)
+		s.Equal(assets.OpenAPISpec, body)
+
+		// The tag is stable across requests
+		resp = s.get(path, "")
+		s.Equal(etag, resp.Header.Get("ETag"))
+
+		s.assertNotModified(path, etag, etag)
+		s.assertNotModified(path, "W/"+etag, etag)
+		s.Equal(api.OpenAPICacheControl, s.get(path, etag).Header.Get("Cache-Control"))
+
+		resp = s.get(path, `"0000000000000000"`)
+		s.Equal(http.StatusOK, resp.StatusCode)
+	}
+}
+
+func TestETagSuite(t *testing.T) {
+	suite.Run(t, new(ETagTestSuite))
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type ExcludeKeywordTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *ExcludeKeywordTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+
+	_, err := s.setup.CreateTestInvoiceWithStatus("Travel - Flight to Tokyo", nil, nil, "paid", 900)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceWithStatus("Travel - Hotel", nil, nil, "paid", 300)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceWithStatus("Travel - Train", nil, nil, "unpaid", 40)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceWithStatus("Office rent", nil, nil, "paid", 1500)
+	s.Require().NoError(err)
+
+	// The excluded term also matches the description
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices", map[string]interface{}{
+		"title":       "Travel - Airline",
+		"description": "Return flight to Osaka",
+		"items":       []map[string]interface{}{{"description": "Ticket", "unit_price": 700}},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+}
+
+func (s *ExcludeKeywordTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// listTitles lists invoices through the API and returns their titles
+func (s *ExcludeKeywordTestSuite) listTitles(query string) []string {
+	resp, err := s.setup.MakeRequest("GET", "/api/invoices?"+query, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	var titles []string
+	for _, invoice := range result["data"].([]interface{}) {
+		titles = append(titles, invoice.(map[string]interface{})["title"].(string))
+	}
+	return titles
+}
+
+func (s *ExcludeKeywordTestSuite) TestListInvoices() {
+	s.ElementsMatch([]string{"Travel - Hotel", "Travel - Train"}, s.listTitles("keyword=travel&exclude_keyword=flight"))
+	// Without a keyword every invoice not matching the excluded term is kept
+	s.ElementsMatch([]string{"Travel - Hotel", "Travel - Train", "Office rent"}, s.listTitles("exclude_keyword=FLIGHT"))
+	s.Len(s.listTitles("keyword=travel"), 4)
+}
+
+func (s *ExcludeKeywordTestSuite) TestStatistics() {
+	stats, err := s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, services.StatisticsOptions{
+		Period:         services.PeriodLastMonth,
+		Keyword:        "travel",
+		ExcludeKeyword: "flight",
+		GroupBy:        services.GroupByDay,
+	})
+	s.Require().NoError(err)
+	s.Equal(int64(2), stats.InvoiceCount)
+	s.Equal(340.0, stats.TotalAmount)
+	s.Equal("flight", stats.Filters.ExcludeKeyword)
+
+	var dayTotal float64
+	for _, item := range stats.Breakdown {
+		dayTotal += item.Amount
+	}
+	s.Equal(340.0, dayTotal)
+}
+
+func TestExcludeKeywordSuite(t *testing.T) {
+	suite.Run(t, new(ExcludeKeywordTestSuite))
+}
@@ -0,0 +1,108 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/invoice-management/internal/tools"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type ExpectedAmountTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *ExpectedAmountTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *ExpectedAmountTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// createInvoice creates an invoice with two items adding up to 150 and the given expected amount
+func (s *ExpectedAmountTestSuite) createInvoice(title string, expectedAmount float64) map[string]interface{} {
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices", map[string]interface{}{
+		"title":           title,
+		"currency":        "USD",
+		"expected_amount": expectedAmount,
+		"items": []map[string]interface{}{
+			{"description": "Hosting", "quantity": 2, "unit_price": 50},
+			{"description": "Support", "unit_price": 50},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	return invoice
+}
+
+func (s *ExpectedAmountTestSuite) TestMismatchWarns() {
+	invoice := s.createInvoice("Mismatched", 175)
+	s.Equal(150.0, invoice["amount"])
+	s.Require().Len(invoice["warnings"], 1)
+	warning := invoice["warnings"].([]interface{})[0].(string)
+	s.Contains(warning, "USD 150.00")
+	s.Contains(warning, "USD 175.00")
+	s.Contains(warning, "-25.00")
+
+	// The invoice is created regardless, and warnings aren't stored with it
+	resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/invoices/%d", int(invoice["id"].(float64))), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	stored, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("Mismatched", stored["title"])
+	s.Nil(stored["warnings"])
+}
+
+func (s *ExpectedAmountTestSuite) TestMatchWithinTolerance() {
+	s.Nil(s.createInvoice("Rounded", 150.01)["warnings"])
+
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices", map[string]interface{}{
+		"title": "Unchecked",
+		"items": []map[string]interface{}{{"description": "Hosting", "unit_price": 80}},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Nil(invoice["warnings"])
+}
+
+func (s *ExpectedAmountTestSuite) TestCreateInvoiceToolWarns() {
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: s.setup.TestUserID})
+	handler := tools.NewCreateInvoiceTool(s.setup.InvoiceService).GetHandler()
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"title":           "From tool",
+		"expected_amount": 99.5,
+		"items":           []interface{}{map[string]interface{}{"description": "Hosting", "unit_price": 100.0}},
+	}
+	result, err := handler(ctx, request)
+	s.Require().NoError(err)
+	s.Require().False(result.IsError)
+
+	var created struct {
+		ID       uint     `json:"id"`
+		Amount   float64  `json:"amount"`
+		Warnings []string `json:"warnings"`
+	}
+	s.Require().NoError(json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &created))
+	s.NotZero(created.ID)
+	s.Equal(100.0, created.Amount)
+	s.Require().Len(created.Warnings, 1)
+	s.Contains(created.Warnings[0], "99.50")
+}
+
+func TestExpectedAmountSuite(t *testing.T) {
+	suite.Run(t, new(ExpectedAmountTestSuite))
+}
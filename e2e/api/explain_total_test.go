@@ -0,0 +1,135 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type ExplainTotalTestSuite struct {
+	suite.Suite
+	setup     *TestSetup
+	fxService *services.MockFXService
+}
+
+func (s *ExplainTotalTestSuite) SetupTest() {
+	// HKD -> USD: 1 HKD = 0.125 USD
+	s.fxService = services.NewMockFXService()
+	s.fxService.SetRate("HKD", "USD", 0.125)
+	s.fxService.SetRate("EUR", "USD", 1.1)
+
+	s.setup = NewTestSetupWithFXService(s.T(), s.fxService)
+}
+
+func (s *ExplainTotalTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// createInvoice creates an HKD invoice with an HKD and a EUR item and returns its ID and item IDs
+func (s *ExplainTotalTestSuite) createInvoice() (uint, []uint) {
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices", map[string]interface{}{
+		"title":    "Hong Kong trip",
+		"currency": "HKD",
+		"items": []map[string]interface{}{
+			{"description": "Hotel", "quantity": 2, "unit_price": 400},
+			{"description": "Conference", "unit_price": 100, "currency": "EUR"},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	var itemIDs []uint
+	for _, item := range invoice["items"].([]interface{}) {
+		itemIDs = append(itemIDs, uint(item.(map[string]interface{})["id"].(float64)))
+	}
+	return uint(invoice["id"].(float64)), itemIDs
+}
+
+func (s *ExplainTotalTestSuite) TestExplainTotal() {
+	invoiceID, itemIDs := s.createInvoice()
+
+	explanation, err := s.setup.InvoiceService.ExplainTotal(s.setup.TestUserID, invoiceID)
+	s.Require().NoError(err)
+	s.Equal("HKD", explanation.Currency)
+	s.Equal("USD", explanation.TargetCurrency)
+	s.Require().Len(explanation.Items, 2)
+
+	hotel := explanation.Items[0]
+	s.Equal(itemIDs[0], hotel.ItemID)
+	s.Equal(800.0, hotel.Amount)
+	s.Equal("HKD", hotel.Currency)
+	s.Equal(0.125, hotel.FXRateUsed)
+	s.NotEmpty(hotel.FXRateDate)
+	s.Equal(100.0, hotel.TargetAmount)
+	s.False(hotel.Manual)
+
+	conference := explanation.Items[1]
+	s.Equal("EUR", conference.Currency)
+	s.Equal(1.1, conference.FXRateUsed)
+	s.Equal(110.0, conference.TargetAmount)
+
+	s.Equal(210.0, explanation.TotalTargetAmount)
+	s.Equal(explanation.Amount, explanation.ExpectedAmount)
+	s.False(explanation.HasManualOverride)
+	s.False(explanation.HasStaleRate)
+	s.Empty(explanation.Mismatches)
+}
+
+func (s *ExplainTotalTestSuite) TestManualOverride() {
+	invoiceID, itemIDs := s.createInvoice()
+
+	override := 120.0
+	resp, err := s.setup.UpdateInvoiceItemWithTargetAmount(invoiceID, itemIDs[0], "Hotel", 2, 400, &override)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	explanation, err := s.setup.InvoiceService.ExplainTotal(s.setup.TestUserID, invoiceID)
+	s.Require().NoError(err)
+	s.True(explanation.HasManualOverride)
+	s.True(explanation.Items[0].Manual)
+	s.Empty(explanation.Items[0].FXRateDate)
+	s.Equal(0.15, explanation.Items[0].FXRateUsed)
+	s.Equal(230.0, explanation.TotalTargetAmount)
+	// An override is consistent with its implied rate
+	s.Empty(explanation.Mismatches)
+
+	// Recalculating at the current rate drops the override
+	_, err = s.setup.InvoiceService.RecalculateTotals(s.setup.TestUserID, invoiceID)
+	s.Require().NoError(err)
+	explanation, err = s.setup.InvoiceService.ExplainTotal(s.setup.TestUserID, invoiceID)
+	s.Require().NoError(err)
+	s.False(explanation.HasManualOverride)
+	s.Equal(210.0, explanation.TotalTargetAmount)
+}
+
+func (s *ExplainTotalTestSuite) TestMismatches() {
+	invoiceID, itemIDs := s.createInvoice()
+
+	db := s.setup.DBService.GetDB()
+	s.Require().NoError(db.Exec("UPDATE invoices SET amount = 950 WHERE id = ?", invoiceID).Error)
+	s.Require().NoError(db.Exec("UPDATE invoice_items SET target_amount = 105 WHERE id = ?", itemIDs[1]).Error)
+
+	explanation, err := s.setup.InvoiceService.ExplainTotal(s.setup.TestUserID, invoiceID)
+	s.Require().NoError(err)
+	s.Equal(950.0, explanation.Amount)
+	s.Equal(900.0, explanation.ExpectedAmount)
+	s.Equal(110.0, explanation.Items[1].ExpectedTargetAmount)
+	s.Equal(205.0, explanation.TotalTargetAmount)
+	s.Len(explanation.Mismatches, 2)
+
+	// Explaining changes nothing
+	var amount float64
+	s.Require().NoError(db.Raw("SELECT amount FROM invoices WHERE id = ?", invoiceID).Scan(&amount).Error)
+	s.Equal(950.0, amount)
+
+	_, err = s.setup.InvoiceService.ExplainTotal("other-user", invoiceID)
+	s.Error(err)
+}
+
+func TestExplainTotalSuite(t *testing.T) {
+	suite.Run(t, new(ExplainTotalTestSuite))
+}
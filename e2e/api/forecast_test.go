@@ -0,0 +1,69 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type ForecastTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *ForecastTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+
+	// One invoice in each of the last three weeks
+	_, err := s.setup.CreateTestInvoiceOnDate("Groceries week 1", nil, nil, "paid", 100.00, DaysAgo(3))
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceOnDate("Groceries week 2", nil, nil, "paid", 200.00, DaysAgo(10))
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceOnDate("Groceries week 3", nil, nil, "paid", 300.00, DaysAgo(17))
+	s.Require().NoError(err)
+}
+
+func (s *ForecastTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+func (s *ForecastTestSuite) TestForecastNextPeriod() {
+	forecast, err := s.setup.AnalyticsService.ForecastNextPeriod(s.setup.TestUserID, services.Period7Days)
+	s.Require().NoError(err)
+
+	s.Equal("USD", forecast.Currency)
+	s.Require().Len(forecast.Windows, services.DefaultForecastWindows)
+	s.InDelta(100.00, forecast.Windows[0].Amount, 0.01)
+	s.InDelta(200.00, forecast.Windows[1].Amount, 0.01)
+	s.InDelta(300.00, forecast.Windows[2].Amount, 0.01)
+	s.InDelta(200.00, forecast.ProjectedTotal, 0.01)
+	s.InDelta(100.00, forecast.Min, 0.01)
+	s.InDelta(300.00, forecast.Max, 0.01)
+	s.True(forecast.EndDate.After(forecast.StartDate))
+}
+
+func (s *ForecastTestSuite) TestForecastWithCustomWindows() {
+	forecast, err := s.setup.AnalyticsService.ForecastNextPeriodWithWindows(s.setup.TestUserID, services.Period7Days, 2)
+	s.Require().NoError(err)
+	s.Len(forecast.Windows, 2)
+	s.InDelta(150.00, forecast.ProjectedTotal, 0.01)
+
+	// Empty windows pull the average down
+	forecast, err = s.setup.AnalyticsService.ForecastNextPeriodWithWindows(s.setup.TestUserID, services.Period7Days, 4)
+	s.Require().NoError(err)
+	s.InDelta(150.00, forecast.ProjectedTotal, 0.01)
+	s.InDelta(0.00, forecast.Min, 0.01)
+}
+
+func (s *ForecastTestSuite) TestForecastInvalidInput() {
+	_, err := s.setup.AnalyticsService.ForecastNextPeriod(s.setup.TestUserID, services.AnalyticsPeriod("2w"))
+	s.Error(err)
+
+	_, err = s.setup.AnalyticsService.ForecastNextPeriodWithWindows(s.setup.TestUserID, services.Period1Month, services.MaxForecastWindows+1)
+	s.Error(err)
+}
+
+func TestForecastSuite(t *testing.T) {
+	suite.Run(t, new(ForecastTestSuite))
+}
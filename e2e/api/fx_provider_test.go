@@ -0,0 +1,136 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+// stubFXProvider is an FXProvider returning a fixed rate, or err when set
+type stubFXProvider struct {
+	name  string
+	rate  float64
+	err   error
+	calls int
+}
+
+func (p *stubFXProvider) Name() string {
+	return p.name
+}
+
+func (p *stubFXProvider) FetchRate(ctx context.Context, from, to string) (*services.ExchangeRate, error) {
+	p.calls++
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &services.ExchangeRate{
+		From:     from,
+		To:       to,
+		Rate:     p.rate,
+		Date:     time.Now().Format("2006-01-02"),
+		CachedAt: time.Now(),
+	}, nil
+}
+
+type FXProviderTestSuite struct {
+	suite.Suite
+	setup     *TestSetup
+	primary   *stubFXProvider
+	secondary *stubFXProvider
+}
+
+func (s *FXProviderTestSuite) SetupTest() {
+	s.primary = &stubFXProvider{name: "primary", rate: 0.125}
+	s.secondary = &stubFXProvider{name: "secondary", rate: 0.125}
+
+	// No cache, so every conversion goes to the providers
+	fxService := services.NewFXService(nil, []services.FXProvider{s.primary, s.secondary}, time.Minute)
+	s.setup = NewTestSetupWithFXService(s.T(), fxService)
+}
+
+func (s *FXProviderTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// createHKDInvoice creates an HKD invoice with one item through the API
+func (s *FXProviderTestSuite) createHKDInvoice(title string, unitPrice float64) *http.Response {
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices", map[string]interface{}{
+		"title":    title,
+		"currency": "HKD",
+		"items": []map[string]interface{}{
+			{"description": "Item", "quantity": 1, "unit_price": unitPrice},
+		},
+	})
+	s.Require().NoError(err)
+	return resp
+}
+
+func (s *FXProviderTestSuite) TestFallsBackToNextProvider() {
+	s.primary.err = errors.New("connection refused")
+
+	resp := s.createHKDInvoice("Hotel", 80)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	s.Equal(10.0, invoice["target_amount"])
+	s.Equal([]interface{}{0.125}, itemField(invoice, "fx_rate_used"))
+	s.Equal([]interface{}{false}, itemField(invoice, "fx_stale"))
+	s.Equal(1, s.primary.calls)
+	s.Equal(1, s.secondary.calls)
+}
+
+// TestUsesLastKnownRateWhenProvidersFail verifies a stale rate is used and flagged
+// once every provider is down
+func (s *FXProviderTestSuite) TestUsesLastKnownRateWhenProvidersFail() {
+	resp := s.createHKDInvoice("Hotel", 80)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	s.Equal(0, s.secondary.calls)
+
+	s.primary.err = errors.New("connection refused")
+	s.secondary.err = errors.New("status 503")
+
+	resp = s.createHKDInvoice("Taxi", 160)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	s.Equal(20.0, invoice["target_amount"])
+	s.Equal([]interface{}{true}, itemField(invoice, "fx_stale"))
+}
+
+// TestFailsWithoutAnyRate verifies invoices are rejected rather than converted 1:1
+func (s *FXProviderTestSuite) TestFailsWithoutAnyRate() {
+	s.primary.err = errors.New("connection refused")
+	s.secondary.err = errors.New("status 503")
+
+	resp := s.createHKDInvoice("Hotel", 80)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+	body, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Contains(body["error"], "exchange rate unavailable")
+
+	invoices, _, err := s.setup.InvoiceService.ListInvoices(s.setup.TestUserID, services.InvoiceListOptions{})
+	s.Require().NoError(err)
+	s.Empty(invoices)
+
+	// Invoices already in the base currency need no rate
+	resp, err = s.setup.MakeRequest("POST", "/api/invoices", map[string]interface{}{
+		"title":    "Lunch",
+		"currency": "USD",
+		"items": []map[string]interface{}{
+			{"description": "Item", "quantity": 1, "unit_price": 12},
+		},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusCreated, resp.StatusCode)
+}
+
+func TestFXProviderSuite(t *testing.T) {
+	suite.Run(t, new(FXProviderTestSuite))
+}
@@ -0,0 +1,202 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/rxtech-lab/invoice-management/internal/tools"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type FXRefreshTestSuite struct {
+	suite.Suite
+	setup     *TestSetup
+	fxService *services.MockFXService
+}
+
+func (s *FXRefreshTestSuite) SetupTest() {
+	s.fxService = services.NewMockFXService()
+	s.fxService.SetRate("EUR", "USD", 1.1)
+	s.fxService.SetRate("GBP", "USD", 1.25)
+
+	s.setup = NewTestSetupWithFXService(s.T(), s.fxService)
+}
+
+func (s *FXRefreshTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// createInvoice creates an invoice in currency with a single item of the given price and
+// returns its ID and item ID
+func (s *FXRefreshTestSuite) createInvoice(currency string, unitPrice float64) (uint, uint) {
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices", map[string]interface{}{
+		"title":    "Invoice",
+		"currency": currency,
+		"items":    []map[string]interface{}{{"description": "Item", "unit_price": unitPrice}},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	item := invoice["items"].([]interface{})[0].(map[string]interface{})
+	return uint(invoice["id"].(float64)), uint(item["id"].(float64))
+}
+
+// targetAmount returns the base-currency total of an invoice's items
+func (s *FXRefreshTestSuite) targetAmount(invoiceID uint) float64 {
+	invoice, err := s.setup.InvoiceService.GetInvoiceByID(s.setup.TestUserID, invoiceID)
+	s.Require().NoError(err)
+	var total float64
+	for _, item := range invoice.Items {
+		total += item.TargetAmount
+	}
+	return total
+}
+
+func (s *FXRefreshTestSuite) TestRefreshFXForCurrency() {
+	plainID, _ := s.createInvoice("EUR", 100)
+	overriddenID, overriddenItemID := s.createInvoice("EUR", 200)
+	poundsID, _ := s.createInvoice("GBP", 80)
+
+	override := 230.0
+	resp, err := s.setup.UpdateInvoiceItemWithTargetAmount(overriddenID, overriddenItemID, "Item", 1, 200, &override)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	s.fxService.SetRate("EUR", "USD", 1.2)
+	s.fxService.SetRate("GBP", "USD", 1.3)
+
+	refreshed, err := s.setup.InvoiceService.RefreshFXForCurrency(s.setup.TestUserID, " eur ", false)
+	s.Require().NoError(err)
+	s.Equal(int64(2), refreshed)
+	s.Equal(120.0, s.targetAmount(plainID))
+	// The manual override is kept
+	s.Equal(230.0, s.targetAmount(overriddenID))
+	// Invoices in other currencies are left alone
+	s.Equal(100.0, s.targetAmount(poundsID))
+
+	refreshed, err = s.setup.InvoiceService.RefreshFXForCurrency(s.setup.TestUserID, "EUR", true)
+	s.Require().NoError(err)
+	s.Equal(int64(2), refreshed)
+	s.Equal(240.0, s.targetAmount(overriddenID))
+
+	invoice, err := s.setup.InvoiceService.GetInvoiceByID(s.setup.TestUserID, overriddenID)
+	s.Require().NoError(err)
+	s.False(invoice.Items[0].FXManual)
+	s.Equal(1.2, invoice.Items[0].FXRateUsed)
+	s.Equal(1.2, invoice.FXRateUsed)
+}
+
+func (s *FXRefreshTestSuite) TestBatches() {
+	for i := 0; i < services.FXRefreshBatchSize+5; i++ {
+		s.createInvoice("EUR", float64(i+1))
+	}
+	s.fxService.SetRate("EUR", "USD", 2)
+
+	refreshed, err := s.setup.InvoiceService.RefreshFXForCurrency(s.setup.TestUserID, "EUR", false)
+	s.Require().NoError(err)
+	s.Equal(int64(services.FXRefreshBatchSize+5), refreshed)
+
+	var stale int64
+	s.Require().NoError(s.setup.DBService.GetDB().Raw("SELECT COUNT(*) FROM invoice_items WHERE fx_rate_used <> 2").Scan(&stale).Error)
+	s.Zero(stale)
+}
+
+func (s *FXRefreshTestSuite) TestErrors() {
+	_, err := s.setup.InvoiceService.RefreshFXForCurrency(s.setup.TestUserID, "EURO", false)
+	s.Error(err)
+
+	// Invoices of other users are not counted
+	s.createInvoice("EUR", 100)
+	refreshed, err := s.setup.InvoiceService.RefreshFXForCurrency("other-user", "EUR", false)
+	s.Require().NoError(err)
+	s.Zero(refreshed)
+}
+
+func (s *FXRefreshTestSuite) TestClearTargetOverrides() {
+	invoiceID, itemID := s.createInvoice("EUR", 100)
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices/"+uintToString(invoiceID)+"/items", map[string]interface{}{
+		"description": "Extra",
+		"unit_price":  50,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	extra, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	extraID := uint(extra["id"].(float64))
+
+	for _, item := range []struct {
+		id                  uint
+		unitPrice, override float64
+	}{{itemID, 100, 130}, {extraID, 50, 70}} {
+		resp, err := s.setup.UpdateInvoiceItemWithTargetAmount(invoiceID, item.id, "Item", 1, item.unitPrice, &item.override)
+		s.Require().NoError(err)
+		s.Require().Equal(http.StatusOK, resp.StatusCode)
+	}
+	s.Equal(200.0, s.targetAmount(invoiceID))
+	s.fxService.SetRate("EUR", "USD", 1.2)
+
+	// A single item goes back to the current rate; the other keeps its override
+	s.Require().NoError(s.setup.InvoiceService.ClearItemTargetOverride(s.setup.TestUserID, itemID))
+	item, err := s.setup.InvoiceService.GetInvoiceItem(s.setup.TestUserID, itemID)
+	s.Require().NoError(err)
+	s.Equal(120.0, item.TargetAmount)
+	s.Equal(1.2, item.FXRateUsed)
+	s.False(item.FXManual)
+	s.Equal(190.0, s.targetAmount(invoiceID))
+
+	s.Require().NoError(s.setup.InvoiceService.ClearTargetOverrides(s.setup.TestUserID, invoiceID))
+	invoice, err := s.setup.InvoiceService.GetInvoiceByID(s.setup.TestUserID, invoiceID)
+	s.Require().NoError(err)
+	for _, item := range invoice.Items {
+		s.False(item.FXManual)
+		s.Equal(1.2, item.FXRateUsed)
+		s.InDelta(item.Amount*1.2, item.TargetAmount, 0.001)
+	}
+	s.Equal(180.0, s.targetAmount(invoiceID))
+	s.Equal(150.0, invoice.Amount)
+
+	err = s.setup.InvoiceService.ClearTargetOverrides("other-user", invoiceID)
+	s.ErrorIs(err, services.ErrInvoiceNotFound)
+	s.Error(s.setup.InvoiceService.ClearItemTargetOverride("other-user", itemID))
+}
+
+func (s *FXRefreshTestSuite) TestResetItemFXTool() {
+	invoiceID, itemID := s.createInvoice("EUR", 100)
+	otherInvoiceID, _ := s.createInvoice("EUR", 200)
+	override := 130.0
+	resp, err := s.setup.UpdateInvoiceItemWithTargetAmount(invoiceID, itemID, "Item", 1, 100, &override)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: s.setup.TestUserID})
+	handler := tools.NewResetItemFXTool(s.setup.InvoiceService).GetHandler()
+	request := mcp.CallToolRequest{}
+
+	// The item must be on the invoice
+	request.Params.Arguments = map[string]interface{}{"invoice_id": float64(otherInvoiceID), "item_id": float64(itemID)}
+	result, err := handler(ctx, request)
+	s.Require().NoError(err)
+	s.True(result.IsError)
+	s.Equal(130.0, s.targetAmount(invoiceID))
+
+	request.Params.Arguments = map[string]interface{}{"invoice_id": float64(invoiceID), "item_id": float64(itemID)}
+	result, err = handler(ctx, request)
+	s.Require().NoError(err)
+	s.Require().False(result.IsError, result.Content[0].(mcp.TextContent).Text)
+	var invoice models.Invoice
+	s.Require().NoError(json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &invoice))
+	s.Require().Len(invoice.Items, 1)
+	s.Equal(110.0, invoice.Items[0].TargetAmount)
+	s.False(invoice.Items[0].FXManual)
+}
+
+func TestFXRefreshSuite(t *testing.T) {
+	suite.Run(t, new(FXRefreshTestSuite))
+}
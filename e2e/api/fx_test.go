@@ -356,6 +356,252 @@ func (s *FXTestSuite) TestFXRateUsedTracking() {
 	s.InDelta(float64(1.1), item["fx_rate_used"].(float64), 0.001)
 }
 
+// TestMixedCurrencyItems tests items carrying their own currency within a USD invoice
+func (s *FXTestSuite) TestMixedCurrencyItems() {
+	invoiceID, err := s.setup.CreateTestInvoiceWithCurrency("Mixed Invoice", "USD")
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceItem(invoiceID, "Subtotal", 1, 100)
+	s.Require().NoError(err)
+
+	// HKD surcharge: 80 HKD = 10 USD
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/invoices/%d/items", invoiceID), map[string]interface{}{
+		"description": "Surcharge",
+		"unit_price":  80,
+		"currency":    "hkd",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	surcharge, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("HKD", surcharge["currency"])
+	s.Equal(80.0, surcharge["amount"])
+	s.Equal(10.0, surcharge["target_amount"])
+	surchargeID := uint(surcharge["id"].(float64))
+
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/invoices/%d", invoiceID), nil)
+	s.Require().NoError(err)
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(180.0, invoice["amount"])
+	s.Equal(true, invoice["amount_currency_mixed"])
+	s.Equal(110.0, invoice["target_amount"])
+
+	// Items without their own currency omit it
+	items := invoice["items"].([]interface{})
+	s.NotContains(items[0].(map[string]interface{}), "currency")
+
+	// Resetting the currency converts the item with the invoice currency again
+	resp, err = s.setup.MakeRequest("PUT", fmt.Sprintf("/api/invoices/%d/items/%d", invoiceID, surchargeID), map[string]interface{}{
+		"currency": "",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	updated, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(80.0, updated["target_amount"])
+
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/invoices/%d", invoiceID), nil)
+	s.Require().NoError(err)
+	invoice, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(false, invoice["amount_currency_mixed"])
+
+	// Invalid currency codes are rejected
+	resp, err = s.setup.MakeRequest("POST", fmt.Sprintf("/api/invoices/%d/items", invoiceID), map[string]interface{}{
+		"description": "Bad",
+		"unit_price":  1,
+		"currency":    "DOLLARS",
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+// TestPreviewCurrencyConversion verifies the preview matches the real conversion without saving it
+func (s *FXTestSuite) TestPreviewCurrencyConversion() {
+	invoiceID, err := s.setup.CreateTestInvoiceWithCurrency("Preview Invoice", "USD")
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceItem(invoiceID, "Service", 2, 40.00)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/invoices/%d/convert/preview", invoiceID), map[string]interface{}{
+		"currency": "hkd",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	preview, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	s.Equal("HKD", preview["from_currency"])
+	s.Equal("USD", preview["to_currency"])
+	s.Equal(0.125, preview["rate"])
+	s.Equal(float64(80), preview["current_total"])
+	s.Equal(float64(10), preview["total"]) // 80 HKD * 0.125
+	items := preview["items"].([]interface{})
+	s.Require().Len(items, 1)
+	item := items[0].(map[string]interface{})
+	s.Equal("HKD", item["currency"])
+	s.Equal(float64(80), item["current_target_amount"])
+	s.Equal(float64(10), item["target_amount"])
+
+	// Nothing was saved
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/invoices/%d", invoiceID), nil)
+	s.Require().NoError(err)
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("USD", invoice["currency"])
+	s.Equal(float64(80), invoice["target_amount"])
+
+	// Applying the change produces the previewed amounts
+	resp, err = s.setup.MakeRequest("PUT", fmt.Sprintf("/api/invoices/%d", invoiceID), map[string]interface{}{
+		"currency": "HKD",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	invoice, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(preview["total"], invoice["target_amount"])
+
+	resp, err = s.setup.MakeRequest("POST", fmt.Sprintf("/api/invoices/%d/convert/preview", invoiceID), map[string]interface{}{
+		"currency": "dollars",
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("POST", "/api/invoices/999999/convert/preview", map[string]interface{}{
+		"currency": "HKD",
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+// TestTargetAmountRoundedToCurrencyPrecision verifies JPY target amounts have no decimals
+func (s *FXTestSuite) TestTargetAmountRoundedToCurrencyPrecision() {
+	s.fxService.SetRate("USD", "JPY", 149.537)
+	resp, err := s.setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{
+		"base_currency": "JPY",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	invoiceID, err := s.setup.CreateTestInvoiceWithCurrency("Tokyo Office", "USD")
+	s.Require().NoError(err)
+	itemID, err := s.setup.CreateTestInvoiceItem(invoiceID, "Desk", 1, 10.00)
+	s.Require().NoError(err)
+
+	item, err := s.setup.InvoiceService.GetInvoiceItem(s.setup.TestUserID, itemID)
+	s.Require().NoError(err)
+	s.Equal("JPY", item.TargetCurrency)
+	s.Equal(float64(1495), item.TargetAmount) // 1495.37 rounded to 0 decimals
+	s.Equal(149.537, item.FXRateUsed)
+}
+
+// TestInvoiceTotalEqualsSumOfItems verifies the invoice total is the sum of the rounded item amounts
+func (s *FXTestSuite) TestInvoiceTotalEqualsSumOfItems() {
+	s.fxService.SetRate("HKD", "USD", 0.128205)
+
+	invoiceID, err := s.setup.CreateTestInvoiceWithCurrency("Many Small Items", "HKD")
+	s.Require().NoError(err)
+	for i := 0; i < 7; i++ {
+		_, err := s.setup.CreateTestInvoiceItem(invoiceID, fmt.Sprintf("Item %d", i+1), 1, 1.00)
+		s.Require().NoError(err)
+	}
+
+	resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/invoices/%d", invoiceID), nil)
+	s.Require().NoError(err)
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	var sum float64
+	for _, raw := range invoice["items"].([]interface{}) {
+		item := raw.(map[string]interface{})
+		s.Equal(0.13, item["target_amount"]) // 0.128205 rounded to 2 decimals
+		sum += item["target_amount"].(float64)
+	}
+	// 7 * 0.13 = 0.91, whereas rounding the unrounded sum (0.897435) would give 0.90
+	s.InDelta(0.91, sum, 1e-9)
+	s.Equal(0.91, invoice["target_amount"])
+}
+
+// TestInvoiceAverageFXRate verifies the invoice rate is the target-amount-weighted average of its items' rates
+func (s *FXTestSuite) TestInvoiceAverageFXRate() {
+	invoiceID, err := s.setup.CreateTestInvoiceWithCurrency("HKD Invoice", "HKD")
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceItem(invoiceID, "Rent", 1, 800) // 100 USD at 0.125
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/invoices/%d", invoiceID), nil)
+	s.Require().NoError(err)
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(0.125, invoice["fx_rate_used"])
+
+	// A later item converted at a different rate
+	s.fxService.SetRate("HKD", "USD", 0.13)
+	_, err = s.setup.CreateTestInvoiceItem(invoiceID, "Utilities", 1, 400) // 52 USD at 0.13
+	s.Require().NoError(err)
+
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/invoices/%d", invoiceID), nil)
+	s.Require().NoError(err)
+	invoice, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(152.0, invoice["target_amount"])
+	// (0.125 * 100 + 0.13 * 52) / 152
+	s.InDelta(0.126711, invoice["fx_rate_used"].(float64), 0.000001)
+}
+
+// TestCloneInvoiceIntoCurrency re-bills a USD invoice in HKD: item amounts are converted,
+// while target amounts stay in USD
+func (s *FXTestSuite) TestCloneInvoiceIntoCurrency() {
+	s.fxService.SetRate("USD", "HKD", 7.8)
+
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices", map[string]interface{}{
+		"title":          "Consulting",
+		"currency":       "USD",
+		"discount_type":  "fixed",
+		"discount_value": 10,
+		"items": []map[string]interface{}{
+			{"description": "Hours", "quantity": 10, "unit_price": 120},
+			{"description": "Travel", "quantity": 1, "unit_price": 50, "discount_type": "fixed", "discount_value": 5},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	source, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	path := fmt.Sprintf("/api/invoices/%d/clone", int(source["id"].(float64)))
+	resp, err = s.setup.MakeRequest("POST", path, map[string]interface{}{
+		"title":           "Consulting (HKD)",
+		"target_currency": "hkd",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	clone, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	s.Equal("HKD", clone["currency"])
+	s.Equal([]interface{}{936.0, 390.0}, itemField(clone, "unit_price"))
+	s.Equal([]interface{}{9360.0, 351.0}, itemField(clone, "amount"))
+	s.Equal(78.0, clone["discount_value"])
+	s.Equal(9633.0, clone["amount"])
+
+	// Target amounts are in the base currency at the HKD rate
+	s.Equal([]interface{}{"USD", "USD"}, itemField(clone, "target_currency"))
+	s.InDelta(9633*0.125, clone["target_amount"], 0.01)
+
+	// The source invoice is unchanged
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/invoices/%d", int(source["id"].(float64))), nil)
+	s.Require().NoError(err)
+	source, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("USD", source["currency"])
+	s.Equal([]interface{}{1200.0, 45.0}, itemField(source, "amount"))
+
+	resp, err = s.setup.MakeRequest("POST", path, map[string]interface{}{"target_currency": "dollars"})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
 func TestFXSuite(t *testing.T) {
 	suite.Run(t, new(FXTestSuite))
 }
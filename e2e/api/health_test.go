@@ -0,0 +1,57 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type HealthTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *HealthTestSuite) SetupTest() {
+	s.setup = NewTestSetupWithFXService(s.T(), services.NewMockFXService())
+}
+
+func (s *HealthTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+func (s *HealthTestSuite) check() (int, map[string]interface{}) {
+	resp, err := s.setup.App.Test(httptest.NewRequest("GET", "/health", nil), -1)
+	s.Require().NoError(err)
+	body, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	return resp.StatusCode, body
+}
+
+func (s *HealthTestSuite) TestHealthy() {
+	status, body := s.check()
+	s.Equal(http.StatusOK, status)
+	s.Equal("ok", body["status"])
+	s.Equal("ok", body["database"].(map[string]interface{})["status"])
+	s.Equal(true, body["fx"].(map[string]interface{})["configured"])
+	s.Equal(true, body["upload"].(map[string]interface{})["available"])
+}
+
+func (s *HealthTestSuite) TestDatabaseUnavailable() {
+	sqlDB, err := s.setup.DBService.GetDB().DB()
+	s.Require().NoError(err)
+	s.Require().NoError(sqlDB.Close())
+
+	status, body := s.check()
+	s.Equal(http.StatusServiceUnavailable, status)
+	s.Equal("unavailable", body["status"])
+	database := body["database"].(map[string]interface{})
+	s.Equal("error", database["status"])
+	s.NotEmpty(database["error"])
+}
+
+func TestHealthSuite(t *testing.T) {
+	suite.Run(t, new(HealthTestSuite))
+}
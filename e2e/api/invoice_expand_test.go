@@ -0,0 +1,142 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type InvoiceExpandTestSuite struct {
+	suite.Suite
+	setup     *TestSetup
+	invoiceID uint
+}
+
+func (s *InvoiceExpandTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+
+	categoryID, err := s.setup.CreateTestCategory("Office")
+	s.Require().NoError(err)
+	s.invoiceID, err = s.setup.CreateTestInvoice("Supplies", &categoryID, nil)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceItem(s.invoiceID, "Paper", 2, 10)
+	s.Require().NoError(err)
+}
+
+func (s *InvoiceExpandTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+func (s *InvoiceExpandTestSuite) getInvoice(query string) map[string]interface{} {
+	resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/invoices/%d%s", s.invoiceID, query), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	return invoice
+}
+
+func (s *InvoiceExpandTestSuite) TestDefaultLoadsAllRelations() {
+	invoice := s.getInvoice("")
+	s.NotNil(invoice["category"])
+	s.Len(invoice["items"], 1)
+	s.Equal(float64(20), invoice["target_amount"])
+}
+
+func (s *InvoiceExpandTestSuite) TestExpandSelectsRelations() {
+	invoice := s.getInvoice("?expand=items")
+	s.Nil(invoice["category"])
+	s.Len(invoice["items"], 1)
+	s.Equal(float64(20), invoice["target_amount"])
+
+	// Header only: the foreign keys and stored amount remain, target_amount needs the items
+	invoice = s.getInvoice("?expand=")
+	s.Nil(invoice["category"])
+	s.Nil(invoice["items"])
+	s.Nil(invoice["target_amount"])
+	s.NotNil(invoice["category_id"])
+	s.Equal(float64(20), invoice["amount"])
+
+	resp, err := s.setup.MakeRequest("GET", "/api/invoices?expand=category", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	data := result["data"].([]interface{})
+	s.Require().Len(data, 1)
+	listed := data[0].(map[string]interface{})
+	s.NotNil(listed["category"])
+	s.Nil(listed["items"])
+	s.Nil(listed["target_amount"])
+}
+
+func (s *InvoiceExpandTestSuite) TestUnknownRelation() {
+	resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/invoices/%d?expand=items,owner", s.invoiceID), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", "/api/invoices?expand=owner", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestInvoiceExpandTestSuite(t *testing.T) {
+	suite.Run(t, new(InvoiceExpandTestSuite))
+}
+
+// BenchmarkGetInvoiceByID compares loading an invoice with every relation against loading
+// only its header, reporting the number of SQL queries issued per load
+func BenchmarkGetInvoiceByID(b *testing.B) {
+	dbService, err := services.NewSqliteDBService(":memory:")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer dbService.Close()
+	db := dbService.GetDB()
+
+	var queries int64
+	if err := db.Callback().Query().After("gorm:query").Register("bench:count_queries", func(*gorm.DB) {
+		atomic.AddInt64(&queries, 1)
+	}); err != nil {
+		b.Fatal(err)
+	}
+
+	invoiceService := services.NewInvoiceService(db, nil)
+	result, err := invoiceService.CreateInvoice("bench-user", &models.Invoice{
+		Title:    "Benchmark",
+		Currency: "USD",
+		Items: []models.InvoiceItem{
+			{Description: "Service", Quantity: 1, UnitPrice: 100},
+		},
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+	invoiceID := result.Invoice.ID
+
+	for _, bc := range []struct {
+		name string
+		load services.InvoiceLoadOptions
+	}{
+		{"AllRelations", services.AllInvoiceRelations()},
+		{"HeaderOnly", services.InvoiceLoadOptions{}},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			atomic.StoreInt64(&queries, 0)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := invoiceService.GetInvoiceByIDWithOptions("bench-user", invoiceID, bc.load); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(atomic.LoadInt64(&queries))/float64(b.N), "queries/op")
+		})
+	}
+}
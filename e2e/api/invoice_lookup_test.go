@@ -0,0 +1,143 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/invoice-management/internal/tools"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+const lookupVendorLink = "https://vendor.example.com/billing/latest.pdf"
+
+type InvoiceLookupTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *InvoiceLookupTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *InvoiceLookupTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// createInvoice creates an invoice with a single item of the given price and original link and
+// returns it
+func (s *InvoiceLookupTestSuite) createInvoice(title string, unitPrice float64, link string) map[string]interface{} {
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices", map[string]interface{}{
+		"title":                  title,
+		"original_download_link": link,
+		"items":                  []map[string]interface{}{{"description": title, "unit_price": unitPrice}},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	return invoice
+}
+
+// lookup looks invoices up with the given query and returns the status code and the titles found
+func (s *InvoiceLookupTestSuite) lookup(query url.Values) (int, []string) {
+	resp, err := s.setup.MakeRequest("GET", "/api/invoices/lookup?"+query.Encode(), nil)
+	s.Require().NoError(err)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	if resp.StatusCode != http.StatusOK {
+		return resp.StatusCode, nil
+	}
+	var titles []string
+	for _, invoice := range result["data"].([]interface{}) {
+		titles = append(titles, invoice.(map[string]interface{})["title"].(string))
+	}
+	return resp.StatusCode, titles
+}
+
+func (s *InvoiceLookupTestSuite) TestLookupByNumber() {
+	invoice := s.createInvoice("Numbered", 10, "")
+	s.createInvoice("Other", 20, "")
+	number := invoice["invoice_number"].(string)
+
+	status, titles := s.lookup(url.Values{"number": {" " + number + " "}})
+	s.Equal(http.StatusOK, status)
+	s.Equal([]string{"Numbered"}, titles)
+
+	// Invoices from before numbering go by their ID
+	legacyID := int(s.createInvoice("Legacy", 30, "")["id"].(float64))
+	s.Require().NoError(s.setup.DBService.GetDB().Exec("UPDATE invoices SET invoice_number = '' WHERE id = ?", legacyID).Error)
+	status, titles = s.lookup(url.Values{"number": {fmt.Sprint(legacyID)}})
+	s.Equal(http.StatusOK, status)
+	s.Equal([]string{"Legacy"}, titles)
+
+	status, _ = s.lookup(url.Values{"number": {"INV-1999-9999"}})
+	s.Equal(http.StatusNotFound, status)
+
+	// A number matching both an invoice number and an unnumbered invoice's ID is ambiguous
+	s.Require().NoError(s.setup.DBService.GetDB().Exec("UPDATE invoices SET invoice_number = ? WHERE title = 'Other'", fmt.Sprint(legacyID)).Error)
+	status, _ = s.lookup(url.Values{"number": {fmt.Sprint(legacyID)}})
+	s.Equal(http.StatusConflict, status)
+
+	// Other users' numbers don't match
+	resp, err := s.setup.MakeAuthenticatedRequest("GET", "/api/invoices/lookup?number="+url.QueryEscape(number), nil, "other-user")
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (s *InvoiceLookupTestSuite) TestLookupByLink() {
+	s.createInvoice("January", 10, lookupVendorLink)
+	s.createInvoice("February", 20, lookupVendorLink)
+	s.createInvoice("Elsewhere", 30, "https://other.example.com/invoice.pdf")
+	s.Require().NoError(s.setup.DBService.GetDB().Exec("UPDATE invoices SET created_at = ? WHERE title = 'January'", DaysAgo(30)).Error)
+
+	status, titles := s.lookup(url.Values{"link": {lookupVendorLink}})
+	s.Equal(http.StatusOK, status)
+	s.Equal([]string{"February", "January"}, titles)
+
+	status, _ = s.lookup(url.Values{"link": {"https://vendor.example.com/billing/"}})
+	s.Equal(http.StatusNotFound, status)
+
+	status, _ = s.lookup(url.Values{})
+	s.Equal(http.StatusBadRequest, status)
+	status, _ = s.lookup(url.Values{"number": {"INV-2024-0001"}, "link": {lookupVendorLink}})
+	s.Equal(http.StatusBadRequest, status)
+}
+
+func (s *InvoiceLookupTestSuite) TestLookupInvoiceTool() {
+	s.createInvoice("January", 10, lookupVendorLink)
+	s.createInvoice("February", 20, lookupVendorLink)
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: s.setup.TestUserID})
+	handler := tools.NewLookupInvoiceTool(s.setup.InvoiceService).GetHandler()
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{"link": lookupVendorLink}
+	result, err := handler(ctx, request)
+	s.Require().NoError(err)
+	s.Require().False(result.IsError)
+	var found struct {
+		Count int `json:"count"`
+	}
+	s.Require().NoError(json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &found))
+	s.Equal(2, found.Count)
+
+	request.Params.Arguments = map[string]interface{}{"number": "INV-1999-9999"}
+	result, err = handler(ctx, request)
+	s.Require().NoError(err)
+	s.True(result.IsError)
+	s.Contains(result.Content[0].(mcp.TextContent).Text, "invoice not found")
+
+	request.Params.Arguments = map[string]interface{}{}
+	result, err = handler(ctx, request)
+	s.Require().NoError(err)
+	s.True(result.IsError)
+}
+
+func TestInvoiceLookupSuite(t *testing.T) {
+	suite.Run(t, new(InvoiceLookupTestSuite))
+}
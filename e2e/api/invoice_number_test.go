@@ -0,0 +1,112 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type InvoiceNumberTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *InvoiceNumberTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *InvoiceNumberTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+func (s *InvoiceNumberTestSuite) getInvoice(id uint) map[string]interface{} {
+	resp, err := s.setup.MakeRequest("GET", fmt.Sprintf("/api/invoices/%d", id), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	return invoice
+}
+
+func (s *InvoiceNumberTestSuite) TestSequentialNumbers() {
+	year := time.Now().Year()
+
+	firstID, err := s.setup.CreateTestInvoice("First", nil, nil)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceItem(firstID, "Item", 1, 10)
+	s.Require().NoError(err)
+	secondID, err := s.setup.CreateTestInvoice("Second", nil, nil)
+	s.Require().NoError(err)
+
+	s.Equal(fmt.Sprintf("INV-%d-0001", year), s.getInvoice(firstID)["invoice_number"])
+	s.Equal(fmt.Sprintf("INV-%d-0002", year), s.getInvoice(secondID)["invoice_number"])
+
+	// Sequences are per user
+	resp, err := s.setup.MakeAuthenticatedRequest("POST", "/api/invoices", map[string]interface{}{
+		"title":    "Other user's invoice",
+		"currency": "USD",
+	}, "other-user")
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	other, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(fmt.Sprintf("INV-%d-0001", year), other["invoice_number"])
+
+	// Numbers are included in list responses
+	resp, err = s.setup.MakeRequest("GET", "/api/invoices", nil)
+	s.Require().NoError(err)
+	list, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	for _, item := range list["data"].([]interface{}) {
+		s.NotEmpty(item.(map[string]interface{})["invoice_number"])
+	}
+}
+
+func (s *InvoiceNumberTestSuite) TestCustomFormat() {
+	resp, err := s.setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{
+		"base_currency":          "USD",
+		"invoice_number_prefix":  "ACME/",
+		"invoice_number_padding": 6,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	settings, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("ACME/", settings["invoice_number_prefix"])
+	s.Equal(float64(6), settings["invoice_number_padding"])
+
+	id, err := s.setup.CreateTestInvoice("Custom", nil, nil)
+	s.Require().NoError(err)
+	s.Equal("ACME/000001", s.getInvoice(id)["invoice_number"])
+
+	// Omitting the format keeps it
+	resp, err = s.setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{
+		"base_currency": "EUR",
+	})
+	s.Require().NoError(err)
+	settings, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("ACME/", settings["invoice_number_prefix"])
+
+	resp, err = s.setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{
+		"base_currency":          "USD",
+		"invoice_number_padding": 11,
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *InvoiceNumberTestSuite) TestLegacyInvoiceFallsBackToID() {
+	id, err := s.setup.CreateTestInvoice("Legacy", nil, nil)
+	s.Require().NoError(err)
+	s.Require().NoError(s.setup.DBService.GetDB().Exec("UPDATE invoices SET invoice_number = '' WHERE id = ?", id).Error)
+
+	s.Equal(fmt.Sprintf("%d", id), s.getInvoice(id)["invoice_number"])
+}
+
+func TestInvoiceNumberSuite(t *testing.T) {
+	suite.Run(t, new(InvoiceNumberTestSuite))
+}
@@ -0,0 +1,153 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type InvoiceTemplateTestSuite struct {
+	suite.Suite
+	setup           *TestSetup
+	templateService services.TemplateService
+}
+
+func (s *InvoiceTemplateTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+	s.templateService = services.NewTemplateService(s.setup.DBService.GetDB())
+}
+
+func (s *InvoiceTemplateTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+func (s *InvoiceTemplateTestSuite) createTemplate() *models.InvoiceTemplate {
+	categoryID, err := s.setup.CreateTestCategory("Consulting")
+	s.Require().NoError(err)
+	receiverID, err := s.setup.CreateTestReceiver("Acme", true)
+	s.Require().NoError(err)
+
+	template := &models.InvoiceTemplate{
+		Name:       "Monthly consulting",
+		Title:      "Consulting services",
+		Currency:   "usd",
+		CategoryID: &categoryID,
+		ReceiverID: &receiverID,
+		Items: []models.InvoiceTemplateItem{
+			{Description: "Retainer", UnitPrice: 1000},
+			{Description: "Support", Quantity: 4, Unit: "hour", UnitPrice: 50},
+		},
+		Tags: []string{"consulting", " retainer ", "consulting"},
+	}
+	s.Require().NoError(s.templateService.CreateTemplate(s.setup.TestUserID, template))
+	return template
+}
+
+func (s *InvoiceTemplateTestSuite) TestCreateAndList() {
+	template := s.createTemplate()
+	s.Equal("USD", template.Currency)
+	s.Equal(1.0, template.Items[0].Quantity)
+	s.Equal([]string{"consulting", "retainer"}, template.Tags)
+
+	templates, err := s.templateService.ListTemplates(s.setup.TestUserID)
+	s.Require().NoError(err)
+	s.Require().Len(templates, 1)
+	s.Equal("Monthly consulting", templates[0].Name)
+	s.Len(templates[0].Items, 2)
+	s.Equal("hour", templates[0].Items[1].Unit)
+
+	// Templates are user-scoped and never create invoices by themselves
+	others, err := s.templateService.ListTemplates("other-user")
+	s.Require().NoError(err)
+	s.Empty(others)
+	_, total, err := s.setup.InvoiceService.ListInvoices(s.setup.TestUserID, services.InvoiceListOptions{})
+	s.Require().NoError(err)
+	s.Equal(int64(0), total)
+}
+
+func (s *InvoiceTemplateTestSuite) TestCreateValidation() {
+	err := s.templateService.CreateTemplate(s.setup.TestUserID, &models.InvoiceTemplate{Name: "  "})
+	s.Error(err)
+
+	otherCategory := &models.InvoiceCategory{UserID: "other-user", Name: "Theirs"}
+	s.Require().NoError(s.setup.DBService.GetDB().Create(otherCategory).Error)
+	err = s.templateService.CreateTemplate(s.setup.TestUserID, &models.InvoiceTemplate{Name: "Borrowed", CategoryID: &otherCategory.ID})
+	s.ErrorContains(err, "category not found")
+}
+
+func (s *InvoiceTemplateTestSuite) TestApply() {
+	template := s.createTemplate()
+	start := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC)
+	title := "Consulting March"
+
+	result, err := s.setup.InvoiceService.CreateFromTemplate(s.setup.TestUserID, template.ID, services.CloneOptions{
+		Title:            &title,
+		InvoiceStartedAt: &start,
+		InvoiceEndedAt:   &end,
+	})
+	s.Require().NoError(err)
+	s.Require().False(result.IsDuplicate)
+
+	invoice := result.Invoice
+	s.Equal("Consulting March", invoice.Title)
+	s.Equal(models.InvoiceStatusUnpaid, invoice.Status)
+	s.Equal(1200.0, invoice.Amount)
+	s.Equal(template.CategoryID, invoice.CategoryID)
+	s.Equal(template.ReceiverID, invoice.ReceiverID)
+	s.Len(invoice.Items, 2)
+	var tagNames []string
+	for _, tag := range invoice.Tags {
+		tagNames = append(tagNames, tag.Name)
+	}
+	s.ElementsMatch([]string{"consulting", "retainer"}, tagNames)
+
+	// Applying it again for the same period matches the first invoice
+	again, err := s.setup.InvoiceService.CreateFromTemplate(s.setup.TestUserID, template.ID, services.CloneOptions{
+		InvoiceStartedAt: &start,
+		InvoiceEndedAt:   &end,
+	})
+	s.Require().NoError(err)
+	s.True(again.IsDuplicate)
+	s.Equal(invoice.ID, again.Invoice.ID)
+
+	_, err = s.setup.InvoiceService.CreateFromTemplate("other-user", template.ID, services.CloneOptions{})
+	s.ErrorContains(err, "template not found")
+}
+
+func (s *InvoiceTemplateTestSuite) TestApplyRejectsInvalidStatus() {
+	template := s.createTemplate()
+	status := models.InvoiceStatus("archived")
+
+	_, err := s.setup.InvoiceService.CreateFromTemplate(s.setup.TestUserID, template.ID, services.CloneOptions{Status: &status})
+	s.ErrorContains(err, "invalid status")
+
+	_, total, err := s.setup.InvoiceService.ListInvoices(s.setup.TestUserID, services.InvoiceListOptions{})
+	s.Require().NoError(err)
+	s.Equal(int64(0), total)
+}
+
+func (s *InvoiceTemplateTestSuite) TestUpdateAndDelete() {
+	template := s.createTemplate()
+
+	template.Name = "Quarterly consulting"
+	template.Items = template.Items[:1]
+	s.Require().NoError(s.templateService.UpdateTemplate(s.setup.TestUserID, template))
+
+	updated, err := s.templateService.GetTemplateByID(s.setup.TestUserID, template.ID)
+	s.Require().NoError(err)
+	s.Equal("Quarterly consulting", updated.Name)
+	s.Len(updated.Items, 1)
+
+	s.Error(s.templateService.DeleteTemplate("other-user", template.ID))
+	s.Require().NoError(s.templateService.DeleteTemplate(s.setup.TestUserID, template.ID))
+	_, err = s.templateService.GetTemplateByID(s.setup.TestUserID, template.ID)
+	s.Error(err)
+}
+
+func TestInvoiceTemplateSuite(t *testing.T) {
+	suite.Run(t, new(InvoiceTemplateTestSuite))
+}
@@ -1,10 +1,14 @@
 package api
 
 import (
+	"fmt"
 	"net/http"
+	"net/url"
 	"testing"
 	"time"
 
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -130,6 +134,185 @@ func (s *InvoiceTestSuite) TestListInvoicesWithKeyword() {
 	s.Equal(1, len(data))
 }
 
+func (s *InvoiceTestSuite) TestListInvoicesWithCursor() {
+	categoryID, _ := s.setup.CreateTestCategory("Test")
+	companyID, _ := s.setup.CreateTestCompany("Test")
+
+	// Create invoices with different amounts to avoid duplicate detection
+	for i := 1; i <= 5; i++ {
+		_, err := s.setup.CreateTestInvoiceWithStatus("Invoice "+uintToString(uint(i)), &categoryID, &companyID, "unpaid", float64(i*100))
+		s.Require().NoError(err)
+	}
+
+	seen := map[float64]bool{}
+	path := "/api/invoices?limit=2&cursor_direction=desc"
+	pages := 0
+	for {
+		resp, err := s.setup.MakeRequest("GET", path, nil)
+		s.Require().NoError(err)
+		s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+		result, err := s.setup.ReadResponseBody(resp)
+		s.Require().NoError(err)
+		s.Equal(float64(5), result["total"])
+		pages++
+
+		for _, item := range result["data"].([]interface{}) {
+			id := item.(map[string]interface{})["id"].(float64)
+			s.False(seen[id], "invoice %v returned twice", id)
+			seen[id] = true
+		}
+
+		nextCursor, ok := result["next_cursor"].(string)
+		// In cursor mode has_more follows the cursor rather than the (always zero) offset
+		s.Equal(ok, result["pagination"].(map[string]interface{})["has_more"])
+		if !ok {
+			break
+		}
+		s.Require().Less(pages, 5)
+		path = "/api/invoices?limit=2&cursor=" + nextCursor
+	}
+
+	s.Equal(3, pages)
+	s.Len(seen, 5)
+}
+
+func (s *InvoiceTestSuite) TestListInvoicesWithInvalidCursor() {
+	resp, err := s.setup.MakeRequest("GET", "/api/invoices?cursor=not-a-cursor", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+// TestListInvoicesStableSort pages through invoices tied on every sort field, which come back by
+// descending ID on every page
+func (s *InvoiceTestSuite) TestListInvoicesStableSort() {
+	createdAt := time.Now().Add(-time.Hour)
+	var ids []float64
+	for i := 1; i <= 5; i++ {
+		// Equal amounts, told apart from duplicates by their billing dates
+		startedAt := createdAt.AddDate(0, i, 0)
+		result, err := s.setup.InvoiceService.CreateInvoice(s.setup.TestUserID, &models.Invoice{
+			Title:            fmt.Sprintf("Subscription %d", i),
+			Currency:         "USD",
+			InvoiceStartedAt: &startedAt,
+			Items:            []models.InvoiceItem{{Description: "Plan", Quantity: 1, UnitPrice: 100}},
+		})
+		s.Require().NoError(err)
+		s.Require().False(result.IsDuplicate)
+		ids = append([]float64{float64(result.Invoice.ID)}, ids...)
+	}
+	s.Require().NoError(s.setup.DBService.GetDB().Exec("UPDATE invoices SET created_at = ?", createdAt).Error)
+
+	for _, sortBy := range []string{"amount", "amount,created_at", "created_at, amount"} {
+		for _, order := range []string{"asc", "desc"} {
+			var listed []float64
+			for offset := 0; offset < 5; offset += 2 {
+				query := url.Values{"sort_by": {sortBy}, "sort_order": {order}, "limit": {"2"}, "offset": {fmt.Sprint(offset)}}
+				resp, err := s.setup.MakeRequest("GET", "/api/invoices?"+query.Encode(), nil)
+				s.Require().NoError(err)
+				s.Require().Equal(http.StatusOK, resp.StatusCode)
+				result, err := s.setup.ReadResponseBody(resp)
+				s.Require().NoError(err)
+				for _, item := range result["data"].([]interface{}) {
+					listed = append(listed, item.(map[string]interface{})["id"].(float64))
+				}
+			}
+			s.Equal(ids, listed, "sort_by=%s sort_order=%s", sortBy, order)
+		}
+	}
+
+	for _, sortBy := range []string{"amount,total", "id", "amount,amount"} {
+		resp, err := s.setup.MakeRequest("GET", "/api/invoices?sort_by="+url.QueryEscape(sortBy), nil)
+		s.Require().NoError(err)
+		s.Equal(http.StatusBadRequest, resp.StatusCode, sortBy)
+	}
+	_, _, err := s.setup.InvoiceService.ListInvoices(s.setup.TestUserID, services.InvoiceListOptions{SortBy: "priority"})
+	s.ErrorContains(err, "unknown sort field")
+}
+
+func (s *InvoiceTestSuite) TestSearchInvoicesByCompanyName() {
+	categoryID, _ := s.setup.CreateTestCategory("Travel")
+	companyID, _ := s.setup.CreateTestCompany("Acme Holdings")
+	otherCompanyID, _ := s.setup.CreateTestCompany("Globex")
+
+	// Use different amounts to avoid duplicate detection
+	invoiceID, err := s.setup.CreateTestInvoiceWithStatus("Quarterly services", &categoryID, &companyID, "unpaid", 300.00)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceWithStatus("Monthly services", &categoryID, &otherCompanyID, "unpaid", 100.00)
+	s.Require().NoError(err)
+
+	invoices, err := s.setup.InvoiceService.SearchInvoices(s.setup.TestUserID, "Acme", false)
+	s.Require().NoError(err)
+	s.Require().Len(invoices, 1)
+	s.Equal(invoiceID, invoices[0].ID)
+	s.Require().NotNil(invoices[0].Company)
+	s.Equal("Acme Holdings", invoices[0].Company.Name)
+}
+
+func (s *InvoiceTestSuite) TestSearchInvoicesByReceiverName() {
+	receiverID, err := s.setup.CreateTestReceiver("Marriott Hotels", true)
+	s.Require().NoError(err)
+
+	invoice := map[string]interface{}{
+		"title":       "Conference stay",
+		"description": "Three nights",
+		"currency":    "USD",
+		"status":      "unpaid",
+		"receiver_id": receiverID,
+	}
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices", invoice)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	invoiceID := uint(result["id"].(float64))
+
+	_, err = s.setup.CreateTestInvoice("Office rent", nil, nil)
+	s.Require().NoError(err)
+
+	invoices, err := s.setup.InvoiceService.SearchInvoices(s.setup.TestUserID, "marriott", false)
+	s.Require().NoError(err)
+	s.Require().Len(invoices, 1)
+	s.Equal(invoiceID, invoices[0].ID)
+	s.Require().NotNil(invoices[0].Receiver)
+	s.Equal("Marriott Hotels", invoices[0].Receiver.Name)
+}
+
+func (s *InvoiceTestSuite) TestSearchInvoicesHighlight() {
+	companyID, err := s.setup.CreateTestCompany("Harbour Catering")
+	s.Require().NoError(err)
+
+	invoice := map[string]interface{}{
+		"title":       "Harbour cruise",
+		"description": "Evening dinner cruise around the harbour for the whole team, including drinks and a harbour view",
+		"currency":    "USD",
+		"company_id":  companyID,
+	}
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices", invoice)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+
+	results, err := s.setup.InvoiceService.SearchInvoices(s.setup.TestUserID, "HARBOUR", true)
+	s.Require().NoError(err)
+	s.Require().Len(results, 1)
+	s.Equal([]string{"title", "description", "company"}, results[0].MatchedFields)
+	s.Equal("**Harbour** cruise", results[0].Snippets["title"])
+	s.Equal("Evening dinner cruise around the **harbour** for the whole team, including drinks…", results[0].Snippets["description"])
+
+	results, err = s.setup.InvoiceService.SearchInvoices(s.setup.TestUserID, "catering", true)
+	s.Require().NoError(err)
+	s.Require().Len(results, 1)
+	s.Equal([]string{"company"}, results[0].MatchedFields)
+	s.Empty(results[0].Snippets)
+
+	// Without the flag nothing is computed
+	results, err = s.setup.InvoiceService.SearchInvoices(s.setup.TestUserID, "harbour", false)
+	s.Require().NoError(err)
+	s.Require().Len(results, 1)
+	s.Nil(results[0].MatchedFields)
+	s.Nil(results[0].Snippets)
+}
+
 func (s *InvoiceTestSuite) TestGetInvoice() {
 	categoryID, _ := s.setup.CreateTestCategory("Test")
 	companyID, _ := s.setup.CreateTestCompany("Test")
@@ -180,6 +363,54 @@ func (s *InvoiceTestSuite) TestUpdateInvoice() {
 	s.Equal("paid", result["status"])
 }
 
+// TestUpdateInvoiceStaleVersion verifies an update based on an outdated version is rejected
+func (s *InvoiceTestSuite) TestUpdateInvoiceStaleVersion() {
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices", map[string]interface{}{"title": "Original Invoice"})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	created, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(1.0, created["version"])
+	invoiceID := uint(created["id"].(float64))
+
+	resp, err = s.setup.MakeRequest("PUT", "/api/invoices/"+uintToString(invoiceID), map[string]interface{}{
+		"title":   "First Update",
+		"version": 1,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(2.0, result["version"])
+
+	// A second client still holding version 1
+	resp, err = s.setup.MakeRequest("PUT", "/api/invoices/"+uintToString(invoiceID), map[string]interface{}{
+		"title":   "Stale Update",
+		"version": 1,
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusConflict, resp.StatusCode)
+
+	// Two updates of the same loaded invoice: the second one loses
+	first, err := s.setup.InvoiceService.GetInvoiceByID(s.setup.TestUserID, invoiceID)
+	s.Require().NoError(err)
+	second, err := s.setup.InvoiceService.GetInvoiceByID(s.setup.TestUserID, invoiceID)
+	s.Require().NoError(err)
+	first.Title = "From first"
+	s.Require().NoError(s.setup.InvoiceService.UpdateInvoice(s.setup.TestUserID, first))
+	second.Title = "From second"
+	err = s.setup.InvoiceService.UpdateInvoice(s.setup.TestUserID, second)
+	var conflictErr *services.VersionConflictError
+	s.Require().ErrorAs(err, &conflictErr)
+	s.Equal(2, conflictErr.ExpectedVersion)
+	s.Equal(3, conflictErr.CurrentVersion)
+
+	invoice, err := s.setup.InvoiceService.GetInvoiceByID(s.setup.TestUserID, invoiceID)
+	s.Require().NoError(err)
+	s.Equal("From first", invoice.Title)
+	s.Equal(3, invoice.Version)
+}
+
 func (s *InvoiceTestSuite) TestUpdateInvoiceStatus() {
 	categoryID, _ := s.setup.CreateTestCategory("Test")
 	companyID, _ := s.setup.CreateTestCompany("Test")
@@ -272,6 +503,49 @@ func (s *InvoiceTestSuite) TestUpdateInvoiceItem() {
 	s.Equal(float64(200), result["unit_price"])
 }
 
+func (s *InvoiceTestSuite) TestInvoiceItemUnit() {
+	invoiceID, err := s.setup.CreateTestInvoice("Consulting", nil, nil)
+	s.Require().NoError(err)
+	path := "/api/invoices/" + uintToString(invoiceID) + "/items"
+
+	resp, err := s.setup.MakeRequest("POST", path, map[string]interface{}{
+		"description": "Consulting",
+		"quantity":    3,
+		"unit":        "  hour ",
+		"unit_price":  100.00,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	item, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("hour", item["unit"])
+	s.Equal(float64(300), item["amount"])
+	itemPath := path + "/" + uintToString(uint(item["id"].(float64)))
+
+	// Updates that leave out the unit keep it
+	resp, err = s.setup.MakeRequest("PUT", itemPath, map[string]interface{}{"quantity": 4})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	item, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("hour", item["unit"])
+
+	resp, err = s.setup.MakeRequest("PUT", itemPath, map[string]interface{}{"unit": ""})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	item, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.NotContains(item, "unit")
+
+	resp, err = s.setup.MakeRequest("POST", path, map[string]interface{}{
+		"description": "Freight",
+		"unit":        "twenty-one-characters",
+		"unit_price":  10.00,
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
 func (s *InvoiceTestSuite) TestDeleteInvoiceItem() {
 	categoryID, _ := s.setup.CreateTestCategory("Test")
 	companyID, _ := s.setup.CreateTestCompany("Test")
@@ -700,6 +974,526 @@ func (s *InvoiceTestSuite) TestUpdateInvoiceItem_RecalculatesTargetAmountWhenFla
 	s.InDelta(expectedRatio, actualRatio, 0.01, "target_amount should scale proportionally with amount")
 }
 
+func (s *InvoiceTestSuite) TestCloneInvoice() {
+	categoryID, err := s.setup.CreateTestCategory("Housing")
+	s.Require().NoError(err)
+	receiverID, err := s.setup.CreateTestReceiver("Landlord", false)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", "/api/tags", map[string]interface{}{"name": "rent"})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	tag, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	startDate := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC)
+
+	resp, err = s.setup.MakeRequest("POST", "/api/invoices", map[string]interface{}{
+		"title":              "May Rent",
+		"category_id":        categoryID,
+		"receiver_id":        receiverID,
+		"currency":           "USD",
+		"status":             "paid",
+		"tag_ids":            []int{int(tag["id"].(float64))},
+		"invoice_started_at": startDate.Format(time.RFC3339),
+		"invoice_ended_at":   endDate.Format(time.RFC3339),
+		"items": []map[string]interface{}{
+			{"description": "Rent", "quantity": 1, "unit_price": 1500.00},
+			{"description": "Parking", "quantity": 1, "unit_price": 100.00},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	source, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	sourceID := uint(source["id"].(float64))
+
+	// Clone into June
+	cloneBody := map[string]interface{}{
+		"title":              "June Rent",
+		"invoice_started_at": startDate.AddDate(0, 1, 0).Format(time.RFC3339),
+		"invoice_ended_at":   endDate.AddDate(0, 1, 0).Format(time.RFC3339),
+	}
+	resp, err = s.setup.MakeRequest("POST", "/api/invoices/"+uintToString(sourceID)+"/clone", cloneBody)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+
+	clone, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.NotEqual(source["id"], clone["id"])
+	s.Equal("June Rent", clone["title"])
+	s.Equal("unpaid", clone["status"])
+	s.Equal(float64(1600), clone["amount"])
+	s.Equal(float64(categoryID), clone["category"].(map[string]interface{})["id"])
+	s.Equal(float64(receiverID), clone["receiver"].(map[string]interface{})["id"])
+	s.Len(clone["items"], 2)
+	s.Require().Len(clone["tags"], 1)
+	s.Equal("rent", clone["tags"].([]interface{})[0].(map[string]interface{})["name"])
+
+	// Cloning into the same period again is caught by duplicate detection
+	resp, err = s.setup.MakeRequest("POST", "/api/invoices/"+uintToString(sourceID)+"/clone", cloneBody)
+	s.Require().NoError(err)
+	s.Equal(http.StatusConflict, resp.StatusCode)
+}
+
+func (s *InvoiceTestSuite) TestCloneInvoiceRejectsInvalidStatus() {
+	sourceID, err := s.setup.CreateTestInvoiceWithStatus("Source", nil, nil, "paid", 100)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices/"+uintToString(sourceID)+"/clone", map[string]interface{}{
+		"title":  "Clone",
+		"status": "archived",
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	var count int64
+	s.Require().NoError(s.setup.DBService.GetDB().Model(&models.Invoice{}).Where("title = ?", "Clone").Count(&count).Error)
+	s.Zero(count)
+}
+
+func (s *InvoiceTestSuite) TestCloneInvoiceNotFound() {
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices/99999/clone", map[string]interface{}{})
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (s *InvoiceTestSuite) TestListInvoicesByAmountRange() {
+	for i, amount := range []float64{100, 200, 300} {
+		_, err := s.setup.CreateTestInvoiceWithStatus(fmt.Sprintf("Invoice %d", i+1), nil, nil, "unpaid", amount)
+		s.Require().NoError(err)
+	}
+
+	// Bounds are inclusive
+	resp, err := s.setup.MakeRequest("GET", "/api/invoices?min_amount=100&max_amount=200", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(2), result["total"])
+	s.Len(result["data"], 2)
+
+	resp, err = s.setup.MakeRequest("GET", "/api/invoices?min_amount=300", nil)
+	s.Require().NoError(err)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(1), result["total"])
+
+	// An empty range matches nothing
+	resp, err = s.setup.MakeRequest("GET", "/api/invoices?min_amount=250&max_amount=150", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(0), result["total"])
+	s.Empty(result["data"])
+
+	resp, err = s.setup.MakeRequest("GET", "/api/invoices?min_amount=100&amount_field=total", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+// TestListInvoicesByAmountRange_AmountField verifies target_amount filtering normalizes currencies
+func (s *InvoiceTestSuite) TestListInvoicesByAmountRange_AmountField() {
+	fxService := services.NewMockFXService()
+	fxService.SetRate("HKD", "USD", 0.125)
+	setup := NewTestSetupWithFXService(s.T(), fxService)
+	defer setup.Cleanup()
+
+	hkdInvoiceID, err := setup.CreateTestInvoiceWithCurrency("HKD Invoice", "HKD")
+	s.Require().NoError(err)
+	_, err = setup.CreateTestInvoiceItem(hkdInvoiceID, "Service", 1, 800) // 100 USD
+	s.Require().NoError(err)
+
+	usdInvoiceID, err := setup.CreateTestInvoiceWithCurrency("USD Invoice", "USD")
+	s.Require().NoError(err)
+	_, err = setup.CreateTestInvoiceItem(usdInvoiceID, "Service", 1, 150)
+	s.Require().NoError(err)
+
+	// Default compares the USD target amount
+	resp, err := setup.MakeRequest("GET", "/api/invoices?min_amount=120", nil)
+	s.Require().NoError(err)
+	result, err := setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	data := result["data"].([]interface{})
+	s.Require().Len(data, 1)
+	s.Equal(float64(usdInvoiceID), data[0].(map[string]interface{})["id"])
+
+	// amount_field=amount compares the raw invoice amount
+	resp, err = setup.MakeRequest("GET", "/api/invoices?min_amount=120&amount_field=amount", nil)
+	s.Require().NoError(err)
+	result, err = setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Len(result["data"], 2)
+}
+
+// TestListInvoicesTotals verifies the list totals cover all matching invoices, not just the page
+func (s *InvoiceTestSuite) TestListInvoicesTotals() {
+	fxService := services.NewMockFXService()
+	fxService.SetRate("HKD", "USD", 0.125)
+	setup := NewTestSetupWithFXService(s.T(), fxService)
+	defer setup.Cleanup()
+
+	hkdInvoiceID, err := setup.CreateTestInvoiceWithCurrency("HKD Invoice", "HKD")
+	s.Require().NoError(err)
+	_, err = setup.CreateTestInvoiceItem(hkdInvoiceID, "Service", 1, 800) // 100 USD
+	s.Require().NoError(err)
+
+	usdInvoiceID, err := setup.CreateTestInvoiceWithCurrency("USD Invoice", "USD")
+	s.Require().NoError(err)
+	_, err = setup.CreateTestInvoiceItem(usdInvoiceID, "Service", 1, 150)
+	s.Require().NoError(err)
+
+	resp, err := setup.MakeRequest("GET", "/api/invoices?limit=1", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	result, err := setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Len(result["data"], 1)
+	s.Equal(float64(2), result["total"])
+	s.Equal(float64(950), result["total_amount"])
+	s.Equal(float64(250), result["total_target_amount"])
+
+	// Totals respect the filters
+	resp, err = setup.MakeRequest("GET", "/api/invoices?keyword=HKD", nil)
+	s.Require().NoError(err)
+	result, err = setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(1), result["total"])
+	s.Equal(float64(800), result["total_amount"])
+	s.Equal(float64(100), result["total_target_amount"])
+}
+
+// TestListInvoicesByDueDate lists what's due in the next week, as list_upcoming_invoices does
+func (s *InvoiceTestSuite) TestListInvoicesByDueDate() {
+	now := time.Now()
+	dueIn := map[string]int{"Due later": 5, "Due soon": 2, "Due next month": 20, "Paid soon": 1}
+	amounts := map[string]float64{"Due later": 200, "Due soon": 100, "Due next month": 300, "Paid soon": 500}
+	for title, days := range dueIn {
+		status := "unpaid"
+		if title == "Paid soon" {
+			status = "paid"
+		}
+		id, err := s.setup.CreateTestInvoiceWithStatus(title, nil, nil, status, amounts[title])
+		s.Require().NoError(err)
+		s.Require().NoError(s.setup.DBService.GetDB().Exec("UPDATE invoices SET due_date = ? WHERE id = ?", now.AddDate(0, 0, days), id).Error)
+	}
+	_, err := s.setup.CreateTestInvoiceWithStatus("No due date", nil, nil, "unpaid", 400)
+	s.Require().NoError(err)
+
+	until := now.AddDate(0, 0, 7)
+	status := models.InvoiceStatusUnpaid
+	page, err := s.setup.InvoiceService.ListInvoicesPage(s.setup.TestUserID, services.InvoiceListOptions{
+		Status:    &status,
+		DueAfter:  &now,
+		DueBefore: &until,
+		SortBy:    "due_date",
+		SortOrder: "asc",
+	})
+	s.Require().NoError(err)
+	s.Require().Len(page.Invoices, 2)
+	s.Equal("Due soon", page.Invoices[0].Title)
+	s.Equal("Due later", page.Invoices[1].Title)
+	s.Equal(int64(2), page.Total)
+	s.Equal(300.0, page.TotalTargetAmount)
+
+	// Either bound can be used alone
+	page, err = s.setup.InvoiceService.ListInvoicesPage(s.setup.TestUserID, services.InvoiceListOptions{DueAfter: &until})
+	s.Require().NoError(err)
+	s.Require().Len(page.Invoices, 1)
+	s.Equal("Due next month", page.Invoices[0].Title)
+}
+
+func (s *InvoiceTestSuite) TestRecalculateTotals() {
+	driftedID, err := s.setup.CreateTestInvoice("Drifted", nil, nil)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceItem(driftedID, "Paper", 2, 10)
+	s.Require().NoError(err)
+	okID, err := s.setup.CreateTestInvoiceWithStatus("Consistent", nil, nil, "paid", 50)
+	s.Require().NoError(err)
+
+	// Simulate manual database edits
+	db := s.setup.DBService.GetDB()
+	s.Require().NoError(db.Exec("UPDATE invoices SET amount = 999 WHERE id = ?", driftedID).Error)
+	s.Require().NoError(db.Exec("UPDATE invoice_items SET target_amount = 5 WHERE invoice_id = ?", driftedID).Error)
+
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/invoices/%d/recalculate", driftedID), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(999.0, result["amount_before"])
+	s.Equal(20.0, result["amount_after"])
+	s.Equal(5.0, result["target_amount_before"])
+	s.Equal(20.0, result["target_amount_after"])
+
+	// A full pass finds nothing left to correct
+	recalculations, err := s.setup.InvoiceService.RecalculateAllTotals(s.setup.TestUserID)
+	s.Require().NoError(err)
+	s.Len(recalculations, 2)
+	for _, recalculation := range recalculations {
+		s.False(recalculation.Changed(), recalculation.InvoiceID)
+	}
+	s.Equal(okID, recalculations[1].InvoiceID)
+	s.Equal(50.0, recalculations[1].AmountAfter)
+
+	resp, err = s.setup.MakeRequest("POST", "/api/invoices/999999/recalculate", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (s *InvoiceTestSuite) TestListIncompleteInvoices() {
+	categoryID, err := s.setup.CreateTestCategory("Utilities")
+	s.Require().NoError(err)
+	companyID, err := s.setup.CreateTestCompany("Power Co")
+	s.Require().NoError(err)
+	receiverID, err := s.setup.CreateTestReceiver("John Doe", false)
+	s.Require().NoError(err)
+
+	completeID, err := s.setup.CreateTestInvoiceWithStatus("Complete", &categoryID, &companyID, "unpaid", 100)
+	s.Require().NoError(err)
+	noCategoryID, err := s.setup.CreateTestInvoiceWithStatus("No category", nil, &companyID, "unpaid", 200)
+	s.Require().NoError(err)
+	noReceiverID, err := s.setup.CreateTestInvoiceWithStatus("No receiver", &categoryID, &companyID, "unpaid", 300)
+	s.Require().NoError(err)
+
+	for _, id := range []uint{completeID, noCategoryID} {
+		resp, err := s.setup.MakeRequest("PUT", "/api/invoices/"+uintToString(id), map[string]interface{}{
+			"receiver_id": receiverID,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(http.StatusOK, resp.StatusCode)
+	}
+
+	invoiceIDs := func(filter services.IncompleteFilter) []uint {
+		invoices, err := s.setup.InvoiceService.ListIncomplete(s.setup.TestUserID, filter)
+		s.Require().NoError(err)
+		ids := make([]uint, 0, len(invoices))
+		for _, invoice := range invoices {
+			ids = append(ids, invoice.ID)
+		}
+		return ids
+	}
+
+	s.ElementsMatch([]uint{noCategoryID}, invoiceIDs(services.IncompleteFilter{MissingCategory: true}))
+	s.ElementsMatch([]uint{noReceiverID}, invoiceIDs(services.IncompleteFilter{MissingReceiver: true}))
+	s.Empty(invoiceIDs(services.IncompleteFilter{MissingCompany: true}))
+
+	// Flags are combined with OR
+	s.ElementsMatch([]uint{noCategoryID, noReceiverID}, invoiceIDs(services.IncompleteFilter{MissingCategory: true, MissingReceiver: true}))
+
+	_, err = s.setup.InvoiceService.ListIncomplete(s.setup.TestUserID, services.IncompleteFilter{})
+	s.Error(err)
+}
+
+func (s *InvoiceTestSuite) TestReorderInvoiceItems() {
+	invoiceID, err := s.setup.CreateTestInvoice("Reorder Test", nil, nil)
+	s.Require().NoError(err)
+
+	var itemIDs []uint
+	for _, description := range []string{"First", "Second", "Third"} {
+		itemID, err := s.setup.CreateTestInvoiceItem(invoiceID, description, 1, 10)
+		s.Require().NoError(err)
+		itemIDs = append(itemIDs, itemID)
+	}
+
+	itemDescriptions := func(invoice map[string]interface{}) []string {
+		var descriptions []string
+		for _, item := range invoice["items"].([]interface{}) {
+			descriptions = append(descriptions, item.(map[string]interface{})["description"].(string))
+		}
+		return descriptions
+	}
+
+	// Items are appended in insertion order
+	resp, err := s.setup.MakeRequest("GET", "/api/invoices/"+uintToString(invoiceID), nil)
+	s.Require().NoError(err)
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal([]string{"First", "Second", "Third"}, itemDescriptions(invoice))
+
+	resp, err = s.setup.MakeRequest("PUT", "/api/invoices/"+uintToString(invoiceID)+"/items/order", map[string]interface{}{
+		"item_ids": []uint{itemIDs[2], itemIDs[0], itemIDs[1]},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	invoice, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal([]string{"Third", "First", "Second"}, itemDescriptions(invoice))
+
+	// The new order is audited on the invoice
+	trail, _, err := s.setup.InvoiceService.GetAuditTrail(s.setup.TestUserID, invoiceID, services.AuditTrailOptions{})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(trail)
+	s.Equal(models.AuditActionUpdate, trail[0].Action)
+	s.Equal(models.AuditEntityInvoice, trail[0].EntityType)
+	s.Equal(map[string]interface{}{
+		"from": fmt.Sprintf("%d,%d,%d", itemIDs[0], itemIDs[1], itemIDs[2]),
+		"to":   fmt.Sprintf("%d,%d,%d", itemIDs[2], itemIDs[0], itemIDs[1]),
+	}, trail[0].Diff["item_order"])
+
+	// New items go to the end
+	_, err = s.setup.CreateTestInvoiceItem(invoiceID, "Fourth", 1, 10)
+	s.Require().NoError(err)
+	resp, err = s.setup.MakeRequest("GET", "/api/invoices/"+uintToString(invoiceID), nil)
+	s.Require().NoError(err)
+	invoice, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal([]string{"Third", "First", "Second", "Fourth"}, itemDescriptions(invoice))
+}
+
+func (s *InvoiceTestSuite) TestReorderInvoiceItems_InvalidItems() {
+	invoiceID, err := s.setup.CreateTestInvoice("Reorder Test", nil, nil)
+	s.Require().NoError(err)
+	firstID, err := s.setup.CreateTestInvoiceItem(invoiceID, "First", 1, 10)
+	s.Require().NoError(err)
+	secondID, err := s.setup.CreateTestInvoiceItem(invoiceID, "Second", 1, 20)
+	s.Require().NoError(err)
+
+	// Every item must be listed exactly once
+	for _, itemIDs := range [][]uint{{firstID}, {firstID, firstID}, {firstID, 99999}} {
+		resp, err := s.setup.MakeRequest("PUT", "/api/invoices/"+uintToString(invoiceID)+"/items/order", map[string]interface{}{
+			"item_ids": itemIDs,
+		})
+		s.Require().NoError(err)
+		s.Equal(http.StatusBadRequest, resp.StatusCode)
+	}
+
+	resp, err := s.setup.MakeRequest("PUT", "/api/invoices/99999/items/order", map[string]interface{}{
+		"item_ids": []uint{secondID, firstID},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (s *InvoiceTestSuite) TestAddInvoiceItemsBatch() {
+	invoiceID, err := s.setup.CreateTestInvoice("Batch Test", nil, nil)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceItem(invoiceID, "Existing", 1, 10)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices/"+uintToString(invoiceID)+"/items/batch", map[string]interface{}{
+		"items": []map[string]interface{}{
+			{"description": "Design", "quantity": 4, "unit_price": 50},
+			{"description": "Hosting", "unit_price": 20, "discount_type": "percent", "discount_value": 50},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	items := result["data"].([]interface{})
+	s.Require().Len(items, 2)
+	for _, item := range items {
+		s.NotZero(item.(map[string]interface{})["id"])
+	}
+	s.Equal([]interface{}{200.0, 10.0}, itemField(map[string]interface{}{"items": items}, "target_amount"))
+
+	resp, err = s.setup.MakeRequest("GET", "/api/invoices/"+uintToString(invoiceID), nil)
+	s.Require().NoError(err)
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(220.0, invoice["amount"])
+	s.Equal([]interface{}{"Existing", "Design", "Hosting"}, itemField(invoice, "description"))
+
+	// An invalid item rejects the whole batch
+	resp, err = s.setup.MakeRequest("POST", "/api/invoices/"+uintToString(invoiceID)+"/items/batch", map[string]interface{}{
+		"items": []map[string]interface{}{
+			{"description": "Valid", "unit_price": 5},
+			{"description": "Invalid", "unit_price": 5, "discount_type": "percent", "discount_value": 150},
+		},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", "/api/invoices/"+uintToString(invoiceID), nil)
+	s.Require().NoError(err)
+	invoice, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(220.0, invoice["amount"])
+	s.Len(invoice["items"], 3)
+
+	resp, err = s.setup.MakeRequest("POST", "/api/invoices/99999/items/batch", map[string]interface{}{
+		"items": []map[string]interface{}{{"description": "Orphan", "unit_price": 5}},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *InvoiceTestSuite) TestInvoiceFacets() {
+	utilitiesID, err := s.setup.CreateTestCategory("Utilities")
+	s.Require().NoError(err)
+	travelID, err := s.setup.CreateTestCategory("Travel")
+	s.Require().NoError(err)
+	// A category without invoices isn't listed
+	_, err = s.setup.CreateTestCategory("Unused")
+	s.Require().NoError(err)
+	deletedID, err := s.setup.CreateTestCategory("Deleted")
+	s.Require().NoError(err)
+	companyID, err := s.setup.CreateTestCompany("Power Co")
+	s.Require().NoError(err)
+	receiverID, err := s.setup.CreateTestReceiver("Jane Doe", false)
+	s.Require().NoError(err)
+
+	var deletedInvoiceID uint
+	for i, invoice := range []map[string]interface{}{
+		{"currency": "USD", "status": "paid", "category_id": utilitiesID, "company_id": companyID, "receiver_id": receiverID},
+		{"currency": "USD", "status": "unpaid", "category_id": utilitiesID},
+		{"currency": "HKD", "status": "unpaid", "category_id": travelID},
+		{"currency": "EUR", "status": "overdue", "category_id": deletedID},
+		{"currency": "JPY", "status": "paid", "category_id": travelID},
+	} {
+		invoice["title"] = fmt.Sprintf("Invoice %d", i+1)
+		invoice["items"] = []map[string]interface{}{{"description": "Item", "unit_price": 10 * (i + 1)}}
+		resp, err := s.setup.MakeRequest("POST", "/api/invoices", invoice)
+		s.Require().NoError(err)
+		s.Require().Equal(http.StatusCreated, resp.StatusCode)
+		result, err := s.setup.ReadResponseBody(resp)
+		s.Require().NoError(err)
+		deletedInvoiceID = uint(result["id"].(float64))
+	}
+
+	// The last invoice and a category are deleted; another user's invoice isn't counted
+	resp, err := s.setup.MakeRequest("DELETE", "/api/invoices/"+uintToString(deletedInvoiceID), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusNoContent, resp.StatusCode)
+	s.Require().NoError(s.setup.DBService.GetDB().Delete(&models.InvoiceCategory{}, deletedID).Error)
+	resp, err = s.setup.MakeAuthenticatedRequest("POST", "/api/invoices", map[string]interface{}{
+		"title": "Other user", "currency": "GBP",
+	}, "other-user")
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", "/api/invoices/facets", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	facets, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	s.Equal([]interface{}{
+		map[string]interface{}{"value": "USD", "count": 2.0},
+		map[string]interface{}{"value": "EUR", "count": 1.0},
+		map[string]interface{}{"value": "HKD", "count": 1.0},
+	}, facets["currencies"])
+	s.Equal([]interface{}{
+		map[string]interface{}{"value": "unpaid", "count": 2.0},
+		map[string]interface{}{"value": "overdue", "count": 1.0},
+		map[string]interface{}{"value": "paid", "count": 1.0},
+	}, facets["statuses"])
+	s.Equal([]interface{}{
+		map[string]interface{}{"id": float64(travelID), "name": "Travel", "count": 1.0},
+		map[string]interface{}{"id": float64(utilitiesID), "name": "Utilities", "count": 2.0},
+	}, facets["categories"])
+	s.Equal([]interface{}{
+		map[string]interface{}{"id": float64(companyID), "name": "Power Co", "count": 1.0},
+	}, facets["companies"])
+	s.Equal([]interface{}{
+		map[string]interface{}{"id": float64(receiverID), "name": "Jane Doe", "count": 1.0},
+	}, facets["receivers"])
+}
+
 func TestInvoiceSuite(t *testing.T) {
 	suite.Run(t, new(InvoiceTestSuite))
 }
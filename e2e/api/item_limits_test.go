@@ -0,0 +1,168 @@
+package api
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type ItemLimitsTestSuite struct {
+	suite.Suite
+	setup     *TestSetup
+	invoiceID uint
+}
+
+func (s *ItemLimitsTestSuite) SetupTest() {
+	// HKD -> USD: 1 HKD = 0.125 USD (i.e., 1 USD = 8 HKD)
+	fxService := services.NewMockFXService()
+	fxService.SetRate("HKD", "USD", 0.125)
+	s.setup = NewTestSetupWithFXService(s.T(), fxService)
+
+	var err error
+	s.invoiceID, err = s.setup.CreateTestInvoice("Limits", nil, nil)
+	s.Require().NoError(err)
+}
+
+func (s *ItemLimitsTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// addItem adds an item through the API and returns the response status
+func (s *ItemLimitsTestSuite) addItem(item map[string]interface{}) int {
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/invoices/%d/items", s.invoiceID), item)
+	s.Require().NoError(err)
+	return resp.StatusCode
+}
+
+func (s *ItemLimitsTestSuite) TestDefaultLimits() {
+	resp, err := s.setup.MakeRequest("GET", "/api/settings", nil)
+	s.Require().NoError(err)
+	settings, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(services.DefaultMaxItemUnitPrice), settings["max_item_unit_price"])
+	s.Equal(float64(services.DefaultMaxItemQuantity), settings["max_item_quantity"])
+	s.Equal(float64(services.DefaultMaxItemLineAmount), settings["max_item_line_amount"])
+
+	// The limits are inclusive and apply to the magnitude
+	s.Equal(http.StatusCreated, s.addItem(map[string]interface{}{"description": "At limit", "unit_price": 10_000_000}))
+	s.Equal(http.StatusCreated, s.addItem(map[string]interface{}{"description": "Refund at limit", "unit_price": -10_000_000}))
+	s.Equal(http.StatusBadRequest, s.addItem(map[string]interface{}{"description": "Typo", "unit_price": 1_000_000_000}))
+	s.Equal(http.StatusBadRequest, s.addItem(map[string]interface{}{"description": "Typo", "unit_price": -10_000_000.01}))
+	s.Equal(http.StatusBadRequest, s.addItem(map[string]interface{}{"description": "Bulk", "quantity": 1_000_001, "unit_price": 0.01}))
+	s.Equal(http.StatusBadRequest, s.addItem(map[string]interface{}{"description": "Large line", "quantity": 11, "unit_price": 10_000_000}))
+
+	// Prices are compared in the base currency: 80,000,000 HKD is 10,000,000 USD
+	s.Equal(http.StatusCreated, s.addItem(map[string]interface{}{"description": "HKD", "unit_price": 80_000_000, "currency": "HKD"}))
+	s.Equal(http.StatusBadRequest, s.addItem(map[string]interface{}{"description": "HKD", "unit_price": 80_000_008, "currency": "HKD"}))
+
+	resp, err = s.setup.MakeRequest("POST", "/api/invoices", map[string]interface{}{
+		"title": "Typo",
+		"items": []map[string]interface{}{{"description": "Typo", "unit_price": 1_000_000_000}},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *ItemLimitsTestSuite) TestCustomLimits() {
+	resp, err := s.setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{
+		"base_currency":        "USD",
+		"max_item_unit_price":  500,
+		"max_item_line_amount": 1000,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	s.Equal(http.StatusCreated, s.addItem(map[string]interface{}{"description": "Laptop stand", "quantity": 2, "unit_price": 500}))
+	s.Equal(http.StatusBadRequest, s.addItem(map[string]interface{}{"description": "Laptop", "unit_price": 500.5}))
+	s.Equal(http.StatusBadRequest, s.addItem(map[string]interface{}{"description": "Stands", "quantity": 3, "unit_price": 400}))
+
+	// Updating an item is checked too
+	itemID, err := s.setup.CreateTestInvoiceItem(s.invoiceID, "Mouse", 1, 20)
+	s.Require().NoError(err)
+	resp, err = s.setup.MakeRequest("PUT", fmt.Sprintf("/api/invoices/%d/items/%d", s.invoiceID, itemID), map[string]interface{}{
+		"description": "Mouse",
+		"unit_price":  2000,
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	// Lowering a limit doesn't lock existing items: edits that keep the amounts are accepted
+	itemID, err = s.setup.CreateTestInvoiceItem(s.invoiceID, "Keyboard", 1, 400)
+	s.Require().NoError(err)
+	resp, err = s.setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{
+		"base_currency":       "USD",
+		"max_item_unit_price": 100,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	resp, err = s.setup.MakeRequest("PUT", fmt.Sprintf("/api/invoices/%d/items/%d", s.invoiceID, itemID), map[string]interface{}{
+		"description": "Wireless keyboard",
+		"quantity":    1,
+		"unit_price":  400,
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	// 0 resets a limit to its default; negative limits are rejected
+	resp, err = s.setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{
+		"base_currency":       "USD",
+		"max_item_unit_price": 0,
+	})
+	s.Require().NoError(err)
+	settings, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(services.DefaultMaxItemUnitPrice), settings["max_item_unit_price"])
+	s.Equal(1000.0, settings["max_item_line_amount"])
+
+	resp, err = s.setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{
+		"base_currency":     "USD",
+		"max_item_quantity": -1,
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *ItemLimitsTestSuite) TestRejectsNonFiniteValues() {
+	// Numbers too large for a float64 are rejected while decoding the request
+	req := httptest.NewRequest("POST", fmt.Sprintf("/api/invoices/%d/items", s.invoiceID),
+		strings.NewReader(`{"description": "Overflow", "unit_price": 1e400}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Test-User-ID", s.setup.TestUserID)
+	resp, err := s.setup.App.Test(req, -1)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	// Callers that bypass JSON decoding are checked by the service
+	for _, item := range []models.InvoiceItem{
+		{Description: "NaN price", Quantity: 1, UnitPrice: math.NaN()},
+		{Description: "Inf price", Quantity: 1, UnitPrice: math.Inf(1)},
+		{Description: "NaN quantity", Quantity: math.NaN(), UnitPrice: 1},
+		{Description: "Inf quantity", Quantity: math.Inf(-1), UnitPrice: 1},
+		{Description: "NaN discount", Quantity: 1, UnitPrice: 1, DiscountType: models.DiscountTypeFixed, DiscountValue: math.NaN()},
+	} {
+		err := s.setup.InvoiceService.AddInvoiceItem(s.setup.TestUserID, s.invoiceID, &item)
+		s.Error(err, item.Description)
+	}
+
+	invoice, err := s.setup.InvoiceService.GetInvoiceByID(s.setup.TestUserID, s.invoiceID)
+	s.Require().NoError(err)
+	s.Empty(invoice.Items)
+
+	// Edits are still checked for non-finite values
+	itemID, err := s.setup.CreateTestInvoiceItem(s.invoiceID, "Mouse", 1, 20)
+	s.Require().NoError(err)
+	err = s.setup.InvoiceService.UpdateInvoiceItem(s.setup.TestUserID, itemID,
+		&models.InvoiceItem{Description: "Mouse", Quantity: 1, UnitPrice: math.Inf(1)}, nil, false)
+	s.Error(err)
+}
+
+func TestItemLimitsSuite(t *testing.T) {
+	suite.Run(t, new(ItemLimitsTestSuite))
+}
@@ -0,0 +1,160 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type JobManagerTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *JobManagerTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *JobManagerTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// TestWaitsForRunningJob verifies Shutdown waits for a job finishing its work after cancellation
+func (s *JobManagerTestSuite) TestWaitsForRunningJob() {
+	jobs := services.NewJobManager(5 * time.Second)
+	started := make(chan struct{})
+	finished := false
+	s.True(jobs.Go(func(ctx context.Context) {
+		close(started)
+		<-ctx.Done()
+		// Finish the work in progress
+		time.Sleep(200 * time.Millisecond)
+		finished = true
+	}))
+	<-started
+	s.Equal(int64(1), jobs.Running())
+
+	begin := time.Now()
+	s.Require().NoError(jobs.Shutdown())
+	s.True(finished)
+	s.GreaterOrEqual(time.Since(begin), 200*time.Millisecond)
+	s.Zero(jobs.Running())
+
+	// Nothing starts once shutdown has begun
+	s.False(jobs.Go(func(ctx context.Context) { s.Fail("job started after shutdown") }))
+}
+
+// TestDrainTimeout verifies Shutdown gives up on a job that outlives the drain timeout
+func (s *JobManagerTestSuite) TestDrainTimeout() {
+	jobs := services.NewJobManager(100 * time.Millisecond)
+	release := make(chan struct{})
+	defer close(release)
+	s.True(jobs.Go(func(ctx context.Context) { <-release }))
+
+	begin := time.Now()
+	err := jobs.Shutdown()
+	s.ErrorIs(err, services.ErrJobDrainTimeout)
+	s.GreaterOrEqual(time.Since(begin), 100*time.Millisecond)
+	s.Less(time.Since(begin), 2*time.Second)
+	s.Equal(int64(1), jobs.Running())
+}
+
+// TestExportRefusedAfterShutdown verifies an export requested while draining is failed instead of started
+func (s *JobManagerTestSuite) TestExportRefusedAfterShutdown() {
+	jobs := services.NewJobManager(time.Second)
+	s.Require().NoError(jobs.Shutdown())
+	db := s.setup.DBService.GetDB()
+	exportService := services.NewExportService(db, services.NewBackupService(db), s.setup.UploadService, jobs)
+
+	_, err := exportService.RequestExport(s.setup.TestUserID)
+	s.Error(err)
+	var job models.ExportJob
+	s.Require().NoError(db.Where("user_id = ?", s.setup.TestUserID).First(&job).Error)
+	s.Equal(models.ExportJobFailed, job.Status)
+}
+
+// TestFailsOnlyExpiredExportLeases verifies interrupted exports are failed only once the lease of
+// the instance running them has expired, so other instances' exports keep running
+func (s *JobManagerTestSuite) TestFailsOnlyExpiredExportLeases() {
+	db := s.setup.DBService.GetDB()
+	exportService := services.NewExportService(db, services.NewBackupService(db), s.setup.UploadService,
+		services.NewJobManager(time.Second))
+
+	live := time.Now().Add(time.Minute)
+	expired := time.Now().Add(-time.Minute)
+	jobs := []models.ExportJob{
+		{UserID: "other-user", Status: models.ExportJobRunning, Owner: "other-instance", LeaseExpiresAt: &live},
+		{UserID: "other-user", Status: models.ExportJobRunning, Owner: "stopped-instance", LeaseExpiresAt: &expired},
+		{UserID: s.setup.TestUserID, Status: models.ExportJobPending},
+	}
+	s.Require().NoError(db.Create(&jobs).Error)
+
+	s.Require().NoError(exportService.FailInterruptedJobs())
+	for i, want := range []models.ExportJobStatus{models.ExportJobRunning, models.ExportJobFailed, models.ExportJobFailed} {
+		var job models.ExportJob
+		s.Require().NoError(db.First(&job, jobs[i].ID).Error)
+		s.Equal(want, job.Status, i)
+	}
+}
+
+// TestExpiresOldExportBundles verifies bundles past the TTL are deleted and their jobs expired
+func (s *JobManagerTestSuite) TestExpiresOldExportBundles() {
+	db := s.setup.DBService.GetDB()
+	exportService := services.NewExportService(db, services.NewBackupService(db), s.setup.UploadService,
+		services.NewJobManager(time.Second))
+
+	ctx := context.Background()
+	var keys []string
+	for _, name := range []string{"old.zip", "recent.zip"} {
+		key, err := s.setup.UploadService.UploadFile(ctx, s.setup.TestUserID, name, []byte("bundle"), "application/zip")
+		s.Require().NoError(err)
+		keys = append(keys, key)
+	}
+	old := time.Now().Add(-8 * 24 * time.Hour)
+	recent := time.Now().Add(-time.Hour)
+	jobs := []models.ExportJob{
+		{UserID: s.setup.TestUserID, Status: models.ExportJobCompleted, S3Key: keys[0], CompletedAt: &old},
+		{UserID: s.setup.TestUserID, Status: models.ExportJobCompleted, S3Key: keys[1], CompletedAt: &recent},
+	}
+	s.Require().NoError(db.Create(&jobs).Error)
+
+	expiredCount, err := exportService.ExpireBundles(ctx, services.DefaultExportBundleTTL)
+	s.Require().NoError(err)
+	s.Equal(1, expiredCount)
+
+	detail, err := exportService.GetExportJob(ctx, s.setup.TestUserID, jobs[0].ID)
+	s.Require().NoError(err)
+	s.Equal(models.ExportJobExpired, detail.Status)
+	s.Empty(detail.DownloadURL)
+	_, _, err = s.setup.UploadService.DownloadFile(ctx, keys[0], 1<<20)
+	s.Error(err)
+
+	detail, err = exportService.GetExportJob(ctx, s.setup.TestUserID, jobs[1].ID)
+	s.Require().NoError(err)
+	s.Equal(models.ExportJobCompleted, detail.Status)
+	_, _, err = s.setup.UploadService.DownloadFile(ctx, keys[1], 1<<20)
+	s.NoError(err)
+}
+
+// TestReminderRunStopsOnShutdown verifies an overdue reminder run starts no digest once cancelled
+func (s *JobManagerTestSuite) TestReminderRunStopsOnShutdown() {
+	db := s.setup.DBService.GetDB()
+	s.Require().NoError(db.Create(&models.UserSettings{UserID: s.setup.TestUserID, Email: "billing@example.com"}).Error)
+	notificationService := services.NewNotificationService(services.SMTPConfig{
+		Host: "smtp.example.com",
+		From: "invoices@example.com",
+	}, services.NewSettingsService(db))
+	job := services.NewOverdueReminderJob(db, s.setup.InvoiceService, notificationService)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	s.ErrorIs(job.RunOnce(ctx), context.Canceled)
+}
+
+func TestJobManagerSuite(t *testing.T) {
+	suite.Run(t, new(JobManagerTestSuite))
+}
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/invoice-management/internal/tools"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+// MCPArgsTestSuite tests how MCP tools parse numeric arguments
+type MCPArgsTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *MCPArgsTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *MCPArgsTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// call runs a tool handler as the test user and returns the result text
+func (s *MCPArgsTestSuite) call(handler server.ToolHandlerFunc, args map[string]interface{}) (string, bool) {
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: s.setup.TestUserID})
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = args
+	result, err := handler(ctx, request)
+	s.Require().NoError(err)
+	s.Require().Len(result.Content, 1)
+	text, ok := result.Content[0].(mcp.TextContent)
+	s.Require().True(ok)
+	return text.Text, result.IsError
+}
+
+// listInvoiceIDs lists invoices through the list_invoices tool and returns their IDs
+func (s *MCPArgsTestSuite) listInvoiceIDs(args map[string]interface{}) []uint {
+	text, isError := s.call(tools.NewListInvoicesTool(s.setup.InvoiceService).GetHandler(), args)
+	s.Require().False(isError, text)
+	var page struct {
+		Data []struct {
+			ID uint `json:"id"`
+		} `json:"data"`
+	}
+	s.Require().NoError(json.Unmarshal([]byte(text), &page))
+	ids := make([]uint, 0, len(page.Data))
+	for _, invoice := range page.Data {
+		ids = append(ids, invoice.ID)
+	}
+	return ids
+}
+
+func (s *MCPArgsTestSuite) TestNumericStrings() {
+	categoryID, err := s.setup.CreateTestCategory("Utilities")
+	s.Require().NoError(err)
+	inCategory, err := s.setup.CreateTestInvoiceWithStatus("Electricity", &categoryID, nil, "paid", 120)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceWithStatus("Groceries", nil, nil, "paid", 80)
+	s.Require().NoError(err)
+
+	for _, value := range []interface{}{float64(categoryID), uintToString(categoryID), " " + uintToString(categoryID) + " ", json.Number(uintToString(categoryID))} {
+		s.Equal([]uint{inCategory}, s.listInvoiceIDs(map[string]interface{}{"category_id": value}), value)
+	}
+	s.Equal([]uint{inCategory}, s.listInvoiceIDs(map[string]interface{}{"min_amount": "100"}))
+	s.Len(s.listInvoiceIDs(map[string]interface{}{"limit": "1"}), 1)
+
+	// An empty string is treated as a missing argument
+	s.Len(s.listInvoiceIDs(map[string]interface{}{"category_id": ""}), 2)
+}
+
+func (s *MCPArgsTestSuite) TestInvalidValues() {
+	listInvoices := tools.NewListInvoicesTool(s.setup.InvoiceService).GetHandler()
+	for _, args := range []map[string]interface{}{
+		{"category_id": "five"},
+		{"category_id": "1.5"},
+		{"category_id": -1},
+		{"category_id": true},
+		{"min_amount": "lots"},
+		{"limit": "NaN"},
+	} {
+		text, isError := s.call(listInvoices, args)
+		s.True(isError, args)
+		s.Contains(text, "Invalid ", args)
+	}
+
+	text, isError := s.call(tools.NewGetInvoiceTool(s.setup.InvoiceService).GetHandler(), map[string]interface{}{"invoice_id": "abc"})
+	s.True(isError)
+	s.Equal("Invalid invoice_id 'abc': must be a non-negative integer", text)
+
+	text, isError = s.call(tools.NewBulkTagInvoicesTool(s.setup.InvoiceService).GetHandler(), map[string]interface{}{
+		"tag_ids": []interface{}{float64(1), "x"},
+	})
+	s.True(isError)
+	s.Contains(text, "Invalid tag_ids 'x'")
+
+	text, isError = s.call(tools.NewAddInvoiceItemsTool(s.setup.InvoiceService).GetHandler(), map[string]interface{}{
+		"invoice_id": "1",
+		"items":      []interface{}{map[string]interface{}{"description": "Item", "unit_price": "ten"}},
+	})
+	s.True(isError)
+	s.Equal("item 1: Invalid unit_price 'ten': must be a number", text)
+
+	text, isError = s.call(tools.NewInvoiceStatisticsTool(s.setup.AnalyticsService).GetHandler(), map[string]interface{}{"days": "a week"})
+	s.True(isError)
+	s.Contains(text, "Invalid days")
+
+	text, isError = s.call(tools.NewInvoiceStatisticsTool(s.setup.AnalyticsService).GetHandler(), map[string]interface{}{
+		"start_date": "2023-03-01", "end_date": "2023-03-31T23:59:59Z",
+	})
+	s.True(isError)
+	s.Contains(text, "Invalid start_date '2023-03-01': must be an RFC3339 timestamp")
+
+	text, isError = s.call(tools.NewInvoiceStatisticsTool(s.setup.AnalyticsService).GetHandler(), map[string]interface{}{
+		"start_date": "2023-03-01T00:00:00Z", "end_date": "2023-03-31T23:59:59Z",
+	})
+	s.False(isError, text)
+	s.Contains(text, `"period":"custom"`)
+	s.Contains(text, `"start_date":"2023-03-01T00:00:00Z"`)
+}
+
+func TestMCPArgsSuite(t *testing.T) {
+	suite.Run(t, new(MCPArgsTestSuite))
+}
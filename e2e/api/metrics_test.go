@@ -0,0 +1,62 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/rxtech-lab/invoice-management/internal/metrics"
+	"github.com/stretchr/testify/suite"
+)
+
+type MetricsTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *MetricsTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *MetricsTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+func (s *MetricsTestSuite) scrape() string {
+	// /metrics is public, so no user header is sent
+	resp, err := s.setup.MakeAuthenticatedRequest("GET", "/metrics", nil, "")
+	s.Require().NoError(err)
+	body, err := io.ReadAll(resp.Body)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode, string(body))
+	return string(body)
+}
+
+func (s *MetricsTestSuite) TestExposesRequestAndInvoiceMetrics() {
+	created := testutil.ToFloat64(metrics.InvoicesCreatedTotal)
+
+	categoryID, err := s.setup.CreateTestCategory("Utilities")
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoice("Electricity", &categoryID, nil)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("GET", "/api/categories/999999", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusNotFound, resp.StatusCode)
+
+	s.Equal(created+1, testutil.ToFloat64(metrics.InvoicesCreatedTotal))
+
+	body := s.scrape()
+	// Requests are labeled by route pattern, not by the raw path
+	s.Contains(body, `invoice_management_http_requests_total{method="GET",route="/api/categories/:id",status="404"}`)
+	s.NotContains(body, "/api/categories/999999")
+	s.Contains(body, "invoice_management_http_request_duration_seconds_bucket")
+	s.Contains(body, "invoice_management_invoices_created_total")
+	s.Contains(body, "invoice_management_fx_cache_hits_total")
+	s.Contains(body, "invoice_management_db_open_connections")
+}
+
+func TestMetricsSuite(t *testing.T) {
+	suite.Run(t, new(MetricsTestSuite))
+}
@@ -0,0 +1,128 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type NegativeItemsTestSuite struct {
+	suite.Suite
+	setup     *TestSetup
+	fxService *services.MockFXService
+}
+
+func (s *NegativeItemsTestSuite) SetupTest() {
+	// HKD -> USD: 1 HKD = 0.125 USD (i.e., 1 USD = 8 HKD)
+	s.fxService = services.NewMockFXService()
+	s.fxService.SetRate("HKD", "USD", 0.125)
+
+	s.setup = NewTestSetupWithFXService(s.T(), s.fxService)
+}
+
+func (s *NegativeItemsTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// createInvoice creates an invoice through the API and returns the response body
+func (s *NegativeItemsTestSuite) createInvoice(body map[string]interface{}) map[string]interface{} {
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices", body)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	return invoice
+}
+
+func (s *NegativeItemsTestSuite) TestAdjustmentLineTotalsNet() {
+	invoice := s.createInvoice(map[string]interface{}{
+		"title":    "Consulting",
+		"currency": "HKD",
+		"items": []map[string]interface{}{
+			{"description": "Consulting", "quantity": 10, "unit_price": 80},
+			{"description": "Goodwill adjustment", "quantity": 1, "unit_price": -160},
+			{"description": "Returned hours", "quantity": -2, "unit_price": 80},
+		},
+	})
+
+	s.Equal([]interface{}{800.0, -160.0, -160.0}, itemField(invoice, "amount"))
+	s.Equal([]interface{}{100.0, -20.0, -20.0}, itemField(invoice, "target_amount"))
+	s.Equal(480.0, invoice["amount"])
+	s.Equal(60.0, invoice["target_amount"])
+}
+
+func (s *NegativeItemsTestSuite) TestNegativeInvoiceTotal() {
+	invoice := s.createInvoice(map[string]interface{}{
+		"title":    "Refund",
+		"currency": "HKD",
+		"items": []map[string]interface{}{
+			{"description": "Refund", "quantity": 1, "unit_price": -400, "discount_type": "fixed", "discount_value": 80},
+		},
+	})
+
+	// A fixed discount shrinks a refund line towards zero
+	s.Equal(-320.0, invoice["amount"])
+	s.Equal(-40.0, invoice["target_amount"])
+
+	// Adding a line through the items endpoint keeps the negative total
+	id := int(invoice["id"].(float64))
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/invoices/%d/items", id), map[string]interface{}{
+		"description": "Restocking fee",
+		"quantity":    1,
+		"unit_price":  40,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/invoices/%d", id), nil)
+	s.Require().NoError(err)
+	invoice, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(-280.0, invoice["amount"])
+	s.Equal(-35.0, invoice["target_amount"])
+
+	// A second refund of the same amount is detected as a duplicate
+	duplicate := s.createInvoice(map[string]interface{}{
+		"title":    "Refund again",
+		"currency": "HKD",
+		"items": []map[string]interface{}{
+			{"description": "Refund", "quantity": 2, "unit_price": -140},
+		},
+	})
+	s.Equal(float64(id), duplicate["id"])
+}
+
+func (s *NegativeItemsTestSuite) TestZeroQuantity() {
+	invoice := s.createInvoice(map[string]interface{}{
+		"title":    "Quote",
+		"currency": "USD",
+		"items": []map[string]interface{}{
+			{"description": "Free sample", "quantity": 0, "unit_price": 0},
+			{"description": "Setup", "quantity": 0, "unit_price": 25},
+			{"description": "Support", "unit_price": 10},
+		},
+	})
+
+	// An explicit 0 is kept for a zero-priced line; a priced line defaults to 1
+	s.Equal([]interface{}{0.0, 1.0, 1.0}, itemField(invoice, "quantity"))
+	s.Equal(35.0, invoice["amount"])
+
+	resp, err := s.setup.MakeRequest("POST", fmt.Sprintf("/api/invoices/%d/items", int(invoice["id"].(float64))), map[string]interface{}{
+		"description": "Placeholder",
+		"quantity":    0,
+		"unit_price":  0,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	item, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(0.0, item["quantity"])
+}
+
+func TestNegativeItemsSuite(t *testing.T) {
+	suite.Run(t, new(NegativeItemsTestSuite))
+}
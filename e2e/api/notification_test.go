@@ -0,0 +1,132 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+// sentMail is a message captured by the stub SMTP sender
+type sentMail struct {
+	addr string
+	from string
+	to   []string
+	msg  string
+}
+
+type NotificationTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+	sent  []sentMail
+}
+
+func (s *NotificationTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+	s.sent = nil
+}
+
+func (s *NotificationTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// newJob builds an overdue reminder job that captures mail instead of sending it
+func (s *NotificationTestSuite) newJob(config services.SMTPConfig) *services.OverdueReminderJob {
+	config.SendMail = func(addr string, auth smtp.Auth, from string, to []string, msg []byte) error {
+		s.sent = append(s.sent, sentMail{addr: addr, from: from, to: to, msg: string(msg)})
+		return nil
+	}
+	db := s.setup.DBService.GetDB()
+	notificationService := services.NewNotificationService(config, services.NewSettingsService(db))
+	return services.NewOverdueReminderJob(db, s.setup.InvoiceService, notificationService)
+}
+
+// createInvoiceDue creates an unpaid invoice with one item, due the given number of days from now
+func (s *NotificationTestSuite) createInvoiceDue(title string, days int, unitPrice float64) {
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices", map[string]interface{}{
+		"title":    title,
+		"currency": "USD",
+		"due_date": time.Now().Add(time.Duration(days) * 24 * time.Hour).Format(time.RFC3339),
+		"items": []map[string]interface{}{
+			{"description": "Service", "quantity": 1, "unit_price": unitPrice},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+}
+
+func (s *NotificationTestSuite) setEmail(email string) *http.Response {
+	resp, err := s.setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{
+		"base_currency": "USD",
+		"email":         email,
+	})
+	s.Require().NoError(err)
+	return resp
+}
+
+func (s *NotificationTestSuite) TestSendsOneDigestPerUser() {
+	s.Require().Equal(http.StatusOK, s.setEmail("billing@example.com").StatusCode)
+	s.createInvoiceDue("Hosting", -3, 50)
+	s.createInvoiceDue("Consulting", -10, 200)
+	s.createInvoiceDue("Not Yet Due", 5, 75)
+
+	job := s.newJob(services.SMTPConfig{Host: "smtp.example.com", From: "invoices@example.com"})
+	s.Require().NoError(job.RunOnce(context.Background()))
+
+	s.Require().Len(s.sent, 1)
+	mail := s.sent[0]
+	s.Equal("smtp.example.com:587", mail.addr)
+	s.Equal("invoices@example.com", mail.from)
+	s.Equal([]string{"billing@example.com"}, mail.to)
+	s.Contains(mail.msg, "Subject: You have 2 overdue invoices")
+	s.Contains(mail.msg, "Consulting: 200.00 USD")
+	s.Contains(mail.msg, "(10 days overdue)")
+	s.Contains(mail.msg, "Hosting: 50.00 USD")
+	s.Contains(mail.msg, "- 250.00 USD")
+	s.NotContains(mail.msg, "Not Yet Due")
+
+	// Most overdue first
+	s.Less(strings.Index(mail.msg, "Consulting"), strings.Index(mail.msg, "Hosting"))
+}
+
+func (s *NotificationTestSuite) TestSkipsWhenNotConfigured() {
+	s.Require().Equal(http.StatusOK, s.setEmail("billing@example.com").StatusCode)
+	s.createInvoiceDue("Hosting", -3, 50)
+
+	s.Require().NoError(s.newJob(services.SMTPConfig{}).RunOnce(context.Background()))
+	s.Empty(s.sent)
+}
+
+func (s *NotificationTestSuite) TestSkipsUsersWithoutEmail() {
+	s.createInvoiceDue("Hosting", -3, 50)
+
+	s.Require().NoError(s.newJob(services.SMTPConfig{Host: "smtp.example.com", From: "invoices@example.com"}).RunOnce(context.Background()))
+	s.Empty(s.sent)
+}
+
+func (s *NotificationTestSuite) TestEmailSetting() {
+	resp := s.setEmail("not-an-email")
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	resp = s.setEmail(" billing@example.com ")
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	settings, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("billing@example.com", settings["email"])
+
+	// Clearing the email turns reminders off
+	resp = s.setEmail("")
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	settings, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Nil(settings["email"])
+}
+
+func TestNotificationTestSuite(t *testing.T) {
+	suite.Run(t, new(NotificationTestSuite))
+}
@@ -0,0 +1,311 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	organizationMemberUser = "org-member-user"
+	organizationViewerUser = "org-viewer-user"
+	organizationOutsider   = "org-outsider-user"
+)
+
+type OrganizationTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *OrganizationTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *OrganizationTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// request makes a request as userID and returns the status code and decoded body
+func (s *OrganizationTestSuite) request(userID, method, path string, body interface{}) (int, map[string]interface{}) {
+	resp, err := s.setup.MakeAuthenticatedRequest(method, path, body, userID)
+	s.Require().NoError(err)
+	if resp.StatusCode == http.StatusNoContent {
+		return resp.StatusCode, nil
+	}
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	return resp.StatusCode, result
+}
+
+// createOrganization creates an organization owned by the test user, with a member and a viewer.
+// Only users who have used the service can be added, so the member, the viewer, and the outsider
+// first list their organizations.
+func (s *OrganizationTestSuite) createOrganization() uint {
+	for _, userID := range []string{organizationMemberUser, organizationViewerUser, organizationOutsider} {
+		resp, err := s.setup.MakeAuthenticatedRequest("GET", "/api/organizations", nil, userID)
+		s.Require().NoError(err)
+		s.Require().Equal(http.StatusOK, resp.StatusCode)
+	}
+
+	status, organization := s.request(s.setup.TestUserID, "POST", "/api/organizations", map[string]interface{}{"name": "Team"})
+	s.Require().Equal(http.StatusCreated, status)
+	s.Equal("owner", organization["role"])
+	id := uint(organization["id"].(float64))
+
+	for userID, role := range map[string]string{organizationMemberUser: "member", organizationViewerUser: "viewer"} {
+		status, member := s.request(s.setup.TestUserID, "PUT", fmt.Sprintf("/api/organizations/%d/members/%s", id, userID),
+			map[string]interface{}{"role": role})
+		s.Require().Equal(http.StatusOK, status)
+		s.Equal(role, member["role"])
+	}
+	return id
+}
+
+// createInvoice creates an invoice as userID, in organizationID unless it is zero. Each title
+// gets its own amount so invoices aren't taken for duplicates.
+func (s *OrganizationTestSuite) createInvoice(userID, title string, organizationID uint) (int, map[string]interface{}) {
+	body := map[string]interface{}{
+		"title": title,
+		"items": []map[string]interface{}{{"description": title, "unit_price": len(title)}},
+	}
+	if organizationID != 0 {
+		body["organization_id"] = organizationID
+	}
+	return s.request(userID, "POST", "/api/invoices", body)
+}
+
+// listedTitles returns the titles of the invoices listed for userID at path
+func (s *OrganizationTestSuite) listedTitles(userID, path string) []string {
+	status, result := s.request(userID, "GET", path, nil)
+	s.Require().Equal(http.StatusOK, status)
+	var titles []string
+	for _, invoice := range result["data"].([]interface{}) {
+		titles = append(titles, invoice.(map[string]interface{})["title"].(string))
+	}
+	return titles
+}
+
+func (s *OrganizationTestSuite) TestPersonalOrganization() {
+	status, invoice := s.createInvoice(s.setup.TestUserID, "Personal invoice", 0)
+	s.Require().Equal(http.StatusCreated, status)
+
+	personal, err := services.NewOrganizationService(s.setup.DBService.GetDB()).GetPersonalOrganization(s.setup.TestUserID)
+	s.Require().NoError(err)
+	s.Equal(float64(personal.ID), invoice["organization_id"])
+
+	resp, err := s.setup.MakeRequest("GET", "/api/organizations", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	organizations, err := s.setup.ReadResponseBodyArray(resp)
+	s.Require().NoError(err)
+	s.Require().Len(organizations, 1)
+	organization := organizations[0].(map[string]interface{})
+	s.Equal(services.PersonalOrganizationName, organization["name"])
+	s.Equal(s.setup.TestUserID, organization["personal_user_id"])
+	s.Equal("owner", organization["role"])
+}
+
+func (s *OrganizationTestSuite) TestSharedInvoices() {
+	organizationID := s.createOrganization()
+
+	status, _ := s.createInvoice(s.setup.TestUserID, "Own invoice", 0)
+	s.Require().Equal(http.StatusCreated, status)
+	status, shared := s.createInvoice(s.setup.TestUserID, "Shared invoice", organizationID)
+	s.Require().Equal(http.StatusCreated, status)
+	sharedPath := fmt.Sprintf("/api/invoices/%d", int(shared["id"].(float64)))
+
+	// Every member sees the organization's invoices in its context, but not the creator's other
+	// invoices; without an organization, listings only cover the user's own invoices
+	organizationPath := fmt.Sprintf("/api/invoices?organization_id=%d", organizationID)
+	for _, userID := range []string{organizationMemberUser, organizationViewerUser} {
+		status, invoice := s.request(userID, "GET", sharedPath, nil)
+		s.Require().Equal(http.StatusOK, status)
+		s.Equal(s.setup.TestUserID, invoice["user_id"])
+		s.Equal([]string{"Shared invoice"}, s.listedTitles(userID, organizationPath))
+		s.Empty(s.listedTitles(userID, "/api/invoices"))
+	}
+	status, _ = s.request(organizationOutsider, "GET", sharedPath, nil)
+	s.Equal(http.StatusNotFound, status)
+	s.Empty(s.listedTitles(organizationOutsider, "/api/invoices"))
+	s.Empty(s.listedTitles(organizationOutsider, organizationPath))
+
+	// Only the creator changes an invoice
+	status, _ = s.request(organizationMemberUser, "PUT", sharedPath, map[string]interface{}{"title": "Taken over"})
+	s.Equal(http.StatusBadRequest, status)
+	status, invoice := s.request(s.setup.TestUserID, "PUT", sharedPath, map[string]interface{}{"title": "Renamed"})
+	s.Require().Equal(http.StatusOK, status)
+	s.Equal("Renamed", invoice["title"])
+
+	// Members create invoices in the organization, viewers and outsiders don't
+	status, _ = s.createInvoice(organizationMemberUser, "Member invoice", organizationID)
+	s.Equal(http.StatusCreated, status)
+	status, _ = s.createInvoice(organizationViewerUser, "Viewer invoice", organizationID)
+	s.Equal(http.StatusBadRequest, status)
+	status, _ = s.createInvoice(organizationOutsider, "Outsider invoice", organizationID)
+	s.Equal(http.StatusBadRequest, status)
+
+	s.ElementsMatch([]string{"Own invoice", "Renamed"}, s.listedTitles(s.setup.TestUserID, "/api/invoices"))
+	s.ElementsMatch([]string{"Renamed", "Member invoice"}, s.listedTitles(s.setup.TestUserID, organizationPath))
+	s.ElementsMatch([]string{"Renamed", "Member invoice"},
+		s.listedTitles(s.setup.TestUserID, organizationPath+"&keyword=e"))
+}
+
+func (s *OrganizationTestSuite) TestOrganizationTotalsInViewerCurrency() {
+	s.setup.Cleanup()
+	fxService := services.NewMockFXService()
+	fxService.SetRate("EUR", "USD", 2)
+	fxService.SetRate("USD", "EUR", 0.5)
+	s.setup = NewTestSetupWithFXService(s.T(), fxService)
+	organizationID := s.createOrganization()
+
+	// The member converts their items to EUR, the owner to USD
+	status, _ := s.request(organizationMemberUser, "PUT", "/api/settings", map[string]interface{}{"base_currency": "EUR"})
+	s.Require().Equal(http.StatusOK, status)
+	status, _ = s.request(organizationMemberUser, "POST", "/api/invoices", map[string]interface{}{
+		"title":           "Euro invoice",
+		"currency":        "EUR",
+		"organization_id": organizationID,
+		"items":           []map[string]interface{}{{"description": "Hotel", "unit_price": 10}},
+	})
+	s.Require().Equal(http.StatusCreated, status)
+	status, _ = s.createInvoice(s.setup.TestUserID, "Dollar invoice", organizationID) // 14 USD
+	s.Require().Equal(http.StatusCreated, status)
+
+	path := fmt.Sprintf("/api/invoices?organization_id=%d", organizationID)
+	status, result := s.request(s.setup.TestUserID, "GET", path, nil)
+	s.Require().Equal(http.StatusOK, status)
+	s.Equal(float64(2), result["total"])
+	s.Equal(float64(34), result["total_target_amount"]) // 14 USD + 10 EUR at 2
+
+	status, result = s.request(organizationMemberUser, "GET", path, nil)
+	s.Require().Equal(http.StatusOK, status)
+	s.Equal(float64(17), result["total_target_amount"]) // 14 USD at 0.5 + 10 EUR
+}
+
+func (s *OrganizationTestSuite) TestMembersShareAuditTrail() {
+	organizationID := s.createOrganization()
+	status, shared := s.createInvoice(s.setup.TestUserID, "Shared invoice", organizationID)
+	s.Require().Equal(http.StatusCreated, status)
+	auditPath := fmt.Sprintf("/api/invoices/%d/audit", int(shared["id"].(float64)))
+
+	status, result := s.request(organizationViewerUser, "GET", auditPath, nil)
+	s.Require().Equal(http.StatusOK, status)
+	s.Len(result["data"], 1)
+	status, _ = s.request(organizationOutsider, "GET", auditPath, nil)
+	s.Equal(http.StatusNotFound, status)
+}
+
+func (s *OrganizationTestSuite) TestSetMemberRequiresKnownUser() {
+	organizationID := s.createOrganization()
+	membersPath := fmt.Sprintf("/api/organizations/%d/members", organizationID)
+
+	status, result := s.request(s.setup.TestUserID, "PUT", membersPath+"/unknown-user", map[string]interface{}{"role": "member"})
+	s.Equal(http.StatusBadRequest, status)
+	s.Contains(result["error"], "user not found")
+
+	// Adding and removing members is audited
+	status, _ = s.request(s.setup.TestUserID, "PUT", membersPath+"/"+organizationOutsider, map[string]interface{}{"role": "viewer"})
+	s.Require().Equal(http.StatusOK, status)
+	status, _ = s.request(s.setup.TestUserID, "DELETE", membersPath+"/"+organizationOutsider, nil)
+	s.Require().Equal(http.StatusNoContent, status)
+
+	var entries []models.AuditLog
+	s.Require().NoError(s.setup.DBService.GetDB().
+		Where("entity_type = ?", models.AuditEntityOrganizationMember).
+		Order("id ASC").Find(&entries).Error)
+	s.Require().Len(entries, 4) // the member, the viewer, and the outsider's add and removal
+	s.Equal(models.AuditActionCreate, entries[2].Action)
+	s.Equal(models.AuditActionDelete, entries[3].Action)
+	s.Equal(s.setup.TestUserID, entries[3].ActorSub)
+	s.Contains(entries[3].Diff.String(), organizationOutsider)
+}
+
+func (s *OrganizationTestSuite) TestMembers() {
+	organizationID := s.createOrganization()
+	membersPath := fmt.Sprintf("/api/organizations/%d/members", organizationID)
+	ownerPath := fmt.Sprintf("%s/%s", membersPath, s.setup.TestUserID)
+
+	resp, err := s.setup.MakeAuthenticatedRequest("GET", membersPath, nil, organizationViewerUser)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	members, err := s.setup.ReadResponseBodyArray(resp)
+	s.Require().NoError(err)
+	s.Require().Len(members, 3)
+	s.Equal(s.setup.TestUserID, members[0].(map[string]interface{})["user_id"])
+
+	status, _ := s.request(organizationOutsider, "GET", membersPath, nil)
+	s.Equal(http.StatusNotFound, status)
+
+	// Only owners manage members
+	status, _ = s.request(organizationMemberUser, "PUT", membersPath+"/"+organizationOutsider, map[string]interface{}{"role": "member"})
+	s.Equal(http.StatusBadRequest, status)
+	status, _ = s.request(organizationMemberUser, "DELETE", membersPath+"/"+organizationViewerUser, nil)
+	s.Equal(http.StatusBadRequest, status)
+	status, _ = s.request(s.setup.TestUserID, "PUT", membersPath+"/"+organizationOutsider, map[string]interface{}{"role": "admin"})
+	s.Equal(http.StatusBadRequest, status)
+
+	// The last owner can't step down or leave
+	status, _ = s.request(s.setup.TestUserID, "PUT", ownerPath, map[string]interface{}{"role": "member"})
+	s.Equal(http.StatusBadRequest, status)
+	status, _ = s.request(s.setup.TestUserID, "DELETE", ownerPath, nil)
+	s.Equal(http.StatusBadRequest, status)
+
+	// Once another member is promoted, the original owner can leave
+	status, _ = s.request(s.setup.TestUserID, "PUT", membersPath+"/"+organizationMemberUser, map[string]interface{}{"role": "owner"})
+	s.Require().Equal(http.StatusOK, status)
+	status, _ = s.request(s.setup.TestUserID, "DELETE", ownerPath, nil)
+	s.Equal(http.StatusNoContent, status)
+
+	// Members leave on their own, and lose access to the organization's invoices
+	status, shared := s.createInvoice(organizationMemberUser, "Shared invoice", organizationID)
+	s.Require().Equal(http.StatusCreated, status)
+	status, _ = s.request(organizationViewerUser, "DELETE", membersPath+"/"+organizationViewerUser, nil)
+	s.Equal(http.StatusNoContent, status)
+	status, _ = s.request(organizationViewerUser, "GET", fmt.Sprintf("/api/invoices/%d", int(shared["id"].(float64))), nil)
+	s.Equal(http.StatusNotFound, status)
+}
+
+func (s *OrganizationTestSuite) TestMigrationCreatesPersonalOrganizations() {
+	path := filepath.Join(s.T().TempDir(), "invoices.db")
+	dbService, err := services.NewSqliteDBService(path)
+	s.Require().NoError(err)
+
+	for _, userID := range []string{"user-a", "user-a", "user-b"} {
+		s.Require().NoError(dbService.GetDB().Create(&models.Invoice{UserID: userID, Title: "Invoice", Status: models.InvoiceStatusUnpaid}).Error)
+	}
+	s.Require().NoError(dbService.GetDB().Exec("UPDATE invoices SET organization_id = NULL").Error)
+	s.Require().NoError(dbService.Close())
+
+	dbService, err = services.NewSqliteDBService(path)
+	s.Require().NoError(err)
+	defer dbService.Close()
+	db := dbService.GetDB()
+
+	organizationService := services.NewOrganizationService(db)
+	for _, userID := range []string{"user-a", "user-b"} {
+		personal, err := organizationService.GetPersonalOrganization(userID)
+		s.Require().NoError(err)
+
+		var invoices []models.Invoice
+		s.Require().NoError(db.Where("user_id = ?", userID).Find(&invoices).Error)
+		s.Require().NotEmpty(invoices)
+		for _, invoice := range invoices {
+			s.Require().NotNil(invoice.OrganizationID)
+			s.Equal(personal.ID, *invoice.OrganizationID)
+		}
+
+		members, err := organizationService.ListMembers(userID, personal.ID)
+		s.Require().NoError(err)
+		s.Require().Len(members, 1)
+		s.Equal(models.OrganizationRoleOwner, members[0].Role)
+	}
+}
+
+func TestOrganizationSuite(t *testing.T) {
+	suite.Run(t, new(OrganizationTestSuite))
+}
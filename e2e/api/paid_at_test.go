@@ -0,0 +1,138 @@
+package api
+
+import (
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type PaidAtTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *PaidAtTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *PaidAtTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// getInvoice fetches an invoice through the API
+func (s *PaidAtTestSuite) getInvoice(id uint) map[string]interface{} {
+	resp, err := s.setup.MakeRequest("GET", "/api/invoices/"+uintToString(id), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	return invoice
+}
+
+// setStatus changes an invoice status through the status endpoint
+func (s *PaidAtTestSuite) setStatus(id uint, status string) {
+	resp, err := s.setup.MakeRequest("PATCH", "/api/invoices/"+uintToString(id)+"/status", map[string]interface{}{"status": status})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+}
+
+func (s *PaidAtTestSuite) TestPaidAtFollowsStatus() {
+	id, err := s.setup.CreateTestInvoice("Internet", nil, nil)
+	s.Require().NoError(err)
+	s.NotContains(s.getInvoice(id), "paid_at")
+
+	s.setStatus(id, "paid")
+	paidAt := s.getInvoice(id)["paid_at"]
+	s.Require().NotNil(paidAt)
+
+	// Staying paid keeps the original payment time
+	s.setStatus(id, "paid")
+	s.Equal(paidAt, s.getInvoice(id)["paid_at"])
+	resp, err := s.setup.MakeRequest("PUT", "/api/invoices/"+uintToString(id), map[string]interface{}{"title": "Fiber internet"})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	s.Equal(paidAt, s.getInvoice(id)["paid_at"])
+
+	s.setStatus(id, "unpaid")
+	s.NotContains(s.getInvoice(id), "paid_at")
+
+	resp, err = s.setup.MakeRequest("PUT", "/api/invoices/"+uintToString(id), map[string]interface{}{"status": "paid"})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	s.NotNil(s.getInvoice(id)["paid_at"])
+
+	resp, err = s.setup.MakeRequest("POST", "/api/invoices", map[string]interface{}{"title": "Paid upfront", "status": "paid"})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	created, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.NotNil(created["paid_at"])
+}
+
+func (s *PaidAtTestSuite) TestSummaryByPaymentDate() {
+	// An invoice from three months ago that was only paid now
+	oldID, err := s.setup.CreateTestInvoiceOnDate("Old invoice", nil, nil, "unpaid", 300, DaysAgo(90))
+	s.Require().NoError(err)
+	s.setStatus(oldID, "paid")
+
+	// An invoice from this month that was paid two months ago, e.g. a prepaid subscription
+	prepaidID, err := s.setup.CreateTestInvoiceWithStatus("Prepaid", nil, nil, "paid", 50)
+	s.Require().NoError(err)
+	s.Require().NoError(s.setup.DBService.GetDB().Exec("UPDATE invoices SET paid_at = ? WHERE id = ?", DaysAgo(60), prepaidID).Error)
+
+	_, err = s.setup.CreateTestInvoiceWithStatus("Open", nil, nil, "unpaid", 20)
+	s.Require().NoError(err)
+
+	byInvoiceDate, err := s.setup.AnalyticsService.GetSummary(s.setup.TestUserID, services.Period1Month)
+	s.Require().NoError(err)
+	s.Equal(string(services.PaidByInvoiceDate), byInvoiceDate.PaidBy)
+	s.Equal(int64(1), byInvoiceDate.PaidCount)
+	s.Equal(50.0, byInvoiceDate.PaidAmount)
+	s.Equal(70.0, byInvoiceDate.TotalAmount)
+
+	resp, err := s.setup.MakeRequest("GET", "/api/analytics/summary?period=1m&paid_by=payment_date", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	byPaymentDate, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("payment_date", byPaymentDate["paid_by"])
+	s.Equal(1.0, byPaymentDate["paid_count"])
+	s.Equal(300.0, byPaymentDate["paid_amount"])
+	// The other buckets still use the invoice date
+	s.Equal(70.0, byPaymentDate["total_amount"])
+	s.Equal(20.0, byPaymentDate["unpaid_amount"])
+}
+
+func (s *PaidAtTestSuite) TestMigrationBackfillsPaidAt() {
+	path := filepath.Join(s.T().TempDir(), "invoices.db")
+	dbService, err := services.NewSqliteDBService(path)
+	s.Require().NoError(err)
+
+	updatedAt := DaysAgo(10).UTC().Truncate(time.Second)
+	paid := models.Invoice{UserID: s.setup.TestUserID, Title: "Paid before paid_at", Status: models.InvoiceStatusPaid, UpdatedAt: updatedAt}
+	unpaid := models.Invoice{UserID: s.setup.TestUserID, Title: "Unpaid", Status: models.InvoiceStatusUnpaid}
+	s.Require().NoError(dbService.GetDB().Create(&paid).Error)
+	s.Require().NoError(dbService.GetDB().Create(&unpaid).Error)
+	s.Require().NoError(dbService.GetDB().Exec("UPDATE invoices SET paid_at = NULL").Error)
+	s.Require().NoError(dbService.Close())
+
+	dbService, err = services.NewSqliteDBService(path)
+	s.Require().NoError(err)
+	defer dbService.Close()
+
+	var invoices []models.Invoice
+	s.Require().NoError(dbService.GetDB().Order("id").Find(&invoices).Error)
+	s.Require().Len(invoices, 2)
+	s.Require().NotNil(invoices[0].PaidAt)
+	s.True(updatedAt.Equal(*invoices[0].PaidAt))
+	s.Nil(invoices[1].PaidAt)
+}
+
+func TestPaidAtSuite(t *testing.T) {
+	suite.Run(t, new(PaidAtTestSuite))
+}
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type PaymentMethodTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *PaymentMethodTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+
+	for _, invoice := range []map[string]interface{}{
+		{"title": "Flights", "payment_method": "Amex Business", "items": []map[string]interface{}{{"description": "Flight", "unit_price": 400}}},
+		{"title": "Hotel", "payment_method": " Amex Business ", "items": []map[string]interface{}{{"description": "Hotel", "unit_price": 250}}},
+		{"title": "Rent", "payment_method": "Checking ****1234", "items": []map[string]interface{}{{"description": "Rent", "unit_price": 1000}}},
+		{"title": "Coffee", "items": []map[string]interface{}{{"description": "Coffee", "unit_price": 5}}},
+	} {
+		resp, err := s.setup.MakeRequest("POST", "/api/invoices", invoice)
+		s.Require().NoError(err)
+		s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	}
+}
+
+func (s *PaymentMethodTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// listTitles lists invoices with the given query and returns their titles
+func (s *PaymentMethodTestSuite) listTitles(query string) []string {
+	resp, err := s.setup.MakeRequest("GET", "/api/invoices?sort_by=amount&sort_order=asc&"+query, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	titles := []string{}
+	for _, invoice := range result["data"].([]interface{}) {
+		titles = append(titles, invoice.(map[string]interface{})["title"].(string))
+	}
+	return titles
+}
+
+func (s *PaymentMethodTestSuite) TestFilterByPaymentMethod() {
+	s.Equal([]string{"Hotel", "Flights"}, s.listTitles("payment_method=Amex%20Business"))
+	s.Equal([]string{"Rent"}, s.listTitles("payment_method=Checking%20****1234"))
+	s.Equal([]string{"Coffee"}, s.listTitles("payment_method="))
+	s.Len(s.listTitles(""), 4)
+}
+
+func (s *PaymentMethodTestSuite) TestListPaymentMethods() {
+	resp, err := s.setup.MakeRequest("GET", "/api/payment-methods", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal([]interface{}{"Amex Business", "Checking ****1234"}, result["data"])
+}
+
+func (s *PaymentMethodTestSuite) TestUpdatePaymentMethod() {
+	id, err := s.setup.CreateTestInvoice("Groceries", nil, nil)
+	s.Require().NoError(err)
+
+	path := "/api/invoices/" + uintToString(id)
+	resp, err := s.setup.MakeRequest("PUT", path, map[string]interface{}{"payment_method": "Visa"})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("Visa", invoice["payment_method"])
+
+	// Omitting the payment method keeps it
+	resp, err = s.setup.MakeRequest("PUT", path, map[string]interface{}{"title": "Weekly groceries"})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	invoice, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("Visa", invoice["payment_method"])
+
+	resp, err = s.setup.MakeRequest("PUT", path, map[string]interface{}{"payment_method": string(make([]byte, 101))})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *PaymentMethodTestSuite) TestStatisticsGroupedByPaymentMethod() {
+	stats, err := s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, services.StatisticsOptions{
+		Period:  services.PeriodLastWeek,
+		GroupBy: services.GroupByPaymentMethod,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(stats.Breakdown, 3)
+
+	s.Equal("Checking ****1234", stats.Breakdown[0].Name)
+	s.Equal(1000.0, stats.Breakdown[0].Amount)
+	s.Equal("Amex Business", stats.Breakdown[1].Name)
+	s.Equal(650.0, stats.Breakdown[1].Amount)
+	s.Equal(int64(2), stats.Breakdown[1].Count)
+	s.Equal(services.UnspecifiedPaymentMethod, stats.Breakdown[2].Name)
+	s.Equal(5.0, stats.Breakdown[2].Amount)
+}
+
+func TestPaymentMethodSuite(t *testing.T) {
+	suite.Run(t, new(PaymentMethodTestSuite))
+}
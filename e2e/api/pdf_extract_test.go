@@ -0,0 +1,192 @@
+package api
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type PDFExtractTestSuite struct {
+	suite.Suite
+	setup      *TestSetup
+	pdfService services.PDFService
+}
+
+func (s *PDFExtractTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+	s.pdfService = services.NewPDFService(services.PDFServiceConfig{Storage: s.setup.UploadService})
+}
+
+func (s *PDFExtractTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// buildPDF assembles a PDF from object bodies; object i+1 is objects[i].
+// Streams are given as [2]string{dict, data} and are Flate-compressed.
+func buildPDF(objects ...interface{}) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.7\n")
+	for i, object := range objects {
+		fmt.Fprintf(&buf, "%d 0 obj\n", i+1)
+		switch body := object.(type) {
+		case string:
+			buf.WriteString(body)
+		case [2]string:
+			var compressed bytes.Buffer
+			w := zlib.NewWriter(&compressed)
+			_, _ = w.Write([]byte(body[1]))
+			_ = w.Close()
+			fmt.Fprintf(&buf, "<<%s /Filter /FlateDecode /Length %d>>\nstream\n", body[0], compressed.Len())
+			buf.Write(compressed.Bytes())
+			buf.WriteString("\nendstream")
+		}
+		buf.WriteString("\nendobj\n")
+	}
+	buf.WriteString("trailer\n<</Root 1 0 R>>\n%%EOF\n")
+	return buf.Bytes()
+}
+
+func (s *PDFExtractTestSuite) upload(filename string, content []byte, contentType string) string {
+	key, err := s.setup.UploadService.UploadFile(context.Background(), s.setup.TestUserID, filename, content, contentType)
+	s.Require().NoError(err)
+	return key
+}
+
+func (s *PDFExtractTestSuite) TestExtractFields() {
+	content := `BT /F1 12 Tf 72 720 Td (Acme Supplies LLC) Tj
+0 -20 Td (Invoice Date: 2024-03-15) Tj
+0 -20 Td (Due Date: April 14, 2024) Tj
+0 -20 Td (Bill To:) Tj
+0 -20 Td (John Doe Inc.) Tj
+0 -20 Td (Subtotal 1,000.00) Tj
+0 -20 Td (Tax 80.00) Tj
+0 -20 Td [(Total Due) -500 (USD 1,080.00)] TJ ET`
+	pdf := buildPDF(
+		"<</Type /Catalog /Pages 2 0 R>>",
+		"<</Type /Pages /Kids [3 0 R] /Count 1>>",
+		"<</Type /Page /Parent 2 0 R /Resources <</Font <</F1 5 0 R>>>> /Contents 4 0 R>>",
+		[2]string{"", content},
+		"<</Type /Font /Subtype /Type1 /BaseFont /Helvetica>>",
+	)
+	key := s.upload("invoice.pdf", pdf, "application/pdf")
+
+	extracted, err := s.pdfService.ExtractFields(context.Background(), key)
+	s.Require().NoError(err)
+
+	s.Require().NotNil(extracted.TotalAmount)
+	s.Equal(1080.0, extracted.TotalAmount.Value)
+	s.Equal(0.9, extracted.TotalAmount.Confidence)
+
+	s.Require().NotNil(extracted.Currency)
+	s.Equal("USD", extracted.Currency.Value)
+
+	s.Require().NotNil(extracted.InvoiceDate)
+	s.Equal(time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), extracted.InvoiceDate.Value)
+
+	s.Require().NotNil(extracted.DueDate)
+	s.Equal(time.Date(2024, 4, 14, 0, 0, 0, 0, time.UTC), extracted.DueDate.Value)
+
+	// The customer in the "Bill To" block is not mistaken for the vendor
+	s.Require().NotNil(extracted.VendorName)
+	s.Equal("Acme Supplies LLC", extracted.VendorName.Value)
+	s.Contains(extracted.TextPreview, "Total Due")
+}
+
+func (s *PDFExtractTestSuite) TestExtractFieldsWithToUnicodeFont() {
+	// Two-byte glyph codes mapped through a ToUnicode CMap, as produced by browsers
+	hex := func(text string) string {
+		var out bytes.Buffer
+		for _, r := range text {
+			if r == '€' {
+				r = 0x80
+			}
+			fmt.Fprintf(&out, "%04X", r)
+		}
+		return "<" + out.String() + ">"
+	}
+	content := fmt.Sprintf("BT /C0 10 Tf 1 0 0 1 50 800 Tm %s Tj 1 0 0 1 50 780 Tm %s Tj 1 0 0 1 50 760 Tm %s Tj 1 0 0 1 300 760 Tm %s Tj ET",
+		hex("Beispiel GmbH"), hex("Datum: 31.01.2024"), hex("Gesamtbetrag / Total:"), hex("€250,50"))
+	cmap := `/CIDInit /ProcSet findresource begin 12 dict begin begincmap
+1 begincodespacerange <0000> <FFFF> endcodespacerange
+1 beginbfchar <0080> <20AC> endbfchar
+1 beginbfrange <0020> <007E> <0020> endbfrange
+endcmap end end`
+	pdf := buildPDF(
+		"<</Type /Catalog /Pages 2 0 R>>",
+		"<</Type /Pages /Kids [3 0 R] /Count 1>>",
+		"<</Type /Page /Parent 2 0 R /Resources <</Font <</C0 5 0 R>>>> /Contents 4 0 R>>",
+		[2]string{"", content},
+		"<</Type /Font /Subtype /Type0 /BaseFont /Arial /Encoding /Identity-H /ToUnicode 6 0 R>>",
+		[2]string{"", cmap},
+	)
+	key := s.upload("rechnung.pdf", pdf, "application/pdf")
+
+	extracted, err := s.pdfService.ExtractFields(context.Background(), key)
+	s.Require().NoError(err)
+
+	s.Require().NotNil(extracted.TotalAmount)
+	s.Equal(250.5, extracted.TotalAmount.Value)
+	s.Require().NotNil(extracted.Currency)
+	s.Equal("EUR", extracted.Currency.Value)
+	s.Require().NotNil(extracted.InvoiceDate)
+	s.Equal(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), extracted.InvoiceDate.Value)
+	s.Nil(extracted.DueDate)
+	s.Require().NotNil(extracted.VendorName)
+	s.Equal("Beispiel GmbH", extracted.VendorName.Value)
+}
+
+func (s *PDFExtractTestSuite) TestExtractFieldsRejectsNonPDF() {
+	key := s.upload("notes.txt", []byte("Total: $10.00"), "text/plain")
+	_, err := s.pdfService.ExtractFields(context.Background(), key)
+	s.ErrorIs(err, services.ErrNotPDF)
+
+	// The content is checked too, not only the declared type
+	key = s.upload("fake.pdf", []byte("<html>Total: $10.00</html>"), "application/pdf")
+	_, err = s.pdfService.ExtractFields(context.Background(), key)
+	s.ErrorIs(err, services.ErrNotPDF)
+}
+
+func (s *PDFExtractTestSuite) TestExtractFieldsWithoutTextLayer() {
+	pdf := buildPDF(
+		"<</Type /Catalog /Pages 2 0 R>>",
+		"<</Type /Pages /Kids [3 0 R] /Count 1>>",
+		"<</Type /Page /Parent 2 0 R /MediaBox [0 0 612 792]>>",
+	)
+	key := s.upload("scan.pdf", pdf, "application/pdf")
+
+	_, err := s.pdfService.ExtractFields(context.Background(), key)
+	s.ErrorIs(err, services.ErrNoPDFText)
+}
+
+// TestExtractFieldsRejectsDecompressionBomb verifies streams are only inflated up to the size limits
+func (s *PDFExtractTestSuite) TestExtractFieldsRejectsDecompressionBomb() {
+	bomb := buildPDF(
+		"<</Type /Catalog /Pages 2 0 R>>",
+		[2]string{"", strings.Repeat("0", services.MaxPDFStreamSize+1)},
+	)
+	s.Less(len(bomb), 64<<10)
+	key := s.upload("bomb.pdf", bomb, "application/pdf")
+	_, err := s.pdfService.ExtractFields(context.Background(), key)
+	s.ErrorIs(err, services.ErrPDFTooLarge)
+
+	// Streams under the per-stream limit still count towards the document's
+	stream := [2]string{"", strings.Repeat("0", services.MaxPDFStreamSize-1)}
+	objects := []interface{}{"<</Type /Catalog /Pages 2 0 R>>"}
+	for i := 0; i <= services.MaxPDFDecodedSize/services.MaxPDFStreamSize; i++ {
+		objects = append(objects, stream)
+	}
+	key = s.upload("bombs.pdf", buildPDF(objects...), "application/pdf")
+	_, err = s.pdfService.ExtractFields(context.Background(), key)
+	s.ErrorIs(err, services.ErrPDFTooLarge)
+}
+
+func TestPDFExtractSuite(t *testing.T) {
+	suite.Run(t, new(PDFExtractTestSuite))
+}
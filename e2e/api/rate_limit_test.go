@@ -0,0 +1,99 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/invoice-management/internal/api/middleware"
+	"github.com/stretchr/testify/suite"
+)
+
+// RateLimitSuite tests the per-user rate limiting middleware
+type RateLimitSuite struct {
+	suite.Suite
+	app *fiber.App
+}
+
+func (s *RateLimitSuite) SetupTest() {
+	s.app = fiber.New()
+	SetupTestAuthMiddleware(s.app)
+	s.app.Use(middleware.RateLimitMiddleware(2))
+
+	ok := func(c *fiber.Ctx) error {
+		return c.SendStatus(http.StatusOK)
+	}
+	s.app.Get("/health", ok)
+	s.app.Get("/.well-known/oauth-protected-resource", ok)
+	s.app.Get("/api/categories", ok)
+}
+
+func (s *RateLimitSuite) request(path, userID string) *http.Response {
+	req := httptest.NewRequest("GET", path, nil)
+	if userID != "" {
+		req.Header.Set("X-Test-User-ID", userID)
+	}
+	resp, err := s.app.Test(req, -1)
+	s.Require().NoError(err)
+	return resp
+}
+
+func (s *RateLimitSuite) TestLimitsPerUser() {
+	s.Equal(http.StatusOK, s.request("/api/categories", "user-a").StatusCode)
+	s.Equal(http.StatusOK, s.request("/api/categories", "user-a").StatusCode)
+
+	resp := s.request("/api/categories", "user-a")
+	s.Equal(http.StatusTooManyRequests, resp.StatusCode)
+	s.Equal("30", resp.Header.Get("Retry-After"))
+
+	// Other users have their own bucket
+	s.Equal(http.StatusOK, s.request("/api/categories", "user-b").StatusCode)
+}
+
+func (s *RateLimitSuite) TestUnauthenticatedRequestsLimitedByIP() {
+	s.Equal(http.StatusOK, s.request("/api/categories", "").StatusCode)
+	s.Equal(http.StatusOK, s.request("/api/categories", "").StatusCode)
+	s.Equal(http.StatusTooManyRequests, s.request("/api/categories", "").StatusCode)
+
+	// Authenticated users are not affected by the IP bucket
+	s.Equal(http.StatusOK, s.request("/api/categories", "user-a").StatusCode)
+}
+
+func (s *RateLimitSuite) TestSkipsHealthAndWellKnown() {
+	for i := 0; i < 5; i++ {
+		s.Equal(http.StatusOK, s.request("/health", "").StatusCode)
+		s.Equal(http.StatusOK, s.request("/.well-known/oauth-protected-resource", "").StatusCode)
+	}
+}
+
+func (s *RateLimitSuite) TestSweepsIdleClients() {
+	limiter := middleware.NewRateLimiter(2)
+	s.app = fiber.New()
+	SetupTestAuthMiddleware(s.app)
+	s.app.Use(limiter.Handler())
+	s.app.Get("/api/categories", func(c *fiber.Ctx) error {
+		return c.SendStatus(http.StatusOK)
+	})
+
+	s.request("/api/categories", "user-a")
+	s.request("/api/categories", "user-a")
+	s.request("/api/categories", "user-b")
+	s.Equal(2, limiter.Clients())
+
+	// Clients still refilling are kept
+	s.Zero(limiter.Sweep(time.Now().Add(30 * time.Second)))
+	s.Equal(2, limiter.Clients())
+
+	// Clients idle for the refill time are dropped, and start again with a full bucket
+	s.Equal(2, limiter.Sweep(time.Now().Add(2*time.Minute)))
+	s.Zero(limiter.Clients())
+	s.Equal(http.StatusOK, s.request("/api/categories", "user-a").StatusCode)
+	s.Equal(http.StatusOK, s.request("/api/categories", "user-a").StatusCode)
+	s.Equal(http.StatusTooManyRequests, s.request("/api/categories", "user-a").StatusCode)
+}
+
+func TestRateLimitSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitSuite))
+}
@@ -0,0 +1,125 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReceiverTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *ReceiverTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *ReceiverTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+func (s *ReceiverTestSuite) TestContactDetailsRoundTrip() {
+	resp, err := s.setup.MakeRequest("POST", "/api/receivers", map[string]interface{}{
+		"name":            "Acme Supplies",
+		"is_organization": true,
+		"email":           " billing@acme.example ",
+		"phone":           "+44 20 7946 0958",
+		"address":         "1 Market Street\nLondon EC1A 1AA",
+		"bank_account":    "GB33BUKB20201555555555",
+		"tax_id":          "GB123456789",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	created, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	id := uint(created["id"].(float64))
+
+	path := "/api/receivers/" + uintToString(id)
+	resp, err = s.setup.MakeRequest("GET", path, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	receiver, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("billing@acme.example", receiver["email"])
+	s.Equal("+44 20 7946 0958", receiver["phone"])
+	s.Equal("1 Market Street\nLondon EC1A 1AA", receiver["address"])
+	s.Equal("GB33BUKB20201555555555", receiver["bank_account"])
+	s.Equal("GB123456789", receiver["tax_id"])
+
+	// Omitted details are kept and empty ones cleared
+	resp, err = s.setup.MakeRequest("PUT", path, map[string]interface{}{"bank_account": "DE89370400440532013000", "phone": ""})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	receiver, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("DE89370400440532013000", receiver["bank_account"])
+	s.Equal("billing@acme.example", receiver["email"])
+	s.NotContains(receiver, "phone")
+
+	// The details are carried onto the receiver's statements
+	resp, err = s.setup.MakeRequest("GET", path+"/statement?start="+DaysAgo(30).Format(time.RFC3339)+"&end="+time.Now().Format(time.RFC3339), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	statement, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("DE89370400440532013000", statement["bank_account"])
+	s.Equal("GB123456789", statement["tax_id"])
+}
+
+func (s *ReceiverTestSuite) TestInvalidContactDetails() {
+	for _, body := range []map[string]interface{}{
+		{"name": "Bad email", "email": "not-an-email"},
+		{"name": "Display name", "email": "Acme <billing@acme.example>"},
+		{"name": "Bad phone", "phone": "call me"},
+		{"name": "Short phone", "phone": "+1 23"},
+		{"name": "Long tax ID", "tax_id": string(make([]byte, 101))},
+	} {
+		resp, err := s.setup.MakeRequest("POST", "/api/receivers", body)
+		s.Require().NoError(err)
+		s.Equal(http.StatusBadRequest, resp.StatusCode, body["name"])
+	}
+
+	id, err := s.setup.CreateTestReceiver("Valid", false)
+	s.Require().NoError(err)
+	resp, err := s.setup.MakeRequest("PUT", "/api/receivers/"+uintToString(id), map[string]interface{}{"email": "billing@"})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *ReceiverTestSuite) TestDeleteReceiverInUse() {
+	receiverID, err := s.setup.CreateTestReceiver("Power Co", true)
+	s.Require().NoError(err)
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices", map[string]interface{}{
+		"title":       "Electricity",
+		"receiver_id": receiverID,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	invoiceID := uint(invoice["id"].(float64))
+
+	err = s.setup.ReceiverService.DeleteReceiver(s.setup.TestUserID, receiverID, false)
+	var inUseErr *services.InUseError
+	s.Require().ErrorAs(err, &inUseErr)
+	s.Equal(int64(1), inUseErr.InvoiceCount)
+
+	// Deleted invoices don't block the deletion but lose the reference too
+	s.Require().NoError(s.setup.InvoiceService.DeleteInvoice(s.setup.TestUserID, invoiceID))
+	s.Require().NoError(s.setup.ReceiverService.DeleteReceiver(s.setup.TestUserID, receiverID, false))
+
+	var deleted models.Invoice
+	s.Require().NoError(s.setup.DBService.GetDB().Unscoped().First(&deleted, invoiceID).Error)
+	s.Nil(deleted.ReceiverID)
+
+	s.Error(s.setup.ReceiverService.DeleteReceiver(s.setup.TestUserID, receiverID, true))
+}
+
+func TestReceiverSuite(t *testing.T) {
+	suite.Run(t, new(ReceiverTestSuite))
+}
@@ -0,0 +1,160 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/rxtech-lab/invoice-management/internal/tools"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReconcileTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *ReconcileTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *ReconcileTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// reconcileDate returns midnight UTC of the given day of March 2024
+func reconcileDate(day int) time.Time {
+	return time.Date(2024, time.March, day, 0, 0, 0, 0, time.UTC)
+}
+
+// createInvoice creates an invoice for userID with a single item of the given price, billed and
+// due on dueDate so equal amounts aren't taken for duplicates
+func (s *ReconcileTestSuite) createInvoice(userID, title string, unitPrice float64, status models.InvoiceStatus, dueDate time.Time) uint {
+	result, err := s.setup.InvoiceService.CreateInvoice(userID, &models.Invoice{
+		Title:            title,
+		Currency:         "USD",
+		Status:           status,
+		DueDate:          &dueDate,
+		InvoiceStartedAt: &dueDate,
+		Items:            []models.InvoiceItem{{Description: title, Quantity: 1, UnitPrice: unitPrice}},
+	})
+	s.Require().NoError(err)
+	s.Require().False(result.IsDuplicate)
+	return result.Invoice.ID
+}
+
+func (s *ReconcileTestSuite) TestReconcile() {
+	userID := s.setup.TestUserID
+	rentID := s.createInvoice(userID, "Rent", 1500, models.InvoiceStatusUnpaid, reconcileDate(1))
+	s.createInvoice(userID, "Last year's rent", 1500, models.InvoiceStatusUnpaid, reconcileDate(1).AddDate(-1, 0, 0))
+	// Paid invoices are matched on the day they were paid
+	phoneID := s.createInvoice(userID, "Phone", 45.20, models.InvoiceStatusPaid, reconcileDate(1).AddDate(0, -1, 0))
+	s.Require().NoError(s.setup.DBService.GetDB().Model(&models.Invoice{}).Where("id = ?", phoneID).Update("paid_at", reconcileDate(5)).Error)
+	coffeeID := s.createInvoice(userID, "Coffee beans", 12.00, models.InvoiceStatusUnpaid, reconcileDate(10))
+	mugID := s.createInvoice(userID, "Coffee mug", 12.05, models.InvoiceStatusUnpaid, reconcileDate(11))
+	s.createInvoice("other-user", "Someone else's", 999, models.InvoiceStatusUnpaid, reconcileDate(4))
+
+	result, err := s.setup.InvoiceService.Reconcile(userID, []services.StatementLine{
+		{Date: reconcileDate(3), Amount: -1500, Description: "LANDLORD LTD"},
+		{Date: reconcileDate(6), Amount: -45.25, Description: "TELCO"},
+		{Date: reconcileDate(10), Amount: -12, Description: "COFFEE SHOP"},
+		{Date: reconcileDate(4), Amount: -999, Description: "UNKNOWN"},
+		{Date: reconcileDate(20), Amount: -45.20, Description: "TELCO LATE"},
+	})
+	s.Require().NoError(err)
+	s.Equal("USD", result.Currency)
+
+	s.Require().Len(result.Matched, 2)
+	s.Equal(1, result.Matched[0].Line)
+	s.Equal("LANDLORD LTD", result.Matched[0].Description)
+	s.Equal(rentID, result.Matched[0].Match.InvoiceID)
+	s.Equal(2, result.Matched[0].Match.DaysApart)
+	s.Equal(2, result.Matched[1].Line)
+	s.Equal(phoneID, result.Matched[1].Match.InvoiceID)
+	s.Equal(1, result.Matched[1].Match.DaysApart)
+	s.InDelta(-0.05, result.Matched[1].Match.AmountDifference, 1e-9)
+
+	s.Require().Len(result.Ambiguous, 1)
+	s.Equal(3, result.Ambiguous[0].Line)
+	s.Require().Len(result.Ambiguous[0].Candidates, 2)
+	s.Equal(coffeeID, result.Ambiguous[0].Candidates[0].InvoiceID)
+	s.Equal(mugID, result.Ambiguous[0].Candidates[1].InvoiceID)
+
+	s.Require().Len(result.Unmatched, 2)
+	s.Equal(4, result.Unmatched[0].Line)
+	s.Equal(5, result.Unmatched[1].Line)
+
+	// Nothing is changed
+	rent, err := s.setup.InvoiceService.GetInvoiceByID(userID, rentID)
+	s.Require().NoError(err)
+	s.Equal(models.InvoiceStatusUnpaid, rent.Status)
+	s.Nil(rent.PaidAt)
+}
+
+func (s *ReconcileTestSuite) TestSharedCandidateIsAmbiguous() {
+	rentID := s.createInvoice(s.setup.TestUserID, "Rent", 1500, models.InvoiceStatusUnpaid, reconcileDate(1))
+
+	result, err := s.setup.InvoiceService.Reconcile(s.setup.TestUserID, []services.StatementLine{
+		{Date: reconcileDate(1), Amount: 1500},
+		{Date: reconcileDate(2), Amount: 1500},
+	})
+	s.Require().NoError(err)
+	s.Empty(result.Matched)
+	s.Require().Len(result.Ambiguous, 2)
+	for _, line := range result.Ambiguous {
+		s.Require().Len(line.Candidates, 1)
+		s.Equal(rentID, line.Candidates[0].InvoiceID)
+	}
+}
+
+func (s *ReconcileTestSuite) TestValidation() {
+	_, err := s.setup.InvoiceService.Reconcile(s.setup.TestUserID, nil)
+	s.Error(err)
+	_, err = s.setup.InvoiceService.Reconcile(s.setup.TestUserID, []services.StatementLine{{Amount: 10}})
+	s.ErrorContains(err, "line 1: date is required")
+	_, err = s.setup.InvoiceService.Reconcile(s.setup.TestUserID, []services.StatementLine{
+		{Date: reconcileDate(1), Amount: 10},
+		{Date: reconcileDate(1)},
+	})
+	s.ErrorContains(err, "line 2")
+}
+
+func (s *ReconcileTestSuite) TestReconcileStatementTool() {
+	rentID := s.createInvoice(s.setup.TestUserID, "Rent", 1500, models.InvoiceStatusUnpaid, reconcileDate(1))
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: s.setup.TestUserID})
+	handler := tools.NewReconcileStatementTool(s.setup.InvoiceService).GetHandler()
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"lines": []interface{}{
+			map[string]interface{}{"date": "2024-03-02", "amount": -1500.0, "description": "LANDLORD LTD"},
+			map[string]interface{}{"date": "2024-03-02T09:30:00Z", "amount": "-20.00"},
+		},
+	}
+	result, err := handler(ctx, request)
+	s.Require().NoError(err)
+	s.Require().False(result.IsError, result.Content[0].(mcp.TextContent).Text)
+	var reconciled services.ReconcileResult
+	s.Require().NoError(json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &reconciled))
+	s.Require().Len(reconciled.Matched, 1)
+	s.Equal(rentID, reconciled.Matched[0].Match.InvoiceID)
+	s.Require().Len(reconciled.Unmatched, 1)
+	s.Equal(2, reconciled.Unmatched[0].Line)
+
+	request.Params.Arguments = map[string]interface{}{
+		"lines": []interface{}{map[string]interface{}{"date": "03/02/2024", "amount": 10.0}},
+	}
+	result, err = handler(ctx, request)
+	s.Require().NoError(err)
+	s.True(result.IsError)
+	s.Contains(result.Content[0].(mcp.TextContent).Text, "line 1")
+}
+
+func TestReconcileSuite(t *testing.T) {
+	suite.Run(t, new(ReconcileTestSuite))
+}
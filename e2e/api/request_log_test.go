@@ -0,0 +1,118 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/rxtech-lab/invoice-management/internal/api/middleware"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+// RequestLogSuite tests request ID assignment and structured request logging
+type RequestLogSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *RequestLogSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *RequestLogSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+func (s *RequestLogSuite) TestGeneratesRequestID() {
+	resp, err := s.setup.MakeAuthenticatedRequest("GET", "/api/categories", nil, s.setup.TestUserID)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	_, err = uuid.Parse(resp.Header.Get(middleware.RequestIDHeader))
+	s.NoError(err)
+}
+
+func (s *RequestLogSuite) TestReusesClientRequestID() {
+	req := httptest.NewRequest("GET", "/api/categories", nil)
+	req.Header.Set("X-Test-User-ID", s.setup.TestUserID)
+	req.Header.Set(middleware.RequestIDHeader, "trace-abc-123")
+	resp, err := s.setup.App.Test(req, -1)
+	s.Require().NoError(err)
+	s.Equal("trace-abc-123", resp.Header.Get(middleware.RequestIDHeader))
+
+	// Values with whitespace or control characters are replaced
+	req = httptest.NewRequest("GET", "/api/categories", nil)
+	req.Header.Set("X-Test-User-ID", s.setup.TestUserID)
+	req.Header.Set(middleware.RequestIDHeader, "bad id")
+	resp, err = s.setup.App.Test(req, -1)
+	s.Require().NoError(err)
+	_, err = uuid.Parse(resp.Header.Get(middleware.RequestIDHeader))
+	s.NoError(err)
+}
+
+func (s *RequestLogSuite) TestJSONLogLine() {
+	s.T().Setenv(middleware.LogFormatEnvVar, "json")
+
+	// The JSON logger writes to stdout, so capture it while the request runs
+	stdout := os.Stdout
+	r, w, err := os.Pipe()
+	s.Require().NoError(err)
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	var contextRequestID string
+	app := fiber.New()
+	app.Use(middleware.RequestIDMiddleware())
+	app.Use(middleware.RequestLoggerMiddleware())
+	SetupTestAuthMiddleware(app)
+	app.Get("/api/invoices/:id", func(c *fiber.Ctx) error {
+		contextRequestID = utils.GetRequestID(c.UserContext())
+		return c.SendStatus(http.StatusNotFound)
+	})
+
+	req := httptest.NewRequest("GET", "/api/invoices/42", nil)
+	req.Header.Set("X-Test-User-ID", "user-a")
+	req.Header.Set(middleware.RequestIDHeader, "trace-json-1")
+	_, err = app.Test(req, -1)
+	s.Require().NoError(err)
+
+	s.Require().NoError(w.Close())
+	output, err := io.ReadAll(r)
+	s.Require().NoError(err)
+
+	s.Equal("trace-json-1", contextRequestID)
+
+	var line map[string]interface{}
+	s.Require().NoError(json.Unmarshal(bytes.TrimSpace(output), &line), string(output))
+	s.Equal("request", line["msg"])
+	s.Equal("trace-json-1", line["request_id"])
+	s.Equal("user-a", line["user"])
+	s.Equal("GET", line["method"])
+	s.Equal("/api/invoices/:id", line["route"])
+	s.Equal("/api/invoices/42", line["path"])
+	s.Equal(float64(http.StatusNotFound), line["status"])
+	s.Contains(line, "latency_ms")
+}
+
+func (s *RequestLogSuite) TestLogfKeepsRequestIDLiteral() {
+	var output bytes.Buffer
+	log.SetOutput(&output)
+	defer log.SetOutput(os.Stderr)
+
+	ctx := utils.WithRequestID(context.Background(), "trace-%d-%s")
+	utils.Logf(ctx, "Warning: invoice %d skipped", 7)
+	s.Contains(output.String(), "[request_id=trace-%d-%s] Warning: invoice 7 skipped")
+}
+
+func TestRequestLogSuite(t *testing.T) {
+	suite.Run(t, new(RequestLogSuite))
+}
@@ -0,0 +1,280 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type SettingsTestSuite struct {
+	suite.Suite
+	setup     *TestSetup
+	fxService *services.MockFXService
+}
+
+func (s *SettingsTestSuite) SetupTest() {
+	// 1 USD = 8 HKD
+	s.fxService = services.NewMockFXService()
+	s.fxService.SetRate("USD", "HKD", 8)
+	s.fxService.SetRate("HKD", "USD", 0.125)
+
+	s.setup = NewTestSetupWithFXService(s.T(), s.fxService)
+}
+
+func (s *SettingsTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+func (s *SettingsTestSuite) TestGetDefaultSettings() {
+	resp, err := s.setup.MakeRequest("GET", "/api/settings", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	settings, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("USD", settings["base_currency"])
+}
+
+func (s *SettingsTestSuite) TestUpdateSettings() {
+	resp, err := s.setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{
+		"base_currency": "hkd",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	settings, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("HKD", settings["base_currency"])
+
+	resp, err = s.setup.MakeRequest("GET", "/api/settings", nil)
+	s.Require().NoError(err)
+	settings, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("HKD", settings["base_currency"])
+}
+
+func (s *SettingsTestSuite) TestUpdateSettingsInvalidCurrency() {
+	resp, err := s.setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{
+		"base_currency": "DOLLARS",
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *SettingsTestSuite) TestUpdateTimezone() {
+	resp, err := s.setup.MakeRequest("GET", "/api/settings", nil)
+	s.Require().NoError(err)
+	settings, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("UTC", settings["timezone"])
+
+	resp, err = s.setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{
+		"base_currency": "USD",
+		"timezone":      " America/New_York ",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	settings, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("America/New_York", settings["timezone"])
+
+	// Omitting the timezone keeps it
+	resp, err = s.setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{"base_currency": "HKD"})
+	s.Require().NoError(err)
+	settings, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("America/New_York", settings["timezone"])
+
+	for _, timezone := range []string{"Eastern", "Local"} {
+		resp, err = s.setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{
+			"base_currency": "USD",
+			"timezone":      timezone,
+		})
+		s.Require().NoError(err)
+		s.Equal(http.StatusBadRequest, resp.StatusCode, timezone)
+	}
+}
+
+// TestItemsConvertToBaseCurrency verifies item target amounts and analytics use the configured base currency
+// TestPaymentTrackingMode verifies the status stays the source of truth: manual is the default and
+// the only accepted mode, as there are no payment records to derive the status from
+func (s *SettingsTestSuite) TestPaymentTrackingMode() {
+	resp, err := s.setup.MakeRequest("GET", "/api/settings", nil)
+	s.Require().NoError(err)
+	settings, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("manual", settings["payment_tracking_mode"])
+
+	resp, err = s.setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{
+		"base_currency":         "USD",
+		"payment_tracking_mode": "manual",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	settings, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("manual", settings["payment_tracking_mode"])
+
+	for _, mode := range []string{"payments", "automatic"} {
+		resp, err = s.setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{
+			"base_currency":         "USD",
+			"payment_tracking_mode": mode,
+		})
+		s.Require().NoError(err)
+		s.Equal(http.StatusBadRequest, resp.StatusCode, mode)
+		body, err := s.setup.ReadResponseBody(resp)
+		s.Require().NoError(err)
+		s.Contains(body["error"], "payment_tracking_mode", mode)
+	}
+
+	// Omitting the mode keeps it
+	resp, err = s.setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{"base_currency": "USD"})
+	s.Require().NoError(err)
+	settings, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("manual", settings["payment_tracking_mode"])
+}
+
+func (s *SettingsTestSuite) TestUpdateBranding() {
+	resp, err := s.setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{
+		"base_currency":   "USD",
+		"company_name":    " Acme Ltd ",
+		"company_address": "1 Main Street\nSpringfield",
+		"logo_s3_key":     "uploads/logo.png",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	settings, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("Acme Ltd", settings["company_name"])
+	s.Equal("1 Main Street\nSpringfield", settings["company_address"])
+	s.Equal("uploads/logo.png", settings["logo_s3_key"])
+
+	// Omitted fields are kept, empty strings clear them
+	resp, err = s.setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{
+		"base_currency": "USD",
+		"logo_s3_key":   "",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	settings, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("Acme Ltd", settings["company_name"])
+	s.NotContains(settings, "logo_s3_key")
+
+	resp, err = s.setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{
+		"base_currency": "USD",
+		"company_name":  strings.Repeat("a", 256),
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *SettingsTestSuite) TestItemsConvertToBaseCurrency() {
+	resp, err := s.setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{
+		"base_currency": "HKD",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	invoiceID, err := s.setup.CreateTestInvoiceWithCurrency("US Vendor", "USD")
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceItem(invoiceID, "Service", 1, 10.00)
+	s.Require().NoError(err)
+
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/invoices/%d", invoiceID), nil)
+	s.Require().NoError(err)
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	items := invoice["items"].([]interface{})
+	s.Require().Len(items, 1)
+	item := items[0].(map[string]interface{})
+	s.Equal("HKD", item["target_currency"])
+	s.Equal(float64(80), item["target_amount"]) // 10 USD * 8 = 80 HKD
+	s.Equal(float64(8), item["fx_rate_used"])
+
+	// Items already in the base currency are not converted
+	hkdInvoiceID, err := s.setup.CreateTestInvoiceWithCurrency("Local Vendor", "HKD")
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceItem(hkdInvoiceID, "Service", 1, 20.00)
+	s.Require().NoError(err)
+
+	resp, err = s.setup.MakeRequest("GET", "/api/analytics/summary?period=1m", nil)
+	s.Require().NoError(err)
+	summary, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("HKD", summary["currency"])
+	s.Equal(float64(100), summary["total_amount"]) // 80 HKD + 20 HKD
+}
+
+// TestBaseCurrencyChangeRecalculatesInvoices verifies existing invoices are converted to a new
+// base currency, and that a currency they can't be converted to isn't saved
+func (s *SettingsTestSuite) TestBaseCurrencyChangeRecalculatesInvoices() {
+	invoiceID, err := s.setup.CreateTestInvoiceWithCurrency("US Vendor", "USD")
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceItem(invoiceID, "Service", 1, 10.00)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{
+		"base_currency": "HKD",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", fmt.Sprintf("/api/invoices/%d", invoiceID), nil)
+	s.Require().NoError(err)
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	item := invoice["items"].([]interface{})[0].(map[string]interface{})
+	s.Equal("HKD", item["target_currency"])
+	s.Equal(float64(80), item["target_amount"])
+
+	resp, err = s.setup.MakeRequest("GET", "/api/analytics/summary?period=1m", nil)
+	s.Require().NoError(err)
+	summary, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(float64(80), summary["total_amount"])
+}
+
+func (s *SettingsTestSuite) TestBaseCurrencyChangeFailsWithoutRates() {
+	setup := NewTestSetupWithFXService(s.T(), &unavailableFXService{MockFXService: services.NewMockFXService()})
+	defer setup.Cleanup()
+
+	invoiceID, err := setup.CreateTestInvoiceWithCurrency("US Vendor", "USD")
+	s.Require().NoError(err)
+	_, err = setup.CreateTestInvoiceItem(invoiceID, "Service", 1, 10.00)
+	s.Require().NoError(err)
+
+	resp, err := setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{
+		"base_currency": "JPY",
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+	result, err := setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Contains(result["error"], "exchange rate unavailable")
+
+	// Neither the settings nor the invoice changed
+	resp, err = setup.MakeRequest("GET", "/api/settings", nil)
+	s.Require().NoError(err)
+	settings, err := setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("USD", settings["base_currency"])
+
+	resp, err = setup.MakeRequest("GET", fmt.Sprintf("/api/invoices/%d", invoiceID), nil)
+	s.Require().NoError(err)
+	invoice, err := setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	item := invoice["items"].([]interface{})[0].(map[string]interface{})
+	s.Equal("USD", item["target_currency"])
+	s.Equal(float64(10), item["target_amount"])
+}
+
+func TestSettingsSuite(t *testing.T) {
+	suite.Run(t, new(SettingsTestSuite))
+}
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SimilarInvoicesTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *SimilarInvoicesTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *SimilarInvoicesTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// createInvoice creates an invoice with a single item of the given price and returns its ID
+func (s *SimilarInvoicesTestSuite) createInvoice(title string, receiverID *uint, unitPrice float64) uint {
+	body := map[string]interface{}{
+		"title": title,
+		"items": []map[string]interface{}{{"description": "Item", "unit_price": unitPrice}},
+	}
+	if receiverID != nil {
+		body["receiver_id"] = *receiverID
+	}
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices", body)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	return uint(invoice["id"].(float64))
+}
+
+// similarTitles fetches the similar invoices through the API and returns their titles
+func (s *SimilarInvoicesTestSuite) similarTitles(id uint, query string) []string {
+	resp, err := s.setup.MakeRequest("GET", "/api/invoices/"+uintToString(id)+"/similar"+query, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	titles := []string{}
+	for _, invoice := range result["data"].([]interface{}) {
+		titles = append(titles, invoice.(map[string]interface{})["title"].(string))
+	}
+	return titles
+}
+
+func (s *SimilarInvoicesTestSuite) TestScoring() {
+	receiverID, err := s.setup.CreateTestReceiver("Power Co", true)
+	s.Require().NoError(err)
+
+	id := s.createInvoice("Electricity bill March", &receiverID, 100)
+	// Same receiver (3) + two of four title words (1) + 2% off the amount (0.8)
+	s.createInvoice("Electricity bill February", &receiverID, 98)
+	// Same receiver only
+	s.createInvoice("Internet", &receiverID, 500)
+	// One of four title words (0.5) + 1% off the amount (0.9)
+	s.createInvoice("Water bill", nil, 101)
+	// Nothing in common
+	s.createInvoice("Groceries", nil, 1000)
+	// Deleted invoices are never suggested
+	deletedID := s.createInvoice("Electricity bill April", &receiverID, 100.5)
+	resp, err := s.setup.MakeRequest("DELETE", "/api/invoices/"+uintToString(deletedID), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusNoContent, resp.StatusCode)
+
+	s.Equal([]string{"Electricity bill February", "Internet", "Water bill"}, s.similarTitles(id, ""))
+	s.Equal([]string{"Electricity bill February", "Internet"}, s.similarTitles(id, "?limit=2"))
+
+	invoices, err := s.setup.InvoiceService.FindSimilar(s.setup.TestUserID, id, 0)
+	s.Require().NoError(err)
+	s.Len(invoices, 3)
+	for _, invoice := range invoices {
+		s.NotEqual(id, invoice.ID)
+	}
+}
+
+func (s *SimilarInvoicesTestSuite) TestNoMatches() {
+	id := s.createInvoice("Groceries", nil, 50)
+	s.createInvoice("Rent", nil, 1500)
+	s.Empty(s.similarTitles(id, ""))
+}
+
+func (s *SimilarInvoicesTestSuite) TestErrors() {
+	resp, err := s.setup.MakeRequest("GET", "/api/invoices/9999/similar", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+
+	id := s.createInvoice("Groceries", nil, 50)
+	resp, err = s.setup.MakeRequest("GET", "/api/invoices/"+uintToString(id)+"/similar?limit=51", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	_, err = s.setup.InvoiceService.FindSimilar("other-user", id, 5)
+	s.Error(err)
+}
+
+func TestSimilarInvoicesSuite(t *testing.T) {
+	suite.Run(t, new(SimilarInvoicesTestSuite))
+}
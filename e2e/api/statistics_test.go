@@ -1,10 +1,19 @@
 package api
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
 	"testing"
+	"time"
 
+	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/rxtech-lab/invoice-management/internal/models"
 	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/rxtech-lab/invoice-management/internal/tools"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -90,6 +99,34 @@ func (s *StatisticsTestSuite) TestLastDayPeriod() {
 	s.GreaterOrEqual(stats.InvoiceCount, int64(1))
 }
 
+func (s *StatisticsTestSuite) TestDailyAverageOverMultipleDays() {
+	opts := services.StatisticsOptions{
+		Period: services.PeriodLastWeek,
+	}
+
+	stats, err := s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, opts)
+	s.Require().NoError(err)
+
+	s.Equal(955.00, stats.TotalAmount)
+	s.InDelta(955.00/7, stats.DailyAverage, 0.001)
+
+	monthDays := time.Date(stats.EndDate.Year(), stats.EndDate.Month()+1, 0, 0, 0, 0, 0, stats.EndDate.Location()).Day()
+	s.InDelta(955.00/7*float64(monthDays), stats.ProjectedMonthEnd, 0.001)
+}
+
+func (s *StatisticsTestSuite) TestDailyAverageOverSingleDay() {
+	opts := services.StatisticsOptions{
+		Period:  services.PeriodLastDay,
+		Keyword: "Internet",
+	}
+
+	stats, err := s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, opts)
+	s.Require().NoError(err)
+
+	s.Equal(80.00, stats.TotalAmount)
+	s.InDelta(80.00, stats.DailyAverage, 0.001)
+}
+
 func (s *StatisticsTestSuite) TestCustomDays() {
 	opts := services.StatisticsOptions{
 		Period: services.PeriodCustom,
@@ -104,6 +141,69 @@ func (s *StatisticsTestSuite) TestCustomDays() {
 	s.GreaterOrEqual(stats.InvoiceCount, int64(3))
 }
 
+func (s *StatisticsTestSuite) TestExplicitDateRange() {
+	march := time.Date(2023, time.March, 10, 12, 0, 0, 0, time.UTC)
+	_, err := s.setup.CreateTestInvoiceOnDate("March Rent", &s.categoryID, nil, "paid", 1200.00, march)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceOnDate("April Rent", &s.categoryID, nil, "paid", 1250.00, march.AddDate(0, 1, 0))
+	s.Require().NoError(err)
+
+	// The window is given in another timezone and normalized to UTC
+	hongKong := time.FixedZone("HKT", 8*3600)
+	start := time.Date(2023, time.March, 1, 8, 0, 0, 0, hongKong)
+	end := time.Date(2023, time.April, 1, 7, 59, 59, 0, hongKong)
+	stats, err := s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, services.StatisticsOptions{
+		Period:    services.PeriodCustom,
+		StartDate: &start,
+		EndDate:   &end,
+		GroupBy:   services.GroupByMonth,
+	})
+	s.Require().NoError(err)
+
+	s.Equal(int64(1), stats.InvoiceCount)
+	s.Equal(1200.00, stats.TotalAmount)
+	s.Equal(time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC), stats.StartDate)
+	s.Equal(time.UTC, stats.EndDate.Location())
+	s.Require().Len(stats.Breakdown, 1)
+	s.Equal("2023-03", stats.Breakdown[0].Date)
+
+	// Explicit dates take precedence over the period
+	stats, err = s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, services.StatisticsOptions{
+		Period:    services.PeriodLastWeek,
+		StartDate: &start,
+		EndDate:   &end,
+	})
+	s.Require().NoError(err)
+	s.Equal(int64(1), stats.InvoiceCount)
+}
+
+func (s *StatisticsTestSuite) TestExplicitDateRangeValidation() {
+	start := time.Date(2023, time.March, 31, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+	_, err := s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, services.StatisticsOptions{StartDate: &start, EndDate: &end})
+	s.Require().Error(err)
+	s.Contains(err.Error(), "is after end date")
+
+	_, err = s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, services.StatisticsOptions{StartDate: &start})
+	s.Require().Error(err)
+	s.Contains(err.Error(), "must be set together")
+
+	farStart := end.AddDate(0, 0, -services.MaxStatisticsRangeDays-1)
+	_, err = s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, services.StatisticsOptions{StartDate: &farStart, EndDate: &end})
+	s.Require().Error(err)
+	s.Contains(err.Error(), fmt.Sprintf("exceeds %d days", services.MaxStatisticsRangeDays))
+
+	// The limit is configurable
+	defer func(days int) { services.MaxStatisticsRangeDays = days }(services.MaxStatisticsRangeDays)
+	services.MaxStatisticsRangeDays = 7
+	weekStart := end.AddDate(0, 0, -7)
+	_, err = s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, services.StatisticsOptions{StartDate: &weekStart, EndDate: &end})
+	s.NoError(err)
+	weekStart = weekStart.Add(-time.Second)
+	_, err = s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, services.StatisticsOptions{StartDate: &weekStart, EndDate: &end})
+	s.Error(err)
+}
+
 func (s *StatisticsTestSuite) TestFilterByCategory() {
 	opts := services.StatisticsOptions{
 		Period:     services.PeriodLastMonth,
@@ -210,6 +310,63 @@ func (s *StatisticsTestSuite) TestGroupByDay() {
 	}
 }
 
+// TestGroupByDayInTimezone verifies days are bucketed in the user's timezone, or the requested one
+func (s *StatisticsTestSuite) TestGroupByDayInTimezone() {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	s.Require().NoError(err)
+
+	// 20:00 UTC yesterday is 05:00 the next day in Tokyo
+	lateNight := time.Now().UTC().Truncate(24 * time.Hour).Add(-4 * time.Hour)
+	_, err = s.setup.CreateTestInvoiceOnDate("Late night taxi", nil, nil, "paid", 42.00, lateNight)
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{
+		"base_currency": "USD",
+		"timezone":      "Asia/Tokyo",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	// dayOf returns the breakdown item of a date
+	dayOf := func(stats *services.InvoiceStatistics, date string) services.BreakdownItem {
+		for _, item := range stats.Breakdown {
+			if item.Date == date {
+				return item
+			}
+		}
+		s.Failf("missing day", "no breakdown item for %s", date)
+		return services.BreakdownItem{}
+	}
+
+	opts := services.StatisticsOptions{
+		Period:              services.PeriodLastWeek,
+		GroupBy:             services.GroupByDay,
+		DateField:           services.DateFieldCreatedAt,
+		Keyword:             "taxi",
+		IncludeAggregations: true,
+	}
+	stats, err := s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, opts)
+	s.Require().NoError(err)
+	s.Equal("Asia/Tokyo", stats.Timezone)
+	s.Require().NotNil(stats.Aggregations.MaxDay)
+	s.Equal(lateNight.In(tokyo).Format("2006-01-02"), stats.Aggregations.MaxDay.Date)
+	s.Equal(42.0, dayOf(stats, lateNight.In(tokyo).Format("2006-01-02")).Amount)
+	s.Equal(0.0, dayOf(stats, lateNight.Format("2006-01-02")).Amount)
+	// The zero-filled range ends on today in Tokyo
+	s.Equal(time.Now().In(tokyo).Format("2006-01-02"), stats.Breakdown[len(stats.Breakdown)-1].Date)
+
+	// An explicit timezone overrides the setting
+	opts.Timezone = "UTC"
+	stats, err = s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, opts)
+	s.Require().NoError(err)
+	s.Equal("UTC", stats.Timezone)
+	s.Equal(42.0, dayOf(stats, lateNight.Format("2006-01-02")).Amount)
+
+	opts.Timezone = "Mars/Olympus_Mons"
+	_, err = s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, opts)
+	s.Error(err)
+}
+
 func (s *StatisticsTestSuite) TestGroupByMonth() {
 	opts := services.StatisticsOptions{
 		Period:  services.PeriodLastYear,
@@ -225,6 +382,38 @@ func (s *StatisticsTestSuite) TestGroupByMonth() {
 	s.GreaterOrEqual(len(stats.Breakdown), 1)
 }
 
+// TestGroupByQuarter verifies invoices are bucketed by calendar quarter, including across year boundaries
+func (s *StatisticsTestSuite) TestGroupByQuarter() {
+	// Spread over the last year so the buckets reach into the previous calendar year
+	expected := map[string]float64{}
+	for i, days := range []int{30, 120, 210, 300, 355} {
+		date := DaysAgo(days)
+		amount := float64(100 * (i + 1))
+		_, err := s.setup.CreateTestInvoiceOnDate("Quarterly Report", nil, nil, "paid", amount, date)
+		s.Require().NoError(err)
+
+		utc := date.UTC()
+		expected[fmt.Sprintf("%d-Q%d", utc.Year(), (int(utc.Month())+2)/3)] += amount
+	}
+
+	stats, err := s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, services.StatisticsOptions{
+		Period:  services.PeriodLastYear,
+		GroupBy: services.GroupByQuarter,
+		Keyword: "Quarterly",
+	})
+	s.Require().NoError(err)
+
+	actual := map[string]float64{}
+	var labels []string
+	for _, item := range stats.Breakdown {
+		s.Regexp(`^\d{4}-Q[1-4]$`, item.Date)
+		actual[item.Date] = item.Amount
+		labels = append(labels, item.Date)
+	}
+	s.Equal(expected, actual)
+	s.True(sort.StringsAreSorted(labels), "quarters should be in ascending order: %v", labels)
+}
+
 func (s *StatisticsTestSuite) TestGroupByCategory() {
 	opts := services.StatisticsOptions{
 		Period:  services.PeriodLastMonth,
@@ -244,6 +433,95 @@ func (s *StatisticsTestSuite) TestGroupByCategory() {
 	}
 }
 
+func (s *StatisticsTestSuite) TestGroupByCategoryWithSplitItems() {
+	waterID, err := s.setup.CreateTestCategory("Water")
+	s.Require().NoError(err)
+
+	// A utility bill split across categories: the electricity item uses the invoice category
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices", map[string]interface{}{
+		"title":       "Utility Bill",
+		"currency":    "USD",
+		"category_id": s.categoryID,
+		"items": []map[string]interface{}{
+			{"description": "Electricity", "quantity": 1, "unit_price": 120},
+			{"description": "Water", "quantity": 1, "unit_price": 40, "category_id": waterID},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	invoice, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	items := invoice["items"].([]interface{})
+	s.Require().Len(items, 2)
+	s.Nil(items[0].(map[string]interface{})["category_id"])
+	s.Equal(float64(waterID), items[1].(map[string]interface{})["category_id"])
+
+	opts := services.StatisticsOptions{
+		Period:  services.PeriodLastMonth,
+		GroupBy: services.GroupByCategory,
+	}
+	stats, err := s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, opts)
+	s.Require().NoError(err)
+
+	breakdown := make(map[string]services.BreakdownItem)
+	for _, item := range stats.Breakdown {
+		breakdown[item.Name] = item
+	}
+	s.Require().Len(breakdown, 3)
+	s.InDelta(575.00, breakdown["Utilities"].Amount, 0.01) // 150 + 175 + 50 + 80 + 120
+	s.Equal(int64(5), breakdown["Utilities"].Count)
+	s.InDelta(500.00, breakdown["Services"].Amount, 0.01)
+	s.InDelta(40.00, breakdown["Water"].Amount, 0.01)
+	s.Equal(int64(1), breakdown["Water"].Count)
+
+	// The invoice total is unchanged by the split
+	s.InDelta(1115.00, stats.TotalAmount, 0.01)
+
+	resp, err = s.setup.MakeRequest("GET", "/api/analytics/by-category?period=1m", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	byCategory, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	amounts := make(map[string]float64)
+	for _, item := range byCategory["items"].([]interface{}) {
+		group := item.(map[string]interface{})
+		amounts[group["name"].(string)] = group["total_amount"].(float64)
+	}
+	s.InDelta(575.00, amounts["Utilities"], 0.01)
+	s.InDelta(40.00, amounts["Water"], 0.01)
+}
+
+// TestSplitItemsRejectForeignCategory verifies items can't be attributed to another user's category
+func (s *StatisticsTestSuite) TestSplitItemsRejectForeignCategory() {
+	foreign := models.InvoiceCategory{UserID: "other-user", Name: "Theirs"}
+	s.Require().NoError(s.setup.DBService.GetDB().Create(&foreign).Error)
+
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices", map[string]interface{}{
+		"title":    "Utility Bill",
+		"currency": "USD",
+		"items": []map[string]interface{}{
+			{"description": "Water", "quantity": 1, "unit_price": 40, "category_id": foreign.ID},
+		},
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	invoiceID, err := s.setup.CreateTestInvoice("Utility Bill", nil, nil)
+	s.Require().NoError(err)
+	err = s.setup.InvoiceService.AddInvoiceItem(s.setup.TestUserID, invoiceID,
+		&models.InvoiceItem{Description: "Water", Quantity: 1, UnitPrice: 40, CategoryID: &foreign.ID})
+	s.Error(err)
+
+	itemID, err := s.setup.CreateTestInvoiceItem(invoiceID, "Water", 1, 40)
+	s.Require().NoError(err)
+	err = s.setup.InvoiceService.UpdateInvoiceItem(s.setup.TestUserID, itemID,
+		&models.InvoiceItem{Description: "Water", Quantity: 1, UnitPrice: 40, CategoryID: &foreign.ID}, nil, false)
+	s.Error(err)
+	item, err := s.setup.InvoiceService.GetInvoiceItem(s.setup.TestUserID, itemID)
+	s.Require().NoError(err)
+	s.Nil(item.CategoryID)
+}
+
 func (s *StatisticsTestSuite) TestGroupByCompany() {
 	opts := services.StatisticsOptions{
 		Period:  services.PeriodLastMonth,
@@ -258,6 +536,40 @@ func (s *StatisticsTestSuite) TestGroupByCompany() {
 	s.GreaterOrEqual(len(stats.Breakdown), 1) // Electric Co and No Company
 }
 
+func (s *StatisticsTestSuite) TestGroupByCompanyTopN() {
+	gasID, err := s.setup.CreateTestCompany("Gas Co")
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceOnDate("Gas Bill", nil, &gasID, "paid", 30.00, DaysAgo(1))
+	s.Require().NoError(err)
+
+	// Groups by amount: No Company 580 (2), Electric Co 375 (3), Gas Co 30 (1)
+	opts := services.StatisticsOptions{
+		Period:  services.PeriodLastMonth,
+		GroupBy: services.GroupByCompany,
+		Limit:   2,
+	}
+	stats, err := s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, opts)
+	s.Require().NoError(err)
+	s.Require().Len(stats.Breakdown, 2)
+	s.Equal("No Company", stats.Breakdown[0].Name)
+	s.Equal("Electric Co", stats.Breakdown[1].Name)
+
+	// The remainder is collapsed into "Other"
+	opts.Limit = 1
+	opts.OthersBucket = true
+	stats, err = s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, opts)
+	s.Require().NoError(err)
+	s.Require().Len(stats.Breakdown, 2)
+	s.Equal(580.0, stats.Breakdown[0].Amount)
+	s.Equal(services.BreakdownItem{Name: services.OthersBreakdownName, Amount: 405, Count: 4}, stats.Breakdown[1])
+
+	// No "Other" item when nothing is cut
+	opts.Limit = 3
+	stats, err = s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, opts)
+	s.Require().NoError(err)
+	s.Len(stats.Breakdown, 3)
+}
+
 func (s *StatisticsTestSuite) TestAggregations() {
 	opts := services.StatisticsOptions{
 		Period:              services.PeriodLastMonth,
@@ -287,6 +599,69 @@ func (s *StatisticsTestSuite) TestAggregationsWithMaxInvoice() {
 	s.Equal("Consulting Fee", stats.Aggregations.MaxInvoice.Title)
 }
 
+// TestAggregationsWithMinInvoice verifies zero-amount invoices are skipped when picking the smallest
+func (s *StatisticsTestSuite) TestAggregationsWithMinInvoice() {
+	_, err := s.setup.CreateTestInvoiceOnDate("Free Trial", &s.categoryID, nil, "paid", 0, DaysAgo(1))
+	s.Require().NoError(err)
+
+	opts := services.StatisticsOptions{
+		Period:              services.PeriodLastMonth,
+		GroupBy:             services.GroupByCategory,
+		IncludeAggregations: true,
+	}
+
+	stats, err := s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, opts)
+	s.Require().NoError(err)
+
+	s.Require().NotNil(stats.Aggregations.MinInvoice)
+	s.Equal("Water Bill", stats.Aggregations.MinInvoice.Title)
+	s.Require().NotNil(stats.Aggregations.MinCategory)
+	s.Equal("Utilities", stats.Aggregations.MinCategory.Name)
+	s.Require().NotNil(stats.Aggregations.MaxCategory)
+	s.Equal("Services", stats.Aggregations.MaxCategory.Name)
+
+	// Only zero-amount invoices: the zero invoice is the min
+	opts = services.StatisticsOptions{
+		Period:              services.PeriodLastMonth,
+		Keyword:             "Free Trial",
+		IncludeAggregations: true,
+	}
+	stats, err = s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, opts)
+	s.Require().NoError(err)
+	s.Require().NotNil(stats.Aggregations.MinInvoice)
+	s.Equal("Free Trial", stats.Aggregations.MinInvoice.Title)
+}
+
+// TestDateFieldInvoiceStartedAt verifies statistics can use the billing period instead of the entry date
+func (s *StatisticsTestSuite) TestDateFieldInvoiceStartedAt() {
+	db := s.setup.DBService.GetDB()
+	// Water Bill was entered 2 days ago for a billing period that started 40 days ago
+	s.Require().NoError(db.Exec("UPDATE invoices SET invoice_started_at = ? WHERE title = ?", DaysAgo(40), "Water Bill").Error)
+	s.Require().NoError(db.Exec("UPDATE invoices SET invoice_started_at = ? WHERE title = ?", DaysAgo(3), "Consulting Fee").Error)
+
+	opts := services.StatisticsOptions{
+		Period:    services.PeriodLastMonth,
+		DateField: services.DateFieldInvoiceStartedAt,
+		GroupBy:   services.GroupByMonth,
+	}
+	stats, err := s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, opts)
+	s.Require().NoError(err)
+
+	s.Equal("invoice_started_at", stats.DateField)
+	s.Equal(int64(1), stats.InvoiceCount)
+	s.Equal(500.00, stats.TotalAmount)
+	// The three invoices without a billing period are excluded and reported
+	s.Equal(int64(3), stats.ExcludedCount)
+	s.Require().Len(stats.Breakdown, 1)
+	s.Equal(DaysAgo(3).Format("2006-01"), stats.Breakdown[0].Date)
+
+	// The default date is unaffected
+	stats, err = s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, services.StatisticsOptions{Period: services.PeriodLastMonth})
+	s.Require().NoError(err)
+	s.Equal(int64(5), stats.InvoiceCount)
+	s.Equal(int64(0), stats.ExcludedCount)
+}
+
 func (s *StatisticsTestSuite) TestGroupByDayWithAggregations() {
 	opts := services.StatisticsOptions{
 		Period:              services.PeriodLastWeek,
@@ -383,6 +758,333 @@ func (s *StatisticsTestSuite) TestMaxSpendLastWeek() {
 	s.NotNil(stats.Aggregations.MaxInvoice)
 }
 
+func (s *StatisticsTestSuite) TestReceiverDetail() {
+	// Assign the electricity invoices to the receiver
+	db := s.setup.DBService.GetDB()
+	s.Require().NoError(db.Exec("UPDATE invoices SET receiver_id = ? WHERE title LIKE ?", s.receiverID, "Electricity%").Error)
+
+	detail, err := s.setup.AnalyticsService.GetReceiverDetail(s.setup.TestUserID, s.receiverID, services.PeriodLastMonth)
+	s.Require().NoError(err)
+
+	s.Equal(s.receiverID, detail.ReceiverID)
+	s.Equal("John Doe", detail.Name)
+	s.Equal(int64(2), detail.InvoiceCount)
+	s.Equal(325.00, detail.TotalAmount)
+	s.Equal(150.00, detail.PaidAmount)
+	s.Equal(175.00, detail.UnpaidAmount)
+	s.Equal(int64(1), detail.PaidCount)
+	s.Equal(int64(1), detail.UnpaidCount)
+	s.Equal(162.50, detail.AvgAmount)
+	s.Require().NotNil(detail.FirstInvoiceDate)
+	s.Require().NotNil(detail.LastInvoiceDate)
+	s.True(detail.FirstInvoiceDate.Before(*detail.LastInvoiceDate))
+
+	s.Require().Len(detail.TopInvoices, 2)
+	s.Equal("Electricity February", detail.TopInvoices[0].Title)
+	s.Equal(175.00, detail.TopInvoices[0].Amount)
+}
+
+func (s *StatisticsTestSuite) TestReceiverDetailNotOwned() {
+	_, err := s.setup.AnalyticsService.GetReceiverDetail("other-user-456", s.receiverID, services.PeriodLastMonth)
+	s.Error(err)
+
+	resp, err := s.setup.MakeRequest("GET", "/api/receivers/99999/statistics", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (s *StatisticsTestSuite) TestReceiverStatisticsEndpoint() {
+	db := s.setup.DBService.GetDB()
+	s.Require().NoError(db.Exec("UPDATE invoices SET receiver_id = ? WHERE title = ?", s.receiverID, "Water Bill").Error)
+
+	resp, err := s.setup.MakeRequest("GET", "/api/receivers/"+uintToString(s.receiverID)+"/statistics?period=last_week", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("last_week", result["period"])
+	s.Equal(float64(1), result["invoice_count"])
+	s.Equal(50.00, result["total_amount"])
+	s.Len(result["top_invoices"], 1)
+}
+
+// TestReceiverStatisticsErrors verifies only a missing receiver is a 404
+func (s *StatisticsTestSuite) TestReceiverStatisticsErrors() {
+	path := "/api/receivers/" + uintToString(s.receiverID) + "/statistics"
+
+	resp, err := s.setup.MakeRequest("GET", path+"?period=last_decade", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	// Failures after the receiver is found are server errors
+	s.Require().NoError(s.setup.DBService.GetDB().Exec("DROP TABLE user_settings").Error)
+	resp, err = s.setup.MakeRequest("GET", path, nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusInternalServerError, resp.StatusCode)
+}
+
+func (s *StatisticsTestSuite) TestCompanyBreakdown() {
+	// The electricity invoices go to the receiver; the water bill has none
+	db := s.setup.DBService.GetDB()
+	s.Require().NoError(db.Exec("UPDATE invoices SET receiver_id = ? WHERE title LIKE ?", s.receiverID, "Electricity%").Error)
+
+	breakdown, err := s.setup.AnalyticsService.GetCompanyBreakdown(s.setup.TestUserID, s.companyID, services.PeriodLastMonth)
+	s.Require().NoError(err)
+
+	s.Equal(s.companyID, breakdown.CompanyID)
+	s.Equal("Electric Co", breakdown.Name)
+	s.Equal("USD", breakdown.Currency)
+	s.Equal(int64(3), breakdown.InvoiceCount)
+	s.Equal(375.00, breakdown.TotalAmount)
+
+	s.Require().Len(breakdown.Receivers, 2)
+	s.Equal(s.receiverID, breakdown.Receivers[0].ID)
+	s.Equal("John Doe", breakdown.Receivers[0].Name)
+	s.Equal(325.00, breakdown.Receivers[0].Amount)
+	s.Equal(int64(2), breakdown.Receivers[0].Count)
+	s.Zero(breakdown.Receivers[1].ID)
+	s.Equal(services.UnspecifiedReceiver, breakdown.Receivers[1].Name)
+	s.Equal(50.00, breakdown.Receivers[1].Amount)
+
+	s.Require().Len(breakdown.Categories, 1)
+	s.Equal(s.categoryID, breakdown.Categories[0].ID)
+	s.Equal(375.00, breakdown.Categories[0].Amount)
+	s.Equal(int64(3), breakdown.Categories[0].Count)
+}
+
+func (s *StatisticsTestSuite) TestCompanyBreakdownNotOwned() {
+	_, err := s.setup.AnalyticsService.GetCompanyBreakdown("other-user-456", s.companyID, services.PeriodLastMonth)
+	s.Error(err)
+
+	resp, err := s.setup.MakeAuthenticatedRequest("GET", "/api/companies/"+uintToString(s.companyID)+"/breakdown", nil, "other-user-456")
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+// TestCompanyBreakdownErrors verifies only a missing company is a 404
+func (s *StatisticsTestSuite) TestCompanyBreakdownErrors() {
+	path := "/api/companies/" + uintToString(s.companyID) + "/breakdown"
+
+	resp, err := s.setup.MakeRequest("GET", path+"?period=last_decade", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	// Failures after the company is found are server errors
+	s.Require().NoError(s.setup.DBService.GetDB().Exec("DROP TABLE user_settings").Error)
+	resp, err = s.setup.MakeRequest("GET", path, nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusInternalServerError, resp.StatusCode)
+}
+
+func (s *StatisticsTestSuite) TestCompanyBreakdownEndpoint() {
+	resp, err := s.setup.MakeRequest("GET", "/api/companies/"+uintToString(s.companyID)+"/breakdown?period=last_week", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("last_week", result["period"])
+	s.Equal(float64(3), result["invoice_count"])
+	s.Equal(375.00, result["total_amount"])
+	receivers := result["receivers"].([]interface{})
+	s.Require().Len(receivers, 1)
+	s.Equal(services.UnspecifiedReceiver, receivers[0].(map[string]interface{})["name"])
+	s.NotContains(receivers[0], "id")
+	s.Len(result["categories"], 1)
+}
+
+// TestNetRefunds verifies a linked credit note takes the original invoice's category and company
+// and is subtracted from their totals with NetRefunds
+func (s *StatisticsTestSuite) TestNetRefunds() {
+	originalID, err := s.setup.CreateTestInvoiceOnDate("Electricity March", &s.categoryID, &s.companyID, "paid", 100.00, DaysAgo(4))
+	s.Require().NoError(err)
+	creditID, err := s.setup.CreateTestInvoiceOnDate("Electricity credit note", nil, nil, "paid", 40.00, DaysAgo(1))
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.setup.InvoiceService.LinkInvoices(s.setup.TestUserID, creditID, originalID, models.InvoiceRelationCreditNote))
+
+	credit, err := s.setup.InvoiceService.GetInvoiceByID(s.setup.TestUserID, creditID)
+	s.Require().NoError(err)
+	s.Equal(originalID, *credit.RelatedInvoiceID)
+	s.Equal(models.InvoiceRelationCreditNote, credit.RelationType)
+	s.Equal(s.categoryID, *credit.CategoryID)
+	s.Equal(s.companyID, *credit.CompanyID)
+
+	opts := services.StatisticsOptions{Period: services.PeriodLastMonth}
+	stats, err := s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, opts)
+	s.Require().NoError(err)
+	s.Equal(1095.0, stats.TotalAmount)
+
+	opts.NetRefunds = true
+	stats, err = s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, opts)
+	s.Require().NoError(err)
+	s.Equal(1015.0, stats.TotalAmount)
+	s.Equal(int64(7), stats.InvoiceCount)
+
+	opts.GroupBy = services.GroupByCompany
+	stats, err = s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, opts)
+	s.Require().NoError(err)
+	amounts := make(map[string]float64)
+	for _, item := range stats.Breakdown {
+		amounts[item.Name] = item.Amount
+	}
+	s.Equal(435.0, amounts["Electric Co"])
+
+	opts.GroupBy = services.GroupByCategory
+	stats, err = s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, opts)
+	s.Require().NoError(err)
+	for _, item := range stats.Breakdown {
+		amounts[item.Name] = item.Amount
+	}
+	s.Equal(515.0, amounts["Utilities"])
+
+	// Removing the link stops netting
+	s.Require().NoError(s.setup.InvoiceService.LinkInvoices(s.setup.TestUserID, creditID, 0, ""))
+	opts.GroupBy = ""
+	stats, err = s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, opts)
+	s.Require().NoError(err)
+	s.Equal(1095.0, stats.TotalAmount)
+}
+
+func (s *StatisticsTestSuite) TestLinkInvoicesValidation() {
+	invoiceID, err := s.setup.CreateTestInvoiceWithStatus("Refund", nil, nil, "paid", 20)
+	s.Require().NoError(err)
+	otherID, err := s.setup.CreateTestInvoiceWithStatus("Original", nil, nil, "paid", 60)
+	s.Require().NoError(err)
+	foreign, err := s.setup.InvoiceService.CreateInvoice("other-user", &models.Invoice{Title: "Someone else's", Currency: "USD"})
+	s.Require().NoError(err)
+
+	s.Error(s.setup.InvoiceService.LinkInvoices(s.setup.TestUserID, invoiceID, invoiceID, models.InvoiceRelationRefund))
+	s.Error(s.setup.InvoiceService.LinkInvoices(s.setup.TestUserID, invoiceID, otherID, "chargeback"))
+	s.Error(s.setup.InvoiceService.LinkInvoices(s.setup.TestUserID, invoiceID, foreign.Invoice.ID, models.InvoiceRelationRefund))
+	s.Error(s.setup.InvoiceService.LinkInvoices("other-user", foreign.Invoice.ID, invoiceID, models.InvoiceRelationRefund))
+
+	s.Require().NoError(s.setup.InvoiceService.LinkInvoices(s.setup.TestUserID, invoiceID, otherID, models.InvoiceRelationRefund))
+	// The original can't be linked back to its refund
+	s.Error(s.setup.InvoiceService.LinkInvoices(s.setup.TestUserID, otherID, invoiceID, models.InvoiceRelationCorrection))
+
+	// Nor can a longer chain be closed into a cycle
+	correctionID, err := s.setup.CreateTestInvoiceWithStatus("Correction", nil, nil, "paid", 10)
+	s.Require().NoError(err)
+	s.Require().NoError(s.setup.InvoiceService.LinkInvoices(s.setup.TestUserID, otherID, correctionID, models.InvoiceRelationCorrection))
+	s.Error(s.setup.InvoiceService.LinkInvoices(s.setup.TestUserID, correctionID, invoiceID, models.InvoiceRelationCorrection))
+	var correction models.Invoice
+	s.Require().NoError(s.setup.DBService.GetDB().First(&correction, correctionID).Error)
+	s.Nil(correction.RelatedInvoiceID)
+}
+
+// tagInvoice replaces the tags of the fixture invoice with the given title
+func (s *StatisticsTestSuite) tagInvoice(title string, tags ...string) {
+	var invoice models.Invoice
+	s.Require().NoError(s.setup.DBService.GetDB().Where("user_id = ? AND title = ?", s.setup.TestUserID, title).First(&invoice).Error)
+	s.Require().NoError(s.setup.InvoiceService.SetInvoiceTags(s.setup.TestUserID, invoice.ID, tags))
+}
+
+// tagID returns the ID of the test user's tag with the given name
+func (s *StatisticsTestSuite) tagID(name string) uint {
+	var tag models.InvoiceTag
+	s.Require().NoError(s.setup.DBService.GetDB().Where("user_id = ? AND name = ?", s.setup.TestUserID, name).First(&tag).Error)
+	return tag.ID
+}
+
+func (s *StatisticsTestSuite) TestFilterByTags() {
+	s.tagInvoice("Electricity January", "travel")
+	s.tagInvoice("Consulting Fee", "travel", "work")
+	s.tagInvoice("Water Bill", "work")
+	travel, work := s.tagID("travel"), s.tagID("work")
+
+	opts := services.StatisticsOptions{
+		Period: services.PeriodLastMonth,
+		TagIDs: []uint{travel},
+	}
+	stats, err := s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, opts)
+	s.Require().NoError(err)
+
+	// The summary matches the tagged invoices as listed
+	tagged, total, err := s.setup.InvoiceService.ListInvoices(s.setup.TestUserID, services.InvoiceListOptions{TagIDs: []uint{travel}})
+	s.Require().NoError(err)
+	var taggedAmount float64
+	for _, invoice := range tagged {
+		taggedAmount += invoice.Amount
+	}
+	s.Equal(total, stats.InvoiceCount)
+	s.Equal(taggedAmount, stats.TotalAmount)
+	s.Equal(650.0, stats.TotalAmount)
+	s.Equal([]uint{travel}, stats.Filters.TagIDs)
+	s.Equal(int64(1), stats.ByStatus.Paid.Count)
+	s.Equal(int64(1), stats.ByStatus.Overdue.Count)
+
+	// Every grouping only sees the tagged invoices
+	for _, groupBy := range []services.StatisticsGroupBy{
+		services.GroupByDay, services.GroupByWeek, services.GroupByMonth, services.GroupByQuarter,
+		services.GroupByCategory, services.GroupByCompany, services.GroupByReceiver, services.GroupByPaymentMethod,
+	} {
+		opts.GroupBy = groupBy
+		opts.IncludeAggregations = true
+		stats, err := s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, opts)
+		s.Require().NoError(err, groupBy)
+		var amount float64
+		var count int64
+		for _, item := range stats.Breakdown {
+			amount += item.Amount
+			count += item.Count
+		}
+		s.InDelta(650.0, amount, 0.001, groupBy)
+		s.Equal(int64(2), count, groupBy)
+		s.Equal(500.0, stats.Aggregations.MaxAmount, groupBy)
+		s.Equal(150.0, stats.Aggregations.MinAmount, groupBy)
+	}
+
+	// With all, invoices need every tag
+	opts = services.StatisticsOptions{
+		Period:   services.PeriodLastMonth,
+		TagIDs:   []uint{travel, work},
+		TagMatch: services.TagMatchAll,
+		GroupBy:  services.GroupByCategory,
+	}
+	stats, err = s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, opts)
+	s.Require().NoError(err)
+	s.Equal(int64(1), stats.InvoiceCount)
+	s.Equal(500.0, stats.TotalAmount)
+	s.Require().Len(stats.Breakdown, 1)
+	s.Equal("Services", stats.Breakdown[0].Name)
+
+	opts.TagMatch = services.TagMatchAny
+	stats, err = s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, opts)
+	s.Require().NoError(err)
+	s.Equal(int64(3), stats.InvoiceCount)
+
+	opts.TagMatch = "most"
+	_, err = s.setup.AnalyticsService.GetStatistics(s.setup.TestUserID, opts)
+	s.Error(err)
+}
+
+func (s *StatisticsTestSuite) TestStatisticsToolTagFilter() {
+	s.tagInvoice("Electricity January", "travel")
+	s.tagInvoice("Consulting Fee", "travel", "work")
+	handler := tools.NewInvoiceStatisticsTool(s.setup.AnalyticsService).GetHandler()
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: s.setup.TestUserID})
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"tag_ids":   []interface{}{float64(s.tagID("travel")), float64(s.tagID("work"))},
+		"tag_match": "all",
+	}
+	result, err := handler(ctx, request)
+	s.Require().NoError(err)
+	s.Require().False(result.IsError)
+	var stats services.InvoiceStatistics
+	s.Require().NoError(json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &stats))
+	s.Equal(int64(1), stats.InvoiceCount)
+	s.Equal(500.0, stats.TotalAmount)
+
+	request.Params.Arguments = map[string]interface{}{"tag_ids": []interface{}{float64(s.tagID("travel"))}, "tag_match": "some"}
+	result, err = handler(ctx, request)
+	s.Require().NoError(err)
+	s.True(result.IsError)
+}
+
 func TestStatisticsSuite(t *testing.T) {
 	suite.Run(t, new(StatisticsTestSuite))
 }
@@ -0,0 +1,100 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/tools"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type StatusCountsTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *StatusCountsTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *StatusCountsTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// createInvoice creates an invoice for userID with a single item of the given price and status
+func (s *StatusCountsTestSuite) createInvoice(userID string, unitPrice float64, status models.InvoiceStatus, draft bool) uint {
+	result, err := s.setup.InvoiceService.CreateInvoice(userID, &models.Invoice{
+		Title:    "Invoice",
+		Currency: "USD",
+		Status:   status,
+		IsDraft:  draft,
+		Items:    []models.InvoiceItem{{Description: "Item", Quantity: 1, UnitPrice: unitPrice}},
+	})
+	s.Require().NoError(err)
+	s.Require().False(result.IsDuplicate)
+	return result.Invoice.ID
+}
+
+func (s *StatusCountsTestSuite) TestCountByStatus() {
+	userID := s.setup.TestUserID
+	counts, err := s.setup.InvoiceService.CountByStatus(userID)
+	s.Require().NoError(err)
+	s.Equal(map[models.InvoiceStatus]int64{
+		models.InvoiceStatusPaid:    0,
+		models.InvoiceStatusUnpaid:  0,
+		models.InvoiceStatusOverdue: 0,
+	}, counts)
+
+	s.createInvoice(userID, 10, models.InvoiceStatusPaid, false)
+	s.createInvoice(userID, 20, models.InvoiceStatusUnpaid, false)
+	s.createInvoice(userID, 30, models.InvoiceStatusUnpaid, false)
+	deleted := s.createInvoice(userID, 40, models.InvoiceStatusUnpaid, false)
+	s.Require().NoError(s.setup.InvoiceService.DeleteInvoice(userID, deleted))
+	s.createInvoice(userID, 50, models.InvoiceStatusPaid, true)
+	s.createInvoice("other-user", 60, models.InvoiceStatusOverdue, false)
+
+	counts, err = s.setup.InvoiceService.CountByStatus(userID)
+	s.Require().NoError(err)
+	s.Equal(map[models.InvoiceStatus]int64{
+		models.InvoiceStatusPaid:    1,
+		models.InvoiceStatusUnpaid:  2,
+		models.InvoiceStatusOverdue: 0,
+	}, counts)
+}
+
+func (s *StatusCountsTestSuite) TestStatusCountsEndpoint() {
+	s.createInvoice(s.setup.TestUserID, 10, models.InvoiceStatusOverdue, false)
+
+	resp, err := s.setup.MakeRequest("GET", "/api/invoices/status-counts", nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	counts, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(map[string]interface{}{"paid": 0.0, "unpaid": 0.0, "overdue": 1.0}, counts)
+}
+
+func (s *StatusCountsTestSuite) TestInvoiceStatusCountsTool() {
+	s.createInvoice(s.setup.TestUserID, 10, models.InvoiceStatusUnpaid, false)
+	s.createInvoice(s.setup.TestUserID, 20, models.InvoiceStatusOverdue, false)
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: s.setup.TestUserID})
+
+	result, err := tools.NewInvoiceStatusCountsTool(s.setup.InvoiceService).GetHandler()(ctx, mcp.CallToolRequest{})
+	s.Require().NoError(err)
+	s.Require().False(result.IsError)
+	var counted struct {
+		Counts map[string]int64 `json:"counts"`
+		Total  int64            `json:"total"`
+	}
+	s.Require().NoError(json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &counted))
+	s.Equal(map[string]int64{"paid": 0, "unpaid": 1, "overdue": 1}, counted.Counts)
+	s.Equal(int64(2), counted.Total)
+}
+
+func TestStatusCountsSuite(t *testing.T) {
+	suite.Run(t, new(StatusCountsTestSuite))
+}
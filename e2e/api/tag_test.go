@@ -0,0 +1,376 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/rxtech-lab/invoice-management/internal/tools"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type TagTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *TagTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *TagTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+func (s *TagTestSuite) createTag(body map[string]interface{}) map[string]interface{} {
+	resp, err := s.setup.MakeRequest("POST", "/api/tags", body)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+
+	tag, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	return tag
+}
+
+func (s *TagTestSuite) TestCreateTagAssignsDistinctColors() {
+	first := s.createTag(map[string]interface{}{"name": "travel"})
+	second := s.createTag(map[string]interface{}{"name": "food"})
+
+	s.NotEmpty(first["color"])
+	s.NotEmpty(second["color"])
+	s.NotEqual(first["color"], second["color"])
+	s.NotEqual("#6B7280", first["color"])
+}
+
+func (s *TagTestSuite) TestCreateTagKeepsExplicitColor() {
+	tag := s.createTag(map[string]interface{}{"name": "travel", "color": "#123456"})
+	s.Equal("#123456", tag["color"])
+}
+
+// TestCreateTagCyclesPaletteAfterAllColorsUsed verifies colors only repeat once the palette is exhausted
+func (s *TagTestSuite) TestCreateTagCyclesPaletteAfterAllColorsUsed() {
+	seen := map[string]bool{}
+	for i := 0; i < 12; i++ {
+		tag := s.createTag(map[string]interface{}{"name": fmt.Sprintf("tag-%d", i)})
+		color := tag["color"].(string)
+		s.False(seen[color], "color %s assigned twice before palette was exhausted", color)
+		seen[color] = true
+	}
+
+	tag := s.createTag(map[string]interface{}{"name": "tag-12"})
+	s.True(seen[tag["color"].(string)])
+}
+
+// TestBulkAddTags verifies tags are added to every invoice matching the filter without duplicating mappings
+func (s *TagTestSuite) TestBulkAddTags() {
+	companyID, err := s.setup.CreateTestCompany("Power Co")
+	s.Require().NoError(err)
+	otherCompanyID, err := s.setup.CreateTestCompany("Water Co")
+	s.Require().NoError(err)
+
+	_, err = s.setup.CreateTestInvoiceWithStatus("January", nil, &companyID, "paid", 100)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceWithStatus("February", nil, &companyID, "paid", 110)
+	s.Require().NoError(err)
+	otherInvoiceID, err := s.setup.CreateTestInvoiceWithStatus("Water", nil, &otherCompanyID, "paid", 50)
+	s.Require().NoError(err)
+
+	utilities := uint(s.createTag(map[string]interface{}{"name": "utilities"})["id"].(float64))
+	power := uint(s.createTag(map[string]interface{}{"name": "power"})["id"].(float64))
+
+	opts := services.InvoiceListOptions{CompanyID: &companyID}
+	affected, err := s.setup.InvoiceService.BulkAddTags(s.setup.TestUserID, opts, []uint{utilities, power, utilities})
+	s.Require().NoError(err)
+	s.Equal(int64(2), affected)
+
+	// Re-running is a no-op
+	affected, err = s.setup.InvoiceService.BulkAddTags(s.setup.TestUserID, opts, []uint{utilities, power})
+	s.Require().NoError(err)
+	s.Equal(int64(0), affected)
+
+	var mappings int64
+	s.Require().NoError(s.setup.DBService.GetDB().Table("invoice_tag_mappings").Count(&mappings).Error)
+	s.Equal(int64(4), mappings)
+
+	other, err := s.setup.InvoiceService.GetInvoiceByID(s.setup.TestUserID, otherInvoiceID)
+	s.Require().NoError(err)
+	s.Empty(other.Tags)
+}
+
+func (s *TagTestSuite) TestBulkAddTagsRejectsForeignTags() {
+	_, err := s.setup.CreateTestInvoiceWithStatus("January", nil, nil, "paid", 100)
+	s.Require().NoError(err)
+	tagID := uint(s.createTag(map[string]interface{}{"name": "mine"})["id"].(float64))
+
+	_, err = s.setup.InvoiceService.BulkAddTags("other-user", services.InvoiceListOptions{}, []uint{tagID})
+	s.Error(err)
+
+	_, err = s.setup.InvoiceService.BulkAddTags(s.setup.TestUserID, services.InvoiceListOptions{}, []uint{tagID, 9999})
+	s.Error(err)
+
+	var mappings int64
+	s.Require().NoError(s.setup.DBService.GetDB().Table("invoice_tag_mappings").Count(&mappings).Error)
+	s.Equal(int64(0), mappings)
+}
+
+// listTitles lists the titles of the invoices returned for a query string
+func (s *TagTestSuite) listTitles(query string) []string {
+	resp, err := s.setup.MakeRequest("GET", "/api/invoices?"+query, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	titles := []string{}
+	for _, invoice := range result["data"].([]interface{}) {
+		titles = append(titles, invoice.(map[string]interface{})["title"].(string))
+	}
+	return titles
+}
+
+// TestListInvoicesByTagName verifies tag names resolve to tags and match any or all of them
+func (s *TagTestSuite) TestListInvoicesByTagName() {
+	_, err := s.setup.CreateTestInvoiceWithStatus("Both", nil, nil, "paid", 100)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceWithStatus("Travel only", nil, nil, "paid", 50)
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceWithStatus("Untagged", nil, nil, "paid", 10)
+	s.Require().NoError(err)
+
+	travel := uint(s.createTag(map[string]interface{}{"name": "travel"})["id"].(float64))
+	work := uint(s.createTag(map[string]interface{}{"name": "work"})["id"].(float64))
+
+	_, err = s.setup.InvoiceService.BulkAddTags(s.setup.TestUserID, services.InvoiceListOptions{Keyword: "Both"}, []uint{travel, work})
+	s.Require().NoError(err)
+	_, err = s.setup.InvoiceService.BulkAddTags(s.setup.TestUserID, services.InvoiceListOptions{Keyword: "Travel"}, []uint{travel})
+	s.Require().NoError(err)
+
+	s.ElementsMatch([]string{"Both", "Travel only"}, s.listTitles("tags=travel,%20work"))
+	s.ElementsMatch([]string{"Both", "Travel only"}, s.listTitles("tags=travel&tag_match=any"))
+	s.Equal([]string{"Both"}, s.listTitles("tags=travel,work&tag_match=all"))
+	s.Equal([]string{"Both"}, s.listTitles(fmt.Sprintf("tag_ids=%d&tags=travel&tag_match=all", work)))
+
+	// Unknown names never match, but don't hide invoices matching another name with "any"
+	s.ElementsMatch([]string{"Both", "Travel only"}, s.listTitles("tags=travel,missing"))
+	s.Empty(s.listTitles("tags=missing"))
+	s.Empty(s.listTitles("tags=travel,missing&tag_match=all"))
+
+	resp, err := s.setup.MakeRequest("GET", "/api/invoices?tags=travel&tag_match=some", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", "/api/invoices?tag_ids=abc", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *TagTestSuite) TestTagUsageAndCleanup() {
+	tagService := services.NewTagService(s.setup.DBService.GetDB())
+	db := s.setup.DBService.GetDB()
+
+	january, err := s.setup.CreateTestInvoiceOnDate("January", nil, nil, "paid", 100, time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC))
+	s.Require().NoError(err)
+	february, err := s.setup.CreateTestInvoiceOnDate("February", nil, nil, "paid", 110, time.Date(2024, time.February, 15, 0, 0, 0, 0, time.UTC))
+	s.Require().NoError(err)
+	deletedID, err := s.setup.CreateTestInvoiceWithStatus("Deleted", nil, nil, "paid", 120)
+	s.Require().NoError(err)
+
+	used := uint(s.createTag(map[string]interface{}{"name": "used"})["id"].(float64))
+	stale := uint(s.createTag(map[string]interface{}{"name": "stale"})["id"].(float64))
+	never := uint(s.createTag(map[string]interface{}{"name": "never"})["id"].(float64))
+	recent := uint(s.createTag(map[string]interface{}{"name": "recent"})["id"].(float64))
+	for _, invoiceID := range []uint{january, february} {
+		s.Require().NoError(tagService.AddTagToInvoice(s.setup.TestUserID, invoiceID, used))
+	}
+	s.Require().NoError(tagService.AddTagToInvoice(s.setup.TestUserID, deletedID, stale))
+	s.Require().NoError(s.setup.InvoiceService.DeleteInvoice(s.setup.TestUserID, deletedID))
+	s.Require().NoError(db.Table("invoice_tags").Where("id IN ?", []uint{used, stale, never}).
+		Update("created_at", time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)).Error)
+
+	usage, err := tagService.GetTagUsage(s.setup.TestUserID)
+	s.Require().NoError(err)
+	s.Require().Len(usage, 4)
+	s.Equal("used", usage[0].Name)
+	s.Equal(int64(2), usage[0].InvoiceCount)
+	s.Require().NotNil(usage[0].LastUsedAt)
+	s.Equal("2024-02-15", usage[0].LastUsedAt.UTC().Format("2006-01-02"))
+	// A deleted invoice doesn't count as use
+	for _, tag := range usage[1:] {
+		s.Zero(tag.InvoiceCount, tag.Name)
+		s.Nil(tag.LastUsedAt, tag.Name)
+	}
+
+	// Only unused tags created before the cutoff are deleted, with their stray mappings
+	deleted, err := tagService.DeleteUnusedTags(s.setup.TestUserID, time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC))
+	s.Require().NoError(err)
+	s.Equal(int64(2), deleted)
+
+	var mappings int64
+	s.Require().NoError(db.Table("invoice_tag_mappings").Where("invoice_tag_id = ?", stale).Count(&mappings).Error)
+	s.Zero(mappings)
+	s.Require().NoError(db.Table("invoice_tag_mappings").Where("invoice_tag_id = ?", used).Count(&mappings).Error)
+	s.Equal(int64(2), mappings)
+
+	// Other users' tags are left alone
+	deleted, err = tagService.DeleteUnusedTags("other-user", time.Time{})
+	s.Require().NoError(err)
+	s.Zero(deleted)
+
+	deleted, err = tagService.DeleteUnusedTags(s.setup.TestUserID, time.Time{})
+	s.Require().NoError(err)
+	s.Equal(int64(1), deleted)
+
+	usage, err = tagService.GetTagUsage(s.setup.TestUserID)
+	s.Require().NoError(err)
+	s.Require().Len(usage, 1)
+	s.Equal(used, usage[0].ID)
+	_, err = tagService.GetTagByID(s.setup.TestUserID, recent)
+	s.Error(err)
+}
+
+func (s *TagTestSuite) TestCleanupUnusedTagsTool() {
+	tagService := services.NewTagService(s.setup.DBService.GetDB())
+	s.createTag(map[string]interface{}{"name": "unused"})
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: s.setup.TestUserID})
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{"older_than": "last year"}
+	result, err := tools.NewCleanupUnusedTagsTool(tagService).GetHandler()(ctx, request)
+	s.Require().NoError(err)
+	s.True(result.IsError)
+	s.Contains(result.Content[0].(mcp.TextContent).Text, "Invalid older_than 'last year'")
+
+	request.Params.Arguments = map[string]interface{}{}
+	result, err = tools.NewCleanupUnusedTagsTool(tagService).GetHandler()(ctx, request)
+	s.Require().NoError(err)
+	s.False(result.IsError)
+	s.JSONEq(`{"deleted_count": 1}`, result.Content[0].(mcp.TextContent).Text)
+}
+
+// tagTotals returns the by-tag analytics items keyed by tag name
+func (s *TagTestSuite) tagTotals(query string) map[string]map[string]interface{} {
+	resp, err := s.setup.MakeRequest("GET", "/api/analytics/by-tag"+query, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	body, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	totals := map[string]map[string]interface{}{}
+	for _, item := range body["items"].([]interface{}) {
+		entry := item.(map[string]interface{})
+		totals[entry["name"].(string)] = entry
+	}
+	return totals
+}
+
+// TestTagHierarchyRollup verifies parent tag totals include their children's invoices with include_children
+func (s *TagTestSuite) TestTagHierarchyRollup() {
+	tagService := services.NewTagService(s.setup.DBService.GetDB())
+	travel := s.createTag(map[string]interface{}{"name": "travel"})
+	travelID := uint(travel["id"].(float64))
+	s.Nil(travel["parent_id"])
+	flights := s.createTag(map[string]interface{}{"name": "flights", "parent_id": travelID})
+	s.Equal(float64(travelID), flights["parent_id"])
+	flightsID := uint(flights["id"].(float64))
+	hotelsID := uint(s.createTag(map[string]interface{}{"name": "hotels", "parent_id": travelID})["id"].(float64))
+
+	flight, err := s.setup.CreateTestInvoiceWithStatus("Flight", nil, nil, "unpaid", 100)
+	s.Require().NoError(err)
+	hotel, err := s.setup.CreateTestInvoiceWithStatus("Hotel", nil, nil, "paid", 50)
+	s.Require().NoError(err)
+	// Carrying both the parent and a child, it counts once for the parent
+	trip, err := s.setup.CreateTestInvoiceWithStatus("Trip", nil, nil, "paid", 30)
+	s.Require().NoError(err)
+	s.Require().NoError(tagService.AddTagToInvoice(s.setup.TestUserID, flight, flightsID))
+	s.Require().NoError(tagService.AddTagToInvoice(s.setup.TestUserID, hotel, hotelsID))
+	s.Require().NoError(tagService.AddTagToInvoice(s.setup.TestUserID, trip, travelID))
+	s.Require().NoError(tagService.AddTagToInvoice(s.setup.TestUserID, trip, flightsID))
+
+	direct := s.tagTotals("")
+	s.InDelta(30.0, direct["travel"]["total_amount"], 0.001)
+	s.Equal(float64(1), direct["travel"]["invoice_count"])
+
+	rolled := s.tagTotals("?include_children=true")
+	s.Require().Len(rolled, 3)
+	s.InDelta(180.0, rolled["travel"]["total_amount"], 0.001)
+	s.InDelta(80.0, rolled["travel"]["paid_amount"], 0.001)
+	s.InDelta(100.0, rolled["travel"]["unpaid_amount"], 0.001)
+	s.Equal(float64(3), rolled["travel"]["invoice_count"])
+	s.InDelta(130.0, rolled["flights"]["total_amount"], 0.001)
+	s.Equal(float64(2), rolled["flights"]["invoice_count"])
+	s.InDelta(50.0, rolled["hotels"]["total_amount"], 0.001)
+
+	// The tag_spending tool rolls children up the same way with include_children
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: s.setup.TestUserID})
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{"include_children": true}
+	result, err := tools.NewTagSpendingTool(s.setup.AnalyticsService).GetHandler()(ctx, request)
+	s.Require().NoError(err)
+	s.Require().False(result.IsError, result.Content[0].(mcp.TextContent).Text)
+	var spending services.AnalyticsByGroup
+	s.Require().NoError(json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &spending))
+	s.Require().Len(spending.Items, 3)
+	totals := map[string]float64{}
+	for _, item := range spending.Items {
+		totals[item.Name] = item.TotalAmount
+	}
+	s.InDelta(180.0, totals["travel"], 0.001)
+	s.InDelta(130.0, totals["flights"], 0.001)
+}
+
+// TestTagParentCycles verifies a tag can't be nested under itself, a descendant, or another user's tag
+func (s *TagTestSuite) TestTagParentCycles() {
+	tagService := services.NewTagService(s.setup.DBService.GetDB())
+	travelID := uint(s.createTag(map[string]interface{}{"name": "travel"})["id"].(float64))
+	flightsID := uint(s.createTag(map[string]interface{}{"name": "flights", "parent_id": travelID})["id"].(float64))
+	s.createTag(map[string]interface{}{"name": "red-eye", "parent_id": flightsID})
+
+	resp, err := s.setup.MakeRequest("PUT", fmt.Sprintf("/api/tags/%d", travelID), map[string]interface{}{"parent_id": flightsID})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	travel, err := tagService.GetTagByID(s.setup.TestUserID, travelID)
+	s.Require().NoError(err)
+	travel.ParentID = &travelID
+	s.ErrorIs(tagService.UpdateTag(s.setup.TestUserID, travel), services.ErrTagCycle)
+	redEye, err := tagService.GetTagByName(s.setup.TestUserID, "red-eye")
+	s.Require().NoError(err)
+	travel.ParentID = &redEye.ID
+	s.ErrorIs(tagService.UpdateTag(s.setup.TestUserID, travel), services.ErrTagCycle)
+
+	foreign := &models.InvoiceTag{Name: "foreign"}
+	s.Require().NoError(tagService.CreateTag("other-user", foreign))
+	s.Error(tagService.CreateTag(s.setup.TestUserID, &models.InvoiceTag{Name: "nested", ParentID: &foreign.ID}))
+
+	// The update_tag tool moves a tag back to the top level with parent_id 0
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: s.setup.TestUserID})
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{"tag_id": float64(flightsID), "parent_id": float64(0)}
+	result, err := tools.NewUpdateTagTool(tagService).GetHandler()(ctx, request)
+	s.Require().NoError(err)
+	s.Require().False(result.IsError, result.Content[0].(mcp.TextContent).Text)
+	flights, err := tagService.GetTagByID(s.setup.TestUserID, flightsID)
+	s.Require().NoError(err)
+	s.Nil(flights.ParentID)
+
+	// Deleting a parent moves its children up to its own parent
+	flights.ParentID = &travelID
+	s.Require().NoError(tagService.UpdateTag(s.setup.TestUserID, flights))
+	s.Require().NoError(tagService.DeleteTag(s.setup.TestUserID, flightsID))
+	redEye, err = tagService.GetTagByID(s.setup.TestUserID, redEye.ID)
+	s.Require().NoError(err)
+	s.Require().NotNil(redEye.ParentID)
+	s.Equal(travelID, *redEye.ParentID)
+}
+
+func TestTagSuite(t *testing.T) {
+	suite.Run(t, new(TagTestSuite))
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -48,14 +49,20 @@ func NewTestSetup(t *testing.T) *TestSetup {
 	tagService := services.NewTagService(db)
 	invoiceService := services.NewInvoiceService(db, nil)
 	uploadService := services.NewMockUploadService()
-	fileUploadService := services.NewFileUploadService(db)
-	analyticsService := services.NewAnalyticsService(db)
 
 	// Create file unlink service with empty URL (will skip unlinking)
 	fileUnlinkService := services.NewFileUnlinkService(services.FileUnlinkConfig{
 		FileServerURL: "",
 	})
 
+	fileUploadService := services.NewFileUploadService(db, fileUnlinkService)
+	analyticsService := services.NewAnalyticsService(db)
+	settingsService := services.NewSettingsService(db)
+	budgetService := services.NewBudgetService(db, analyticsService, nil)
+	backupService := services.NewBackupService(db)
+	exportService := services.NewExportService(db, backupService, uploadService, services.NewJobManager(services.DefaultJobDrainTimeout))
+	healthService := services.NewHealthService(dbService, nil, uploadService)
+
 	// Create API server
 	apiServer := api.NewAPIServer(
 		dbService,
@@ -67,8 +74,14 @@ func NewTestSetup(t *testing.T) *TestSetup {
 		uploadService,
 		fileUploadService,
 		analyticsService,
+		settingsService,
+		budgetService,
+		backupService,
+		exportService,
 		fileUnlinkService,
 		nil, // No PDF service for tests
+		healthService,
+		services.NewOrganizationService(db),
 		nil, // No MCP server for tests
 	)
 
@@ -333,6 +346,10 @@ func SetupTestAuthMiddleware(app *fiber.App) {
 			user := &utils.AuthenticatedUser{
 				Sub: userID,
 			}
+			// Space-separated scopes, like the OAuth scope claim
+			if scopes := c.Get("X-Test-Scopes"); scopes != "" {
+				user.Scopes = strings.Split(scopes, " ")
+			}
 			c.Locals(middleware.AuthenticatedUserContextKey, user)
 		}
 		return c.Next()
@@ -408,8 +425,6 @@ func NewTestSetupWithFileServer(t *testing.T, fileServerURL string, withOAuth bo
 	tagService := services.NewTagService(db)
 	invoiceService := services.NewInvoiceService(db, nil)
 	uploadService := services.NewMockUploadService()
-	fileUploadService := services.NewFileUploadService(db)
-	analyticsService := services.NewAnalyticsService(db)
 
 	// Create file unlink service with the provided URL
 	fileUnlinkService := services.NewFileUnlinkService(services.FileUnlinkConfig{
@@ -417,6 +432,14 @@ func NewTestSetupWithFileServer(t *testing.T, fileServerURL string, withOAuth bo
 		Timeout:       5 * time.Second,
 	})
 
+	fileUploadService := services.NewFileUploadService(db, fileUnlinkService)
+	analyticsService := services.NewAnalyticsService(db)
+	settingsService := services.NewSettingsService(db)
+	budgetService := services.NewBudgetService(db, analyticsService, nil)
+	backupService := services.NewBackupService(db)
+	exportService := services.NewExportService(db, backupService, uploadService, services.NewJobManager(services.DefaultJobDrainTimeout))
+	healthService := services.NewHealthService(dbService, nil, uploadService)
+
 	// Create API server with file unlink service
 	apiServer := api.NewAPIServer(
 		dbService,
@@ -428,8 +451,14 @@ func NewTestSetupWithFileServer(t *testing.T, fileServerURL string, withOAuth bo
 		uploadService,
 		fileUploadService,
 		analyticsService,
+		settingsService,
+		budgetService,
+		backupService,
+		exportService,
 		fileUnlinkService,
 		nil, // No PDF service for tests
+		healthService,
+		services.NewOrganizationService(db),
 		nil, // No MCP server for tests
 	)
 
@@ -527,14 +556,20 @@ func NewTestSetupWithFXService(t *testing.T, fxService services.FXService) *Test
 	tagService := services.NewTagService(db)
 	invoiceService := services.NewInvoiceService(db, fxService)
 	uploadService := services.NewMockUploadService()
-	fileUploadService := services.NewFileUploadService(db)
-	analyticsService := services.NewAnalyticsService(db)
 
 	// Create file unlink service with empty URL (will skip unlinking)
 	fileUnlinkService := services.NewFileUnlinkService(services.FileUnlinkConfig{
 		FileServerURL: "",
 	})
 
+	fileUploadService := services.NewFileUploadService(db, fileUnlinkService)
+	analyticsService := services.NewAnalyticsService(db)
+	settingsService := services.NewSettingsService(db)
+	budgetService := services.NewBudgetService(db, analyticsService, fxService)
+	backupService := services.NewBackupService(db)
+	exportService := services.NewExportService(db, backupService, uploadService, services.NewJobManager(services.DefaultJobDrainTimeout))
+	healthService := services.NewHealthService(dbService, fxService, uploadService)
+
 	// Create API server
 	apiServer := api.NewAPIServer(
 		dbService,
@@ -546,8 +581,14 @@ func NewTestSetupWithFXService(t *testing.T, fxService services.FXService) *Test
 		uploadService,
 		fileUploadService,
 		analyticsService,
+		settingsService,
+		budgetService,
+		backupService,
+		exportService,
 		fileUnlinkService,
 		nil, // No PDF service for tests
+		healthService,
+		services.NewOrganizationService(db),
 		nil, // No MCP server for tests
 	)
 
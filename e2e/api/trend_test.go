@@ -0,0 +1,125 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type MonthlyTrendTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *MonthlyTrendTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *MonthlyTrendTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// getTrend fetches the monthly trend through the API
+func (s *MonthlyTrendTestSuite) getTrend(months int) []map[string]interface{} {
+	resp, err := s.setup.MakeRequest("GET", "/api/analytics/trend?months="+strconv.Itoa(months), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("USD", result["currency"])
+
+	var points []map[string]interface{}
+	for _, point := range result["data"].([]interface{}) {
+		points = append(points, point.(map[string]interface{}))
+	}
+	return points
+}
+
+func (s *MonthlyTrendTestSuite) TestMonthlyTrend() {
+	now := time.Now().UTC()
+	thisMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	_, err := s.setup.CreateTestInvoiceOnDate("This month", nil, nil, "paid", 100, thisMonth.Add(time.Hour))
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceOnDate("Two months ago", nil, nil, "unpaid", 40, thisMonth.AddDate(0, -2, 1))
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceOnDate("Also two months ago", nil, nil, "overdue", 10, thisMonth.AddDate(0, -2, 2))
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceOnDate("Too old", nil, nil, "paid", 999, thisMonth.AddDate(0, -12, 0))
+	s.Require().NoError(err)
+
+	points := s.getTrend(services.DefaultTrendMonths)
+	s.Require().Len(points, 12)
+	s.Equal(thisMonth.AddDate(0, -11, 0).Format("2006-01"), points[0]["month"])
+	s.Equal(thisMonth.Format("2006-01"), points[11]["month"])
+
+	s.Equal(100.0, points[11]["total_amount"])
+	s.Equal(100.0, points[11]["paid_amount"])
+	s.Equal(1.0, points[11]["invoice_count"])
+	s.Equal(50.0, points[9]["total_amount"])
+	s.Equal(0.0, points[9]["paid_amount"])
+	s.Equal(50.0, points[9]["unpaid_amount"])
+	s.Equal(2.0, points[9]["invoice_count"])
+	for _, i := range []int{0, 5, 10} {
+		s.Equal(0.0, points[i]["total_amount"])
+		s.Equal(0.0, points[i]["invoice_count"])
+	}
+}
+
+func (s *MonthlyTrendTestSuite) TestMonthBoundariesAcrossYears() {
+	// Two years always cross at least one year change
+	points, err := s.setup.AnalyticsService.GetMonthlyTrend(s.setup.TestUserID, 24)
+	s.Require().NoError(err)
+	s.Require().Len(points, 24)
+	for i, point := range points {
+		s.Equal(1, point.StartDate.Day())
+		s.Equal(point.StartDate.AddDate(0, 1, 0), point.EndDate)
+		s.Equal(point.StartDate.Format("2006-01"), point.Month)
+		if i > 0 {
+			s.Equal(points[i-1].EndDate, point.StartDate, "month %s", point.Month)
+		}
+	}
+}
+
+func (s *MonthlyTrendTestSuite) TestUserTimezone() {
+	resp, err := s.setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{
+		"base_currency": "USD",
+		"timezone":      "Asia/Tokyo",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	s.Require().NoError(err)
+	now := time.Now().In(tokyo)
+	thisMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, tokyo)
+
+	// Just after midnight in Tokyo on the 1st is still the previous month in UTC
+	_, err = s.setup.CreateTestInvoiceOnDate("Tokyo month start", nil, nil, "paid", 70, thisMonth.Add(30*time.Minute).UTC())
+	s.Require().NoError(err)
+	// Just before midnight in Tokyo on the last day of the previous month is already this month in UTC
+	_, err = s.setup.CreateTestInvoiceOnDate("Tokyo month end", nil, nil, "unpaid", 30, thisMonth.Add(-30*time.Minute).UTC())
+	s.Require().NoError(err)
+
+	points := s.getTrend(2)
+	s.Require().Len(points, 2)
+	s.Equal(thisMonth.Format(time.RFC3339), points[1]["start_date"])
+	s.Equal(70.0, points[1]["total_amount"])
+	s.Equal(30.0, points[0]["total_amount"])
+	s.Equal(30.0, points[0]["unpaid_amount"])
+}
+
+func (s *MonthlyTrendTestSuite) TestInvalidMonths() {
+	for _, months := range []string{"0", "-1", "121"} {
+		resp, err := s.setup.MakeRequest("GET", "/api/analytics/trend?months="+months, nil)
+		s.Require().NoError(err)
+		s.Equal(http.StatusBadRequest, resp.StatusCode, months)
+	}
+}
+
+func TestMonthlyTrendSuite(t *testing.T) {
+	suite.Run(t, new(MonthlyTrendTestSuite))
+}
@@ -0,0 +1,164 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type UnsupportedCurrencyTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *UnsupportedCurrencyTestSuite) SetupTest() {
+	fxService := services.NewMockFXService()
+	fxService.SetRate("EUR", "USD", 1.1)
+	s.setup = NewTestSetupWithFXService(s.T(), fxService)
+}
+
+func (s *UnsupportedCurrencyTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// createInvoice creates an invoice in currency with a single item of the given price through the
+// API and returns the status code and response body
+func (s *UnsupportedCurrencyTestSuite) createInvoice(currency string, unitPrice float64) (int, map[string]interface{}) {
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices", map[string]interface{}{
+		"title":    currency + " invoice",
+		"currency": currency,
+		"items":    []map[string]interface{}{{"description": "Service", "unit_price": unitPrice}},
+	})
+	s.Require().NoError(err)
+	body, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	return resp.StatusCode, body
+}
+
+// setPolicy sets the unsupported_currency setting and returns the status code
+func (s *UnsupportedCurrencyTestSuite) setPolicy(policy string) int {
+	resp, err := s.setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{
+		"base_currency":        "USD",
+		"unsupported_currency": policy,
+	})
+	s.Require().NoError(err)
+	return resp.StatusCode
+}
+
+// firstItem returns the first item of an invoice response
+func firstItem(invoice map[string]interface{}) map[string]interface{} {
+	return invoice["items"].([]interface{})[0].(map[string]interface{})
+}
+
+func (s *UnsupportedCurrencyTestSuite) TestValidCurrency() {
+	status, invoice := s.createInvoice("EUR", 100)
+	s.Require().Equal(http.StatusCreated, status)
+	item := firstItem(invoice)
+	s.InDelta(110.0, item["target_amount"], 0.001)
+	s.Equal(false, item["fx_unsupported"])
+
+	// Lower case codes are ISO codes too
+	status, _ = s.createInvoice("eur", 200)
+	s.Equal(http.StatusCreated, status)
+}
+
+func (s *UnsupportedCurrencyTestSuite) TestRejectedByDefault() {
+	status, body := s.createInvoice("XYZ", 100)
+	s.Equal(http.StatusBadRequest, status)
+	s.Contains(body["error"], `unsupported currency "XYZ"`)
+	s.Contains(body["error"], "unsupported_currency")
+
+	// Invoices without items are checked too
+	resp, err := s.setup.MakeRequest("POST", "/api/invoices", map[string]interface{}{"title": "Empty", "currency": "XYZ"})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	status, invoice := s.createInvoice("USD", 50)
+	s.Require().Equal(http.StatusCreated, status)
+	invoiceID := int(invoice["id"].(float64))
+	resp, err = s.setup.MakeRequest("POST", fmt.Sprintf("/api/invoices/%d/items", invoiceID), map[string]interface{}{
+		"description": "Foreign", "unit_price": 10, "currency": "ABC",
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	existing, err := s.setup.InvoiceService.GetInvoiceByID(s.setup.TestUserID, uint(invoiceID))
+	s.Require().NoError(err)
+	existing.Currency = "XYZ"
+	s.ErrorIs(s.setup.InvoiceService.UpdateInvoice(s.setup.TestUserID, existing), services.ErrUnsupportedCurrency)
+}
+
+func (s *UnsupportedCurrencyTestSuite) TestPassThrough() {
+	s.Equal(http.StatusBadRequest, s.setPolicy("convert_anyway"))
+	s.Require().Equal(http.StatusOK, s.setPolicy(string(models.UnsupportedCurrencyPassThrough)))
+
+	status, invoice := s.createInvoice("XYZ", 100)
+	s.Require().Equal(http.StatusCreated, status)
+	item := firstItem(invoice)
+	s.Equal(true, item["fx_unsupported"])
+	s.Equal(100.0, item["target_amount"])
+	s.Equal(1.0, item["fx_rate_used"])
+
+	// Supported items of the same invoice are converted as usual
+	invoiceID := uint(invoice["id"].(float64))
+	s.Require().NoError(s.setup.InvoiceService.AddInvoiceItem(s.setup.TestUserID, invoiceID, &models.InvoiceItem{
+		Description: "Euro fee", Quantity: 1, UnitPrice: 10, Currency: "EUR",
+	}))
+	stored, err := s.setup.InvoiceService.GetInvoiceByID(s.setup.TestUserID, invoiceID)
+	s.Require().NoError(err)
+	s.Require().Len(stored.Items, 2)
+	s.False(stored.Items[1].FXUnsupported)
+	s.InDelta(11.0, stored.Items[1].TargetAmount, 0.001)
+}
+
+// TestRejectAppliesToRecalculation verifies switching back to reject stops recalculations from
+// pricing existing unsupported items 1:1, and that such invoices are counted in the analytics
+func (s *UnsupportedCurrencyTestSuite) TestRejectAppliesToRecalculation() {
+	s.Require().Equal(http.StatusOK, s.setPolicy(string(models.UnsupportedCurrencyPassThrough)))
+	status, invoice := s.createInvoice("XYZ", 100)
+	s.Require().Equal(http.StatusCreated, status)
+	invoiceID := uint(invoice["id"].(float64))
+	status, _ = s.createInvoice("EUR", 50)
+	s.Require().Equal(http.StatusCreated, status)
+
+	resp, err := s.setup.MakeRequest("GET", "/api/analytics/summary", nil)
+	s.Require().NoError(err)
+	summary, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal(2.0, summary["invoice_count"])
+	s.Equal(1.0, summary["fx_unsupported_count"])
+
+	stats, err := s.setup.AnalyticsService.WithoutCache().GetStatistics(s.setup.TestUserID, services.StatisticsOptions{Period: services.PeriodLastMonth})
+	s.Require().NoError(err)
+	s.Equal(int64(1), stats.FXUnsupportedCount)
+
+	s.Require().Equal(http.StatusOK, s.setPolicy(string(models.UnsupportedCurrencyReject)))
+	_, err = s.setup.InvoiceService.RecalculateTotals(s.setup.TestUserID, invoiceID)
+	s.ErrorIs(err, services.ErrUnsupportedCurrency)
+	_, err = s.setup.InvoiceService.RefreshFXForCurrency(s.setup.TestUserID, "XYZ", false)
+	s.ErrorIs(err, services.ErrUnsupportedCurrency)
+}
+
+func (s *UnsupportedCurrencyTestSuite) TestFXServiceRejectsUnknownCurrency() {
+	provider := &stubFXProvider{name: "stub", rate: 2}
+	fxService := services.NewFXService(nil, []services.FXProvider{provider}, 0)
+
+	_, err := fxService.GetExchangeRate(context.Background(), "XYZ", "USD")
+	s.ErrorIs(err, services.ErrUnsupportedCurrency)
+	_, _, err = fxService.ConvertAmount(context.Background(), 10, "USD", "ABC")
+	s.ErrorIs(err, services.ErrUnsupportedCurrency)
+	s.Zero(provider.calls)
+
+	rate, err := fxService.GetExchangeRate(context.Background(), "EUR", "USD")
+	s.Require().NoError(err)
+	s.Equal(2.0, rate.Rate)
+}
+
+func TestUnsupportedCurrencySuite(t *testing.T) {
+	suite.Run(t, new(UnsupportedCurrencyTestSuite))
+}
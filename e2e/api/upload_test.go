@@ -1,12 +1,21 @@
 package api
 
 import (
+	"bufio"
 	"bytes"
+	"context"
+	"fmt"
+	"io"
 	"mime/multipart"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/textproto"
 	"testing"
+	"time"
 
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -104,6 +113,130 @@ func (s *UploadTestSuite) TestGetPresignedURLMissingFilename() {
 	s.Equal(http.StatusBadRequest, resp.StatusCode)
 }
 
+// upload posts content as the file of a multipart upload with the given part Content-Type
+func (s *UploadTestSuite) upload(filename, contentType string, content []byte) *http.Response {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", `form-data; name="file"; filename="`+filename+`"`)
+	if contentType != "" {
+		header.Set("Content-Type", contentType)
+	}
+	part, err := writer.CreatePart(header)
+	s.Require().NoError(err)
+	_, err = part.Write(content)
+	s.Require().NoError(err)
+	s.Require().NoError(writer.Close())
+
+	req := httptest.NewRequest("POST", "/api/upload", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("X-Test-User-ID", s.setup.TestUserID)
+	resp, err := s.setup.App.Test(req, -1)
+	s.Require().NoError(err)
+	return resp
+}
+
+// storedUploads counts the uploads recorded for the test user
+func (s *UploadTestSuite) storedUploads() int64 {
+	var count int64
+	s.Require().NoError(s.setup.DBService.GetDB().Model(&models.FileUpload{}).Where("user_id = ?", s.setup.TestUserID).Count(&count).Error)
+	return count
+}
+
+func (s *UploadTestSuite) TestUploadContentTypes() {
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+	// Missing and generic content types are sniffed
+	resp := s.upload("receipt.png", "", png)
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("image/png", result["content_type"])
+	s.Equal(float64(len(png)), result["size"])
+
+	resp = s.upload("scan.jpg", "image/jpeg; name=scan.jpg", []byte("\xff\xd8\xff\xe0\x00\x10JFIF"))
+	s.Require().Equal(http.StatusCreated, resp.StatusCode)
+	result, err = s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Equal("image/jpeg", result["content_type"])
+
+	for contentType, content := range map[string]string{
+		"text/html":                "<html></html>",
+		"application/octet-stream": "plain text notes",
+		"application/zip":          "PK",
+		// The declared type is never trusted: the content must match an allowed type
+		"image/png":       "<html><script></script></html>",
+		"application/pdf": "plain text notes",
+	} {
+		resp := s.upload("file", contentType, []byte(content))
+		s.Equal(http.StatusUnsupportedMediaType, resp.StatusCode, contentType)
+	}
+	s.Equal(int64(2), s.storedUploads())
+}
+
+func (s *UploadTestSuite) TestUploadTooLarge() {
+	defer func(max int64) { services.MaxUploadBytes = max }(services.MaxUploadBytes)
+	services.MaxUploadBytes = 1024
+
+	pdf := append([]byte("%PDF-1.4\n"), bytes.Repeat([]byte("0"), 1024)...)
+	resp := s.upload("large.pdf", "application/pdf", pdf[:1024])
+	s.Equal(http.StatusCreated, resp.StatusCode)
+
+	resp = s.upload("large.pdf", "application/pdf", pdf)
+	s.Equal(http.StatusRequestEntityTooLarge, resp.StatusCode)
+	result, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+	s.Contains(result["error"], "presigned URL")
+	s.Equal(int64(1), s.storedUploads())
+}
+
+func (s *UploadTestSuite) TestOversizedBodyRejected() {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+	go func() { _ = s.setup.App.Listener(listener) }()
+	defer func() { _ = s.setup.App.Shutdown() }()
+
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	s.Require().NoError(err)
+	defer conn.Close()
+	s.Require().NoError(conn.SetDeadline(time.Now().Add(10 * time.Second)))
+
+	// Announce a 1 GiB body but only send the headers and the first bytes: the request is rejected
+	// from its Content-Length without waiting for the rest of the body
+	_, err = fmt.Fprintf(conn, "POST /api/upload HTTP/1.1\r\nHost: localhost\r\n"+
+		"Content-Type: multipart/form-data; boundary=x\r\nX-Test-User-ID: %s\r\nContent-Length: %d\r\n\r\n--x\r\n",
+		s.setup.TestUserID, 1<<30)
+	s.Require().NoError(err)
+
+	resp, err := http.ReadResponse(bufio.NewReader(conn), nil)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+	s.Equal(http.StatusRequestEntityTooLarge, resp.StatusCode)
+	s.Zero(s.storedUploads())
+}
+
+// endlessReader yields zeros forever
+type endlessReader struct{}
+
+func (endlessReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
+
+func (s *UploadTestSuite) TestUploadStreamStopsAtLimit() {
+	defer func(max int64) { services.MaxUploadBytes = max }(services.MaxUploadBytes)
+	services.MaxUploadBytes = 4096
+
+	// An endless upload is cut off at the limit instead of being read into memory
+	_, _, err := s.setup.UploadService.UploadStream(context.Background(), s.setup.TestUserID, "endless.pdf",
+		services.LimitUpload(endlessReader{}), "application/pdf")
+	s.ErrorIs(err, services.ErrUploadTooLarge)
+
+	content, err := io.ReadAll(services.LimitUpload(bytes.NewReader(make([]byte, 4096))))
+	s.Require().NoError(err)
+	s.Len(content, 4096)
+}
+
 func TestUploadSuite(t *testing.T) {
 	suite.Run(t, new(UploadTestSuite))
 }
@@ -0,0 +1,116 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type VendorStatementTestSuite struct {
+	suite.Suite
+	setup      *TestSetup
+	receiverID uint
+}
+
+func (s *VendorStatementTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+
+	receiverID, err := s.setup.CreateTestReceiver("Landlord", true)
+	s.Require().NoError(err)
+	s.receiverID = receiverID
+}
+
+func (s *VendorStatementTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+// createReceiverInvoice creates an invoice for the suite's receiver dated daysAgo
+func (s *VendorStatementTestSuite) createReceiverInvoice(title, status string, amount float64, daysAgo int) uint {
+	invoiceID, err := s.setup.CreateTestInvoiceOnDate(title, nil, nil, status, amount, DaysAgo(daysAgo))
+	s.Require().NoError(err)
+	s.Require().NoError(s.setup.DBService.GetDB().
+		Exec("UPDATE invoices SET receiver_id = ? WHERE id = ?", s.receiverID, invoiceID).Error)
+	return invoiceID
+}
+
+func (s *VendorStatementTestSuite) statementPath(receiverID uint, start, end time.Time) string {
+	return "/api/receivers/" + uintToString(receiverID) + "/statement?start=" + start.UTC().Format(time.RFC3339) +
+		"&end=" + end.UTC().Format(time.RFC3339)
+}
+
+func (s *VendorStatementTestSuite) TestStatement() {
+	s.createReceiverInvoice("January rent", "unpaid", 100, 30)
+	s.createReceiverInvoice("February rent", "paid", 50, 25)
+	marchID := s.createReceiverInvoice("March rent", "paid", 200, 10)
+	s.createReceiverInvoice("April rent", "unpaid", 80, 5)
+	creditID := s.createReceiverInvoice("March rent credit", "paid", 20, 3)
+	s.Require().NoError(s.setup.InvoiceService.LinkInvoices(s.setup.TestUserID, creditID, marchID, models.InvoiceRelationCreditNote))
+
+	// Another receiver's invoice stays off the statement
+	_, err := s.setup.CreateTestInvoiceOnDate("Groceries", nil, nil, "unpaid", 35, DaysAgo(4))
+	s.Require().NoError(err)
+
+	resp, err := s.setup.MakeRequest("GET", s.statementPath(s.receiverID, DaysAgo(20), time.Now()), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	statement, err := s.setup.ReadResponseBody(resp)
+	s.Require().NoError(err)
+
+	s.Equal("Landlord", statement["name"])
+	s.Equal("USD", statement["currency"])
+	s.Equal(100.0, statement["opening_balance"])
+	s.Equal(260.0, statement["total_billed"])
+	s.Equal(180.0, statement["total_paid"])
+	s.Equal(80.0, statement["outstanding"])
+	s.Equal(180.0, statement["closing_balance"])
+
+	invoices := statement["invoices"].([]interface{})
+	s.Require().Len(invoices, 3)
+	var titles []interface{}
+	var balances []interface{}
+	for _, entry := range invoices {
+		titles = append(titles, entry.(map[string]interface{})["title"])
+		balances = append(balances, entry.(map[string]interface{})["balance"])
+	}
+	s.Equal([]interface{}{"March rent", "April rent", "March rent credit"}, titles)
+	s.Equal([]interface{}{100.0, 180.0, 180.0}, balances)
+	s.Equal(-20.0, invoices[2].(map[string]interface{})["billed"])
+}
+
+func (s *VendorStatementTestSuite) TestStatementPDF() {
+	s.createReceiverInvoice("March rent", "unpaid", 200, 10)
+
+	statement, err := s.setup.AnalyticsService.GenerateVendorStatement(s.setup.TestUserID, s.receiverID, DaysAgo(20), time.Now())
+	s.Require().NoError(err)
+	pdf, err := services.NewMockPDFService().RenderVendorStatement(context.Background(), statement)
+	s.Require().NoError(err)
+	s.Contains(string(pdf), "%PDF")
+
+	// The test server has no PDF service
+	resp, err := s.setup.MakeRequest("GET", s.statementPath(s.receiverID, DaysAgo(20), time.Now())+"&format=pdf", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusInternalServerError, resp.StatusCode)
+}
+
+func (s *VendorStatementTestSuite) TestInvalidRequests() {
+	resp, err := s.setup.MakeRequest("GET", s.statementPath(s.receiverID, time.Now(), DaysAgo(20)), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", "/api/receivers/"+uintToString(s.receiverID)+"/statement", nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = s.setup.MakeRequest("GET", s.statementPath(99999, DaysAgo(20), time.Now()), nil)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func TestVendorStatementSuite(t *testing.T) {
+	suite.Run(t, new(VendorStatementTestSuite))
+}
@@ -0,0 +1,86 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type WeekdaySpendingTestSuite struct {
+	suite.Suite
+	setup *TestSetup
+}
+
+func (s *WeekdaySpendingTestSuite) SetupTest() {
+	s.setup = NewTestSetup(s.T())
+}
+
+func (s *WeekdaySpendingTestSuite) TearDownTest() {
+	s.setup.Cleanup()
+}
+
+var weekdays = []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
+
+func (s *WeekdaySpendingTestSuite) TestAllDaysMondayFirst() {
+	spending, err := s.setup.AnalyticsService.GetByWeekday(s.setup.TestUserID, services.Period1Month, services.DateFieldDefault)
+	s.Require().NoError(err)
+	s.Require().Len(spending.Days, 7)
+	for i, day := range spending.Days {
+		s.Equal(weekdays[i], day.Weekday)
+		s.Zero(day.Amount)
+		s.Zero(day.InvoiceCount)
+	}
+	s.Equal("USD", spending.Currency)
+	s.Equal("UTC", spending.Timezone)
+}
+
+func (s *WeekdaySpendingTestSuite) TestUserTimezone() {
+	resp, err := s.setup.MakeRequest("PUT", "/api/settings", map[string]interface{}{
+		"base_currency": "USD",
+		"timezone":      "Asia/Tokyo",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	s.Require().NoError(err)
+	now := time.Now().In(tokyo)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, tokyo)
+	// Monday of last week, so every invoice below is in the past month
+	monday := today.AddDate(0, 0, -(int(today.Weekday())+6)%7-7)
+
+	// Just after midnight on Monday in Tokyo is still Sunday in UTC
+	_, err = s.setup.CreateTestInvoiceOnDate("Monday early", nil, nil, "paid", 70, monday.Add(30*time.Minute).UTC())
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceOnDate("Monday late", nil, nil, "unpaid", 20, monday.Add(20*time.Hour).UTC())
+	s.Require().NoError(err)
+	_, err = s.setup.CreateTestInvoiceOnDate("Wednesday", nil, nil, "paid", 30, monday.AddDate(0, 0, 2).Add(12*time.Hour).UTC())
+	s.Require().NoError(err)
+
+	spending, err := s.setup.AnalyticsService.GetByWeekday(s.setup.TestUserID, services.Period1Month, services.DateFieldCreatedAt)
+	s.Require().NoError(err)
+	s.Equal("Asia/Tokyo", spending.Timezone)
+	s.Require().Len(spending.Days, 7)
+	for i, day := range spending.Days {
+		s.Equal(weekdays[i], day.Weekday)
+	}
+	s.Equal(90.0, spending.Days[0].Amount)
+	s.Equal(int64(2), spending.Days[0].InvoiceCount)
+	s.Equal(30.0, spending.Days[2].Amount)
+	s.Equal(int64(1), spending.Days[2].InvoiceCount)
+	s.Zero(spending.Days[6].Amount)
+
+	// Invoices without a due date are left out when grouping by it
+	spending, err = s.setup.AnalyticsService.GetByWeekday(s.setup.TestUserID, services.Period1Month, services.DateFieldDueDate)
+	s.Require().NoError(err)
+	for _, day := range spending.Days {
+		s.Zero(day.InvoiceCount)
+	}
+}
+
+func TestWeekdaySpendingSuite(t *testing.T) {
+	suite.Run(t, new(WeekdaySpendingTestSuite))
+}
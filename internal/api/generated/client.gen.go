@@ -104,6 +104,23 @@ type ClientInterface interface {
 	// GetAnalyticsSummary request
 	GetAnalyticsSummary(ctx context.Context, params *GetAnalyticsSummaryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetAnalyticsTrend request
+	GetAnalyticsTrend(ctx context.Context, params *GetAnalyticsTrendParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListBudgets request
+	ListBudgets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateBudgetWithBody request with any body
+	CreateBudgetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateBudget(ctx context.Context, body CreateBudgetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBudgetStatus request
+	GetBudgetStatus(ctx context.Context, params *GetBudgetStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteBudget request
+	DeleteBudget(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListCategories request
 	ListCategories(ctx context.Context, params *ListCategoriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -113,7 +130,7 @@ type ClientInterface interface {
 	CreateCategory(ctx context.Context, body CreateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteCategory request
-	DeleteCategory(ctx context.Context, id CategoryId, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteCategory(ctx context.Context, id CategoryId, params *DeleteCategoryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetCategory request
 	GetCategory(ctx context.Context, id CategoryId, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -132,7 +149,7 @@ type ClientInterface interface {
 	CreateCompany(ctx context.Context, body CreateCompanyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteCompany request
-	DeleteCompany(ctx context.Context, id CompanyId, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteCompany(ctx context.Context, id CompanyId, params *DeleteCompanyParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetCompany request
 	GetCompany(ctx context.Context, id CompanyId, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -142,9 +159,29 @@ type ClientInterface interface {
 
 	UpdateCompany(ctx context.Context, id CompanyId, body UpdateCompanyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetCompanyBreakdown request
+	GetCompanyBreakdown(ctx context.Context, id CompanyId, params *GetCompanyBreakdownParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDashboard request
+	GetDashboard(ctx context.Context, params *GetDashboardParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportData request
+	ExportData(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RequestExport request
+	RequestExport(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetExportJob request
+	GetExportJob(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetFileDownloadURL request
 	GetFileDownloadURL(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ImportDataWithBody request with any body
+	ImportDataWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ImportData(ctx context.Context, body ImportDataJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListInvoices request
 	ListInvoices(ctx context.Context, params *ListInvoicesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -153,27 +190,85 @@ type ClientInterface interface {
 
 	CreateInvoice(ctx context.Context, body CreateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetInvoiceFacets request
+	GetInvoiceFacets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportInvoicesWithBody request with any body
+	ImportInvoicesWithBody(ctx context.Context, params *ImportInvoicesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LookupInvoices request
+	LookupInvoices(ctx context.Context, params *LookupInvoicesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetInvoiceStatusCounts request
+	GetInvoiceStatusCounts(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteInvoice request
 	DeleteInvoice(ctx context.Context, id InvoiceId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetInvoice request
-	GetInvoice(ctx context.Context, id InvoiceId, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetInvoice(ctx context.Context, id InvoiceId, params *GetInvoiceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpdateInvoiceWithBody request with any body
 	UpdateInvoiceWithBody(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	UpdateInvoice(ctx context.Context, id InvoiceId, body UpdateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListInvoiceAttachments request
+	ListInvoiceAttachments(ctx context.Context, id InvoiceId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddInvoiceAttachmentWithBody request with any body
+	AddInvoiceAttachmentWithBody(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddInvoiceAttachment(ctx context.Context, id InvoiceId, body AddInvoiceAttachmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveInvoiceAttachment request
+	RemoveInvoiceAttachment(ctx context.Context, id InvoiceId, attachmentId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetInvoiceAuditTrail request
+	GetInvoiceAuditTrail(ctx context.Context, id InvoiceId, params *GetInvoiceAuditTrailParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CloneInvoiceWithBody request with any body
+	CloneInvoiceWithBody(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CloneInvoice(ctx context.Context, id InvoiceId, body CloneInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PreviewCurrencyConversionWithBody request with any body
+	PreviewCurrencyConversionWithBody(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PreviewCurrencyConversion(ctx context.Context, id InvoiceId, body PreviewCurrencyConversionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FinalizeInvoice request
+	FinalizeInvoice(ctx context.Context, id InvoiceId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// AddInvoiceItemWithBody request with any body
 	AddInvoiceItemWithBody(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	AddInvoiceItem(ctx context.Context, id InvoiceId, body AddInvoiceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AddInvoiceItemsWithBody request with any body
+	AddInvoiceItemsWithBody(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddInvoiceItems(ctx context.Context, id InvoiceId, body AddInvoiceItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReorderInvoiceItemsWithBody request with any body
+	ReorderInvoiceItemsWithBody(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReorderInvoiceItems(ctx context.Context, id InvoiceId, body ReorderInvoiceItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RecalculateInvoiceTotals request
+	RecalculateInvoiceTotals(ctx context.Context, id InvoiceId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindSimilarInvoices request
+	FindSimilarInvoices(ctx context.Context, id InvoiceId, params *FindSimilarInvoicesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UpdateInvoiceStatusWithBody request with any body
 	UpdateInvoiceStatusWithBody(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	UpdateInvoiceStatus(ctx context.Context, id InvoiceId, body UpdateInvoiceStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetInvoiceStatusHistory request
+	GetInvoiceStatusHistory(ctx context.Context, id InvoiceId, params *GetInvoiceStatusHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// AddTagToInvoiceWithBody request with any body
 	AddTagToInvoiceWithBody(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -190,6 +285,28 @@ type ClientInterface interface {
 
 	UpdateInvoiceItem(ctx context.Context, invoiceId int, itemId int, body UpdateInvoiceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListOrganizations request
+	ListOrganizations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateOrganizationWithBody request with any body
+	CreateOrganizationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateOrganization(ctx context.Context, body CreateOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListOrganizationMembers request
+	ListOrganizationMembers(ctx context.Context, id OrganizationId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveOrganizationMember request
+	RemoveOrganizationMember(ctx context.Context, id OrganizationId, userId MemberUserId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetOrganizationMemberWithBody request with any body
+	SetOrganizationMemberWithBody(ctx context.Context, id OrganizationId, userId MemberUserId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetOrganizationMember(ctx context.Context, id OrganizationId, userId MemberUserId, body SetOrganizationMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPaymentMethods request
+	ListPaymentMethods(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListReceivers request
 	ListReceivers(ctx context.Context, params *ListReceiversParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -204,7 +321,7 @@ type ClientInterface interface {
 	MergeReceivers(ctx context.Context, body MergeReceiversJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteReceiver request
-	DeleteReceiver(ctx context.Context, id ReceiverId, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteReceiver(ctx context.Context, id ReceiverId, params *DeleteReceiverParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetReceiver request
 	GetReceiver(ctx context.Context, id ReceiverId, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -214,6 +331,20 @@ type ClientInterface interface {
 
 	UpdateReceiver(ctx context.Context, id ReceiverId, body UpdateReceiverJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetReceiverStatement request
+	GetReceiverStatement(ctx context.Context, id ReceiverId, params *GetReceiverStatementParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetReceiverStatistics request
+	GetReceiverStatistics(ctx context.Context, id ReceiverId, params *GetReceiverStatisticsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSettings request
+	GetSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateSettingsWithBody request with any body
+	UpdateSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSettings(ctx context.Context, body UpdateSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListTags request
 	ListTags(ctx context.Context, params *ListTagsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -313,6 +444,78 @@ func (c *Client) GetAnalyticsSummary(ctx context.Context, params *GetAnalyticsSu
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetAnalyticsTrend(ctx context.Context, params *GetAnalyticsTrendParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAnalyticsTrendRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListBudgets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBudgetsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBudgetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBudgetRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBudget(ctx context.Context, body CreateBudgetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBudgetRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBudgetStatus(ctx context.Context, params *GetBudgetStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBudgetStatusRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteBudget(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteBudgetRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ListCategories(ctx context.Context, params *ListCategoriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewListCategoriesRequest(c.Server, params)
 	if err != nil {
@@ -349,8 +552,8 @@ func (c *Client) CreateCategory(ctx context.Context, body CreateCategoryJSONRequ
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteCategory(ctx context.Context, id CategoryId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteCategoryRequest(c.Server, id)
+func (c *Client) DeleteCategory(ctx context.Context, id CategoryId, params *DeleteCategoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCategoryRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -433,8 +636,8 @@ func (c *Client) CreateCompany(ctx context.Context, body CreateCompanyJSONReques
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteCompany(ctx context.Context, id CompanyId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteCompanyRequest(c.Server, id)
+func (c *Client) DeleteCompany(ctx context.Context, id CompanyId, params *DeleteCompanyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCompanyRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -481,8 +684,8 @@ func (c *Client) UpdateCompany(ctx context.Context, id CompanyId, body UpdateCom
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetFileDownloadURL(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetFileDownloadURLRequest(c.Server, key)
+func (c *Client) GetCompanyBreakdown(ctx context.Context, id CompanyId, params *GetCompanyBreakdownParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCompanyBreakdownRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -493,8 +696,8 @@ func (c *Client) GetFileDownloadURL(ctx context.Context, key string, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListInvoices(ctx context.Context, params *ListInvoicesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListInvoicesRequest(c.Server, params)
+func (c *Client) GetDashboard(ctx context.Context, params *GetDashboardParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDashboardRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -505,8 +708,8 @@ func (c *Client) ListInvoices(ctx context.Context, params *ListInvoicesParams, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateInvoiceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateInvoiceRequestWithBody(c.Server, contentType, body)
+func (c *Client) ExportData(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportDataRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -517,8 +720,8 @@ func (c *Client) CreateInvoiceWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateInvoice(ctx context.Context, body CreateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateInvoiceRequest(c.Server, body)
+func (c *Client) RequestExport(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestExportRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -529,8 +732,8 @@ func (c *Client) CreateInvoice(ctx context.Context, body CreateInvoiceJSONReques
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteInvoice(ctx context.Context, id InvoiceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteInvoiceRequest(c.Server, id)
+func (c *Client) GetExportJob(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetExportJobRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -541,8 +744,8 @@ func (c *Client) DeleteInvoice(ctx context.Context, id InvoiceId, reqEditors ...
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetInvoice(ctx context.Context, id InvoiceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetInvoiceRequest(c.Server, id)
+func (c *Client) GetFileDownloadURL(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFileDownloadURLRequest(c.Server, key)
 	if err != nil {
 		return nil, err
 	}
@@ -553,8 +756,8 @@ func (c *Client) GetInvoice(ctx context.Context, id InvoiceId, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateInvoiceWithBody(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateInvoiceRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) ImportDataWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportDataRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -565,8 +768,8 @@ func (c *Client) UpdateInvoiceWithBody(ctx context.Context, id InvoiceId, conten
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateInvoice(ctx context.Context, id InvoiceId, body UpdateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateInvoiceRequest(c.Server, id, body)
+func (c *Client) ImportData(ctx context.Context, body ImportDataJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportDataRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -577,8 +780,8 @@ func (c *Client) UpdateInvoice(ctx context.Context, id InvoiceId, body UpdateInv
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddInvoiceItemWithBody(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddInvoiceItemRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) ListInvoices(ctx context.Context, params *ListInvoicesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListInvoicesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -589,8 +792,8 @@ func (c *Client) AddInvoiceItemWithBody(ctx context.Context, id InvoiceId, conte
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddInvoiceItem(ctx context.Context, id InvoiceId, body AddInvoiceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddInvoiceItemRequest(c.Server, id, body)
+func (c *Client) CreateInvoiceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInvoiceRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -601,8 +804,8 @@ func (c *Client) AddInvoiceItem(ctx context.Context, id InvoiceId, body AddInvoi
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateInvoiceStatusWithBody(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateInvoiceStatusRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) CreateInvoice(ctx context.Context, body CreateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInvoiceRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -613,8 +816,8 @@ func (c *Client) UpdateInvoiceStatusWithBody(ctx context.Context, id InvoiceId,
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateInvoiceStatus(ctx context.Context, id InvoiceId, body UpdateInvoiceStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateInvoiceStatusRequest(c.Server, id, body)
+func (c *Client) GetInvoiceFacets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInvoiceFacetsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -625,8 +828,8 @@ func (c *Client) UpdateInvoiceStatus(ctx context.Context, id InvoiceId, body Upd
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddTagToInvoiceWithBody(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddTagToInvoiceRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) ImportInvoicesWithBody(ctx context.Context, params *ImportInvoicesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportInvoicesRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -637,8 +840,8 @@ func (c *Client) AddTagToInvoiceWithBody(ctx context.Context, id InvoiceId, cont
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddTagToInvoice(ctx context.Context, id InvoiceId, body AddTagToInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddTagToInvoiceRequest(c.Server, id, body)
+func (c *Client) LookupInvoices(ctx context.Context, params *LookupInvoicesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLookupInvoicesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -649,8 +852,8 @@ func (c *Client) AddTagToInvoice(ctx context.Context, id InvoiceId, body AddTagT
 	return c.Client.Do(req)
 }
 
-func (c *Client) RemoveTagFromInvoice(ctx context.Context, id InvoiceId, tagId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRemoveTagFromInvoiceRequest(c.Server, id, tagId)
+func (c *Client) GetInvoiceStatusCounts(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInvoiceStatusCountsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -661,8 +864,8 @@ func (c *Client) RemoveTagFromInvoice(ctx context.Context, id InvoiceId, tagId i
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteInvoiceItem(ctx context.Context, invoiceId int, itemId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteInvoiceItemRequest(c.Server, invoiceId, itemId)
+func (c *Client) DeleteInvoice(ctx context.Context, id InvoiceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteInvoiceRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -673,8 +876,8 @@ func (c *Client) DeleteInvoiceItem(ctx context.Context, invoiceId int, itemId in
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateInvoiceItemWithBody(ctx context.Context, invoiceId int, itemId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateInvoiceItemRequestWithBody(c.Server, invoiceId, itemId, contentType, body)
+func (c *Client) GetInvoice(ctx context.Context, id InvoiceId, params *GetInvoiceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInvoiceRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -685,8 +888,8 @@ func (c *Client) UpdateInvoiceItemWithBody(ctx context.Context, invoiceId int, i
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateInvoiceItem(ctx context.Context, invoiceId int, itemId int, body UpdateInvoiceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateInvoiceItemRequest(c.Server, invoiceId, itemId, body)
+func (c *Client) UpdateInvoiceWithBody(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateInvoiceRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -697,8 +900,8 @@ func (c *Client) UpdateInvoiceItem(ctx context.Context, invoiceId int, itemId in
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListReceivers(ctx context.Context, params *ListReceiversParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListReceiversRequest(c.Server, params)
+func (c *Client) UpdateInvoice(ctx context.Context, id InvoiceId, body UpdateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateInvoiceRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -709,8 +912,8 @@ func (c *Client) ListReceivers(ctx context.Context, params *ListReceiversParams,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateReceiverWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateReceiverRequestWithBody(c.Server, contentType, body)
+func (c *Client) ListInvoiceAttachments(ctx context.Context, id InvoiceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListInvoiceAttachmentsRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -721,8 +924,8 @@ func (c *Client) CreateReceiverWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateReceiver(ctx context.Context, body CreateReceiverJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateReceiverRequest(c.Server, body)
+func (c *Client) AddInvoiceAttachmentWithBody(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddInvoiceAttachmentRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -733,8 +936,8 @@ func (c *Client) CreateReceiver(ctx context.Context, body CreateReceiverJSONRequ
 	return c.Client.Do(req)
 }
 
-func (c *Client) MergeReceiversWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMergeReceiversRequestWithBody(c.Server, contentType, body)
+func (c *Client) AddInvoiceAttachment(ctx context.Context, id InvoiceId, body AddInvoiceAttachmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddInvoiceAttachmentRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -745,8 +948,8 @@ func (c *Client) MergeReceiversWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) MergeReceivers(ctx context.Context, body MergeReceiversJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMergeReceiversRequest(c.Server, body)
+func (c *Client) RemoveInvoiceAttachment(ctx context.Context, id InvoiceId, attachmentId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveInvoiceAttachmentRequest(c.Server, id, attachmentId)
 	if err != nil {
 		return nil, err
 	}
@@ -757,8 +960,8 @@ func (c *Client) MergeReceivers(ctx context.Context, body MergeReceiversJSONRequ
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteReceiver(ctx context.Context, id ReceiverId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteReceiverRequest(c.Server, id)
+func (c *Client) GetInvoiceAuditTrail(ctx context.Context, id InvoiceId, params *GetInvoiceAuditTrailParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInvoiceAuditTrailRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -769,8 +972,8 @@ func (c *Client) DeleteReceiver(ctx context.Context, id ReceiverId, reqEditors .
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetReceiver(ctx context.Context, id ReceiverId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetReceiverRequest(c.Server, id)
+func (c *Client) CloneInvoiceWithBody(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCloneInvoiceRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -781,8 +984,8 @@ func (c *Client) GetReceiver(ctx context.Context, id ReceiverId, reqEditors ...R
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateReceiverWithBody(ctx context.Context, id ReceiverId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateReceiverRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) CloneInvoice(ctx context.Context, id InvoiceId, body CloneInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCloneInvoiceRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -793,8 +996,8 @@ func (c *Client) UpdateReceiverWithBody(ctx context.Context, id ReceiverId, cont
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateReceiver(ctx context.Context, id ReceiverId, body UpdateReceiverJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateReceiverRequest(c.Server, id, body)
+func (c *Client) PreviewCurrencyConversionWithBody(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewCurrencyConversionRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -805,8 +1008,8 @@ func (c *Client) UpdateReceiver(ctx context.Context, id ReceiverId, body UpdateR
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListTags(ctx context.Context, params *ListTagsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListTagsRequest(c.Server, params)
+func (c *Client) PreviewCurrencyConversion(ctx context.Context, id InvoiceId, body PreviewCurrencyConversionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewCurrencyConversionRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -817,8 +1020,8 @@ func (c *Client) ListTags(ctx context.Context, params *ListTagsParams, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateTagWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTagRequestWithBody(c.Server, contentType, body)
+func (c *Client) FinalizeInvoice(ctx context.Context, id InvoiceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFinalizeInvoiceRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -829,8 +1032,8 @@ func (c *Client) CreateTagWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateTag(ctx context.Context, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTagRequest(c.Server, body)
+func (c *Client) AddInvoiceItemWithBody(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddInvoiceItemRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -841,8 +1044,8 @@ func (c *Client) CreateTag(ctx context.Context, body CreateTagJSONRequestBody, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteTag(ctx context.Context, id TagId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteTagRequest(c.Server, id)
+func (c *Client) AddInvoiceItem(ctx context.Context, id InvoiceId, body AddInvoiceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddInvoiceItemRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -853,8 +1056,8 @@ func (c *Client) DeleteTag(ctx context.Context, id TagId, reqEditors ...RequestE
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetTag(ctx context.Context, id TagId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTagRequest(c.Server, id)
+func (c *Client) AddInvoiceItemsWithBody(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddInvoiceItemsRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -865,8 +1068,8 @@ func (c *Client) GetTag(ctx context.Context, id TagId, reqEditors ...RequestEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTagWithBody(ctx context.Context, id TagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTagRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) AddInvoiceItems(ctx context.Context, id InvoiceId, body AddInvoiceItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddInvoiceItemsRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -877,8 +1080,8 @@ func (c *Client) UpdateTagWithBody(ctx context.Context, id TagId, contentType st
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTag(ctx context.Context, id TagId, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTagRequest(c.Server, id, body)
+func (c *Client) ReorderInvoiceItemsWithBody(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReorderInvoiceItemsRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -889,8 +1092,8 @@ func (c *Client) UpdateTag(ctx context.Context, id TagId, body UpdateTagJSONRequ
 	return c.Client.Do(req)
 }
 
-func (c *Client) UploadFileWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUploadFileRequestWithBody(c.Server, contentType, body)
+func (c *Client) ReorderInvoiceItems(ctx context.Context, id InvoiceId, body ReorderInvoiceItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReorderInvoiceItemsRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -901,8 +1104,8 @@ func (c *Client) UploadFileWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) ConfirmPresignedUploadWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewConfirmPresignedUploadRequestWithBody(c.Server, contentType, body)
+func (c *Client) RecalculateInvoiceTotals(ctx context.Context, id InvoiceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRecalculateInvoiceTotalsRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -913,8 +1116,8 @@ func (c *Client) ConfirmPresignedUploadWithBody(ctx context.Context, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) ConfirmPresignedUpload(ctx context.Context, body ConfirmPresignedUploadJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewConfirmPresignedUploadRequest(c.Server, body)
+func (c *Client) FindSimilarInvoices(ctx context.Context, id InvoiceId, params *FindSimilarInvoicesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindSimilarInvoicesRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -925,8 +1128,8 @@ func (c *Client) ConfirmPresignedUpload(ctx context.Context, body ConfirmPresign
 	return c.Client.Do(req)
 }
 
-func (c *Client) UploadHtmlToPdfWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUploadHtmlToPdfRequestWithBody(c.Server, contentType, body)
+func (c *Client) UpdateInvoiceStatusWithBody(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateInvoiceStatusRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -937,8 +1140,8 @@ func (c *Client) UploadHtmlToPdfWithBody(ctx context.Context, contentType string
 	return c.Client.Do(req)
 }
 
-func (c *Client) UploadHtmlToPdf(ctx context.Context, body UploadHtmlToPdfJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUploadHtmlToPdfRequest(c.Server, body)
+func (c *Client) UpdateInvoiceStatus(ctx context.Context, id InvoiceId, body UpdateInvoiceStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateInvoiceStatusRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -949,8 +1152,8 @@ func (c *Client) UploadHtmlToPdf(ctx context.Context, body UploadHtmlToPdfJSONRe
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetPresignedURL(ctx context.Context, params *GetPresignedURLParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetPresignedURLRequest(c.Server, params)
+func (c *Client) GetInvoiceStatusHistory(ctx context.Context, id InvoiceId, params *GetInvoiceStatusHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInvoiceStatusHistoryRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -961,8 +1164,8 @@ func (c *Client) GetPresignedURL(ctx context.Context, params *GetPresignedURLPar
 	return c.Client.Do(req)
 }
 
-func (c *Client) HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewHealthCheckRequest(c.Server)
+func (c *Client) AddTagToInvoiceWithBody(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTagToInvoiceRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -973,528 +1176,523 @@ func (c *Client) HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-// NewGetAnalyticsByCategoryRequest generates requests for GetAnalyticsByCategory
-func NewGetAnalyticsByCategoryRequest(server string, params *GetAnalyticsByCategoryParams) (*http.Request, error) {
-	var err error
+func (c *Client) AddTagToInvoice(ctx context.Context, id InvoiceId, body AddTagToInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTagToInvoiceRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) RemoveTagFromInvoice(ctx context.Context, id InvoiceId, tagId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveTagFromInvoiceRequest(c.Server, id, tagId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api/analytics/by-category")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) DeleteInvoiceItem(ctx context.Context, invoiceId int, itemId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteInvoiceItemRequest(c.Server, invoiceId, itemId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateInvoiceItemWithBody(ctx context.Context, invoiceId int, itemId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateInvoiceItemRequestWithBody(c.Server, invoiceId, itemId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Period != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, *params.Period); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) UpdateInvoiceItem(ctx context.Context, invoiceId int, itemId int, body UpdateInvoiceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateInvoiceItemRequest(c.Server, invoiceId, itemId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetAnalyticsByCompanyRequest generates requests for GetAnalyticsByCompany
-func NewGetAnalyticsByCompanyRequest(server string, params *GetAnalyticsByCompanyParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ListOrganizations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrganizationsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/analytics/by-company")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateOrganizationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Period != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, *params.Period); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) CreateOrganization(ctx context.Context, body CreateOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetAnalyticsByReceiverRequest generates requests for GetAnalyticsByReceiver
-func NewGetAnalyticsByReceiverRequest(server string, params *GetAnalyticsByReceiverParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ListOrganizationMembers(ctx context.Context, id OrganizationId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrganizationMembersRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/analytics/by-receiver")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RemoveOrganizationMember(ctx context.Context, id OrganizationId, userId MemberUserId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveOrganizationMemberRequest(c.Server, id, userId)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Period != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, *params.Period); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) SetOrganizationMemberWithBody(ctx context.Context, id OrganizationId, userId MemberUserId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetOrganizationMemberRequestWithBody(c.Server, id, userId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetAnalyticsByTagRequest generates requests for GetAnalyticsByTag
-func NewGetAnalyticsByTagRequest(server string, params *GetAnalyticsByTagParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) SetOrganizationMember(ctx context.Context, id OrganizationId, userId MemberUserId, body SetOrganizationMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetOrganizationMemberRequest(c.Server, id, userId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/analytics/by-tag")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListPaymentMethods(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPaymentMethodsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Period != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, *params.Period); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) ListReceivers(ctx context.Context, params *ListReceiversParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListReceiversRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetAnalyticsSummaryRequest generates requests for GetAnalyticsSummary
-func NewGetAnalyticsSummaryRequest(server string, params *GetAnalyticsSummaryParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateReceiverWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateReceiverRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/analytics/summary")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateReceiver(ctx context.Context, body CreateReceiverJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateReceiverRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Period != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, *params.Period); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) MergeReceiversWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMergeReceiversRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewListCategoriesRequest generates requests for ListCategories
-func NewListCategoriesRequest(server string, params *ListCategoriesParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) MergeReceivers(ctx context.Context, body MergeReceiversJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMergeReceiversRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/categories")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteReceiver(ctx context.Context, id ReceiverId, params *DeleteReceiverParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteReceiverRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Keyword != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keyword", runtime.ParamLocationQuery, *params.Keyword); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetReceiver(ctx context.Context, id ReceiverId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReceiverRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateCategoryRequest calls the generic CreateCategory builder with application/json body
-func NewCreateCategoryRequest(server string, body CreateCategoryJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UpdateReceiverWithBody(ctx context.Context, id ReceiverId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateReceiverRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateCategoryRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateCategoryRequestWithBody generates requests for CreateCategory with any type of body
-func NewCreateCategoryRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateReceiver(ctx context.Context, id ReceiverId, body UpdateReceiverJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateReceiverRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/categories")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetReceiverStatement(ctx context.Context, id ReceiverId, params *GetReceiverStatementParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReceiverStatementRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteCategoryRequest generates requests for DeleteCategory
-func NewDeleteCategoryRequest(server string, id CategoryId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) GetReceiverStatistics(ctx context.Context, id ReceiverId, params *GetReceiverStatisticsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReceiverStatisticsRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSettingsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/categories/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSettingsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetCategoryRequest generates requests for GetCategory
-func NewGetCategoryRequest(server string, id CategoryId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) UpdateSettings(ctx context.Context, body UpdateSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSettingsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ListTags(ctx context.Context, params *ListTagsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTagsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/categories/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateTagWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTagRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateCategoryRequest calls the generic UpdateCategory builder with application/json body
-func NewUpdateCategoryRequest(server string, id CategoryId, body UpdateCategoryJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) CreateTag(ctx context.Context, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTagRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateCategoryRequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateCategoryRequestWithBody generates requests for UpdateCategory with any type of body
-func NewUpdateCategoryRequestWithBody(server string, id CategoryId, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) DeleteTag(ctx context.Context, id TagId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTagRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetTag(ctx context.Context, id TagId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTagRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/categories/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateTagWithBody(ctx context.Context, id TagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTagRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) UpdateTag(ctx context.Context, id TagId, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTagRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewListCompaniesRequest generates requests for ListCompanies
-func NewListCompaniesRequest(server string, params *ListCompaniesParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UploadFileWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadFileRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/companies")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ConfirmPresignedUploadWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmPresignedUploadRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+func (c *Client) ConfirmPresignedUpload(ctx context.Context, body ConfirmPresignedUploadJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmPresignedUploadRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		if params.Keyword != nil {
+func (c *Client) UploadHtmlToPdfWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadHtmlToPdfRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keyword", runtime.ParamLocationQuery, *params.Keyword); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+func (c *Client) UploadHtmlToPdf(ctx context.Context, body UploadHtmlToPdfJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadHtmlToPdfRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		}
+func (c *Client) GetPresignedURL(ctx context.Context, params *GetPresignedURLParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPresignedURLRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		if params.Limit != nil {
+func (c *Client) HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHealthCheckRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+// NewGetAnalyticsByCategoryRequest generates requests for GetAnalyticsByCategory
+func NewGetAnalyticsByCategoryRequest(server string, params *GetAnalyticsByCategoryParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/analytics/by-category")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Period != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, *params.Period); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1508,9 +1706,9 @@ func NewListCompaniesRequest(server string, params *ListCompaniesParams) (*http.
 
 		}
 
-		if params.Offset != nil {
+		if params.NoCache != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "no_cache", runtime.ParamLocationQuery, *params.NoCache); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1535,19 +1733,8 @@ func NewListCompaniesRequest(server string, params *ListCompaniesParams) (*http.
 	return req, nil
 }
 
-// NewCreateCompanyRequest calls the generic CreateCompany builder with application/json body
-func NewCreateCompanyRequest(server string, body CreateCompanyJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateCompanyRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewCreateCompanyRequestWithBody generates requests for CreateCompany with any type of body
-func NewCreateCompanyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetAnalyticsByCompanyRequest generates requests for GetAnalyticsByCompany
+func NewGetAnalyticsByCompanyRequest(server string, params *GetAnalyticsByCompanyParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1555,7 +1742,7 @@ func NewCreateCompanyRequestWithBody(server string, contentType string, body io.
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/companies")
+	operationPath := fmt.Sprintf("/api/analytics/by-company")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1565,43 +1752,45 @@ func NewCreateCompanyRequestWithBody(server string, contentType string, body io.
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return req, nil
-}
+		if params.Period != nil {
 
-// NewDeleteCompanyRequest generates requests for DeleteCompany
-func NewDeleteCompanyRequest(server string, id CompanyId) (*http.Request, error) {
-	var err error
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, *params.Period); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	var pathParam0 string
+		}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
+		if params.NoCache != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "no_cache", runtime.ParamLocationQuery, *params.NoCache); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	operationPath := fmt.Sprintf("/api/companies/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1609,23 +1798,16 @@ func NewDeleteCompanyRequest(server string, id CompanyId) (*http.Request, error)
 	return req, nil
 }
 
-// NewGetCompanyRequest generates requests for GetCompany
-func NewGetCompanyRequest(server string, id CompanyId) (*http.Request, error) {
+// NewGetAnalyticsByReceiverRequest generates requests for GetAnalyticsByReceiver
+func NewGetAnalyticsByReceiverRequest(server string, params *GetAnalyticsByReceiverParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/companies/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/analytics/by-receiver")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1635,6 +1817,44 @@ func NewGetCompanyRequest(server string, id CompanyId) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Period != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, *params.Period); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.NoCache != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "no_cache", runtime.ParamLocationQuery, *params.NoCache); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1643,34 +1863,16 @@ func NewGetCompanyRequest(server string, id CompanyId) (*http.Request, error) {
 	return req, nil
 }
 
-// NewUpdateCompanyRequest calls the generic UpdateCompany builder with application/json body
-func NewUpdateCompanyRequest(server string, id CompanyId, body UpdateCompanyJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateCompanyRequestWithBody(server, id, "application/json", bodyReader)
-}
-
-// NewUpdateCompanyRequestWithBody generates requests for UpdateCompany with any type of body
-func NewUpdateCompanyRequestWithBody(server string, id CompanyId, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetAnalyticsByTagRequest generates requests for GetAnalyticsByTag
+func NewGetAnalyticsByTagRequest(server string, params *GetAnalyticsByTagParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/companies/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/analytics/by-tag")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1680,75 +1882,12 @@ func NewUpdateCompanyRequestWithBody(server string, id CompanyId, contentType st
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return req, nil
-}
+		if params.Period != nil {
 
-// NewGetFileDownloadURLRequest generates requests for GetFileDownloadURL
-func NewGetFileDownloadURLRequest(server string, key string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/files/%s/download", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewListInvoicesRequest generates requests for ListInvoices
-func NewListInvoicesRequest(server string, params *ListInvoicesParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/invoices")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Keyword != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keyword", runtime.ParamLocationQuery, *params.Keyword); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, *params.Period); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1762,9 +1901,9 @@ func NewListInvoicesRequest(server string, params *ListInvoicesParams) (*http.Re
 
 		}
 
-		if params.CategoryId != nil {
+		if params.IncludeChildren != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "category_id", runtime.ParamLocationQuery, *params.CategoryId); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_children", runtime.ParamLocationQuery, *params.IncludeChildren); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1778,9 +1917,9 @@ func NewListInvoicesRequest(server string, params *ListInvoicesParams) (*http.Re
 
 		}
 
-		if params.CompanyId != nil {
+		if params.NoCache != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "company_id", runtime.ParamLocationQuery, *params.CompanyId); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "no_cache", runtime.ParamLocationQuery, *params.NoCache); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1794,41 +1933,42 @@ func NewListInvoicesRequest(server string, params *ListInvoicesParams) (*http.Re
 
 		}
 
-		if params.ReceiverId != nil {
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "receiver_id", runtime.ParamLocationQuery, *params.ReceiverId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	return req, nil
+}
 
-		if params.TagIds != nil {
+// NewGetAnalyticsSummaryRequest generates requests for GetAnalyticsSummary
+func NewGetAnalyticsSummaryRequest(server string, params *GetAnalyticsSummaryParams) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag_ids", runtime.ParamLocationQuery, *params.TagIds); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/api/analytics/summary")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if params.Status != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Period != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, *params.Period); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1842,9 +1982,9 @@ func NewListInvoicesRequest(server string, params *ListInvoicesParams) (*http.Re
 
 		}
 
-		if params.SortBy != nil {
+		if params.PaidBy != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort_by", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "paid_by", runtime.ParamLocationQuery, *params.PaidBy); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1858,9 +1998,9 @@ func NewListInvoicesRequest(server string, params *ListInvoicesParams) (*http.Re
 
 		}
 
-		if params.SortOrder != nil {
+		if params.NoCache != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort_order", runtime.ParamLocationQuery, *params.SortOrder); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "no_cache", runtime.ParamLocationQuery, *params.NoCache); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1874,25 +2014,42 @@ func NewListInvoicesRequest(server string, params *ListInvoicesParams) (*http.Re
 
 		}
 
-		if params.Limit != nil {
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	return req, nil
+}
 
-		if params.Offset != nil {
+// NewGetAnalyticsTrendRequest generates requests for GetAnalyticsTrend
+func NewGetAnalyticsTrendRequest(server string, params *GetAnalyticsTrendParams) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/analytics/trend")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Months != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "months", runtime.ParamLocationQuery, *params.Months); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1917,19 +2074,46 @@ func NewListInvoicesRequest(server string, params *ListInvoicesParams) (*http.Re
 	return req, nil
 }
 
-// NewCreateInvoiceRequest calls the generic CreateInvoice builder with application/json body
-func NewCreateInvoiceRequest(server string, body CreateInvoiceJSONRequestBody) (*http.Request, error) {
+// NewListBudgetsRequest generates requests for ListBudgets
+func NewListBudgetsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/budgets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateBudgetRequest calls the generic CreateBudget builder with application/json body
+func NewCreateBudgetRequest(server string, body CreateBudgetJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateInvoiceRequestWithBody(server, "application/json", bodyReader)
+	return NewCreateBudgetRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewCreateInvoiceRequestWithBody generates requests for CreateInvoice with any type of body
-func NewCreateInvoiceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateBudgetRequestWithBody generates requests for CreateBudget with any type of body
+func NewCreateBudgetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1937,7 +2121,7 @@ func NewCreateInvoiceRequestWithBody(server string, contentType string, body io.
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/invoices")
+	operationPath := fmt.Sprintf("/api/budgets")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1957,23 +2141,16 @@ func NewCreateInvoiceRequestWithBody(server string, contentType string, body io.
 	return req, nil
 }
 
-// NewDeleteInvoiceRequest generates requests for DeleteInvoice
-func NewDeleteInvoiceRequest(server string, id InvoiceId) (*http.Request, error) {
+// NewGetBudgetStatusRequest generates requests for GetBudgetStatus
+func NewGetBudgetStatusRequest(server string, params *GetBudgetStatusParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/invoices/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/budgets/status")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1983,7 +2160,29 @@ func NewDeleteInvoiceRequest(server string, id InvoiceId) (*http.Request, error)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.AsOf != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "as_of", runtime.ParamLocationQuery, *params.AsOf); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1991,8 +2190,8 @@ func NewDeleteInvoiceRequest(server string, id InvoiceId) (*http.Request, error)
 	return req, nil
 }
 
-// NewGetInvoiceRequest generates requests for GetInvoice
-func NewGetInvoiceRequest(server string, id InvoiceId) (*http.Request, error) {
+// NewDeleteBudgetRequest generates requests for DeleteBudget
+func NewDeleteBudgetRequest(server string, id int) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2007,7 +2206,7 @@ func NewGetInvoiceRequest(server string, id InvoiceId) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/invoices/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/budgets/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2017,7 +2216,7 @@ func NewGetInvoiceRequest(server string, id InvoiceId) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2025,34 +2224,16 @@ func NewGetInvoiceRequest(server string, id InvoiceId) (*http.Request, error) {
 	return req, nil
 }
 
-// NewUpdateInvoiceRequest calls the generic UpdateInvoice builder with application/json body
-func NewUpdateInvoiceRequest(server string, id InvoiceId, body UpdateInvoiceJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateInvoiceRequestWithBody(server, id, "application/json", bodyReader)
-}
-
-// NewUpdateInvoiceRequestWithBody generates requests for UpdateInvoice with any type of body
-func NewUpdateInvoiceRequestWithBody(server string, id InvoiceId, contentType string, body io.Reader) (*http.Request, error) {
+// NewListCategoriesRequest generates requests for ListCategories
+func NewListCategoriesRequest(server string, params *ListCategoriesParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/invoices/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/categories")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2062,91 +2243,89 @@ func NewUpdateInvoiceRequestWithBody(server string, id InvoiceId, contentType st
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	req.Header.Add("Content-Type", contentType)
+		if params.Keyword != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keyword", runtime.ParamLocationQuery, *params.Keyword); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// NewAddInvoiceItemRequest calls the generic AddInvoiceItem builder with application/json body
-func NewAddInvoiceItemRequest(server string, id InvoiceId, body AddInvoiceItemJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAddInvoiceItemRequestWithBody(server, id, "application/json", bodyReader)
-}
+		}
 
-// NewAddInvoiceItemRequestWithBody generates requests for AddInvoiceItem with any type of body
-func NewAddInvoiceItemRequestWithBody(server string, id InvoiceId, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+		if params.Limit != nil {
 
-	var pathParam0 string
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		if params.Offset != nil {
 
-	operationPath := fmt.Sprintf("/api/invoices/%s/items", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewUpdateInvoiceStatusRequest calls the generic UpdateInvoiceStatus builder with application/json body
-func NewUpdateInvoiceStatusRequest(server string, id InvoiceId, body UpdateInvoiceStatusJSONRequestBody) (*http.Request, error) {
+// NewCreateCategoryRequest calls the generic CreateCategory builder with application/json body
+func NewCreateCategoryRequest(server string, body CreateCategoryJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateInvoiceStatusRequestWithBody(server, id, "application/json", bodyReader)
+	return NewCreateCategoryRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewUpdateInvoiceStatusRequestWithBody generates requests for UpdateInvoiceStatus with any type of body
-func NewUpdateInvoiceStatusRequestWithBody(server string, id InvoiceId, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateCategoryRequestWithBody generates requests for CreateCategory with any type of body
+func NewCreateCategoryRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/invoices/%s/status", pathParam0)
+	operationPath := fmt.Sprintf("/api/categories")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2156,7 +2335,7 @@ func NewUpdateInvoiceStatusRequestWithBody(server string, id InvoiceId, contentT
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2166,19 +2345,8 @@ func NewUpdateInvoiceStatusRequestWithBody(server string, id InvoiceId, contentT
 	return req, nil
 }
 
-// NewAddTagToInvoiceRequest calls the generic AddTagToInvoice builder with application/json body
-func NewAddTagToInvoiceRequest(server string, id InvoiceId, body AddTagToInvoiceJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAddTagToInvoiceRequestWithBody(server, id, "application/json", bodyReader)
-}
-
-// NewAddTagToInvoiceRequestWithBody generates requests for AddTagToInvoice with any type of body
-func NewAddTagToInvoiceRequestWithBody(server string, id InvoiceId, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeleteCategoryRequest generates requests for DeleteCategory
+func NewDeleteCategoryRequest(server string, id CategoryId, params *DeleteCategoryParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2193,7 +2361,7 @@ func NewAddTagToInvoiceRequestWithBody(server string, id InvoiceId, contentType
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/invoices/%s/tags", pathParam0)
+	operationPath := fmt.Sprintf("/api/categories/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2203,47 +2371,26 @@ func NewAddTagToInvoiceRequestWithBody(server string, id InvoiceId, contentType
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
-}
-
-// NewRemoveTagFromInvoiceRequest generates requests for RemoveTagFromInvoice
-func NewRemoveTagFromInvoiceRequest(server string, id InvoiceId, tagId int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tagId", runtime.ParamLocationPath, tagId)
-	if err != nil {
-		return nil, err
-	}
+		if params.Force != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, *params.Force); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	operationPath := fmt.Sprintf("/api/invoices/%s/tags/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
 	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
@@ -2254,20 +2401,13 @@ func NewRemoveTagFromInvoiceRequest(server string, id InvoiceId, tagId int) (*ht
 	return req, nil
 }
 
-// NewDeleteInvoiceItemRequest generates requests for DeleteInvoiceItem
-func NewDeleteInvoiceItemRequest(server string, invoiceId int, itemId int) (*http.Request, error) {
+// NewGetCategoryRequest generates requests for GetCategory
+func NewGetCategoryRequest(server string, id CategoryId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "invoice_id", runtime.ParamLocationPath, invoiceId)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "item_id", runtime.ParamLocationPath, itemId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
@@ -2277,7 +2417,7 @@ func NewDeleteInvoiceItemRequest(server string, invoiceId int, itemId int) (*htt
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/invoices/%s/items/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/categories/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2287,7 +2427,7 @@ func NewDeleteInvoiceItemRequest(server string, invoiceId int, itemId int) (*htt
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2295,31 +2435,24 @@ func NewDeleteInvoiceItemRequest(server string, invoiceId int, itemId int) (*htt
 	return req, nil
 }
 
-// NewUpdateInvoiceItemRequest calls the generic UpdateInvoiceItem builder with application/json body
-func NewUpdateInvoiceItemRequest(server string, invoiceId int, itemId int, body UpdateInvoiceItemJSONRequestBody) (*http.Request, error) {
+// NewUpdateCategoryRequest calls the generic UpdateCategory builder with application/json body
+func NewUpdateCategoryRequest(server string, id CategoryId, body UpdateCategoryJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateInvoiceItemRequestWithBody(server, invoiceId, itemId, "application/json", bodyReader)
+	return NewUpdateCategoryRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-// NewUpdateInvoiceItemRequestWithBody generates requests for UpdateInvoiceItem with any type of body
-func NewUpdateInvoiceItemRequestWithBody(server string, invoiceId int, itemId int, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateCategoryRequestWithBody generates requests for UpdateCategory with any type of body
+func NewUpdateCategoryRequestWithBody(server string, id CategoryId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "invoice_id", runtime.ParamLocationPath, invoiceId)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "item_id", runtime.ParamLocationPath, itemId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
@@ -2329,7 +2462,7 @@ func NewUpdateInvoiceItemRequestWithBody(server string, invoiceId int, itemId in
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/invoices/%s/items/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/categories/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2349,8 +2482,8 @@ func NewUpdateInvoiceItemRequestWithBody(server string, invoiceId int, itemId in
 	return req, nil
 }
 
-// NewListReceiversRequest generates requests for ListReceivers
-func NewListReceiversRequest(server string, params *ListReceiversParams) (*http.Request, error) {
+// NewListCompaniesRequest generates requests for ListCompanies
+func NewListCompaniesRequest(server string, params *ListCompaniesParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2358,7 +2491,7 @@ func NewListReceiversRequest(server string, params *ListReceiversParams) (*http.
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/receivers")
+	operationPath := fmt.Sprintf("/api/companies")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2430,19 +2563,19 @@ func NewListReceiversRequest(server string, params *ListReceiversParams) (*http.
 	return req, nil
 }
 
-// NewCreateReceiverRequest calls the generic CreateReceiver builder with application/json body
-func NewCreateReceiverRequest(server string, body CreateReceiverJSONRequestBody) (*http.Request, error) {
+// NewCreateCompanyRequest calls the generic CreateCompany builder with application/json body
+func NewCreateCompanyRequest(server string, body CreateCompanyJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateReceiverRequestWithBody(server, "application/json", bodyReader)
+	return NewCreateCompanyRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewCreateReceiverRequestWithBody generates requests for CreateReceiver with any type of body
-func NewCreateReceiverRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateCompanyRequestWithBody generates requests for CreateCompany with any type of body
+func NewCreateCompanyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2450,7 +2583,7 @@ func NewCreateReceiverRequestWithBody(server string, contentType string, body io
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/receivers")
+	operationPath := fmt.Sprintf("/api/companies")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2470,27 +2603,23 @@ func NewCreateReceiverRequestWithBody(server string, contentType string, body io
 	return req, nil
 }
 
-// NewMergeReceiversRequest calls the generic MergeReceivers builder with application/json body
-func NewMergeReceiversRequest(server string, body MergeReceiversJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewDeleteCompanyRequest generates requests for DeleteCompany
+func NewDeleteCompanyRequest(server string, id CompanyId, params *DeleteCompanyParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewMergeReceiversRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewMergeReceiversRequestWithBody generates requests for MergeReceivers with any type of body
-func NewMergeReceiversRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/receivers/merge")
+	operationPath := fmt.Sprintf("/api/companies/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2500,40 +2629,26 @@ func NewMergeReceiversRequestWithBody(server string, contentType string, body io
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return req, nil
-}
+		if params.Force != nil {
 
-// NewDeleteReceiverRequest generates requests for DeleteReceiver
-func NewDeleteReceiverRequest(server string, id ReceiverId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, *params.Force); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	operationPath := fmt.Sprintf("/api/receivers/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
 	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
@@ -2544,8 +2659,8 @@ func NewDeleteReceiverRequest(server string, id ReceiverId) (*http.Request, erro
 	return req, nil
 }
 
-// NewGetReceiverRequest generates requests for GetReceiver
-func NewGetReceiverRequest(server string, id ReceiverId) (*http.Request, error) {
+// NewGetCompanyRequest generates requests for GetCompany
+func NewGetCompanyRequest(server string, id CompanyId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2560,7 +2675,7 @@ func NewGetReceiverRequest(server string, id ReceiverId) (*http.Request, error)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/receivers/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/companies/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2578,19 +2693,19 @@ func NewGetReceiverRequest(server string, id ReceiverId) (*http.Request, error)
 	return req, nil
 }
 
-// NewUpdateReceiverRequest calls the generic UpdateReceiver builder with application/json body
-func NewUpdateReceiverRequest(server string, id ReceiverId, body UpdateReceiverJSONRequestBody) (*http.Request, error) {
+// NewUpdateCompanyRequest calls the generic UpdateCompany builder with application/json body
+func NewUpdateCompanyRequest(server string, id CompanyId, body UpdateCompanyJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateReceiverRequestWithBody(server, id, "application/json", bodyReader)
+	return NewUpdateCompanyRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-// NewUpdateReceiverRequestWithBody generates requests for UpdateReceiver with any type of body
-func NewUpdateReceiverRequestWithBody(server string, id ReceiverId, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateCompanyRequestWithBody generates requests for UpdateCompany with any type of body
+func NewUpdateCompanyRequestWithBody(server string, id CompanyId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2605,7 +2720,7 @@ func NewUpdateReceiverRequestWithBody(server string, id ReceiverId, contentType
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/receivers/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/companies/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2625,16 +2740,23 @@ func NewUpdateReceiverRequestWithBody(server string, id ReceiverId, contentType
 	return req, nil
 }
 
-// NewListTagsRequest generates requests for ListTags
-func NewListTagsRequest(server string, params *ListTagsParams) (*http.Request, error) {
+// NewGetCompanyBreakdownRequest generates requests for GetCompanyBreakdown
+func NewGetCompanyBreakdownRequest(server string, id CompanyId, params *GetCompanyBreakdownParams) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/tags")
+	operationPath := fmt.Sprintf("/api/companies/%s/breakdown", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2647,9 +2769,9 @@ func NewListTagsRequest(server string, params *ListTagsParams) (*http.Request, e
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Keyword != nil {
+		if params.Period != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keyword", runtime.ParamLocationQuery, *params.Keyword); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, *params.Period); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2663,9 +2785,42 @@ func NewListTagsRequest(server string, params *ListTagsParams) (*http.Request, e
 
 		}
 
-		if params.Limit != nil {
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDashboardRequest generates requests for GetDashboard
+func NewGetDashboardRequest(server string, params *GetDashboardParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/dashboard")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Period != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, *params.Period); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2679,9 +2834,9 @@ func NewListTagsRequest(server string, params *ListTagsParams) (*http.Request, e
 
 		}
 
-		if params.Offset != nil {
+		if params.NoCache != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "no_cache", runtime.ParamLocationQuery, *params.NoCache); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2706,19 +2861,8 @@ func NewListTagsRequest(server string, params *ListTagsParams) (*http.Request, e
 	return req, nil
 }
 
-// NewCreateTagRequest calls the generic CreateTag builder with application/json body
-func NewCreateTagRequest(server string, body CreateTagJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateTagRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewCreateTagRequestWithBody generates requests for CreateTag with any type of body
-func NewCreateTagRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewExportDataRequest generates requests for ExportData
+func NewExportDataRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2726,7 +2870,7 @@ func NewCreateTagRequestWithBody(server string, contentType string, body io.Read
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/tags")
+	operationPath := fmt.Sprintf("/api/export")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2736,33 +2880,24 @@ func NewCreateTagRequestWithBody(server string, contentType string, body io.Read
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeleteTagRequest generates requests for DeleteTag
-func NewDeleteTagRequest(server string, id TagId) (*http.Request, error) {
+// NewRequestExportRequest generates requests for RequestExport
+func NewRequestExportRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/tags/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/exports")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2772,7 +2907,7 @@ func NewDeleteTagRequest(server string, id TagId) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2780,8 +2915,8 @@ func NewDeleteTagRequest(server string, id TagId) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetTagRequest generates requests for GetTag
-func NewGetTagRequest(server string, id TagId) (*http.Request, error) {
+// NewGetExportJobRequest generates requests for GetExportJob
+func NewGetExportJobRequest(server string, id int) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2796,7 +2931,7 @@ func NewGetTagRequest(server string, id TagId) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/tags/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/exports/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2814,103 +2949,23 @@ func NewGetTagRequest(server string, id TagId) (*http.Request, error) {
 	return req, nil
 }
 
-// NewUpdateTagRequest calls the generic UpdateTag builder with application/json body
-func NewUpdateTagRequest(server string, id TagId, body UpdateTagJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateTagRequestWithBody(server, id, "application/json", bodyReader)
-}
-
-// NewUpdateTagRequestWithBody generates requests for UpdateTag with any type of body
-func NewUpdateTagRequestWithBody(server string, id TagId, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetFileDownloadURLRequest generates requests for GetFileDownloadURL
+func NewGetFileDownloadURLRequest(server string, key string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/tags/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
-}
-
-// NewUploadFileRequestWithBody generates requests for UploadFile with any type of body
-func NewUploadFileRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/upload")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
-}
-
-// NewConfirmPresignedUploadRequest calls the generic ConfirmPresignedUpload builder with application/json body
-func NewConfirmPresignedUploadRequest(server string, body ConfirmPresignedUploadJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewConfirmPresignedUploadRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewConfirmPresignedUploadRequestWithBody generates requests for ConfirmPresignedUpload with any type of body
-func NewConfirmPresignedUploadRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/upload/confirm")
+	operationPath := fmt.Sprintf("/api/files/%s/download", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2920,29 +2975,27 @@ func NewConfirmPresignedUploadRequestWithBody(server string, contentType string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewUploadHtmlToPdfRequest calls the generic UploadHtmlToPdf builder with application/json body
-func NewUploadHtmlToPdfRequest(server string, body UploadHtmlToPdfJSONRequestBody) (*http.Request, error) {
+// NewImportDataRequest calls the generic ImportData builder with application/json body
+func NewImportDataRequest(server string, body ImportDataJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUploadHtmlToPdfRequestWithBody(server, "application/json", bodyReader)
+	return NewImportDataRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewUploadHtmlToPdfRequestWithBody generates requests for UploadHtmlToPdf with any type of body
-func NewUploadHtmlToPdfRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewImportDataRequestWithBody generates requests for ImportData with any type of body
+func NewImportDataRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2950,7 +3003,7 @@ func NewUploadHtmlToPdfRequestWithBody(server string, contentType string, body i
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/upload/html-to-pdf")
+	operationPath := fmt.Sprintf("/api/import")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2970,8 +3023,8 @@ func NewUploadHtmlToPdfRequestWithBody(server string, contentType string, body i
 	return req, nil
 }
 
-// NewGetPresignedURLRequest generates requests for GetPresignedURL
-func NewGetPresignedURLRequest(server string, params *GetPresignedURLParams) (*http.Request, error) {
+// NewListInvoicesRequest generates requests for ListInvoices
+func NewListInvoicesRequest(server string, params *ListInvoicesParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2979,7 +3032,7 @@ func NewGetPresignedURLRequest(server string, params *GetPresignedURLParams) (*h
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/upload/presigned")
+	operationPath := fmt.Sprintf("/api/invoices")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2992,21 +3045,9 @@ func NewGetPresignedURLRequest(server string, params *GetPresignedURLParams) (*h
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filename", runtime.ParamLocationQuery, params.Filename); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		if params.ContentType != nil {
+		if params.Keyword != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "content_type", runtime.ParamLocationQuery, *params.ContentType); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keyword", runtime.ParamLocationQuery, *params.Keyword); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -3020,1847 +3061,7589 @@ func NewGetPresignedURLRequest(server string, params *GetPresignedURLParams) (*h
 
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+		if params.ExcludeKeyword != nil {
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "exclude_keyword", runtime.ParamLocationQuery, *params.ExcludeKeyword); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CategoryId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "category_id", runtime.ParamLocationQuery, *params.CategoryId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CompanyId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "company_id", runtime.ParamLocationQuery, *params.CompanyId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ReceiverId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "receiver_id", runtime.ParamLocationQuery, *params.ReceiverId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TagIds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag_ids", runtime.ParamLocationQuery, *params.TagIds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Tags != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tags", runtime.ParamLocationQuery, *params.Tags); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TagMatch != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag_match", runtime.ParamLocationQuery, *params.TagMatch); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PaymentMethod != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "payment_method", runtime.ParamLocationQuery, *params.PaymentMethod); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeDrafts != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_drafts", runtime.ParamLocationQuery, *params.IncludeDrafts); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrganizationId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "organization_id", runtime.ParamLocationQuery, *params.OrganizationId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort_by", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortOrder != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort_order", runtime.ParamLocationQuery, *params.SortOrder); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CursorDirection != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor_direction", runtime.ParamLocationQuery, *params.CursorDirection); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinAmount != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "min_amount", runtime.ParamLocationQuery, *params.MinAmount); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxAmount != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_amount", runtime.ParamLocationQuery, *params.MaxAmount); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AmountField != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "amount_field", runtime.ParamLocationQuery, *params.AmountField); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Expand != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Locale != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "locale", runtime.ParamLocationQuery, *params.Locale); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateInvoiceRequest calls the generic CreateInvoice builder with application/json body
+func NewCreateInvoiceRequest(server string, body CreateInvoiceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateInvoiceRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateInvoiceRequestWithBody generates requests for CreateInvoice with any type of body
+func NewCreateInvoiceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetInvoiceFacetsRequest generates requests for GetInvoiceFacets
+func NewGetInvoiceFacetsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/facets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewImportInvoicesRequestWithBody generates requests for ImportInvoices with any type of body
+func NewImportInvoicesRequestWithBody(server string, params *ImportInvoicesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreateMissing != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "create_missing", runtime.ParamLocationQuery, *params.CreateMissing); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLookupInvoicesRequest generates requests for LookupInvoices
+func NewLookupInvoicesRequest(server string, params *LookupInvoicesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/lookup")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Number != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "number", runtime.ParamLocationQuery, *params.Number); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Link != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "link", runtime.ParamLocationQuery, *params.Link); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetInvoiceStatusCountsRequest generates requests for GetInvoiceStatusCounts
+func NewGetInvoiceStatusCountsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/status-counts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteInvoiceRequest generates requests for DeleteInvoice
+func NewDeleteInvoiceRequest(server string, id InvoiceId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetInvoiceRequest generates requests for GetInvoice
+func NewGetInvoiceRequest(server string, id InvoiceId, params *GetInvoiceParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Expand != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeAmountInWords != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_amount_in_words", runtime.ParamLocationQuery, *params.IncludeAmountInWords); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Locale != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "locale", runtime.ParamLocationQuery, *params.Locale); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateInvoiceRequest calls the generic UpdateInvoice builder with application/json body
+func NewUpdateInvoiceRequest(server string, id InvoiceId, body UpdateInvoiceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateInvoiceRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateInvoiceRequestWithBody generates requests for UpdateInvoice with any type of body
+func NewUpdateInvoiceRequestWithBody(server string, id InvoiceId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListInvoiceAttachmentsRequest generates requests for ListInvoiceAttachments
+func NewListInvoiceAttachmentsRequest(server string, id InvoiceId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/attachments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddInvoiceAttachmentRequest calls the generic AddInvoiceAttachment builder with application/json body
+func NewAddInvoiceAttachmentRequest(server string, id InvoiceId, body AddInvoiceAttachmentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddInvoiceAttachmentRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAddInvoiceAttachmentRequestWithBody generates requests for AddInvoiceAttachment with any type of body
+func NewAddInvoiceAttachmentRequestWithBody(server string, id InvoiceId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/attachments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemoveInvoiceAttachmentRequest generates requests for RemoveInvoiceAttachment
+func NewRemoveInvoiceAttachmentRequest(server string, id InvoiceId, attachmentId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "attachmentId", runtime.ParamLocationPath, attachmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/attachments/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetInvoiceAuditTrailRequest generates requests for GetInvoiceAuditTrail
+func NewGetInvoiceAuditTrailRequest(server string, id InvoiceId, params *GetInvoiceAuditTrailParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/audit", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Action != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "action", runtime.ParamLocationQuery, *params.Action); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Start != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, *params.Start); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.End != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, *params.End); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCloneInvoiceRequest calls the generic CloneInvoice builder with application/json body
+func NewCloneInvoiceRequest(server string, id InvoiceId, body CloneInvoiceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCloneInvoiceRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewCloneInvoiceRequestWithBody generates requests for CloneInvoice with any type of body
+func NewCloneInvoiceRequestWithBody(server string, id InvoiceId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/clone", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPreviewCurrencyConversionRequest calls the generic PreviewCurrencyConversion builder with application/json body
+func NewPreviewCurrencyConversionRequest(server string, id InvoiceId, body PreviewCurrencyConversionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPreviewCurrencyConversionRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPreviewCurrencyConversionRequestWithBody generates requests for PreviewCurrencyConversion with any type of body
+func NewPreviewCurrencyConversionRequestWithBody(server string, id InvoiceId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/convert/preview", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewFinalizeInvoiceRequest generates requests for FinalizeInvoice
+func NewFinalizeInvoiceRequest(server string, id InvoiceId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/finalize", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddInvoiceItemRequest calls the generic AddInvoiceItem builder with application/json body
+func NewAddInvoiceItemRequest(server string, id InvoiceId, body AddInvoiceItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddInvoiceItemRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAddInvoiceItemRequestWithBody generates requests for AddInvoiceItem with any type of body
+func NewAddInvoiceItemRequestWithBody(server string, id InvoiceId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/items", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAddInvoiceItemsRequest calls the generic AddInvoiceItems builder with application/json body
+func NewAddInvoiceItemsRequest(server string, id InvoiceId, body AddInvoiceItemsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddInvoiceItemsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAddInvoiceItemsRequestWithBody generates requests for AddInvoiceItems with any type of body
+func NewAddInvoiceItemsRequestWithBody(server string, id InvoiceId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/items/batch", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewReorderInvoiceItemsRequest calls the generic ReorderInvoiceItems builder with application/json body
+func NewReorderInvoiceItemsRequest(server string, id InvoiceId, body ReorderInvoiceItemsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReorderInvoiceItemsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewReorderInvoiceItemsRequestWithBody generates requests for ReorderInvoiceItems with any type of body
+func NewReorderInvoiceItemsRequestWithBody(server string, id InvoiceId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/items/order", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRecalculateInvoiceTotalsRequest generates requests for RecalculateInvoiceTotals
+func NewRecalculateInvoiceTotalsRequest(server string, id InvoiceId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/recalculate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindSimilarInvoicesRequest generates requests for FindSimilarInvoices
+func NewFindSimilarInvoicesRequest(server string, id InvoiceId, params *FindSimilarInvoicesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/similar", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateInvoiceStatusRequest calls the generic UpdateInvoiceStatus builder with application/json body
+func NewUpdateInvoiceStatusRequest(server string, id InvoiceId, body UpdateInvoiceStatusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateInvoiceStatusRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateInvoiceStatusRequestWithBody generates requests for UpdateInvoiceStatus with any type of body
+func NewUpdateInvoiceStatusRequestWithBody(server string, id InvoiceId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/status", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetInvoiceStatusHistoryRequest generates requests for GetInvoiceStatusHistory
+func NewGetInvoiceStatusHistoryRequest(server string, id InvoiceId, params *GetInvoiceStatusHistoryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/status-history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Action != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "action", runtime.ParamLocationQuery, *params.Action); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Start != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, *params.Start); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.End != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, *params.End); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddTagToInvoiceRequest calls the generic AddTagToInvoice builder with application/json body
+func NewAddTagToInvoiceRequest(server string, id InvoiceId, body AddTagToInvoiceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddTagToInvoiceRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAddTagToInvoiceRequestWithBody generates requests for AddTagToInvoice with any type of body
+func NewAddTagToInvoiceRequestWithBody(server string, id InvoiceId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/tags", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemoveTagFromInvoiceRequest generates requests for RemoveTagFromInvoice
+func NewRemoveTagFromInvoiceRequest(server string, id InvoiceId, tagId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tagId", runtime.ParamLocationPath, tagId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/tags/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteInvoiceItemRequest generates requests for DeleteInvoiceItem
+func NewDeleteInvoiceItemRequest(server string, invoiceId int, itemId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "invoice_id", runtime.ParamLocationPath, invoiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "item_id", runtime.ParamLocationPath, itemId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/items/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateInvoiceItemRequest calls the generic UpdateInvoiceItem builder with application/json body
+func NewUpdateInvoiceItemRequest(server string, invoiceId int, itemId int, body UpdateInvoiceItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateInvoiceItemRequestWithBody(server, invoiceId, itemId, "application/json", bodyReader)
+}
+
+// NewUpdateInvoiceItemRequestWithBody generates requests for UpdateInvoiceItem with any type of body
+func NewUpdateInvoiceItemRequestWithBody(server string, invoiceId int, itemId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "invoice_id", runtime.ParamLocationPath, invoiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "item_id", runtime.ParamLocationPath, itemId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/items/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListOrganizationsRequest generates requests for ListOrganizations
+func NewListOrganizationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateOrganizationRequest calls the generic CreateOrganization builder with application/json body
+func NewCreateOrganizationRequest(server string, body CreateOrganizationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateOrganizationRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateOrganizationRequestWithBody generates requests for CreateOrganization with any type of body
+func NewCreateOrganizationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListOrganizationMembersRequest generates requests for ListOrganizationMembers
+func NewListOrganizationMembersRequest(server string, id OrganizationId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/members", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRemoveOrganizationMemberRequest generates requests for RemoveOrganizationMember
+func NewRemoveOrganizationMemberRequest(server string, id OrganizationId, userId MemberUserId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/members/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetOrganizationMemberRequest calls the generic SetOrganizationMember builder with application/json body
+func NewSetOrganizationMemberRequest(server string, id OrganizationId, userId MemberUserId, body SetOrganizationMemberJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetOrganizationMemberRequestWithBody(server, id, userId, "application/json", bodyReader)
+}
+
+// NewSetOrganizationMemberRequestWithBody generates requests for SetOrganizationMember with any type of body
+func NewSetOrganizationMemberRequestWithBody(server string, id OrganizationId, userId MemberUserId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/members/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListPaymentMethodsRequest generates requests for ListPaymentMethods
+func NewListPaymentMethodsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/payment-methods")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListReceiversRequest generates requests for ListReceivers
+func NewListReceiversRequest(server string, params *ListReceiversParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/receivers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Keyword != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keyword", runtime.ParamLocationQuery, *params.Keyword); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateReceiverRequest calls the generic CreateReceiver builder with application/json body
+func NewCreateReceiverRequest(server string, body CreateReceiverJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateReceiverRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateReceiverRequestWithBody generates requests for CreateReceiver with any type of body
+func NewCreateReceiverRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/receivers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewMergeReceiversRequest calls the generic MergeReceivers builder with application/json body
+func NewMergeReceiversRequest(server string, body MergeReceiversJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMergeReceiversRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewMergeReceiversRequestWithBody generates requests for MergeReceivers with any type of body
+func NewMergeReceiversRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/receivers/merge")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteReceiverRequest generates requests for DeleteReceiver
+func NewDeleteReceiverRequest(server string, id ReceiverId, params *DeleteReceiverParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/receivers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Force != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, *params.Force); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetReceiverRequest generates requests for GetReceiver
+func NewGetReceiverRequest(server string, id ReceiverId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/receivers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateReceiverRequest calls the generic UpdateReceiver builder with application/json body
+func NewUpdateReceiverRequest(server string, id ReceiverId, body UpdateReceiverJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateReceiverRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateReceiverRequestWithBody generates requests for UpdateReceiver with any type of body
+func NewUpdateReceiverRequestWithBody(server string, id ReceiverId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/receivers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetReceiverStatementRequest generates requests for GetReceiverStatement
+func NewGetReceiverStatementRequest(server string, id ReceiverId, params *GetReceiverStatementParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/receivers/%s/statement", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, params.Start); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, params.End); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetReceiverStatisticsRequest generates requests for GetReceiverStatistics
+func NewGetReceiverStatisticsRequest(server string, id ReceiverId, params *GetReceiverStatisticsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/receivers/%s/statistics", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Period != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, *params.Period); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSettingsRequest generates requests for GetSettings
+func NewGetSettingsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateSettingsRequest calls the generic UpdateSettings builder with application/json body
+func NewUpdateSettingsRequest(server string, body UpdateSettingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSettingsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateSettingsRequestWithBody generates requests for UpdateSettings with any type of body
+func NewUpdateSettingsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListTagsRequest generates requests for ListTags
+func NewListTagsRequest(server string, params *ListTagsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/tags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Keyword != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keyword", runtime.ParamLocationQuery, *params.Keyword); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTagRequest calls the generic CreateTag builder with application/json body
+func NewCreateTagRequest(server string, body CreateTagJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTagRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTagRequestWithBody generates requests for CreateTag with any type of body
+func NewCreateTagRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/tags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTagRequest generates requests for DeleteTag
+func NewDeleteTagRequest(server string, id TagId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/tags/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTagRequest generates requests for GetTag
+func NewGetTagRequest(server string, id TagId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/tags/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateTagRequest calls the generic UpdateTag builder with application/json body
+func NewUpdateTagRequest(server string, id TagId, body UpdateTagJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTagRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateTagRequestWithBody generates requests for UpdateTag with any type of body
+func NewUpdateTagRequestWithBody(server string, id TagId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/tags/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUploadFileRequestWithBody generates requests for UploadFile with any type of body
+func NewUploadFileRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/upload")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewConfirmPresignedUploadRequest calls the generic ConfirmPresignedUpload builder with application/json body
+func NewConfirmPresignedUploadRequest(server string, body ConfirmPresignedUploadJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewConfirmPresignedUploadRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewConfirmPresignedUploadRequestWithBody generates requests for ConfirmPresignedUpload with any type of body
+func NewConfirmPresignedUploadRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/upload/confirm")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUploadHtmlToPdfRequest calls the generic UploadHtmlToPdf builder with application/json body
+func NewUploadHtmlToPdfRequest(server string, body UploadHtmlToPdfJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUploadHtmlToPdfRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUploadHtmlToPdfRequestWithBody generates requests for UploadHtmlToPdf with any type of body
+func NewUploadHtmlToPdfRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/upload/html-to-pdf")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPresignedURLRequest generates requests for GetPresignedURL
+func NewGetPresignedURLRequest(server string, params *GetPresignedURLParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/upload/presigned")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filename", runtime.ParamLocationQuery, params.Filename); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.ContentType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "content_type", runtime.ParamLocationQuery, *params.ContentType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHealthCheckRequest generates requests for HealthCheck
+func NewHealthCheckRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAnalyticsByCategoryWithResponse request
+	GetAnalyticsByCategoryWithResponse(ctx context.Context, params *GetAnalyticsByCategoryParams, reqEditors ...RequestEditorFn) (*GetAnalyticsByCategoryResponse, error)
+
+	// GetAnalyticsByCompanyWithResponse request
+	GetAnalyticsByCompanyWithResponse(ctx context.Context, params *GetAnalyticsByCompanyParams, reqEditors ...RequestEditorFn) (*GetAnalyticsByCompanyResponse, error)
+
+	// GetAnalyticsByReceiverWithResponse request
+	GetAnalyticsByReceiverWithResponse(ctx context.Context, params *GetAnalyticsByReceiverParams, reqEditors ...RequestEditorFn) (*GetAnalyticsByReceiverResponse, error)
+
+	// GetAnalyticsByTagWithResponse request
+	GetAnalyticsByTagWithResponse(ctx context.Context, params *GetAnalyticsByTagParams, reqEditors ...RequestEditorFn) (*GetAnalyticsByTagResponse, error)
+
+	// GetAnalyticsSummaryWithResponse request
+	GetAnalyticsSummaryWithResponse(ctx context.Context, params *GetAnalyticsSummaryParams, reqEditors ...RequestEditorFn) (*GetAnalyticsSummaryResponse, error)
+
+	// GetAnalyticsTrendWithResponse request
+	GetAnalyticsTrendWithResponse(ctx context.Context, params *GetAnalyticsTrendParams, reqEditors ...RequestEditorFn) (*GetAnalyticsTrendResponse, error)
+
+	// ListBudgetsWithResponse request
+	ListBudgetsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListBudgetsResponse, error)
+
+	// CreateBudgetWithBodyWithResponse request with any body
+	CreateBudgetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBudgetResponse, error)
+
+	CreateBudgetWithResponse(ctx context.Context, body CreateBudgetJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBudgetResponse, error)
+
+	// GetBudgetStatusWithResponse request
+	GetBudgetStatusWithResponse(ctx context.Context, params *GetBudgetStatusParams, reqEditors ...RequestEditorFn) (*GetBudgetStatusResponse, error)
+
+	// DeleteBudgetWithResponse request
+	DeleteBudgetWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteBudgetResponse, error)
+
+	// ListCategoriesWithResponse request
+	ListCategoriesWithResponse(ctx context.Context, params *ListCategoriesParams, reqEditors ...RequestEditorFn) (*ListCategoriesResponse, error)
+
+	// CreateCategoryWithBodyWithResponse request with any body
+	CreateCategoryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error)
+
+	CreateCategoryWithResponse(ctx context.Context, body CreateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error)
+
+	// DeleteCategoryWithResponse request
+	DeleteCategoryWithResponse(ctx context.Context, id CategoryId, params *DeleteCategoryParams, reqEditors ...RequestEditorFn) (*DeleteCategoryResponse, error)
+
+	// GetCategoryWithResponse request
+	GetCategoryWithResponse(ctx context.Context, id CategoryId, reqEditors ...RequestEditorFn) (*GetCategoryResponse, error)
+
+	// UpdateCategoryWithBodyWithResponse request with any body
+	UpdateCategoryWithBodyWithResponse(ctx context.Context, id CategoryId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCategoryResponse, error)
+
+	UpdateCategoryWithResponse(ctx context.Context, id CategoryId, body UpdateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCategoryResponse, error)
+
+	// ListCompaniesWithResponse request
+	ListCompaniesWithResponse(ctx context.Context, params *ListCompaniesParams, reqEditors ...RequestEditorFn) (*ListCompaniesResponse, error)
+
+	// CreateCompanyWithBodyWithResponse request with any body
+	CreateCompanyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCompanyResponse, error)
+
+	CreateCompanyWithResponse(ctx context.Context, body CreateCompanyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCompanyResponse, error)
+
+	// DeleteCompanyWithResponse request
+	DeleteCompanyWithResponse(ctx context.Context, id CompanyId, params *DeleteCompanyParams, reqEditors ...RequestEditorFn) (*DeleteCompanyResponse, error)
+
+	// GetCompanyWithResponse request
+	GetCompanyWithResponse(ctx context.Context, id CompanyId, reqEditors ...RequestEditorFn) (*GetCompanyResponse, error)
+
+	// UpdateCompanyWithBodyWithResponse request with any body
+	UpdateCompanyWithBodyWithResponse(ctx context.Context, id CompanyId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCompanyResponse, error)
+
+	UpdateCompanyWithResponse(ctx context.Context, id CompanyId, body UpdateCompanyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCompanyResponse, error)
+
+	// GetCompanyBreakdownWithResponse request
+	GetCompanyBreakdownWithResponse(ctx context.Context, id CompanyId, params *GetCompanyBreakdownParams, reqEditors ...RequestEditorFn) (*GetCompanyBreakdownResponse, error)
+
+	// GetDashboardWithResponse request
+	GetDashboardWithResponse(ctx context.Context, params *GetDashboardParams, reqEditors ...RequestEditorFn) (*GetDashboardResponse, error)
+
+	// ExportDataWithResponse request
+	ExportDataWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ExportDataResponse, error)
+
+	// RequestExportWithResponse request
+	RequestExportWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RequestExportResponse, error)
+
+	// GetExportJobWithResponse request
+	GetExportJobWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetExportJobResponse, error)
+
+	// GetFileDownloadURLWithResponse request
+	GetFileDownloadURLWithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*GetFileDownloadURLResponse, error)
+
+	// ImportDataWithBodyWithResponse request with any body
+	ImportDataWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportDataResponse, error)
+
+	ImportDataWithResponse(ctx context.Context, body ImportDataJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportDataResponse, error)
+
+	// ListInvoicesWithResponse request
+	ListInvoicesWithResponse(ctx context.Context, params *ListInvoicesParams, reqEditors ...RequestEditorFn) (*ListInvoicesResponse, error)
+
+	// CreateInvoiceWithBodyWithResponse request with any body
+	CreateInvoiceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInvoiceResponse, error)
+
+	CreateInvoiceWithResponse(ctx context.Context, body CreateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInvoiceResponse, error)
+
+	// GetInvoiceFacetsWithResponse request
+	GetInvoiceFacetsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetInvoiceFacetsResponse, error)
+
+	// ImportInvoicesWithBodyWithResponse request with any body
+	ImportInvoicesWithBodyWithResponse(ctx context.Context, params *ImportInvoicesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportInvoicesResponse, error)
+
+	// LookupInvoicesWithResponse request
+	LookupInvoicesWithResponse(ctx context.Context, params *LookupInvoicesParams, reqEditors ...RequestEditorFn) (*LookupInvoicesResponse, error)
+
+	// GetInvoiceStatusCountsWithResponse request
+	GetInvoiceStatusCountsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetInvoiceStatusCountsResponse, error)
+
+	// DeleteInvoiceWithResponse request
+	DeleteInvoiceWithResponse(ctx context.Context, id InvoiceId, reqEditors ...RequestEditorFn) (*DeleteInvoiceResponse, error)
+
+	// GetInvoiceWithResponse request
+	GetInvoiceWithResponse(ctx context.Context, id InvoiceId, params *GetInvoiceParams, reqEditors ...RequestEditorFn) (*GetInvoiceResponse, error)
+
+	// UpdateInvoiceWithBodyWithResponse request with any body
+	UpdateInvoiceWithBodyWithResponse(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateInvoiceResponse, error)
+
+	UpdateInvoiceWithResponse(ctx context.Context, id InvoiceId, body UpdateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateInvoiceResponse, error)
+
+	// ListInvoiceAttachmentsWithResponse request
+	ListInvoiceAttachmentsWithResponse(ctx context.Context, id InvoiceId, reqEditors ...RequestEditorFn) (*ListInvoiceAttachmentsResponse, error)
+
+	// AddInvoiceAttachmentWithBodyWithResponse request with any body
+	AddInvoiceAttachmentWithBodyWithResponse(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddInvoiceAttachmentResponse, error)
+
+	AddInvoiceAttachmentWithResponse(ctx context.Context, id InvoiceId, body AddInvoiceAttachmentJSONRequestBody, reqEditors ...RequestEditorFn) (*AddInvoiceAttachmentResponse, error)
+
+	// RemoveInvoiceAttachmentWithResponse request
+	RemoveInvoiceAttachmentWithResponse(ctx context.Context, id InvoiceId, attachmentId int, reqEditors ...RequestEditorFn) (*RemoveInvoiceAttachmentResponse, error)
+
+	// GetInvoiceAuditTrailWithResponse request
+	GetInvoiceAuditTrailWithResponse(ctx context.Context, id InvoiceId, params *GetInvoiceAuditTrailParams, reqEditors ...RequestEditorFn) (*GetInvoiceAuditTrailResponse, error)
+
+	// CloneInvoiceWithBodyWithResponse request with any body
+	CloneInvoiceWithBodyWithResponse(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CloneInvoiceResponse, error)
+
+	CloneInvoiceWithResponse(ctx context.Context, id InvoiceId, body CloneInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*CloneInvoiceResponse, error)
+
+	// PreviewCurrencyConversionWithBodyWithResponse request with any body
+	PreviewCurrencyConversionWithBodyWithResponse(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreviewCurrencyConversionResponse, error)
+
+	PreviewCurrencyConversionWithResponse(ctx context.Context, id InvoiceId, body PreviewCurrencyConversionJSONRequestBody, reqEditors ...RequestEditorFn) (*PreviewCurrencyConversionResponse, error)
+
+	// FinalizeInvoiceWithResponse request
+	FinalizeInvoiceWithResponse(ctx context.Context, id InvoiceId, reqEditors ...RequestEditorFn) (*FinalizeInvoiceResponse, error)
+
+	// AddInvoiceItemWithBodyWithResponse request with any body
+	AddInvoiceItemWithBodyWithResponse(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddInvoiceItemResponse, error)
+
+	AddInvoiceItemWithResponse(ctx context.Context, id InvoiceId, body AddInvoiceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*AddInvoiceItemResponse, error)
+
+	// AddInvoiceItemsWithBodyWithResponse request with any body
+	AddInvoiceItemsWithBodyWithResponse(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddInvoiceItemsResponse, error)
+
+	AddInvoiceItemsWithResponse(ctx context.Context, id InvoiceId, body AddInvoiceItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*AddInvoiceItemsResponse, error)
+
+	// ReorderInvoiceItemsWithBodyWithResponse request with any body
+	ReorderInvoiceItemsWithBodyWithResponse(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReorderInvoiceItemsResponse, error)
+
+	ReorderInvoiceItemsWithResponse(ctx context.Context, id InvoiceId, body ReorderInvoiceItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*ReorderInvoiceItemsResponse, error)
+
+	// RecalculateInvoiceTotalsWithResponse request
+	RecalculateInvoiceTotalsWithResponse(ctx context.Context, id InvoiceId, reqEditors ...RequestEditorFn) (*RecalculateInvoiceTotalsResponse, error)
+
+	// FindSimilarInvoicesWithResponse request
+	FindSimilarInvoicesWithResponse(ctx context.Context, id InvoiceId, params *FindSimilarInvoicesParams, reqEditors ...RequestEditorFn) (*FindSimilarInvoicesResponse, error)
+
+	// UpdateInvoiceStatusWithBodyWithResponse request with any body
+	UpdateInvoiceStatusWithBodyWithResponse(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateInvoiceStatusResponse, error)
+
+	UpdateInvoiceStatusWithResponse(ctx context.Context, id InvoiceId, body UpdateInvoiceStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateInvoiceStatusResponse, error)
+
+	// GetInvoiceStatusHistoryWithResponse request
+	GetInvoiceStatusHistoryWithResponse(ctx context.Context, id InvoiceId, params *GetInvoiceStatusHistoryParams, reqEditors ...RequestEditorFn) (*GetInvoiceStatusHistoryResponse, error)
+
+	// AddTagToInvoiceWithBodyWithResponse request with any body
+	AddTagToInvoiceWithBodyWithResponse(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTagToInvoiceResponse, error)
+
+	AddTagToInvoiceWithResponse(ctx context.Context, id InvoiceId, body AddTagToInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTagToInvoiceResponse, error)
+
+	// RemoveTagFromInvoiceWithResponse request
+	RemoveTagFromInvoiceWithResponse(ctx context.Context, id InvoiceId, tagId int, reqEditors ...RequestEditorFn) (*RemoveTagFromInvoiceResponse, error)
+
+	// DeleteInvoiceItemWithResponse request
+	DeleteInvoiceItemWithResponse(ctx context.Context, invoiceId int, itemId int, reqEditors ...RequestEditorFn) (*DeleteInvoiceItemResponse, error)
+
+	// UpdateInvoiceItemWithBodyWithResponse request with any body
+	UpdateInvoiceItemWithBodyWithResponse(ctx context.Context, invoiceId int, itemId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateInvoiceItemResponse, error)
+
+	UpdateInvoiceItemWithResponse(ctx context.Context, invoiceId int, itemId int, body UpdateInvoiceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateInvoiceItemResponse, error)
+
+	// ListOrganizationsWithResponse request
+	ListOrganizationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListOrganizationsResponse, error)
+
+	// CreateOrganizationWithBodyWithResponse request with any body
+	CreateOrganizationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationResponse, error)
+
+	CreateOrganizationWithResponse(ctx context.Context, body CreateOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationResponse, error)
+
+	// ListOrganizationMembersWithResponse request
+	ListOrganizationMembersWithResponse(ctx context.Context, id OrganizationId, reqEditors ...RequestEditorFn) (*ListOrganizationMembersResponse, error)
+
+	// RemoveOrganizationMemberWithResponse request
+	RemoveOrganizationMemberWithResponse(ctx context.Context, id OrganizationId, userId MemberUserId, reqEditors ...RequestEditorFn) (*RemoveOrganizationMemberResponse, error)
+
+	// SetOrganizationMemberWithBodyWithResponse request with any body
+	SetOrganizationMemberWithBodyWithResponse(ctx context.Context, id OrganizationId, userId MemberUserId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetOrganizationMemberResponse, error)
+
+	SetOrganizationMemberWithResponse(ctx context.Context, id OrganizationId, userId MemberUserId, body SetOrganizationMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*SetOrganizationMemberResponse, error)
+
+	// ListPaymentMethodsWithResponse request
+	ListPaymentMethodsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPaymentMethodsResponse, error)
+
+	// ListReceiversWithResponse request
+	ListReceiversWithResponse(ctx context.Context, params *ListReceiversParams, reqEditors ...RequestEditorFn) (*ListReceiversResponse, error)
+
+	// CreateReceiverWithBodyWithResponse request with any body
+	CreateReceiverWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateReceiverResponse, error)
+
+	CreateReceiverWithResponse(ctx context.Context, body CreateReceiverJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateReceiverResponse, error)
+
+	// MergeReceiversWithBodyWithResponse request with any body
+	MergeReceiversWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergeReceiversResponse, error)
+
+	MergeReceiversWithResponse(ctx context.Context, body MergeReceiversJSONRequestBody, reqEditors ...RequestEditorFn) (*MergeReceiversResponse, error)
+
+	// DeleteReceiverWithResponse request
+	DeleteReceiverWithResponse(ctx context.Context, id ReceiverId, params *DeleteReceiverParams, reqEditors ...RequestEditorFn) (*DeleteReceiverResponse, error)
+
+	// GetReceiverWithResponse request
+	GetReceiverWithResponse(ctx context.Context, id ReceiverId, reqEditors ...RequestEditorFn) (*GetReceiverResponse, error)
+
+	// UpdateReceiverWithBodyWithResponse request with any body
+	UpdateReceiverWithBodyWithResponse(ctx context.Context, id ReceiverId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateReceiverResponse, error)
+
+	UpdateReceiverWithResponse(ctx context.Context, id ReceiverId, body UpdateReceiverJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateReceiverResponse, error)
+
+	// GetReceiverStatementWithResponse request
+	GetReceiverStatementWithResponse(ctx context.Context, id ReceiverId, params *GetReceiverStatementParams, reqEditors ...RequestEditorFn) (*GetReceiverStatementResponse, error)
+
+	// GetReceiverStatisticsWithResponse request
+	GetReceiverStatisticsWithResponse(ctx context.Context, id ReceiverId, params *GetReceiverStatisticsParams, reqEditors ...RequestEditorFn) (*GetReceiverStatisticsResponse, error)
+
+	// GetSettingsWithResponse request
+	GetSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSettingsResponse, error)
+
+	// UpdateSettingsWithBodyWithResponse request with any body
+	UpdateSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSettingsResponse, error)
+
+	UpdateSettingsWithResponse(ctx context.Context, body UpdateSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSettingsResponse, error)
+
+	// ListTagsWithResponse request
+	ListTagsWithResponse(ctx context.Context, params *ListTagsParams, reqEditors ...RequestEditorFn) (*ListTagsResponse, error)
+
+	// CreateTagWithBodyWithResponse request with any body
+	CreateTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTagResponse, error)
+
+	CreateTagWithResponse(ctx context.Context, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTagResponse, error)
+
+	// DeleteTagWithResponse request
+	DeleteTagWithResponse(ctx context.Context, id TagId, reqEditors ...RequestEditorFn) (*DeleteTagResponse, error)
+
+	// GetTagWithResponse request
+	GetTagWithResponse(ctx context.Context, id TagId, reqEditors ...RequestEditorFn) (*GetTagResponse, error)
+
+	// UpdateTagWithBodyWithResponse request with any body
+	UpdateTagWithBodyWithResponse(ctx context.Context, id TagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error)
+
+	UpdateTagWithResponse(ctx context.Context, id TagId, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error)
+
+	// UploadFileWithBodyWithResponse request with any body
+	UploadFileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadFileResponse, error)
+
+	// ConfirmPresignedUploadWithBodyWithResponse request with any body
+	ConfirmPresignedUploadWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmPresignedUploadResponse, error)
+
+	ConfirmPresignedUploadWithResponse(ctx context.Context, body ConfirmPresignedUploadJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmPresignedUploadResponse, error)
+
+	// UploadHtmlToPdfWithBodyWithResponse request with any body
+	UploadHtmlToPdfWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadHtmlToPdfResponse, error)
+
+	UploadHtmlToPdfWithResponse(ctx context.Context, body UploadHtmlToPdfJSONRequestBody, reqEditors ...RequestEditorFn) (*UploadHtmlToPdfResponse, error)
+
+	// GetPresignedURLWithResponse request
+	GetPresignedURLWithResponse(ctx context.Context, params *GetPresignedURLParams, reqEditors ...RequestEditorFn) (*GetPresignedURLResponse, error)
+
+	// HealthCheckWithResponse request
+	HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error)
+}
+
+type GetAnalyticsByCategoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AnalyticsByGroup
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAnalyticsByCategoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAnalyticsByCategoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAnalyticsByCompanyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AnalyticsByGroup
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAnalyticsByCompanyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAnalyticsByCompanyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAnalyticsByReceiverResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AnalyticsByGroup
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAnalyticsByReceiverResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAnalyticsByReceiverResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAnalyticsByTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AnalyticsByGroup
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAnalyticsByTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAnalyticsByTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAnalyticsSummaryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AnalyticsSummary
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAnalyticsSummaryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAnalyticsSummaryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAnalyticsTrendResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MonthlyTrend
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAnalyticsTrendResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAnalyticsTrendResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListBudgetsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BudgetListResponse
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBudgetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBudgetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateBudgetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Budget
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateBudgetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateBudgetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBudgetStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BudgetStatusResponse
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBudgetStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBudgetStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteBudgetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBudgetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBudgetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCategoriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CategoryListResponse
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCategoriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCategoriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateCategoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Category
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateCategoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateCategoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCategoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+	JSON409      *InUse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCategoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCategoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCategoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Category
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCategoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCategoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCategoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Category
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCategoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCategoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCompaniesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CompanyListResponse
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCompaniesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCompaniesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateCompanyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Company
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateCompanyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateCompanyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCompanyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+	JSON409      *InUse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCompanyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCompanyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCompanyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Company
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCompanyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCompanyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCompanyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Company
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCompanyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCompanyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCompanyBreakdownResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CompanyBreakdown
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCompanyBreakdownResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCompanyBreakdownResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDashboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Dashboard
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDashboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDashboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportDataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ExportDocument
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportDataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportDataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RequestExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *ExportJob
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r RequestExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RequestExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetExportJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ExportJob
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetExportJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetExportJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFileDownloadURLResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FileDownloadURLResponse
+	JSON401      *Unauthorized
+	JSON403      *Error
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFileDownloadURLResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFileDownloadURLResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportDataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ImportResult
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportDataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportDataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListInvoicesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InvoiceListResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ListInvoicesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListInvoicesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateInvoiceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Invoice
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateInvoiceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateInvoiceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInvoiceFacetsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InvoiceFacets
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInvoiceFacetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInvoiceFacetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportInvoicesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InvoiceImportResult
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportInvoicesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportInvoicesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LookupInvoicesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InvoiceLookupResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r LookupInvoicesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LookupInvoicesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInvoiceStatusCountsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InvoiceStatusCounts
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInvoiceStatusCountsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInvoiceStatusCountsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteInvoiceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteInvoiceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteInvoiceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInvoiceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Invoice
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInvoiceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInvoiceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateInvoiceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Invoice
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateInvoiceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateInvoiceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListInvoiceAttachmentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InvoiceAttachmentListResponse
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ListInvoiceAttachmentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListInvoiceAttachmentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddInvoiceAttachmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *InvoiceAttachment
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r AddInvoiceAttachmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddInvoiceAttachmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveInvoiceAttachmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveInvoiceAttachmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveInvoiceAttachmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInvoiceAuditTrailResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuditTrailResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInvoiceAuditTrailResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInvoiceAuditTrailResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CloneInvoiceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Invoice
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CloneInvoiceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CloneInvoiceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PreviewCurrencyConversionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ConversionPreview
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r PreviewCurrencyConversionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PreviewCurrencyConversionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FinalizeInvoiceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Invoice
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r FinalizeInvoiceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FinalizeInvoiceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddInvoiceItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *InvoiceItem
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r AddInvoiceItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddInvoiceItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddInvoiceItemsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *InvoiceItemListResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r AddInvoiceItemsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddInvoiceItemsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReorderInvoiceItemsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Invoice
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ReorderInvoiceItemsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReorderInvoiceItemsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RecalculateInvoiceTotalsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TotalsRecalculation
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r RecalculateInvoiceTotalsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RecalculateInvoiceTotalsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindSimilarInvoicesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SimilarInvoicesResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r FindSimilarInvoicesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindSimilarInvoicesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateInvoiceStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Invoice
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateInvoiceStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateInvoiceStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInvoiceStatusHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuditTrailResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInvoiceStatusHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInvoiceStatusHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddTagToInvoiceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Invoice
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r AddTagToInvoiceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddTagToInvoiceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveTagFromInvoiceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Invoice
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveTagFromInvoiceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveTagFromInvoiceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteInvoiceItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteInvoiceItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteInvoiceItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateInvoiceItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InvoiceItem
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateInvoiceItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateInvoiceItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListOrganizationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Organization
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOrganizationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOrganizationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateOrganizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Organization
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateOrganizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateOrganizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListOrganizationMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]OrganizationMember
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOrganizationMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOrganizationMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveOrganizationMemberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveOrganizationMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveOrganizationMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetOrganizationMemberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrganizationMember
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r SetOrganizationMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetOrganizationMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPaymentMethodsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PaymentMethodListResponse
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPaymentMethodsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPaymentMethodsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListReceiversResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReceiverListResponse
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ListReceiversResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListReceiversResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateReceiverResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Receiver
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateReceiverResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateReceiverResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MergeReceiversResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MergeReceiversResult
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r MergeReceiversResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MergeReceiversResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteReceiverResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+	JSON409      *InUse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteReceiverResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteReceiverResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetReceiverResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Receiver
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetReceiverResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetReceiverResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateReceiverResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Receiver
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateReceiverResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateReceiverResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetReceiverStatementResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VendorStatement
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetReceiverStatementResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetReceiverStatementResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetReceiverStatisticsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReceiverDetail
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetReceiverStatisticsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetReceiverStatisticsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserSettings
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserSettings
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TagListResponse
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Tag
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Tag
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Tag
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UploadFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *UploadResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON413      *Error
+	JSON415      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfirmPresignedUploadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *UploadResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfirmPresignedUploadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfirmPresignedUploadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UploadHtmlToPdfResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *UploadResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadHtmlToPdfResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadHtmlToPdfResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPresignedURLResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PresignedURLResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPresignedURLResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPresignedURLResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HealthCheckResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HealthStatus
+	JSON503      *HealthStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r HealthCheckResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HealthCheckResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetAnalyticsByCategoryWithResponse request returning *GetAnalyticsByCategoryResponse
+func (c *ClientWithResponses) GetAnalyticsByCategoryWithResponse(ctx context.Context, params *GetAnalyticsByCategoryParams, reqEditors ...RequestEditorFn) (*GetAnalyticsByCategoryResponse, error) {
+	rsp, err := c.GetAnalyticsByCategory(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAnalyticsByCategoryResponse(rsp)
+}
+
+// GetAnalyticsByCompanyWithResponse request returning *GetAnalyticsByCompanyResponse
+func (c *ClientWithResponses) GetAnalyticsByCompanyWithResponse(ctx context.Context, params *GetAnalyticsByCompanyParams, reqEditors ...RequestEditorFn) (*GetAnalyticsByCompanyResponse, error) {
+	rsp, err := c.GetAnalyticsByCompany(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAnalyticsByCompanyResponse(rsp)
+}
+
+// GetAnalyticsByReceiverWithResponse request returning *GetAnalyticsByReceiverResponse
+func (c *ClientWithResponses) GetAnalyticsByReceiverWithResponse(ctx context.Context, params *GetAnalyticsByReceiverParams, reqEditors ...RequestEditorFn) (*GetAnalyticsByReceiverResponse, error) {
+	rsp, err := c.GetAnalyticsByReceiver(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAnalyticsByReceiverResponse(rsp)
+}
+
+// GetAnalyticsByTagWithResponse request returning *GetAnalyticsByTagResponse
+func (c *ClientWithResponses) GetAnalyticsByTagWithResponse(ctx context.Context, params *GetAnalyticsByTagParams, reqEditors ...RequestEditorFn) (*GetAnalyticsByTagResponse, error) {
+	rsp, err := c.GetAnalyticsByTag(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAnalyticsByTagResponse(rsp)
+}
+
+// GetAnalyticsSummaryWithResponse request returning *GetAnalyticsSummaryResponse
+func (c *ClientWithResponses) GetAnalyticsSummaryWithResponse(ctx context.Context, params *GetAnalyticsSummaryParams, reqEditors ...RequestEditorFn) (*GetAnalyticsSummaryResponse, error) {
+	rsp, err := c.GetAnalyticsSummary(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAnalyticsSummaryResponse(rsp)
+}
+
+// GetAnalyticsTrendWithResponse request returning *GetAnalyticsTrendResponse
+func (c *ClientWithResponses) GetAnalyticsTrendWithResponse(ctx context.Context, params *GetAnalyticsTrendParams, reqEditors ...RequestEditorFn) (*GetAnalyticsTrendResponse, error) {
+	rsp, err := c.GetAnalyticsTrend(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAnalyticsTrendResponse(rsp)
+}
+
+// ListBudgetsWithResponse request returning *ListBudgetsResponse
+func (c *ClientWithResponses) ListBudgetsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListBudgetsResponse, error) {
+	rsp, err := c.ListBudgets(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBudgetsResponse(rsp)
+}
+
+// CreateBudgetWithBodyWithResponse request with arbitrary body returning *CreateBudgetResponse
+func (c *ClientWithResponses) CreateBudgetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBudgetResponse, error) {
+	rsp, err := c.CreateBudgetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBudgetResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateBudgetWithResponse(ctx context.Context, body CreateBudgetJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBudgetResponse, error) {
+	rsp, err := c.CreateBudget(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBudgetResponse(rsp)
+}
+
+// GetBudgetStatusWithResponse request returning *GetBudgetStatusResponse
+func (c *ClientWithResponses) GetBudgetStatusWithResponse(ctx context.Context, params *GetBudgetStatusParams, reqEditors ...RequestEditorFn) (*GetBudgetStatusResponse, error) {
+	rsp, err := c.GetBudgetStatus(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBudgetStatusResponse(rsp)
+}
+
+// DeleteBudgetWithResponse request returning *DeleteBudgetResponse
+func (c *ClientWithResponses) DeleteBudgetWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteBudgetResponse, error) {
+	rsp, err := c.DeleteBudget(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBudgetResponse(rsp)
+}
+
+// ListCategoriesWithResponse request returning *ListCategoriesResponse
+func (c *ClientWithResponses) ListCategoriesWithResponse(ctx context.Context, params *ListCategoriesParams, reqEditors ...RequestEditorFn) (*ListCategoriesResponse, error) {
+	rsp, err := c.ListCategories(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCategoriesResponse(rsp)
+}
+
+// CreateCategoryWithBodyWithResponse request with arbitrary body returning *CreateCategoryResponse
+func (c *ClientWithResponses) CreateCategoryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error) {
+	rsp, err := c.CreateCategoryWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCategoryResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateCategoryWithResponse(ctx context.Context, body CreateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error) {
+	rsp, err := c.CreateCategory(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCategoryResponse(rsp)
+}
+
+// DeleteCategoryWithResponse request returning *DeleteCategoryResponse
+func (c *ClientWithResponses) DeleteCategoryWithResponse(ctx context.Context, id CategoryId, params *DeleteCategoryParams, reqEditors ...RequestEditorFn) (*DeleteCategoryResponse, error) {
+	rsp, err := c.DeleteCategory(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCategoryResponse(rsp)
+}
+
+// GetCategoryWithResponse request returning *GetCategoryResponse
+func (c *ClientWithResponses) GetCategoryWithResponse(ctx context.Context, id CategoryId, reqEditors ...RequestEditorFn) (*GetCategoryResponse, error) {
+	rsp, err := c.GetCategory(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCategoryResponse(rsp)
+}
+
+// UpdateCategoryWithBodyWithResponse request with arbitrary body returning *UpdateCategoryResponse
+func (c *ClientWithResponses) UpdateCategoryWithBodyWithResponse(ctx context.Context, id CategoryId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCategoryResponse, error) {
+	rsp, err := c.UpdateCategoryWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCategoryResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCategoryWithResponse(ctx context.Context, id CategoryId, body UpdateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCategoryResponse, error) {
+	rsp, err := c.UpdateCategory(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCategoryResponse(rsp)
+}
+
+// ListCompaniesWithResponse request returning *ListCompaniesResponse
+func (c *ClientWithResponses) ListCompaniesWithResponse(ctx context.Context, params *ListCompaniesParams, reqEditors ...RequestEditorFn) (*ListCompaniesResponse, error) {
+	rsp, err := c.ListCompanies(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCompaniesResponse(rsp)
+}
+
+// CreateCompanyWithBodyWithResponse request with arbitrary body returning *CreateCompanyResponse
+func (c *ClientWithResponses) CreateCompanyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCompanyResponse, error) {
+	rsp, err := c.CreateCompanyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCompanyResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateCompanyWithResponse(ctx context.Context, body CreateCompanyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCompanyResponse, error) {
+	rsp, err := c.CreateCompany(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCompanyResponse(rsp)
+}
+
+// DeleteCompanyWithResponse request returning *DeleteCompanyResponse
+func (c *ClientWithResponses) DeleteCompanyWithResponse(ctx context.Context, id CompanyId, params *DeleteCompanyParams, reqEditors ...RequestEditorFn) (*DeleteCompanyResponse, error) {
+	rsp, err := c.DeleteCompany(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCompanyResponse(rsp)
+}
+
+// GetCompanyWithResponse request returning *GetCompanyResponse
+func (c *ClientWithResponses) GetCompanyWithResponse(ctx context.Context, id CompanyId, reqEditors ...RequestEditorFn) (*GetCompanyResponse, error) {
+	rsp, err := c.GetCompany(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCompanyResponse(rsp)
+}
+
+// UpdateCompanyWithBodyWithResponse request with arbitrary body returning *UpdateCompanyResponse
+func (c *ClientWithResponses) UpdateCompanyWithBodyWithResponse(ctx context.Context, id CompanyId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCompanyResponse, error) {
+	rsp, err := c.UpdateCompanyWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCompanyResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCompanyWithResponse(ctx context.Context, id CompanyId, body UpdateCompanyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCompanyResponse, error) {
+	rsp, err := c.UpdateCompany(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCompanyResponse(rsp)
+}
+
+// GetCompanyBreakdownWithResponse request returning *GetCompanyBreakdownResponse
+func (c *ClientWithResponses) GetCompanyBreakdownWithResponse(ctx context.Context, id CompanyId, params *GetCompanyBreakdownParams, reqEditors ...RequestEditorFn) (*GetCompanyBreakdownResponse, error) {
+	rsp, err := c.GetCompanyBreakdown(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCompanyBreakdownResponse(rsp)
+}
+
+// GetDashboardWithResponse request returning *GetDashboardResponse
+func (c *ClientWithResponses) GetDashboardWithResponse(ctx context.Context, params *GetDashboardParams, reqEditors ...RequestEditorFn) (*GetDashboardResponse, error) {
+	rsp, err := c.GetDashboard(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDashboardResponse(rsp)
+}
+
+// ExportDataWithResponse request returning *ExportDataResponse
+func (c *ClientWithResponses) ExportDataWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ExportDataResponse, error) {
+	rsp, err := c.ExportData(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportDataResponse(rsp)
+}
+
+// RequestExportWithResponse request returning *RequestExportResponse
+func (c *ClientWithResponses) RequestExportWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RequestExportResponse, error) {
+	rsp, err := c.RequestExport(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequestExportResponse(rsp)
+}
+
+// GetExportJobWithResponse request returning *GetExportJobResponse
+func (c *ClientWithResponses) GetExportJobWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetExportJobResponse, error) {
+	rsp, err := c.GetExportJob(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetExportJobResponse(rsp)
+}
+
+// GetFileDownloadURLWithResponse request returning *GetFileDownloadURLResponse
+func (c *ClientWithResponses) GetFileDownloadURLWithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*GetFileDownloadURLResponse, error) {
+	rsp, err := c.GetFileDownloadURL(ctx, key, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFileDownloadURLResponse(rsp)
+}
+
+// ImportDataWithBodyWithResponse request with arbitrary body returning *ImportDataResponse
+func (c *ClientWithResponses) ImportDataWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportDataResponse, error) {
+	rsp, err := c.ImportDataWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportDataResponse(rsp)
+}
+
+func (c *ClientWithResponses) ImportDataWithResponse(ctx context.Context, body ImportDataJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportDataResponse, error) {
+	rsp, err := c.ImportData(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportDataResponse(rsp)
+}
+
+// ListInvoicesWithResponse request returning *ListInvoicesResponse
+func (c *ClientWithResponses) ListInvoicesWithResponse(ctx context.Context, params *ListInvoicesParams, reqEditors ...RequestEditorFn) (*ListInvoicesResponse, error) {
+	rsp, err := c.ListInvoices(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListInvoicesResponse(rsp)
+}
+
+// CreateInvoiceWithBodyWithResponse request with arbitrary body returning *CreateInvoiceResponse
+func (c *ClientWithResponses) CreateInvoiceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInvoiceResponse, error) {
+	rsp, err := c.CreateInvoiceWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInvoiceResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateInvoiceWithResponse(ctx context.Context, body CreateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInvoiceResponse, error) {
+	rsp, err := c.CreateInvoice(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInvoiceResponse(rsp)
+}
+
+// GetInvoiceFacetsWithResponse request returning *GetInvoiceFacetsResponse
+func (c *ClientWithResponses) GetInvoiceFacetsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetInvoiceFacetsResponse, error) {
+	rsp, err := c.GetInvoiceFacets(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInvoiceFacetsResponse(rsp)
+}
+
+// ImportInvoicesWithBodyWithResponse request with arbitrary body returning *ImportInvoicesResponse
+func (c *ClientWithResponses) ImportInvoicesWithBodyWithResponse(ctx context.Context, params *ImportInvoicesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportInvoicesResponse, error) {
+	rsp, err := c.ImportInvoicesWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportInvoicesResponse(rsp)
+}
+
+// LookupInvoicesWithResponse request returning *LookupInvoicesResponse
+func (c *ClientWithResponses) LookupInvoicesWithResponse(ctx context.Context, params *LookupInvoicesParams, reqEditors ...RequestEditorFn) (*LookupInvoicesResponse, error) {
+	rsp, err := c.LookupInvoices(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLookupInvoicesResponse(rsp)
+}
+
+// GetInvoiceStatusCountsWithResponse request returning *GetInvoiceStatusCountsResponse
+func (c *ClientWithResponses) GetInvoiceStatusCountsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetInvoiceStatusCountsResponse, error) {
+	rsp, err := c.GetInvoiceStatusCounts(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInvoiceStatusCountsResponse(rsp)
+}
+
+// DeleteInvoiceWithResponse request returning *DeleteInvoiceResponse
+func (c *ClientWithResponses) DeleteInvoiceWithResponse(ctx context.Context, id InvoiceId, reqEditors ...RequestEditorFn) (*DeleteInvoiceResponse, error) {
+	rsp, err := c.DeleteInvoice(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteInvoiceResponse(rsp)
+}
+
+// GetInvoiceWithResponse request returning *GetInvoiceResponse
+func (c *ClientWithResponses) GetInvoiceWithResponse(ctx context.Context, id InvoiceId, params *GetInvoiceParams, reqEditors ...RequestEditorFn) (*GetInvoiceResponse, error) {
+	rsp, err := c.GetInvoice(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInvoiceResponse(rsp)
+}
+
+// UpdateInvoiceWithBodyWithResponse request with arbitrary body returning *UpdateInvoiceResponse
+func (c *ClientWithResponses) UpdateInvoiceWithBodyWithResponse(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateInvoiceResponse, error) {
+	rsp, err := c.UpdateInvoiceWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateInvoiceResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateInvoiceWithResponse(ctx context.Context, id InvoiceId, body UpdateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateInvoiceResponse, error) {
+	rsp, err := c.UpdateInvoice(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateInvoiceResponse(rsp)
+}
+
+// ListInvoiceAttachmentsWithResponse request returning *ListInvoiceAttachmentsResponse
+func (c *ClientWithResponses) ListInvoiceAttachmentsWithResponse(ctx context.Context, id InvoiceId, reqEditors ...RequestEditorFn) (*ListInvoiceAttachmentsResponse, error) {
+	rsp, err := c.ListInvoiceAttachments(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListInvoiceAttachmentsResponse(rsp)
+}
+
+// AddInvoiceAttachmentWithBodyWithResponse request with arbitrary body returning *AddInvoiceAttachmentResponse
+func (c *ClientWithResponses) AddInvoiceAttachmentWithBodyWithResponse(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddInvoiceAttachmentResponse, error) {
+	rsp, err := c.AddInvoiceAttachmentWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddInvoiceAttachmentResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddInvoiceAttachmentWithResponse(ctx context.Context, id InvoiceId, body AddInvoiceAttachmentJSONRequestBody, reqEditors ...RequestEditorFn) (*AddInvoiceAttachmentResponse, error) {
+	rsp, err := c.AddInvoiceAttachment(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddInvoiceAttachmentResponse(rsp)
+}
+
+// RemoveInvoiceAttachmentWithResponse request returning *RemoveInvoiceAttachmentResponse
+func (c *ClientWithResponses) RemoveInvoiceAttachmentWithResponse(ctx context.Context, id InvoiceId, attachmentId int, reqEditors ...RequestEditorFn) (*RemoveInvoiceAttachmentResponse, error) {
+	rsp, err := c.RemoveInvoiceAttachment(ctx, id, attachmentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveInvoiceAttachmentResponse(rsp)
+}
+
+// GetInvoiceAuditTrailWithResponse request returning *GetInvoiceAuditTrailResponse
+func (c *ClientWithResponses) GetInvoiceAuditTrailWithResponse(ctx context.Context, id InvoiceId, params *GetInvoiceAuditTrailParams, reqEditors ...RequestEditorFn) (*GetInvoiceAuditTrailResponse, error) {
+	rsp, err := c.GetInvoiceAuditTrail(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInvoiceAuditTrailResponse(rsp)
+}
+
+// CloneInvoiceWithBodyWithResponse request with arbitrary body returning *CloneInvoiceResponse
+func (c *ClientWithResponses) CloneInvoiceWithBodyWithResponse(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CloneInvoiceResponse, error) {
+	rsp, err := c.CloneInvoiceWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloneInvoiceResponse(rsp)
+}
+
+func (c *ClientWithResponses) CloneInvoiceWithResponse(ctx context.Context, id InvoiceId, body CloneInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*CloneInvoiceResponse, error) {
+	rsp, err := c.CloneInvoice(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloneInvoiceResponse(rsp)
+}
+
+// PreviewCurrencyConversionWithBodyWithResponse request with arbitrary body returning *PreviewCurrencyConversionResponse
+func (c *ClientWithResponses) PreviewCurrencyConversionWithBodyWithResponse(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreviewCurrencyConversionResponse, error) {
+	rsp, err := c.PreviewCurrencyConversionWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreviewCurrencyConversionResponse(rsp)
+}
+
+func (c *ClientWithResponses) PreviewCurrencyConversionWithResponse(ctx context.Context, id InvoiceId, body PreviewCurrencyConversionJSONRequestBody, reqEditors ...RequestEditorFn) (*PreviewCurrencyConversionResponse, error) {
+	rsp, err := c.PreviewCurrencyConversion(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreviewCurrencyConversionResponse(rsp)
+}
+
+// FinalizeInvoiceWithResponse request returning *FinalizeInvoiceResponse
+func (c *ClientWithResponses) FinalizeInvoiceWithResponse(ctx context.Context, id InvoiceId, reqEditors ...RequestEditorFn) (*FinalizeInvoiceResponse, error) {
+	rsp, err := c.FinalizeInvoice(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFinalizeInvoiceResponse(rsp)
+}
+
+// AddInvoiceItemWithBodyWithResponse request with arbitrary body returning *AddInvoiceItemResponse
+func (c *ClientWithResponses) AddInvoiceItemWithBodyWithResponse(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddInvoiceItemResponse, error) {
+	rsp, err := c.AddInvoiceItemWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddInvoiceItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddInvoiceItemWithResponse(ctx context.Context, id InvoiceId, body AddInvoiceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*AddInvoiceItemResponse, error) {
+	rsp, err := c.AddInvoiceItem(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddInvoiceItemResponse(rsp)
+}
+
+// AddInvoiceItemsWithBodyWithResponse request with arbitrary body returning *AddInvoiceItemsResponse
+func (c *ClientWithResponses) AddInvoiceItemsWithBodyWithResponse(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddInvoiceItemsResponse, error) {
+	rsp, err := c.AddInvoiceItemsWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddInvoiceItemsResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddInvoiceItemsWithResponse(ctx context.Context, id InvoiceId, body AddInvoiceItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*AddInvoiceItemsResponse, error) {
+	rsp, err := c.AddInvoiceItems(ctx, id, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return ParseAddInvoiceItemsResponse(rsp)
 }
 
-// NewHealthCheckRequest generates requests for HealthCheck
-func NewHealthCheckRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+// ReorderInvoiceItemsWithBodyWithResponse request with arbitrary body returning *ReorderInvoiceItemsResponse
+func (c *ClientWithResponses) ReorderInvoiceItemsWithBodyWithResponse(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReorderInvoiceItemsResponse, error) {
+	rsp, err := c.ReorderInvoiceItemsWithBody(ctx, id, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseReorderInvoiceItemsResponse(rsp)
+}
 
-	operationPath := fmt.Sprintf("/health")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *ClientWithResponses) ReorderInvoiceItemsWithResponse(ctx context.Context, id InvoiceId, body ReorderInvoiceItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*ReorderInvoiceItemsResponse, error) {
+	rsp, err := c.ReorderInvoiceItems(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseReorderInvoiceItemsResponse(rsp)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// RecalculateInvoiceTotalsWithResponse request returning *RecalculateInvoiceTotalsResponse
+func (c *ClientWithResponses) RecalculateInvoiceTotalsWithResponse(ctx context.Context, id InvoiceId, reqEditors ...RequestEditorFn) (*RecalculateInvoiceTotalsResponse, error) {
+	rsp, err := c.RecalculateInvoiceTotals(ctx, id, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseRecalculateInvoiceTotalsResponse(rsp)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+// FindSimilarInvoicesWithResponse request returning *FindSimilarInvoicesResponse
+func (c *ClientWithResponses) FindSimilarInvoicesWithResponse(ctx context.Context, id InvoiceId, params *FindSimilarInvoicesParams, reqEditors ...RequestEditorFn) (*FindSimilarInvoicesResponse, error) {
+	rsp, err := c.FindSimilarInvoices(ctx, id, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return ParseFindSimilarInvoicesResponse(rsp)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// UpdateInvoiceStatusWithBodyWithResponse request with arbitrary body returning *UpdateInvoiceStatusResponse
+func (c *ClientWithResponses) UpdateInvoiceStatusWithBodyWithResponse(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateInvoiceStatusResponse, error) {
+	rsp, err := c.UpdateInvoiceStatusWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return ParseUpdateInvoiceStatusResponse(rsp)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+func (c *ClientWithResponses) UpdateInvoiceStatusWithResponse(ctx context.Context, id InvoiceId, body UpdateInvoiceStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateInvoiceStatusResponse, error) {
+	rsp, err := c.UpdateInvoiceStatus(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateInvoiceStatusResponse(rsp)
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+// GetInvoiceStatusHistoryWithResponse request returning *GetInvoiceStatusHistoryResponse
+func (c *ClientWithResponses) GetInvoiceStatusHistoryWithResponse(ctx context.Context, id InvoiceId, params *GetInvoiceStatusHistoryParams, reqEditors ...RequestEditorFn) (*GetInvoiceStatusHistoryResponse, error) {
+	rsp, err := c.GetInvoiceStatusHistory(ctx, id, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	return ParseGetInvoiceStatusHistoryResponse(rsp)
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+// AddTagToInvoiceWithBodyWithResponse request with arbitrary body returning *AddTagToInvoiceResponse
+func (c *ClientWithResponses) AddTagToInvoiceWithBodyWithResponse(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTagToInvoiceResponse, error) {
+	rsp, err := c.AddTagToInvoiceWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseAddTagToInvoiceResponse(rsp)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetAnalyticsByCategoryWithResponse request
-	GetAnalyticsByCategoryWithResponse(ctx context.Context, params *GetAnalyticsByCategoryParams, reqEditors ...RequestEditorFn) (*GetAnalyticsByCategoryResponse, error)
-
-	// GetAnalyticsByCompanyWithResponse request
-	GetAnalyticsByCompanyWithResponse(ctx context.Context, params *GetAnalyticsByCompanyParams, reqEditors ...RequestEditorFn) (*GetAnalyticsByCompanyResponse, error)
-
-	// GetAnalyticsByReceiverWithResponse request
-	GetAnalyticsByReceiverWithResponse(ctx context.Context, params *GetAnalyticsByReceiverParams, reqEditors ...RequestEditorFn) (*GetAnalyticsByReceiverResponse, error)
-
-	// GetAnalyticsByTagWithResponse request
-	GetAnalyticsByTagWithResponse(ctx context.Context, params *GetAnalyticsByTagParams, reqEditors ...RequestEditorFn) (*GetAnalyticsByTagResponse, error)
-
-	// GetAnalyticsSummaryWithResponse request
-	GetAnalyticsSummaryWithResponse(ctx context.Context, params *GetAnalyticsSummaryParams, reqEditors ...RequestEditorFn) (*GetAnalyticsSummaryResponse, error)
-
-	// ListCategoriesWithResponse request
-	ListCategoriesWithResponse(ctx context.Context, params *ListCategoriesParams, reqEditors ...RequestEditorFn) (*ListCategoriesResponse, error)
-
-	// CreateCategoryWithBodyWithResponse request with any body
-	CreateCategoryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error)
-
-	CreateCategoryWithResponse(ctx context.Context, body CreateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error)
-
-	// DeleteCategoryWithResponse request
-	DeleteCategoryWithResponse(ctx context.Context, id CategoryId, reqEditors ...RequestEditorFn) (*DeleteCategoryResponse, error)
-
-	// GetCategoryWithResponse request
-	GetCategoryWithResponse(ctx context.Context, id CategoryId, reqEditors ...RequestEditorFn) (*GetCategoryResponse, error)
-
-	// UpdateCategoryWithBodyWithResponse request with any body
-	UpdateCategoryWithBodyWithResponse(ctx context.Context, id CategoryId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCategoryResponse, error)
-
-	UpdateCategoryWithResponse(ctx context.Context, id CategoryId, body UpdateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCategoryResponse, error)
-
-	// ListCompaniesWithResponse request
-	ListCompaniesWithResponse(ctx context.Context, params *ListCompaniesParams, reqEditors ...RequestEditorFn) (*ListCompaniesResponse, error)
-
-	// CreateCompanyWithBodyWithResponse request with any body
-	CreateCompanyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCompanyResponse, error)
-
-	CreateCompanyWithResponse(ctx context.Context, body CreateCompanyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCompanyResponse, error)
-
-	// DeleteCompanyWithResponse request
-	DeleteCompanyWithResponse(ctx context.Context, id CompanyId, reqEditors ...RequestEditorFn) (*DeleteCompanyResponse, error)
-
-	// GetCompanyWithResponse request
-	GetCompanyWithResponse(ctx context.Context, id CompanyId, reqEditors ...RequestEditorFn) (*GetCompanyResponse, error)
-
-	// UpdateCompanyWithBodyWithResponse request with any body
-	UpdateCompanyWithBodyWithResponse(ctx context.Context, id CompanyId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCompanyResponse, error)
-
-	UpdateCompanyWithResponse(ctx context.Context, id CompanyId, body UpdateCompanyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCompanyResponse, error)
-
-	// GetFileDownloadURLWithResponse request
-	GetFileDownloadURLWithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*GetFileDownloadURLResponse, error)
-
-	// ListInvoicesWithResponse request
-	ListInvoicesWithResponse(ctx context.Context, params *ListInvoicesParams, reqEditors ...RequestEditorFn) (*ListInvoicesResponse, error)
-
-	// CreateInvoiceWithBodyWithResponse request with any body
-	CreateInvoiceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInvoiceResponse, error)
-
-	CreateInvoiceWithResponse(ctx context.Context, body CreateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInvoiceResponse, error)
-
-	// DeleteInvoiceWithResponse request
-	DeleteInvoiceWithResponse(ctx context.Context, id InvoiceId, reqEditors ...RequestEditorFn) (*DeleteInvoiceResponse, error)
-
-	// GetInvoiceWithResponse request
-	GetInvoiceWithResponse(ctx context.Context, id InvoiceId, reqEditors ...RequestEditorFn) (*GetInvoiceResponse, error)
-
-	// UpdateInvoiceWithBodyWithResponse request with any body
-	UpdateInvoiceWithBodyWithResponse(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateInvoiceResponse, error)
-
-	UpdateInvoiceWithResponse(ctx context.Context, id InvoiceId, body UpdateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateInvoiceResponse, error)
-
-	// AddInvoiceItemWithBodyWithResponse request with any body
-	AddInvoiceItemWithBodyWithResponse(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddInvoiceItemResponse, error)
-
-	AddInvoiceItemWithResponse(ctx context.Context, id InvoiceId, body AddInvoiceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*AddInvoiceItemResponse, error)
-
-	// UpdateInvoiceStatusWithBodyWithResponse request with any body
-	UpdateInvoiceStatusWithBodyWithResponse(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateInvoiceStatusResponse, error)
-
-	UpdateInvoiceStatusWithResponse(ctx context.Context, id InvoiceId, body UpdateInvoiceStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateInvoiceStatusResponse, error)
-
-	// AddTagToInvoiceWithBodyWithResponse request with any body
-	AddTagToInvoiceWithBodyWithResponse(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTagToInvoiceResponse, error)
-
-	AddTagToInvoiceWithResponse(ctx context.Context, id InvoiceId, body AddTagToInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTagToInvoiceResponse, error)
-
-	// RemoveTagFromInvoiceWithResponse request
-	RemoveTagFromInvoiceWithResponse(ctx context.Context, id InvoiceId, tagId int, reqEditors ...RequestEditorFn) (*RemoveTagFromInvoiceResponse, error)
-
-	// DeleteInvoiceItemWithResponse request
-	DeleteInvoiceItemWithResponse(ctx context.Context, invoiceId int, itemId int, reqEditors ...RequestEditorFn) (*DeleteInvoiceItemResponse, error)
-
-	// UpdateInvoiceItemWithBodyWithResponse request with any body
-	UpdateInvoiceItemWithBodyWithResponse(ctx context.Context, invoiceId int, itemId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateInvoiceItemResponse, error)
-
-	UpdateInvoiceItemWithResponse(ctx context.Context, invoiceId int, itemId int, body UpdateInvoiceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateInvoiceItemResponse, error)
-
-	// ListReceiversWithResponse request
-	ListReceiversWithResponse(ctx context.Context, params *ListReceiversParams, reqEditors ...RequestEditorFn) (*ListReceiversResponse, error)
-
-	// CreateReceiverWithBodyWithResponse request with any body
-	CreateReceiverWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateReceiverResponse, error)
-
-	CreateReceiverWithResponse(ctx context.Context, body CreateReceiverJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateReceiverResponse, error)
-
-	// MergeReceiversWithBodyWithResponse request with any body
-	MergeReceiversWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergeReceiversResponse, error)
-
-	MergeReceiversWithResponse(ctx context.Context, body MergeReceiversJSONRequestBody, reqEditors ...RequestEditorFn) (*MergeReceiversResponse, error)
-
-	// DeleteReceiverWithResponse request
-	DeleteReceiverWithResponse(ctx context.Context, id ReceiverId, reqEditors ...RequestEditorFn) (*DeleteReceiverResponse, error)
-
-	// GetReceiverWithResponse request
-	GetReceiverWithResponse(ctx context.Context, id ReceiverId, reqEditors ...RequestEditorFn) (*GetReceiverResponse, error)
-
-	// UpdateReceiverWithBodyWithResponse request with any body
-	UpdateReceiverWithBodyWithResponse(ctx context.Context, id ReceiverId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateReceiverResponse, error)
-
-	UpdateReceiverWithResponse(ctx context.Context, id ReceiverId, body UpdateReceiverJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateReceiverResponse, error)
-
-	// ListTagsWithResponse request
-	ListTagsWithResponse(ctx context.Context, params *ListTagsParams, reqEditors ...RequestEditorFn) (*ListTagsResponse, error)
+func (c *ClientWithResponses) AddTagToInvoiceWithResponse(ctx context.Context, id InvoiceId, body AddTagToInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTagToInvoiceResponse, error) {
+	rsp, err := c.AddTagToInvoice(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddTagToInvoiceResponse(rsp)
+}
 
-	// CreateTagWithBodyWithResponse request with any body
-	CreateTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTagResponse, error)
+// RemoveTagFromInvoiceWithResponse request returning *RemoveTagFromInvoiceResponse
+func (c *ClientWithResponses) RemoveTagFromInvoiceWithResponse(ctx context.Context, id InvoiceId, tagId int, reqEditors ...RequestEditorFn) (*RemoveTagFromInvoiceResponse, error) {
+	rsp, err := c.RemoveTagFromInvoice(ctx, id, tagId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveTagFromInvoiceResponse(rsp)
+}
 
-	CreateTagWithResponse(ctx context.Context, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTagResponse, error)
+// DeleteInvoiceItemWithResponse request returning *DeleteInvoiceItemResponse
+func (c *ClientWithResponses) DeleteInvoiceItemWithResponse(ctx context.Context, invoiceId int, itemId int, reqEditors ...RequestEditorFn) (*DeleteInvoiceItemResponse, error) {
+	rsp, err := c.DeleteInvoiceItem(ctx, invoiceId, itemId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteInvoiceItemResponse(rsp)
+}
 
-	// DeleteTagWithResponse request
-	DeleteTagWithResponse(ctx context.Context, id TagId, reqEditors ...RequestEditorFn) (*DeleteTagResponse, error)
+// UpdateInvoiceItemWithBodyWithResponse request with arbitrary body returning *UpdateInvoiceItemResponse
+func (c *ClientWithResponses) UpdateInvoiceItemWithBodyWithResponse(ctx context.Context, invoiceId int, itemId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateInvoiceItemResponse, error) {
+	rsp, err := c.UpdateInvoiceItemWithBody(ctx, invoiceId, itemId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateInvoiceItemResponse(rsp)
+}
 
-	// GetTagWithResponse request
-	GetTagWithResponse(ctx context.Context, id TagId, reqEditors ...RequestEditorFn) (*GetTagResponse, error)
+func (c *ClientWithResponses) UpdateInvoiceItemWithResponse(ctx context.Context, invoiceId int, itemId int, body UpdateInvoiceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateInvoiceItemResponse, error) {
+	rsp, err := c.UpdateInvoiceItem(ctx, invoiceId, itemId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateInvoiceItemResponse(rsp)
+}
 
-	// UpdateTagWithBodyWithResponse request with any body
-	UpdateTagWithBodyWithResponse(ctx context.Context, id TagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error)
+// ListOrganizationsWithResponse request returning *ListOrganizationsResponse
+func (c *ClientWithResponses) ListOrganizationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListOrganizationsResponse, error) {
+	rsp, err := c.ListOrganizations(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOrganizationsResponse(rsp)
+}
 
-	UpdateTagWithResponse(ctx context.Context, id TagId, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error)
+// CreateOrganizationWithBodyWithResponse request with arbitrary body returning *CreateOrganizationResponse
+func (c *ClientWithResponses) CreateOrganizationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationResponse, error) {
+	rsp, err := c.CreateOrganizationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationResponse(rsp)
+}
 
-	// UploadFileWithBodyWithResponse request with any body
-	UploadFileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadFileResponse, error)
+func (c *ClientWithResponses) CreateOrganizationWithResponse(ctx context.Context, body CreateOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationResponse, error) {
+	rsp, err := c.CreateOrganization(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationResponse(rsp)
+}
 
-	// ConfirmPresignedUploadWithBodyWithResponse request with any body
-	ConfirmPresignedUploadWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmPresignedUploadResponse, error)
+// ListOrganizationMembersWithResponse request returning *ListOrganizationMembersResponse
+func (c *ClientWithResponses) ListOrganizationMembersWithResponse(ctx context.Context, id OrganizationId, reqEditors ...RequestEditorFn) (*ListOrganizationMembersResponse, error) {
+	rsp, err := c.ListOrganizationMembers(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOrganizationMembersResponse(rsp)
+}
 
-	ConfirmPresignedUploadWithResponse(ctx context.Context, body ConfirmPresignedUploadJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmPresignedUploadResponse, error)
+// RemoveOrganizationMemberWithResponse request returning *RemoveOrganizationMemberResponse
+func (c *ClientWithResponses) RemoveOrganizationMemberWithResponse(ctx context.Context, id OrganizationId, userId MemberUserId, reqEditors ...RequestEditorFn) (*RemoveOrganizationMemberResponse, error) {
+	rsp, err := c.RemoveOrganizationMember(ctx, id, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveOrganizationMemberResponse(rsp)
+}
 
-	// UploadHtmlToPdfWithBodyWithResponse request with any body
-	UploadHtmlToPdfWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadHtmlToPdfResponse, error)
+// SetOrganizationMemberWithBodyWithResponse request with arbitrary body returning *SetOrganizationMemberResponse
+func (c *ClientWithResponses) SetOrganizationMemberWithBodyWithResponse(ctx context.Context, id OrganizationId, userId MemberUserId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetOrganizationMemberResponse, error) {
+	rsp, err := c.SetOrganizationMemberWithBody(ctx, id, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetOrganizationMemberResponse(rsp)
+}
 
-	UploadHtmlToPdfWithResponse(ctx context.Context, body UploadHtmlToPdfJSONRequestBody, reqEditors ...RequestEditorFn) (*UploadHtmlToPdfResponse, error)
+func (c *ClientWithResponses) SetOrganizationMemberWithResponse(ctx context.Context, id OrganizationId, userId MemberUserId, body SetOrganizationMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*SetOrganizationMemberResponse, error) {
+	rsp, err := c.SetOrganizationMember(ctx, id, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetOrganizationMemberResponse(rsp)
+}
 
-	// GetPresignedURLWithResponse request
-	GetPresignedURLWithResponse(ctx context.Context, params *GetPresignedURLParams, reqEditors ...RequestEditorFn) (*GetPresignedURLResponse, error)
+// ListPaymentMethodsWithResponse request returning *ListPaymentMethodsResponse
+func (c *ClientWithResponses) ListPaymentMethodsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPaymentMethodsResponse, error) {
+	rsp, err := c.ListPaymentMethods(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPaymentMethodsResponse(rsp)
+}
 
-	// HealthCheckWithResponse request
-	HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error)
+// ListReceiversWithResponse request returning *ListReceiversResponse
+func (c *ClientWithResponses) ListReceiversWithResponse(ctx context.Context, params *ListReceiversParams, reqEditors ...RequestEditorFn) (*ListReceiversResponse, error) {
+	rsp, err := c.ListReceivers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListReceiversResponse(rsp)
 }
 
-type GetAnalyticsByCategoryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AnalyticsByGroup
-	JSON401      *Unauthorized
+// CreateReceiverWithBodyWithResponse request with arbitrary body returning *CreateReceiverResponse
+func (c *ClientWithResponses) CreateReceiverWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateReceiverResponse, error) {
+	rsp, err := c.CreateReceiverWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateReceiverResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAnalyticsByCategoryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) CreateReceiverWithResponse(ctx context.Context, body CreateReceiverJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateReceiverResponse, error) {
+	rsp, err := c.CreateReceiver(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCreateReceiverResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAnalyticsByCategoryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// MergeReceiversWithBodyWithResponse request with arbitrary body returning *MergeReceiversResponse
+func (c *ClientWithResponses) MergeReceiversWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergeReceiversResponse, error) {
+	rsp, err := c.MergeReceiversWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseMergeReceiversResponse(rsp)
 }
 
-type GetAnalyticsByCompanyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AnalyticsByGroup
-	JSON401      *Unauthorized
+func (c *ClientWithResponses) MergeReceiversWithResponse(ctx context.Context, body MergeReceiversJSONRequestBody, reqEditors ...RequestEditorFn) (*MergeReceiversResponse, error) {
+	rsp, err := c.MergeReceivers(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMergeReceiversResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAnalyticsByCompanyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeleteReceiverWithResponse request returning *DeleteReceiverResponse
+func (c *ClientWithResponses) DeleteReceiverWithResponse(ctx context.Context, id ReceiverId, params *DeleteReceiverParams, reqEditors ...RequestEditorFn) (*DeleteReceiverResponse, error) {
+	rsp, err := c.DeleteReceiver(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeleteReceiverResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAnalyticsByCompanyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetReceiverWithResponse request returning *GetReceiverResponse
+func (c *ClientWithResponses) GetReceiverWithResponse(ctx context.Context, id ReceiverId, reqEditors ...RequestEditorFn) (*GetReceiverResponse, error) {
+	rsp, err := c.GetReceiver(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetReceiverResponse(rsp)
 }
 
-type GetAnalyticsByReceiverResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AnalyticsByGroup
-	JSON401      *Unauthorized
+// UpdateReceiverWithBodyWithResponse request with arbitrary body returning *UpdateReceiverResponse
+func (c *ClientWithResponses) UpdateReceiverWithBodyWithResponse(ctx context.Context, id ReceiverId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateReceiverResponse, error) {
+	rsp, err := c.UpdateReceiverWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateReceiverResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAnalyticsByReceiverResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) UpdateReceiverWithResponse(ctx context.Context, id ReceiverId, body UpdateReceiverJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateReceiverResponse, error) {
+	rsp, err := c.UpdateReceiver(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdateReceiverResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAnalyticsByReceiverResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetReceiverStatementWithResponse request returning *GetReceiverStatementResponse
+func (c *ClientWithResponses) GetReceiverStatementWithResponse(ctx context.Context, id ReceiverId, params *GetReceiverStatementParams, reqEditors ...RequestEditorFn) (*GetReceiverStatementResponse, error) {
+	rsp, err := c.GetReceiverStatement(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetReceiverStatementResponse(rsp)
 }
 
-type GetAnalyticsByTagResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AnalyticsByGroup
-	JSON401      *Unauthorized
+// GetReceiverStatisticsWithResponse request returning *GetReceiverStatisticsResponse
+func (c *ClientWithResponses) GetReceiverStatisticsWithResponse(ctx context.Context, id ReceiverId, params *GetReceiverStatisticsParams, reqEditors ...RequestEditorFn) (*GetReceiverStatisticsResponse, error) {
+	rsp, err := c.GetReceiverStatistics(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetReceiverStatisticsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAnalyticsByTagResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetSettingsWithResponse request returning *GetSettingsResponse
+func (c *ClientWithResponses) GetSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSettingsResponse, error) {
+	rsp, err := c.GetSettings(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetSettingsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAnalyticsByTagResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// UpdateSettingsWithBodyWithResponse request with arbitrary body returning *UpdateSettingsResponse
+func (c *ClientWithResponses) UpdateSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSettingsResponse, error) {
+	rsp, err := c.UpdateSettingsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdateSettingsResponse(rsp)
 }
 
-type GetAnalyticsSummaryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AnalyticsSummary
-	JSON401      *Unauthorized
+func (c *ClientWithResponses) UpdateSettingsWithResponse(ctx context.Context, body UpdateSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSettingsResponse, error) {
+	rsp, err := c.UpdateSettings(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSettingsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAnalyticsSummaryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ListTagsWithResponse request returning *ListTagsResponse
+func (c *ClientWithResponses) ListTagsWithResponse(ctx context.Context, params *ListTagsParams, reqEditors ...RequestEditorFn) (*ListTagsResponse, error) {
+	rsp, err := c.ListTags(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseListTagsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAnalyticsSummaryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CreateTagWithBodyWithResponse request with arbitrary body returning *CreateTagResponse
+func (c *ClientWithResponses) CreateTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTagResponse, error) {
+	rsp, err := c.CreateTagWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCreateTagResponse(rsp)
 }
 
-type ListCategoriesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CategoryListResponse
-	JSON401      *Unauthorized
+func (c *ClientWithResponses) CreateTagWithResponse(ctx context.Context, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTagResponse, error) {
+	rsp, err := c.CreateTag(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTagResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ListCategoriesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeleteTagWithResponse request returning *DeleteTagResponse
+func (c *ClientWithResponses) DeleteTagWithResponse(ctx context.Context, id TagId, reqEditors ...RequestEditorFn) (*DeleteTagResponse, error) {
+	rsp, err := c.DeleteTag(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeleteTagResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListCategoriesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetTagWithResponse request returning *GetTagResponse
+func (c *ClientWithResponses) GetTagWithResponse(ctx context.Context, id TagId, reqEditors ...RequestEditorFn) (*GetTagResponse, error) {
+	rsp, err := c.GetTag(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetTagResponse(rsp)
 }
 
-type CreateCategoryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Category
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
+// UpdateTagWithBodyWithResponse request with arbitrary body returning *UpdateTagResponse
+func (c *ClientWithResponses) UpdateTagWithBodyWithResponse(ctx context.Context, id TagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error) {
+	rsp, err := c.UpdateTagWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTagResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateCategoryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) UpdateTagWithResponse(ctx context.Context, id TagId, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error) {
+	rsp, err := c.UpdateTag(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdateTagResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateCategoryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// UploadFileWithBodyWithResponse request with arbitrary body returning *UploadFileResponse
+func (c *ClientWithResponses) UploadFileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadFileResponse, error) {
+	rsp, err := c.UploadFileWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUploadFileResponse(rsp)
 }
 
-type DeleteCategoryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *Unauthorized
-	JSON404      *NotFound
+// ConfirmPresignedUploadWithBodyWithResponse request with arbitrary body returning *ConfirmPresignedUploadResponse
+func (c *ClientWithResponses) ConfirmPresignedUploadWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmPresignedUploadResponse, error) {
+	rsp, err := c.ConfirmPresignedUploadWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmPresignedUploadResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteCategoryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) ConfirmPresignedUploadWithResponse(ctx context.Context, body ConfirmPresignedUploadJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmPresignedUploadResponse, error) {
+	rsp, err := c.ConfirmPresignedUpload(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseConfirmPresignedUploadResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteCategoryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// UploadHtmlToPdfWithBodyWithResponse request with arbitrary body returning *UploadHtmlToPdfResponse
+func (c *ClientWithResponses) UploadHtmlToPdfWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadHtmlToPdfResponse, error) {
+	rsp, err := c.UploadHtmlToPdfWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUploadHtmlToPdfResponse(rsp)
 }
 
-type GetCategoryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Category
-	JSON401      *Unauthorized
-	JSON404      *NotFound
+func (c *ClientWithResponses) UploadHtmlToPdfWithResponse(ctx context.Context, body UploadHtmlToPdfJSONRequestBody, reqEditors ...RequestEditorFn) (*UploadHtmlToPdfResponse, error) {
+	rsp, err := c.UploadHtmlToPdf(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadHtmlToPdfResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetCategoryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetPresignedURLWithResponse request returning *GetPresignedURLResponse
+func (c *ClientWithResponses) GetPresignedURLWithResponse(ctx context.Context, params *GetPresignedURLParams, reqEditors ...RequestEditorFn) (*GetPresignedURLResponse, error) {
+	rsp, err := c.GetPresignedURL(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetPresignedURLResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCategoryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// HealthCheckWithResponse request returning *HealthCheckResponse
+func (c *ClientWithResponses) HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error) {
+	rsp, err := c.HealthCheck(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseHealthCheckResponse(rsp)
 }
 
-type UpdateCategoryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Category
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-}
+// ParseGetAnalyticsByCategoryResponse parses an HTTP response from a GetAnalyticsByCategoryWithResponse call
+func ParseGetAnalyticsByCategoryResponse(rsp *http.Response) (*GetAnalyticsByCategoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r UpdateCategoryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &GetAnalyticsByCategoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateCategoryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AnalyticsByGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
 	}
-	return 0
-}
 
-type ListCompaniesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CompanyListResponse
-	JSON401      *Unauthorized
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListCompaniesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetAnalyticsByCompanyResponse parses an HTTP response from a GetAnalyticsByCompanyWithResponse call
+func ParseGetAnalyticsByCompanyResponse(rsp *http.Response) (*GetAnalyticsByCompanyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListCompaniesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetAnalyticsByCompanyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type CreateCompanyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Company
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AnalyticsByGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r CreateCompanyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateCompanyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type DeleteCompanyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *Unauthorized
-	JSON404      *NotFound
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteCompanyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetAnalyticsByReceiverResponse parses an HTTP response from a GetAnalyticsByReceiverWithResponse call
+func ParseGetAnalyticsByReceiverResponse(rsp *http.Response) (*GetAnalyticsByReceiverResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteCompanyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetAnalyticsByReceiverResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetCompanyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Company
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AnalyticsByGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetCompanyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCompanyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseGetAnalyticsByTagResponse parses an HTTP response from a GetAnalyticsByTagWithResponse call
+func ParseGetAnalyticsByTagResponse(rsp *http.Response) (*GetAnalyticsByTagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateCompanyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Company
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
+	response := &GetAnalyticsByTagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AnalyticsByGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateCompanyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetAnalyticsSummaryResponse parses an HTTP response from a GetAnalyticsSummaryWithResponse call
+func ParseGetAnalyticsSummaryResponse(rsp *http.Response) (*GetAnalyticsSummaryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateCompanyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetAnalyticsSummaryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetFileDownloadURLResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *FileDownloadURLResponse
-	JSON401      *Unauthorized
-	JSON403      *Error
-	JSON404      *NotFound
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AnalyticsSummary
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetFileDownloadURLResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetFileDownloadURLResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type ListInvoicesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *InvoiceListResponse
-	JSON401      *Unauthorized
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListInvoicesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetAnalyticsTrendResponse parses an HTTP response from a GetAnalyticsTrendWithResponse call
+func ParseGetAnalyticsTrendResponse(rsp *http.Response) (*GetAnalyticsTrendResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListInvoicesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetAnalyticsTrendResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type CreateInvoiceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Invoice
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MonthlyTrend
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r CreateInvoiceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateInvoiceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type DeleteInvoiceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *Unauthorized
-	JSON404      *NotFound
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteInvoiceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseListBudgetsResponse parses an HTTP response from a ListBudgetsWithResponse call
+func ParseListBudgetsResponse(rsp *http.Response) (*ListBudgetsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteInvoiceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ListBudgetsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetInvoiceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Invoice
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BudgetListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetInvoiceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetInvoiceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type UpdateInvoiceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Invoice
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateInvoiceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseCreateBudgetResponse parses an HTTP response from a CreateBudgetWithResponse call
+func ParseCreateBudgetResponse(rsp *http.Response) (*CreateBudgetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateInvoiceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &CreateBudgetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type AddInvoiceItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *InvoiceItem
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Budget
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
-// Status returns HTTPResponse.Status
-func (r AddInvoiceItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddInvoiceItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type UpdateInvoiceStatusResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Invoice
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateInvoiceStatusResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetBudgetStatusResponse parses an HTTP response from a GetBudgetStatusWithResponse call
+func ParseGetBudgetStatusResponse(rsp *http.Response) (*GetBudgetStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBudgetStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateInvoiceStatusResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BudgetStatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
 	}
-	return 0
-}
 
-type AddTagToInvoiceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Invoice
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON404      *NotFound
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r AddTagToInvoiceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteBudgetResponse parses an HTTP response from a DeleteBudgetWithResponse call
+func ParseDeleteBudgetResponse(rsp *http.Response) (*DeleteBudgetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddTagToInvoiceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeleteBudgetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type RemoveTagFromInvoiceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Invoice
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// Status returns HTTPResponse.Status
-func (r RemoveTagFromInvoiceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RemoveTagFromInvoiceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type DeleteInvoiceItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *Unauthorized
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteInvoiceItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseListCategoriesResponse parses an HTTP response from a ListCategoriesWithResponse call
+func ParseListCategoriesResponse(rsp *http.Response) (*ListCategoriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteInvoiceItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ListCategoriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type UpdateInvoiceItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *InvoiceItem
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CategoryListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r UpdateInvoiceItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateInvoiceItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type ListReceiversResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ReceiverListResponse
-	JSON401      *Unauthorized
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListReceiversResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseCreateCategoryResponse parses an HTTP response from a CreateCategoryWithResponse call
+func ParseCreateCategoryResponse(rsp *http.Response) (*CreateCategoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListReceiversResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &CreateCategoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type CreateReceiverResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Receiver
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Category
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
-// Status returns HTTPResponse.Status
-func (r CreateReceiverResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateReceiverResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type MergeReceiversResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MergeReceiversResult
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON404      *NotFound
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r MergeReceiversResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteCategoryResponse parses an HTTP response from a DeleteCategoryWithResponse call
+func ParseDeleteCategoryResponse(rsp *http.Response) (*DeleteCategoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r MergeReceiversResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeleteCategoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeleteReceiverResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeleteReceiverResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest InUse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteReceiverResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetReceiverResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Receiver
-	JSON401      *Unauthorized
-	JSON404      *NotFound
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetReceiverResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetCategoryResponse parses an HTTP response from a GetCategoryWithResponse call
+func ParseGetCategoryResponse(rsp *http.Response) (*GetCategoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetReceiverResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetCategoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type UpdateReceiverResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Receiver
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Category
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r UpdateReceiverResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateReceiverResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type ListTagsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TagListResponse
-	JSON401      *Unauthorized
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListTagsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseUpdateCategoryResponse parses an HTTP response from a UpdateCategoryWithResponse call
+func ParseUpdateCategoryResponse(rsp *http.Response) (*UpdateCategoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListTagsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &UpdateCategoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type CreateTagResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Tag
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Category
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r CreateTagResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateTagResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type DeleteTagResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *Unauthorized
-	JSON404      *NotFound
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteTagResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseListCompaniesResponse parses an HTTP response from a ListCompaniesWithResponse call
+func ParseListCompaniesResponse(rsp *http.Response) (*ListCompaniesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteTagResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ListCompaniesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetTagResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Tag
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CompanyListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetTagResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTagResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type UpdateTagResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Tag
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateTagResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseCreateCompanyResponse parses an HTTP response from a CreateCompanyWithResponse call
+func ParseCreateCompanyResponse(rsp *http.Response) (*CreateCompanyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateTagResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &CreateCompanyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type UploadFileResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *UploadResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Company
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// Status returns HTTPResponse.Status
-func (r UploadFileResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UploadFileResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type ConfirmPresignedUploadResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *UploadResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ConfirmPresignedUploadResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteCompanyResponse parses an HTTP response from a DeleteCompanyWithResponse call
+func ParseDeleteCompanyResponse(rsp *http.Response) (*DeleteCompanyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ConfirmPresignedUploadResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeleteCompanyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type UploadHtmlToPdfResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *UploadResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON500      *Error
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// Status returns HTTPResponse.Status
-func (r UploadHtmlToPdfResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest InUse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UploadHtmlToPdfResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetPresignedURLResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PresignedURLResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetPresignedURLResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetCompanyResponse parses an HTTP response from a GetCompanyWithResponse call
+func ParseGetCompanyResponse(rsp *http.Response) (*GetCompanyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetPresignedURLResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetCompanyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type HealthCheckResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Status *string `json:"status,omitempty"`
-	}
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Company
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r HealthCheckResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r HealthCheckResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
+
+	return response, nil
 }
 
-// GetAnalyticsByCategoryWithResponse request returning *GetAnalyticsByCategoryResponse
-func (c *ClientWithResponses) GetAnalyticsByCategoryWithResponse(ctx context.Context, params *GetAnalyticsByCategoryParams, reqEditors ...RequestEditorFn) (*GetAnalyticsByCategoryResponse, error) {
-	rsp, err := c.GetAnalyticsByCategory(ctx, params, reqEditors...)
+// ParseUpdateCompanyResponse parses an HTTP response from a UpdateCompanyWithResponse call
+func ParseUpdateCompanyResponse(rsp *http.Response) (*UpdateCompanyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAnalyticsByCategoryResponse(rsp)
-}
 
-// GetAnalyticsByCompanyWithResponse request returning *GetAnalyticsByCompanyResponse
-func (c *ClientWithResponses) GetAnalyticsByCompanyWithResponse(ctx context.Context, params *GetAnalyticsByCompanyParams, reqEditors ...RequestEditorFn) (*GetAnalyticsByCompanyResponse, error) {
-	rsp, err := c.GetAnalyticsByCompany(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateCompanyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetAnalyticsByCompanyResponse(rsp)
-}
 
-// GetAnalyticsByReceiverWithResponse request returning *GetAnalyticsByReceiverResponse
-func (c *ClientWithResponses) GetAnalyticsByReceiverWithResponse(ctx context.Context, params *GetAnalyticsByReceiverParams, reqEditors ...RequestEditorFn) (*GetAnalyticsByReceiverResponse, error) {
-	rsp, err := c.GetAnalyticsByReceiver(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Company
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
 	}
-	return ParseGetAnalyticsByReceiverResponse(rsp)
+
+	return response, nil
 }
 
-// GetAnalyticsByTagWithResponse request returning *GetAnalyticsByTagResponse
-func (c *ClientWithResponses) GetAnalyticsByTagWithResponse(ctx context.Context, params *GetAnalyticsByTagParams, reqEditors ...RequestEditorFn) (*GetAnalyticsByTagResponse, error) {
-	rsp, err := c.GetAnalyticsByTag(ctx, params, reqEditors...)
+// ParseGetCompanyBreakdownResponse parses an HTTP response from a GetCompanyBreakdownWithResponse call
+func ParseGetCompanyBreakdownResponse(rsp *http.Response) (*GetCompanyBreakdownResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAnalyticsByTagResponse(rsp)
-}
 
-// GetAnalyticsSummaryWithResponse request returning *GetAnalyticsSummaryResponse
-func (c *ClientWithResponses) GetAnalyticsSummaryWithResponse(ctx context.Context, params *GetAnalyticsSummaryParams, reqEditors ...RequestEditorFn) (*GetAnalyticsSummaryResponse, error) {
-	rsp, err := c.GetAnalyticsSummary(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetCompanyBreakdownResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetAnalyticsSummaryResponse(rsp)
-}
 
-// ListCategoriesWithResponse request returning *ListCategoriesResponse
-func (c *ClientWithResponses) ListCategoriesWithResponse(ctx context.Context, params *ListCategoriesParams, reqEditors ...RequestEditorFn) (*ListCategoriesResponse, error) {
-	rsp, err := c.ListCategories(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CompanyBreakdown
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseListCategoriesResponse(rsp)
+
+	return response, nil
 }
 
-// CreateCategoryWithBodyWithResponse request with arbitrary body returning *CreateCategoryResponse
-func (c *ClientWithResponses) CreateCategoryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error) {
-	rsp, err := c.CreateCategoryWithBody(ctx, contentType, body, reqEditors...)
+// ParseGetDashboardResponse parses an HTTP response from a GetDashboardWithResponse call
+func ParseGetDashboardResponse(rsp *http.Response) (*GetDashboardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateCategoryResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateCategoryWithResponse(ctx context.Context, body CreateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCategoryResponse, error) {
-	rsp, err := c.CreateCategory(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetDashboardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateCategoryResponse(rsp)
-}
 
-// DeleteCategoryWithResponse request returning *DeleteCategoryResponse
-func (c *ClientWithResponses) DeleteCategoryWithResponse(ctx context.Context, id CategoryId, reqEditors ...RequestEditorFn) (*DeleteCategoryResponse, error) {
-	rsp, err := c.DeleteCategory(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Dashboard
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
 	}
-	return ParseDeleteCategoryResponse(rsp)
+
+	return response, nil
 }
 
-// GetCategoryWithResponse request returning *GetCategoryResponse
-func (c *ClientWithResponses) GetCategoryWithResponse(ctx context.Context, id CategoryId, reqEditors ...RequestEditorFn) (*GetCategoryResponse, error) {
-	rsp, err := c.GetCategory(ctx, id, reqEditors...)
+// ParseExportDataResponse parses an HTTP response from a ExportDataWithResponse call
+func ParseExportDataResponse(rsp *http.Response) (*ExportDataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetCategoryResponse(rsp)
-}
 
-// UpdateCategoryWithBodyWithResponse request with arbitrary body returning *UpdateCategoryResponse
-func (c *ClientWithResponses) UpdateCategoryWithBodyWithResponse(ctx context.Context, id CategoryId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCategoryResponse, error) {
-	rsp, err := c.UpdateCategoryWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ExportDataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateCategoryResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateCategoryWithResponse(ctx context.Context, id CategoryId, body UpdateCategoryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCategoryResponse, error) {
-	rsp, err := c.UpdateCategory(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ExportDocument
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
 	}
-	return ParseUpdateCategoryResponse(rsp)
+
+	return response, nil
 }
 
-// ListCompaniesWithResponse request returning *ListCompaniesResponse
-func (c *ClientWithResponses) ListCompaniesWithResponse(ctx context.Context, params *ListCompaniesParams, reqEditors ...RequestEditorFn) (*ListCompaniesResponse, error) {
-	rsp, err := c.ListCompanies(ctx, params, reqEditors...)
+// ParseRequestExportResponse parses an HTTP response from a RequestExportWithResponse call
+func ParseRequestExportResponse(rsp *http.Response) (*RequestExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListCompaniesResponse(rsp)
-}
 
-// CreateCompanyWithBodyWithResponse request with arbitrary body returning *CreateCompanyResponse
-func (c *ClientWithResponses) CreateCompanyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCompanyResponse, error) {
-	rsp, err := c.CreateCompanyWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &RequestExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateCompanyResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateCompanyWithResponse(ctx context.Context, body CreateCompanyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCompanyResponse, error) {
-	rsp, err := c.CreateCompany(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest ExportJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
 	}
-	return ParseCreateCompanyResponse(rsp)
+
+	return response, nil
 }
 
-// DeleteCompanyWithResponse request returning *DeleteCompanyResponse
-func (c *ClientWithResponses) DeleteCompanyWithResponse(ctx context.Context, id CompanyId, reqEditors ...RequestEditorFn) (*DeleteCompanyResponse, error) {
-	rsp, err := c.DeleteCompany(ctx, id, reqEditors...)
+// ParseGetExportJobResponse parses an HTTP response from a GetExportJobWithResponse call
+func ParseGetExportJobResponse(rsp *http.Response) (*GetExportJobResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteCompanyResponse(rsp)
-}
 
-// GetCompanyWithResponse request returning *GetCompanyResponse
-func (c *ClientWithResponses) GetCompanyWithResponse(ctx context.Context, id CompanyId, reqEditors ...RequestEditorFn) (*GetCompanyResponse, error) {
-	rsp, err := c.GetCompany(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetExportJobResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetCompanyResponse(rsp)
-}
 
-// UpdateCompanyWithBodyWithResponse request with arbitrary body returning *UpdateCompanyResponse
-func (c *ClientWithResponses) UpdateCompanyWithBodyWithResponse(ctx context.Context, id CompanyId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCompanyResponse, error) {
-	rsp, err := c.UpdateCompanyWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ExportJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseUpdateCompanyResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdateCompanyWithResponse(ctx context.Context, id CompanyId, body UpdateCompanyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCompanyResponse, error) {
-	rsp, err := c.UpdateCompany(ctx, id, body, reqEditors...)
+// ParseGetFileDownloadURLResponse parses an HTTP response from a GetFileDownloadURLWithResponse call
+func ParseGetFileDownloadURLResponse(rsp *http.Response) (*GetFileDownloadURLResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateCompanyResponse(rsp)
-}
 
-// GetFileDownloadURLWithResponse request returning *GetFileDownloadURLResponse
-func (c *ClientWithResponses) GetFileDownloadURLWithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*GetFileDownloadURLResponse, error) {
-	rsp, err := c.GetFileDownloadURL(ctx, key, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetFileDownloadURLResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetFileDownloadURLResponse(rsp)
-}
 
-// ListInvoicesWithResponse request returning *ListInvoicesResponse
-func (c *ClientWithResponses) ListInvoicesWithResponse(ctx context.Context, params *ListInvoicesParams, reqEditors ...RequestEditorFn) (*ListInvoicesResponse, error) {
-	rsp, err := c.ListInvoices(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FileDownloadURLResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseListInvoicesResponse(rsp)
+
+	return response, nil
 }
 
-// CreateInvoiceWithBodyWithResponse request with arbitrary body returning *CreateInvoiceResponse
-func (c *ClientWithResponses) CreateInvoiceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInvoiceResponse, error) {
-	rsp, err := c.CreateInvoiceWithBody(ctx, contentType, body, reqEditors...)
+// ParseImportDataResponse parses an HTTP response from a ImportDataWithResponse call
+func ParseImportDataResponse(rsp *http.Response) (*ImportDataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateInvoiceResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateInvoiceWithResponse(ctx context.Context, body CreateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInvoiceResponse, error) {
-	rsp, err := c.CreateInvoice(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ImportDataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateInvoiceResponse(rsp)
-}
 
-// DeleteInvoiceWithResponse request returning *DeleteInvoiceResponse
-func (c *ClientWithResponses) DeleteInvoiceWithResponse(ctx context.Context, id InvoiceId, reqEditors ...RequestEditorFn) (*DeleteInvoiceResponse, error) {
-	rsp, err := c.DeleteInvoice(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
 	}
-	return ParseDeleteInvoiceResponse(rsp)
-}
 
-// GetInvoiceWithResponse request returning *GetInvoiceResponse
-func (c *ClientWithResponses) GetInvoiceWithResponse(ctx context.Context, id InvoiceId, reqEditors ...RequestEditorFn) (*GetInvoiceResponse, error) {
-	rsp, err := c.GetInvoice(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetInvoiceResponse(rsp)
+	return response, nil
 }
 
-// UpdateInvoiceWithBodyWithResponse request with arbitrary body returning *UpdateInvoiceResponse
-func (c *ClientWithResponses) UpdateInvoiceWithBodyWithResponse(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateInvoiceResponse, error) {
-	rsp, err := c.UpdateInvoiceWithBody(ctx, id, contentType, body, reqEditors...)
+// ParseListInvoicesResponse parses an HTTP response from a ListInvoicesWithResponse call
+func ParseListInvoicesResponse(rsp *http.Response) (*ListInvoicesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateInvoiceResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateInvoiceWithResponse(ctx context.Context, id InvoiceId, body UpdateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateInvoiceResponse, error) {
-	rsp, err := c.UpdateInvoice(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListInvoicesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateInvoiceResponse(rsp)
-}
 
-// AddInvoiceItemWithBodyWithResponse request with arbitrary body returning *AddInvoiceItemResponse
-func (c *ClientWithResponses) AddInvoiceItemWithBodyWithResponse(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddInvoiceItemResponse, error) {
-	rsp, err := c.AddInvoiceItemWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAddInvoiceItemResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InvoiceListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-func (c *ClientWithResponses) AddInvoiceItemWithResponse(ctx context.Context, id InvoiceId, body AddInvoiceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*AddInvoiceItemResponse, error) {
-	rsp, err := c.AddInvoiceItem(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAddInvoiceItemResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// UpdateInvoiceStatusWithBodyWithResponse request with arbitrary body returning *UpdateInvoiceStatusResponse
-func (c *ClientWithResponses) UpdateInvoiceStatusWithBodyWithResponse(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateInvoiceStatusResponse, error) {
-	rsp, err := c.UpdateInvoiceStatusWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseUpdateInvoiceStatusResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdateInvoiceStatusWithResponse(ctx context.Context, id InvoiceId, body UpdateInvoiceStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateInvoiceStatusResponse, error) {
-	rsp, err := c.UpdateInvoiceStatus(ctx, id, body, reqEditors...)
+// ParseCreateInvoiceResponse parses an HTTP response from a CreateInvoiceWithResponse call
+func ParseCreateInvoiceResponse(rsp *http.Response) (*CreateInvoiceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateInvoiceStatusResponse(rsp)
-}
 
-// AddTagToInvoiceWithBodyWithResponse request with arbitrary body returning *AddTagToInvoiceResponse
-func (c *ClientWithResponses) AddTagToInvoiceWithBodyWithResponse(ctx context.Context, id InvoiceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTagToInvoiceResponse, error) {
-	rsp, err := c.AddTagToInvoiceWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateInvoiceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseAddTagToInvoiceResponse(rsp)
-}
 
-func (c *ClientWithResponses) AddTagToInvoiceWithResponse(ctx context.Context, id InvoiceId, body AddTagToInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTagToInvoiceResponse, error) {
-	rsp, err := c.AddTagToInvoice(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Invoice
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
 	}
-	return ParseAddTagToInvoiceResponse(rsp)
+
+	return response, nil
 }
 
-// RemoveTagFromInvoiceWithResponse request returning *RemoveTagFromInvoiceResponse
-func (c *ClientWithResponses) RemoveTagFromInvoiceWithResponse(ctx context.Context, id InvoiceId, tagId int, reqEditors ...RequestEditorFn) (*RemoveTagFromInvoiceResponse, error) {
-	rsp, err := c.RemoveTagFromInvoice(ctx, id, tagId, reqEditors...)
+// ParseGetInvoiceFacetsResponse parses an HTTP response from a GetInvoiceFacetsWithResponse call
+func ParseGetInvoiceFacetsResponse(rsp *http.Response) (*GetInvoiceFacetsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseRemoveTagFromInvoiceResponse(rsp)
-}
 
-// DeleteInvoiceItemWithResponse request returning *DeleteInvoiceItemResponse
-func (c *ClientWithResponses) DeleteInvoiceItemWithResponse(ctx context.Context, invoiceId int, itemId int, reqEditors ...RequestEditorFn) (*DeleteInvoiceItemResponse, error) {
-	rsp, err := c.DeleteInvoiceItem(ctx, invoiceId, itemId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetInvoiceFacetsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteInvoiceItemResponse(rsp)
-}
 
-// UpdateInvoiceItemWithBodyWithResponse request with arbitrary body returning *UpdateInvoiceItemResponse
-func (c *ClientWithResponses) UpdateInvoiceItemWithBodyWithResponse(ctx context.Context, invoiceId int, itemId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateInvoiceItemResponse, error) {
-	rsp, err := c.UpdateInvoiceItemWithBody(ctx, invoiceId, itemId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InvoiceFacets
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
 	}
-	return ParseUpdateInvoiceItemResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdateInvoiceItemWithResponse(ctx context.Context, invoiceId int, itemId int, body UpdateInvoiceItemJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateInvoiceItemResponse, error) {
-	rsp, err := c.UpdateInvoiceItem(ctx, invoiceId, itemId, body, reqEditors...)
+// ParseImportInvoicesResponse parses an HTTP response from a ImportInvoicesWithResponse call
+func ParseImportInvoicesResponse(rsp *http.Response) (*ImportInvoicesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateInvoiceItemResponse(rsp)
-}
 
-// ListReceiversWithResponse request returning *ListReceiversResponse
-func (c *ClientWithResponses) ListReceiversWithResponse(ctx context.Context, params *ListReceiversParams, reqEditors ...RequestEditorFn) (*ListReceiversResponse, error) {
-	rsp, err := c.ListReceivers(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ImportInvoicesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseListReceiversResponse(rsp)
-}
 
-// CreateReceiverWithBodyWithResponse request with arbitrary body returning *CreateReceiverResponse
-func (c *ClientWithResponses) CreateReceiverWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateReceiverResponse, error) {
-	rsp, err := c.CreateReceiverWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InvoiceImportResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
 	}
-	return ParseCreateReceiverResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateReceiverWithResponse(ctx context.Context, body CreateReceiverJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateReceiverResponse, error) {
-	rsp, err := c.CreateReceiver(ctx, body, reqEditors...)
+// ParseLookupInvoicesResponse parses an HTTP response from a LookupInvoicesWithResponse call
+func ParseLookupInvoicesResponse(rsp *http.Response) (*LookupInvoicesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateReceiverResponse(rsp)
-}
 
-// MergeReceiversWithBodyWithResponse request with arbitrary body returning *MergeReceiversResponse
-func (c *ClientWithResponses) MergeReceiversWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MergeReceiversResponse, error) {
-	rsp, err := c.MergeReceiversWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &LookupInvoicesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseMergeReceiversResponse(rsp)
-}
 
-func (c *ClientWithResponses) MergeReceiversWithResponse(ctx context.Context, body MergeReceiversJSONRequestBody, reqEditors ...RequestEditorFn) (*MergeReceiversResponse, error) {
-	rsp, err := c.MergeReceivers(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InvoiceLookupResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	}
-	return ParseMergeReceiversResponse(rsp)
+
+	return response, nil
 }
 
-// DeleteReceiverWithResponse request returning *DeleteReceiverResponse
-func (c *ClientWithResponses) DeleteReceiverWithResponse(ctx context.Context, id ReceiverId, reqEditors ...RequestEditorFn) (*DeleteReceiverResponse, error) {
-	rsp, err := c.DeleteReceiver(ctx, id, reqEditors...)
+// ParseGetInvoiceStatusCountsResponse parses an HTTP response from a GetInvoiceStatusCountsWithResponse call
+func ParseGetInvoiceStatusCountsResponse(rsp *http.Response) (*GetInvoiceStatusCountsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteReceiverResponse(rsp)
-}
 
-// GetReceiverWithResponse request returning *GetReceiverResponse
-func (c *ClientWithResponses) GetReceiverWithResponse(ctx context.Context, id ReceiverId, reqEditors ...RequestEditorFn) (*GetReceiverResponse, error) {
-	rsp, err := c.GetReceiver(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetInvoiceStatusCountsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetReceiverResponse(rsp)
-}
 
-// UpdateReceiverWithBodyWithResponse request with arbitrary body returning *UpdateReceiverResponse
-func (c *ClientWithResponses) UpdateReceiverWithBodyWithResponse(ctx context.Context, id ReceiverId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateReceiverResponse, error) {
-	rsp, err := c.UpdateReceiverWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InvoiceStatusCounts
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
 	}
-	return ParseUpdateReceiverResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdateReceiverWithResponse(ctx context.Context, id ReceiverId, body UpdateReceiverJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateReceiverResponse, error) {
-	rsp, err := c.UpdateReceiver(ctx, id, body, reqEditors...)
+// ParseDeleteInvoiceResponse parses an HTTP response from a DeleteInvoiceWithResponse call
+func ParseDeleteInvoiceResponse(rsp *http.Response) (*DeleteInvoiceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateReceiverResponse(rsp)
-}
 
-// ListTagsWithResponse request returning *ListTagsResponse
-func (c *ClientWithResponses) ListTagsWithResponse(ctx context.Context, params *ListTagsParams, reqEditors ...RequestEditorFn) (*ListTagsResponse, error) {
-	rsp, err := c.ListTags(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteInvoiceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseListTagsResponse(rsp)
-}
 
-// CreateTagWithBodyWithResponse request with arbitrary body returning *CreateTagResponse
-func (c *ClientWithResponses) CreateTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTagResponse, error) {
-	rsp, err := c.CreateTagWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseCreateTagResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateTagWithResponse(ctx context.Context, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTagResponse, error) {
-	rsp, err := c.CreateTag(ctx, body, reqEditors...)
+// ParseGetInvoiceResponse parses an HTTP response from a GetInvoiceWithResponse call
+func ParseGetInvoiceResponse(rsp *http.Response) (*GetInvoiceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateTagResponse(rsp)
-}
 
-// DeleteTagWithResponse request returning *DeleteTagResponse
-func (c *ClientWithResponses) DeleteTagWithResponse(ctx context.Context, id TagId, reqEditors ...RequestEditorFn) (*DeleteTagResponse, error) {
-	rsp, err := c.DeleteTag(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetInvoiceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteTagResponse(rsp)
-}
 
-// GetTagWithResponse request returning *GetTagResponse
-func (c *ClientWithResponses) GetTagWithResponse(ctx context.Context, id TagId, reqEditors ...RequestEditorFn) (*GetTagResponse, error) {
-	rsp, err := c.GetTag(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Invoice
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseGetTagResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateTagWithBodyWithResponse request with arbitrary body returning *UpdateTagResponse
-func (c *ClientWithResponses) UpdateTagWithBodyWithResponse(ctx context.Context, id TagId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error) {
-	rsp, err := c.UpdateTagWithBody(ctx, id, contentType, body, reqEditors...)
+// ParseUpdateInvoiceResponse parses an HTTP response from a UpdateInvoiceWithResponse call
+func ParseUpdateInvoiceResponse(rsp *http.Response) (*UpdateInvoiceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateTagResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateTagWithResponse(ctx context.Context, id TagId, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error) {
-	rsp, err := c.UpdateTag(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateInvoiceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateTagResponse(rsp)
-}
 
-// UploadFileWithBodyWithResponse request with arbitrary body returning *UploadFileResponse
-func (c *ClientWithResponses) UploadFileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadFileResponse, error) {
-	rsp, err := c.UploadFileWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Invoice
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	}
-	return ParseUploadFileResponse(rsp)
+
+	return response, nil
 }
 
-// ConfirmPresignedUploadWithBodyWithResponse request with arbitrary body returning *ConfirmPresignedUploadResponse
-func (c *ClientWithResponses) ConfirmPresignedUploadWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmPresignedUploadResponse, error) {
-	rsp, err := c.ConfirmPresignedUploadWithBody(ctx, contentType, body, reqEditors...)
+// ParseListInvoiceAttachmentsResponse parses an HTTP response from a ListInvoiceAttachmentsWithResponse call
+func ParseListInvoiceAttachmentsResponse(rsp *http.Response) (*ListInvoiceAttachmentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseConfirmPresignedUploadResponse(rsp)
-}
 
-func (c *ClientWithResponses) ConfirmPresignedUploadWithResponse(ctx context.Context, body ConfirmPresignedUploadJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmPresignedUploadResponse, error) {
-	rsp, err := c.ConfirmPresignedUpload(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListInvoiceAttachmentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseConfirmPresignedUploadResponse(rsp)
-}
 
-// UploadHtmlToPdfWithBodyWithResponse request with arbitrary body returning *UploadHtmlToPdfResponse
-func (c *ClientWithResponses) UploadHtmlToPdfWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadHtmlToPdfResponse, error) {
-	rsp, err := c.UploadHtmlToPdfWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InvoiceAttachmentListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseUploadHtmlToPdfResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UploadHtmlToPdfWithResponse(ctx context.Context, body UploadHtmlToPdfJSONRequestBody, reqEditors ...RequestEditorFn) (*UploadHtmlToPdfResponse, error) {
-	rsp, err := c.UploadHtmlToPdf(ctx, body, reqEditors...)
+// ParseAddInvoiceAttachmentResponse parses an HTTP response from a AddInvoiceAttachmentWithResponse call
+func ParseAddInvoiceAttachmentResponse(rsp *http.Response) (*AddInvoiceAttachmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUploadHtmlToPdfResponse(rsp)
-}
 
-// GetPresignedURLWithResponse request returning *GetPresignedURLResponse
-func (c *ClientWithResponses) GetPresignedURLWithResponse(ctx context.Context, params *GetPresignedURLParams, reqEditors ...RequestEditorFn) (*GetPresignedURLResponse, error) {
-	rsp, err := c.GetPresignedURL(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetPresignedURLResponse(rsp)
-}
+	response := &AddInvoiceAttachmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest InvoiceAttachment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-// HealthCheckWithResponse request returning *HealthCheckResponse
-func (c *ClientWithResponses) HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error) {
-	rsp, err := c.HealthCheck(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseHealthCheckResponse(rsp)
+
+	return response, nil
 }
 
-// ParseGetAnalyticsByCategoryResponse parses an HTTP response from a GetAnalyticsByCategoryWithResponse call
-func ParseGetAnalyticsByCategoryResponse(rsp *http.Response) (*GetAnalyticsByCategoryResponse, error) {
+// ParseRemoveInvoiceAttachmentResponse parses an HTTP response from a RemoveInvoiceAttachmentWithResponse call
+func ParseRemoveInvoiceAttachmentResponse(rsp *http.Response) (*RemoveInvoiceAttachmentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAnalyticsByCategoryResponse{
+	response := &RemoveInvoiceAttachmentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AnalyticsByGroup
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetAnalyticsByCompanyResponse parses an HTTP response from a GetAnalyticsByCompanyWithResponse call
-func ParseGetAnalyticsByCompanyResponse(rsp *http.Response) (*GetAnalyticsByCompanyResponse, error) {
+// ParseGetInvoiceAuditTrailResponse parses an HTTP response from a GetInvoiceAuditTrailWithResponse call
+func ParseGetInvoiceAuditTrailResponse(rsp *http.Response) (*GetInvoiceAuditTrailResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAnalyticsByCompanyResponse{
+	response := &GetInvoiceAuditTrailResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AnalyticsByGroup
+		var dest AuditTrailResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4868,31 +10651,45 @@ func ParseGetAnalyticsByCompanyResponse(rsp *http.Response) (*GetAnalyticsByComp
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetAnalyticsByReceiverResponse parses an HTTP response from a GetAnalyticsByReceiverWithResponse call
-func ParseGetAnalyticsByReceiverResponse(rsp *http.Response) (*GetAnalyticsByReceiverResponse, error) {
+// ParseCloneInvoiceResponse parses an HTTP response from a CloneInvoiceWithResponse call
+func ParseCloneInvoiceResponse(rsp *http.Response) (*CloneInvoiceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAnalyticsByReceiverResponse{
+	response := &CloneInvoiceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AnalyticsByGroup
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Invoice
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
@@ -4901,32 +10698,53 @@ func ParseGetAnalyticsByReceiverResponse(rsp *http.Response) (*GetAnalyticsByRec
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetAnalyticsByTagResponse parses an HTTP response from a GetAnalyticsByTagWithResponse call
-func ParseGetAnalyticsByTagResponse(rsp *http.Response) (*GetAnalyticsByTagResponse, error) {
+// ParsePreviewCurrencyConversionResponse parses an HTTP response from a PreviewCurrencyConversionWithResponse call
+func ParsePreviewCurrencyConversionResponse(rsp *http.Response) (*PreviewCurrencyConversionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAnalyticsByTagResponse{
+	response := &PreviewCurrencyConversionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AnalyticsByGroup
+		var dest ConversionPreview
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4934,32 +10752,46 @@ func ParseGetAnalyticsByTagResponse(rsp *http.Response) (*GetAnalyticsByTagRespo
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetAnalyticsSummaryResponse parses an HTTP response from a GetAnalyticsSummaryWithResponse call
-func ParseGetAnalyticsSummaryResponse(rsp *http.Response) (*GetAnalyticsSummaryResponse, error) {
+// ParseFinalizeInvoiceResponse parses an HTTP response from a FinalizeInvoiceWithResponse call
+func ParseFinalizeInvoiceResponse(rsp *http.Response) (*FinalizeInvoiceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAnalyticsSummaryResponse{
+	response := &FinalizeInvoiceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AnalyticsSummary
+		var dest Invoice
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4967,31 +10799,45 @@ func ParseGetAnalyticsSummaryResponse(rsp *http.Response) (*GetAnalyticsSummaryR
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseListCategoriesResponse parses an HTTP response from a ListCategoriesWithResponse call
-func ParseListCategoriesResponse(rsp *http.Response) (*ListCategoriesResponse, error) {
+// ParseAddInvoiceItemResponse parses an HTTP response from a AddInvoiceItemWithResponse call
+func ParseAddInvoiceItemResponse(rsp *http.Response) (*AddInvoiceItemResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListCategoriesResponse{
+	response := &AddInvoiceItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CategoryListResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest InvoiceItem
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
@@ -5005,22 +10851,22 @@ func ParseListCategoriesResponse(rsp *http.Response) (*ListCategoriesResponse, e
 	return response, nil
 }
 
-// ParseCreateCategoryResponse parses an HTTP response from a CreateCategoryWithResponse call
-func ParseCreateCategoryResponse(rsp *http.Response) (*CreateCategoryResponse, error) {
+// ParseAddInvoiceItemsResponse parses an HTTP response from a AddInvoiceItemsWithResponse call
+func ParseAddInvoiceItemsResponse(rsp *http.Response) (*AddInvoiceItemsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateCategoryResponse{
+	response := &AddInvoiceItemsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Category
+		var dest InvoiceItemListResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5045,20 +10891,34 @@ func ParseCreateCategoryResponse(rsp *http.Response) (*CreateCategoryResponse, e
 	return response, nil
 }
 
-// ParseDeleteCategoryResponse parses an HTTP response from a DeleteCategoryWithResponse call
-func ParseDeleteCategoryResponse(rsp *http.Response) (*DeleteCategoryResponse, error) {
+// ParseReorderInvoiceItemsResponse parses an HTTP response from a ReorderInvoiceItemsWithResponse call
+func ParseReorderInvoiceItemsResponse(rsp *http.Response) (*ReorderInvoiceItemsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteCategoryResponse{
+	response := &ReorderInvoiceItemsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Invoice
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5078,22 +10938,22 @@ func ParseDeleteCategoryResponse(rsp *http.Response) (*DeleteCategoryResponse, e
 	return response, nil
 }
 
-// ParseGetCategoryResponse parses an HTTP response from a GetCategoryWithResponse call
-func ParseGetCategoryResponse(rsp *http.Response) (*GetCategoryResponse, error) {
+// ParseRecalculateInvoiceTotalsResponse parses an HTTP response from a RecalculateInvoiceTotalsWithResponse call
+func ParseRecalculateInvoiceTotalsResponse(rsp *http.Response) (*RecalculateInvoiceTotalsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCategoryResponse{
+	response := &RecalculateInvoiceTotalsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Category
+		var dest TotalsRecalculation
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5118,22 +10978,22 @@ func ParseGetCategoryResponse(rsp *http.Response) (*GetCategoryResponse, error)
 	return response, nil
 }
 
-// ParseUpdateCategoryResponse parses an HTTP response from a UpdateCategoryWithResponse call
-func ParseUpdateCategoryResponse(rsp *http.Response) (*UpdateCategoryResponse, error) {
+// ParseFindSimilarInvoicesResponse parses an HTTP response from a FindSimilarInvoicesWithResponse call
+func ParseFindSimilarInvoicesResponse(rsp *http.Response) (*FindSimilarInvoicesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateCategoryResponse{
+	response := &FindSimilarInvoicesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Category
+		var dest SimilarInvoicesResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5153,32 +11013,46 @@ func ParseUpdateCategoryResponse(rsp *http.Response) (*UpdateCategoryResponse, e
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseListCompaniesResponse parses an HTTP response from a ListCompaniesWithResponse call
-func ParseListCompaniesResponse(rsp *http.Response) (*ListCompaniesResponse, error) {
+// ParseUpdateInvoiceStatusResponse parses an HTTP response from a UpdateInvoiceStatusWithResponse call
+func ParseUpdateInvoiceStatusResponse(rsp *http.Response) (*UpdateInvoiceStatusResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListCompaniesResponse{
+	response := &UpdateInvoiceStatusResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CompanyListResponse
+		var dest Invoice
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5191,26 +11065,26 @@ func ParseListCompaniesResponse(rsp *http.Response) (*ListCompaniesResponse, err
 	return response, nil
 }
 
-// ParseCreateCompanyResponse parses an HTTP response from a CreateCompanyWithResponse call
-func ParseCreateCompanyResponse(rsp *http.Response) (*CreateCompanyResponse, error) {
+// ParseGetInvoiceStatusHistoryResponse parses an HTTP response from a GetInvoiceStatusHistoryWithResponse call
+func ParseGetInvoiceStatusHistoryResponse(rsp *http.Response) (*GetInvoiceStatusHistoryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateCompanyResponse{
+	response := &GetInvoiceStatusHistoryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Company
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuditTrailResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -5226,25 +11100,46 @@ func ParseCreateCompanyResponse(rsp *http.Response) (*CreateCompanyResponse, err
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDeleteCompanyResponse parses an HTTP response from a DeleteCompanyWithResponse call
-func ParseDeleteCompanyResponse(rsp *http.Response) (*DeleteCompanyResponse, error) {
+// ParseAddTagToInvoiceResponse parses an HTTP response from a AddTagToInvoiceWithResponse call
+func ParseAddTagToInvoiceResponse(rsp *http.Response) (*AddTagToInvoiceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteCompanyResponse{
+	response := &AddTagToInvoiceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Invoice
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5264,22 +11159,22 @@ func ParseDeleteCompanyResponse(rsp *http.Response) (*DeleteCompanyResponse, err
 	return response, nil
 }
 
-// ParseGetCompanyResponse parses an HTTP response from a GetCompanyWithResponse call
-func ParseGetCompanyResponse(rsp *http.Response) (*GetCompanyResponse, error) {
+// ParseRemoveTagFromInvoiceResponse parses an HTTP response from a RemoveTagFromInvoiceWithResponse call
+func ParseRemoveTagFromInvoiceResponse(rsp *http.Response) (*RemoveTagFromInvoiceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCompanyResponse{
+	response := &RemoveTagFromInvoiceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Company
+		var dest Invoice
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5304,34 +11199,20 @@ func ParseGetCompanyResponse(rsp *http.Response) (*GetCompanyResponse, error) {
 	return response, nil
 }
 
-// ParseUpdateCompanyResponse parses an HTTP response from a UpdateCompanyWithResponse call
-func ParseUpdateCompanyResponse(rsp *http.Response) (*UpdateCompanyResponse, error) {
+// ParseDeleteInvoiceItemResponse parses an HTTP response from a DeleteInvoiceItemWithResponse call
+func ParseDeleteInvoiceItemResponse(rsp *http.Response) (*DeleteInvoiceItemResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateCompanyResponse{
+	response := &DeleteInvoiceItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Company
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5344,69 +11225,62 @@ func ParseUpdateCompanyResponse(rsp *http.Response) (*UpdateCompanyResponse, err
 	return response, nil
 }
 
-// ParseGetFileDownloadURLResponse parses an HTTP response from a GetFileDownloadURLWithResponse call
-func ParseGetFileDownloadURLResponse(rsp *http.Response) (*GetFileDownloadURLResponse, error) {
+// ParseUpdateInvoiceItemResponse parses an HTTP response from a UpdateInvoiceItemWithResponse call
+func ParseUpdateInvoiceItemResponse(rsp *http.Response) (*UpdateInvoiceItemResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetFileDownloadURLResponse{
+	response := &UpdateInvoiceItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FileDownloadURLResponse
+		var dest InvoiceItem
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON401 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseListInvoicesResponse parses an HTTP response from a ListInvoicesWithResponse call
-func ParseListInvoicesResponse(rsp *http.Response) (*ListInvoicesResponse, error) {
+// ParseListOrganizationsResponse parses an HTTP response from a ListOrganizationsWithResponse call
+func ParseListOrganizationsResponse(rsp *http.Response) (*ListOrganizationsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListInvoicesResponse{
+	response := &ListOrganizationsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest InvoiceListResponse
+		var dest []Organization
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5424,22 +11298,22 @@ func ParseListInvoicesResponse(rsp *http.Response) (*ListInvoicesResponse, error
 	return response, nil
 }
 
-// ParseCreateInvoiceResponse parses an HTTP response from a CreateInvoiceWithResponse call
-func ParseCreateInvoiceResponse(rsp *http.Response) (*CreateInvoiceResponse, error) {
+// ParseCreateOrganizationResponse parses an HTTP response from a CreateOrganizationWithResponse call
+func ParseCreateOrganizationResponse(rsp *http.Response) (*CreateOrganizationResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateInvoiceResponse{
+	response := &CreateOrganizationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Invoice
+		var dest Organization
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5464,20 +11338,27 @@ func ParseCreateInvoiceResponse(rsp *http.Response) (*CreateInvoiceResponse, err
 	return response, nil
 }
 
-// ParseDeleteInvoiceResponse parses an HTTP response from a DeleteInvoiceWithResponse call
-func ParseDeleteInvoiceResponse(rsp *http.Response) (*DeleteInvoiceResponse, error) {
+// ParseListOrganizationMembersResponse parses an HTTP response from a ListOrganizationMembersWithResponse call
+func ParseListOrganizationMembersResponse(rsp *http.Response) (*ListOrganizationMembersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteInvoiceResponse{
+	response := &ListOrganizationMembersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []OrganizationMember
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5497,26 +11378,26 @@ func ParseDeleteInvoiceResponse(rsp *http.Response) (*DeleteInvoiceResponse, err
 	return response, nil
 }
 
-// ParseGetInvoiceResponse parses an HTTP response from a GetInvoiceWithResponse call
-func ParseGetInvoiceResponse(rsp *http.Response) (*GetInvoiceResponse, error) {
+// ParseRemoveOrganizationMemberResponse parses an HTTP response from a RemoveOrganizationMemberWithResponse call
+func ParseRemoveOrganizationMemberResponse(rsp *http.Response) (*RemoveOrganizationMemberResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetInvoiceResponse{
+	response := &RemoveOrganizationMemberResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Invoice
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
@@ -5525,34 +11406,27 @@ func ParseGetInvoiceResponse(rsp *http.Response) (*GetInvoiceResponse, error) {
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseUpdateInvoiceResponse parses an HTTP response from a UpdateInvoiceWithResponse call
-func ParseUpdateInvoiceResponse(rsp *http.Response) (*UpdateInvoiceResponse, error) {
+// ParseSetOrganizationMemberResponse parses an HTTP response from a SetOrganizationMemberWithResponse call
+func ParseSetOrganizationMemberResponse(rsp *http.Response) (*SetOrganizationMemberResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateInvoiceResponse{
+	response := &SetOrganizationMemberResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Invoice
+		var dest OrganizationMember
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5577,33 +11451,26 @@ func ParseUpdateInvoiceResponse(rsp *http.Response) (*UpdateInvoiceResponse, err
 	return response, nil
 }
 
-// ParseAddInvoiceItemResponse parses an HTTP response from a AddInvoiceItemWithResponse call
-func ParseAddInvoiceItemResponse(rsp *http.Response) (*AddInvoiceItemResponse, error) {
+// ParseListPaymentMethodsResponse parses an HTTP response from a ListPaymentMethodsWithResponse call
+func ParseListPaymentMethodsResponse(rsp *http.Response) (*ListPaymentMethodsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AddInvoiceItemResponse{
+	response := &ListPaymentMethodsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest InvoiceItem
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaymentMethodListResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
@@ -5617,34 +11484,27 @@ func ParseAddInvoiceItemResponse(rsp *http.Response) (*AddInvoiceItemResponse, e
 	return response, nil
 }
 
-// ParseUpdateInvoiceStatusResponse parses an HTTP response from a UpdateInvoiceStatusWithResponse call
-func ParseUpdateInvoiceStatusResponse(rsp *http.Response) (*UpdateInvoiceStatusResponse, error) {
+// ParseListReceiversResponse parses an HTTP response from a ListReceiversWithResponse call
+func ParseListReceiversResponse(rsp *http.Response) (*ListReceiversResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateInvoiceStatusResponse{
+	response := &ListReceiversResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Invoice
+		var dest ReceiverListResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5657,26 +11517,26 @@ func ParseUpdateInvoiceStatusResponse(rsp *http.Response) (*UpdateInvoiceStatusR
 	return response, nil
 }
 
-// ParseAddTagToInvoiceResponse parses an HTTP response from a AddTagToInvoiceWithResponse call
-func ParseAddTagToInvoiceResponse(rsp *http.Response) (*AddTagToInvoiceResponse, error) {
+// ParseCreateReceiverResponse parses an HTTP response from a CreateReceiverWithResponse call
+func ParseCreateReceiverResponse(rsp *http.Response) (*CreateReceiverResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AddTagToInvoiceResponse{
+	response := &CreateReceiverResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Invoice
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Receiver
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -5692,39 +11552,39 @@ func ParseAddTagToInvoiceResponse(rsp *http.Response) (*AddTagToInvoiceResponse,
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseRemoveTagFromInvoiceResponse parses an HTTP response from a RemoveTagFromInvoiceWithResponse call
-func ParseRemoveTagFromInvoiceResponse(rsp *http.Response) (*RemoveTagFromInvoiceResponse, error) {
+// ParseMergeReceiversResponse parses an HTTP response from a MergeReceiversWithResponse call
+func ParseMergeReceiversResponse(rsp *http.Response) (*MergeReceiversResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RemoveTagFromInvoiceResponse{
+	response := &MergeReceiversResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Invoice
+		var dest MergeReceiversResult
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5744,15 +11604,15 @@ func ParseRemoveTagFromInvoiceResponse(rsp *http.Response) (*RemoveTagFromInvoic
 	return response, nil
 }
 
-// ParseDeleteInvoiceItemResponse parses an HTTP response from a DeleteInvoiceItemWithResponse call
-func ParseDeleteInvoiceItemResponse(rsp *http.Response) (*DeleteInvoiceItemResponse, error) {
+// ParseDeleteReceiverResponse parses an HTTP response from a DeleteReceiverWithResponse call
+func ParseDeleteReceiverResponse(rsp *http.Response) (*DeleteReceiverResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteInvoiceItemResponse{
+	response := &DeleteReceiverResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5765,67 +11625,41 @@ func ParseDeleteInvoiceItemResponse(rsp *http.Response) (*DeleteInvoiceItemRespo
 		}
 		response.JSON401 = &dest
 
-	}
-
-	return response, nil
-}
-
-// ParseUpdateInvoiceItemResponse parses an HTTP response from a UpdateInvoiceItemWithResponse call
-func ParseUpdateInvoiceItemResponse(rsp *http.Response) (*UpdateInvoiceItemResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &UpdateInvoiceItemResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest InvoiceItem
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest InUse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON409 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseListReceiversResponse parses an HTTP response from a ListReceiversWithResponse call
-func ParseListReceiversResponse(rsp *http.Response) (*ListReceiversResponse, error) {
+// ParseGetReceiverResponse parses an HTTP response from a GetReceiverWithResponse call
+func ParseGetReceiverResponse(rsp *http.Response) (*GetReceiverResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListReceiversResponse{
+	response := &GetReceiverResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ReceiverListResponse
+		var dest Receiver
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5838,31 +11672,38 @@ func ParseListReceiversResponse(rsp *http.Response) (*ListReceiversResponse, err
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseCreateReceiverResponse parses an HTTP response from a CreateReceiverWithResponse call
-func ParseCreateReceiverResponse(rsp *http.Response) (*CreateReceiverResponse, error) {
+// ParseUpdateReceiverResponse parses an HTTP response from a UpdateReceiverWithResponse call
+func ParseUpdateReceiverResponse(rsp *http.Response) (*UpdateReceiverResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateReceiverResponse{
+	response := &UpdateReceiverResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest Receiver
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -5883,22 +11724,22 @@ func ParseCreateReceiverResponse(rsp *http.Response) (*CreateReceiverResponse, e
 	return response, nil
 }
 
-// ParseMergeReceiversResponse parses an HTTP response from a MergeReceiversWithResponse call
-func ParseMergeReceiversResponse(rsp *http.Response) (*MergeReceiversResponse, error) {
+// ParseGetReceiverStatementResponse parses an HTTP response from a GetReceiverStatementWithResponse call
+func ParseGetReceiverStatementResponse(rsp *http.Response) (*GetReceiverStatementResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &MergeReceiversResponse{
+	response := &GetReceiverStatementResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MergeReceiversResult
+		var dest VendorStatement
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5925,25 +11766,49 @@ func ParseMergeReceiversResponse(rsp *http.Response) (*MergeReceiversResponse, e
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (application/pdf) unsupported
+
 	}
 
 	return response, nil
 }
 
-// ParseDeleteReceiverResponse parses an HTTP response from a DeleteReceiverWithResponse call
-func ParseDeleteReceiverResponse(rsp *http.Response) (*DeleteReceiverResponse, error) {
+// ParseGetReceiverStatisticsResponse parses an HTTP response from a GetReceiverStatisticsWithResponse call
+func ParseGetReceiverStatisticsResponse(rsp *http.Response) (*GetReceiverStatisticsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteReceiverResponse{
+	response := &GetReceiverStatisticsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReceiverDetail
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5958,27 +11823,34 @@ func ParseDeleteReceiverResponse(rsp *http.Response) (*DeleteReceiverResponse, e
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetReceiverResponse parses an HTTP response from a GetReceiverWithResponse call
-func ParseGetReceiverResponse(rsp *http.Response) (*GetReceiverResponse, error) {
+// ParseGetSettingsResponse parses an HTTP response from a GetSettingsWithResponse call
+func ParseGetSettingsResponse(rsp *http.Response) (*GetSettingsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetReceiverResponse{
+	response := &GetSettingsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Receiver
+		var dest UserSettings
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5991,34 +11863,27 @@ func ParseGetReceiverResponse(rsp *http.Response) (*GetReceiverResponse, error)
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseUpdateReceiverResponse parses an HTTP response from a UpdateReceiverWithResponse call
-func ParseUpdateReceiverResponse(rsp *http.Response) (*UpdateReceiverResponse, error) {
+// ParseUpdateSettingsResponse parses an HTTP response from a UpdateSettingsWithResponse call
+func ParseUpdateSettingsResponse(rsp *http.Response) (*UpdateSettingsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateReceiverResponse{
+	response := &UpdateSettingsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Receiver
+		var dest UserSettings
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -6264,6 +12129,20 @@ func ParseUploadFileResponse(rsp *http.Response) (*UploadFileResponse, error) {
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
 	}
 
 	return response, nil
@@ -6411,14 +12290,19 @@ func ParseHealthCheckResponse(rsp *http.Response) (*HealthCheckResponse, error)
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Status *string `json:"status,omitempty"`
-		}
+		var dest HealthStatus
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest HealthStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
 	}
 
 	return response, nil
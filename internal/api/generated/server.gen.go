@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"mime/multipart"
 	"net/url"
 
@@ -31,6 +32,21 @@ type ServerInterface interface {
 	// Get invoice summary analytics
 	// (GET /api/analytics/summary)
 	GetAnalyticsSummary(c *fiber.Ctx, params GetAnalyticsSummaryParams) error
+	// Get monthly invoice trend
+	// (GET /api/analytics/trend)
+	GetAnalyticsTrend(c *fiber.Ctx, params GetAnalyticsTrendParams) error
+	// List budgets
+	// (GET /api/budgets)
+	ListBudgets(c *fiber.Ctx) error
+	// Create a budget
+	// (POST /api/budgets)
+	CreateBudget(c *fiber.Ctx) error
+	// Get budget status
+	// (GET /api/budgets/status)
+	GetBudgetStatus(c *fiber.Ctx, params GetBudgetStatusParams) error
+	// Delete a budget
+	// (DELETE /api/budgets/{id})
+	DeleteBudget(c *fiber.Ctx, id int) error
 	// List categories
 	// (GET /api/categories)
 	ListCategories(c *fiber.Ctx, params ListCategoriesParams) error
@@ -39,7 +55,7 @@ type ServerInterface interface {
 	CreateCategory(c *fiber.Ctx) error
 	// Delete category
 	// (DELETE /api/categories/{id})
-	DeleteCategory(c *fiber.Ctx, id CategoryId) error
+	DeleteCategory(c *fiber.Ctx, id CategoryId, params DeleteCategoryParams) error
 	// Get category
 	// (GET /api/categories/{id})
 	GetCategory(c *fiber.Ctx, id CategoryId) error
@@ -54,37 +70,103 @@ type ServerInterface interface {
 	CreateCompany(c *fiber.Ctx) error
 	// Delete company
 	// (DELETE /api/companies/{id})
-	DeleteCompany(c *fiber.Ctx, id CompanyId) error
+	DeleteCompany(c *fiber.Ctx, id CompanyId, params DeleteCompanyParams) error
 	// Get company
 	// (GET /api/companies/{id})
 	GetCompany(c *fiber.Ctx, id CompanyId) error
 	// Update company
 	// (PUT /api/companies/{id})
 	UpdateCompany(c *fiber.Ctx, id CompanyId) error
+	// Get company breakdown
+	// (GET /api/companies/{id}/breakdown)
+	GetCompanyBreakdown(c *fiber.Ctx, id CompanyId, params GetCompanyBreakdownParams) error
+	// Get dashboard data
+	// (GET /api/dashboard)
+	GetDashboard(c *fiber.Ctx, params GetDashboardParams) error
+	// Export account data
+	// (GET /api/export)
+	ExportData(c *fiber.Ctx) error
+	// Request an export bundle
+	// (POST /api/exports)
+	RequestExport(c *fiber.Ctx) error
+	// Get an export job
+	// (GET /api/exports/{id})
+	GetExportJob(c *fiber.Ctx, id int) error
 	// Get file download URL
 	// (GET /api/files/{key}/download)
 	GetFileDownloadURL(c *fiber.Ctx, key string) error
+	// Import account data
+	// (POST /api/import)
+	ImportData(c *fiber.Ctx) error
 	// List invoices
 	// (GET /api/invoices)
 	ListInvoices(c *fiber.Ctx, params ListInvoicesParams) error
 	// Create invoice
 	// (POST /api/invoices)
 	CreateInvoice(c *fiber.Ctx) error
+	// Get invoice facets
+	// (GET /api/invoices/facets)
+	GetInvoiceFacets(c *fiber.Ctx) error
+	// Import invoices from a CSV file
+	// (POST /api/invoices/import)
+	ImportInvoices(c *fiber.Ctx, params ImportInvoicesParams) error
+	// Look up invoices by number or original link
+	// (GET /api/invoices/lookup)
+	LookupInvoices(c *fiber.Ctx, params LookupInvoicesParams) error
+	// Count invoices by status
+	// (GET /api/invoices/status-counts)
+	GetInvoiceStatusCounts(c *fiber.Ctx) error
 	// Delete invoice
 	// (DELETE /api/invoices/{id})
 	DeleteInvoice(c *fiber.Ctx, id InvoiceId) error
 	// Get invoice
 	// (GET /api/invoices/{id})
-	GetInvoice(c *fiber.Ctx, id InvoiceId) error
+	GetInvoice(c *fiber.Ctx, id InvoiceId, params GetInvoiceParams) error
 	// Update invoice
 	// (PUT /api/invoices/{id})
 	UpdateInvoice(c *fiber.Ctx, id InvoiceId) error
+	// List invoice attachments
+	// (GET /api/invoices/{id}/attachments)
+	ListInvoiceAttachments(c *fiber.Ctx, id InvoiceId) error
+	// Add invoice attachment
+	// (POST /api/invoices/{id}/attachments)
+	AddInvoiceAttachment(c *fiber.Ctx, id InvoiceId) error
+	// Remove invoice attachment
+	// (DELETE /api/invoices/{id}/attachments/{attachmentId})
+	RemoveInvoiceAttachment(c *fiber.Ctx, id InvoiceId, attachmentId int) error
+	// Get invoice audit trail
+	// (GET /api/invoices/{id}/audit)
+	GetInvoiceAuditTrail(c *fiber.Ctx, id InvoiceId, params GetInvoiceAuditTrailParams) error
+	// Clone invoice
+	// (POST /api/invoices/{id}/clone)
+	CloneInvoice(c *fiber.Ctx, id InvoiceId) error
+	// Preview currency change
+	// (POST /api/invoices/{id}/convert/preview)
+	PreviewCurrencyConversion(c *fiber.Ctx, id InvoiceId) error
+	// Finalize a draft invoice
+	// (POST /api/invoices/{id}/finalize)
+	FinalizeInvoice(c *fiber.Ctx, id InvoiceId) error
 	// Add invoice item
 	// (POST /api/invoices/{id}/items)
 	AddInvoiceItem(c *fiber.Ctx, id InvoiceId) error
+	// Add invoice items in bulk
+	// (POST /api/invoices/{id}/items/batch)
+	AddInvoiceItems(c *fiber.Ctx, id InvoiceId) error
+	// Reorder invoice items
+	// (PUT /api/invoices/{id}/items/order)
+	ReorderInvoiceItems(c *fiber.Ctx, id InvoiceId) error
+	// Recalculate invoice totals
+	// (POST /api/invoices/{id}/recalculate)
+	RecalculateInvoiceTotals(c *fiber.Ctx, id InvoiceId) error
+	// Find similar invoices
+	// (GET /api/invoices/{id}/similar)
+	FindSimilarInvoices(c *fiber.Ctx, id InvoiceId, params FindSimilarInvoicesParams) error
 	// Update invoice status
 	// (PATCH /api/invoices/{id}/status)
 	UpdateInvoiceStatus(c *fiber.Ctx, id InvoiceId) error
+	// Get invoice status history
+	// (GET /api/invoices/{id}/status-history)
+	GetInvoiceStatusHistory(c *fiber.Ctx, id InvoiceId, params GetInvoiceStatusHistoryParams) error
 	// Add tag to invoice
 	// (POST /api/invoices/{id}/tags)
 	AddTagToInvoice(c *fiber.Ctx, id InvoiceId) error
@@ -97,6 +179,24 @@ type ServerInterface interface {
 	// Update invoice item
 	// (PUT /api/invoices/{invoice_id}/items/{item_id})
 	UpdateInvoiceItem(c *fiber.Ctx, invoiceId int, itemId int) error
+	// List organizations
+	// (GET /api/organizations)
+	ListOrganizations(c *fiber.Ctx) error
+	// Create organization
+	// (POST /api/organizations)
+	CreateOrganization(c *fiber.Ctx) error
+	// List organization members
+	// (GET /api/organizations/{id}/members)
+	ListOrganizationMembers(c *fiber.Ctx, id OrganizationId) error
+	// Remove organization member
+	// (DELETE /api/organizations/{id}/members/{user_id})
+	RemoveOrganizationMember(c *fiber.Ctx, id OrganizationId, userId MemberUserId) error
+	// Add or update organization member
+	// (PUT /api/organizations/{id}/members/{user_id})
+	SetOrganizationMember(c *fiber.Ctx, id OrganizationId, userId MemberUserId) error
+	// List payment methods
+	// (GET /api/payment-methods)
+	ListPaymentMethods(c *fiber.Ctx) error
 	// List receivers
 	// (GET /api/receivers)
 	ListReceivers(c *fiber.Ctx, params ListReceiversParams) error
@@ -108,13 +208,25 @@ type ServerInterface interface {
 	MergeReceivers(c *fiber.Ctx) error
 	// Delete receiver
 	// (DELETE /api/receivers/{id})
-	DeleteReceiver(c *fiber.Ctx, id ReceiverId) error
+	DeleteReceiver(c *fiber.Ctx, id ReceiverId, params DeleteReceiverParams) error
 	// Get receiver
 	// (GET /api/receivers/{id})
 	GetReceiver(c *fiber.Ctx, id ReceiverId) error
 	// Update receiver
 	// (PUT /api/receivers/{id})
 	UpdateReceiver(c *fiber.Ctx, id ReceiverId) error
+	// Get receiver statement
+	// (GET /api/receivers/{id}/statement)
+	GetReceiverStatement(c *fiber.Ctx, id ReceiverId, params GetReceiverStatementParams) error
+	// Get receiver statistics
+	// (GET /api/receivers/{id}/statistics)
+	GetReceiverStatistics(c *fiber.Ctx, id ReceiverId, params GetReceiverStatisticsParams) error
+	// Get user settings
+	// (GET /api/settings)
+	GetSettings(c *fiber.Ctx) error
+	// Update user settings
+	// (PUT /api/settings)
+	UpdateSettings(c *fiber.Ctx) error
 	// List tags
 	// (GET /api/tags)
 	ListTags(c *fiber.Ctx, params ListTagsParams) error
@@ -161,7 +273,7 @@ func (siw *ServerInterfaceWrapper) GetAnalyticsByCategory(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetAnalyticsByCategoryParams
@@ -179,6 +291,13 @@ func (siw *ServerInterfaceWrapper) GetAnalyticsByCategory(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter period: %w", err).Error())
 	}
 
+	// ------------- Optional query parameter "no_cache" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "no_cache", query, &params.NoCache)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter no_cache: %w", err).Error())
+	}
+
 	return siw.Handler.GetAnalyticsByCategory(c, params)
 }
 
@@ -189,7 +308,7 @@ func (siw *ServerInterfaceWrapper) GetAnalyticsByCompany(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetAnalyticsByCompanyParams
@@ -207,6 +326,13 @@ func (siw *ServerInterfaceWrapper) GetAnalyticsByCompany(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter period: %w", err).Error())
 	}
 
+	// ------------- Optional query parameter "no_cache" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "no_cache", query, &params.NoCache)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter no_cache: %w", err).Error())
+	}
+
 	return siw.Handler.GetAnalyticsByCompany(c, params)
 }
 
@@ -217,7 +343,7 @@ func (siw *ServerInterfaceWrapper) GetAnalyticsByReceiver(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetAnalyticsByReceiverParams
@@ -235,6 +361,13 @@ func (siw *ServerInterfaceWrapper) GetAnalyticsByReceiver(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter period: %w", err).Error())
 	}
 
+	// ------------- Optional query parameter "no_cache" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "no_cache", query, &params.NoCache)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter no_cache: %w", err).Error())
+	}
+
 	return siw.Handler.GetAnalyticsByReceiver(c, params)
 }
 
@@ -245,7 +378,7 @@ func (siw *ServerInterfaceWrapper) GetAnalyticsByTag(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetAnalyticsByTagParams
@@ -263,6 +396,20 @@ func (siw *ServerInterfaceWrapper) GetAnalyticsByTag(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter period: %w", err).Error())
 	}
 
+	// ------------- Optional query parameter "include_children" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_children", query, &params.IncludeChildren)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter include_children: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "no_cache" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "no_cache", query, &params.NoCache)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter no_cache: %w", err).Error())
+	}
+
 	return siw.Handler.GetAnalyticsByTag(c, params)
 }
 
@@ -273,7 +420,7 @@ func (siw *ServerInterfaceWrapper) GetAnalyticsSummary(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetAnalyticsSummaryParams
@@ -291,9 +438,119 @@ func (siw *ServerInterfaceWrapper) GetAnalyticsSummary(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter period: %w", err).Error())
 	}
 
+	// ------------- Optional query parameter "paid_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "paid_by", query, &params.PaidBy)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter paid_by: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "no_cache" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "no_cache", query, &params.NoCache)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter no_cache: %w", err).Error())
+	}
+
 	return siw.Handler.GetAnalyticsSummary(c, params)
 }
 
+// GetAnalyticsTrend operation middleware
+func (siw *ServerInterfaceWrapper) GetAnalyticsTrend(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAnalyticsTrendParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "months" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "months", query, &params.Months)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter months: %w", err).Error())
+	}
+
+	return siw.Handler.GetAnalyticsTrend(c, params)
+}
+
+// ListBudgets operation middleware
+func (siw *ServerInterfaceWrapper) ListBudgets(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+
+	return siw.Handler.ListBudgets(c)
+}
+
+// CreateBudget operation middleware
+func (siw *ServerInterfaceWrapper) CreateBudget(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+
+	return siw.Handler.CreateBudget(c)
+}
+
+// GetBudgetStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetBudgetStatus(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBudgetStatusParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "as_of" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "as_of", query, &params.AsOf)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter as_of: %w", err).Error())
+	}
+
+	return siw.Handler.GetBudgetStatus(c, params)
+}
+
+// DeleteBudget operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBudget(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+
+	return siw.Handler.DeleteBudget(c, id)
+}
+
 // ListCategories operation middleware
 func (siw *ServerInterfaceWrapper) ListCategories(c *fiber.Ctx) error {
 
@@ -301,7 +558,7 @@ func (siw *ServerInterfaceWrapper) ListCategories(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params ListCategoriesParams
@@ -341,7 +598,7 @@ func (siw *ServerInterfaceWrapper) CreateCategory(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
 	return siw.Handler.CreateCategory(c)
 }
@@ -361,9 +618,25 @@ func (siw *ServerInterfaceWrapper) DeleteCategory(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteCategoryParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "force" -------------
 
-	return siw.Handler.DeleteCategory(c, id)
+	err = runtime.BindQueryParameter("form", true, false, "force", query, &params.Force)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter force: %w", err).Error())
+	}
+
+	return siw.Handler.DeleteCategory(c, id, params)
 }
 
 // GetCategory operation middleware
@@ -381,7 +654,7 @@ func (siw *ServerInterfaceWrapper) GetCategory(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
 	return siw.Handler.GetCategory(c, id)
 }
@@ -401,7 +674,7 @@ func (siw *ServerInterfaceWrapper) UpdateCategory(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
 	return siw.Handler.UpdateCategory(c, id)
 }
@@ -413,7 +686,7 @@ func (siw *ServerInterfaceWrapper) ListCompanies(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params ListCompaniesParams
@@ -453,7 +726,7 @@ func (siw *ServerInterfaceWrapper) CreateCompany(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
 	return siw.Handler.CreateCompany(c)
 }
@@ -473,9 +746,25 @@ func (siw *ServerInterfaceWrapper) DeleteCompany(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteCompanyParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "force" -------------
 
-	return siw.Handler.DeleteCompany(c, id)
+	err = runtime.BindQueryParameter("form", true, false, "force", query, &params.Force)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter force: %w", err).Error())
+	}
+
+	return siw.Handler.DeleteCompany(c, id, params)
 }
 
 // GetCompany operation middleware
@@ -493,7 +782,7 @@ func (siw *ServerInterfaceWrapper) GetCompany(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
 	return siw.Handler.GetCompany(c, id)
 }
@@ -513,11 +802,122 @@ func (siw *ServerInterfaceWrapper) UpdateCompany(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
 	return siw.Handler.UpdateCompany(c, id)
 }
 
+// GetCompanyBreakdown operation middleware
+func (siw *ServerInterfaceWrapper) GetCompanyBreakdown(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id CompanyId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCompanyBreakdownParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "period" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "period", query, &params.Period)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter period: %w", err).Error())
+	}
+
+	return siw.Handler.GetCompanyBreakdown(c, id, params)
+}
+
+// GetDashboard operation middleware
+func (siw *ServerInterfaceWrapper) GetDashboard(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDashboardParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "period" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "period", query, &params.Period)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter period: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "no_cache" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "no_cache", query, &params.NoCache)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter no_cache: %w", err).Error())
+	}
+
+	return siw.Handler.GetDashboard(c, params)
+}
+
+// ExportData operation middleware
+func (siw *ServerInterfaceWrapper) ExportData(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+
+	return siw.Handler.ExportData(c)
+}
+
+// RequestExport operation middleware
+func (siw *ServerInterfaceWrapper) RequestExport(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+
+	return siw.Handler.RequestExport(c)
+}
+
+// GetExportJob operation middleware
+func (siw *ServerInterfaceWrapper) GetExportJob(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+
+	return siw.Handler.GetExportJob(c, id)
+}
+
 // GetFileDownloadURL operation middleware
 func (siw *ServerInterfaceWrapper) GetFileDownloadURL(c *fiber.Ctx) error {
 
@@ -533,11 +933,21 @@ func (siw *ServerInterfaceWrapper) GetFileDownloadURL(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
 	return siw.Handler.GetFileDownloadURL(c, key)
 }
 
+// ImportData operation middleware
+func (siw *ServerInterfaceWrapper) ImportData(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+
+	return siw.Handler.ImportData(c)
+}
+
 // ListInvoices operation middleware
 func (siw *ServerInterfaceWrapper) ListInvoices(c *fiber.Ctx) error {
 
@@ -545,7 +955,7 @@ func (siw *ServerInterfaceWrapper) ListInvoices(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params ListInvoicesParams
@@ -563,6 +973,13 @@ func (siw *ServerInterfaceWrapper) ListInvoices(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter keyword: %w", err).Error())
 	}
 
+	// ------------- Optional query parameter "exclude_keyword" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "exclude_keyword", query, &params.ExcludeKeyword)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter exclude_keyword: %w", err).Error())
+	}
+
 	// ------------- Optional query parameter "category_id" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "category_id", query, &params.CategoryId)
@@ -591,6 +1008,20 @@ func (siw *ServerInterfaceWrapper) ListInvoices(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter tag_ids: %w", err).Error())
 	}
 
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", query, &params.Tags)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter tags: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "tag_match" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag_match", query, &params.TagMatch)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter tag_match: %w", err).Error())
+	}
+
 	// ------------- Optional query parameter "status" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "status", query, &params.Status)
@@ -598,6 +1029,27 @@ func (siw *ServerInterfaceWrapper) ListInvoices(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter status: %w", err).Error())
 	}
 
+	// ------------- Optional query parameter "payment_method" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "payment_method", query, &params.PaymentMethod)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter payment_method: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "include_drafts" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_drafts", query, &params.IncludeDrafts)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter include_drafts: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "organization_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "organization_id", query, &params.OrganizationId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter organization_id: %w", err).Error())
+	}
+
 	// ------------- Optional query parameter "sort_by" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "sort_by", query, &params.SortBy)
@@ -626,234 +1078,197 @@ func (siw *ServerInterfaceWrapper) ListInvoices(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter offset: %w", err).Error())
 	}
 
-	return siw.Handler.ListInvoices(c, params)
-}
-
-// CreateInvoice operation middleware
-func (siw *ServerInterfaceWrapper) CreateInvoice(c *fiber.Ctx) error {
+	// ------------- Optional query parameter "cursor" -------------
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "cursor", query, &params.Cursor)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter cursor: %w", err).Error())
+	}
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	// ------------- Optional query parameter "cursor_direction" -------------
 
-	return siw.Handler.CreateInvoice(c)
-}
+	err = runtime.BindQueryParameter("form", true, false, "cursor_direction", query, &params.CursorDirection)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter cursor_direction: %w", err).Error())
+	}
 
-// DeleteInvoice operation middleware
-func (siw *ServerInterfaceWrapper) DeleteInvoice(c *fiber.Ctx) error {
+	// ------------- Optional query parameter "min_amount" -------------
 
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "min_amount", query, &params.MinAmount)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter min_amount: %w", err).Error())
+	}
 
-	// ------------- Path parameter "id" -------------
-	var id InvoiceId
+	// ------------- Optional query parameter "max_amount" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "max_amount", query, &params.MaxAmount)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter max_amount: %w", err).Error())
 	}
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "amount_field" -------------
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
-
-	return siw.Handler.DeleteInvoice(c, id)
-}
-
-// GetInvoice operation middleware
-func (siw *ServerInterfaceWrapper) GetInvoice(c *fiber.Ctx) error {
-
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "amount_field", query, &params.AmountField)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter amount_field: %w", err).Error())
+	}
 
-	// ------------- Path parameter "id" -------------
-	var id InvoiceId
+	// ------------- Optional query parameter "expand" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "expand", query, &params.Expand)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter expand: %w", err).Error())
 	}
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "locale" -------------
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	err = runtime.BindQueryParameter("form", true, false, "locale", query, &params.Locale)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter locale: %w", err).Error())
+	}
 
-	return siw.Handler.GetInvoice(c, id)
+	return siw.Handler.ListInvoices(c, params)
 }
 
-// UpdateInvoice operation middleware
-func (siw *ServerInterfaceWrapper) UpdateInvoice(c *fiber.Ctx) error {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id InvoiceId
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
-	}
+// CreateInvoice operation middleware
+func (siw *ServerInterfaceWrapper) CreateInvoice(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-	return siw.Handler.UpdateInvoice(c, id)
+	return siw.Handler.CreateInvoice(c)
 }
 
-// AddInvoiceItem operation middleware
-func (siw *ServerInterfaceWrapper) AddInvoiceItem(c *fiber.Ctx) error {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id InvoiceId
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
-	}
+// GetInvoiceFacets operation middleware
+func (siw *ServerInterfaceWrapper) GetInvoiceFacets(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-	return siw.Handler.AddInvoiceItem(c, id)
+	return siw.Handler.GetInvoiceFacets(c)
 }
 
-// UpdateInvoiceStatus operation middleware
-func (siw *ServerInterfaceWrapper) UpdateInvoiceStatus(c *fiber.Ctx) error {
+// ImportInvoices operation middleware
+func (siw *ServerInterfaceWrapper) ImportInvoices(c *fiber.Ctx) error {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id InvoiceId
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
-	}
-
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
-
-	return siw.Handler.UpdateInvoiceStatus(c, id)
-}
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-// AddTagToInvoice operation middleware
-func (siw *ServerInterfaceWrapper) AddTagToInvoice(c *fiber.Ctx) error {
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportInvoicesParams
 
-	var err error
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
 
-	// ------------- Path parameter "id" -------------
-	var id InvoiceId
+	// ------------- Optional query parameter "format" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "format", query, &params.Format)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter format: %w", err).Error())
 	}
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "create_missing" -------------
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	err = runtime.BindQueryParameter("form", true, false, "create_missing", query, &params.CreateMissing)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter create_missing: %w", err).Error())
+	}
 
-	return siw.Handler.AddTagToInvoice(c, id)
+	return siw.Handler.ImportInvoices(c, params)
 }
 
-// RemoveTagFromInvoice operation middleware
-func (siw *ServerInterfaceWrapper) RemoveTagFromInvoice(c *fiber.Ctx) error {
+// LookupInvoices operation middleware
+func (siw *ServerInterfaceWrapper) LookupInvoices(c *fiber.Ctx) error {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id InvoiceId
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
-	}
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-	// ------------- Path parameter "tagId" -------------
-	var tagId int
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupInvoicesParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "tagId", c.Params("tagId"), &tagId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter tagId: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
-
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	// ------------- Optional query parameter "number" -------------
 
-	return siw.Handler.RemoveTagFromInvoice(c, id, tagId)
-}
-
-// DeleteInvoiceItem operation middleware
-func (siw *ServerInterfaceWrapper) DeleteInvoiceItem(c *fiber.Ctx) error {
-
-	var err error
-
-	// ------------- Path parameter "invoice_id" -------------
-	var invoiceId int
-
-	err = runtime.BindStyledParameterWithOptions("simple", "invoice_id", c.Params("invoice_id"), &invoiceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "number", query, &params.Number)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter invoice_id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter number: %w", err).Error())
 	}
 
-	// ------------- Path parameter "item_id" -------------
-	var itemId int
+	// ------------- Optional query parameter "link" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "item_id", c.Params("item_id"), &itemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "link", query, &params.Link)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter item_id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter link: %w", err).Error())
 	}
 
+	return siw.Handler.LookupInvoices(c, params)
+}
+
+// GetInvoiceStatusCounts operation middleware
+func (siw *ServerInterfaceWrapper) GetInvoiceStatusCounts(c *fiber.Ctx) error {
+
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-	return siw.Handler.DeleteInvoiceItem(c, invoiceId, itemId)
+	return siw.Handler.GetInvoiceStatusCounts(c)
 }
 
-// UpdateInvoiceItem operation middleware
-func (siw *ServerInterfaceWrapper) UpdateInvoiceItem(c *fiber.Ctx) error {
+// DeleteInvoice operation middleware
+func (siw *ServerInterfaceWrapper) DeleteInvoice(c *fiber.Ctx) error {
 
 	var err error
 
-	// ------------- Path parameter "invoice_id" -------------
-	var invoiceId int
-
-	err = runtime.BindStyledParameterWithOptions("simple", "invoice_id", c.Params("invoice_id"), &invoiceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter invoice_id: %w", err).Error())
-	}
-
-	// ------------- Path parameter "item_id" -------------
-	var itemId int
+	// ------------- Path parameter "id" -------------
+	var id InvoiceId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "item_id", c.Params("item_id"), &itemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter item_id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-	return siw.Handler.UpdateInvoiceItem(c, invoiceId, itemId)
+	return siw.Handler.DeleteInvoice(c, id)
 }
 
-// ListReceivers operation middleware
-func (siw *ServerInterfaceWrapper) ListReceivers(c *fiber.Ctx) error {
+// GetInvoice operation middleware
+func (siw *ServerInterfaceWrapper) GetInvoice(c *fiber.Ctx) error {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id InvoiceId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListReceiversParams
+	var params GetInvoiceParams
 
 	var query url.Values
 	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
@@ -861,57 +1276,57 @@ func (siw *ServerInterfaceWrapper) ListReceivers(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "keyword" -------------
+	// ------------- Optional query parameter "expand" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "keyword", query, &params.Keyword)
+	err = runtime.BindQueryParameter("form", true, false, "expand", query, &params.Expand)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter keyword: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter expand: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Optional query parameter "include_amount_in_words" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	err = runtime.BindQueryParameter("form", true, false, "include_amount_in_words", query, &params.IncludeAmountInWords)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter include_amount_in_words: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Optional query parameter "locale" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", query, &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "locale", query, &params.Locale)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter offset: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter locale: %w", err).Error())
 	}
 
-	return siw.Handler.ListReceivers(c, params)
+	return siw.Handler.GetInvoice(c, id, params)
 }
 
-// CreateReceiver operation middleware
-func (siw *ServerInterfaceWrapper) CreateReceiver(c *fiber.Ctx) error {
-
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
+// UpdateInvoice operation middleware
+func (siw *ServerInterfaceWrapper) UpdateInvoice(c *fiber.Ctx) error {
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	var err error
 
-	return siw.Handler.CreateReceiver(c)
-}
+	// ------------- Path parameter "id" -------------
+	var id InvoiceId
 
-// MergeReceivers operation middleware
-func (siw *ServerInterfaceWrapper) MergeReceivers(c *fiber.Ctx) error {
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-	return siw.Handler.MergeReceivers(c)
+	return siw.Handler.UpdateInvoice(c, id)
 }
 
-// DeleteReceiver operation middleware
-func (siw *ServerInterfaceWrapper) DeleteReceiver(c *fiber.Ctx) error {
+// ListInvoiceAttachments operation middleware
+func (siw *ServerInterfaceWrapper) ListInvoiceAttachments(c *fiber.Ctx) error {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id ReceiverId
+	var id InvoiceId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -920,18 +1335,18 @@ func (siw *ServerInterfaceWrapper) DeleteReceiver(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-	return siw.Handler.DeleteReceiver(c, id)
+	return siw.Handler.ListInvoiceAttachments(c, id)
 }
 
-// GetReceiver operation middleware
-func (siw *ServerInterfaceWrapper) GetReceiver(c *fiber.Ctx) error {
+// AddInvoiceAttachment operation middleware
+func (siw *ServerInterfaceWrapper) AddInvoiceAttachment(c *fiber.Ctx) error {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id ReceiverId
+	var id InvoiceId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -940,42 +1355,58 @@ func (siw *ServerInterfaceWrapper) GetReceiver(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-	return siw.Handler.GetReceiver(c, id)
+	return siw.Handler.AddInvoiceAttachment(c, id)
 }
 
-// UpdateReceiver operation middleware
-func (siw *ServerInterfaceWrapper) UpdateReceiver(c *fiber.Ctx) error {
+// RemoveInvoiceAttachment operation middleware
+func (siw *ServerInterfaceWrapper) RemoveInvoiceAttachment(c *fiber.Ctx) error {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id ReceiverId
+	var id InvoiceId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachmentId", c.Params("attachmentId"), &attachmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter attachmentId: %w", err).Error())
+	}
+
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-	return siw.Handler.UpdateReceiver(c, id)
+	return siw.Handler.RemoveInvoiceAttachment(c, id, attachmentId)
 }
 
-// ListTags operation middleware
-func (siw *ServerInterfaceWrapper) ListTags(c *fiber.Ctx) error {
+// GetInvoiceAuditTrail operation middleware
+func (siw *ServerInterfaceWrapper) GetInvoiceAuditTrail(c *fiber.Ctx) error {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id InvoiceId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListTagsParams
+	var params GetInvoiceAuditTrailParams
 
 	var query url.Values
 	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
@@ -983,11 +1414,25 @@ func (siw *ServerInterfaceWrapper) ListTags(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "keyword" -------------
+	// ------------- Optional query parameter "action" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "keyword", query, &params.Keyword)
+	err = runtime.BindQueryParameter("form", true, false, "action", query, &params.Action)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter keyword: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter action: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "start" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start", query, &params.Start)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter start: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "end" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end", query, &params.End)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter end: %w", err).Error())
 	}
 
 	// ------------- Optional query parameter "limit" -------------
@@ -1004,26 +1449,36 @@ func (siw *ServerInterfaceWrapper) ListTags(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter offset: %w", err).Error())
 	}
 
-	return siw.Handler.ListTags(c, params)
+	return siw.Handler.GetInvoiceAuditTrail(c, id, params)
 }
 
-// CreateTag operation middleware
-func (siw *ServerInterfaceWrapper) CreateTag(c *fiber.Ctx) error {
+// CloneInvoice operation middleware
+func (siw *ServerInterfaceWrapper) CloneInvoice(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id InvoiceId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-	return siw.Handler.CreateTag(c)
+	return siw.Handler.CloneInvoice(c, id)
 }
 
-// DeleteTag operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTag(c *fiber.Ctx) error {
+// PreviewCurrencyConversion operation middleware
+func (siw *ServerInterfaceWrapper) PreviewCurrencyConversion(c *fiber.Ctx) error {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id TagId
+	var id InvoiceId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -1032,18 +1487,18 @@ func (siw *ServerInterfaceWrapper) DeleteTag(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-	return siw.Handler.DeleteTag(c, id)
+	return siw.Handler.PreviewCurrencyConversion(c, id)
 }
 
-// GetTag operation middleware
-func (siw *ServerInterfaceWrapper) GetTag(c *fiber.Ctx) error {
+// FinalizeInvoice operation middleware
+func (siw *ServerInterfaceWrapper) FinalizeInvoice(c *fiber.Ctx) error {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id TagId
+	var id InvoiceId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -1052,18 +1507,18 @@ func (siw *ServerInterfaceWrapper) GetTag(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-	return siw.Handler.GetTag(c, id)
+	return siw.Handler.FinalizeInvoice(c, id)
 }
 
-// UpdateTag operation middleware
-func (siw *ServerInterfaceWrapper) UpdateTag(c *fiber.Ctx) error {
+// AddInvoiceItem operation middleware
+func (siw *ServerInterfaceWrapper) AddInvoiceItem(c *fiber.Ctx) error {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id TagId
+	var id InvoiceId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -1072,52 +1527,90 @@ func (siw *ServerInterfaceWrapper) UpdateTag(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-	return siw.Handler.UpdateTag(c, id)
+	return siw.Handler.AddInvoiceItem(c, id)
 }
 
-// UploadFile operation middleware
-func (siw *ServerInterfaceWrapper) UploadFile(c *fiber.Ctx) error {
+// AddInvoiceItems operation middleware
+func (siw *ServerInterfaceWrapper) AddInvoiceItems(c *fiber.Ctx) error {
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
+	var err error
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	// ------------- Path parameter "id" -------------
+	var id InvoiceId
 
-	return siw.Handler.UploadFile(c)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+
+	return siw.Handler.AddInvoiceItems(c, id)
 }
 
-// ConfirmPresignedUpload operation middleware
-func (siw *ServerInterfaceWrapper) ConfirmPresignedUpload(c *fiber.Ctx) error {
+// ReorderInvoiceItems operation middleware
+func (siw *ServerInterfaceWrapper) ReorderInvoiceItems(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id InvoiceId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-	return siw.Handler.ConfirmPresignedUpload(c)
+	return siw.Handler.ReorderInvoiceItems(c, id)
 }
 
-// UploadHtmlToPdf operation middleware
-func (siw *ServerInterfaceWrapper) UploadHtmlToPdf(c *fiber.Ctx) error {
+// RecalculateInvoiceTotals operation middleware
+func (siw *ServerInterfaceWrapper) RecalculateInvoiceTotals(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id InvoiceId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-	return siw.Handler.UploadHtmlToPdf(c)
+	return siw.Handler.RecalculateInvoiceTotals(c, id)
 }
 
-// GetPresignedURL operation middleware
-func (siw *ServerInterfaceWrapper) GetPresignedURL(c *fiber.Ctx) error {
+// FindSimilarInvoices operation middleware
+func (siw *ServerInterfaceWrapper) FindSimilarInvoices(c *fiber.Ctx) error {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id InvoiceId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	c.Context().SetUserValue(OAuth2Scopes, []string{"read:invoices", "write:invoices", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetPresignedURLParams
+	var params FindSimilarInvoicesParams
 
 	var query url.Values
 	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
@@ -1125,1748 +1618,4987 @@ func (siw *ServerInterfaceWrapper) GetPresignedURL(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	// ------------- Required query parameter "filename" -------------
-
-	if paramValue := c.Query("filename"); paramValue != "" {
-
-	} else {
-		err = fmt.Errorf("Query argument filename is required, but not found")
-		c.Status(fiber.StatusBadRequest).JSON(err)
-		return err
-	}
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "filename", query, &params.Filename)
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter filename: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "content_type" -------------
+	return siw.Handler.FindSimilarInvoices(c, id, params)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "content_type", query, &params.ContentType)
+// UpdateInvoiceStatus operation middleware
+func (siw *ServerInterfaceWrapper) UpdateInvoiceStatus(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id InvoiceId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter content_type: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
-	return siw.Handler.GetPresignedURL(c, params)
-}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-// HealthCheck operation middleware
-func (siw *ServerInterfaceWrapper) HealthCheck(c *fiber.Ctx) error {
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-	return siw.Handler.HealthCheck(c)
+	return siw.Handler.UpdateInvoiceStatus(c, id)
 }
 
-// FiberServerOptions provides options for the Fiber server.
-type FiberServerOptions struct {
-	BaseURL     string
-	Middlewares []MiddlewareFunc
-}
+// GetInvoiceStatusHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetInvoiceStatusHistory(c *fiber.Ctx) error {
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router fiber.Router, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, FiberServerOptions{})
-}
+	var err error
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, options FiberServerOptions) {
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
+	// ------------- Path parameter "id" -------------
+	var id InvoiceId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
-	for _, m := range options.Middlewares {
-		router.Use(fiber.Handler(m))
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetInvoiceStatusHistoryParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	router.Get(options.BaseURL+"/api/analytics/by-category", wrapper.GetAnalyticsByCategory)
+	// ------------- Optional query parameter "action" -------------
 
-	router.Get(options.BaseURL+"/api/analytics/by-company", wrapper.GetAnalyticsByCompany)
+	err = runtime.BindQueryParameter("form", true, false, "action", query, &params.Action)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter action: %w", err).Error())
+	}
 
-	router.Get(options.BaseURL+"/api/analytics/by-receiver", wrapper.GetAnalyticsByReceiver)
+	// ------------- Optional query parameter "start" -------------
 
-	router.Get(options.BaseURL+"/api/analytics/by-tag", wrapper.GetAnalyticsByTag)
+	err = runtime.BindQueryParameter("form", true, false, "start", query, &params.Start)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter start: %w", err).Error())
+	}
 
-	router.Get(options.BaseURL+"/api/analytics/summary", wrapper.GetAnalyticsSummary)
+	// ------------- Optional query parameter "end" -------------
 
-	router.Get(options.BaseURL+"/api/categories", wrapper.ListCategories)
+	err = runtime.BindQueryParameter("form", true, false, "end", query, &params.End)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter end: %w", err).Error())
+	}
 
-	router.Post(options.BaseURL+"/api/categories", wrapper.CreateCategory)
+	// ------------- Optional query parameter "limit" -------------
 
-	router.Delete(options.BaseURL+"/api/categories/:id", wrapper.DeleteCategory)
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
 
-	router.Get(options.BaseURL+"/api/categories/:id", wrapper.GetCategory)
+	// ------------- Optional query parameter "offset" -------------
 
-	router.Put(options.BaseURL+"/api/categories/:id", wrapper.UpdateCategory)
+	err = runtime.BindQueryParameter("form", true, false, "offset", query, &params.Offset)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter offset: %w", err).Error())
+	}
 
-	router.Get(options.BaseURL+"/api/companies", wrapper.ListCompanies)
+	return siw.Handler.GetInvoiceStatusHistory(c, id, params)
+}
 
-	router.Post(options.BaseURL+"/api/companies", wrapper.CreateCompany)
+// AddTagToInvoice operation middleware
+func (siw *ServerInterfaceWrapper) AddTagToInvoice(c *fiber.Ctx) error {
 
-	router.Delete(options.BaseURL+"/api/companies/:id", wrapper.DeleteCompany)
+	var err error
 
-	router.Get(options.BaseURL+"/api/companies/:id", wrapper.GetCompany)
+	// ------------- Path parameter "id" -------------
+	var id InvoiceId
 
-	router.Put(options.BaseURL+"/api/companies/:id", wrapper.UpdateCompany)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-	router.Get(options.BaseURL+"/api/files/:key/download", wrapper.GetFileDownloadURL)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	router.Get(options.BaseURL+"/api/invoices", wrapper.ListInvoices)
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-	router.Post(options.BaseURL+"/api/invoices", wrapper.CreateInvoice)
+	return siw.Handler.AddTagToInvoice(c, id)
+}
 
-	router.Delete(options.BaseURL+"/api/invoices/:id", wrapper.DeleteInvoice)
+// RemoveTagFromInvoice operation middleware
+func (siw *ServerInterfaceWrapper) RemoveTagFromInvoice(c *fiber.Ctx) error {
 
-	router.Get(options.BaseURL+"/api/invoices/:id", wrapper.GetInvoice)
+	var err error
 
-	router.Put(options.BaseURL+"/api/invoices/:id", wrapper.UpdateInvoice)
+	// ------------- Path parameter "id" -------------
+	var id InvoiceId
 
-	router.Post(options.BaseURL+"/api/invoices/:id/items", wrapper.AddInvoiceItem)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-	router.Patch(options.BaseURL+"/api/invoices/:id/status", wrapper.UpdateInvoiceStatus)
+	// ------------- Path parameter "tagId" -------------
+	var tagId int
 
-	router.Post(options.BaseURL+"/api/invoices/:id/tags", wrapper.AddTagToInvoice)
+	err = runtime.BindStyledParameterWithOptions("simple", "tagId", c.Params("tagId"), &tagId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter tagId: %w", err).Error())
+	}
 
-	router.Delete(options.BaseURL+"/api/invoices/:id/tags/:tagId", wrapper.RemoveTagFromInvoice)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	router.Delete(options.BaseURL+"/api/invoices/:invoice_id/items/:item_id", wrapper.DeleteInvoiceItem)
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-	router.Put(options.BaseURL+"/api/invoices/:invoice_id/items/:item_id", wrapper.UpdateInvoiceItem)
+	return siw.Handler.RemoveTagFromInvoice(c, id, tagId)
+}
 
-	router.Get(options.BaseURL+"/api/receivers", wrapper.ListReceivers)
+// DeleteInvoiceItem operation middleware
+func (siw *ServerInterfaceWrapper) DeleteInvoiceItem(c *fiber.Ctx) error {
 
-	router.Post(options.BaseURL+"/api/receivers", wrapper.CreateReceiver)
+	var err error
 
-	router.Post(options.BaseURL+"/api/receivers/merge", wrapper.MergeReceivers)
+	// ------------- Path parameter "invoice_id" -------------
+	var invoiceId int
 
-	router.Delete(options.BaseURL+"/api/receivers/:id", wrapper.DeleteReceiver)
+	err = runtime.BindStyledParameterWithOptions("simple", "invoice_id", c.Params("invoice_id"), &invoiceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter invoice_id: %w", err).Error())
+	}
 
-	router.Get(options.BaseURL+"/api/receivers/:id", wrapper.GetReceiver)
+	// ------------- Path parameter "item_id" -------------
+	var itemId int
 
-	router.Put(options.BaseURL+"/api/receivers/:id", wrapper.UpdateReceiver)
+	err = runtime.BindStyledParameterWithOptions("simple", "item_id", c.Params("item_id"), &itemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter item_id: %w", err).Error())
+	}
 
-	router.Get(options.BaseURL+"/api/tags", wrapper.ListTags)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	router.Post(options.BaseURL+"/api/tags", wrapper.CreateTag)
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-	router.Delete(options.BaseURL+"/api/tags/:id", wrapper.DeleteTag)
+	return siw.Handler.DeleteInvoiceItem(c, invoiceId, itemId)
+}
 
-	router.Get(options.BaseURL+"/api/tags/:id", wrapper.GetTag)
+// UpdateInvoiceItem operation middleware
+func (siw *ServerInterfaceWrapper) UpdateInvoiceItem(c *fiber.Ctx) error {
 
-	router.Put(options.BaseURL+"/api/tags/:id", wrapper.UpdateTag)
+	var err error
 
-	router.Post(options.BaseURL+"/api/upload", wrapper.UploadFile)
+	// ------------- Path parameter "invoice_id" -------------
+	var invoiceId int
 
-	router.Post(options.BaseURL+"/api/upload/confirm", wrapper.ConfirmPresignedUpload)
+	err = runtime.BindStyledParameterWithOptions("simple", "invoice_id", c.Params("invoice_id"), &invoiceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter invoice_id: %w", err).Error())
+	}
 
-	router.Post(options.BaseURL+"/api/upload/html-to-pdf", wrapper.UploadHtmlToPdf)
+	// ------------- Path parameter "item_id" -------------
+	var itemId int
 
-	router.Get(options.BaseURL+"/api/upload/presigned", wrapper.GetPresignedURL)
+	err = runtime.BindStyledParameterWithOptions("simple", "item_id", c.Params("item_id"), &itemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter item_id: %w", err).Error())
+	}
 
-	router.Get(options.BaseURL+"/health", wrapper.HealthCheck)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
+	return siw.Handler.UpdateInvoiceItem(c, invoiceId, itemId)
 }
 
-type BadRequestJSONResponse Error
+// ListOrganizations operation middleware
+func (siw *ServerInterfaceWrapper) ListOrganizations(c *fiber.Ctx) error {
 
-type NotFoundJSONResponse Error
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-type UnauthorizedJSONResponse Error
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-type GetAnalyticsByCategoryRequestObject struct {
-	Params GetAnalyticsByCategoryParams
+	return siw.Handler.ListOrganizations(c)
 }
 
-type GetAnalyticsByCategoryResponseObject interface {
-	VisitGetAnalyticsByCategoryResponse(ctx *fiber.Ctx) error
-}
+// CreateOrganization operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrganization(c *fiber.Ctx) error {
 
-type GetAnalyticsByCategory200JSONResponse AnalyticsByGroup
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-func (response GetAnalyticsByCategory200JSONResponse) VisitGetAnalyticsByCategoryResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-	return ctx.JSON(&response)
+	return siw.Handler.CreateOrganization(c)
 }
 
-type GetAnalyticsByCategory401JSONResponse struct{ UnauthorizedJSONResponse }
-
-func (response GetAnalyticsByCategory401JSONResponse) VisitGetAnalyticsByCategoryResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+// ListOrganizationMembers operation middleware
+func (siw *ServerInterfaceWrapper) ListOrganizationMembers(c *fiber.Ctx) error {
 
-	return ctx.JSON(&response)
-}
+	var err error
 
-type GetAnalyticsByCompanyRequestObject struct {
-	Params GetAnalyticsByCompanyParams
-}
+	// ------------- Path parameter "id" -------------
+	var id OrganizationId
 
-type GetAnalyticsByCompanyResponseObject interface {
-	VisitGetAnalyticsByCompanyResponse(ctx *fiber.Ctx) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-type GetAnalyticsByCompany200JSONResponse AnalyticsByGroup
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-func (response GetAnalyticsByCompany200JSONResponse) VisitGetAnalyticsByCompanyResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-	return ctx.JSON(&response)
+	return siw.Handler.ListOrganizationMembers(c, id)
 }
 
-type GetAnalyticsByCompany401JSONResponse struct{ UnauthorizedJSONResponse }
+// RemoveOrganizationMember operation middleware
+func (siw *ServerInterfaceWrapper) RemoveOrganizationMember(c *fiber.Ctx) error {
 
-func (response GetAnalyticsByCompany401JSONResponse) VisitGetAnalyticsByCompanyResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "id" -------------
+	var id OrganizationId
 
-type GetAnalyticsByReceiverRequestObject struct {
-	Params GetAnalyticsByReceiverParams
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-type GetAnalyticsByReceiverResponseObject interface {
-	VisitGetAnalyticsByReceiverResponse(ctx *fiber.Ctx) error
-}
+	// ------------- Path parameter "user_id" -------------
+	var userId MemberUserId
 
-type GetAnalyticsByReceiver200JSONResponse AnalyticsByGroup
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Params("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter user_id: %w", err).Error())
+	}
 
-func (response GetAnalyticsByReceiver200JSONResponse) VisitGetAnalyticsByReceiverResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return ctx.JSON(&response)
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+
+	return siw.Handler.RemoveOrganizationMember(c, id, userId)
 }
 
-type GetAnalyticsByReceiver401JSONResponse struct{ UnauthorizedJSONResponse }
+// SetOrganizationMember operation middleware
+func (siw *ServerInterfaceWrapper) SetOrganizationMember(c *fiber.Ctx) error {
 
-func (response GetAnalyticsByReceiver401JSONResponse) VisitGetAnalyticsByReceiverResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "id" -------------
+	var id OrganizationId
 
-type GetAnalyticsByTagRequestObject struct {
-	Params GetAnalyticsByTagParams
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-type GetAnalyticsByTagResponseObject interface {
-	VisitGetAnalyticsByTagResponse(ctx *fiber.Ctx) error
-}
+	// ------------- Path parameter "user_id" -------------
+	var userId MemberUserId
 
-type GetAnalyticsByTag200JSONResponse AnalyticsByGroup
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Params("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter user_id: %w", err).Error())
+	}
 
-func (response GetAnalyticsByTag200JSONResponse) VisitGetAnalyticsByTagResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return ctx.JSON(&response)
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
+
+	return siw.Handler.SetOrganizationMember(c, id, userId)
 }
 
-type GetAnalyticsByTag401JSONResponse struct{ UnauthorizedJSONResponse }
+// ListPaymentMethods operation middleware
+func (siw *ServerInterfaceWrapper) ListPaymentMethods(c *fiber.Ctx) error {
 
-func (response GetAnalyticsByTag401JSONResponse) VisitGetAnalyticsByTagResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return ctx.JSON(&response)
-}
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-type GetAnalyticsSummaryRequestObject struct {
-	Params GetAnalyticsSummaryParams
+	return siw.Handler.ListPaymentMethods(c)
 }
 
-type GetAnalyticsSummaryResponseObject interface {
-	VisitGetAnalyticsSummaryResponse(ctx *fiber.Ctx) error
-}
+// ListReceivers operation middleware
+func (siw *ServerInterfaceWrapper) ListReceivers(c *fiber.Ctx) error {
 
-type GetAnalyticsSummary200JSONResponse AnalyticsSummary
+	var err error
 
-func (response GetAnalyticsSummary200JSONResponse) VisitGetAnalyticsSummaryResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return ctx.JSON(&response)
-}
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-type GetAnalyticsSummary401JSONResponse struct{ UnauthorizedJSONResponse }
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListReceiversParams
 
-func (response GetAnalyticsSummary401JSONResponse) VisitGetAnalyticsSummaryResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
-}
+	// ------------- Optional query parameter "keyword" -------------
 
-type ListCategoriesRequestObject struct {
-	Params ListCategoriesParams
-}
+	err = runtime.BindQueryParameter("form", true, false, "keyword", query, &params.Keyword)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter keyword: %w", err).Error())
+	}
 
-type ListCategoriesResponseObject interface {
-	VisitListCategoriesResponse(ctx *fiber.Ctx) error
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type ListCategories200JSONResponse CategoryListResponse
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
 
-func (response ListCategories200JSONResponse) VisitListCategoriesResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	// ------------- Optional query parameter "offset" -------------
 
-	return ctx.JSON(&response)
+	err = runtime.BindQueryParameter("form", true, false, "offset", query, &params.Offset)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter offset: %w", err).Error())
+	}
+
+	return siw.Handler.ListReceivers(c, params)
 }
 
-type ListCategories401JSONResponse struct{ UnauthorizedJSONResponse }
+// CreateReceiver operation middleware
+func (siw *ServerInterfaceWrapper) CreateReceiver(c *fiber.Ctx) error {
 
-func (response ListCategories401JSONResponse) VisitListCategoriesResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return ctx.JSON(&response)
-}
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-type CreateCategoryRequestObject struct {
-	Body *CreateCategoryJSONRequestBody
+	return siw.Handler.CreateReceiver(c)
 }
 
-type CreateCategoryResponseObject interface {
-	VisitCreateCategoryResponse(ctx *fiber.Ctx) error
-}
+// MergeReceivers operation middleware
+func (siw *ServerInterfaceWrapper) MergeReceivers(c *fiber.Ctx) error {
 
-type CreateCategory201JSONResponse Category
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-func (response CreateCategory201JSONResponse) VisitCreateCategoryResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(201)
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-	return ctx.JSON(&response)
+	return siw.Handler.MergeReceivers(c)
 }
 
-type CreateCategory400JSONResponse struct{ BadRequestJSONResponse }
+// DeleteReceiver operation middleware
+func (siw *ServerInterfaceWrapper) DeleteReceiver(c *fiber.Ctx) error {
 
-func (response CreateCategory400JSONResponse) VisitCreateCategoryResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "id" -------------
+	var id ReceiverId
 
-type CreateCategory401JSONResponse struct{ UnauthorizedJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-func (response CreateCategory401JSONResponse) VisitCreateCategoryResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return ctx.JSON(&response)
-}
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-type DeleteCategoryRequestObject struct {
-	Id CategoryId `json:"id"`
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteReceiverParams
 
-type DeleteCategoryResponseObject interface {
-	VisitDeleteCategoryResponse(ctx *fiber.Ctx) error
-}
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
 
-type DeleteCategory204Response struct {
-}
+	// ------------- Optional query parameter "force" -------------
 
-func (response DeleteCategory204Response) VisitDeleteCategoryResponse(ctx *fiber.Ctx) error {
-	ctx.Status(204)
-	return nil
+	err = runtime.BindQueryParameter("form", true, false, "force", query, &params.Force)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter force: %w", err).Error())
+	}
+
+	return siw.Handler.DeleteReceiver(c, id, params)
 }
 
-type DeleteCategory401JSONResponse struct{ UnauthorizedJSONResponse }
+// GetReceiver operation middleware
+func (siw *ServerInterfaceWrapper) GetReceiver(c *fiber.Ctx) error {
 
-func (response DeleteCategory401JSONResponse) VisitDeleteCategoryResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "id" -------------
+	var id ReceiverId
 
-type DeleteCategory404JSONResponse struct{ NotFoundJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-func (response DeleteCategory404JSONResponse) VisitDeleteCategoryResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return ctx.JSON(&response)
-}
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-type GetCategoryRequestObject struct {
-	Id CategoryId `json:"id"`
+	return siw.Handler.GetReceiver(c, id)
 }
 
-type GetCategoryResponseObject interface {
-	VisitGetCategoryResponse(ctx *fiber.Ctx) error
-}
+// UpdateReceiver operation middleware
+func (siw *ServerInterfaceWrapper) UpdateReceiver(c *fiber.Ctx) error {
 
-type GetCategory200JSONResponse Category
+	var err error
 
-func (response GetCategory200JSONResponse) VisitGetCategoryResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	// ------------- Path parameter "id" -------------
+	var id ReceiverId
 
-	return ctx.JSON(&response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-type GetCategory401JSONResponse struct{ UnauthorizedJSONResponse }
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-func (response GetCategory401JSONResponse) VisitGetCategoryResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-	return ctx.JSON(&response)
+	return siw.Handler.UpdateReceiver(c, id)
 }
 
-type GetCategory404JSONResponse struct{ NotFoundJSONResponse }
+// GetReceiverStatement operation middleware
+func (siw *ServerInterfaceWrapper) GetReceiverStatement(c *fiber.Ctx) error {
 
-func (response GetCategory404JSONResponse) VisitGetCategoryResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "id" -------------
+	var id ReceiverId
 
-type UpdateCategoryRequestObject struct {
-	Id   CategoryId `json:"id"`
-	Body *UpdateCategoryJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-type UpdateCategoryResponseObject interface {
-	VisitUpdateCategoryResponse(ctx *fiber.Ctx) error
-}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-type UpdateCategory200JSONResponse Category
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-func (response UpdateCategory200JSONResponse) VisitUpdateCategoryResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReceiverStatementParams
 
-	return ctx.JSON(&response)
-}
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
 
-type UpdateCategory400JSONResponse struct{ BadRequestJSONResponse }
+	// ------------- Required query parameter "start" -------------
 
-func (response UpdateCategory400JSONResponse) VisitUpdateCategoryResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	if paramValue := c.Query("start"); paramValue != "" {
 
-	return ctx.JSON(&response)
-}
+	} else {
+		err = fmt.Errorf("Query argument start is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
 
-type UpdateCategory401JSONResponse struct{ UnauthorizedJSONResponse }
+	err = runtime.BindQueryParameter("form", true, true, "start", query, &params.Start)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter start: %w", err).Error())
+	}
 
-func (response UpdateCategory401JSONResponse) VisitUpdateCategoryResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	// ------------- Required query parameter "end" -------------
 
-	return ctx.JSON(&response)
-}
+	if paramValue := c.Query("end"); paramValue != "" {
 
-type ListCompaniesRequestObject struct {
-	Params ListCompaniesParams
-}
+	} else {
+		err = fmt.Errorf("Query argument end is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
 
-type ListCompaniesResponseObject interface {
-	VisitListCompaniesResponse(ctx *fiber.Ctx) error
-}
+	err = runtime.BindQueryParameter("form", true, true, "end", query, &params.End)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter end: %w", err).Error())
+	}
 
-type ListCompanies200JSONResponse CompanyListResponse
+	// ------------- Optional query parameter "format" -------------
 
-func (response ListCompanies200JSONResponse) VisitListCompaniesResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	err = runtime.BindQueryParameter("form", true, false, "format", query, &params.Format)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter format: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
+	return siw.Handler.GetReceiverStatement(c, id, params)
 }
 
-type ListCompanies401JSONResponse struct{ UnauthorizedJSONResponse }
+// GetReceiverStatistics operation middleware
+func (siw *ServerInterfaceWrapper) GetReceiverStatistics(c *fiber.Ctx) error {
 
-func (response ListCompanies401JSONResponse) VisitListCompaniesResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "id" -------------
+	var id ReceiverId
 
-type CreateCompanyRequestObject struct {
-	Body *CreateCompanyJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-type CreateCompanyResponseObject interface {
-	VisitCreateCompanyResponse(ctx *fiber.Ctx) error
-}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-type CreateCompany201JSONResponse Company
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-func (response CreateCompany201JSONResponse) VisitCreateCompanyResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(201)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReceiverStatisticsParams
 
-	return ctx.JSON(&response)
-}
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
 
-type CreateCompany400JSONResponse struct{ BadRequestJSONResponse }
+	// ------------- Optional query parameter "period" -------------
 
-func (response CreateCompany400JSONResponse) VisitCreateCompanyResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	err = runtime.BindQueryParameter("form", true, false, "period", query, &params.Period)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter period: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
+	return siw.Handler.GetReceiverStatistics(c, id, params)
 }
 
-type CreateCompany401JSONResponse struct{ UnauthorizedJSONResponse }
+// GetSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetSettings(c *fiber.Ctx) error {
 
-func (response CreateCompany401JSONResponse) VisitCreateCompanyResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return ctx.JSON(&response)
-}
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-type DeleteCompanyRequestObject struct {
-	Id CompanyId `json:"id"`
+	return siw.Handler.GetSettings(c)
 }
 
-type DeleteCompanyResponseObject interface {
-	VisitDeleteCompanyResponse(ctx *fiber.Ctx) error
-}
+// UpdateSettings operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSettings(c *fiber.Ctx) error {
 
-type DeleteCompany204Response struct {
-}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-func (response DeleteCompany204Response) VisitDeleteCompanyResponse(ctx *fiber.Ctx) error {
-	ctx.Status(204)
-	return nil
-}
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-type DeleteCompany401JSONResponse struct{ UnauthorizedJSONResponse }
+	return siw.Handler.UpdateSettings(c)
+}
 
-func (response DeleteCompany401JSONResponse) VisitDeleteCompanyResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+// ListTags operation middleware
+func (siw *ServerInterfaceWrapper) ListTags(c *fiber.Ctx) error {
 
-	return ctx.JSON(&response)
-}
+	var err error
 
-type DeleteCompany404JSONResponse struct{ NotFoundJSONResponse }
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-func (response DeleteCompany404JSONResponse) VisitDeleteCompanyResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-	return ctx.JSON(&response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTagsParams
 
-type GetCompanyRequestObject struct {
-	Id CompanyId `json:"id"`
-}
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
 
-type GetCompanyResponseObject interface {
-	VisitGetCompanyResponse(ctx *fiber.Ctx) error
-}
+	// ------------- Optional query parameter "keyword" -------------
 
-type GetCompany200JSONResponse Company
+	err = runtime.BindQueryParameter("form", true, false, "keyword", query, &params.Keyword)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter keyword: %w", err).Error())
+	}
 
-func (response GetCompany200JSONResponse) VisitGetCompanyResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	// ------------- Optional query parameter "limit" -------------
 
-	return ctx.JSON(&response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
 
-type GetCompany401JSONResponse struct{ UnauthorizedJSONResponse }
+	// ------------- Optional query parameter "offset" -------------
 
-func (response GetCompany401JSONResponse) VisitGetCompanyResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	err = runtime.BindQueryParameter("form", true, false, "offset", query, &params.Offset)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter offset: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
+	return siw.Handler.ListTags(c, params)
 }
 
-type GetCompany404JSONResponse struct{ NotFoundJSONResponse }
+// CreateTag operation middleware
+func (siw *ServerInterfaceWrapper) CreateTag(c *fiber.Ctx) error {
 
-func (response GetCompany404JSONResponse) VisitGetCompanyResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return ctx.JSON(&response)
-}
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-type UpdateCompanyRequestObject struct {
-	Id   CompanyId `json:"id"`
-	Body *UpdateCompanyJSONRequestBody
+	return siw.Handler.CreateTag(c)
 }
 
-type UpdateCompanyResponseObject interface {
-	VisitUpdateCompanyResponse(ctx *fiber.Ctx) error
-}
+// DeleteTag operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTag(c *fiber.Ctx) error {
 
-type UpdateCompany200JSONResponse Company
+	var err error
 
-func (response UpdateCompany200JSONResponse) VisitUpdateCompanyResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	// ------------- Path parameter "id" -------------
+	var id TagId
 
-	return ctx.JSON(&response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-type UpdateCompany400JSONResponse struct{ BadRequestJSONResponse }
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-func (response UpdateCompany400JSONResponse) VisitUpdateCompanyResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-	return ctx.JSON(&response)
+	return siw.Handler.DeleteTag(c, id)
 }
 
-type UpdateCompany401JSONResponse struct{ UnauthorizedJSONResponse }
+// GetTag operation middleware
+func (siw *ServerInterfaceWrapper) GetTag(c *fiber.Ctx) error {
 
-func (response UpdateCompany401JSONResponse) VisitUpdateCompanyResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	var err error
 
-	return ctx.JSON(&response)
-}
+	// ------------- Path parameter "id" -------------
+	var id TagId
 
-type GetFileDownloadURLRequestObject struct {
-	Key string `json:"key"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-type GetFileDownloadURLResponseObject interface {
-	VisitGetFileDownloadURLResponse(ctx *fiber.Ctx) error
-}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-type GetFileDownloadURL200JSONResponse FileDownloadURLResponse
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-func (response GetFileDownloadURL200JSONResponse) VisitGetFileDownloadURLResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	return siw.Handler.GetTag(c, id)
+}
 
-	return ctx.JSON(&response)
-}
+// UpdateTag operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTag(c *fiber.Ctx) error {
 
-type GetFileDownloadURL401JSONResponse struct{ UnauthorizedJSONResponse }
+	var err error
 
-func (response GetFileDownloadURL401JSONResponse) VisitGetFileDownloadURLResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	// ------------- Path parameter "id" -------------
+	var id TagId
 
-	return ctx.JSON(&response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
 
-type GetFileDownloadURL403JSONResponse Error
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-func (response GetFileDownloadURL403JSONResponse) VisitGetFileDownloadURLResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(403)
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-	return ctx.JSON(&response)
+	return siw.Handler.UpdateTag(c, id)
 }
 
-type GetFileDownloadURL404JSONResponse struct{ NotFoundJSONResponse }
+// UploadFile operation middleware
+func (siw *ServerInterfaceWrapper) UploadFile(c *fiber.Ctx) error {
 
-func (response GetFileDownloadURL404JSONResponse) VisitGetFileDownloadURLResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return ctx.JSON(&response)
-}
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-type ListInvoicesRequestObject struct {
-	Params ListInvoicesParams
+	return siw.Handler.UploadFile(c)
 }
 
-type ListInvoicesResponseObject interface {
-	VisitListInvoicesResponse(ctx *fiber.Ctx) error
-}
+// ConfirmPresignedUpload operation middleware
+func (siw *ServerInterfaceWrapper) ConfirmPresignedUpload(c *fiber.Ctx) error {
 
-type ListInvoices200JSONResponse InvoiceListResponse
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-func (response ListInvoices200JSONResponse) VisitListInvoicesResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-	return ctx.JSON(&response)
+	return siw.Handler.ConfirmPresignedUpload(c)
 }
 
-type ListInvoices401JSONResponse struct{ UnauthorizedJSONResponse }
+// UploadHtmlToPdf operation middleware
+func (siw *ServerInterfaceWrapper) UploadHtmlToPdf(c *fiber.Ctx) error {
 
-func (response ListInvoices401JSONResponse) VisitListInvoicesResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return ctx.JSON(&response)
-}
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-type CreateInvoiceRequestObject struct {
-	Body *CreateInvoiceJSONRequestBody
+	return siw.Handler.UploadHtmlToPdf(c)
 }
 
-type CreateInvoiceResponseObject interface {
-	VisitCreateInvoiceResponse(ctx *fiber.Ctx) error
-}
+// GetPresignedURL operation middleware
+func (siw *ServerInterfaceWrapper) GetPresignedURL(c *fiber.Ctx) error {
 
-type CreateInvoice201JSONResponse Invoice
+	var err error
 
-func (response CreateInvoice201JSONResponse) VisitCreateInvoiceResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(201)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return ctx.JSON(&response)
-}
+	c.Context().SetUserValue(OAuth2Scopes, []string{"invoices:read", "invoices:write", "read:categories", "write:categories", "read:companies", "write:companies", "read:receivers", "write:receivers"})
 
-type CreateInvoice400JSONResponse struct{ BadRequestJSONResponse }
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPresignedURLParams
 
-func (response CreateInvoice400JSONResponse) VisitCreateInvoiceResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
 
-	return ctx.JSON(&response)
-}
+	// ------------- Required query parameter "filename" -------------
 
-type CreateInvoice401JSONResponse struct{ UnauthorizedJSONResponse }
+	if paramValue := c.Query("filename"); paramValue != "" {
 
-func (response CreateInvoice401JSONResponse) VisitCreateInvoiceResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	} else {
+		err = fmt.Errorf("Query argument filename is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
 
-	return ctx.JSON(&response)
-}
+	err = runtime.BindQueryParameter("form", true, true, "filename", query, &params.Filename)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter filename: %w", err).Error())
+	}
 
-type DeleteInvoiceRequestObject struct {
-	Id InvoiceId `json:"id"`
+	// ------------- Optional query parameter "content_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "content_type", query, &params.ContentType)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter content_type: %w", err).Error())
+	}
+
+	return siw.Handler.GetPresignedURL(c, params)
 }
 
-type DeleteInvoiceResponseObject interface {
-	VisitDeleteInvoiceResponse(ctx *fiber.Ctx) error
+// HealthCheck operation middleware
+func (siw *ServerInterfaceWrapper) HealthCheck(c *fiber.Ctx) error {
+
+	return siw.Handler.HealthCheck(c)
 }
 
-type DeleteInvoice204Response struct {
+// FiberServerOptions provides options for the Fiber server.
+type FiberServerOptions struct {
+	BaseURL     string
+	Middlewares []MiddlewareFunc
 }
 
-func (response DeleteInvoice204Response) VisitDeleteInvoiceResponse(ctx *fiber.Ctx) error {
-	ctx.Status(204)
-	return nil
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router fiber.Router, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, FiberServerOptions{})
 }
 
-type DeleteInvoice401JSONResponse struct{ UnauthorizedJSONResponse }
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, options FiberServerOptions) {
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
 
-func (response DeleteInvoice401JSONResponse) VisitDeleteInvoiceResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	for _, m := range options.Middlewares {
+		router.Use(fiber.Handler(m))
+	}
 
-	return ctx.JSON(&response)
-}
+	router.Get(options.BaseURL+"/api/analytics/by-category", wrapper.GetAnalyticsByCategory)
 
-type DeleteInvoice404JSONResponse struct{ NotFoundJSONResponse }
+	router.Get(options.BaseURL+"/api/analytics/by-company", wrapper.GetAnalyticsByCompany)
 
-func (response DeleteInvoice404JSONResponse) VisitDeleteInvoiceResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	router.Get(options.BaseURL+"/api/analytics/by-receiver", wrapper.GetAnalyticsByReceiver)
 
-	return ctx.JSON(&response)
-}
+	router.Get(options.BaseURL+"/api/analytics/by-tag", wrapper.GetAnalyticsByTag)
 
-type GetInvoiceRequestObject struct {
-	Id InvoiceId `json:"id"`
-}
+	router.Get(options.BaseURL+"/api/analytics/summary", wrapper.GetAnalyticsSummary)
 
-type GetInvoiceResponseObject interface {
-	VisitGetInvoiceResponse(ctx *fiber.Ctx) error
-}
+	router.Get(options.BaseURL+"/api/analytics/trend", wrapper.GetAnalyticsTrend)
 
-type GetInvoice200JSONResponse Invoice
+	router.Get(options.BaseURL+"/api/budgets", wrapper.ListBudgets)
 
-func (response GetInvoice200JSONResponse) VisitGetInvoiceResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	router.Post(options.BaseURL+"/api/budgets", wrapper.CreateBudget)
 
-	return ctx.JSON(&response)
-}
+	router.Get(options.BaseURL+"/api/budgets/status", wrapper.GetBudgetStatus)
 
-type GetInvoice401JSONResponse struct{ UnauthorizedJSONResponse }
+	router.Delete(options.BaseURL+"/api/budgets/:id", wrapper.DeleteBudget)
 
-func (response GetInvoice401JSONResponse) VisitGetInvoiceResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	router.Get(options.BaseURL+"/api/categories", wrapper.ListCategories)
 
-	return ctx.JSON(&response)
-}
+	router.Post(options.BaseURL+"/api/categories", wrapper.CreateCategory)
 
-type GetInvoice404JSONResponse struct{ NotFoundJSONResponse }
+	router.Delete(options.BaseURL+"/api/categories/:id", wrapper.DeleteCategory)
 
-func (response GetInvoice404JSONResponse) VisitGetInvoiceResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	router.Get(options.BaseURL+"/api/categories/:id", wrapper.GetCategory)
 
-	return ctx.JSON(&response)
-}
+	router.Put(options.BaseURL+"/api/categories/:id", wrapper.UpdateCategory)
 
-type UpdateInvoiceRequestObject struct {
-	Id   InvoiceId `json:"id"`
-	Body *UpdateInvoiceJSONRequestBody
-}
+	router.Get(options.BaseURL+"/api/companies", wrapper.ListCompanies)
 
-type UpdateInvoiceResponseObject interface {
-	VisitUpdateInvoiceResponse(ctx *fiber.Ctx) error
-}
+	router.Post(options.BaseURL+"/api/companies", wrapper.CreateCompany)
 
-type UpdateInvoice200JSONResponse Invoice
+	router.Delete(options.BaseURL+"/api/companies/:id", wrapper.DeleteCompany)
 
-func (response UpdateInvoice200JSONResponse) VisitUpdateInvoiceResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	router.Get(options.BaseURL+"/api/companies/:id", wrapper.GetCompany)
 
-	return ctx.JSON(&response)
-}
+	router.Put(options.BaseURL+"/api/companies/:id", wrapper.UpdateCompany)
 
-type UpdateInvoice400JSONResponse struct{ BadRequestJSONResponse }
+	router.Get(options.BaseURL+"/api/companies/:id/breakdown", wrapper.GetCompanyBreakdown)
 
-func (response UpdateInvoice400JSONResponse) VisitUpdateInvoiceResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	router.Get(options.BaseURL+"/api/dashboard", wrapper.GetDashboard)
 
-	return ctx.JSON(&response)
-}
+	router.Get(options.BaseURL+"/api/export", wrapper.ExportData)
 
-type UpdateInvoice401JSONResponse struct{ UnauthorizedJSONResponse }
+	router.Post(options.BaseURL+"/api/exports", wrapper.RequestExport)
 
-func (response UpdateInvoice401JSONResponse) VisitUpdateInvoiceResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	router.Get(options.BaseURL+"/api/exports/:id", wrapper.GetExportJob)
 
-	return ctx.JSON(&response)
-}
+	router.Get(options.BaseURL+"/api/files/:key/download", wrapper.GetFileDownloadURL)
 
-type AddInvoiceItemRequestObject struct {
-	Id   InvoiceId `json:"id"`
-	Body *AddInvoiceItemJSONRequestBody
-}
+	router.Post(options.BaseURL+"/api/import", wrapper.ImportData)
 
-type AddInvoiceItemResponseObject interface {
-	VisitAddInvoiceItemResponse(ctx *fiber.Ctx) error
-}
+	router.Get(options.BaseURL+"/api/invoices", wrapper.ListInvoices)
 
-type AddInvoiceItem201JSONResponse InvoiceItem
+	router.Post(options.BaseURL+"/api/invoices", wrapper.CreateInvoice)
 
-func (response AddInvoiceItem201JSONResponse) VisitAddInvoiceItemResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(201)
+	router.Get(options.BaseURL+"/api/invoices/facets", wrapper.GetInvoiceFacets)
 
-	return ctx.JSON(&response)
-}
+	router.Post(options.BaseURL+"/api/invoices/import", wrapper.ImportInvoices)
 
-type AddInvoiceItem400JSONResponse struct{ BadRequestJSONResponse }
+	router.Get(options.BaseURL+"/api/invoices/lookup", wrapper.LookupInvoices)
 
-func (response AddInvoiceItem400JSONResponse) VisitAddInvoiceItemResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	router.Get(options.BaseURL+"/api/invoices/status-counts", wrapper.GetInvoiceStatusCounts)
 
-	return ctx.JSON(&response)
-}
+	router.Delete(options.BaseURL+"/api/invoices/:id", wrapper.DeleteInvoice)
 
-type AddInvoiceItem401JSONResponse struct{ UnauthorizedJSONResponse }
+	router.Get(options.BaseURL+"/api/invoices/:id", wrapper.GetInvoice)
 
-func (response AddInvoiceItem401JSONResponse) VisitAddInvoiceItemResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	router.Put(options.BaseURL+"/api/invoices/:id", wrapper.UpdateInvoice)
 
-	return ctx.JSON(&response)
-}
+	router.Get(options.BaseURL+"/api/invoices/:id/attachments", wrapper.ListInvoiceAttachments)
 
-type UpdateInvoiceStatusRequestObject struct {
-	Id   InvoiceId `json:"id"`
-	Body *UpdateInvoiceStatusJSONRequestBody
-}
+	router.Post(options.BaseURL+"/api/invoices/:id/attachments", wrapper.AddInvoiceAttachment)
 
-type UpdateInvoiceStatusResponseObject interface {
-	VisitUpdateInvoiceStatusResponse(ctx *fiber.Ctx) error
-}
+	router.Delete(options.BaseURL+"/api/invoices/:id/attachments/:attachmentId", wrapper.RemoveInvoiceAttachment)
 
-type UpdateInvoiceStatus200JSONResponse Invoice
+	router.Get(options.BaseURL+"/api/invoices/:id/audit", wrapper.GetInvoiceAuditTrail)
 
-func (response UpdateInvoiceStatus200JSONResponse) VisitUpdateInvoiceStatusResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	router.Post(options.BaseURL+"/api/invoices/:id/clone", wrapper.CloneInvoice)
 
-	return ctx.JSON(&response)
-}
+	router.Post(options.BaseURL+"/api/invoices/:id/convert/preview", wrapper.PreviewCurrencyConversion)
 
-type UpdateInvoiceStatus400JSONResponse struct{ BadRequestJSONResponse }
+	router.Post(options.BaseURL+"/api/invoices/:id/finalize", wrapper.FinalizeInvoice)
 
-func (response UpdateInvoiceStatus400JSONResponse) VisitUpdateInvoiceStatusResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	router.Post(options.BaseURL+"/api/invoices/:id/items", wrapper.AddInvoiceItem)
+
+	router.Post(options.BaseURL+"/api/invoices/:id/items/batch", wrapper.AddInvoiceItems)
+
+	router.Put(options.BaseURL+"/api/invoices/:id/items/order", wrapper.ReorderInvoiceItems)
+
+	router.Post(options.BaseURL+"/api/invoices/:id/recalculate", wrapper.RecalculateInvoiceTotals)
+
+	router.Get(options.BaseURL+"/api/invoices/:id/similar", wrapper.FindSimilarInvoices)
+
+	router.Patch(options.BaseURL+"/api/invoices/:id/status", wrapper.UpdateInvoiceStatus)
+
+	router.Get(options.BaseURL+"/api/invoices/:id/status-history", wrapper.GetInvoiceStatusHistory)
+
+	router.Post(options.BaseURL+"/api/invoices/:id/tags", wrapper.AddTagToInvoice)
+
+	router.Delete(options.BaseURL+"/api/invoices/:id/tags/:tagId", wrapper.RemoveTagFromInvoice)
+
+	router.Delete(options.BaseURL+"/api/invoices/:invoice_id/items/:item_id", wrapper.DeleteInvoiceItem)
+
+	router.Put(options.BaseURL+"/api/invoices/:invoice_id/items/:item_id", wrapper.UpdateInvoiceItem)
+
+	router.Get(options.BaseURL+"/api/organizations", wrapper.ListOrganizations)
+
+	router.Post(options.BaseURL+"/api/organizations", wrapper.CreateOrganization)
+
+	router.Get(options.BaseURL+"/api/organizations/:id/members", wrapper.ListOrganizationMembers)
+
+	router.Delete(options.BaseURL+"/api/organizations/:id/members/:user_id", wrapper.RemoveOrganizationMember)
+
+	router.Put(options.BaseURL+"/api/organizations/:id/members/:user_id", wrapper.SetOrganizationMember)
+
+	router.Get(options.BaseURL+"/api/payment-methods", wrapper.ListPaymentMethods)
+
+	router.Get(options.BaseURL+"/api/receivers", wrapper.ListReceivers)
+
+	router.Post(options.BaseURL+"/api/receivers", wrapper.CreateReceiver)
+
+	router.Post(options.BaseURL+"/api/receivers/merge", wrapper.MergeReceivers)
+
+	router.Delete(options.BaseURL+"/api/receivers/:id", wrapper.DeleteReceiver)
+
+	router.Get(options.BaseURL+"/api/receivers/:id", wrapper.GetReceiver)
+
+	router.Put(options.BaseURL+"/api/receivers/:id", wrapper.UpdateReceiver)
+
+	router.Get(options.BaseURL+"/api/receivers/:id/statement", wrapper.GetReceiverStatement)
+
+	router.Get(options.BaseURL+"/api/receivers/:id/statistics", wrapper.GetReceiverStatistics)
+
+	router.Get(options.BaseURL+"/api/settings", wrapper.GetSettings)
+
+	router.Put(options.BaseURL+"/api/settings", wrapper.UpdateSettings)
+
+	router.Get(options.BaseURL+"/api/tags", wrapper.ListTags)
+
+	router.Post(options.BaseURL+"/api/tags", wrapper.CreateTag)
+
+	router.Delete(options.BaseURL+"/api/tags/:id", wrapper.DeleteTag)
+
+	router.Get(options.BaseURL+"/api/tags/:id", wrapper.GetTag)
+
+	router.Put(options.BaseURL+"/api/tags/:id", wrapper.UpdateTag)
+
+	router.Post(options.BaseURL+"/api/upload", wrapper.UploadFile)
+
+	router.Post(options.BaseURL+"/api/upload/confirm", wrapper.ConfirmPresignedUpload)
+
+	router.Post(options.BaseURL+"/api/upload/html-to-pdf", wrapper.UploadHtmlToPdf)
+
+	router.Get(options.BaseURL+"/api/upload/presigned", wrapper.GetPresignedURL)
+
+	router.Get(options.BaseURL+"/health", wrapper.HealthCheck)
 
-	return ctx.JSON(&response)
 }
 
-type UpdateInvoiceStatus401JSONResponse struct{ UnauthorizedJSONResponse }
+type BadRequestJSONResponse Error
 
-func (response UpdateInvoiceStatus401JSONResponse) VisitUpdateInvoiceStatusResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+type InUseJSONResponse InUseError
 
-	return ctx.JSON(&response)
+type NotFoundJSONResponse Error
+
+type NotModifiedResponse struct {
 }
 
-type AddTagToInvoiceRequestObject struct {
-	Id   InvoiceId `json:"id"`
-	Body *AddTagToInvoiceJSONRequestBody
+type UnauthorizedJSONResponse Error
+
+type GetAnalyticsByCategoryRequestObject struct {
+	Params GetAnalyticsByCategoryParams
 }
 
-type AddTagToInvoiceResponseObject interface {
-	VisitAddTagToInvoiceResponse(ctx *fiber.Ctx) error
+type GetAnalyticsByCategoryResponseObject interface {
+	VisitGetAnalyticsByCategoryResponse(ctx *fiber.Ctx) error
 }
 
-type AddTagToInvoice200JSONResponse Invoice
+type GetAnalyticsByCategory200JSONResponse AnalyticsByGroup
 
-func (response AddTagToInvoice200JSONResponse) VisitAddTagToInvoiceResponse(ctx *fiber.Ctx) error {
+func (response GetAnalyticsByCategory200JSONResponse) VisitGetAnalyticsByCategoryResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(200)
 
 	return ctx.JSON(&response)
 }
 
-type AddTagToInvoice400JSONResponse struct{ BadRequestJSONResponse }
+type GetAnalyticsByCategory401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response AddTagToInvoice400JSONResponse) VisitAddTagToInvoiceResponse(ctx *fiber.Ctx) error {
+func (response GetAnalyticsByCategory401JSONResponse) VisitGetAnalyticsByCategoryResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	ctx.Status(401)
 
 	return ctx.JSON(&response)
 }
 
-type AddTagToInvoice401JSONResponse struct{ UnauthorizedJSONResponse }
+type GetAnalyticsByCompanyRequestObject struct {
+	Params GetAnalyticsByCompanyParams
+}
 
-func (response AddTagToInvoice401JSONResponse) VisitAddTagToInvoiceResponse(ctx *fiber.Ctx) error {
+type GetAnalyticsByCompanyResponseObject interface {
+	VisitGetAnalyticsByCompanyResponse(ctx *fiber.Ctx) error
+}
+
+type GetAnalyticsByCompany200JSONResponse AnalyticsByGroup
+
+func (response GetAnalyticsByCompany200JSONResponse) VisitGetAnalyticsByCompanyResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	ctx.Status(200)
 
 	return ctx.JSON(&response)
 }
 
-type AddTagToInvoice404JSONResponse struct{ NotFoundJSONResponse }
+type GetAnalyticsByCompany401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response AddTagToInvoice404JSONResponse) VisitAddTagToInvoiceResponse(ctx *fiber.Ctx) error {
+func (response GetAnalyticsByCompany401JSONResponse) VisitGetAnalyticsByCompanyResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	ctx.Status(401)
 
 	return ctx.JSON(&response)
 }
 
-type RemoveTagFromInvoiceRequestObject struct {
-	Id    InvoiceId `json:"id"`
-	TagId int       `json:"tagId"`
+type GetAnalyticsByReceiverRequestObject struct {
+	Params GetAnalyticsByReceiverParams
 }
 
-type RemoveTagFromInvoiceResponseObject interface {
-	VisitRemoveTagFromInvoiceResponse(ctx *fiber.Ctx) error
+type GetAnalyticsByReceiverResponseObject interface {
+	VisitGetAnalyticsByReceiverResponse(ctx *fiber.Ctx) error
 }
 
-type RemoveTagFromInvoice200JSONResponse Invoice
+type GetAnalyticsByReceiver200JSONResponse AnalyticsByGroup
 
-func (response RemoveTagFromInvoice200JSONResponse) VisitRemoveTagFromInvoiceResponse(ctx *fiber.Ctx) error {
+func (response GetAnalyticsByReceiver200JSONResponse) VisitGetAnalyticsByReceiverResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(200)
 
 	return ctx.JSON(&response)
 }
 
-type RemoveTagFromInvoice401JSONResponse struct{ UnauthorizedJSONResponse }
+type GetAnalyticsByReceiver401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response RemoveTagFromInvoice401JSONResponse) VisitRemoveTagFromInvoiceResponse(ctx *fiber.Ctx) error {
+func (response GetAnalyticsByReceiver401JSONResponse) VisitGetAnalyticsByReceiverResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(401)
 
 	return ctx.JSON(&response)
 }
 
-type RemoveTagFromInvoice404JSONResponse struct{ NotFoundJSONResponse }
+type GetAnalyticsByTagRequestObject struct {
+	Params GetAnalyticsByTagParams
+}
 
-func (response RemoveTagFromInvoice404JSONResponse) VisitRemoveTagFromInvoiceResponse(ctx *fiber.Ctx) error {
+type GetAnalyticsByTagResponseObject interface {
+	VisitGetAnalyticsByTagResponse(ctx *fiber.Ctx) error
+}
+
+type GetAnalyticsByTag200JSONResponse AnalyticsByGroup
+
+func (response GetAnalyticsByTag200JSONResponse) VisitGetAnalyticsByTagResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	ctx.Status(200)
 
 	return ctx.JSON(&response)
 }
 
-type DeleteInvoiceItemRequestObject struct {
-	InvoiceId int `json:"invoice_id"`
-	ItemId    int `json:"item_id"`
+type GetAnalyticsByTag401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetAnalyticsByTag401JSONResponse) VisitGetAnalyticsByTagResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
 }
 
-type DeleteInvoiceItemResponseObject interface {
-	VisitDeleteInvoiceItemResponse(ctx *fiber.Ctx) error
+type GetAnalyticsSummaryRequestObject struct {
+	Params GetAnalyticsSummaryParams
 }
 
-type DeleteInvoiceItem204Response struct {
+type GetAnalyticsSummaryResponseObject interface {
+	VisitGetAnalyticsSummaryResponse(ctx *fiber.Ctx) error
 }
 
-func (response DeleteInvoiceItem204Response) VisitDeleteInvoiceItemResponse(ctx *fiber.Ctx) error {
-	ctx.Status(204)
-	return nil
+type GetAnalyticsSummary200JSONResponse AnalyticsSummary
+
+func (response GetAnalyticsSummary200JSONResponse) VisitGetAnalyticsSummaryResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
 }
 
-type DeleteInvoiceItem401JSONResponse struct{ UnauthorizedJSONResponse }
+type GetAnalyticsSummary401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response DeleteInvoiceItem401JSONResponse) VisitDeleteInvoiceItemResponse(ctx *fiber.Ctx) error {
+func (response GetAnalyticsSummary401JSONResponse) VisitGetAnalyticsSummaryResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(401)
 
 	return ctx.JSON(&response)
 }
 
-type UpdateInvoiceItemRequestObject struct {
-	InvoiceId int `json:"invoice_id"`
-	ItemId    int `json:"item_id"`
-	Body      *UpdateInvoiceItemJSONRequestBody
+type GetAnalyticsTrendRequestObject struct {
+	Params GetAnalyticsTrendParams
 }
 
-type UpdateInvoiceItemResponseObject interface {
-	VisitUpdateInvoiceItemResponse(ctx *fiber.Ctx) error
+type GetAnalyticsTrendResponseObject interface {
+	VisitGetAnalyticsTrendResponse(ctx *fiber.Ctx) error
 }
 
-type UpdateInvoiceItem200JSONResponse InvoiceItem
+type GetAnalyticsTrend200JSONResponse MonthlyTrend
 
-func (response UpdateInvoiceItem200JSONResponse) VisitUpdateInvoiceItemResponse(ctx *fiber.Ctx) error {
+func (response GetAnalyticsTrend200JSONResponse) VisitGetAnalyticsTrendResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(200)
 
 	return ctx.JSON(&response)
 }
 
-type UpdateInvoiceItem400JSONResponse struct{ BadRequestJSONResponse }
+type GetAnalyticsTrend400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response UpdateInvoiceItem400JSONResponse) VisitUpdateInvoiceItemResponse(ctx *fiber.Ctx) error {
+func (response GetAnalyticsTrend400JSONResponse) VisitGetAnalyticsTrendResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(400)
 
 	return ctx.JSON(&response)
 }
 
-type UpdateInvoiceItem401JSONResponse struct{ UnauthorizedJSONResponse }
+type GetAnalyticsTrend401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response UpdateInvoiceItem401JSONResponse) VisitUpdateInvoiceItemResponse(ctx *fiber.Ctx) error {
+func (response GetAnalyticsTrend401JSONResponse) VisitGetAnalyticsTrendResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(401)
 
 	return ctx.JSON(&response)
 }
 
-type ListReceiversRequestObject struct {
-	Params ListReceiversParams
+type ListBudgetsRequestObject struct {
 }
 
-type ListReceiversResponseObject interface {
-	VisitListReceiversResponse(ctx *fiber.Ctx) error
+type ListBudgetsResponseObject interface {
+	VisitListBudgetsResponse(ctx *fiber.Ctx) error
 }
 
-type ListReceivers200JSONResponse ReceiverListResponse
+type ListBudgets200JSONResponse BudgetListResponse
 
-func (response ListReceivers200JSONResponse) VisitListReceiversResponse(ctx *fiber.Ctx) error {
+func (response ListBudgets200JSONResponse) VisitListBudgetsResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(200)
 
 	return ctx.JSON(&response)
 }
 
-type ListReceivers401JSONResponse struct{ UnauthorizedJSONResponse }
+type ListBudgets401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response ListReceivers401JSONResponse) VisitListReceiversResponse(ctx *fiber.Ctx) error {
+func (response ListBudgets401JSONResponse) VisitListBudgetsResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(401)
 
 	return ctx.JSON(&response)
 }
 
-type CreateReceiverRequestObject struct {
-	Body *CreateReceiverJSONRequestBody
+type CreateBudgetRequestObject struct {
+	Body *CreateBudgetJSONRequestBody
 }
 
-type CreateReceiverResponseObject interface {
-	VisitCreateReceiverResponse(ctx *fiber.Ctx) error
+type CreateBudgetResponseObject interface {
+	VisitCreateBudgetResponse(ctx *fiber.Ctx) error
 }
 
-type CreateReceiver201JSONResponse Receiver
+type CreateBudget201JSONResponse Budget
 
-func (response CreateReceiver201JSONResponse) VisitCreateReceiverResponse(ctx *fiber.Ctx) error {
+func (response CreateBudget201JSONResponse) VisitCreateBudgetResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(201)
 
 	return ctx.JSON(&response)
 }
 
-type CreateReceiver400JSONResponse struct{ BadRequestJSONResponse }
+type CreateBudget400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response CreateReceiver400JSONResponse) VisitCreateReceiverResponse(ctx *fiber.Ctx) error {
+func (response CreateBudget400JSONResponse) VisitCreateBudgetResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(400)
 
 	return ctx.JSON(&response)
 }
 
-type CreateReceiver401JSONResponse struct{ UnauthorizedJSONResponse }
+type CreateBudget401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response CreateReceiver401JSONResponse) VisitCreateReceiverResponse(ctx *fiber.Ctx) error {
+func (response CreateBudget401JSONResponse) VisitCreateBudgetResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(401)
 
 	return ctx.JSON(&response)
 }
 
-type MergeReceiversRequestObject struct {
-	Body *MergeReceiversJSONRequestBody
+type GetBudgetStatusRequestObject struct {
+	Params GetBudgetStatusParams
 }
 
-type MergeReceiversResponseObject interface {
-	VisitMergeReceiversResponse(ctx *fiber.Ctx) error
+type GetBudgetStatusResponseObject interface {
+	VisitGetBudgetStatusResponse(ctx *fiber.Ctx) error
 }
 
-type MergeReceivers200JSONResponse MergeReceiversResult
+type GetBudgetStatus200JSONResponse BudgetStatusResponse
 
-func (response MergeReceivers200JSONResponse) VisitMergeReceiversResponse(ctx *fiber.Ctx) error {
+func (response GetBudgetStatus200JSONResponse) VisitGetBudgetStatusResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(200)
 
 	return ctx.JSON(&response)
 }
 
-type MergeReceivers400JSONResponse struct{ BadRequestJSONResponse }
-
-func (response MergeReceivers400JSONResponse) VisitMergeReceiversResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
-
-	return ctx.JSON(&response)
-}
-
-type MergeReceivers401JSONResponse struct{ UnauthorizedJSONResponse }
+type GetBudgetStatus401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response MergeReceivers401JSONResponse) VisitMergeReceiversResponse(ctx *fiber.Ctx) error {
+func (response GetBudgetStatus401JSONResponse) VisitGetBudgetStatusResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(401)
 
 	return ctx.JSON(&response)
 }
 
-type MergeReceivers404JSONResponse struct{ NotFoundJSONResponse }
-
-func (response MergeReceivers404JSONResponse) VisitMergeReceiversResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
-
-	return ctx.JSON(&response)
-}
-
-type DeleteReceiverRequestObject struct {
-	Id ReceiverId `json:"id"`
+type DeleteBudgetRequestObject struct {
+	Id int `json:"id"`
 }
 
-type DeleteReceiverResponseObject interface {
-	VisitDeleteReceiverResponse(ctx *fiber.Ctx) error
+type DeleteBudgetResponseObject interface {
+	VisitDeleteBudgetResponse(ctx *fiber.Ctx) error
 }
 
-type DeleteReceiver204Response struct {
+type DeleteBudget204Response struct {
 }
 
-func (response DeleteReceiver204Response) VisitDeleteReceiverResponse(ctx *fiber.Ctx) error {
+func (response DeleteBudget204Response) VisitDeleteBudgetResponse(ctx *fiber.Ctx) error {
 	ctx.Status(204)
 	return nil
 }
 
-type DeleteReceiver401JSONResponse struct{ UnauthorizedJSONResponse }
+type DeleteBudget401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response DeleteReceiver401JSONResponse) VisitDeleteReceiverResponse(ctx *fiber.Ctx) error {
+func (response DeleteBudget401JSONResponse) VisitDeleteBudgetResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(401)
 
 	return ctx.JSON(&response)
 }
 
-type DeleteReceiver404JSONResponse struct{ NotFoundJSONResponse }
+type DeleteBudget404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response DeleteReceiver404JSONResponse) VisitDeleteReceiverResponse(ctx *fiber.Ctx) error {
+func (response DeleteBudget404JSONResponse) VisitDeleteBudgetResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(404)
 
 	return ctx.JSON(&response)
 }
 
-type GetReceiverRequestObject struct {
-	Id ReceiverId `json:"id"`
+type ListCategoriesRequestObject struct {
+	Params ListCategoriesParams
 }
 
-type GetReceiverResponseObject interface {
-	VisitGetReceiverResponse(ctx *fiber.Ctx) error
+type ListCategoriesResponseObject interface {
+	VisitListCategoriesResponse(ctx *fiber.Ctx) error
 }
 
-type GetReceiver200JSONResponse Receiver
+type ListCategories200JSONResponse CategoryListResponse
 
-func (response GetReceiver200JSONResponse) VisitGetReceiverResponse(ctx *fiber.Ctx) error {
+func (response ListCategories200JSONResponse) VisitListCategoriesResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(200)
 
 	return ctx.JSON(&response)
 }
 
-type GetReceiver401JSONResponse struct{ UnauthorizedJSONResponse }
+type ListCategories401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetReceiver401JSONResponse) VisitGetReceiverResponse(ctx *fiber.Ctx) error {
+func (response ListCategories401JSONResponse) VisitListCategoriesResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(401)
 
 	return ctx.JSON(&response)
 }
 
-type GetReceiver404JSONResponse struct{ NotFoundJSONResponse }
-
-func (response GetReceiver404JSONResponse) VisitGetReceiverResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
-
-	return ctx.JSON(&response)
-}
-
-type UpdateReceiverRequestObject struct {
-	Id   ReceiverId `json:"id"`
-	Body *UpdateReceiverJSONRequestBody
+type CreateCategoryRequestObject struct {
+	Body *CreateCategoryJSONRequestBody
 }
 
-type UpdateReceiverResponseObject interface {
-	VisitUpdateReceiverResponse(ctx *fiber.Ctx) error
+type CreateCategoryResponseObject interface {
+	VisitCreateCategoryResponse(ctx *fiber.Ctx) error
 }
 
-type UpdateReceiver200JSONResponse Receiver
+type CreateCategory201JSONResponse Category
 
-func (response UpdateReceiver200JSONResponse) VisitUpdateReceiverResponse(ctx *fiber.Ctx) error {
+func (response CreateCategory201JSONResponse) VisitCreateCategoryResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	ctx.Status(201)
 
 	return ctx.JSON(&response)
 }
 
-type UpdateReceiver400JSONResponse struct{ BadRequestJSONResponse }
+type CreateCategory400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response UpdateReceiver400JSONResponse) VisitUpdateReceiverResponse(ctx *fiber.Ctx) error {
+func (response CreateCategory400JSONResponse) VisitCreateCategoryResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(400)
 
 	return ctx.JSON(&response)
 }
 
-type UpdateReceiver401JSONResponse struct{ UnauthorizedJSONResponse }
+type CreateCategory401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response UpdateReceiver401JSONResponse) VisitUpdateReceiverResponse(ctx *fiber.Ctx) error {
+func (response CreateCategory401JSONResponse) VisitCreateCategoryResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(401)
 
 	return ctx.JSON(&response)
 }
 
-type ListTagsRequestObject struct {
-	Params ListTagsParams
+type DeleteCategoryRequestObject struct {
+	Id     CategoryId `json:"id"`
+	Params DeleteCategoryParams
 }
 
-type ListTagsResponseObject interface {
-	VisitListTagsResponse(ctx *fiber.Ctx) error
+type DeleteCategoryResponseObject interface {
+	VisitDeleteCategoryResponse(ctx *fiber.Ctx) error
 }
 
-type ListTags200JSONResponse TagListResponse
-
-func (response ListTags200JSONResponse) VisitListTagsResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+type DeleteCategory204Response struct {
+}
 
-	return ctx.JSON(&response)
+func (response DeleteCategory204Response) VisitDeleteCategoryResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
 }
 
-type ListTags401JSONResponse struct{ UnauthorizedJSONResponse }
+type DeleteCategory401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response ListTags401JSONResponse) VisitListTagsResponse(ctx *fiber.Ctx) error {
+func (response DeleteCategory401JSONResponse) VisitDeleteCategoryResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(401)
 
 	return ctx.JSON(&response)
 }
 
-type CreateTagRequestObject struct {
-	Body *CreateTagJSONRequestBody
-}
-
-type CreateTagResponseObject interface {
-	VisitCreateTagResponse(ctx *fiber.Ctx) error
-}
-
-type CreateTag201JSONResponse Tag
+type DeleteCategory404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response CreateTag201JSONResponse) VisitCreateTagResponse(ctx *fiber.Ctx) error {
+func (response DeleteCategory404JSONResponse) VisitDeleteCategoryResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(201)
+	ctx.Status(404)
 
 	return ctx.JSON(&response)
 }
 
-type CreateTag400JSONResponse struct{ BadRequestJSONResponse }
+type DeleteCategory409JSONResponse struct{ InUseJSONResponse }
 
-func (response CreateTag400JSONResponse) VisitCreateTagResponse(ctx *fiber.Ctx) error {
+func (response DeleteCategory409JSONResponse) VisitDeleteCategoryResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	ctx.Status(409)
 
 	return ctx.JSON(&response)
 }
 
-type CreateTag401JSONResponse struct{ UnauthorizedJSONResponse }
-
-func (response CreateTag401JSONResponse) VisitCreateTagResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
-
-	return ctx.JSON(&response)
+type GetCategoryRequestObject struct {
+	Id CategoryId `json:"id"`
 }
 
-type DeleteTagRequestObject struct {
-	Id TagId `json:"id"`
+type GetCategoryResponseObject interface {
+	VisitGetCategoryResponse(ctx *fiber.Ctx) error
 }
 
-type DeleteTagResponseObject interface {
-	VisitDeleteTagResponse(ctx *fiber.Ctx) error
-}
+type GetCategory200JSONResponse Category
 
-type DeleteTag204Response struct {
-}
+func (response GetCategory200JSONResponse) VisitGetCategoryResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
 
-func (response DeleteTag204Response) VisitDeleteTagResponse(ctx *fiber.Ctx) error {
-	ctx.Status(204)
-	return nil
+	return ctx.JSON(&response)
 }
 
-type DeleteTag401JSONResponse struct{ UnauthorizedJSONResponse }
+type GetCategory401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response DeleteTag401JSONResponse) VisitDeleteTagResponse(ctx *fiber.Ctx) error {
+func (response GetCategory401JSONResponse) VisitGetCategoryResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(401)
 
 	return ctx.JSON(&response)
 }
 
-type DeleteTag404JSONResponse struct{ NotFoundJSONResponse }
+type GetCategory404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response DeleteTag404JSONResponse) VisitDeleteTagResponse(ctx *fiber.Ctx) error {
+func (response GetCategory404JSONResponse) VisitGetCategoryResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(404)
 
 	return ctx.JSON(&response)
 }
 
-type GetTagRequestObject struct {
-	Id TagId `json:"id"`
+type UpdateCategoryRequestObject struct {
+	Id   CategoryId `json:"id"`
+	Body *UpdateCategoryJSONRequestBody
 }
 
-type GetTagResponseObject interface {
-	VisitGetTagResponse(ctx *fiber.Ctx) error
+type UpdateCategoryResponseObject interface {
+	VisitUpdateCategoryResponse(ctx *fiber.Ctx) error
 }
 
-type GetTag200JSONResponse Tag
+type UpdateCategory200JSONResponse Category
 
-func (response GetTag200JSONResponse) VisitGetTagResponse(ctx *fiber.Ctx) error {
+func (response UpdateCategory200JSONResponse) VisitUpdateCategoryResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(200)
 
 	return ctx.JSON(&response)
 }
 
-type GetTag401JSONResponse struct{ UnauthorizedJSONResponse }
+type UpdateCategory400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetTag401JSONResponse) VisitGetTagResponse(ctx *fiber.Ctx) error {
+func (response UpdateCategory400JSONResponse) VisitUpdateCategoryResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	ctx.Status(400)
 
 	return ctx.JSON(&response)
 }
 
-type GetTag404JSONResponse struct{ NotFoundJSONResponse }
+type UpdateCategory401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetTag404JSONResponse) VisitGetTagResponse(ctx *fiber.Ctx) error {
+func (response UpdateCategory401JSONResponse) VisitUpdateCategoryResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	ctx.Status(401)
 
 	return ctx.JSON(&response)
 }
 
-type UpdateTagRequestObject struct {
-	Id   TagId `json:"id"`
-	Body *UpdateTagJSONRequestBody
+type ListCompaniesRequestObject struct {
+	Params ListCompaniesParams
 }
 
-type UpdateTagResponseObject interface {
-	VisitUpdateTagResponse(ctx *fiber.Ctx) error
+type ListCompaniesResponseObject interface {
+	VisitListCompaniesResponse(ctx *fiber.Ctx) error
 }
 
-type UpdateTag200JSONResponse Tag
+type ListCompanies200JSONResponse CompanyListResponse
 
-func (response UpdateTag200JSONResponse) VisitUpdateTagResponse(ctx *fiber.Ctx) error {
+func (response ListCompanies200JSONResponse) VisitListCompaniesResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(200)
 
 	return ctx.JSON(&response)
 }
 
-type UpdateTag400JSONResponse struct{ BadRequestJSONResponse }
-
-func (response UpdateTag400JSONResponse) VisitUpdateTagResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
-
-	return ctx.JSON(&response)
-}
-
-type UpdateTag401JSONResponse struct{ UnauthorizedJSONResponse }
+type ListCompanies401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response UpdateTag401JSONResponse) VisitUpdateTagResponse(ctx *fiber.Ctx) error {
+func (response ListCompanies401JSONResponse) VisitListCompaniesResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(401)
 
 	return ctx.JSON(&response)
 }
 
-type UploadFileRequestObject struct {
-	Body *multipart.Reader
+type CreateCompanyRequestObject struct {
+	Body *CreateCompanyJSONRequestBody
 }
 
-type UploadFileResponseObject interface {
-	VisitUploadFileResponse(ctx *fiber.Ctx) error
+type CreateCompanyResponseObject interface {
+	VisitCreateCompanyResponse(ctx *fiber.Ctx) error
 }
 
-type UploadFile201JSONResponse UploadResponse
+type CreateCompany201JSONResponse Company
 
-func (response UploadFile201JSONResponse) VisitUploadFileResponse(ctx *fiber.Ctx) error {
+func (response CreateCompany201JSONResponse) VisitCreateCompanyResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(201)
 
 	return ctx.JSON(&response)
 }
 
-type UploadFile400JSONResponse struct{ BadRequestJSONResponse }
+type CreateCompany400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response UploadFile400JSONResponse) VisitUploadFileResponse(ctx *fiber.Ctx) error {
+func (response CreateCompany400JSONResponse) VisitCreateCompanyResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(400)
 
 	return ctx.JSON(&response)
 }
 
-type UploadFile401JSONResponse struct{ UnauthorizedJSONResponse }
+type CreateCompany401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response UploadFile401JSONResponse) VisitUploadFileResponse(ctx *fiber.Ctx) error {
+func (response CreateCompany401JSONResponse) VisitCreateCompanyResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(401)
 
 	return ctx.JSON(&response)
 }
 
-type ConfirmPresignedUploadRequestObject struct {
-	Body *ConfirmPresignedUploadJSONRequestBody
+type DeleteCompanyRequestObject struct {
+	Id     CompanyId `json:"id"`
+	Params DeleteCompanyParams
 }
 
-type ConfirmPresignedUploadResponseObject interface {
-	VisitConfirmPresignedUploadResponse(ctx *fiber.Ctx) error
+type DeleteCompanyResponseObject interface {
+	VisitDeleteCompanyResponse(ctx *fiber.Ctx) error
 }
 
-type ConfirmPresignedUpload201JSONResponse UploadResponse
+type DeleteCompany204Response struct {
+}
 
-func (response ConfirmPresignedUpload201JSONResponse) VisitConfirmPresignedUploadResponse(ctx *fiber.Ctx) error {
+func (response DeleteCompany204Response) VisitDeleteCompanyResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type DeleteCompany401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteCompany401JSONResponse) VisitDeleteCompanyResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(201)
+	ctx.Status(401)
 
 	return ctx.JSON(&response)
 }
 
-type ConfirmPresignedUpload400JSONResponse struct{ BadRequestJSONResponse }
+type DeleteCompany404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response ConfirmPresignedUpload400JSONResponse) VisitConfirmPresignedUploadResponse(ctx *fiber.Ctx) error {
+func (response DeleteCompany404JSONResponse) VisitDeleteCompanyResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	ctx.Status(404)
 
 	return ctx.JSON(&response)
 }
 
-type ConfirmPresignedUpload401JSONResponse struct{ UnauthorizedJSONResponse }
+type DeleteCompany409JSONResponse struct{ InUseJSONResponse }
 
-func (response ConfirmPresignedUpload401JSONResponse) VisitConfirmPresignedUploadResponse(ctx *fiber.Ctx) error {
+func (response DeleteCompany409JSONResponse) VisitDeleteCompanyResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(401)
+	ctx.Status(409)
 
 	return ctx.JSON(&response)
 }
 
-type UploadHtmlToPdfRequestObject struct {
-	Body *UploadHtmlToPdfJSONRequestBody
-}
-
-type UploadHtmlToPdfResponseObject interface {
-	VisitUploadHtmlToPdfResponse(ctx *fiber.Ctx) error
+type GetCompanyRequestObject struct {
+	Id CompanyId `json:"id"`
 }
 
-type UploadHtmlToPdf201JSONResponse UploadResponse
-
-func (response UploadHtmlToPdf201JSONResponse) VisitUploadHtmlToPdfResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(201)
-
-	return ctx.JSON(&response)
+type GetCompanyResponseObject interface {
+	VisitGetCompanyResponse(ctx *fiber.Ctx) error
 }
 
-type UploadHtmlToPdf400JSONResponse struct{ BadRequestJSONResponse }
+type GetCompany200JSONResponse Company
 
-func (response UploadHtmlToPdf400JSONResponse) VisitUploadHtmlToPdfResponse(ctx *fiber.Ctx) error {
+func (response GetCompany200JSONResponse) VisitGetCompanyResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+	ctx.Status(200)
 
 	return ctx.JSON(&response)
 }
 
-type UploadHtmlToPdf401JSONResponse struct{ UnauthorizedJSONResponse }
+type GetCompany401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response UploadHtmlToPdf401JSONResponse) VisitUploadHtmlToPdfResponse(ctx *fiber.Ctx) error {
+func (response GetCompany401JSONResponse) VisitGetCompanyResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(401)
 
 	return ctx.JSON(&response)
 }
 
-type UploadHtmlToPdf500JSONResponse Error
+type GetCompany404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response UploadHtmlToPdf500JSONResponse) VisitUploadHtmlToPdfResponse(ctx *fiber.Ctx) error {
+func (response GetCompany404JSONResponse) VisitGetCompanyResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(500)
+	ctx.Status(404)
 
 	return ctx.JSON(&response)
 }
 
-type GetPresignedURLRequestObject struct {
-	Params GetPresignedURLParams
+type UpdateCompanyRequestObject struct {
+	Id   CompanyId `json:"id"`
+	Body *UpdateCompanyJSONRequestBody
 }
 
-type GetPresignedURLResponseObject interface {
-	VisitGetPresignedURLResponse(ctx *fiber.Ctx) error
+type UpdateCompanyResponseObject interface {
+	VisitUpdateCompanyResponse(ctx *fiber.Ctx) error
 }
 
-type GetPresignedURL200JSONResponse PresignedURLResponse
+type UpdateCompany200JSONResponse Company
 
-func (response GetPresignedURL200JSONResponse) VisitGetPresignedURLResponse(ctx *fiber.Ctx) error {
+func (response UpdateCompany200JSONResponse) VisitUpdateCompanyResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(200)
 
 	return ctx.JSON(&response)
 }
 
-type GetPresignedURL400JSONResponse struct{ BadRequestJSONResponse }
+type UpdateCompany400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetPresignedURL400JSONResponse) VisitGetPresignedURLResponse(ctx *fiber.Ctx) error {
+func (response UpdateCompany400JSONResponse) VisitUpdateCompanyResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(400)
 
 	return ctx.JSON(&response)
 }
 
-type GetPresignedURL401JSONResponse struct{ UnauthorizedJSONResponse }
+type UpdateCompany401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetPresignedURL401JSONResponse) VisitGetPresignedURLResponse(ctx *fiber.Ctx) error {
+func (response UpdateCompany401JSONResponse) VisitUpdateCompanyResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(401)
 
 	return ctx.JSON(&response)
 }
 
-type HealthCheckRequestObject struct {
+type GetCompanyBreakdownRequestObject struct {
+	Id     CompanyId `json:"id"`
+	Params GetCompanyBreakdownParams
 }
 
-type HealthCheckResponseObject interface {
-	VisitHealthCheckResponse(ctx *fiber.Ctx) error
+type GetCompanyBreakdownResponseObject interface {
+	VisitGetCompanyBreakdownResponse(ctx *fiber.Ctx) error
 }
 
-type HealthCheck200JSONResponse struct {
-	Status *string `json:"status,omitempty"`
+type GetCompanyBreakdown200JSONResponse CompanyBreakdown
+
+func (response GetCompanyBreakdown200JSONResponse) VisitGetCompanyBreakdownResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
 }
 
-func (response HealthCheck200JSONResponse) VisitHealthCheckResponse(ctx *fiber.Ctx) error {
+type GetCompanyBreakdown400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetCompanyBreakdown400JSONResponse) VisitGetCompanyBreakdownResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetCompanyBreakdown401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetCompanyBreakdown401JSONResponse) VisitGetCompanyBreakdownResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetCompanyBreakdown404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetCompanyBreakdown404JSONResponse) VisitGetCompanyBreakdownResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetCompanyBreakdown500JSONResponse Error
+
+func (response GetCompanyBreakdown500JSONResponse) VisitGetCompanyBreakdownResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetDashboardRequestObject struct {
+	Params GetDashboardParams
+}
+
+type GetDashboardResponseObject interface {
+	VisitGetDashboardResponse(ctx *fiber.Ctx) error
+}
+
+type GetDashboard200JSONResponse Dashboard
+
+func (response GetDashboard200JSONResponse) VisitGetDashboardResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
 	ctx.Status(200)
 
 	return ctx.JSON(&response)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Get invoice analytics grouped by category
-	// (GET /api/analytics/by-category)
-	GetAnalyticsByCategory(ctx context.Context, request GetAnalyticsByCategoryRequestObject) (GetAnalyticsByCategoryResponseObject, error)
-	// Get invoice analytics grouped by company
-	// (GET /api/analytics/by-company)
-	GetAnalyticsByCompany(ctx context.Context, request GetAnalyticsByCompanyRequestObject) (GetAnalyticsByCompanyResponseObject, error)
-	// Get invoice analytics grouped by receiver
-	// (GET /api/analytics/by-receiver)
-	GetAnalyticsByReceiver(ctx context.Context, request GetAnalyticsByReceiverRequestObject) (GetAnalyticsByReceiverResponseObject, error)
-	// Get invoice analytics grouped by tag
-	// (GET /api/analytics/by-tag)
-	GetAnalyticsByTag(ctx context.Context, request GetAnalyticsByTagRequestObject) (GetAnalyticsByTagResponseObject, error)
-	// Get invoice summary analytics
-	// (GET /api/analytics/summary)
-	GetAnalyticsSummary(ctx context.Context, request GetAnalyticsSummaryRequestObject) (GetAnalyticsSummaryResponseObject, error)
-	// List categories
-	// (GET /api/categories)
-	ListCategories(ctx context.Context, request ListCategoriesRequestObject) (ListCategoriesResponseObject, error)
-	// Create category
-	// (POST /api/categories)
-	CreateCategory(ctx context.Context, request CreateCategoryRequestObject) (CreateCategoryResponseObject, error)
-	// Delete category
-	// (DELETE /api/categories/{id})
-	DeleteCategory(ctx context.Context, request DeleteCategoryRequestObject) (DeleteCategoryResponseObject, error)
-	// Get category
-	// (GET /api/categories/{id})
-	GetCategory(ctx context.Context, request GetCategoryRequestObject) (GetCategoryResponseObject, error)
-	// Update category
-	// (PUT /api/categories/{id})
-	UpdateCategory(ctx context.Context, request UpdateCategoryRequestObject) (UpdateCategoryResponseObject, error)
-	// List companies
-	// (GET /api/companies)
-	ListCompanies(ctx context.Context, request ListCompaniesRequestObject) (ListCompaniesResponseObject, error)
-	// Create company
-	// (POST /api/companies)
-	CreateCompany(ctx context.Context, request CreateCompanyRequestObject) (CreateCompanyResponseObject, error)
-	// Delete company
-	// (DELETE /api/companies/{id})
-	DeleteCompany(ctx context.Context, request DeleteCompanyRequestObject) (DeleteCompanyResponseObject, error)
-	// Get company
-	// (GET /api/companies/{id})
-	GetCompany(ctx context.Context, request GetCompanyRequestObject) (GetCompanyResponseObject, error)
-	// Update company
-	// (PUT /api/companies/{id})
-	UpdateCompany(ctx context.Context, request UpdateCompanyRequestObject) (UpdateCompanyResponseObject, error)
-	// Get file download URL
-	// (GET /api/files/{key}/download)
-	GetFileDownloadURL(ctx context.Context, request GetFileDownloadURLRequestObject) (GetFileDownloadURLResponseObject, error)
-	// List invoices
-	// (GET /api/invoices)
-	ListInvoices(ctx context.Context, request ListInvoicesRequestObject) (ListInvoicesResponseObject, error)
-	// Create invoice
-	// (POST /api/invoices)
-	CreateInvoice(ctx context.Context, request CreateInvoiceRequestObject) (CreateInvoiceResponseObject, error)
-	// Delete invoice
-	// (DELETE /api/invoices/{id})
-	DeleteInvoice(ctx context.Context, request DeleteInvoiceRequestObject) (DeleteInvoiceResponseObject, error)
-	// Get invoice
-	// (GET /api/invoices/{id})
-	GetInvoice(ctx context.Context, request GetInvoiceRequestObject) (GetInvoiceResponseObject, error)
-	// Update invoice
-	// (PUT /api/invoices/{id})
-	UpdateInvoice(ctx context.Context, request UpdateInvoiceRequestObject) (UpdateInvoiceResponseObject, error)
-	// Add invoice item
-	// (POST /api/invoices/{id}/items)
-	AddInvoiceItem(ctx context.Context, request AddInvoiceItemRequestObject) (AddInvoiceItemResponseObject, error)
-	// Update invoice status
-	// (PATCH /api/invoices/{id}/status)
-	UpdateInvoiceStatus(ctx context.Context, request UpdateInvoiceStatusRequestObject) (UpdateInvoiceStatusResponseObject, error)
-	// Add tag to invoice
-	// (POST /api/invoices/{id}/tags)
-	AddTagToInvoice(ctx context.Context, request AddTagToInvoiceRequestObject) (AddTagToInvoiceResponseObject, error)
-	// Remove tag from invoice
-	// (DELETE /api/invoices/{id}/tags/{tagId})
-	RemoveTagFromInvoice(ctx context.Context, request RemoveTagFromInvoiceRequestObject) (RemoveTagFromInvoiceResponseObject, error)
-	// Delete invoice item
-	// (DELETE /api/invoices/{invoice_id}/items/{item_id})
-	DeleteInvoiceItem(ctx context.Context, request DeleteInvoiceItemRequestObject) (DeleteInvoiceItemResponseObject, error)
-	// Update invoice item
-	// (PUT /api/invoices/{invoice_id}/items/{item_id})
-	UpdateInvoiceItem(ctx context.Context, request UpdateInvoiceItemRequestObject) (UpdateInvoiceItemResponseObject, error)
-	// List receivers
-	// (GET /api/receivers)
-	ListReceivers(ctx context.Context, request ListReceiversRequestObject) (ListReceiversResponseObject, error)
-	// Create receiver
-	// (POST /api/receivers)
-	CreateReceiver(ctx context.Context, request CreateReceiverRequestObject) (CreateReceiverResponseObject, error)
-	// Merge receivers
-	// (POST /api/receivers/merge)
-	MergeReceivers(ctx context.Context, request MergeReceiversRequestObject) (MergeReceiversResponseObject, error)
-	// Delete receiver
-	// (DELETE /api/receivers/{id})
-	DeleteReceiver(ctx context.Context, request DeleteReceiverRequestObject) (DeleteReceiverResponseObject, error)
-	// Get receiver
-	// (GET /api/receivers/{id})
-	GetReceiver(ctx context.Context, request GetReceiverRequestObject) (GetReceiverResponseObject, error)
-	// Update receiver
-	// (PUT /api/receivers/{id})
-	UpdateReceiver(ctx context.Context, request UpdateReceiverRequestObject) (UpdateReceiverResponseObject, error)
-	// List tags
-	// (GET /api/tags)
-	ListTags(ctx context.Context, request ListTagsRequestObject) (ListTagsResponseObject, error)
-	// Create tag
-	// (POST /api/tags)
-	CreateTag(ctx context.Context, request CreateTagRequestObject) (CreateTagResponseObject, error)
-	// Delete tag
-	// (DELETE /api/tags/{id})
-	DeleteTag(ctx context.Context, request DeleteTagRequestObject) (DeleteTagResponseObject, error)
-	// Get tag
-	// (GET /api/tags/{id})
-	GetTag(ctx context.Context, request GetTagRequestObject) (GetTagResponseObject, error)
-	// Update tag
-	// (PUT /api/tags/{id})
-	UpdateTag(ctx context.Context, request UpdateTagRequestObject) (UpdateTagResponseObject, error)
-	// Upload file
-	// (POST /api/upload)
-	UploadFile(ctx context.Context, request UploadFileRequestObject) (UploadFileResponseObject, error)
-	// Confirm presigned upload
-	// (POST /api/upload/confirm)
-	ConfirmPresignedUpload(ctx context.Context, request ConfirmPresignedUploadRequestObject) (ConfirmPresignedUploadResponseObject, error)
-	// Convert HTML to PDF and upload
-	// (POST /api/upload/html-to-pdf)
-	UploadHtmlToPdf(ctx context.Context, request UploadHtmlToPdfRequestObject) (UploadHtmlToPdfResponseObject, error)
-	// Get presigned upload URL
-	// (GET /api/upload/presigned)
-	GetPresignedURL(ctx context.Context, request GetPresignedURLRequestObject) (GetPresignedURLResponseObject, error)
-	// Health check
-	// (GET /health)
-	HealthCheck(ctx context.Context, request HealthCheckRequestObject) (HealthCheckResponseObject, error)
+type GetDashboard304Response = NotModifiedResponse
+
+func (response GetDashboard304Response) VisitGetDashboardResponse(ctx *fiber.Ctx) error {
+	ctx.Status(304)
+	return nil
+}
+
+type GetDashboard401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetDashboard401JSONResponse) VisitGetDashboardResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ExportDataRequestObject struct {
+}
+
+type ExportDataResponseObject interface {
+	VisitExportDataResponse(ctx *fiber.Ctx) error
+}
+
+type ExportData200JSONResponse ExportDocument
+
+func (response ExportData200JSONResponse) VisitExportDataResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ExportData401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ExportData401JSONResponse) VisitExportDataResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type RequestExportRequestObject struct {
+}
+
+type RequestExportResponseObject interface {
+	VisitRequestExportResponse(ctx *fiber.Ctx) error
+}
+
+type RequestExport202JSONResponse ExportJob
+
+func (response RequestExport202JSONResponse) VisitRequestExportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(202)
+
+	return ctx.JSON(&response)
+}
+
+type RequestExport400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RequestExport400JSONResponse) VisitRequestExportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type RequestExport401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RequestExport401JSONResponse) VisitRequestExportResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetExportJobRequestObject struct {
+	Id int `json:"id"`
+}
+
+type GetExportJobResponseObject interface {
+	VisitGetExportJobResponse(ctx *fiber.Ctx) error
+}
+
+type GetExportJob200JSONResponse ExportJob
+
+func (response GetExportJob200JSONResponse) VisitGetExportJobResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetExportJob401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetExportJob401JSONResponse) VisitGetExportJobResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetExportJob404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetExportJob404JSONResponse) VisitGetExportJobResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetFileDownloadURLRequestObject struct {
+	Key string `json:"key"`
+}
+
+type GetFileDownloadURLResponseObject interface {
+	VisitGetFileDownloadURLResponse(ctx *fiber.Ctx) error
+}
+
+type GetFileDownloadURL200JSONResponse FileDownloadURLResponse
+
+func (response GetFileDownloadURL200JSONResponse) VisitGetFileDownloadURLResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetFileDownloadURL401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetFileDownloadURL401JSONResponse) VisitGetFileDownloadURLResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetFileDownloadURL403JSONResponse Error
+
+func (response GetFileDownloadURL403JSONResponse) VisitGetFileDownloadURLResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type GetFileDownloadURL404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetFileDownloadURL404JSONResponse) VisitGetFileDownloadURLResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type ImportDataRequestObject struct {
+	Body *ImportDataJSONRequestBody
+}
+
+type ImportDataResponseObject interface {
+	VisitImportDataResponse(ctx *fiber.Ctx) error
+}
+
+type ImportData200JSONResponse ImportResult
+
+func (response ImportData200JSONResponse) VisitImportDataResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ImportData400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ImportData400JSONResponse) VisitImportDataResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ImportData401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ImportData401JSONResponse) VisitImportDataResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ListInvoicesRequestObject struct {
+	Params ListInvoicesParams
+}
+
+type ListInvoicesResponseObject interface {
+	VisitListInvoicesResponse(ctx *fiber.Ctx) error
+}
+
+type ListInvoices200JSONResponse InvoiceListResponse
+
+func (response ListInvoices200JSONResponse) VisitListInvoicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListInvoices304Response = NotModifiedResponse
+
+func (response ListInvoices304Response) VisitListInvoicesResponse(ctx *fiber.Ctx) error {
+	ctx.Status(304)
+	return nil
+}
+
+type ListInvoices400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListInvoices400JSONResponse) VisitListInvoicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ListInvoices401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListInvoices401JSONResponse) VisitListInvoicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type CreateInvoiceRequestObject struct {
+	Body *CreateInvoiceJSONRequestBody
+}
+
+type CreateInvoiceResponseObject interface {
+	VisitCreateInvoiceResponse(ctx *fiber.Ctx) error
+}
+
+type CreateInvoice201JSONResponse Invoice
+
+func (response CreateInvoice201JSONResponse) VisitCreateInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type CreateInvoice400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateInvoice400JSONResponse) VisitCreateInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type CreateInvoice401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateInvoice401JSONResponse) VisitCreateInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetInvoiceFacetsRequestObject struct {
+}
+
+type GetInvoiceFacetsResponseObject interface {
+	VisitGetInvoiceFacetsResponse(ctx *fiber.Ctx) error
+}
+
+type GetInvoiceFacets200JSONResponse InvoiceFacets
+
+func (response GetInvoiceFacets200JSONResponse) VisitGetInvoiceFacetsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetInvoiceFacets401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetInvoiceFacets401JSONResponse) VisitGetInvoiceFacetsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ImportInvoicesRequestObject struct {
+	Params ImportInvoicesParams
+	Body   *multipart.Reader
+}
+
+type ImportInvoicesResponseObject interface {
+	VisitImportInvoicesResponse(ctx *fiber.Ctx) error
+}
+
+type ImportInvoices200JSONResponse InvoiceImportResult
+
+func (response ImportInvoices200JSONResponse) VisitImportInvoicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ImportInvoices400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ImportInvoices400JSONResponse) VisitImportInvoicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ImportInvoices401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ImportInvoices401JSONResponse) VisitImportInvoicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type LookupInvoicesRequestObject struct {
+	Params LookupInvoicesParams
+}
+
+type LookupInvoicesResponseObject interface {
+	VisitLookupInvoicesResponse(ctx *fiber.Ctx) error
+}
+
+type LookupInvoices200JSONResponse InvoiceLookupResponse
+
+func (response LookupInvoices200JSONResponse) VisitLookupInvoicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type LookupInvoices400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response LookupInvoices400JSONResponse) VisitLookupInvoicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type LookupInvoices401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response LookupInvoices401JSONResponse) VisitLookupInvoicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type LookupInvoices404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response LookupInvoices404JSONResponse) VisitLookupInvoicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type LookupInvoices409JSONResponse Error
+
+func (response LookupInvoices409JSONResponse) VisitLookupInvoicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(409)
+
+	return ctx.JSON(&response)
+}
+
+type GetInvoiceStatusCountsRequestObject struct {
+}
+
+type GetInvoiceStatusCountsResponseObject interface {
+	VisitGetInvoiceStatusCountsResponse(ctx *fiber.Ctx) error
+}
+
+type GetInvoiceStatusCounts200JSONResponse InvoiceStatusCounts
+
+func (response GetInvoiceStatusCounts200JSONResponse) VisitGetInvoiceStatusCountsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetInvoiceStatusCounts401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetInvoiceStatusCounts401JSONResponse) VisitGetInvoiceStatusCountsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteInvoiceRequestObject struct {
+	Id InvoiceId `json:"id"`
+}
+
+type DeleteInvoiceResponseObject interface {
+	VisitDeleteInvoiceResponse(ctx *fiber.Ctx) error
+}
+
+type DeleteInvoice204Response struct {
+}
+
+func (response DeleteInvoice204Response) VisitDeleteInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type DeleteInvoice401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteInvoice401JSONResponse) VisitDeleteInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteInvoice404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteInvoice404JSONResponse) VisitDeleteInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetInvoiceRequestObject struct {
+	Id     InvoiceId `json:"id"`
+	Params GetInvoiceParams
+}
+
+type GetInvoiceResponseObject interface {
+	VisitGetInvoiceResponse(ctx *fiber.Ctx) error
+}
+
+type GetInvoice200JSONResponse Invoice
+
+func (response GetInvoice200JSONResponse) VisitGetInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetInvoice304Response = NotModifiedResponse
+
+func (response GetInvoice304Response) VisitGetInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Status(304)
+	return nil
+}
+
+type GetInvoice400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetInvoice400JSONResponse) VisitGetInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetInvoice401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetInvoice401JSONResponse) VisitGetInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetInvoice404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetInvoice404JSONResponse) VisitGetInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateInvoiceRequestObject struct {
+	Id   InvoiceId `json:"id"`
+	Body *UpdateInvoiceJSONRequestBody
+}
+
+type UpdateInvoiceResponseObject interface {
+	VisitUpdateInvoiceResponse(ctx *fiber.Ctx) error
+}
+
+type UpdateInvoice200JSONResponse Invoice
+
+func (response UpdateInvoice200JSONResponse) VisitUpdateInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateInvoice400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateInvoice400JSONResponse) VisitUpdateInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateInvoice401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateInvoice401JSONResponse) VisitUpdateInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateInvoice409JSONResponse Error
+
+func (response UpdateInvoice409JSONResponse) VisitUpdateInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(409)
+
+	return ctx.JSON(&response)
+}
+
+type ListInvoiceAttachmentsRequestObject struct {
+	Id InvoiceId `json:"id"`
+}
+
+type ListInvoiceAttachmentsResponseObject interface {
+	VisitListInvoiceAttachmentsResponse(ctx *fiber.Ctx) error
+}
+
+type ListInvoiceAttachments200JSONResponse InvoiceAttachmentListResponse
+
+func (response ListInvoiceAttachments200JSONResponse) VisitListInvoiceAttachmentsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListInvoiceAttachments401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListInvoiceAttachments401JSONResponse) VisitListInvoiceAttachmentsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ListInvoiceAttachments404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListInvoiceAttachments404JSONResponse) VisitListInvoiceAttachmentsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AddInvoiceAttachmentRequestObject struct {
+	Id   InvoiceId `json:"id"`
+	Body *AddInvoiceAttachmentJSONRequestBody
+}
+
+type AddInvoiceAttachmentResponseObject interface {
+	VisitAddInvoiceAttachmentResponse(ctx *fiber.Ctx) error
+}
+
+type AddInvoiceAttachment201JSONResponse InvoiceAttachment
+
+func (response AddInvoiceAttachment201JSONResponse) VisitAddInvoiceAttachmentResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type AddInvoiceAttachment400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AddInvoiceAttachment400JSONResponse) VisitAddInvoiceAttachmentResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type AddInvoiceAttachment401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response AddInvoiceAttachment401JSONResponse) VisitAddInvoiceAttachmentResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type RemoveInvoiceAttachmentRequestObject struct {
+	Id           InvoiceId `json:"id"`
+	AttachmentId int       `json:"attachmentId"`
+}
+
+type RemoveInvoiceAttachmentResponseObject interface {
+	VisitRemoveInvoiceAttachmentResponse(ctx *fiber.Ctx) error
+}
+
+type RemoveInvoiceAttachment204Response struct {
+}
+
+func (response RemoveInvoiceAttachment204Response) VisitRemoveInvoiceAttachmentResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type RemoveInvoiceAttachment401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RemoveInvoiceAttachment401JSONResponse) VisitRemoveInvoiceAttachmentResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type RemoveInvoiceAttachment404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RemoveInvoiceAttachment404JSONResponse) VisitRemoveInvoiceAttachmentResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetInvoiceAuditTrailRequestObject struct {
+	Id     InvoiceId `json:"id"`
+	Params GetInvoiceAuditTrailParams
+}
+
+type GetInvoiceAuditTrailResponseObject interface {
+	VisitGetInvoiceAuditTrailResponse(ctx *fiber.Ctx) error
+}
+
+type GetInvoiceAuditTrail200JSONResponse AuditTrailResponse
+
+func (response GetInvoiceAuditTrail200JSONResponse) VisitGetInvoiceAuditTrailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetInvoiceAuditTrail400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetInvoiceAuditTrail400JSONResponse) VisitGetInvoiceAuditTrailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetInvoiceAuditTrail401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetInvoiceAuditTrail401JSONResponse) VisitGetInvoiceAuditTrailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetInvoiceAuditTrail404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetInvoiceAuditTrail404JSONResponse) VisitGetInvoiceAuditTrailResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type CloneInvoiceRequestObject struct {
+	Id   InvoiceId `json:"id"`
+	Body *CloneInvoiceJSONRequestBody
+}
+
+type CloneInvoiceResponseObject interface {
+	VisitCloneInvoiceResponse(ctx *fiber.Ctx) error
+}
+
+type CloneInvoice201JSONResponse Invoice
+
+func (response CloneInvoice201JSONResponse) VisitCloneInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type CloneInvoice400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CloneInvoice400JSONResponse) VisitCloneInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type CloneInvoice401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CloneInvoice401JSONResponse) VisitCloneInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type CloneInvoice404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CloneInvoice404JSONResponse) VisitCloneInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type CloneInvoice409JSONResponse Error
+
+func (response CloneInvoice409JSONResponse) VisitCloneInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(409)
+
+	return ctx.JSON(&response)
+}
+
+type PreviewCurrencyConversionRequestObject struct {
+	Id   InvoiceId `json:"id"`
+	Body *PreviewCurrencyConversionJSONRequestBody
+}
+
+type PreviewCurrencyConversionResponseObject interface {
+	VisitPreviewCurrencyConversionResponse(ctx *fiber.Ctx) error
+}
+
+type PreviewCurrencyConversion200JSONResponse ConversionPreview
+
+func (response PreviewCurrencyConversion200JSONResponse) VisitPreviewCurrencyConversionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type PreviewCurrencyConversion400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PreviewCurrencyConversion400JSONResponse) VisitPreviewCurrencyConversionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PreviewCurrencyConversion401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PreviewCurrencyConversion401JSONResponse) VisitPreviewCurrencyConversionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type PreviewCurrencyConversion404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PreviewCurrencyConversion404JSONResponse) VisitPreviewCurrencyConversionResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type FinalizeInvoiceRequestObject struct {
+	Id InvoiceId `json:"id"`
+}
+
+type FinalizeInvoiceResponseObject interface {
+	VisitFinalizeInvoiceResponse(ctx *fiber.Ctx) error
+}
+
+type FinalizeInvoice200JSONResponse Invoice
+
+func (response FinalizeInvoice200JSONResponse) VisitFinalizeInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type FinalizeInvoice400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response FinalizeInvoice400JSONResponse) VisitFinalizeInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type FinalizeInvoice401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response FinalizeInvoice401JSONResponse) VisitFinalizeInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type FinalizeInvoice404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response FinalizeInvoice404JSONResponse) VisitFinalizeInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AddInvoiceItemRequestObject struct {
+	Id   InvoiceId `json:"id"`
+	Body *AddInvoiceItemJSONRequestBody
+}
+
+type AddInvoiceItemResponseObject interface {
+	VisitAddInvoiceItemResponse(ctx *fiber.Ctx) error
+}
+
+type AddInvoiceItem201JSONResponse InvoiceItem
+
+func (response AddInvoiceItem201JSONResponse) VisitAddInvoiceItemResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type AddInvoiceItem400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AddInvoiceItem400JSONResponse) VisitAddInvoiceItemResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type AddInvoiceItem401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response AddInvoiceItem401JSONResponse) VisitAddInvoiceItemResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type AddInvoiceItemsRequestObject struct {
+	Id   InvoiceId `json:"id"`
+	Body *AddInvoiceItemsJSONRequestBody
+}
+
+type AddInvoiceItemsResponseObject interface {
+	VisitAddInvoiceItemsResponse(ctx *fiber.Ctx) error
+}
+
+type AddInvoiceItems201JSONResponse InvoiceItemListResponse
+
+func (response AddInvoiceItems201JSONResponse) VisitAddInvoiceItemsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type AddInvoiceItems400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AddInvoiceItems400JSONResponse) VisitAddInvoiceItemsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type AddInvoiceItems401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response AddInvoiceItems401JSONResponse) VisitAddInvoiceItemsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ReorderInvoiceItemsRequestObject struct {
+	Id   InvoiceId `json:"id"`
+	Body *ReorderInvoiceItemsJSONRequestBody
+}
+
+type ReorderInvoiceItemsResponseObject interface {
+	VisitReorderInvoiceItemsResponse(ctx *fiber.Ctx) error
+}
+
+type ReorderInvoiceItems200JSONResponse Invoice
+
+func (response ReorderInvoiceItems200JSONResponse) VisitReorderInvoiceItemsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ReorderInvoiceItems400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ReorderInvoiceItems400JSONResponse) VisitReorderInvoiceItemsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ReorderInvoiceItems401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ReorderInvoiceItems401JSONResponse) VisitReorderInvoiceItemsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ReorderInvoiceItems404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ReorderInvoiceItems404JSONResponse) VisitReorderInvoiceItemsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type RecalculateInvoiceTotalsRequestObject struct {
+	Id InvoiceId `json:"id"`
+}
+
+type RecalculateInvoiceTotalsResponseObject interface {
+	VisitRecalculateInvoiceTotalsResponse(ctx *fiber.Ctx) error
+}
+
+type RecalculateInvoiceTotals200JSONResponse TotalsRecalculation
+
+func (response RecalculateInvoiceTotals200JSONResponse) VisitRecalculateInvoiceTotalsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type RecalculateInvoiceTotals401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RecalculateInvoiceTotals401JSONResponse) VisitRecalculateInvoiceTotalsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type RecalculateInvoiceTotals404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RecalculateInvoiceTotals404JSONResponse) VisitRecalculateInvoiceTotalsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type FindSimilarInvoicesRequestObject struct {
+	Id     InvoiceId `json:"id"`
+	Params FindSimilarInvoicesParams
+}
+
+type FindSimilarInvoicesResponseObject interface {
+	VisitFindSimilarInvoicesResponse(ctx *fiber.Ctx) error
+}
+
+type FindSimilarInvoices200JSONResponse SimilarInvoicesResponse
+
+func (response FindSimilarInvoices200JSONResponse) VisitFindSimilarInvoicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type FindSimilarInvoices400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response FindSimilarInvoices400JSONResponse) VisitFindSimilarInvoicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type FindSimilarInvoices401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response FindSimilarInvoices401JSONResponse) VisitFindSimilarInvoicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type FindSimilarInvoices404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response FindSimilarInvoices404JSONResponse) VisitFindSimilarInvoicesResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateInvoiceStatusRequestObject struct {
+	Id   InvoiceId `json:"id"`
+	Body *UpdateInvoiceStatusJSONRequestBody
+}
+
+type UpdateInvoiceStatusResponseObject interface {
+	VisitUpdateInvoiceStatusResponse(ctx *fiber.Ctx) error
+}
+
+type UpdateInvoiceStatus200JSONResponse Invoice
+
+func (response UpdateInvoiceStatus200JSONResponse) VisitUpdateInvoiceStatusResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateInvoiceStatus400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateInvoiceStatus400JSONResponse) VisitUpdateInvoiceStatusResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateInvoiceStatus401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateInvoiceStatus401JSONResponse) VisitUpdateInvoiceStatusResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetInvoiceStatusHistoryRequestObject struct {
+	Id     InvoiceId `json:"id"`
+	Params GetInvoiceStatusHistoryParams
+}
+
+type GetInvoiceStatusHistoryResponseObject interface {
+	VisitGetInvoiceStatusHistoryResponse(ctx *fiber.Ctx) error
+}
+
+type GetInvoiceStatusHistory200JSONResponse AuditTrailResponse
+
+func (response GetInvoiceStatusHistory200JSONResponse) VisitGetInvoiceStatusHistoryResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetInvoiceStatusHistory400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetInvoiceStatusHistory400JSONResponse) VisitGetInvoiceStatusHistoryResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetInvoiceStatusHistory401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetInvoiceStatusHistory401JSONResponse) VisitGetInvoiceStatusHistoryResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetInvoiceStatusHistory404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetInvoiceStatusHistory404JSONResponse) VisitGetInvoiceStatusHistoryResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type AddTagToInvoiceRequestObject struct {
+	Id   InvoiceId `json:"id"`
+	Body *AddTagToInvoiceJSONRequestBody
+}
+
+type AddTagToInvoiceResponseObject interface {
+	VisitAddTagToInvoiceResponse(ctx *fiber.Ctx) error
+}
+
+type AddTagToInvoice200JSONResponse Invoice
+
+func (response AddTagToInvoice200JSONResponse) VisitAddTagToInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type AddTagToInvoice400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AddTagToInvoice400JSONResponse) VisitAddTagToInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type AddTagToInvoice401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response AddTagToInvoice401JSONResponse) VisitAddTagToInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type AddTagToInvoice404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response AddTagToInvoice404JSONResponse) VisitAddTagToInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type RemoveTagFromInvoiceRequestObject struct {
+	Id    InvoiceId `json:"id"`
+	TagId int       `json:"tagId"`
+}
+
+type RemoveTagFromInvoiceResponseObject interface {
+	VisitRemoveTagFromInvoiceResponse(ctx *fiber.Ctx) error
+}
+
+type RemoveTagFromInvoice200JSONResponse Invoice
+
+func (response RemoveTagFromInvoice200JSONResponse) VisitRemoveTagFromInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type RemoveTagFromInvoice401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RemoveTagFromInvoice401JSONResponse) VisitRemoveTagFromInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type RemoveTagFromInvoice404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RemoveTagFromInvoice404JSONResponse) VisitRemoveTagFromInvoiceResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteInvoiceItemRequestObject struct {
+	InvoiceId int `json:"invoice_id"`
+	ItemId    int `json:"item_id"`
+}
+
+type DeleteInvoiceItemResponseObject interface {
+	VisitDeleteInvoiceItemResponse(ctx *fiber.Ctx) error
+}
+
+type DeleteInvoiceItem204Response struct {
+}
+
+func (response DeleteInvoiceItem204Response) VisitDeleteInvoiceItemResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type DeleteInvoiceItem401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteInvoiceItem401JSONResponse) VisitDeleteInvoiceItemResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateInvoiceItemRequestObject struct {
+	InvoiceId int `json:"invoice_id"`
+	ItemId    int `json:"item_id"`
+	Body      *UpdateInvoiceItemJSONRequestBody
+}
+
+type UpdateInvoiceItemResponseObject interface {
+	VisitUpdateInvoiceItemResponse(ctx *fiber.Ctx) error
+}
+
+type UpdateInvoiceItem200JSONResponse InvoiceItem
+
+func (response UpdateInvoiceItem200JSONResponse) VisitUpdateInvoiceItemResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateInvoiceItem400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateInvoiceItem400JSONResponse) VisitUpdateInvoiceItemResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateInvoiceItem401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateInvoiceItem401JSONResponse) VisitUpdateInvoiceItemResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ListOrganizationsRequestObject struct {
+}
+
+type ListOrganizationsResponseObject interface {
+	VisitListOrganizationsResponse(ctx *fiber.Ctx) error
+}
+
+type ListOrganizations200JSONResponse []Organization
+
+func (response ListOrganizations200JSONResponse) VisitListOrganizationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListOrganizations401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListOrganizations401JSONResponse) VisitListOrganizationsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type CreateOrganizationRequestObject struct {
+	Body *CreateOrganizationJSONRequestBody
+}
+
+type CreateOrganizationResponseObject interface {
+	VisitCreateOrganizationResponse(ctx *fiber.Ctx) error
+}
+
+type CreateOrganization201JSONResponse Organization
+
+func (response CreateOrganization201JSONResponse) VisitCreateOrganizationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type CreateOrganization400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateOrganization400JSONResponse) VisitCreateOrganizationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type CreateOrganization401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateOrganization401JSONResponse) VisitCreateOrganizationResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ListOrganizationMembersRequestObject struct {
+	Id OrganizationId `json:"id"`
+}
+
+type ListOrganizationMembersResponseObject interface {
+	VisitListOrganizationMembersResponse(ctx *fiber.Ctx) error
+}
+
+type ListOrganizationMembers200JSONResponse []OrganizationMember
+
+func (response ListOrganizationMembers200JSONResponse) VisitListOrganizationMembersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListOrganizationMembers401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListOrganizationMembers401JSONResponse) VisitListOrganizationMembersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ListOrganizationMembers404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListOrganizationMembers404JSONResponse) VisitListOrganizationMembersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type RemoveOrganizationMemberRequestObject struct {
+	Id     OrganizationId `json:"id"`
+	UserId MemberUserId   `json:"user_id"`
+}
+
+type RemoveOrganizationMemberResponseObject interface {
+	VisitRemoveOrganizationMemberResponse(ctx *fiber.Ctx) error
+}
+
+type RemoveOrganizationMember204Response struct {
+}
+
+func (response RemoveOrganizationMember204Response) VisitRemoveOrganizationMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type RemoveOrganizationMember400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RemoveOrganizationMember400JSONResponse) VisitRemoveOrganizationMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type RemoveOrganizationMember401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RemoveOrganizationMember401JSONResponse) VisitRemoveOrganizationMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type SetOrganizationMemberRequestObject struct {
+	Id     OrganizationId `json:"id"`
+	UserId MemberUserId   `json:"user_id"`
+	Body   *SetOrganizationMemberJSONRequestBody
+}
+
+type SetOrganizationMemberResponseObject interface {
+	VisitSetOrganizationMemberResponse(ctx *fiber.Ctx) error
+}
+
+type SetOrganizationMember200JSONResponse OrganizationMember
+
+func (response SetOrganizationMember200JSONResponse) VisitSetOrganizationMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type SetOrganizationMember400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetOrganizationMember400JSONResponse) VisitSetOrganizationMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type SetOrganizationMember401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SetOrganizationMember401JSONResponse) VisitSetOrganizationMemberResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ListPaymentMethodsRequestObject struct {
+}
+
+type ListPaymentMethodsResponseObject interface {
+	VisitListPaymentMethodsResponse(ctx *fiber.Ctx) error
+}
+
+type ListPaymentMethods200JSONResponse PaymentMethodListResponse
+
+func (response ListPaymentMethods200JSONResponse) VisitListPaymentMethodsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListPaymentMethods401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListPaymentMethods401JSONResponse) VisitListPaymentMethodsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ListReceiversRequestObject struct {
+	Params ListReceiversParams
+}
+
+type ListReceiversResponseObject interface {
+	VisitListReceiversResponse(ctx *fiber.Ctx) error
+}
+
+type ListReceivers200JSONResponse ReceiverListResponse
+
+func (response ListReceivers200JSONResponse) VisitListReceiversResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListReceivers401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListReceivers401JSONResponse) VisitListReceiversResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type CreateReceiverRequestObject struct {
+	Body *CreateReceiverJSONRequestBody
+}
+
+type CreateReceiverResponseObject interface {
+	VisitCreateReceiverResponse(ctx *fiber.Ctx) error
+}
+
+type CreateReceiver201JSONResponse Receiver
+
+func (response CreateReceiver201JSONResponse) VisitCreateReceiverResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type CreateReceiver400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateReceiver400JSONResponse) VisitCreateReceiverResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type CreateReceiver401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateReceiver401JSONResponse) VisitCreateReceiverResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type MergeReceiversRequestObject struct {
+	Body *MergeReceiversJSONRequestBody
+}
+
+type MergeReceiversResponseObject interface {
+	VisitMergeReceiversResponse(ctx *fiber.Ctx) error
+}
+
+type MergeReceivers200JSONResponse MergeReceiversResult
+
+func (response MergeReceivers200JSONResponse) VisitMergeReceiversResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type MergeReceivers400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response MergeReceivers400JSONResponse) VisitMergeReceiversResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type MergeReceivers401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response MergeReceivers401JSONResponse) VisitMergeReceiversResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type MergeReceivers404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response MergeReceivers404JSONResponse) VisitMergeReceiversResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteReceiverRequestObject struct {
+	Id     ReceiverId `json:"id"`
+	Params DeleteReceiverParams
+}
+
+type DeleteReceiverResponseObject interface {
+	VisitDeleteReceiverResponse(ctx *fiber.Ctx) error
+}
+
+type DeleteReceiver204Response struct {
+}
+
+func (response DeleteReceiver204Response) VisitDeleteReceiverResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type DeleteReceiver401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteReceiver401JSONResponse) VisitDeleteReceiverResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteReceiver404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteReceiver404JSONResponse) VisitDeleteReceiverResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteReceiver409JSONResponse struct{ InUseJSONResponse }
+
+func (response DeleteReceiver409JSONResponse) VisitDeleteReceiverResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(409)
+
+	return ctx.JSON(&response)
+}
+
+type GetReceiverRequestObject struct {
+	Id ReceiverId `json:"id"`
+}
+
+type GetReceiverResponseObject interface {
+	VisitGetReceiverResponse(ctx *fiber.Ctx) error
+}
+
+type GetReceiver200JSONResponse Receiver
+
+func (response GetReceiver200JSONResponse) VisitGetReceiverResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetReceiver401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetReceiver401JSONResponse) VisitGetReceiverResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetReceiver404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetReceiver404JSONResponse) VisitGetReceiverResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateReceiverRequestObject struct {
+	Id   ReceiverId `json:"id"`
+	Body *UpdateReceiverJSONRequestBody
+}
+
+type UpdateReceiverResponseObject interface {
+	VisitUpdateReceiverResponse(ctx *fiber.Ctx) error
+}
+
+type UpdateReceiver200JSONResponse Receiver
+
+func (response UpdateReceiver200JSONResponse) VisitUpdateReceiverResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateReceiver400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateReceiver400JSONResponse) VisitUpdateReceiverResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateReceiver401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateReceiver401JSONResponse) VisitUpdateReceiverResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetReceiverStatementRequestObject struct {
+	Id     ReceiverId `json:"id"`
+	Params GetReceiverStatementParams
+}
+
+type GetReceiverStatementResponseObject interface {
+	VisitGetReceiverStatementResponse(ctx *fiber.Ctx) error
+}
+
+type GetReceiverStatement200JSONResponse VendorStatement
+
+func (response GetReceiverStatement200JSONResponse) VisitGetReceiverStatementResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetReceiverStatement200ApplicationpdfResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetReceiverStatement200ApplicationpdfResponse) VisitGetReceiverStatementResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/pdf")
+	if response.ContentLength != 0 {
+		ctx.Response().Header.Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	ctx.Status(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(ctx.Response().BodyWriter(), response.Body)
+	return err
+}
+
+type GetReceiverStatement400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetReceiverStatement400JSONResponse) VisitGetReceiverStatementResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetReceiverStatement401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetReceiverStatement401JSONResponse) VisitGetReceiverStatementResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetReceiverStatement404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetReceiverStatement404JSONResponse) VisitGetReceiverStatementResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetReceiverStatement500JSONResponse Error
+
+func (response GetReceiverStatement500JSONResponse) VisitGetReceiverStatementResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetReceiverStatisticsRequestObject struct {
+	Id     ReceiverId `json:"id"`
+	Params GetReceiverStatisticsParams
+}
+
+type GetReceiverStatisticsResponseObject interface {
+	VisitGetReceiverStatisticsResponse(ctx *fiber.Ctx) error
+}
+
+type GetReceiverStatistics200JSONResponse ReceiverDetail
+
+func (response GetReceiverStatistics200JSONResponse) VisitGetReceiverStatisticsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetReceiverStatistics400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetReceiverStatistics400JSONResponse) VisitGetReceiverStatisticsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetReceiverStatistics401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetReceiverStatistics401JSONResponse) VisitGetReceiverStatisticsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetReceiverStatistics404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetReceiverStatistics404JSONResponse) VisitGetReceiverStatisticsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetReceiverStatistics500JSONResponse Error
+
+func (response GetReceiverStatistics500JSONResponse) VisitGetReceiverStatisticsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetSettingsRequestObject struct {
+}
+
+type GetSettingsResponseObject interface {
+	VisitGetSettingsResponse(ctx *fiber.Ctx) error
+}
+
+type GetSettings200JSONResponse UserSettings
+
+func (response GetSettings200JSONResponse) VisitGetSettingsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetSettings401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetSettings401JSONResponse) VisitGetSettingsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateSettingsRequestObject struct {
+	Body *UpdateSettingsJSONRequestBody
+}
+
+type UpdateSettingsResponseObject interface {
+	VisitUpdateSettingsResponse(ctx *fiber.Ctx) error
+}
+
+type UpdateSettings200JSONResponse UserSettings
+
+func (response UpdateSettings200JSONResponse) VisitUpdateSettingsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateSettings400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateSettings400JSONResponse) VisitUpdateSettingsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateSettings401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateSettings401JSONResponse) VisitUpdateSettingsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type ListTagsRequestObject struct {
+	Params ListTagsParams
+}
+
+type ListTagsResponseObject interface {
+	VisitListTagsResponse(ctx *fiber.Ctx) error
+}
+
+type ListTags200JSONResponse TagListResponse
+
+func (response ListTags200JSONResponse) VisitListTagsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListTags401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListTags401JSONResponse) VisitListTagsResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type CreateTagRequestObject struct {
+	Body *CreateTagJSONRequestBody
+}
+
+type CreateTagResponseObject interface {
+	VisitCreateTagResponse(ctx *fiber.Ctx) error
+}
+
+type CreateTag201JSONResponse Tag
+
+func (response CreateTag201JSONResponse) VisitCreateTagResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type CreateTag400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateTag400JSONResponse) VisitCreateTagResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type CreateTag401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateTag401JSONResponse) VisitCreateTagResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteTagRequestObject struct {
+	Id TagId `json:"id"`
+}
+
+type DeleteTagResponseObject interface {
+	VisitDeleteTagResponse(ctx *fiber.Ctx) error
+}
+
+type DeleteTag204Response struct {
+}
+
+func (response DeleteTag204Response) VisitDeleteTagResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type DeleteTag401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteTag401JSONResponse) VisitDeleteTagResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteTag404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteTag404JSONResponse) VisitDeleteTagResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetTagRequestObject struct {
+	Id TagId `json:"id"`
+}
+
+type GetTagResponseObject interface {
+	VisitGetTagResponse(ctx *fiber.Ctx) error
+}
+
+type GetTag200JSONResponse Tag
+
+func (response GetTag200JSONResponse) VisitGetTagResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetTag401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetTag401JSONResponse) VisitGetTagResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type GetTag404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetTag404JSONResponse) VisitGetTagResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateTagRequestObject struct {
+	Id   TagId `json:"id"`
+	Body *UpdateTagJSONRequestBody
+}
+
+type UpdateTagResponseObject interface {
+	VisitUpdateTagResponse(ctx *fiber.Ctx) error
+}
+
+type UpdateTag200JSONResponse Tag
+
+func (response UpdateTag200JSONResponse) VisitUpdateTagResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateTag400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateTag400JSONResponse) VisitUpdateTagResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateTag401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateTag401JSONResponse) VisitUpdateTagResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type UploadFileRequestObject struct {
+	Body *multipart.Reader
+}
+
+type UploadFileResponseObject interface {
+	VisitUploadFileResponse(ctx *fiber.Ctx) error
+}
+
+type UploadFile201JSONResponse UploadResponse
+
+func (response UploadFile201JSONResponse) VisitUploadFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type UploadFile400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UploadFile400JSONResponse) VisitUploadFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type UploadFile401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UploadFile401JSONResponse) VisitUploadFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type UploadFile413JSONResponse Error
+
+func (response UploadFile413JSONResponse) VisitUploadFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(413)
+
+	return ctx.JSON(&response)
+}
+
+type UploadFile415JSONResponse Error
+
+func (response UploadFile415JSONResponse) VisitUploadFileResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(415)
+
+	return ctx.JSON(&response)
+}
+
+type ConfirmPresignedUploadRequestObject struct {
+	Body *ConfirmPresignedUploadJSONRequestBody
+}
+
+type ConfirmPresignedUploadResponseObject interface {
+	VisitConfirmPresignedUploadResponse(ctx *fiber.Ctx) error
+}
+
+type ConfirmPresignedUpload201JSONResponse UploadResponse
+
+func (response ConfirmPresignedUpload201JSONResponse) VisitConfirmPresignedUploadResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type ConfirmPresignedUpload400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ConfirmPresignedUpload400JSONResponse) VisitConfirmPresignedUploadResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ConfirmPresignedUpload401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ConfirmPresignedUpload401JSONResponse) VisitConfirmPresignedUploadResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type UploadHtmlToPdfRequestObject struct {
+	Body *UploadHtmlToPdfJSONRequestBody
+}
+
+type UploadHtmlToPdfResponseObject interface {
+	VisitUploadHtmlToPdfResponse(ctx *fiber.Ctx) error
+}
+
+type UploadHtmlToPdf201JSONResponse UploadResponse
+
+func (response UploadHtmlToPdf201JSONResponse) VisitUploadHtmlToPdfResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type UploadHtmlToPdf400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UploadHtmlToPdf400JSONResponse) VisitUploadHtmlToPdfResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type UploadHtmlToPdf401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UploadHtmlToPdf401JSONResponse) VisitUploadHtmlToPdfResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type UploadHtmlToPdf500JSONResponse Error
+
+func (response UploadHtmlToPdf500JSONResponse) VisitUploadHtmlToPdfResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetPresignedURLRequestObject struct {
+	Params GetPresignedURLParams
+}
+
+type GetPresignedURLResponseObject interface {
+	VisitGetPresignedURLResponse(ctx *fiber.Ctx) error
+}
+
+type GetPresignedURL200JSONResponse PresignedURLResponse
+
+func (response GetPresignedURL200JSONResponse) VisitGetPresignedURLResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetPresignedURL400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetPresignedURL400JSONResponse) VisitGetPresignedURLResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetPresignedURL401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetPresignedURL401JSONResponse) VisitGetPresignedURLResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(401)
+
+	return ctx.JSON(&response)
+}
+
+type HealthCheckRequestObject struct {
+}
+
+type HealthCheckResponseObject interface {
+	VisitHealthCheckResponse(ctx *fiber.Ctx) error
+}
+
+type HealthCheck200JSONResponse HealthStatus
+
+func (response HealthCheck200JSONResponse) VisitHealthCheckResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type HealthCheck503JSONResponse HealthStatus
+
+func (response HealthCheck503JSONResponse) VisitHealthCheckResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(503)
+
+	return ctx.JSON(&response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Get invoice analytics grouped by category
+	// (GET /api/analytics/by-category)
+	GetAnalyticsByCategory(ctx context.Context, request GetAnalyticsByCategoryRequestObject) (GetAnalyticsByCategoryResponseObject, error)
+	// Get invoice analytics grouped by company
+	// (GET /api/analytics/by-company)
+	GetAnalyticsByCompany(ctx context.Context, request GetAnalyticsByCompanyRequestObject) (GetAnalyticsByCompanyResponseObject, error)
+	// Get invoice analytics grouped by receiver
+	// (GET /api/analytics/by-receiver)
+	GetAnalyticsByReceiver(ctx context.Context, request GetAnalyticsByReceiverRequestObject) (GetAnalyticsByReceiverResponseObject, error)
+	// Get invoice analytics grouped by tag
+	// (GET /api/analytics/by-tag)
+	GetAnalyticsByTag(ctx context.Context, request GetAnalyticsByTagRequestObject) (GetAnalyticsByTagResponseObject, error)
+	// Get invoice summary analytics
+	// (GET /api/analytics/summary)
+	GetAnalyticsSummary(ctx context.Context, request GetAnalyticsSummaryRequestObject) (GetAnalyticsSummaryResponseObject, error)
+	// Get monthly invoice trend
+	// (GET /api/analytics/trend)
+	GetAnalyticsTrend(ctx context.Context, request GetAnalyticsTrendRequestObject) (GetAnalyticsTrendResponseObject, error)
+	// List budgets
+	// (GET /api/budgets)
+	ListBudgets(ctx context.Context, request ListBudgetsRequestObject) (ListBudgetsResponseObject, error)
+	// Create a budget
+	// (POST /api/budgets)
+	CreateBudget(ctx context.Context, request CreateBudgetRequestObject) (CreateBudgetResponseObject, error)
+	// Get budget status
+	// (GET /api/budgets/status)
+	GetBudgetStatus(ctx context.Context, request GetBudgetStatusRequestObject) (GetBudgetStatusResponseObject, error)
+	// Delete a budget
+	// (DELETE /api/budgets/{id})
+	DeleteBudget(ctx context.Context, request DeleteBudgetRequestObject) (DeleteBudgetResponseObject, error)
+	// List categories
+	// (GET /api/categories)
+	ListCategories(ctx context.Context, request ListCategoriesRequestObject) (ListCategoriesResponseObject, error)
+	// Create category
+	// (POST /api/categories)
+	CreateCategory(ctx context.Context, request CreateCategoryRequestObject) (CreateCategoryResponseObject, error)
+	// Delete category
+	// (DELETE /api/categories/{id})
+	DeleteCategory(ctx context.Context, request DeleteCategoryRequestObject) (DeleteCategoryResponseObject, error)
+	// Get category
+	// (GET /api/categories/{id})
+	GetCategory(ctx context.Context, request GetCategoryRequestObject) (GetCategoryResponseObject, error)
+	// Update category
+	// (PUT /api/categories/{id})
+	UpdateCategory(ctx context.Context, request UpdateCategoryRequestObject) (UpdateCategoryResponseObject, error)
+	// List companies
+	// (GET /api/companies)
+	ListCompanies(ctx context.Context, request ListCompaniesRequestObject) (ListCompaniesResponseObject, error)
+	// Create company
+	// (POST /api/companies)
+	CreateCompany(ctx context.Context, request CreateCompanyRequestObject) (CreateCompanyResponseObject, error)
+	// Delete company
+	// (DELETE /api/companies/{id})
+	DeleteCompany(ctx context.Context, request DeleteCompanyRequestObject) (DeleteCompanyResponseObject, error)
+	// Get company
+	// (GET /api/companies/{id})
+	GetCompany(ctx context.Context, request GetCompanyRequestObject) (GetCompanyResponseObject, error)
+	// Update company
+	// (PUT /api/companies/{id})
+	UpdateCompany(ctx context.Context, request UpdateCompanyRequestObject) (UpdateCompanyResponseObject, error)
+	// Get company breakdown
+	// (GET /api/companies/{id}/breakdown)
+	GetCompanyBreakdown(ctx context.Context, request GetCompanyBreakdownRequestObject) (GetCompanyBreakdownResponseObject, error)
+	// Get dashboard data
+	// (GET /api/dashboard)
+	GetDashboard(ctx context.Context, request GetDashboardRequestObject) (GetDashboardResponseObject, error)
+	// Export account data
+	// (GET /api/export)
+	ExportData(ctx context.Context, request ExportDataRequestObject) (ExportDataResponseObject, error)
+	// Request an export bundle
+	// (POST /api/exports)
+	RequestExport(ctx context.Context, request RequestExportRequestObject) (RequestExportResponseObject, error)
+	// Get an export job
+	// (GET /api/exports/{id})
+	GetExportJob(ctx context.Context, request GetExportJobRequestObject) (GetExportJobResponseObject, error)
+	// Get file download URL
+	// (GET /api/files/{key}/download)
+	GetFileDownloadURL(ctx context.Context, request GetFileDownloadURLRequestObject) (GetFileDownloadURLResponseObject, error)
+	// Import account data
+	// (POST /api/import)
+	ImportData(ctx context.Context, request ImportDataRequestObject) (ImportDataResponseObject, error)
+	// List invoices
+	// (GET /api/invoices)
+	ListInvoices(ctx context.Context, request ListInvoicesRequestObject) (ListInvoicesResponseObject, error)
+	// Create invoice
+	// (POST /api/invoices)
+	CreateInvoice(ctx context.Context, request CreateInvoiceRequestObject) (CreateInvoiceResponseObject, error)
+	// Get invoice facets
+	// (GET /api/invoices/facets)
+	GetInvoiceFacets(ctx context.Context, request GetInvoiceFacetsRequestObject) (GetInvoiceFacetsResponseObject, error)
+	// Import invoices from a CSV file
+	// (POST /api/invoices/import)
+	ImportInvoices(ctx context.Context, request ImportInvoicesRequestObject) (ImportInvoicesResponseObject, error)
+	// Look up invoices by number or original link
+	// (GET /api/invoices/lookup)
+	LookupInvoices(ctx context.Context, request LookupInvoicesRequestObject) (LookupInvoicesResponseObject, error)
+	// Count invoices by status
+	// (GET /api/invoices/status-counts)
+	GetInvoiceStatusCounts(ctx context.Context, request GetInvoiceStatusCountsRequestObject) (GetInvoiceStatusCountsResponseObject, error)
+	// Delete invoice
+	// (DELETE /api/invoices/{id})
+	DeleteInvoice(ctx context.Context, request DeleteInvoiceRequestObject) (DeleteInvoiceResponseObject, error)
+	// Get invoice
+	// (GET /api/invoices/{id})
+	GetInvoice(ctx context.Context, request GetInvoiceRequestObject) (GetInvoiceResponseObject, error)
+	// Update invoice
+	// (PUT /api/invoices/{id})
+	UpdateInvoice(ctx context.Context, request UpdateInvoiceRequestObject) (UpdateInvoiceResponseObject, error)
+	// List invoice attachments
+	// (GET /api/invoices/{id}/attachments)
+	ListInvoiceAttachments(ctx context.Context, request ListInvoiceAttachmentsRequestObject) (ListInvoiceAttachmentsResponseObject, error)
+	// Add invoice attachment
+	// (POST /api/invoices/{id}/attachments)
+	AddInvoiceAttachment(ctx context.Context, request AddInvoiceAttachmentRequestObject) (AddInvoiceAttachmentResponseObject, error)
+	// Remove invoice attachment
+	// (DELETE /api/invoices/{id}/attachments/{attachmentId})
+	RemoveInvoiceAttachment(ctx context.Context, request RemoveInvoiceAttachmentRequestObject) (RemoveInvoiceAttachmentResponseObject, error)
+	// Get invoice audit trail
+	// (GET /api/invoices/{id}/audit)
+	GetInvoiceAuditTrail(ctx context.Context, request GetInvoiceAuditTrailRequestObject) (GetInvoiceAuditTrailResponseObject, error)
+	// Clone invoice
+	// (POST /api/invoices/{id}/clone)
+	CloneInvoice(ctx context.Context, request CloneInvoiceRequestObject) (CloneInvoiceResponseObject, error)
+	// Preview currency change
+	// (POST /api/invoices/{id}/convert/preview)
+	PreviewCurrencyConversion(ctx context.Context, request PreviewCurrencyConversionRequestObject) (PreviewCurrencyConversionResponseObject, error)
+	// Finalize a draft invoice
+	// (POST /api/invoices/{id}/finalize)
+	FinalizeInvoice(ctx context.Context, request FinalizeInvoiceRequestObject) (FinalizeInvoiceResponseObject, error)
+	// Add invoice item
+	// (POST /api/invoices/{id}/items)
+	AddInvoiceItem(ctx context.Context, request AddInvoiceItemRequestObject) (AddInvoiceItemResponseObject, error)
+	// Add invoice items in bulk
+	// (POST /api/invoices/{id}/items/batch)
+	AddInvoiceItems(ctx context.Context, request AddInvoiceItemsRequestObject) (AddInvoiceItemsResponseObject, error)
+	// Reorder invoice items
+	// (PUT /api/invoices/{id}/items/order)
+	ReorderInvoiceItems(ctx context.Context, request ReorderInvoiceItemsRequestObject) (ReorderInvoiceItemsResponseObject, error)
+	// Recalculate invoice totals
+	// (POST /api/invoices/{id}/recalculate)
+	RecalculateInvoiceTotals(ctx context.Context, request RecalculateInvoiceTotalsRequestObject) (RecalculateInvoiceTotalsResponseObject, error)
+	// Find similar invoices
+	// (GET /api/invoices/{id}/similar)
+	FindSimilarInvoices(ctx context.Context, request FindSimilarInvoicesRequestObject) (FindSimilarInvoicesResponseObject, error)
+	// Update invoice status
+	// (PATCH /api/invoices/{id}/status)
+	UpdateInvoiceStatus(ctx context.Context, request UpdateInvoiceStatusRequestObject) (UpdateInvoiceStatusResponseObject, error)
+	// Get invoice status history
+	// (GET /api/invoices/{id}/status-history)
+	GetInvoiceStatusHistory(ctx context.Context, request GetInvoiceStatusHistoryRequestObject) (GetInvoiceStatusHistoryResponseObject, error)
+	// Add tag to invoice
+	// (POST /api/invoices/{id}/tags)
+	AddTagToInvoice(ctx context.Context, request AddTagToInvoiceRequestObject) (AddTagToInvoiceResponseObject, error)
+	// Remove tag from invoice
+	// (DELETE /api/invoices/{id}/tags/{tagId})
+	RemoveTagFromInvoice(ctx context.Context, request RemoveTagFromInvoiceRequestObject) (RemoveTagFromInvoiceResponseObject, error)
+	// Delete invoice item
+	// (DELETE /api/invoices/{invoice_id}/items/{item_id})
+	DeleteInvoiceItem(ctx context.Context, request DeleteInvoiceItemRequestObject) (DeleteInvoiceItemResponseObject, error)
+	// Update invoice item
+	// (PUT /api/invoices/{invoice_id}/items/{item_id})
+	UpdateInvoiceItem(ctx context.Context, request UpdateInvoiceItemRequestObject) (UpdateInvoiceItemResponseObject, error)
+	// List organizations
+	// (GET /api/organizations)
+	ListOrganizations(ctx context.Context, request ListOrganizationsRequestObject) (ListOrganizationsResponseObject, error)
+	// Create organization
+	// (POST /api/organizations)
+	CreateOrganization(ctx context.Context, request CreateOrganizationRequestObject) (CreateOrganizationResponseObject, error)
+	// List organization members
+	// (GET /api/organizations/{id}/members)
+	ListOrganizationMembers(ctx context.Context, request ListOrganizationMembersRequestObject) (ListOrganizationMembersResponseObject, error)
+	// Remove organization member
+	// (DELETE /api/organizations/{id}/members/{user_id})
+	RemoveOrganizationMember(ctx context.Context, request RemoveOrganizationMemberRequestObject) (RemoveOrganizationMemberResponseObject, error)
+	// Add or update organization member
+	// (PUT /api/organizations/{id}/members/{user_id})
+	SetOrganizationMember(ctx context.Context, request SetOrganizationMemberRequestObject) (SetOrganizationMemberResponseObject, error)
+	// List payment methods
+	// (GET /api/payment-methods)
+	ListPaymentMethods(ctx context.Context, request ListPaymentMethodsRequestObject) (ListPaymentMethodsResponseObject, error)
+	// List receivers
+	// (GET /api/receivers)
+	ListReceivers(ctx context.Context, request ListReceiversRequestObject) (ListReceiversResponseObject, error)
+	// Create receiver
+	// (POST /api/receivers)
+	CreateReceiver(ctx context.Context, request CreateReceiverRequestObject) (CreateReceiverResponseObject, error)
+	// Merge receivers
+	// (POST /api/receivers/merge)
+	MergeReceivers(ctx context.Context, request MergeReceiversRequestObject) (MergeReceiversResponseObject, error)
+	// Delete receiver
+	// (DELETE /api/receivers/{id})
+	DeleteReceiver(ctx context.Context, request DeleteReceiverRequestObject) (DeleteReceiverResponseObject, error)
+	// Get receiver
+	// (GET /api/receivers/{id})
+	GetReceiver(ctx context.Context, request GetReceiverRequestObject) (GetReceiverResponseObject, error)
+	// Update receiver
+	// (PUT /api/receivers/{id})
+	UpdateReceiver(ctx context.Context, request UpdateReceiverRequestObject) (UpdateReceiverResponseObject, error)
+	// Get receiver statement
+	// (GET /api/receivers/{id}/statement)
+	GetReceiverStatement(ctx context.Context, request GetReceiverStatementRequestObject) (GetReceiverStatementResponseObject, error)
+	// Get receiver statistics
+	// (GET /api/receivers/{id}/statistics)
+	GetReceiverStatistics(ctx context.Context, request GetReceiverStatisticsRequestObject) (GetReceiverStatisticsResponseObject, error)
+	// Get user settings
+	// (GET /api/settings)
+	GetSettings(ctx context.Context, request GetSettingsRequestObject) (GetSettingsResponseObject, error)
+	// Update user settings
+	// (PUT /api/settings)
+	UpdateSettings(ctx context.Context, request UpdateSettingsRequestObject) (UpdateSettingsResponseObject, error)
+	// List tags
+	// (GET /api/tags)
+	ListTags(ctx context.Context, request ListTagsRequestObject) (ListTagsResponseObject, error)
+	// Create tag
+	// (POST /api/tags)
+	CreateTag(ctx context.Context, request CreateTagRequestObject) (CreateTagResponseObject, error)
+	// Delete tag
+	// (DELETE /api/tags/{id})
+	DeleteTag(ctx context.Context, request DeleteTagRequestObject) (DeleteTagResponseObject, error)
+	// Get tag
+	// (GET /api/tags/{id})
+	GetTag(ctx context.Context, request GetTagRequestObject) (GetTagResponseObject, error)
+	// Update tag
+	// (PUT /api/tags/{id})
+	UpdateTag(ctx context.Context, request UpdateTagRequestObject) (UpdateTagResponseObject, error)
+	// Upload file
+	// (POST /api/upload)
+	UploadFile(ctx context.Context, request UploadFileRequestObject) (UploadFileResponseObject, error)
+	// Confirm presigned upload
+	// (POST /api/upload/confirm)
+	ConfirmPresignedUpload(ctx context.Context, request ConfirmPresignedUploadRequestObject) (ConfirmPresignedUploadResponseObject, error)
+	// Convert HTML to PDF and upload
+	// (POST /api/upload/html-to-pdf)
+	UploadHtmlToPdf(ctx context.Context, request UploadHtmlToPdfRequestObject) (UploadHtmlToPdfResponseObject, error)
+	// Get presigned upload URL
+	// (GET /api/upload/presigned)
+	GetPresignedURL(ctx context.Context, request GetPresignedURLRequestObject) (GetPresignedURLResponseObject, error)
+	// Health check
+	// (GET /health)
+	HealthCheck(ctx context.Context, request HealthCheckRequestObject) (HealthCheckResponseObject, error)
+}
+
+type StrictHandlerFunc func(ctx *fiber.Ctx, args interface{}) (interface{}, error)
+
+type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetAnalyticsByCategory operation middleware
+func (sh *strictHandler) GetAnalyticsByCategory(ctx *fiber.Ctx, params GetAnalyticsByCategoryParams) error {
+	var request GetAnalyticsByCategoryRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAnalyticsByCategory(ctx.UserContext(), request.(GetAnalyticsByCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAnalyticsByCategory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetAnalyticsByCategoryResponseObject); ok {
+		if err := validResponse.VisitGetAnalyticsByCategoryResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAnalyticsByCompany operation middleware
+func (sh *strictHandler) GetAnalyticsByCompany(ctx *fiber.Ctx, params GetAnalyticsByCompanyParams) error {
+	var request GetAnalyticsByCompanyRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAnalyticsByCompany(ctx.UserContext(), request.(GetAnalyticsByCompanyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAnalyticsByCompany")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetAnalyticsByCompanyResponseObject); ok {
+		if err := validResponse.VisitGetAnalyticsByCompanyResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAnalyticsByReceiver operation middleware
+func (sh *strictHandler) GetAnalyticsByReceiver(ctx *fiber.Ctx, params GetAnalyticsByReceiverParams) error {
+	var request GetAnalyticsByReceiverRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAnalyticsByReceiver(ctx.UserContext(), request.(GetAnalyticsByReceiverRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAnalyticsByReceiver")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetAnalyticsByReceiverResponseObject); ok {
+		if err := validResponse.VisitGetAnalyticsByReceiverResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAnalyticsByTag operation middleware
+func (sh *strictHandler) GetAnalyticsByTag(ctx *fiber.Ctx, params GetAnalyticsByTagParams) error {
+	var request GetAnalyticsByTagRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAnalyticsByTag(ctx.UserContext(), request.(GetAnalyticsByTagRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAnalyticsByTag")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetAnalyticsByTagResponseObject); ok {
+		if err := validResponse.VisitGetAnalyticsByTagResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAnalyticsSummary operation middleware
+func (sh *strictHandler) GetAnalyticsSummary(ctx *fiber.Ctx, params GetAnalyticsSummaryParams) error {
+	var request GetAnalyticsSummaryRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAnalyticsSummary(ctx.UserContext(), request.(GetAnalyticsSummaryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAnalyticsSummary")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetAnalyticsSummaryResponseObject); ok {
+		if err := validResponse.VisitGetAnalyticsSummaryResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAnalyticsTrend operation middleware
+func (sh *strictHandler) GetAnalyticsTrend(ctx *fiber.Ctx, params GetAnalyticsTrendParams) error {
+	var request GetAnalyticsTrendRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAnalyticsTrend(ctx.UserContext(), request.(GetAnalyticsTrendRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAnalyticsTrend")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetAnalyticsTrendResponseObject); ok {
+		if err := validResponse.VisitGetAnalyticsTrendResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListBudgets operation middleware
+func (sh *strictHandler) ListBudgets(ctx *fiber.Ctx) error {
+	var request ListBudgetsRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ListBudgets(ctx.UserContext(), request.(ListBudgetsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListBudgets")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ListBudgetsResponseObject); ok {
+		if err := validResponse.VisitListBudgetsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreateBudget operation middleware
+func (sh *strictHandler) CreateBudget(ctx *fiber.Ctx) error {
+	var request CreateBudgetRequestObject
+
+	var body CreateBudgetJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateBudget(ctx.UserContext(), request.(CreateBudgetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateBudget")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(CreateBudgetResponseObject); ok {
+		if err := validResponse.VisitCreateBudgetResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetBudgetStatus operation middleware
+func (sh *strictHandler) GetBudgetStatus(ctx *fiber.Ctx, params GetBudgetStatusParams) error {
+	var request GetBudgetStatusRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBudgetStatus(ctx.UserContext(), request.(GetBudgetStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBudgetStatus")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetBudgetStatusResponseObject); ok {
+		if err := validResponse.VisitGetBudgetStatusResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteBudget operation middleware
+func (sh *strictHandler) DeleteBudget(ctx *fiber.Ctx, id int) error {
+	var request DeleteBudgetRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteBudget(ctx.UserContext(), request.(DeleteBudgetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteBudget")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(DeleteBudgetResponseObject); ok {
+		if err := validResponse.VisitDeleteBudgetResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListCategories operation middleware
+func (sh *strictHandler) ListCategories(ctx *fiber.Ctx, params ListCategoriesParams) error {
+	var request ListCategoriesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCategories(ctx.UserContext(), request.(ListCategoriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCategories")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ListCategoriesResponseObject); ok {
+		if err := validResponse.VisitListCategoriesResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreateCategory operation middleware
+func (sh *strictHandler) CreateCategory(ctx *fiber.Ctx) error {
+	var request CreateCategoryRequestObject
+
+	var body CreateCategoryJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCategory(ctx.UserContext(), request.(CreateCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCategory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(CreateCategoryResponseObject); ok {
+		if err := validResponse.VisitCreateCategoryResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteCategory operation middleware
+func (sh *strictHandler) DeleteCategory(ctx *fiber.Ctx, id CategoryId, params DeleteCategoryParams) error {
+	var request DeleteCategoryRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCategory(ctx.UserContext(), request.(DeleteCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCategory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(DeleteCategoryResponseObject); ok {
+		if err := validResponse.VisitDeleteCategoryResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCategory operation middleware
+func (sh *strictHandler) GetCategory(ctx *fiber.Ctx, id CategoryId) error {
+	var request GetCategoryRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCategory(ctx.UserContext(), request.(GetCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCategory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetCategoryResponseObject); ok {
+		if err := validResponse.VisitGetCategoryResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UpdateCategory operation middleware
+func (sh *strictHandler) UpdateCategory(ctx *fiber.Ctx, id CategoryId) error {
+	var request UpdateCategoryRequestObject
+
+	request.Id = id
+
+	var body UpdateCategoryJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCategory(ctx.UserContext(), request.(UpdateCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCategory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(UpdateCategoryResponseObject); ok {
+		if err := validResponse.VisitUpdateCategoryResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListCompanies operation middleware
+func (sh *strictHandler) ListCompanies(ctx *fiber.Ctx, params ListCompaniesParams) error {
+	var request ListCompaniesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCompanies(ctx.UserContext(), request.(ListCompaniesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCompanies")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ListCompaniesResponseObject); ok {
+		if err := validResponse.VisitListCompaniesResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreateCompany operation middleware
+func (sh *strictHandler) CreateCompany(ctx *fiber.Ctx) error {
+	var request CreateCompanyRequestObject
+
+	var body CreateCompanyJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCompany(ctx.UserContext(), request.(CreateCompanyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCompany")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(CreateCompanyResponseObject); ok {
+		if err := validResponse.VisitCreateCompanyResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteCompany operation middleware
+func (sh *strictHandler) DeleteCompany(ctx *fiber.Ctx, id CompanyId, params DeleteCompanyParams) error {
+	var request DeleteCompanyRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCompany(ctx.UserContext(), request.(DeleteCompanyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCompany")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(DeleteCompanyResponseObject); ok {
+		if err := validResponse.VisitDeleteCompanyResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCompany operation middleware
+func (sh *strictHandler) GetCompany(ctx *fiber.Ctx, id CompanyId) error {
+	var request GetCompanyRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompany(ctx.UserContext(), request.(GetCompanyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompany")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetCompanyResponseObject); ok {
+		if err := validResponse.VisitGetCompanyResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UpdateCompany operation middleware
+func (sh *strictHandler) UpdateCompany(ctx *fiber.Ctx, id CompanyId) error {
+	var request UpdateCompanyRequestObject
+
+	request.Id = id
+
+	var body UpdateCompanyJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCompany(ctx.UserContext(), request.(UpdateCompanyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCompany")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(UpdateCompanyResponseObject); ok {
+		if err := validResponse.VisitUpdateCompanyResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCompanyBreakdown operation middleware
+func (sh *strictHandler) GetCompanyBreakdown(ctx *fiber.Ctx, id CompanyId, params GetCompanyBreakdownParams) error {
+	var request GetCompanyBreakdownRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyBreakdown(ctx.UserContext(), request.(GetCompanyBreakdownRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyBreakdown")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetCompanyBreakdownResponseObject); ok {
+		if err := validResponse.VisitGetCompanyBreakdownResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetDashboard operation middleware
+func (sh *strictHandler) GetDashboard(ctx *fiber.Ctx, params GetDashboardParams) error {
+	var request GetDashboardRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDashboard(ctx.UserContext(), request.(GetDashboardRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDashboard")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetDashboardResponseObject); ok {
+		if err := validResponse.VisitGetDashboardResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ExportData operation middleware
+func (sh *strictHandler) ExportData(ctx *fiber.Ctx) error {
+	var request ExportDataRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportData(ctx.UserContext(), request.(ExportDataRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportData")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ExportDataResponseObject); ok {
+		if err := validResponse.VisitExportDataResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// RequestExport operation middleware
+func (sh *strictHandler) RequestExport(ctx *fiber.Ctx) error {
+	var request RequestExportRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.RequestExport(ctx.UserContext(), request.(RequestExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RequestExport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(RequestExportResponseObject); ok {
+		if err := validResponse.VisitRequestExportResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetExportJob operation middleware
+func (sh *strictHandler) GetExportJob(ctx *fiber.Ctx, id int) error {
+	var request GetExportJobRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetExportJob(ctx.UserContext(), request.(GetExportJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetExportJob")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetExportJobResponseObject); ok {
+		if err := validResponse.VisitGetExportJobResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetFileDownloadURL operation middleware
+func (sh *strictHandler) GetFileDownloadURL(ctx *fiber.Ctx, key string) error {
+	var request GetFileDownloadURLRequestObject
+
+	request.Key = key
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFileDownloadURL(ctx.UserContext(), request.(GetFileDownloadURLRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFileDownloadURL")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetFileDownloadURLResponseObject); ok {
+		if err := validResponse.VisitGetFileDownloadURLResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ImportData operation middleware
+func (sh *strictHandler) ImportData(ctx *fiber.Ctx) error {
+	var request ImportDataRequestObject
+
+	var body ImportDataJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportData(ctx.UserContext(), request.(ImportDataRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportData")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ImportDataResponseObject); ok {
+		if err := validResponse.VisitImportDataResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListInvoices operation middleware
+func (sh *strictHandler) ListInvoices(ctx *fiber.Ctx, params ListInvoicesParams) error {
+	var request ListInvoicesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ListInvoices(ctx.UserContext(), request.(ListInvoicesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListInvoices")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ListInvoicesResponseObject); ok {
+		if err := validResponse.VisitListInvoicesResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreateInvoice operation middleware
+func (sh *strictHandler) CreateInvoice(ctx *fiber.Ctx) error {
+	var request CreateInvoiceRequestObject
+
+	var body CreateInvoiceJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateInvoice(ctx.UserContext(), request.(CreateInvoiceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateInvoice")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(CreateInvoiceResponseObject); ok {
+		if err := validResponse.VisitCreateInvoiceResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type StrictHandlerFunc func(ctx *fiber.Ctx, args interface{}) (interface{}, error)
+// GetInvoiceFacets operation middleware
+func (sh *strictHandler) GetInvoiceFacets(ctx *fiber.Ctx) error {
+	var request GetInvoiceFacetsRequestObject
 
-type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetInvoiceFacets(ctx.UserContext(), request.(GetInvoiceFacetsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetInvoiceFacets")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	response, err := handler(ctx, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetInvoiceFacetsResponseObject); ok {
+		if err := validResponse.VisitGetInvoiceFacetsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// GetAnalyticsByCategory operation middleware
-func (sh *strictHandler) GetAnalyticsByCategory(ctx *fiber.Ctx, params GetAnalyticsByCategoryParams) error {
-	var request GetAnalyticsByCategoryRequestObject
+// ImportInvoices operation middleware
+func (sh *strictHandler) ImportInvoices(ctx *fiber.Ctx, params ImportInvoicesParams) error {
+	var request ImportInvoicesRequestObject
 
 	request.Params = params
 
+	request.Body = multipart.NewReader(bytes.NewReader(ctx.Request().Body()), string(ctx.Request().Header.MultipartFormBoundary()))
+
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAnalyticsByCategory(ctx.UserContext(), request.(GetAnalyticsByCategoryRequestObject))
+		return sh.ssi.ImportInvoices(ctx.UserContext(), request.(ImportInvoicesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAnalyticsByCategory")
+		handler = middleware(handler, "ImportInvoices")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(GetAnalyticsByCategoryResponseObject); ok {
-		if err := validResponse.VisitGetAnalyticsByCategoryResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(ImportInvoicesResponseObject); ok {
+		if err := validResponse.VisitImportInvoicesResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2875,25 +6607,25 @@ func (sh *strictHandler) GetAnalyticsByCategory(ctx *fiber.Ctx, params GetAnalyt
 	return nil
 }
 
-// GetAnalyticsByCompany operation middleware
-func (sh *strictHandler) GetAnalyticsByCompany(ctx *fiber.Ctx, params GetAnalyticsByCompanyParams) error {
-	var request GetAnalyticsByCompanyRequestObject
+// LookupInvoices operation middleware
+func (sh *strictHandler) LookupInvoices(ctx *fiber.Ctx, params LookupInvoicesParams) error {
+	var request LookupInvoicesRequestObject
 
 	request.Params = params
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAnalyticsByCompany(ctx.UserContext(), request.(GetAnalyticsByCompanyRequestObject))
+		return sh.ssi.LookupInvoices(ctx.UserContext(), request.(LookupInvoicesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAnalyticsByCompany")
+		handler = middleware(handler, "LookupInvoices")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(GetAnalyticsByCompanyResponseObject); ok {
-		if err := validResponse.VisitGetAnalyticsByCompanyResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(LookupInvoicesResponseObject); ok {
+		if err := validResponse.VisitLookupInvoicesResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2902,25 +6634,50 @@ func (sh *strictHandler) GetAnalyticsByCompany(ctx *fiber.Ctx, params GetAnalyti
 	return nil
 }
 
-// GetAnalyticsByReceiver operation middleware
-func (sh *strictHandler) GetAnalyticsByReceiver(ctx *fiber.Ctx, params GetAnalyticsByReceiverParams) error {
-	var request GetAnalyticsByReceiverRequestObject
+// GetInvoiceStatusCounts operation middleware
+func (sh *strictHandler) GetInvoiceStatusCounts(ctx *fiber.Ctx) error {
+	var request GetInvoiceStatusCountsRequestObject
 
-	request.Params = params
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetInvoiceStatusCounts(ctx.UserContext(), request.(GetInvoiceStatusCountsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetInvoiceStatusCounts")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetInvoiceStatusCountsResponseObject); ok {
+		if err := validResponse.VisitGetInvoiceStatusCountsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteInvoice operation middleware
+func (sh *strictHandler) DeleteInvoice(ctx *fiber.Ctx, id InvoiceId) error {
+	var request DeleteInvoiceRequestObject
+
+	request.Id = id
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAnalyticsByReceiver(ctx.UserContext(), request.(GetAnalyticsByReceiverRequestObject))
+		return sh.ssi.DeleteInvoice(ctx.UserContext(), request.(DeleteInvoiceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAnalyticsByReceiver")
+		handler = middleware(handler, "DeleteInvoice")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(GetAnalyticsByReceiverResponseObject); ok {
-		if err := validResponse.VisitGetAnalyticsByReceiverResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(DeleteInvoiceResponseObject); ok {
+		if err := validResponse.VisitDeleteInvoiceResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2929,25 +6686,26 @@ func (sh *strictHandler) GetAnalyticsByReceiver(ctx *fiber.Ctx, params GetAnalyt
 	return nil
 }
 
-// GetAnalyticsByTag operation middleware
-func (sh *strictHandler) GetAnalyticsByTag(ctx *fiber.Ctx, params GetAnalyticsByTagParams) error {
-	var request GetAnalyticsByTagRequestObject
+// GetInvoice operation middleware
+func (sh *strictHandler) GetInvoice(ctx *fiber.Ctx, id InvoiceId, params GetInvoiceParams) error {
+	var request GetInvoiceRequestObject
 
+	request.Id = id
 	request.Params = params
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAnalyticsByTag(ctx.UserContext(), request.(GetAnalyticsByTagRequestObject))
+		return sh.ssi.GetInvoice(ctx.UserContext(), request.(GetInvoiceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAnalyticsByTag")
+		handler = middleware(handler, "GetInvoice")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(GetAnalyticsByTagResponseObject); ok {
-		if err := validResponse.VisitGetAnalyticsByTagResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(GetInvoiceResponseObject); ok {
+		if err := validResponse.VisitGetInvoiceResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2956,25 +6714,31 @@ func (sh *strictHandler) GetAnalyticsByTag(ctx *fiber.Ctx, params GetAnalyticsBy
 	return nil
 }
 
-// GetAnalyticsSummary operation middleware
-func (sh *strictHandler) GetAnalyticsSummary(ctx *fiber.Ctx, params GetAnalyticsSummaryParams) error {
-	var request GetAnalyticsSummaryRequestObject
+// UpdateInvoice operation middleware
+func (sh *strictHandler) UpdateInvoice(ctx *fiber.Ctx, id InvoiceId) error {
+	var request UpdateInvoiceRequestObject
 
-	request.Params = params
+	request.Id = id
+
+	var body UpdateInvoiceJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAnalyticsSummary(ctx.UserContext(), request.(GetAnalyticsSummaryRequestObject))
+		return sh.ssi.UpdateInvoice(ctx.UserContext(), request.(UpdateInvoiceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAnalyticsSummary")
+		handler = middleware(handler, "UpdateInvoice")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(GetAnalyticsSummaryResponseObject); ok {
-		if err := validResponse.VisitGetAnalyticsSummaryResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(UpdateInvoiceResponseObject); ok {
+		if err := validResponse.VisitUpdateInvoiceResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -2983,25 +6747,25 @@ func (sh *strictHandler) GetAnalyticsSummary(ctx *fiber.Ctx, params GetAnalytics
 	return nil
 }
 
-// ListCategories operation middleware
-func (sh *strictHandler) ListCategories(ctx *fiber.Ctx, params ListCategoriesParams) error {
-	var request ListCategoriesRequestObject
+// ListInvoiceAttachments operation middleware
+func (sh *strictHandler) ListInvoiceAttachments(ctx *fiber.Ctx, id InvoiceId) error {
+	var request ListInvoiceAttachmentsRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.ListCategories(ctx.UserContext(), request.(ListCategoriesRequestObject))
+		return sh.ssi.ListInvoiceAttachments(ctx.UserContext(), request.(ListInvoiceAttachmentsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListCategories")
+		handler = middleware(handler, "ListInvoiceAttachments")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(ListCategoriesResponseObject); ok {
-		if err := validResponse.VisitListCategoriesResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(ListInvoiceAttachmentsResponseObject); ok {
+		if err := validResponse.VisitListInvoiceAttachmentsResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -3010,29 +6774,31 @@ func (sh *strictHandler) ListCategories(ctx *fiber.Ctx, params ListCategoriesPar
 	return nil
 }
 
-// CreateCategory operation middleware
-func (sh *strictHandler) CreateCategory(ctx *fiber.Ctx) error {
-	var request CreateCategoryRequestObject
+// AddInvoiceAttachment operation middleware
+func (sh *strictHandler) AddInvoiceAttachment(ctx *fiber.Ctx, id InvoiceId) error {
+	var request AddInvoiceAttachmentRequestObject
 
-	var body CreateCategoryJSONRequestBody
+	request.Id = id
+
+	var body AddInvoiceAttachmentJSONRequestBody
 	if err := ctx.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateCategory(ctx.UserContext(), request.(CreateCategoryRequestObject))
+		return sh.ssi.AddInvoiceAttachment(ctx.UserContext(), request.(AddInvoiceAttachmentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateCategory")
+		handler = middleware(handler, "AddInvoiceAttachment")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(CreateCategoryResponseObject); ok {
-		if err := validResponse.VisitCreateCategoryResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(AddInvoiceAttachmentResponseObject); ok {
+		if err := validResponse.VisitAddInvoiceAttachmentResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -3041,25 +6807,26 @@ func (sh *strictHandler) CreateCategory(ctx *fiber.Ctx) error {
 	return nil
 }
 
-// DeleteCategory operation middleware
-func (sh *strictHandler) DeleteCategory(ctx *fiber.Ctx, id CategoryId) error {
-	var request DeleteCategoryRequestObject
+// RemoveInvoiceAttachment operation middleware
+func (sh *strictHandler) RemoveInvoiceAttachment(ctx *fiber.Ctx, id InvoiceId, attachmentId int) error {
+	var request RemoveInvoiceAttachmentRequestObject
 
 	request.Id = id
+	request.AttachmentId = attachmentId
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCategory(ctx.UserContext(), request.(DeleteCategoryRequestObject))
+		return sh.ssi.RemoveInvoiceAttachment(ctx.UserContext(), request.(RemoveInvoiceAttachmentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCategory")
+		handler = middleware(handler, "RemoveInvoiceAttachment")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(DeleteCategoryResponseObject); ok {
-		if err := validResponse.VisitDeleteCategoryResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(RemoveInvoiceAttachmentResponseObject); ok {
+		if err := validResponse.VisitRemoveInvoiceAttachmentResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -3068,25 +6835,26 @@ func (sh *strictHandler) DeleteCategory(ctx *fiber.Ctx, id CategoryId) error {
 	return nil
 }
 
-// GetCategory operation middleware
-func (sh *strictHandler) GetCategory(ctx *fiber.Ctx, id CategoryId) error {
-	var request GetCategoryRequestObject
+// GetInvoiceAuditTrail operation middleware
+func (sh *strictHandler) GetInvoiceAuditTrail(ctx *fiber.Ctx, id InvoiceId, params GetInvoiceAuditTrailParams) error {
+	var request GetInvoiceAuditTrailRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCategory(ctx.UserContext(), request.(GetCategoryRequestObject))
+		return sh.ssi.GetInvoiceAuditTrail(ctx.UserContext(), request.(GetInvoiceAuditTrailRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCategory")
+		handler = middleware(handler, "GetInvoiceAuditTrail")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(GetCategoryResponseObject); ok {
-		if err := validResponse.VisitGetCategoryResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(GetInvoiceAuditTrailResponseObject); ok {
+		if err := validResponse.VisitGetInvoiceAuditTrailResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -3095,31 +6863,31 @@ func (sh *strictHandler) GetCategory(ctx *fiber.Ctx, id CategoryId) error {
 	return nil
 }
 
-// UpdateCategory operation middleware
-func (sh *strictHandler) UpdateCategory(ctx *fiber.Ctx, id CategoryId) error {
-	var request UpdateCategoryRequestObject
+// CloneInvoice operation middleware
+func (sh *strictHandler) CloneInvoice(ctx *fiber.Ctx, id InvoiceId) error {
+	var request CloneInvoiceRequestObject
 
 	request.Id = id
 
-	var body UpdateCategoryJSONRequestBody
+	var body CloneInvoiceJSONRequestBody
 	if err := ctx.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateCategory(ctx.UserContext(), request.(UpdateCategoryRequestObject))
+		return sh.ssi.CloneInvoice(ctx.UserContext(), request.(CloneInvoiceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateCategory")
+		handler = middleware(handler, "CloneInvoice")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(UpdateCategoryResponseObject); ok {
-		if err := validResponse.VisitUpdateCategoryResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(CloneInvoiceResponseObject); ok {
+		if err := validResponse.VisitCloneInvoiceResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -3128,25 +6896,31 @@ func (sh *strictHandler) UpdateCategory(ctx *fiber.Ctx, id CategoryId) error {
 	return nil
 }
 
-// ListCompanies operation middleware
-func (sh *strictHandler) ListCompanies(ctx *fiber.Ctx, params ListCompaniesParams) error {
-	var request ListCompaniesRequestObject
+// PreviewCurrencyConversion operation middleware
+func (sh *strictHandler) PreviewCurrencyConversion(ctx *fiber.Ctx, id InvoiceId) error {
+	var request PreviewCurrencyConversionRequestObject
 
-	request.Params = params
+	request.Id = id
+
+	var body PreviewCurrencyConversionJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.ListCompanies(ctx.UserContext(), request.(ListCompaniesRequestObject))
+		return sh.ssi.PreviewCurrencyConversion(ctx.UserContext(), request.(PreviewCurrencyConversionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListCompanies")
+		handler = middleware(handler, "PreviewCurrencyConversion")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(ListCompaniesResponseObject); ok {
-		if err := validResponse.VisitListCompaniesResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(PreviewCurrencyConversionResponseObject); ok {
+		if err := validResponse.VisitPreviewCurrencyConversionResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -3155,29 +6929,58 @@ func (sh *strictHandler) ListCompanies(ctx *fiber.Ctx, params ListCompaniesParam
 	return nil
 }
 
-// CreateCompany operation middleware
-func (sh *strictHandler) CreateCompany(ctx *fiber.Ctx) error {
-	var request CreateCompanyRequestObject
+// FinalizeInvoice operation middleware
+func (sh *strictHandler) FinalizeInvoice(ctx *fiber.Ctx, id InvoiceId) error {
+	var request FinalizeInvoiceRequestObject
 
-	var body CreateCompanyJSONRequestBody
+	request.Id = id
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.FinalizeInvoice(ctx.UserContext(), request.(FinalizeInvoiceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FinalizeInvoice")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(FinalizeInvoiceResponseObject); ok {
+		if err := validResponse.VisitFinalizeInvoiceResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AddInvoiceItem operation middleware
+func (sh *strictHandler) AddInvoiceItem(ctx *fiber.Ctx, id InvoiceId) error {
+	var request AddInvoiceItemRequestObject
+
+	request.Id = id
+
+	var body AddInvoiceItemJSONRequestBody
 	if err := ctx.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateCompany(ctx.UserContext(), request.(CreateCompanyRequestObject))
+		return sh.ssi.AddInvoiceItem(ctx.UserContext(), request.(AddInvoiceItemRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateCompany")
+		handler = middleware(handler, "AddInvoiceItem")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(CreateCompanyResponseObject); ok {
-		if err := validResponse.VisitCreateCompanyResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(AddInvoiceItemResponseObject); ok {
+		if err := validResponse.VisitAddInvoiceItemResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -3186,25 +6989,31 @@ func (sh *strictHandler) CreateCompany(ctx *fiber.Ctx) error {
 	return nil
 }
 
-// DeleteCompany operation middleware
-func (sh *strictHandler) DeleteCompany(ctx *fiber.Ctx, id CompanyId) error {
-	var request DeleteCompanyRequestObject
+// AddInvoiceItems operation middleware
+func (sh *strictHandler) AddInvoiceItems(ctx *fiber.Ctx, id InvoiceId) error {
+	var request AddInvoiceItemsRequestObject
 
 	request.Id = id
 
+	var body AddInvoiceItemsJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCompany(ctx.UserContext(), request.(DeleteCompanyRequestObject))
+		return sh.ssi.AddInvoiceItems(ctx.UserContext(), request.(AddInvoiceItemsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCompany")
+		handler = middleware(handler, "AddInvoiceItems")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(DeleteCompanyResponseObject); ok {
-		if err := validResponse.VisitDeleteCompanyResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(AddInvoiceItemsResponseObject); ok {
+		if err := validResponse.VisitAddInvoiceItemsResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -3213,25 +7022,31 @@ func (sh *strictHandler) DeleteCompany(ctx *fiber.Ctx, id CompanyId) error {
 	return nil
 }
 
-// GetCompany operation middleware
-func (sh *strictHandler) GetCompany(ctx *fiber.Ctx, id CompanyId) error {
-	var request GetCompanyRequestObject
+// ReorderInvoiceItems operation middleware
+func (sh *strictHandler) ReorderInvoiceItems(ctx *fiber.Ctx, id InvoiceId) error {
+	var request ReorderInvoiceItemsRequestObject
 
 	request.Id = id
 
+	var body ReorderInvoiceItemsJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCompany(ctx.UserContext(), request.(GetCompanyRequestObject))
+		return sh.ssi.ReorderInvoiceItems(ctx.UserContext(), request.(ReorderInvoiceItemsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCompany")
+		handler = middleware(handler, "ReorderInvoiceItems")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(GetCompanyResponseObject); ok {
-		if err := validResponse.VisitGetCompanyResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(ReorderInvoiceItemsResponseObject); ok {
+		if err := validResponse.VisitReorderInvoiceItemsResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -3240,31 +7055,53 @@ func (sh *strictHandler) GetCompany(ctx *fiber.Ctx, id CompanyId) error {
 	return nil
 }
 
-// UpdateCompany operation middleware
-func (sh *strictHandler) UpdateCompany(ctx *fiber.Ctx, id CompanyId) error {
-	var request UpdateCompanyRequestObject
+// RecalculateInvoiceTotals operation middleware
+func (sh *strictHandler) RecalculateInvoiceTotals(ctx *fiber.Ctx, id InvoiceId) error {
+	var request RecalculateInvoiceTotalsRequestObject
 
 	request.Id = id
 
-	var body UpdateCompanyJSONRequestBody
-	if err := ctx.BodyParser(&body); err != nil {
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.RecalculateInvoiceTotals(ctx.UserContext(), request.(RecalculateInvoiceTotalsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RecalculateInvoiceTotals")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(RecalculateInvoiceTotalsResponseObject); ok {
+		if err := validResponse.VisitRecalculateInvoiceTotalsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	request.Body = &body
+	return nil
+}
+
+// FindSimilarInvoices operation middleware
+func (sh *strictHandler) FindSimilarInvoices(ctx *fiber.Ctx, id InvoiceId, params FindSimilarInvoicesParams) error {
+	var request FindSimilarInvoicesRequestObject
+
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateCompany(ctx.UserContext(), request.(UpdateCompanyRequestObject))
+		return sh.ssi.FindSimilarInvoices(ctx.UserContext(), request.(FindSimilarInvoicesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateCompany")
+		handler = middleware(handler, "FindSimilarInvoices")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(UpdateCompanyResponseObject); ok {
-		if err := validResponse.VisitUpdateCompanyResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(FindSimilarInvoicesResponseObject); ok {
+		if err := validResponse.VisitFindSimilarInvoicesResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -3273,25 +7110,31 @@ func (sh *strictHandler) UpdateCompany(ctx *fiber.Ctx, id CompanyId) error {
 	return nil
 }
 
-// GetFileDownloadURL operation middleware
-func (sh *strictHandler) GetFileDownloadURL(ctx *fiber.Ctx, key string) error {
-	var request GetFileDownloadURLRequestObject
+// UpdateInvoiceStatus operation middleware
+func (sh *strictHandler) UpdateInvoiceStatus(ctx *fiber.Ctx, id InvoiceId) error {
+	var request UpdateInvoiceStatusRequestObject
+
+	request.Id = id
 
-	request.Key = key
+	var body UpdateInvoiceStatusJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.GetFileDownloadURL(ctx.UserContext(), request.(GetFileDownloadURLRequestObject))
+		return sh.ssi.UpdateInvoiceStatus(ctx.UserContext(), request.(UpdateInvoiceStatusRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetFileDownloadURL")
+		handler = middleware(handler, "UpdateInvoiceStatus")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(GetFileDownloadURLResponseObject); ok {
-		if err := validResponse.VisitGetFileDownloadURLResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(UpdateInvoiceStatusResponseObject); ok {
+		if err := validResponse.VisitUpdateInvoiceStatusResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -3300,25 +7143,26 @@ func (sh *strictHandler) GetFileDownloadURL(ctx *fiber.Ctx, key string) error {
 	return nil
 }
 
-// ListInvoices operation middleware
-func (sh *strictHandler) ListInvoices(ctx *fiber.Ctx, params ListInvoicesParams) error {
-	var request ListInvoicesRequestObject
+// GetInvoiceStatusHistory operation middleware
+func (sh *strictHandler) GetInvoiceStatusHistory(ctx *fiber.Ctx, id InvoiceId, params GetInvoiceStatusHistoryParams) error {
+	var request GetInvoiceStatusHistoryRequestObject
 
+	request.Id = id
 	request.Params = params
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.ListInvoices(ctx.UserContext(), request.(ListInvoicesRequestObject))
+		return sh.ssi.GetInvoiceStatusHistory(ctx.UserContext(), request.(GetInvoiceStatusHistoryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListInvoices")
+		handler = middleware(handler, "GetInvoiceStatusHistory")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(ListInvoicesResponseObject); ok {
-		if err := validResponse.VisitListInvoicesResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(GetInvoiceStatusHistoryResponseObject); ok {
+		if err := validResponse.VisitGetInvoiceStatusHistoryResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -3327,29 +7171,31 @@ func (sh *strictHandler) ListInvoices(ctx *fiber.Ctx, params ListInvoicesParams)
 	return nil
 }
 
-// CreateInvoice operation middleware
-func (sh *strictHandler) CreateInvoice(ctx *fiber.Ctx) error {
-	var request CreateInvoiceRequestObject
+// AddTagToInvoice operation middleware
+func (sh *strictHandler) AddTagToInvoice(ctx *fiber.Ctx, id InvoiceId) error {
+	var request AddTagToInvoiceRequestObject
 
-	var body CreateInvoiceJSONRequestBody
+	request.Id = id
+
+	var body AddTagToInvoiceJSONRequestBody
 	if err := ctx.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateInvoice(ctx.UserContext(), request.(CreateInvoiceRequestObject))
+		return sh.ssi.AddTagToInvoice(ctx.UserContext(), request.(AddTagToInvoiceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateInvoice")
+		handler = middleware(handler, "AddTagToInvoice")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(CreateInvoiceResponseObject); ok {
-		if err := validResponse.VisitCreateInvoiceResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(AddTagToInvoiceResponseObject); ok {
+		if err := validResponse.VisitAddTagToInvoiceResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -3358,25 +7204,26 @@ func (sh *strictHandler) CreateInvoice(ctx *fiber.Ctx) error {
 	return nil
 }
 
-// DeleteInvoice operation middleware
-func (sh *strictHandler) DeleteInvoice(ctx *fiber.Ctx, id InvoiceId) error {
-	var request DeleteInvoiceRequestObject
+// RemoveTagFromInvoice operation middleware
+func (sh *strictHandler) RemoveTagFromInvoice(ctx *fiber.Ctx, id InvoiceId, tagId int) error {
+	var request RemoveTagFromInvoiceRequestObject
 
 	request.Id = id
+	request.TagId = tagId
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteInvoice(ctx.UserContext(), request.(DeleteInvoiceRequestObject))
+		return sh.ssi.RemoveTagFromInvoice(ctx.UserContext(), request.(RemoveTagFromInvoiceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteInvoice")
+		handler = middleware(handler, "RemoveTagFromInvoice")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(DeleteInvoiceResponseObject); ok {
-		if err := validResponse.VisitDeleteInvoiceResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(RemoveTagFromInvoiceResponseObject); ok {
+		if err := validResponse.VisitRemoveTagFromInvoiceResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -3385,25 +7232,26 @@ func (sh *strictHandler) DeleteInvoice(ctx *fiber.Ctx, id InvoiceId) error {
 	return nil
 }
 
-// GetInvoice operation middleware
-func (sh *strictHandler) GetInvoice(ctx *fiber.Ctx, id InvoiceId) error {
-	var request GetInvoiceRequestObject
+// DeleteInvoiceItem operation middleware
+func (sh *strictHandler) DeleteInvoiceItem(ctx *fiber.Ctx, invoiceId int, itemId int) error {
+	var request DeleteInvoiceItemRequestObject
 
-	request.Id = id
+	request.InvoiceId = invoiceId
+	request.ItemId = itemId
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.GetInvoice(ctx.UserContext(), request.(GetInvoiceRequestObject))
+		return sh.ssi.DeleteInvoiceItem(ctx.UserContext(), request.(DeleteInvoiceItemRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetInvoice")
+		handler = middleware(handler, "DeleteInvoiceItem")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(GetInvoiceResponseObject); ok {
-		if err := validResponse.VisitGetInvoiceResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(DeleteInvoiceItemResponseObject); ok {
+		if err := validResponse.VisitDeleteInvoiceItemResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -3412,31 +7260,32 @@ func (sh *strictHandler) GetInvoice(ctx *fiber.Ctx, id InvoiceId) error {
 	return nil
 }
 
-// UpdateInvoice operation middleware
-func (sh *strictHandler) UpdateInvoice(ctx *fiber.Ctx, id InvoiceId) error {
-	var request UpdateInvoiceRequestObject
+// UpdateInvoiceItem operation middleware
+func (sh *strictHandler) UpdateInvoiceItem(ctx *fiber.Ctx, invoiceId int, itemId int) error {
+	var request UpdateInvoiceItemRequestObject
 
-	request.Id = id
+	request.InvoiceId = invoiceId
+	request.ItemId = itemId
 
-	var body UpdateInvoiceJSONRequestBody
+	var body UpdateInvoiceItemJSONRequestBody
 	if err := ctx.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateInvoice(ctx.UserContext(), request.(UpdateInvoiceRequestObject))
+		return sh.ssi.UpdateInvoiceItem(ctx.UserContext(), request.(UpdateInvoiceItemRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateInvoice")
+		handler = middleware(handler, "UpdateInvoiceItem")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(UpdateInvoiceResponseObject); ok {
-		if err := validResponse.VisitUpdateInvoiceResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(UpdateInvoiceItemResponseObject); ok {
+		if err := validResponse.VisitUpdateInvoiceItemResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -3445,31 +7294,23 @@ func (sh *strictHandler) UpdateInvoice(ctx *fiber.Ctx, id InvoiceId) error {
 	return nil
 }
 
-// AddInvoiceItem operation middleware
-func (sh *strictHandler) AddInvoiceItem(ctx *fiber.Ctx, id InvoiceId) error {
-	var request AddInvoiceItemRequestObject
-
-	request.Id = id
-
-	var body AddInvoiceItemJSONRequestBody
-	if err := ctx.BodyParser(&body); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	}
-	request.Body = &body
+// ListOrganizations operation middleware
+func (sh *strictHandler) ListOrganizations(ctx *fiber.Ctx) error {
+	var request ListOrganizationsRequestObject
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.AddInvoiceItem(ctx.UserContext(), request.(AddInvoiceItemRequestObject))
+		return sh.ssi.ListOrganizations(ctx.UserContext(), request.(ListOrganizationsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AddInvoiceItem")
+		handler = middleware(handler, "ListOrganizations")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(AddInvoiceItemResponseObject); ok {
-		if err := validResponse.VisitAddInvoiceItemResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(ListOrganizationsResponseObject); ok {
+		if err := validResponse.VisitListOrganizationsResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -3478,31 +7319,29 @@ func (sh *strictHandler) AddInvoiceItem(ctx *fiber.Ctx, id InvoiceId) error {
 	return nil
 }
 
-// UpdateInvoiceStatus operation middleware
-func (sh *strictHandler) UpdateInvoiceStatus(ctx *fiber.Ctx, id InvoiceId) error {
-	var request UpdateInvoiceStatusRequestObject
-
-	request.Id = id
+// CreateOrganization operation middleware
+func (sh *strictHandler) CreateOrganization(ctx *fiber.Ctx) error {
+	var request CreateOrganizationRequestObject
 
-	var body UpdateInvoiceStatusJSONRequestBody
+	var body CreateOrganizationJSONRequestBody
 	if err := ctx.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateInvoiceStatus(ctx.UserContext(), request.(UpdateInvoiceStatusRequestObject))
+		return sh.ssi.CreateOrganization(ctx.UserContext(), request.(CreateOrganizationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateInvoiceStatus")
+		handler = middleware(handler, "CreateOrganization")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(UpdateInvoiceStatusResponseObject); ok {
-		if err := validResponse.VisitUpdateInvoiceStatusResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(CreateOrganizationResponseObject); ok {
+		if err := validResponse.VisitCreateOrganizationResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -3511,31 +7350,25 @@ func (sh *strictHandler) UpdateInvoiceStatus(ctx *fiber.Ctx, id InvoiceId) error
 	return nil
 }
 
-// AddTagToInvoice operation middleware
-func (sh *strictHandler) AddTagToInvoice(ctx *fiber.Ctx, id InvoiceId) error {
-	var request AddTagToInvoiceRequestObject
+// ListOrganizationMembers operation middleware
+func (sh *strictHandler) ListOrganizationMembers(ctx *fiber.Ctx, id OrganizationId) error {
+	var request ListOrganizationMembersRequestObject
 
 	request.Id = id
 
-	var body AddTagToInvoiceJSONRequestBody
-	if err := ctx.BodyParser(&body); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	}
-	request.Body = &body
-
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.AddTagToInvoice(ctx.UserContext(), request.(AddTagToInvoiceRequestObject))
+		return sh.ssi.ListOrganizationMembers(ctx.UserContext(), request.(ListOrganizationMembersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AddTagToInvoice")
+		handler = middleware(handler, "ListOrganizationMembers")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(AddTagToInvoiceResponseObject); ok {
-		if err := validResponse.VisitAddTagToInvoiceResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(ListOrganizationMembersResponseObject); ok {
+		if err := validResponse.VisitListOrganizationMembersResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -3544,26 +7377,26 @@ func (sh *strictHandler) AddTagToInvoice(ctx *fiber.Ctx, id InvoiceId) error {
 	return nil
 }
 
-// RemoveTagFromInvoice operation middleware
-func (sh *strictHandler) RemoveTagFromInvoice(ctx *fiber.Ctx, id InvoiceId, tagId int) error {
-	var request RemoveTagFromInvoiceRequestObject
+// RemoveOrganizationMember operation middleware
+func (sh *strictHandler) RemoveOrganizationMember(ctx *fiber.Ctx, id OrganizationId, userId MemberUserId) error {
+	var request RemoveOrganizationMemberRequestObject
 
 	request.Id = id
-	request.TagId = tagId
+	request.UserId = userId
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.RemoveTagFromInvoice(ctx.UserContext(), request.(RemoveTagFromInvoiceRequestObject))
+		return sh.ssi.RemoveOrganizationMember(ctx.UserContext(), request.(RemoveOrganizationMemberRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RemoveTagFromInvoice")
+		handler = middleware(handler, "RemoveOrganizationMember")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(RemoveTagFromInvoiceResponseObject); ok {
-		if err := validResponse.VisitRemoveTagFromInvoiceResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(RemoveOrganizationMemberResponseObject); ok {
+		if err := validResponse.VisitRemoveOrganizationMemberResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -3572,26 +7405,32 @@ func (sh *strictHandler) RemoveTagFromInvoice(ctx *fiber.Ctx, id InvoiceId, tagI
 	return nil
 }
 
-// DeleteInvoiceItem operation middleware
-func (sh *strictHandler) DeleteInvoiceItem(ctx *fiber.Ctx, invoiceId int, itemId int) error {
-	var request DeleteInvoiceItemRequestObject
+// SetOrganizationMember operation middleware
+func (sh *strictHandler) SetOrganizationMember(ctx *fiber.Ctx, id OrganizationId, userId MemberUserId) error {
+	var request SetOrganizationMemberRequestObject
 
-	request.InvoiceId = invoiceId
-	request.ItemId = itemId
+	request.Id = id
+	request.UserId = userId
+
+	var body SetOrganizationMemberJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteInvoiceItem(ctx.UserContext(), request.(DeleteInvoiceItemRequestObject))
+		return sh.ssi.SetOrganizationMember(ctx.UserContext(), request.(SetOrganizationMemberRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteInvoiceItem")
+		handler = middleware(handler, "SetOrganizationMember")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(DeleteInvoiceItemResponseObject); ok {
-		if err := validResponse.VisitDeleteInvoiceItemResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(SetOrganizationMemberResponseObject); ok {
+		if err := validResponse.VisitSetOrganizationMemberResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -3600,32 +7439,23 @@ func (sh *strictHandler) DeleteInvoiceItem(ctx *fiber.Ctx, invoiceId int, itemId
 	return nil
 }
 
-// UpdateInvoiceItem operation middleware
-func (sh *strictHandler) UpdateInvoiceItem(ctx *fiber.Ctx, invoiceId int, itemId int) error {
-	var request UpdateInvoiceItemRequestObject
-
-	request.InvoiceId = invoiceId
-	request.ItemId = itemId
-
-	var body UpdateInvoiceItemJSONRequestBody
-	if err := ctx.BodyParser(&body); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	}
-	request.Body = &body
+// ListPaymentMethods operation middleware
+func (sh *strictHandler) ListPaymentMethods(ctx *fiber.Ctx) error {
+	var request ListPaymentMethodsRequestObject
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateInvoiceItem(ctx.UserContext(), request.(UpdateInvoiceItemRequestObject))
+		return sh.ssi.ListPaymentMethods(ctx.UserContext(), request.(ListPaymentMethodsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateInvoiceItem")
+		handler = middleware(handler, "ListPaymentMethods")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
-	} else if validResponse, ok := response.(UpdateInvoiceItemResponseObject); ok {
-		if err := validResponse.VisitUpdateInvoiceItemResponse(ctx); err != nil {
+	} else if validResponse, ok := response.(ListPaymentMethodsResponseObject); ok {
+		if err := validResponse.VisitListPaymentMethodsResponse(ctx); err != nil {
 			return fiber.NewError(fiber.StatusBadRequest, err.Error())
 		}
 	} else if response != nil {
@@ -3724,10 +7554,11 @@ func (sh *strictHandler) MergeReceivers(ctx *fiber.Ctx) error {
 }
 
 // DeleteReceiver operation middleware
-func (sh *strictHandler) DeleteReceiver(ctx *fiber.Ctx, id ReceiverId) error {
+func (sh *strictHandler) DeleteReceiver(ctx *fiber.Ctx, id ReceiverId, params DeleteReceiverParams) error {
 	var request DeleteReceiverRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
 		return sh.ssi.DeleteReceiver(ctx.UserContext(), request.(DeleteReceiverRequestObject))
@@ -3810,6 +7641,118 @@ func (sh *strictHandler) UpdateReceiver(ctx *fiber.Ctx, id ReceiverId) error {
 	return nil
 }
 
+// GetReceiverStatement operation middleware
+func (sh *strictHandler) GetReceiverStatement(ctx *fiber.Ctx, id ReceiverId, params GetReceiverStatementParams) error {
+	var request GetReceiverStatementRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReceiverStatement(ctx.UserContext(), request.(GetReceiverStatementRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReceiverStatement")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetReceiverStatementResponseObject); ok {
+		if err := validResponse.VisitGetReceiverStatementResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetReceiverStatistics operation middleware
+func (sh *strictHandler) GetReceiverStatistics(ctx *fiber.Ctx, id ReceiverId, params GetReceiverStatisticsParams) error {
+	var request GetReceiverStatisticsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReceiverStatistics(ctx.UserContext(), request.(GetReceiverStatisticsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReceiverStatistics")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetReceiverStatisticsResponseObject); ok {
+		if err := validResponse.VisitGetReceiverStatisticsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetSettings operation middleware
+func (sh *strictHandler) GetSettings(ctx *fiber.Ctx) error {
+	var request GetSettingsRequestObject
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSettings(ctx.UserContext(), request.(GetSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSettings")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetSettingsResponseObject); ok {
+		if err := validResponse.VisitGetSettingsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UpdateSettings operation middleware
+func (sh *strictHandler) UpdateSettings(ctx *fiber.Ctx) error {
+	var request UpdateSettingsRequestObject
+
+	var body UpdateSettingsJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateSettings(ctx.UserContext(), request.(UpdateSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateSettings")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(UpdateSettingsResponseObject); ok {
+		if err := validResponse.VisitUpdateSettingsResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // ListTags operation middleware
 func (sh *strictHandler) ListTags(ctx *fiber.Ctx, params ListTagsParams) error {
 	var request ListTagsRequestObject
@@ -22,6 +22,68 @@ const (
 	OAuth2Scopes     = "OAuth2.Scopes"
 )
 
+// Defines values for AuditLogEntryAction.
+const (
+	AuditLogEntryActionCreate       AuditLogEntryAction = "create"
+	AuditLogEntryActionDelete       AuditLogEntryAction = "delete"
+	AuditLogEntryActionStatusChange AuditLogEntryAction = "status_change"
+	AuditLogEntryActionUpdate       AuditLogEntryAction = "update"
+)
+
+// Defines values for AuditLogEntryEntityType.
+const (
+	AuditLogEntryEntityTypeInvoice     AuditLogEntryEntityType = "invoice"
+	AuditLogEntryEntityTypeInvoiceItem AuditLogEntryEntityType = "invoice_item"
+)
+
+// Defines values for BudgetPeriod.
+const (
+	Monthly   BudgetPeriod = "monthly"
+	Quarterly BudgetPeriod = "quarterly"
+	Yearly    BudgetPeriod = "yearly"
+)
+
+// Defines values for DiscountType.
+const (
+	Fixed   DiscountType = "fixed"
+	Percent DiscountType = "percent"
+)
+
+// Defines values for ExportJobStatus.
+const (
+	Completed ExportJobStatus = "completed"
+	Expired   ExportJobStatus = "expired"
+	Failed    ExportJobStatus = "failed"
+	Pending   ExportJobStatus = "pending"
+	Running   ExportJobStatus = "running"
+)
+
+// Defines values for HealthStatusDatabaseStatus.
+const (
+	HealthStatusDatabaseStatusError HealthStatusDatabaseStatus = "error"
+	HealthStatusDatabaseStatusOk    HealthStatusDatabaseStatus = "ok"
+)
+
+// Defines values for HealthStatusStatus.
+const (
+	HealthStatusStatusOk          HealthStatusStatus = "ok"
+	HealthStatusStatusUnavailable HealthStatusStatus = "unavailable"
+)
+
+// Defines values for InvoiceImportRowStatus.
+const (
+	InvoiceImportRowStatusCreated InvoiceImportRowStatus = "created"
+	InvoiceImportRowStatusError   InvoiceImportRowStatus = "error"
+	InvoiceImportRowStatusSkipped InvoiceImportRowStatus = "skipped"
+)
+
+// Defines values for InvoiceRelationType.
+const (
+	Correction InvoiceRelationType = "correction"
+	CreditNote InvoiceRelationType = "credit_note"
+	Refund     InvoiceRelationType = "refund"
+)
+
 // Defines values for InvoiceStatus.
 const (
 	Overdue InvoiceStatus = "overdue"
@@ -29,6 +91,32 @@ const (
 	Unpaid  InvoiceStatus = "unpaid"
 )
 
+// Defines values for OrganizationRole.
+const (
+	Member OrganizationRole = "member"
+	Owner  OrganizationRole = "owner"
+	Viewer OrganizationRole = "viewer"
+)
+
+// Defines values for PaymentTrackingMode.
+const (
+	Manual PaymentTrackingMode = "manual"
+)
+
+// Defines values for UnsupportedCurrencyPolicy.
+const (
+	PassThrough UnsupportedCurrencyPolicy = "pass_through"
+	Reject      UnsupportedCurrencyPolicy = "reject"
+)
+
+// Defines values for AuditAction.
+const (
+	AuditActionCreate       AuditAction = "create"
+	AuditActionDelete       AuditAction = "delete"
+	AuditActionStatusChange AuditAction = "status_change"
+	AuditActionUpdate       AuditAction = "update"
+)
+
 // Defines values for GetAnalyticsByCategoryParamsPeriod.
 const (
 	GetAnalyticsByCategoryParamsPeriodN1m GetAnalyticsByCategoryParamsPeriod = "1m"
@@ -59,36 +147,134 @@ const (
 
 // Defines values for GetAnalyticsSummaryParamsPeriod.
 const (
-	N1m GetAnalyticsSummaryParamsPeriod = "1m"
-	N1y GetAnalyticsSummaryParamsPeriod = "1y"
-	N7d GetAnalyticsSummaryParamsPeriod = "7d"
+	GetAnalyticsSummaryParamsPeriodN1m GetAnalyticsSummaryParamsPeriod = "1m"
+	GetAnalyticsSummaryParamsPeriodN1y GetAnalyticsSummaryParamsPeriod = "1y"
+	GetAnalyticsSummaryParamsPeriodN7d GetAnalyticsSummaryParamsPeriod = "7d"
+)
+
+// Defines values for GetAnalyticsSummaryParamsPaidBy.
+const (
+	InvoiceDate GetAnalyticsSummaryParamsPaidBy = "invoice_date"
+	PaymentDate GetAnalyticsSummaryParamsPaidBy = "payment_date"
+)
+
+// Defines values for GetCompanyBreakdownParamsPeriod.
+const (
+	GetCompanyBreakdownParamsPeriodLastDay   GetCompanyBreakdownParamsPeriod = "last_day"
+	GetCompanyBreakdownParamsPeriodLastMonth GetCompanyBreakdownParamsPeriod = "last_month"
+	GetCompanyBreakdownParamsPeriodLastWeek  GetCompanyBreakdownParamsPeriod = "last_week"
+	GetCompanyBreakdownParamsPeriodLastYear  GetCompanyBreakdownParamsPeriod = "last_year"
 )
 
-// Defines values for ListInvoicesParamsSortBy.
+// Defines values for GetDashboardParamsPeriod.
 const (
-	Amount    ListInvoicesParamsSortBy = "amount"
-	CreatedAt ListInvoicesParamsSortBy = "created_at"
-	DueDate   ListInvoicesParamsSortBy = "due_date"
-	Title     ListInvoicesParamsSortBy = "title"
-	UpdatedAt ListInvoicesParamsSortBy = "updated_at"
+	GetDashboardParamsPeriodN1m GetDashboardParamsPeriod = "1m"
+	GetDashboardParamsPeriodN1y GetDashboardParamsPeriod = "1y"
+	GetDashboardParamsPeriodN7d GetDashboardParamsPeriod = "7d"
+)
+
+// Defines values for ListInvoicesParamsTagMatch.
+const (
+	All ListInvoicesParamsTagMatch = "all"
+	Any ListInvoicesParamsTagMatch = "any"
 )
 
 // Defines values for ListInvoicesParamsSortOrder.
 const (
-	Asc  ListInvoicesParamsSortOrder = "asc"
-	Desc ListInvoicesParamsSortOrder = "desc"
+	ListInvoicesParamsSortOrderAsc  ListInvoicesParamsSortOrder = "asc"
+	ListInvoicesParamsSortOrderDesc ListInvoicesParamsSortOrder = "desc"
+)
+
+// Defines values for ListInvoicesParamsCursorDirection.
+const (
+	ListInvoicesParamsCursorDirectionAsc  ListInvoicesParamsCursorDirection = "asc"
+	ListInvoicesParamsCursorDirectionDesc ListInvoicesParamsCursorDirection = "desc"
+)
+
+// Defines values for ListInvoicesParamsAmountField.
+const (
+	ListInvoicesParamsAmountFieldAmount       ListInvoicesParamsAmountField = "amount"
+	ListInvoicesParamsAmountFieldTargetAmount ListInvoicesParamsAmountField = "target_amount"
+)
+
+// Defines values for ImportInvoicesParamsFormat.
+const (
+	Csv ImportInvoicesParamsFormat = "csv"
+)
+
+// Defines values for GetInvoiceAuditTrailParamsAction.
+const (
+	GetInvoiceAuditTrailParamsActionCreate       GetInvoiceAuditTrailParamsAction = "create"
+	GetInvoiceAuditTrailParamsActionDelete       GetInvoiceAuditTrailParamsAction = "delete"
+	GetInvoiceAuditTrailParamsActionStatusChange GetInvoiceAuditTrailParamsAction = "status_change"
+	GetInvoiceAuditTrailParamsActionUpdate       GetInvoiceAuditTrailParamsAction = "update"
+)
+
+// Defines values for GetInvoiceStatusHistoryParamsAction.
+const (
+	GetInvoiceStatusHistoryParamsActionCreate       GetInvoiceStatusHistoryParamsAction = "create"
+	GetInvoiceStatusHistoryParamsActionDelete       GetInvoiceStatusHistoryParamsAction = "delete"
+	GetInvoiceStatusHistoryParamsActionStatusChange GetInvoiceStatusHistoryParamsAction = "status_change"
+	GetInvoiceStatusHistoryParamsActionUpdate       GetInvoiceStatusHistoryParamsAction = "update"
+)
+
+// Defines values for GetReceiverStatementParamsFormat.
+const (
+	Json GetReceiverStatementParamsFormat = "json"
+	Pdf  GetReceiverStatementParamsFormat = "pdf"
 )
 
+// Defines values for GetReceiverStatisticsParamsPeriod.
+const (
+	GetReceiverStatisticsParamsPeriodLastDay   GetReceiverStatisticsParamsPeriod = "last_day"
+	GetReceiverStatisticsParamsPeriodLastMonth GetReceiverStatisticsParamsPeriod = "last_month"
+	GetReceiverStatisticsParamsPeriodLastWeek  GetReceiverStatisticsParamsPeriod = "last_week"
+	GetReceiverStatisticsParamsPeriodLastYear  GetReceiverStatisticsParamsPeriod = "last_year"
+)
+
+// AddAttachmentRequest defines model for AddAttachmentRequest.
+type AddAttachmentRequest struct {
+	// Key Key of a file previously uploaded via /api/upload or confirmed via /api/upload/confirm
+	Key string `json:"key"`
+}
+
 // AddItemRequest defines model for AddItemRequest.
 type AddItemRequest struct {
+	// CategoryId Category of the item when it differs from the invoice category (defaults to the invoice category)
+	CategoryId *int `json:"category_id,omitempty"`
+
+	// Currency Currency of the item when it differs from the invoice currency (defaults to the invoice currency)
+	Currency *string `json:"currency,omitempty"`
+
 	// Description Item description
-	Description string   `json:"description"`
-	Quantity    *float64 `json:"quantity,omitempty"`
-	UnitPrice   *float64 `json:"unit_price,omitempty"`
+	Description string `json:"description"`
+
+	// DiscountType How discount_value reduces the amount
+	DiscountType *DiscountType `json:"discount_type,omitempty"`
+
+	// DiscountValue Item discount; a percentage (0-100) or an amount in the item currency, depending on discount_type
+	DiscountValue *float64 `json:"discount_value,omitempty"`
+
+	// Quantity Quantity; may be negative for refund or adjustment lines. 0 is kept only when unit_price is also 0
+	Quantity *float64 `json:"quantity,omitempty"`
+
+	// Unit Unit of measure of the quantity (e.g., hour, kg, pcs)
+	Unit *string `json:"unit,omitempty"`
+
+	// UnitPrice Unit price; may be negative for refund or adjustment lines
+	UnitPrice *float64 `json:"unit_price,omitempty"`
+}
+
+// AddItemsRequest defines model for AddItemsRequest.
+type AddItemsRequest struct {
+	// Items Items to add, in order
+	Items []AddItemRequest `json:"items"`
 }
 
 // AnalyticsByGroup defines model for AnalyticsByGroup.
 type AnalyticsByGroup struct {
+	// Currency Base currency all amounts are reported in
+	Currency      *string               `json:"currency,omitempty"`
 	EndDate       *time.Time            `json:"end_date,omitempty"`
 	Items         *[]AnalyticsGroupItem `json:"items,omitempty"`
 	Period        *string               `json:"period,omitempty"`
@@ -110,12 +296,20 @@ type AnalyticsGroupItem struct {
 
 // AnalyticsSummary defines model for AnalyticsSummary.
 type AnalyticsSummary struct {
-	EndDate       *time.Time `json:"end_date,omitempty"`
-	InvoiceCount  *int       `json:"invoice_count,omitempty"`
-	OverdueAmount *float64   `json:"overdue_amount,omitempty"`
-	OverdueCount  *int       `json:"overdue_count,omitempty"`
-	PaidAmount    *float64   `json:"paid_amount,omitempty"`
-	PaidCount     *int       `json:"paid_count,omitempty"`
+	// Currency Base currency all amounts are reported in
+	Currency *string    `json:"currency,omitempty"`
+	EndDate  *time.Time `json:"end_date,omitempty"`
+
+	// FxUnsupportedCount Invoices in the total with items in a currency without exchange rates, counted 1:1
+	FxUnsupportedCount *int     `json:"fx_unsupported_count,omitempty"`
+	InvoiceCount       *int     `json:"invoice_count,omitempty"`
+	OverdueAmount      *float64 `json:"overdue_amount,omitempty"`
+	OverdueCount       *int     `json:"overdue_count,omitempty"`
+	PaidAmount         *float64 `json:"paid_amount,omitempty"`
+
+	// PaidBy Date the paid bucket is filtered on (invoice_date or payment_date)
+	PaidBy    *string `json:"paid_by,omitempty"`
+	PaidCount *int    `json:"paid_count,omitempty"`
 
 	// Period Time period (7d, 1m, 1y)
 	Period       *string    `json:"period,omitempty"`
@@ -125,12 +319,108 @@ type AnalyticsSummary struct {
 	UnpaidCount  *int       `json:"unpaid_count,omitempty"`
 }
 
+// AuditLogEntry defines model for AuditLogEntry.
+type AuditLogEntry struct {
+	Action AuditLogEntryAction `json:"action"`
+
+	// ActorSub Subject of the user who made the change
+	ActorSub  string    `json:"actor_sub"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Diff Changed fields mapped to {"from": old, "to": new}.
+	// "from" is omitted on create and "to" is omitted on delete.
+	Diff map[string]interface{} `json:"diff"`
+
+	// EntityId ID of the changed invoice or item
+	EntityId   int                     `json:"entity_id"`
+	EntityType AuditLogEntryEntityType `json:"entity_type"`
+	Id         int                     `json:"id"`
+	InvoiceId  int                     `json:"invoice_id"`
+}
+
+// AuditLogEntryAction defines model for AuditLogEntry.Action.
+type AuditLogEntryAction string
+
+// AuditLogEntryEntityType defines model for AuditLogEntry.EntityType.
+type AuditLogEntryEntityType string
+
+// AuditTrailResponse defines model for AuditTrailResponse.
+type AuditTrailResponse struct {
+	Data []AuditLogEntry `json:"data"`
+
+	// Pagination Paging metadata shared by all list responses. A limit of 0 or less returns every row,
+	// so the page covers everything and has_more is false.
+	Pagination Pagination `json:"pagination"`
+}
+
+// BreakdownItem defines model for BreakdownItem.
+type BreakdownItem struct {
+	Amount *float64 `json:"amount,omitempty"`
+
+	// Count Number of invoices
+	Count *int `json:"count,omitempty"`
+
+	// Id Receiver or category ID, absent for invoices without one
+	Id   *int    `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// Budget defines model for Budget.
+type Budget struct {
+	Amount     float64      `json:"amount"`
+	Category   *Category    `json:"category,omitempty"`
+	CategoryId int          `json:"category_id"`
+	CreatedAt  *time.Time   `json:"created_at,omitempty"`
+	Currency   string       `json:"currency"`
+	Id         int          `json:"id"`
+	PeriodType BudgetPeriod `json:"period_type"`
+	UpdatedAt  *time.Time   `json:"updated_at,omitempty"`
+}
+
+// BudgetListResponse defines model for BudgetListResponse.
+type BudgetListResponse struct {
+	Data *[]Budget `json:"data,omitempty"`
+}
+
+// BudgetPeriod defines model for BudgetPeriod.
+type BudgetPeriod string
+
+// BudgetStatus defines model for BudgetStatus.
+type BudgetStatus struct {
+	Amount       *float64      `json:"amount,omitempty"`
+	BudgetId     *int          `json:"budget_id,omitempty"`
+	CategoryId   *int          `json:"category_id,omitempty"`
+	CategoryName *string       `json:"category_name,omitempty"`
+	Currency     *string       `json:"currency,omitempty"`
+	OverBudget   *bool         `json:"over_budget,omitempty"`
+	PeriodEnd    *time.Time    `json:"period_end,omitempty"`
+	PeriodStart  *time.Time    `json:"period_start,omitempty"`
+	PeriodType   *BudgetPeriod `json:"period_type,omitempty"`
+
+	// Remaining Budget amount minus spent (negative when over budget)
+	Remaining *float64 `json:"remaining,omitempty"`
+
+	// Spent Spending of the category in the current period, in the budget currency
+	Spent *float64 `json:"spent,omitempty"`
+}
+
+// BudgetStatusResponse defines model for BudgetStatusResponse.
+type BudgetStatusResponse struct {
+	Data *[]BudgetStatus `json:"data,omitempty"`
+}
+
 // Category defines model for Category.
 type Category struct {
 	// Color Hex color code (e.g.,
 	Color     *string    `json:"color,omitempty"`
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 
+	// DefaultCurrency Currency of new invoices in this category that don't specify one (omitted when not set)
+	DefaultCurrency *string `json:"default_currency,omitempty"`
+
+	// DefaultTaxRate Tax rate (a percentage) of new invoices in this category that don't specify one (omitted when not set)
+	DefaultTaxRate *float64 `json:"default_tax_rate,omitempty"`
+
 	// Description Category description
 	Description *string `json:"description,omitempty"`
 
@@ -150,7 +440,24 @@ type CategoryListResponse struct {
 	Data   *[]Category `json:"data,omitempty"`
 	Limit  *int        `json:"limit,omitempty"`
 	Offset *int        `json:"offset,omitempty"`
-	Total  *int        `json:"total,omitempty"`
+
+	// Pagination Paging metadata shared by all list responses. A limit of 0 or less returns every row,
+	// so the page covers everything and has_more is false.
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Total      *int        `json:"total,omitempty"`
+}
+
+// CloneInvoiceRequest Optional overrides for the cloned invoice
+type CloneInvoiceRequest struct {
+	DueDate          *time.Time     `json:"due_date,omitempty"`
+	InvoiceEndedAt   *time.Time     `json:"invoice_ended_at,omitempty"`
+	InvoiceStartedAt *time.Time     `json:"invoice_started_at,omitempty"`
+	Status           *InvoiceStatus `json:"status,omitempty"`
+
+	// TargetCurrency Currency to re-bill the clone in (e.g. HKD). Converts item unit prices and fixed
+	// discounts from their currency at the current FX rate, changing the item amounts
+	TargetCurrency *string `json:"target_currency,omitempty"`
+	Title          *string `json:"title,omitempty"`
 }
 
 // Company defines model for Company.
@@ -185,12 +492,37 @@ type Company struct {
 	Website *string `json:"website,omitempty"`
 }
 
+// CompanyBreakdown defines model for CompanyBreakdown.
+type CompanyBreakdown struct {
+	// Categories The total split by item category, largest first
+	Categories *[]BreakdownItem `json:"categories,omitempty"`
+	CompanyId  *int             `json:"company_id,omitempty"`
+
+	// Currency Base currency all amounts are reported in
+	Currency     *string    `json:"currency,omitempty"`
+	EndDate      *time.Time `json:"end_date,omitempty"`
+	InvoiceCount *int       `json:"invoice_count,omitempty"`
+	Name         *string    `json:"name,omitempty"`
+
+	// Period Time period (last_day, last_week, last_month, last_year)
+	Period *string `json:"period,omitempty"`
+
+	// Receivers The total split by receiver, largest first
+	Receivers   *[]BreakdownItem `json:"receivers,omitempty"`
+	StartDate   *time.Time       `json:"start_date,omitempty"`
+	TotalAmount *float64         `json:"total_amount,omitempty"`
+}
+
 // CompanyListResponse defines model for CompanyListResponse.
 type CompanyListResponse struct {
 	Data   *[]Company `json:"data,omitempty"`
 	Limit  *int       `json:"limit,omitempty"`
 	Offset *int       `json:"offset,omitempty"`
-	Total  *int       `json:"total,omitempty"`
+
+	// Pagination Paging metadata shared by all list responses. A limit of 0 or less returns every row,
+	// so the page covers everything and has_more is false.
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Total      *int        `json:"total,omitempty"`
 }
 
 // ConfirmUploadRequest defines model for ConfirmUploadRequest.
@@ -208,11 +540,52 @@ type ConfirmUploadRequest struct {
 	Size int64 `json:"size"`
 }
 
+// ConversionPreview defines model for ConversionPreview.
+type ConversionPreview struct {
+	// CurrentTotal Current invoice total in the base currency
+	CurrentTotal float64 `json:"current_total"`
+
+	// FromCurrency Proposed invoice currency
+	FromCurrency string                  `json:"from_currency"`
+	Items        []ItemConversionPreview `json:"items"`
+
+	// Rate Exchange rate from the proposed invoice currency to the base currency
+	Rate float64 `json:"rate"`
+
+	// ToCurrency The user's base currency
+	ToCurrency string `json:"to_currency"`
+
+	// Total Invoice total in the base currency after the change
+	Total float64 `json:"total"`
+}
+
+// ConversionPreviewRequest defines model for ConversionPreviewRequest.
+type ConversionPreviewRequest struct {
+	// Currency Proposed invoice currency (ISO 4217 code)
+	Currency string `json:"currency"`
+}
+
+// CreateBudgetRequest defines model for CreateBudgetRequest.
+type CreateBudgetRequest struct {
+	Amount     float64 `json:"amount"`
+	CategoryId int     `json:"category_id"`
+
+	// Currency Currency of the budget amount (default is the user's base currency)
+	Currency   *string       `json:"currency,omitempty"`
+	PeriodType *BudgetPeriod `json:"period_type,omitempty"`
+}
+
 // CreateCategoryRequest defines model for CreateCategoryRequest.
 type CreateCategoryRequest struct {
 	// Color Hex color code
 	Color *string `json:"color,omitempty"`
 
+	// DefaultCurrency Currency (3-letter ISO 4217 code) of new invoices in this category that don't specify one
+	DefaultCurrency *string `json:"default_currency,omitempty"`
+
+	// DefaultTaxRate Tax rate (a percentage) of new invoices in this category that don't specify one
+	DefaultTaxRate *float64 `json:"default_tax_rate,omitempty"`
+
 	// Description Category description
 	Description *string `json:"description,omitempty"`
 
@@ -235,40 +608,106 @@ type CreateCompanyRequest struct {
 
 // CreateInvoiceRequest Request body for creating an invoice. Note that amount is calculated from invoice items and cannot be set directly.
 type CreateInvoiceRequest struct {
-	CategoryId           *int                 `json:"category_id,omitempty"`
-	CompanyId            *int                 `json:"company_id,omitempty"`
-	Currency             *string              `json:"currency,omitempty"`
-	Description          *string              `json:"description,omitempty"`
-	DueDate              *time.Time           `json:"due_date,omitempty"`
-	InvoiceEndedAt       *time.Time           `json:"invoice_ended_at,omitempty"`
-	InvoiceStartedAt     *time.Time           `json:"invoice_started_at,omitempty"`
-	Items                *[]CreateItemRequest `json:"items,omitempty"`
-	OriginalDownloadLink *string              `json:"original_download_link,omitempty"`
-	ReceiverId           *int                 `json:"receiver_id,omitempty"`
-	Status               *InvoiceStatus       `json:"status,omitempty"`
+	CategoryId *int `json:"category_id,omitempty"`
+	CompanyId  *int `json:"company_id,omitempty"`
+
+	// Currency Invoice currency; defaults to the category's default_currency, else USD
+	Currency    *string `json:"currency,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// DiscountType How discount_value reduces the amount
+	DiscountType *DiscountType `json:"discount_type,omitempty"`
+
+	// DiscountValue Invoice discount applied after summing the items; a percentage (0-100) or an amount in the invoice currency, depending on discount_type
+	DiscountValue *float64   `json:"discount_value,omitempty"`
+	DueDate       *time.Time `json:"due_date,omitempty"`
+
+	// ExpectedAmount Total the invoice is expected to have, in the invoice currency, such as the total printed
+	// on the document it was entered from. When the amount computed from the items differs by
+	// more than the currency's smallest unit, the invoice is still created and the response
+	// carries a warning with both values.
+	ExpectedAmount   *float64   `json:"expected_amount,omitempty"`
+	InvoiceEndedAt   *time.Time `json:"invoice_ended_at,omitempty"`
+	InvoiceStartedAt *time.Time `json:"invoice_started_at,omitempty"`
+
+	// IsDraft Create the invoice as a draft, left out of analytics and invoice lists until finalized
+	IsDraft *bool                `json:"is_draft,omitempty"`
+	Items   *[]CreateItemRequest `json:"items,omitempty"`
+
+	// OrganizationId Organization to share the invoice in, which the user must be an owner or member of. Defaults to the user's personal organization
+	OrganizationId       *int    `json:"organization_id,omitempty"`
+	OriginalDownloadLink *string `json:"original_download_link,omitempty"`
+
+	// PaymentMethod Card or account the invoice was paid with (e.g. "Amex Gold"); empty when not recorded
+	PaymentMethod *string        `json:"payment_method,omitempty"`
+	ReceiverId    *int           `json:"receiver_id,omitempty"`
+	Status        *InvoiceStatus `json:"status,omitempty"`
 
 	// TagIds Tag IDs to associate with the invoice
 	TagIds *[]int `json:"tag_ids,omitempty"`
 
+	// TaxRate Tax percentage the invoice was charged at; defaults to the category's default_tax_rate, and 0 overrides it
+	TaxRate *float64 `json:"tax_rate,omitempty"`
+
 	// Title Invoice title
 	Title string `json:"title"`
 }
 
 // CreateItemRequest defines model for CreateItemRequest.
 type CreateItemRequest struct {
+	// CategoryId Category of the item when it differs from the invoice category (defaults to the invoice category)
+	CategoryId *int `json:"category_id,omitempty"`
+
+	// Currency Currency of the item when it differs from the invoice currency (defaults to the invoice currency)
+	Currency *string `json:"currency,omitempty"`
+
 	// Description Item description
-	Description string   `json:"description"`
-	Quantity    *float64 `json:"quantity,omitempty"`
-	UnitPrice   *float64 `json:"unit_price,omitempty"`
+	Description string `json:"description"`
+
+	// DiscountType How discount_value reduces the amount
+	DiscountType *DiscountType `json:"discount_type,omitempty"`
+
+	// DiscountValue Item discount; a percentage (0-100) or an amount in the item currency, depending on discount_type
+	DiscountValue *float64 `json:"discount_value,omitempty"`
+
+	// Quantity Quantity; may be negative for refund or adjustment lines. 0 is kept only when unit_price is also 0
+	Quantity *float64 `json:"quantity,omitempty"`
+
+	// Unit Unit of measure of the quantity (e.g., hour, kg, pcs)
+	Unit *string `json:"unit,omitempty"`
+
+	// UnitPrice Unit price; may be negative for refund or adjustment lines
+	UnitPrice *float64 `json:"unit_price,omitempty"`
+}
+
+// CreateOrganizationRequest defines model for CreateOrganizationRequest.
+type CreateOrganizationRequest struct {
+	// Name Organization name
+	Name string `json:"name"`
 }
 
 // CreateReceiverRequest defines model for CreateReceiverRequest.
 type CreateReceiverRequest struct {
+	// Address Postal address, may span several lines
+	Address *string `json:"address,omitempty"`
+
+	// BankAccount Bank account details for payments (e.g. IBAN)
+	BankAccount *string `json:"bank_account,omitempty"`
+
+	// Email Contact email, e.g. billing@example.com
+	Email *string `json:"email,omitempty"`
+
 	// IsOrganization Whether the receiver is an organization
 	IsOrganization *bool `json:"is_organization,omitempty"`
 
 	// Name Receiver name
 	Name string `json:"name"`
+
+	// Phone Contact phone number with at least 5 digits, e.g. +1 555-0100
+	Phone *string `json:"phone,omitempty"`
+
+	// TaxId Tax or VAT registration number
+	TaxId *string `json:"tax_id,omitempty"`
 }
 
 // CreateTagRequest defines model for CreateTagRequest.
@@ -278,14 +717,88 @@ type CreateTagRequest struct {
 
 	// Name Tag name
 	Name string `json:"name"`
+
+	// ParentId ID of the tag to nest this tag under; omit for a top-level tag
+	ParentId *int `json:"parent_id,omitempty"`
 }
 
+// Dashboard defines model for Dashboard.
+type Dashboard struct {
+	ByCategory *AnalyticsByGroup `json:"by_category,omitempty"`
+	ByCompany  *AnalyticsByGroup `json:"by_company,omitempty"`
+
+	// Errors Sections that failed to load, keyed by section name (omitted when all loaded)
+	Errors *map[string]string `json:"errors,omitempty"`
+
+	// Overdue Unpaid invoices past their due date, oldest due date first
+	Overdue *[]Invoice `json:"overdue,omitempty"`
+
+	// Period Time period the summary and breakdowns cover (7d, 1m, 1y)
+	Period string `json:"period"`
+
+	// Recent The most recently created invoices, newest first
+	Recent  *[]Invoice        `json:"recent,omitempty"`
+	Summary *AnalyticsSummary `json:"summary,omitempty"`
+}
+
+// DiscountType How discount_value reduces the amount
+type DiscountType string
+
 // Error defines model for Error.
 type Error struct {
 	// Error Error message
 	Error *string `json:"error,omitempty"`
 }
 
+// ExportDocument defines model for ExportDocument.
+type ExportDocument struct {
+	Categories *[]Category `json:"categories,omitempty"`
+	Companies  *[]Company  `json:"companies,omitempty"`
+	ExportedAt *time.Time  `json:"exported_at,omitempty"`
+
+	// Invoices Invoices with their items and tag references
+	Invoices  *[]Invoice  `json:"invoices,omitempty"`
+	Receivers *[]Receiver `json:"receivers,omitempty"`
+
+	// SchemaVersion Version of the export format
+	SchemaVersion int    `json:"schema_version"`
+	Tags          *[]Tag `json:"tags,omitempty"`
+}
+
+// ExportJob defines model for ExportJob.
+type ExportJob struct {
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+
+	// DownloadUrl Presigned URL of the zip bundle, once the job has completed
+	DownloadUrl *string `json:"download_url,omitempty"`
+
+	// Error Why the export failed
+	Error *string `json:"error,omitempty"`
+	Id    int     `json:"id"`
+
+	// Status expired once the bundle of a completed job is deleted after the bundle TTL
+	Status ExportJobStatus `json:"status"`
+}
+
+// ExportJobStatus expired once the bundle of a completed job is deleted after the bundle TTL
+type ExportJobStatus string
+
+// FacetEntity defines model for FacetEntity.
+type FacetEntity struct {
+	// Count Number of invoices assigned to this entity
+	Count int64  `json:"count"`
+	Id    int    `json:"id"`
+	Name  string `json:"name"`
+}
+
+// FacetValue defines model for FacetValue.
+type FacetValue struct {
+	// Count Number of invoices with this value
+	Count int64  `json:"count"`
+	Value string `json:"value"`
+}
+
 // FileDownloadURLResponse defines model for FileDownloadURLResponse.
 type FileDownloadURLResponse struct {
 	// DownloadUrl Presigned download URL (expires in 1 hour)
@@ -301,6 +814,33 @@ type FileDownloadURLResponse struct {
 	Key *string `json:"key,omitempty"`
 }
 
+// HealthStatus defines model for HealthStatus.
+type HealthStatus struct {
+	Database struct {
+		// Error Ping error when status is error
+		Error  *string                    `json:"error,omitempty"`
+		Status HealthStatusDatabaseStatus `json:"status"`
+	} `json:"database"`
+	Fx struct {
+		// Configured Whether currency conversion is available
+		Configured bool `json:"configured"`
+
+		// LastSuccessfulFetch Last time exchange rates were fetched from the provider
+		LastSuccessfulFetch *time.Time `json:"last_successful_fetch,omitempty"`
+	} `json:"fx"`
+	Status HealthStatusStatus `json:"status"`
+	Upload struct {
+		// Available Whether S3 file upload is configured
+		Available bool `json:"available"`
+	} `json:"upload"`
+}
+
+// HealthStatusDatabaseStatus defines model for HealthStatus.Database.Status.
+type HealthStatusDatabaseStatus string
+
+// HealthStatusStatus defines model for HealthStatus.Status.
+type HealthStatusStatus string
+
 // HtmlToPdfRequest defines model for HtmlToPdfRequest.
 type HtmlToPdfRequest struct {
 	// Filename Output filename, defaults to generated.pdf
@@ -331,11 +871,47 @@ type HtmlToPdfRequest struct {
 	PaperWidth *float64 `json:"paper_width,omitempty"`
 }
 
+// ImportCounts defines model for ImportCounts.
+type ImportCounts struct {
+	Created int `json:"created"`
+	Skipped int `json:"skipped"`
+}
+
+// ImportResult defines model for ImportResult.
+type ImportResult struct {
+	Categories ImportCounts `json:"categories"`
+	Companies  ImportCounts `json:"companies"`
+	Invoices   ImportCounts `json:"invoices"`
+	Receivers  ImportCounts `json:"receivers"`
+	Tags       ImportCounts `json:"tags"`
+}
+
+// InUseError defines model for InUseError.
+type InUseError struct {
+	// Error Error message
+	Error *string `json:"error,omitempty"`
+
+	// InvoiceCount Number of invoices referencing the record
+	InvoiceCount *int `json:"invoice_count,omitempty"`
+}
+
 // Invoice defines model for Invoice.
 type Invoice struct {
-	// Amount Total amount (calculated from invoice items, read-only)
-	Amount   *float64  `json:"amount,omitempty"`
-	Category *Category `json:"category,omitempty"`
+	// Amount Total amount (calculated from invoice items less the invoice discount, read-only). Sums the raw item amounts, so it mixes currencies when amount_currency_mixed is true.
+	Amount *float64 `json:"amount,omitempty"`
+
+	// AmountCurrencyMixed True when some items are in a currency other than the invoice currency; use target_amount for a single-currency total
+	AmountCurrencyMixed *bool `json:"amount_currency_mixed,omitempty"`
+
+	// AmountFormatted Amount formatted in the invoice currency for the requested locale. Only returned with the locale query parameter, and left out when the items mix currencies.
+	AmountFormatted *string `json:"amount_formatted,omitempty"`
+
+	// AmountInWords Amount spelled out (e.g. "One Hundred Twenty Three Dollars and 45/100"). Only returned by get invoice with include_amount_in_words, and left out when the items mix currencies.
+	AmountInWords *string `json:"amount_in_words,omitempty"`
+
+	// Attachments Additional files attached to the invoice
+	Attachments *[]InvoiceAttachment `json:"attachments,omitempty"`
+	Category    *Category            `json:"category,omitempty"`
 
 	// CategoryId Category ID
 	CategoryId *int     `json:"category_id,omitempty"`
@@ -351,59 +927,207 @@ type Invoice struct {
 	// Description Invoice description
 	Description *string `json:"description,omitempty"`
 
+	// DiscountType How discount_value reduces the amount
+	DiscountType *DiscountType `json:"discount_type,omitempty"`
+
+	// DiscountValue Invoice discount applied after summing the items; a percentage (0-100) or an amount in the invoice currency, depending on discount_type
+	DiscountValue *float64 `json:"discount_value,omitempty"`
+
 	// DueDate Payment due date
 	DueDate *time.Time `json:"due_date,omitempty"`
 
+	// FxRateUsed Average of the items' fx_rate_used weighted by their target_amount (e.g. "avg rate 0.128"); equals the rate when all items share one, 0 for invoices without items
+	FxRateUsed *float64 `json:"fx_rate_used,omitempty"`
+
 	// Id Invoice ID
 	Id *int `json:"id,omitempty"`
 
 	// InvoiceEndedAt Billing cycle end date
 	InvoiceEndedAt *time.Time `json:"invoice_ended_at,omitempty"`
 
+	// InvoiceNumber Sequential human-readable number (e.g. INV-2024-0001), assigned on creation. Invoices created before numbering was introduced return their ID.
+	InvoiceNumber *string `json:"invoice_number,omitempty"`
+
 	// InvoiceStartedAt Billing cycle start date
-	InvoiceStartedAt *time.Time     `json:"invoice_started_at,omitempty"`
-	Items            *[]InvoiceItem `json:"items,omitempty"`
+	InvoiceStartedAt *time.Time `json:"invoice_started_at,omitempty"`
+
+	// IsDraft Whether the invoice is a placeholder draft. Drafts are left out of analytics and, by default, invoice lists until finalized.
+	IsDraft *bool          `json:"is_draft,omitempty"`
+	Items   *[]InvoiceItem `json:"items,omitempty"`
+
+	// OrganizationId Organization the invoice is shared in; every member can see it, only its creator can change it
+	OrganizationId *int `json:"organization_id,omitempty"`
 
 	// OriginalDownloadLink Original invoice file URL
-	OriginalDownloadLink *string   `json:"original_download_link,omitempty"`
-	Receiver             *Receiver `json:"receiver,omitempty"`
+	OriginalDownloadLink *string `json:"original_download_link,omitempty"`
+
+	// PaidAt When the invoice was marked as paid; omitted while it is not paid
+	PaidAt *time.Time `json:"paid_at,omitempty"`
+
+	// PaymentMethod Card or account the invoice was paid with (e.g. "Amex Gold"); omitted when not recorded
+	PaymentMethod *string   `json:"payment_method,omitempty"`
+	Receiver      *Receiver `json:"receiver,omitempty"`
 
 	// ReceiverId Receiver ID
-	ReceiverId *int           `json:"receiver_id,omitempty"`
-	Status     *InvoiceStatus `json:"status,omitempty"`
+	ReceiverId *int `json:"receiver_id,omitempty"`
+
+	// RelatedInvoiceId Invoice this one is linked to, e.g. the invoice a credit note refunds
+	RelatedInvoiceId *int `json:"related_invoice_id,omitempty"`
+
+	// RelationType How an invoice relates to its related invoice
+	RelationType *InvoiceRelationType `json:"relation_type,omitempty"`
+	Status       *InvoiceStatus       `json:"status,omitempty"`
 
 	// Tags Tags for categorization
 	Tags *[]InvoiceTagReference `json:"tags,omitempty"`
 
-	// TargetAmount USD-normalized total amount (calculated from invoice items' target_amount, read-only)
+	// TargetAmount USD-normalized total amount (calculated from invoice items' target_amount, read-only). The invoice discount is spread over the items' target_amount.
 	TargetAmount *float64 `json:"target_amount,omitempty"`
 
+	// TargetAmountFormatted target_amount formatted in the base currency for the requested locale. Only returned with the locale query parameter when target_amount is.
+	TargetAmountFormatted *string `json:"target_amount_formatted,omitempty"`
+
+	// TaxRate Tax percentage the invoice was charged at (e.g. 20 for 20% VAT), recorded for reference;
+	// amounts are entered as charged and aren't changed by it. Omitted when not known.
+	TaxRate *float64 `json:"tax_rate,omitempty"`
+
 	// Title Invoice title
 	Title     *string    `json:"title,omitempty"`
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 
-	// UserId Owner user ID
+	// UserId User who created the invoice
 	UserId *string `json:"user_id,omitempty"`
+
+	// Version Incremented on every update; send it back as the version of an update to detect concurrent changes
+	Version *int `json:"version,omitempty"`
+
+	// Warnings Problems with the input that didn't stop the invoice from being created, such as items
+	// not adding up to expected_amount. Only returned by create invoice.
+	Warnings *[]string `json:"warnings,omitempty"`
+}
+
+// InvoiceAmountReference defines model for InvoiceAmountReference.
+type InvoiceAmountReference struct {
+	// Amount Amount normalized to the user's base currency
+	Amount *float64 `json:"amount,omitempty"`
+	Id     *int     `json:"id,omitempty"`
+	Title  *string  `json:"title,omitempty"`
+}
+
+// InvoiceAttachment defines model for InvoiceAttachment.
+type InvoiceAttachment struct {
+	ContentType *string    `json:"content_type,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	Filename    *string    `json:"filename,omitempty"`
+
+	// Id Attachment ID
+	Id *int `json:"id,omitempty"`
+
+	// InvoiceId Invoice ID
+	InvoiceId *int `json:"invoice_id,omitempty"`
+
+	// S3Key Storage key of the attached file
+	S3Key *string `json:"s3_key,omitempty"`
+
+	// Size File size in bytes
+	Size      *int64     `json:"size,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// InvoiceAttachmentListResponse defines model for InvoiceAttachmentListResponse.
+type InvoiceAttachmentListResponse struct {
+	Data *[]InvoiceAttachment `json:"data,omitempty"`
+}
+
+// InvoiceFacets defines model for InvoiceFacets.
+type InvoiceFacets struct {
+	// Categories Categories assigned to at least one invoice, by name
+	Categories []FacetEntity `json:"categories"`
+
+	// Companies Companies assigned to at least one invoice, by name
+	Companies []FacetEntity `json:"companies"`
+
+	// Currencies Currencies in use, most used first
+	Currencies []FacetValue `json:"currencies"`
+
+	// Receivers Receivers assigned to at least one invoice, by name
+	Receivers []FacetEntity `json:"receivers"`
+
+	// Statuses Statuses in use, most used first
+	Statuses []FacetValue `json:"statuses"`
+}
+
+// InvoiceImportResult defines model for InvoiceImportResult.
+type InvoiceImportResult struct {
+	Created int                `json:"created"`
+	Failed  int                `json:"failed"`
+	Rows    []InvoiceImportRow `json:"rows"`
+	Skipped int                `json:"skipped"`
+}
+
+// InvoiceImportRow defines model for InvoiceImportRow.
+type InvoiceImportRow struct {
+	// Error Why the row failed
+	Error *string `json:"error,omitempty"`
+
+	// InvoiceId The created invoice, or the existing invoice a skipped row duplicates
+	InvoiceId *int `json:"invoice_id,omitempty"`
+
+	// Line Line number of the row in the file, the header being line 1
+	Line   int                    `json:"line"`
+	Status InvoiceImportRowStatus `json:"status"`
 }
 
+// InvoiceImportRowStatus defines model for InvoiceImportRow.Status.
+type InvoiceImportRowStatus string
+
 // InvoiceItem defines model for InvoiceItem.
 type InvoiceItem struct {
-	// Amount Total amount (quantity * unit_price)
-	Amount    *float64   `json:"amount,omitempty"`
-	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// Amount Total amount (quantity * unit_price, less the item discount)
+	Amount *float64 `json:"amount,omitempty"`
+
+	// CategoryId Category of the item when the invoice is split across categories (omitted when the item uses the invoice category)
+	CategoryId *int       `json:"category_id,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+
+	// Currency Currency of unit_price and amount when it differs from the invoice currency (omitted when the item uses the invoice currency)
+	Currency *string `json:"currency,omitempty"`
 
 	// Description Item description
 	Description *string `json:"description,omitempty"`
 
+	// DiscountType How discount_value reduces the amount
+	DiscountType *DiscountType `json:"discount_type,omitempty"`
+
+	// DiscountValue Item discount; a percentage (0-100) or an amount in the item currency, depending on discount_type
+	DiscountValue *float64 `json:"discount_value,omitempty"`
+
+	// FxManual True when target_amount was entered by hand; fx_rate_used is then the implied rate
+	FxManual *bool `json:"fx_manual,omitempty"`
+
+	// FxRateDate Date (YYYY-MM-DD) the FX provider quoted fx_rate_used for (omitted for 1:1 conversions and manual overrides)
+	FxRateDate *string `json:"fx_rate_date,omitempty"`
+
 	// FxRateUsed Exchange rate used for conversion
 	FxRateUsed *float64 `json:"fx_rate_used,omitempty"`
 
+	// FxStale True when fx_rate_used is the last known rate because no FX provider could be reached
+	FxStale *bool `json:"fx_stale,omitempty"`
+
+	// FxUnsupported True when the item currency isn't ISO 4217 and has no exchange rate, so target_amount is
+	// the amount unconverted (fx_rate_used 1) and unreliable in the base currency. Only possible
+	// with the unsupported_currency setting set to pass_through.
+	FxUnsupported *bool `json:"fx_unsupported,omitempty"`
+
 	// Id Item ID
 	Id *int `json:"id,omitempty"`
 
 	// InvoiceId Parent invoice ID
 	InvoiceId *int `json:"invoice_id,omitempty"`
 
+	// Position Display order within the invoice (ascending)
+	Position *int `json:"position,omitempty"`
+
 	// Quantity Quantity
 	Quantity *float64 `json:"quantity,omitempty"`
 
@@ -413,22 +1137,66 @@ type InvoiceItem struct {
 	// TargetCurrency Target currency for normalization (USD)
 	TargetCurrency *string `json:"target_currency,omitempty"`
 
+	// Unit Unit of measure of the quantity (e.g., hour, kg, pcs); empty when not set
+	Unit *string `json:"unit,omitempty"`
+
 	// UnitPrice Unit price
 	UnitPrice *float64   `json:"unit_price,omitempty"`
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 }
 
+// InvoiceItemListResponse defines model for InvoiceItemListResponse.
+type InvoiceItemListResponse struct {
+	// Data The created items with their IDs and target amounts
+	Data []InvoiceItem `json:"data"`
+}
+
 // InvoiceListResponse defines model for InvoiceListResponse.
 type InvoiceListResponse struct {
-	Data   *[]Invoice `json:"data,omitempty"`
-	Limit  *int       `json:"limit,omitempty"`
-	Offset *int       `json:"offset,omitempty"`
-	Total  *int       `json:"total,omitempty"`
+	Data  *[]Invoice `json:"data,omitempty"`
+	Limit *int       `json:"limit,omitempty"`
+
+	// NextCursor Cursor for the next page; only present in cursor mode when more rows exist
+	NextCursor *string `json:"next_cursor,omitempty"`
+	Offset     *int    `json:"offset,omitempty"`
+
+	// Pagination Paging metadata shared by all list responses. A limit of 0 or less returns every row,
+	// so the page covers everything and has_more is false.
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Total      *int        `json:"total,omitempty"`
+
+	// TotalAmount Sum of the raw amounts of all invoices matching the filters (not just this page),
+	// each in its own currency; only meaningful when they share a currency
+	TotalAmount *float64 `json:"total_amount,omitempty"`
+
+	// TotalTargetAmount Sum of all invoices matching the filters (not just this page) in the user's base currency
+	TotalTargetAmount *float64 `json:"total_target_amount,omitempty"`
+}
+
+// InvoiceLookupResponse defines model for InvoiceLookupResponse.
+type InvoiceLookupResponse struct {
+	// Data The matching invoices; a number lookup has exactly one
+	Data []Invoice `json:"data"`
 }
 
+// InvoiceRelationType How an invoice relates to its related invoice
+type InvoiceRelationType string
+
 // InvoiceStatus defines model for InvoiceStatus.
 type InvoiceStatus string
 
+// InvoiceStatusCounts defines model for InvoiceStatusCounts.
+type InvoiceStatusCounts struct {
+	// Overdue Number of overdue invoices
+	Overdue int64 `json:"overdue"`
+
+	// Paid Number of paid invoices
+	Paid int64 `json:"paid"`
+
+	// Unpaid Number of unpaid invoices
+	Unpaid int64 `json:"unpaid"`
+}
+
 // InvoiceTagReference Minimal tag reference included in invoice responses
 type InvoiceTagReference struct {
 	// Id Tag ID
@@ -438,8 +1206,34 @@ type InvoiceTagReference struct {
 	Name string `json:"name"`
 }
 
+// ItemConversionPreview defines model for ItemConversionPreview.
+type ItemConversionPreview struct {
+	// Amount Item amount in its currency
+	Amount float64 `json:"amount"`
+
+	// Currency Currency the item amount would be in (its own currency, or the proposed invoice currency)
+	Currency string `json:"currency"`
+
+	// CurrentTargetAmount Current amount in the base currency
+	CurrentTargetAmount float64 `json:"current_target_amount"`
+	Description         string  `json:"description"`
+	ItemId              int     `json:"item_id"`
+
+	// Rate Exchange rate from the item currency to the base currency
+	Rate float64 `json:"rate"`
+
+	// Stale True when the rate is the last known one because no FX provider could be reached
+	Stale *bool `json:"stale,omitempty"`
+
+	// TargetAmount Amount in the base currency after the change
+	TargetAmount float64 `json:"target_amount"`
+}
+
 // MergeReceiversRequest defines model for MergeReceiversRequest.
 type MergeReceiversRequest struct {
+	// DryRun Preview the merge without changing anything; the response reports what the merge would do
+	DryRun *bool `json:"dry_run,omitempty"`
+
 	// SourceIds IDs of receivers to merge into the target (these receivers will be deleted)
 	SourceIds []int `json:"source_ids"`
 
@@ -449,14 +1243,107 @@ type MergeReceiversRequest struct {
 
 // MergeReceiversResult defines model for MergeReceiversResult.
 type MergeReceiversResult struct {
+	// DryRun True when nothing was changed and the result is a preview
+	DryRun *bool `json:"dry_run,omitempty"`
+
 	// InvoicesUpdated Number of invoices reassigned to the target receiver
 	InvoicesUpdated *int `json:"invoices_updated,omitempty"`
 
 	// MergedCount Number of receivers merged
 	MergedCount *int      `json:"merged_count,omitempty"`
 	Receiver    *Receiver `json:"receiver,omitempty"`
+
+	// Sources Receivers merged into the target
+	Sources *[]Receiver `json:"sources,omitempty"`
+}
+
+// MonthlyTrend defines model for MonthlyTrend.
+type MonthlyTrend struct {
+	// Currency Base currency all amounts are reported in
+	Currency *string              `json:"currency,omitempty"`
+	Data     *[]MonthlyTrendPoint `json:"data,omitempty"`
+
+	// Timezone Timezone the months follow
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// MonthlyTrendPoint defines model for MonthlyTrendPoint.
+type MonthlyTrendPoint struct {
+	// EndDate Start of the next month (exclusive)
+	EndDate      *time.Time `json:"end_date,omitempty"`
+	InvoiceCount *int       `json:"invoice_count,omitempty"`
+
+	// Month Calendar month (YYYY-MM)
+	Month      *string  `json:"month,omitempty"`
+	PaidAmount *float64 `json:"paid_amount,omitempty"`
+
+	// StartDate Start of the month in the user's timezone
+	StartDate   *time.Time `json:"start_date,omitempty"`
+	TotalAmount *float64   `json:"total_amount,omitempty"`
+
+	// UnpaidAmount Unpaid and overdue invoices
+	UnpaidAmount *float64 `json:"unpaid_amount,omitempty"`
+}
+
+// Organization defines model for Organization.
+type Organization struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Id Organization ID
+	Id int `json:"id"`
+
+	// Name Organization name
+	Name string `json:"name"`
+
+	// PersonalUserId Set on the personal organization of this user, which their new invoices go to by default
+	PersonalUserId *string `json:"personal_user_id,omitempty"`
+
+	// Role owner manages members and creates invoices, member creates invoices, and viewer only sees
+	// the organization's invoices. Every member sees all of them.
+	Role      *OrganizationRole `json:"role,omitempty"`
+	UpdatedAt *time.Time        `json:"updated_at,omitempty"`
+}
+
+// OrganizationMember defines model for OrganizationMember.
+type OrganizationMember struct {
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	OrganizationId int        `json:"organization_id"`
+
+	// Role owner manages members and creates invoices, member creates invoices, and viewer only sees
+	// the organization's invoices. Every member sees all of them.
+	Role   OrganizationRole `json:"role"`
+	UserId string           `json:"user_id"`
+}
+
+// OrganizationRole owner manages members and creates invoices, member creates invoices, and viewer only sees
+// the organization's invoices. Every member sees all of them.
+type OrganizationRole string
+
+// Pagination Paging metadata shared by all list responses. A limit of 0 or less returns every row,
+// so the page covers everything and has_more is false.
+type Pagination struct {
+	// HasMore Whether rows exist after this page (in cursor mode, whether next_cursor is set)
+	HasMore bool `json:"has_more"`
+	Limit   int  `json:"limit"`
+	Offset  int  `json:"offset"`
+
+	// Total Number of rows matching the filters across all pages
+	Total int `json:"total"`
+
+	// TotalPages Number of pages of size limit (1 when unlimited and there are rows, 0 when there are none)
+	TotalPages int `json:"total_pages"`
+}
+
+// PaymentMethodListResponse defines model for PaymentMethodListResponse.
+type PaymentMethodListResponse struct {
+	Data []string `json:"data"`
 }
 
+// PaymentTrackingMode What decides whether an invoice is paid: manual (the default) makes the status set by the
+// user the source of truth. Deriving the status from payments is rejected with a 400 until
+// invoices have payment records.
+type PaymentTrackingMode string
+
 // PresignedURLResponse defines model for PresignedURLResponse.
 type PresignedURLResponse struct {
 	// ContentType MIME type
@@ -471,7 +1358,15 @@ type PresignedURLResponse struct {
 
 // Receiver defines model for Receiver.
 type Receiver struct {
-	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// Address Postal address, may span several lines; omitted when not recorded
+	Address *string `json:"address,omitempty"`
+
+	// BankAccount Bank account details for payments (e.g. IBAN); omitted when not recorded
+	BankAccount *string    `json:"bank_account,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+
+	// Email Contact email; omitted when not recorded
+	Email *string `json:"email,omitempty"`
 
 	// Id Receiver ID
 	Id *int `json:"id,omitempty"`
@@ -483,19 +1378,77 @@ type Receiver struct {
 	Name *string `json:"name,omitempty"`
 
 	// OtherNames Alternative names/aliases (populated from merged receivers)
-	OtherNames *[]string  `json:"other_names,omitempty"`
-	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+	OtherNames *[]string `json:"other_names,omitempty"`
+
+	// Phone Contact phone number; omitted when not recorded
+	Phone *string `json:"phone,omitempty"`
+
+	// TaxId Tax or VAT registration number; omitted when not recorded
+	TaxId     *string    `json:"tax_id,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 
 	// UserId Owner user ID
 	UserId *string `json:"user_id,omitempty"`
 }
 
+// ReceiverDetail defines model for ReceiverDetail.
+type ReceiverDetail struct {
+	AvgAmount *float64 `json:"avg_amount,omitempty"`
+
+	// Currency Base currency all amounts are reported in
+	Currency         *string    `json:"currency,omitempty"`
+	EndDate          *time.Time `json:"end_date,omitempty"`
+	FirstInvoiceDate *time.Time `json:"first_invoice_date,omitempty"`
+	InvoiceCount     *int       `json:"invoice_count,omitempty"`
+	LastInvoiceDate  *time.Time `json:"last_invoice_date,omitempty"`
+	Name             *string    `json:"name,omitempty"`
+	PaidAmount       *float64   `json:"paid_amount,omitempty"`
+	PaidCount        *int       `json:"paid_count,omitempty"`
+
+	// Period Time period (last_day, last_week, last_month, last_year)
+	Period     *string    `json:"period,omitempty"`
+	ReceiverId *int       `json:"receiver_id,omitempty"`
+	StartDate  *time.Time `json:"start_date,omitempty"`
+
+	// TopInvoices Largest invoices by base-currency-normalized amount (up to 5)
+	TopInvoices *[]InvoiceAmountReference `json:"top_invoices,omitempty"`
+	TotalAmount *float64                  `json:"total_amount,omitempty"`
+
+	// UnpaidAmount Unpaid and overdue amount
+	UnpaidAmount *float64 `json:"unpaid_amount,omitempty"`
+
+	// UnpaidCount Unpaid and overdue count
+	UnpaidCount *int `json:"unpaid_count,omitempty"`
+}
+
 // ReceiverListResponse defines model for ReceiverListResponse.
 type ReceiverListResponse struct {
 	Data   *[]Receiver `json:"data,omitempty"`
 	Limit  *int        `json:"limit,omitempty"`
 	Offset *int        `json:"offset,omitempty"`
-	Total  *int        `json:"total,omitempty"`
+
+	// Pagination Paging metadata shared by all list responses. A limit of 0 or less returns every row,
+	// so the page covers everything and has_more is false.
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Total      *int        `json:"total,omitempty"`
+}
+
+// ReorderItemsRequest defines model for ReorderItemsRequest.
+type ReorderItemsRequest struct {
+	// ItemIds IDs of all invoice items in the desired order
+	ItemIds []int `json:"item_ids"`
+}
+
+// SetOrganizationMemberRequest defines model for SetOrganizationMemberRequest.
+type SetOrganizationMemberRequest struct {
+	// Role owner manages members and creates invoices, member creates invoices, and viewer only sees
+	// the organization's invoices. Every member sees all of them.
+	Role OrganizationRole `json:"role"`
+}
+
+// SimilarInvoicesResponse defines model for SimilarInvoicesResponse.
+type SimilarInvoicesResponse struct {
+	Data []Invoice `json:"data"`
 }
 
 // Tag defines model for Tag.
@@ -508,7 +1461,10 @@ type Tag struct {
 	Id *int `json:"id,omitempty"`
 
 	// Name Tag name
-	Name      *string    `json:"name,omitempty"`
+	Name *string `json:"name,omitempty"`
+
+	// ParentId ID of the parent tag; omitted for a top-level tag
+	ParentId  *int       `json:"parent_id,omitempty"`
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 
 	// UserId Owner user ID
@@ -520,14 +1476,42 @@ type TagListResponse struct {
 	Data   *[]Tag `json:"data,omitempty"`
 	Limit  *int   `json:"limit,omitempty"`
 	Offset *int   `json:"offset,omitempty"`
-	Total  *int   `json:"total,omitempty"`
+
+	// Pagination Paging metadata shared by all list responses. A limit of 0 or less returns every row,
+	// so the page covers everything and has_more is false.
+	Pagination *Pagination `json:"pagination,omitempty"`
+	Total      *int        `json:"total,omitempty"`
+}
+
+// TotalsRecalculation defines model for TotalsRecalculation.
+type TotalsRecalculation struct {
+	AmountAfter float64 `json:"amount_after"`
+
+	// AmountBefore Invoice amount in the invoice currency before recalculating
+	AmountBefore      float64 `json:"amount_before"`
+	InvoiceId         int     `json:"invoice_id"`
+	TargetAmountAfter float64 `json:"target_amount_after"`
+
+	// TargetAmountBefore Sum of the item target amounts in the base currency before recalculating
+	TargetAmountBefore float64 `json:"target_amount_before"`
 }
 
+// UnsupportedCurrencyPolicy What happens to invoices and items in a currency that isn't ISO 4217 and so has no exchange
+// rate: reject (the default) refuses them with a 400; pass_through stores their items at a 1:1
+// rate, flagged fx_unsupported.
+type UnsupportedCurrencyPolicy string
+
 // UpdateCategoryRequest defines model for UpdateCategoryRequest.
 type UpdateCategoryRequest struct {
 	// Color Hex color code
 	Color *string `json:"color,omitempty"`
 
+	// DefaultCurrency Currency of new invoices in this category that don't specify one; empty clears it
+	DefaultCurrency *string `json:"default_currency,omitempty"`
+
+	// DefaultTaxRate Tax rate (a percentage) of new invoices in this category that don't specify one; 0 clears it
+	DefaultTaxRate *float64 `json:"default_tax_rate,omitempty"`
+
 	// Description Category description
 	Description *string `json:"description,omitempty"`
 
@@ -548,36 +1532,75 @@ type UpdateCompanyRequest struct {
 
 // UpdateInvoiceRequest Request body for updating an invoice. Note that amount is calculated from invoice items and cannot be set directly.
 type UpdateInvoiceRequest struct {
-	CategoryId           *int           `json:"category_id,omitempty"`
-	CompanyId            *int           `json:"company_id,omitempty"`
-	Currency             *string        `json:"currency,omitempty"`
-	Description          *string        `json:"description,omitempty"`
-	DueDate              *time.Time     `json:"due_date,omitempty"`
-	InvoiceEndedAt       *time.Time     `json:"invoice_ended_at,omitempty"`
-	InvoiceStartedAt     *time.Time     `json:"invoice_started_at,omitempty"`
-	OriginalDownloadLink *string        `json:"original_download_link,omitempty"`
-	ReceiverId           *int           `json:"receiver_id,omitempty"`
-	Status               *InvoiceStatus `json:"status,omitempty"`
+	CategoryId  *int    `json:"category_id,omitempty"`
+	CompanyId   *int    `json:"company_id,omitempty"`
+	Currency    *string `json:"currency,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// DiscountType How discount_value reduces the amount
+	DiscountType *DiscountType `json:"discount_type,omitempty"`
+
+	// DiscountValue Invoice discount applied after summing the items; a percentage (0-100) or an amount in the invoice currency, depending on discount_type. Set it to 0 to remove the discount.
+	DiscountValue        *float64   `json:"discount_value,omitempty"`
+	DueDate              *time.Time `json:"due_date,omitempty"`
+	InvoiceEndedAt       *time.Time `json:"invoice_ended_at,omitempty"`
+	InvoiceStartedAt     *time.Time `json:"invoice_started_at,omitempty"`
+	OriginalDownloadLink *string    `json:"original_download_link,omitempty"`
+
+	// PaymentMethod Card or account the invoice was paid with (e.g. "Amex Gold"); empty when not recorded
+	PaymentMethod *string        `json:"payment_method,omitempty"`
+	ReceiverId    *int           `json:"receiver_id,omitempty"`
+	Status        *InvoiceStatus `json:"status,omitempty"`
 
 	// TagIds Tag IDs to associate with the invoice
-	TagIds *[]int  `json:"tag_ids,omitempty"`
-	Title  *string `json:"title,omitempty"`
+	TagIds *[]int `json:"tag_ids,omitempty"`
+
+	// TaxRate Tax percentage the invoice was charged at; unchanged if omitted
+	TaxRate *float64 `json:"tax_rate,omitempty"`
+	Title   *string  `json:"title,omitempty"`
+
+	// Version Version of the invoice the update is based on (from a previous response). The update is rejected with 409 when the invoice has changed since; omit it to update whatever the current version is.
+	Version *int `json:"version,omitempty"`
 }
 
 // UpdateItemRequest defines model for UpdateItemRequest.
 type UpdateItemRequest struct {
 	// AutoCalculateTargetCurrency When true, forces recalculation of target_amount using latest FX rate
-	AutoCalculateTargetCurrency *bool    `json:"auto_calculate_target_currency,omitempty"`
-	Description                 *string  `json:"description,omitempty"`
-	Quantity                    *float64 `json:"quantity,omitempty"`
+	AutoCalculateTargetCurrency *bool `json:"auto_calculate_target_currency,omitempty"`
+
+	// CategoryId Category of the item; 0 resets it to the invoice category
+	CategoryId *int `json:"category_id,omitempty"`
+
+	// Currency Currency of the item; an empty string resets it to the invoice currency. Changing it recalculates target_amount unless target_amount is given.
+	Currency    *string `json:"currency,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// DiscountType How discount_value reduces the amount
+	DiscountType *DiscountType `json:"discount_type,omitempty"`
+
+	// DiscountValue Item discount; a percentage (0-100) or an amount in the item currency, depending on discount_type. Set it to 0 to remove the discount.
+	DiscountValue *float64 `json:"discount_value,omitempty"`
+	Quantity      *float64 `json:"quantity,omitempty"`
 
 	// TargetAmount Manual override for USD amount (optional, auto-calculated if not provided)
 	TargetAmount *float64 `json:"target_amount,omitempty"`
-	UnitPrice    *float64 `json:"unit_price,omitempty"`
+
+	// Unit Unit of measure of the quantity (empty string to clear)
+	Unit      *string  `json:"unit,omitempty"`
+	UnitPrice *float64 `json:"unit_price,omitempty"`
 }
 
-// UpdateReceiverRequest defines model for UpdateReceiverRequest.
+// UpdateReceiverRequest Omitted fields keep their value; an empty contact detail clears it
 type UpdateReceiverRequest struct {
+	// Address Postal address, may span several lines
+	Address *string `json:"address,omitempty"`
+
+	// BankAccount Bank account details for payments (e.g. IBAN)
+	BankAccount *string `json:"bank_account,omitempty"`
+
+	// Email Contact email, e.g. billing@example.com
+	Email *string `json:"email,omitempty"`
+
 	// IsOrganization Whether the receiver is an organization
 	IsOrganization *bool `json:"is_organization,omitempty"`
 
@@ -586,6 +1609,60 @@ type UpdateReceiverRequest struct {
 
 	// OtherNames Alternative names/aliases to update (cannot add new ones, only edit/remove)
 	OtherNames *[]string `json:"other_names,omitempty"`
+
+	// Phone Contact phone number with at least 5 digits, e.g. +1 555-0100
+	Phone *string `json:"phone,omitempty"`
+
+	// TaxId Tax or VAT registration number
+	TaxId *string `json:"tax_id,omitempty"`
+}
+
+// UpdateSettingsRequest defines model for UpdateSettingsRequest.
+type UpdateSettingsRequest struct {
+	// BaseCurrency ISO 4217 currency code
+	BaseCurrency string `json:"base_currency"`
+
+	// CompanyAddress Company address for invoice documents. Unchanged if omitted; an empty string clears it.
+	CompanyAddress *string `json:"company_address,omitempty"`
+
+	// CompanyName Company name for invoice documents. Unchanged if omitted; an empty string clears it.
+	CompanyName *string `json:"company_name,omitempty"`
+
+	// Email Notification email address. Unchanged if omitted; an empty string disables notifications.
+	Email *string `json:"email,omitempty"`
+
+	// InvoiceNumberPadding Minimum digits of the sequence part of invoice numbers. Unchanged if omitted.
+	InvoiceNumberPadding *int `json:"invoice_number_padding,omitempty"`
+
+	// InvoiceNumberPrefix Prefix of new invoice numbers (max 32 characters); {year} is replaced by the current year. Unchanged if omitted.
+	InvoiceNumberPrefix *string `json:"invoice_number_prefix,omitempty"`
+
+	// LogoS3Key Storage key of an uploaded logo (from the upload endpoint). Unchanged if omitted; an empty string clears it.
+	LogoS3Key *string `json:"logo_s3_key,omitempty"`
+
+	// MaxItemLineAmount Largest item amount accepted, in the base currency. Unchanged if omitted; 0 resets it
+	// to the default (100,000,000).
+	MaxItemLineAmount *float64 `json:"max_item_line_amount,omitempty"`
+
+	// MaxItemQuantity Largest item quantity accepted. Unchanged if omitted; 0 resets it to the default (1,000,000).
+	MaxItemQuantity *float64 `json:"max_item_quantity,omitempty"`
+
+	// MaxItemUnitPrice Largest item unit price accepted, in the base currency; items above it are rejected as
+	// likely typos. Unchanged if omitted; 0 resets it to the default (10,000,000).
+	MaxItemUnitPrice *float64 `json:"max_item_unit_price,omitempty"`
+
+	// PaymentTrackingMode What decides whether an invoice is paid: manual (the default) makes the status set by the
+	// user the source of truth. Deriving the status from payments is rejected with a 400 until
+	// invoices have payment records.
+	PaymentTrackingMode *PaymentTrackingMode `json:"payment_tracking_mode,omitempty"`
+
+	// Timezone IANA timezone statistics group days in. Unchanged if omitted; an empty string resets it to UTC.
+	Timezone *string `json:"timezone,omitempty"`
+
+	// UnsupportedCurrency What happens to invoices and items in a currency that isn't ISO 4217 and so has no exchange
+	// rate: reject (the default) refuses them with a 400; pass_through stores their items at a 1:1
+	// rate, flagged fx_unsupported.
+	UnsupportedCurrency *UnsupportedCurrencyPolicy `json:"unsupported_currency,omitempty"`
 }
 
 // UpdateStatusRequest defines model for UpdateStatusRequest.
@@ -600,6 +1677,10 @@ type UpdateTagRequest struct {
 
 	// Name Tag name
 	Name *string `json:"name,omitempty"`
+
+	// ParentId ID of the tag to nest this tag under. Unchanged if omitted; 0 makes it a top-level tag. A
+	// tag can't be nested under itself or one of its descendants.
+	ParentId *int `json:"parent_id,omitempty"`
 }
 
 // UploadResponse defines model for UploadResponse.
@@ -620,21 +1701,164 @@ type UploadResponse struct {
 	Size int `json:"size"`
 }
 
+// UserSettings defines model for UserSettings.
+type UserSettings struct {
+	// BaseCurrency ISO 4217 currency code item target amounts and analytics are normalized to
+	BaseCurrency string `json:"base_currency"`
+
+	// CompanyAddress Company address for the header of invoice documents (omitted when not set)
+	CompanyAddress *string `json:"company_address,omitempty"`
+
+	// CompanyName Company name for the header of invoice documents (omitted when not set)
+	CompanyName *string    `json:"company_name,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+
+	// Email Address that receives notifications such as the daily overdue invoice digest (omitted when not set)
+	Email *string `json:"email,omitempty"`
+
+	// InvoiceNumberPadding Minimum digits of the sequence part of invoice numbers (zero-padded)
+	InvoiceNumberPadding *int `json:"invoice_number_padding,omitempty"`
+
+	// InvoiceNumberPrefix Prefix of new invoice numbers; {year} is replaced by the current year. Numbering restarts for each distinct rendered prefix.
+	InvoiceNumberPrefix *string `json:"invoice_number_prefix,omitempty"`
+
+	// LogoS3Key Storage key of an uploaded logo for invoice documents (omitted when not set); GET /api/files/{key}/download returns a URL for it
+	LogoS3Key *string `json:"logo_s3_key,omitempty"`
+
+	// MaxItemLineAmount Largest item amount accepted, in the base currency (compared by magnitude)
+	MaxItemLineAmount *float64 `json:"max_item_line_amount,omitempty"`
+
+	// MaxItemQuantity Largest item quantity accepted (compared by magnitude)
+	MaxItemQuantity *float64 `json:"max_item_quantity,omitempty"`
+
+	// MaxItemUnitPrice Largest item unit price accepted, in the base currency (compared by magnitude)
+	MaxItemUnitPrice *float64 `json:"max_item_unit_price,omitempty"`
+
+	// PaymentTrackingMode What decides whether an invoice is paid: manual (the default) makes the status set by the
+	// user the source of truth. Deriving the status from payments is rejected with a 400 until
+	// invoices have payment records.
+	PaymentTrackingMode *PaymentTrackingMode `json:"payment_tracking_mode,omitempty"`
+
+	// Timezone IANA timezone statistics group days in
+	Timezone *string `json:"timezone,omitempty"`
+
+	// UnsupportedCurrency What happens to invoices and items in a currency that isn't ISO 4217 and so has no exchange
+	// rate: reject (the default) refuses them with a 400; pass_through stores their items at a 1:1
+	// rate, flagged fx_unsupported.
+	UnsupportedCurrency *UnsupportedCurrencyPolicy `json:"unsupported_currency,omitempty"`
+	UpdatedAt           *time.Time                 `json:"updated_at,omitempty"`
+}
+
+// VendorStatement defines model for VendorStatement.
+type VendorStatement struct {
+	// Address Postal address, may span several lines; omitted when not recorded
+	Address *string `json:"address,omitempty"`
+
+	// BankAccount Bank account details for payments (e.g. IBAN); omitted when not recorded
+	BankAccount *string `json:"bank_account,omitempty"`
+
+	// ClosingBalance opening_balance plus outstanding
+	ClosingBalance *float64 `json:"closing_balance,omitempty"`
+
+	// Currency Base currency all amounts are reported in
+	Currency *string `json:"currency,omitempty"`
+
+	// Email Contact email; omitted when not recorded
+	Email    *string                 `json:"email,omitempty"`
+	EndDate  *time.Time              `json:"end_date,omitempty"`
+	Invoices *[]VendorStatementEntry `json:"invoices,omitempty"`
+	Name     *string                 `json:"name,omitempty"`
+
+	// OpeningBalance Outstanding amount of invoices dated before start_date
+	OpeningBalance *float64 `json:"opening_balance,omitempty"`
+
+	// Outstanding total_billed minus total_paid
+	Outstanding *float64 `json:"outstanding,omitempty"`
+
+	// Phone Contact phone number; omitted when not recorded
+	Phone      *string    `json:"phone,omitempty"`
+	ReceiverId *int       `json:"receiver_id,omitempty"`
+	StartDate  *time.Time `json:"start_date,omitempty"`
+
+	// TaxId Tax or VAT registration number; omitted when not recorded
+	TaxId       *string  `json:"tax_id,omitempty"`
+	TotalBilled *float64 `json:"total_billed,omitempty"`
+	TotalPaid   *float64 `json:"total_paid,omitempty"`
+}
+
+// VendorStatementEntry defines model for VendorStatementEntry.
+type VendorStatementEntry struct {
+	// Amount Invoice amount in its own currency
+	Amount *float64 `json:"amount,omitempty"`
+
+	// Balance Running outstanding balance after this invoice
+	Balance *float64 `json:"balance,omitempty"`
+
+	// Billed Invoice amount in the base currency
+	Billed *float64 `json:"billed,omitempty"`
+
+	// Currency Currency of amount
+	Currency *string `json:"currency,omitempty"`
+
+	// Date Due date, falling back to created_at
+	Date *time.Time `json:"date,omitempty"`
+
+	// FxRateUsed Average rate the invoice was converted to the base currency at (the invoice's fx_rate_used)
+	FxRateUsed    *float64 `json:"fx_rate_used,omitempty"`
+	InvoiceId     *int     `json:"invoice_id,omitempty"`
+	InvoiceNumber *string  `json:"invoice_number,omitempty"`
+
+	// Paid Amount paid in the base currency
+	Paid   *float64 `json:"paid,omitempty"`
+	Status *string  `json:"status,omitempty"`
+	Title  *string  `json:"title,omitempty"`
+}
+
+// AuditAction defines model for AuditAction.
+type AuditAction string
+
+// AuditEnd defines model for AuditEnd.
+type AuditEnd = time.Time
+
+// AuditLimit defines model for AuditLimit.
+type AuditLimit = int
+
+// AuditStart defines model for AuditStart.
+type AuditStart = time.Time
+
 // CategoryId defines model for CategoryId.
 type CategoryId = int
 
 // CompanyId defines model for CompanyId.
 type CompanyId = int
 
+// ForceDelete defines model for ForceDelete.
+type ForceDelete = bool
+
+// InvoiceExpand defines model for InvoiceExpand.
+type InvoiceExpand = string
+
 // InvoiceId defines model for InvoiceId.
 type InvoiceId = int
 
 // Limit defines model for Limit.
 type Limit = int
 
+// Locale defines model for Locale.
+type Locale = string
+
+// MemberUserId defines model for MemberUserId.
+type MemberUserId = string
+
+// NoCache defines model for NoCache.
+type NoCache = bool
+
 // Offset defines model for Offset.
 type Offset = int
 
+// OrganizationId defines model for OrganizationId.
+type OrganizationId = int
+
 // ReceiverId defines model for ReceiverId.
 type ReceiverId = int
 
@@ -644,6 +1868,9 @@ type TagId = int
 // BadRequest defines model for BadRequest.
 type BadRequest = Error
 
+// InUse defines model for InUse.
+type InUse = InUseError
+
 // NotFound defines model for NotFound.
 type NotFound = Error
 
@@ -654,6 +1881,11 @@ type Unauthorized = Error
 type GetAnalyticsByCategoryParams struct {
 	// Period Time period for analytics
 	Period *GetAnalyticsByCategoryParamsPeriod `form:"period,omitempty" json:"period,omitempty"`
+
+	// NoCache Recompute the analytics instead of serving them from the server's short-lived cache.
+	// Cached results are dropped whenever data changes, so this is only needed to refresh
+	// figures that depend on the current time.
+	NoCache *NoCache `form:"no_cache,omitempty" json:"no_cache,omitempty"`
 }
 
 // GetAnalyticsByCategoryParamsPeriod defines parameters for GetAnalyticsByCategory.
@@ -663,6 +1895,11 @@ type GetAnalyticsByCategoryParamsPeriod string
 type GetAnalyticsByCompanyParams struct {
 	// Period Time period for analytics
 	Period *GetAnalyticsByCompanyParamsPeriod `form:"period,omitempty" json:"period,omitempty"`
+
+	// NoCache Recompute the analytics instead of serving them from the server's short-lived cache.
+	// Cached results are dropped whenever data changes, so this is only needed to refresh
+	// figures that depend on the current time.
+	NoCache *NoCache `form:"no_cache,omitempty" json:"no_cache,omitempty"`
 }
 
 // GetAnalyticsByCompanyParamsPeriod defines parameters for GetAnalyticsByCompany.
@@ -672,6 +1909,11 @@ type GetAnalyticsByCompanyParamsPeriod string
 type GetAnalyticsByReceiverParams struct {
 	// Period Time period for analytics
 	Period *GetAnalyticsByReceiverParamsPeriod `form:"period,omitempty" json:"period,omitempty"`
+
+	// NoCache Recompute the analytics instead of serving them from the server's short-lived cache.
+	// Cached results are dropped whenever data changes, so this is only needed to refresh
+	// figures that depend on the current time.
+	NoCache *NoCache `form:"no_cache,omitempty" json:"no_cache,omitempty"`
 }
 
 // GetAnalyticsByReceiverParamsPeriod defines parameters for GetAnalyticsByReceiver.
@@ -681,6 +1923,14 @@ type GetAnalyticsByReceiverParamsPeriod string
 type GetAnalyticsByTagParams struct {
 	// Period Time period for analytics
 	Period *GetAnalyticsByTagParamsPeriod `form:"period,omitempty" json:"period,omitempty"`
+
+	// IncludeChildren Roll the invoices of child tags up into their parent tags
+	IncludeChildren *bool `form:"include_children,omitempty" json:"include_children,omitempty"`
+
+	// NoCache Recompute the analytics instead of serving them from the server's short-lived cache.
+	// Cached results are dropped whenever data changes, so this is only needed to refresh
+	// figures that depend on the current time.
+	NoCache *NoCache `form:"no_cache,omitempty" json:"no_cache,omitempty"`
 }
 
 // GetAnalyticsByTagParamsPeriod defines parameters for GetAnalyticsByTag.
@@ -690,11 +1940,36 @@ type GetAnalyticsByTagParamsPeriod string
 type GetAnalyticsSummaryParams struct {
 	// Period Time period for analytics
 	Period *GetAnalyticsSummaryParamsPeriod `form:"period,omitempty" json:"period,omitempty"`
+
+	// PaidBy Which date places paid invoices in the period. invoice_date uses the due date, falling
+	// back to the creation date, like the other buckets; payment_date counts the invoices paid
+	// within the period, however old they are, so paid amounts may exceed the total.
+	PaidBy *GetAnalyticsSummaryParamsPaidBy `form:"paid_by,omitempty" json:"paid_by,omitempty"`
+
+	// NoCache Recompute the analytics instead of serving them from the server's short-lived cache.
+	// Cached results are dropped whenever data changes, so this is only needed to refresh
+	// figures that depend on the current time.
+	NoCache *NoCache `form:"no_cache,omitempty" json:"no_cache,omitempty"`
 }
 
 // GetAnalyticsSummaryParamsPeriod defines parameters for GetAnalyticsSummary.
 type GetAnalyticsSummaryParamsPeriod string
 
+// GetAnalyticsSummaryParamsPaidBy defines parameters for GetAnalyticsSummary.
+type GetAnalyticsSummaryParamsPaidBy string
+
+// GetAnalyticsTrendParams defines parameters for GetAnalyticsTrend.
+type GetAnalyticsTrendParams struct {
+	// Months Number of months to return
+	Months *int `form:"months,omitempty" json:"months,omitempty"`
+}
+
+// GetBudgetStatusParams defines parameters for GetBudgetStatus.
+type GetBudgetStatusParams struct {
+	// AsOf Evaluate budgets for the period containing this time (default now)
+	AsOf *time.Time `form:"as_of,omitempty" json:"as_of,omitempty"`
+}
+
 // ListCategoriesParams defines parameters for ListCategories.
 type ListCategoriesParams struct {
 	// Keyword Search keyword for category name
@@ -707,6 +1982,12 @@ type ListCategoriesParams struct {
 	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// DeleteCategoryParams defines parameters for DeleteCategory.
+type DeleteCategoryParams struct {
+	// Force Remove the record from the invoices referencing it instead of refusing to delete it
+	Force *ForceDelete `form:"force,omitempty" json:"force,omitempty"`
+}
+
 // ListCompaniesParams defines parameters for ListCompanies.
 type ListCompaniesParams struct {
 	// Keyword Search keyword for company name
@@ -719,11 +2000,43 @@ type ListCompaniesParams struct {
 	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// DeleteCompanyParams defines parameters for DeleteCompany.
+type DeleteCompanyParams struct {
+	// Force Remove the record from the invoices referencing it instead of refusing to delete it
+	Force *ForceDelete `form:"force,omitempty" json:"force,omitempty"`
+}
+
+// GetCompanyBreakdownParams defines parameters for GetCompanyBreakdown.
+type GetCompanyBreakdownParams struct {
+	// Period Time period for the breakdown
+	Period *GetCompanyBreakdownParamsPeriod `form:"period,omitempty" json:"period,omitempty"`
+}
+
+// GetCompanyBreakdownParamsPeriod defines parameters for GetCompanyBreakdown.
+type GetCompanyBreakdownParamsPeriod string
+
+// GetDashboardParams defines parameters for GetDashboard.
+type GetDashboardParams struct {
+	// Period Time period for the summary and breakdowns
+	Period *GetDashboardParamsPeriod `form:"period,omitempty" json:"period,omitempty"`
+
+	// NoCache Recompute the analytics instead of serving them from the server's short-lived cache.
+	// Cached results are dropped whenever data changes, so this is only needed to refresh
+	// figures that depend on the current time.
+	NoCache *NoCache `form:"no_cache,omitempty" json:"no_cache,omitempty"`
+}
+
+// GetDashboardParamsPeriod defines parameters for GetDashboard.
+type GetDashboardParamsPeriod string
+
 // ListInvoicesParams defines parameters for ListInvoices.
 type ListInvoicesParams struct {
 	// Keyword Search keyword for invoice title or description
 	Keyword *string `form:"keyword,omitempty" json:"keyword,omitempty"`
 
+	// ExcludeKeyword Exclude invoices whose title or description contains this keyword (combined with keyword using AND)
+	ExcludeKeyword *string `form:"exclude_keyword,omitempty" json:"exclude_keyword,omitempty"`
+
 	// CategoryId Filter by category ID
 	CategoryId *int `form:"category_id,omitempty" json:"category_id,omitempty"`
 
@@ -736,11 +2049,28 @@ type ListInvoicesParams struct {
 	// TagIds Filter by tag IDs (comma-separated)
 	TagIds *string `form:"tag_ids,omitempty" json:"tag_ids,omitempty"`
 
+	// Tags Filter by tag names (comma-separated); combined with tag_ids
+	Tags *string `form:"tags,omitempty" json:"tags,omitempty"`
+
+	// TagMatch Whether invoices need any (default) or all of the tags given by tag_ids and tags
+	TagMatch *ListInvoicesParamsTagMatch `form:"tag_match,omitempty" json:"tag_match,omitempty"`
+
 	// Status Filter by invoice status
 	Status *InvoiceStatus `form:"status,omitempty" json:"status,omitempty"`
 
-	// SortBy Field to sort by
-	SortBy *ListInvoicesParamsSortBy `form:"sort_by,omitempty" json:"sort_by,omitempty"`
+	// PaymentMethod Filter by exact payment method; an empty value matches invoices without one
+	PaymentMethod *string `form:"payment_method,omitempty" json:"payment_method,omitempty"`
+
+	// IncludeDrafts Include draft invoices, which are left out by default
+	IncludeDrafts *bool `form:"include_drafts,omitempty" json:"include_drafts,omitempty"`
+
+	// OrganizationId Only list invoices of this organization. By default the list has the user's own invoices and those of every organization the user belongs to
+	OrganizationId *int `form:"organization_id,omitempty" json:"organization_id,omitempty"`
+
+	// SortBy Field to sort by (created_at, updated_at, amount, due_date, title), or several comma-separated
+	// fields such as "amount,created_at" to break ties by the later ones. Ties left over are ordered by
+	// id descending so pages don't repeat or skip invoices. Unknown fields are rejected.
+	SortBy *string `form:"sort_by,omitempty" json:"sort_by,omitempty"`
 
 	// SortOrder Sort order
 	SortOrder *ListInvoicesParamsSortOrder `form:"sort_order,omitempty" json:"sort_order,omitempty"`
@@ -750,14 +2080,137 @@ type ListInvoicesParams struct {
 
 	// Offset Number of items to skip
 	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Opaque cursor from a previous response's next_cursor. Enables keyset pagination
+	// ordered by created_at and id; offset, sort_by, and sort_order are ignored.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// CursorDirection Keyset pagination direction. Setting it without a cursor requests the first page in cursor mode.
+	CursorDirection *ListInvoicesParamsCursorDirection `form:"cursor_direction,omitempty" json:"cursor_direction,omitempty"`
+
+	// MinAmount Only include invoices whose amount (see amount_field) is at least this value
+	MinAmount *float64 `form:"min_amount,omitempty" json:"min_amount,omitempty"`
+
+	// MaxAmount Only include invoices whose amount (see amount_field) is at most this value
+	MaxAmount *float64 `form:"max_amount,omitempty" json:"max_amount,omitempty"`
+
+	// AmountField Amount compared by min_amount/max_amount. target_amount (default) is normalized to the
+	// base currency so invoices in different currencies compare fairly; amount is the raw
+	// amount in the invoice currency.
+	AmountField *ListInvoicesParamsAmountField `form:"amount_field,omitempty" json:"amount_field,omitempty"`
+
+	// Expand Comma-separated relations to load with each invoice: category, company, receiver, items,
+	// tags, attachments. All relations are loaded when omitted; an empty value loads none.
+	// target_amount is computed from the items, so it is omitted unless items are expanded.
+	Expand *InvoiceExpand `form:"expand,omitempty" json:"expand,omitempty"`
+
+	// Locale BCP 47 locale (e.g. en-US, de-DE, ja-JP). When given, invoices also include amount_formatted
+	// and target_amount_formatted, formatted for display in that locale.
+	Locale *Locale `form:"locale,omitempty" json:"locale,omitempty"`
 }
 
-// ListInvoicesParamsSortBy defines parameters for ListInvoices.
-type ListInvoicesParamsSortBy string
+// ListInvoicesParamsTagMatch defines parameters for ListInvoices.
+type ListInvoicesParamsTagMatch string
 
 // ListInvoicesParamsSortOrder defines parameters for ListInvoices.
 type ListInvoicesParamsSortOrder string
 
+// ListInvoicesParamsCursorDirection defines parameters for ListInvoices.
+type ListInvoicesParamsCursorDirection string
+
+// ListInvoicesParamsAmountField defines parameters for ListInvoices.
+type ListInvoicesParamsAmountField string
+
+// ImportInvoicesMultipartBody defines parameters for ImportInvoices.
+type ImportInvoicesMultipartBody struct {
+	// File CSV file to import
+	File openapi_types.File `json:"file"`
+}
+
+// ImportInvoicesParams defines parameters for ImportInvoices.
+type ImportInvoicesParams struct {
+	// Format Format of the uploaded file
+	Format *ImportInvoicesParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// CreateMissing Create categories, companies, and receivers that don't exist instead of failing the row
+	CreateMissing *bool `form:"create_missing,omitempty" json:"create_missing,omitempty"`
+}
+
+// ImportInvoicesParamsFormat defines parameters for ImportInvoices.
+type ImportInvoicesParamsFormat string
+
+// LookupInvoicesParams defines parameters for LookupInvoices.
+type LookupInvoicesParams struct {
+	// Number Invoice number, e.g. INV-2024-0001
+	Number *string `form:"number,omitempty" json:"number,omitempty"`
+
+	// Link Original download link, matched exactly
+	Link *string `form:"link,omitempty" json:"link,omitempty"`
+}
+
+// GetInvoiceParams defines parameters for GetInvoice.
+type GetInvoiceParams struct {
+	// Expand Comma-separated relations to load with each invoice: category, company, receiver, items,
+	// tags, attachments. All relations are loaded when omitted; an empty value loads none.
+	// target_amount is computed from the items, so it is omitted unless items are expanded.
+	Expand *InvoiceExpand `form:"expand,omitempty" json:"expand,omitempty"`
+
+	// IncludeAmountInWords Also return the amount spelled out in amount_in_words
+	IncludeAmountInWords *bool `form:"include_amount_in_words,omitempty" json:"include_amount_in_words,omitempty"`
+
+	// Locale BCP 47 locale (e.g. en-US, de-DE, ja-JP). When given, invoices also include amount_formatted
+	// and target_amount_formatted, formatted for display in that locale.
+	Locale *Locale `form:"locale,omitempty" json:"locale,omitempty"`
+}
+
+// GetInvoiceAuditTrailParams defines parameters for GetInvoiceAuditTrail.
+type GetInvoiceAuditTrailParams struct {
+	// Action Only entries with this action
+	Action *GetInvoiceAuditTrailParamsAction `form:"action,omitempty" json:"action,omitempty"`
+
+	// Start Only entries recorded at or after this time
+	Start *AuditStart `form:"start,omitempty" json:"start,omitempty"`
+
+	// End Only entries recorded at or before this time
+	End *AuditEnd `form:"end,omitempty" json:"end,omitempty"`
+
+	// Limit Maximum number of entries to return; every entry when omitted
+	Limit *AuditLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of items to skip
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetInvoiceAuditTrailParamsAction defines parameters for GetInvoiceAuditTrail.
+type GetInvoiceAuditTrailParamsAction string
+
+// FindSimilarInvoicesParams defines parameters for FindSimilarInvoices.
+type FindSimilarInvoicesParams struct {
+	// Limit Maximum number of invoices to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetInvoiceStatusHistoryParams defines parameters for GetInvoiceStatusHistory.
+type GetInvoiceStatusHistoryParams struct {
+	// Action Only entries with this action
+	Action *GetInvoiceStatusHistoryParamsAction `form:"action,omitempty" json:"action,omitempty"`
+
+	// Start Only entries recorded at or after this time
+	Start *AuditStart `form:"start,omitempty" json:"start,omitempty"`
+
+	// End Only entries recorded at or before this time
+	End *AuditEnd `form:"end,omitempty" json:"end,omitempty"`
+
+	// Limit Maximum number of entries to return; every entry when omitted
+	Limit *AuditLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of items to skip
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetInvoiceStatusHistoryParamsAction defines parameters for GetInvoiceStatusHistory.
+type GetInvoiceStatusHistoryParamsAction string
+
 // AddTagToInvoiceJSONBody defines parameters for AddTagToInvoice.
 type AddTagToInvoiceJSONBody struct {
 	// TagId Tag ID to add
@@ -776,6 +2229,36 @@ type ListReceiversParams struct {
 	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// DeleteReceiverParams defines parameters for DeleteReceiver.
+type DeleteReceiverParams struct {
+	// Force Remove the record from the invoices referencing it instead of refusing to delete it
+	Force *ForceDelete `form:"force,omitempty" json:"force,omitempty"`
+}
+
+// GetReceiverStatementParams defines parameters for GetReceiverStatement.
+type GetReceiverStatementParams struct {
+	// Start Start of the statement period (invoice due date, falling back to created_at)
+	Start time.Time `form:"start" json:"start"`
+
+	// End End of the statement period (inclusive)
+	End time.Time `form:"end" json:"end"`
+
+	// Format Response format
+	Format *GetReceiverStatementParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetReceiverStatementParamsFormat defines parameters for GetReceiverStatement.
+type GetReceiverStatementParamsFormat string
+
+// GetReceiverStatisticsParams defines parameters for GetReceiverStatistics.
+type GetReceiverStatisticsParams struct {
+	// Period Time period for statistics
+	Period *GetReceiverStatisticsParamsPeriod `form:"period,omitempty" json:"period,omitempty"`
+}
+
+// GetReceiverStatisticsParamsPeriod defines parameters for GetReceiverStatistics.
+type GetReceiverStatisticsParamsPeriod string
+
 // ListTagsParams defines parameters for ListTags.
 type ListTagsParams struct {
 	// Keyword Search keyword for tag name
@@ -803,6 +2286,9 @@ type GetPresignedURLParams struct {
 	ContentType *string `form:"content_type,omitempty" json:"content_type,omitempty"`
 }
 
+// CreateBudgetJSONRequestBody defines body for CreateBudget for application/json ContentType.
+type CreateBudgetJSONRequestBody = CreateBudgetRequest
+
 // CreateCategoryJSONRequestBody defines body for CreateCategory for application/json ContentType.
 type CreateCategoryJSONRequestBody = CreateCategoryRequest
 
@@ -815,15 +2301,36 @@ type CreateCompanyJSONRequestBody = CreateCompanyRequest
 // UpdateCompanyJSONRequestBody defines body for UpdateCompany for application/json ContentType.
 type UpdateCompanyJSONRequestBody = UpdateCompanyRequest
 
+// ImportDataJSONRequestBody defines body for ImportData for application/json ContentType.
+type ImportDataJSONRequestBody = ExportDocument
+
 // CreateInvoiceJSONRequestBody defines body for CreateInvoice for application/json ContentType.
 type CreateInvoiceJSONRequestBody = CreateInvoiceRequest
 
+// ImportInvoicesMultipartRequestBody defines body for ImportInvoices for multipart/form-data ContentType.
+type ImportInvoicesMultipartRequestBody ImportInvoicesMultipartBody
+
 // UpdateInvoiceJSONRequestBody defines body for UpdateInvoice for application/json ContentType.
 type UpdateInvoiceJSONRequestBody = UpdateInvoiceRequest
 
+// AddInvoiceAttachmentJSONRequestBody defines body for AddInvoiceAttachment for application/json ContentType.
+type AddInvoiceAttachmentJSONRequestBody = AddAttachmentRequest
+
+// CloneInvoiceJSONRequestBody defines body for CloneInvoice for application/json ContentType.
+type CloneInvoiceJSONRequestBody = CloneInvoiceRequest
+
+// PreviewCurrencyConversionJSONRequestBody defines body for PreviewCurrencyConversion for application/json ContentType.
+type PreviewCurrencyConversionJSONRequestBody = ConversionPreviewRequest
+
 // AddInvoiceItemJSONRequestBody defines body for AddInvoiceItem for application/json ContentType.
 type AddInvoiceItemJSONRequestBody = AddItemRequest
 
+// AddInvoiceItemsJSONRequestBody defines body for AddInvoiceItems for application/json ContentType.
+type AddInvoiceItemsJSONRequestBody = AddItemsRequest
+
+// ReorderInvoiceItemsJSONRequestBody defines body for ReorderInvoiceItems for application/json ContentType.
+type ReorderInvoiceItemsJSONRequestBody = ReorderItemsRequest
+
 // UpdateInvoiceStatusJSONRequestBody defines body for UpdateInvoiceStatus for application/json ContentType.
 type UpdateInvoiceStatusJSONRequestBody = UpdateStatusRequest
 
@@ -833,6 +2340,12 @@ type AddTagToInvoiceJSONRequestBody AddTagToInvoiceJSONBody
 // UpdateInvoiceItemJSONRequestBody defines body for UpdateInvoiceItem for application/json ContentType.
 type UpdateInvoiceItemJSONRequestBody = UpdateItemRequest
 
+// CreateOrganizationJSONRequestBody defines body for CreateOrganization for application/json ContentType.
+type CreateOrganizationJSONRequestBody = CreateOrganizationRequest
+
+// SetOrganizationMemberJSONRequestBody defines body for SetOrganizationMember for application/json ContentType.
+type SetOrganizationMemberJSONRequestBody = SetOrganizationMemberRequest
+
 // CreateReceiverJSONRequestBody defines body for CreateReceiver for application/json ContentType.
 type CreateReceiverJSONRequestBody = CreateReceiverRequest
 
@@ -842,6 +2355,9 @@ type MergeReceiversJSONRequestBody = MergeReceiversRequest
 // UpdateReceiverJSONRequestBody defines body for UpdateReceiver for application/json ContentType.
 type UpdateReceiverJSONRequestBody = UpdateReceiverRequest
 
+// UpdateSettingsJSONRequestBody defines body for UpdateSettings for application/json ContentType.
+type UpdateSettingsJSONRequestBody = UpdateSettingsRequest
+
 // CreateTagJSONRequestBody defines body for CreateTag for application/json ContentType.
 type CreateTagJSONRequestBody = CreateTagRequest
 
@@ -860,105 +2376,334 @@ type UploadHtmlToPdfJSONRequestBody = HtmlToPdfRequest
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9+27cOLL3qxDaBT7ng+y2k8zsHv/nxMnEC2eSY7cxB0hyOmypuptridRQlJ2ewO9+",
-	"wIskqkXd2n1xdgYIELfEm4o/FquKVcXvXsDihFGgIvVOv3sJ5jgGAVz9eo0FzBlfXoTyVwhpwEkiCKPe",
-	"afEOXZx7vkfkowSLhed7FMfgnXok9HyPw+8Z4RB6p4Jn4HtpsIAYy9bEMlGlqIA5cO/hwfdeszjB1N2b",
-	"frXBzi7oHSMBuDozrzbY2SWJiah39B5/I3EWI5rFU+CIzRAREKdIMMRBZJzm/f+eAV+WA4hUc3afIcxw",
-	"Fgnv9Kdj34t1s97pybH8Raj55buG9mE2S8Extl/rY0pvSdIwIqZbcQ7JHsOxcwxXEAC5A+6ajPzdBmdj",
-	"jOeunsZ4vrFOHmTpNGE0BbWSXuHwCn7PIFWUDhgVQNWfOEkiEmA5hNG/UzmO71a7f+cw8069v43KVTrS",
-	"b9PRG86Z6ar6Ha9wiLjp7MH3fmXiLctouP2OryBlGQ8AUSbQTPX54Hs3FGdiwTj5A3Ywhkpv8rWpIRs8",
-	"C8MLAbE1EQlnCXBB9CRVWqoxBQExsh8VSE4FJ3QuP/X3DFNBxLIC/xPfmzEeY+GdeiHLphGUVfXCl1Uz",
-	"SsQk4SSA1bXTWfnBRuanyrC/FIXZ9N8QKDycURwtBQnSV8tfOMuSOh2AhpMQCzWSsncs4FCQGFwfrliE",
-	"LF780TZ5xQhU/5Kwsg3TKOYcL+XvBDhhobW6yu5SgbkYOMSMBnrHynE4dIQPbbQsy9WoGbCI8Tqe3sE3",
-	"pF6hgxnjKB8cpM+cBA5dbEayKrVVTQKW6SVVL6I5mIOKCSbhBMd5zR4gFUzgaFiVjA7tppXO11kcY77c",
-	"CGa7ScfugIcZDPvivFJLu8Mpr2q0tVgslpU9jcSA9Et08I/QRyexj06WToyts6p2goiiTiMBXJjJBdQ1",
-	"VmTAQkAHcDQ/8j1JeyGAyxL/+7dPx4f/dXb4Fh/Ovnz/+eHvLpIEHLCAcIJFfzK27jyFpN2x+5BOKb2Z",
-	"OTTUUq9dzDQJB39jlgKfuMb44Z4CR/J1ZZR5zba5vSSpuDJylmM/xwL33pQKuDi2oigX3x0sohCf6+/U",
-	"4ugNV63h1L8ChyGHNG3WifICG8IixJhErt6owIFA+rUlluQP+uHR1uN6w9FUakIjZcIhwElxj8g/cYR0",
-	"CUfVZMEoNH+sfu3ie/ibE8tj/A2REKggMyPYGuVu36vI9+5hmhLRQt68gDW3GSc9F6RuY5Pr0ayHvS1H",
-	"OiM8vkkiVlHdVncSpctMdPWaen/x/g2Sr6QWLRaAZiRyTqp87ob+B07mRCK4KOKofgvLes3rF0h/DbqF",
-	"pTEnQIhmnMUo4ZCSufx5c3WJgIYJI1Q4ZQLyh2NUb0kESL5ChKLpUq+tAjSEip9fek5F39ZT5KitT/er",
-	"xDRduxSY14qp5fy6ZW567PJb24zX2lhXKKQKtVBAr5BGAlgbRzOT72bjj+TJzSy3ham2Ma9O5jSAhMbS",
-	"Z5Fw1aKhXqApC5dIqWmyGqFzhCkyCsQR+pUJQGKBBdJCLSIpCnAUZJFk73rJmcLGmIZpiAJMKRNoCigF",
-	"gULCIRDR8kgKnFUcG8xMmnTAQE9F8/uMc6BB1Srh3Vyf9wB//X0Ga+pZQMOBe11eUykmQ+sOskgYNFjG",
-	"Ice2wwwvnoTsnsptYRIRetsNSYlIbcNsnKJUYJF1jtKg9VoXVgtmPiFh2mTKVEZbnKYsIFgAuidiobYh",
-	"Q1fPopJjt1z5ekFEBM22cv26aznqUi3r8S/znCZEbvVuJAZJJ4zPMSV/4JIgZlQzHKWwahj9bQFiAVwB",
-	"IMejZFSYokpDxZCmjEWAafMWUFjmN7GZjfH8cTv52vq6++PkCnrcd2kTdd1QlT+u9qdKoxjSFM+hn8wt",
-	"pbBzw4puri5b5O6cX2Xcodh9LITBvJySCg/gW0I4pFLEO0ELlvFnnZqB75lKhlWvmOalrCnfa73IcO5+",
-	"7HzrEnI/kr8TcTRmH8NZI1ZbBpqJJBPFMH1k1qvi0nOgwKW0cJSEM9cXLETsmLt34/eXyMjNspmA0Tvg",
-	"6s+P529d7USYhmmAXerKZf4KMU6ACjVN1WEqzuJkETHmc0InUyYEi+ttv1LPkS6F1L9gAWm19eOjl14v",
-	"bmw6i2DmgNklzMSGO+JkvnBJh/LxhrsSLHEwI5ZsqpsEJ8AnC3B/0Uf5Fum3TV2dnAzp6Z6EYtHUkXrZ",
-	"1M8/j37yhm+vap242LERVBxqUmGIXiW6wFEu0R+0yvM+4oDDQ0aj5bN+xAks43Rfq+SKIjDM0BuU5sWe",
-	"VpeqWjHIjLeO2bFNS1np3JS0t310c33+bLAynwuvHXKjrfOswngZS9YbZoBUib77GelyO2k+6rP1qBUm",
-	"S6JI6qbBMogAAQ0HjsmpbrV1oUoO7GSQXpY76TQcETdrZA0yQr5q5SYspZweEk0uK3cNNZeGHfpem0PL",
-	"JnVBtyKYIvt8uZDyh9BfCeczkKsOnLoh5nMQkyZOenN9fkglmSPyB4RI9Ges/w9Vmh7OZ9fTWvd/wGUD",
-	"f80dK9d20f9Hpfbad3va9BlmH/V89m0iReBJloKDiG++BQtM54BkGUnMUONaSbypbrLHlzkZrxxcB9d1",
-	"1fuIuWT+pJ1vV60Odv3/zt/0g3L7IjvT0240ALXMzOJB+daKDswu2b+3/rvyeKUvOTn5otcK30HTHr1q",
-	"XKn6cxGB9Lt+hprBC7dl+W3wOCsXP/d0nFXdLpS/TBZLeTnByrVRO1h4hfeKJUKX8+TaDeqHXoSSGEdI",
-	"4DnieTEp4EdZCKEW9vV6KR0kV03epM07s++pcW8LjvruRjPOe+DzwhyXNur92vPRbY29OE8RmxVWN6Xd",
-	"xLJZRKhcpQvIV+qBWEAKVsl7EkVoKiXUCASEz9pttjGhF/rtSeMm7WSA5/kJZWEZFAzdAiToAEeF2FQO",
-	"J2Z3hsMsSFpUetZ96FcOwrdJ1ofwqeI9NTuoGdrELPxWR+b8Mzjg1Ni8qvQvhD0XzNSUWU5ITd2Us6dr",
-	"OBsbLla6lnVhu2u1//U8pd6U4Uwy4T7mxpurS7VL6NJruR1cWVRc+eY1JJh1pPWnb4f3PSY7nMi3Li+Z",
-	"SACnWJA7UA2kIxwRnEKKDhKW2JK5RnMJbxczsg5zV9jPviXqnEob3NNtlW8fm/oYz38E58Yt7+b7h9YY",
-	"zzeIKjmrewLUjSLkf6hnTdPX7taL5ik5yjRQZLBTjFp/P7BTzH+sE8xfHiuu9dOC/Db3E5wJNikQPGkz",
-	"kDQJfhSpwEG5aLQakjdHGFUamG3iQVkq15TsLBXo7f8ow5dTLOyCq22Beryh6T2mGY4QuwPOSQiKA9xc",
-	"nxd2R5Zop2sfSYodWmuezFRkYMLZHQm1MjvY9Wat8CE9u+v51PyYsrtgmisDOjAsFYchonCPGIXUR4xG",
-	"SwQhESMOUpkfIss3U1iv82YbyTo8Y8WAYNr40jiGH8qRyPEN2t98C6r8pryAjtBbxhFGMw7pQhXCMwHc",
-	"cu3xpfiMfnkzRiOckNGMRJCOvt/C8mGUN97j4G0PLj+D/N17ubdXiF7xdlc9rTi911EthwRBxolYXsv1",
-	"YaLJAXPgZ5n2rJiqX29zcv7rt3HNOP+v38ZIV0KC3QKVrHkBVJgYlaPP9DP9MBWYUISRLKxLKSFtyTKO",
-	"PsjORh8uzl/n7Jsrec3YdRFR7iJiAZ/pmYm/1rvaArAqm56ir5U3p/mAPmfHxy8C1aH6E77K0YwXoAYS",
-	"Z6k4/UwP0Ss5AwqgSiq4un7+088+urp+8c+X8r+fTp776I1++EY/ZBy9kc9l7Xf4DhBGdzgiIfqaZtOv",
-	"6CDNFJGfoSDCJM7DdpZy05UMXqqAsuqvWh7VCyFUlDI7nK6YquF95SyC9KvsVP359RTdSB1SPVZ7JLa/",
-	"XlVJA5aArpIGyddTTWWkHqefaZ5NQW0kilYl6hZCJBKvqsZzxxJRLT0/Ol6ZaTSL2L0EYsTuc6mmHNVr",
-	"qbetPryR7EJ1mJ6ORvLVEXzDcRLBUcDiURFur4YrRy5b4IDD0zKmWe10OLSinFU6BVlGyel2keKBKZFb",
-	"bfMCxW/zvrBH5QXKB753z4mA6kC0v6lv9kffmNarQzPVrLE11bJGqytZw22oY32ArmJ/QUOdsojS6W+h",
-	"a1pUmcoegxVSVMoEQmcs301woDZKk+fi6tsYggW6xFPP97JKF3MiFtlUNc6/CQgWhxGejszHHMaY4jnE",
-	"QEVNIvXOPl6oFaDKyOWVU8C3qO6XtPQVa1E+Gto2rNitluaLo/v3RYfo7OOF53v5MfCpd3J0fHSshKcE",
-	"KE6Id+q9ODo+eqG39IUCqNqZcB5WPpouD22XrDk49V6RcZoWWqsWeFM05yxLIETTZf45Wi3GysXVRF97",
-	"ajR6f7wIvVPvFxBWJobCz8uvpOD51BbPrfrIm2jIy1J07sjL4p3Enl8cBP5DllJPTpaO07+HLysZTZ4f",
-	"H28sm0ctJYUjsUdRxqaznOSXxydN7RcDHtXTguSJBORElFNadOKYVC93svlUDsb7IhtzgKl0t1sbS8bH",
-	"bjCUTNd/IakXkkqHx+0DqZiZ3jiyjwnXBVKhqQ5F0lV5GvoXlLqhxK3joK1jyT6p7gsmoc+L1sWRwPPB",
-	"EBrj+V/o6YUeoY99tg4coWakH2bSMttOK2jUIZOPEkxCLbtpd6IamIahJ8/18+fGT06FVvzkE7VhAJmn",
-	"FZK2IcfWszogg1EidQFlF45IKhCbWcqA1vFzSzJKAfNgUQPLJUnFa1tta8XJtWoE3cLynvHQdoouDg1d",
-	"cDHlPUe6v9KQ56Z3OZyRTgDZo6BJx7hVbDmz5zjwdVmbl00ATLVa0bZzSFlz+eXB9xLmOn3UCrIEEIX7",
-	"AqmWjF7FSDVZhEniCKl4xcLl5gjqzEjxUDUJCp7BQ21WTzY+q66ZLE7Hjf+Gnsfj7nm08lZuYOo1nVwK",
-	"VWXq6+xk9J2EDxoLEbgCcc7Vc4mKRiToIs2KdsfCtHLhOhbny1Z/BuW7uSYBZaWX3ZWKLJ9Vip9XjFvL",
-	"5sXWwawLTjk1QV+1bXtLhD3e7foIQWASpXuZK7nzdk9UkrkibJS1UB1EwjeSKmeMxoVQ9fJ5/Hxtnp+6",
-	"/ZB68dMd4yX3Ot4PP9V06s9PS3v2OtJZXnuAcGZZxwfLZtUEQ38W0cyRR61NMisIvDHBzJqyAkzFs75i",
-	"mZm80R3QkPEmoawwh21RJqt69+1aJMuNiw4OYuD9NASymmHSnvIa+xgijRUtO4WxJlN11xZUXBTQUxQz",
-	"xH4KklgrqbvlMPMlzWLYNkh6vMsVsXcRrGOG+gtgDdiv+B0/eqK2Jn2twTl3ipOnIXr14pxOD6gmGewX",
-	"kwpISWFuryxtR5WtHqEPNFoWyUURU9ELAaYIBwGkqQrQO3IxipXsUZ0SWiWdaDWfqeO+DO391Hlhxk5M",
-	"qU15shzYOrepXORkegQrerH9Gy/eMj4lYQgUHeqwlZBBqhxt2T3VAZpqnjbAGhXEbCRauNeeixboS0eY",
-	"NZQNK+JULGS/AriOMAhRyrjkr06N46L0rRmqcBA7JwRifCUO5REKSM2ZUACveIuUt86sdGCHObRfatPS",
-	"S+3qpNVOylCJdfvg9Ut6VjqxAwrW7UWYGIGDgMUxPkxBTrGJija+T96pd+I/9180jCIPP1hzwopzEe2E",
-	"7O6jeNlvXdfcnevdQ6TilCXu0XTZ1C3jYqLeus61rLi98nyr8tAKovPzDCNWpItfy93ZTLBrOVDGQ3U6",
-	"3TjWvIBruLI9a6BY/VIP3f3/SCq+K7dEi4pfsNFNafiW+2HOuwuuOfTYpWoJigg1sVwNGv9FEcazPY1/",
-	"JXptxxp/keajPqG54+KT0PjLgCoHBlZ38P76PrV8D0JERNoAB12hhMMwFai8uq+f+l+medu7+t9K9y7t",
-	"v6SuUv+N871yqHVS+RcQWyHx8S6Xy77NAR0z1tsaULbjsgZsap62ZQ1Yh6vuFCZPwhownKuOiqA797Z7",
-	"FoZyzy12VhUfSxvBdBaGdua4p4emlSsY97M7m/v86liSBMZhuDcYnYVhJSa+DiSk8zs1oamMsEywCBbN",
-	"nInlViNdQwqaLbCq8IDrXL95mpyqGoP69BiVIfhT4lelPtubbeXZRlu5ltTXO/nVGM/HbL/bXzXEVtsI",
-	"mjIUqA8Kwz6ZzlQzjpDOp4JI+UGK28lvImV+wt3g8XGSmeSUBl71TXcs/29D7ui7wPOLdp3mSsXF5zBW",
-	"kbAtQNalx3j+lrN4A2j2m9GnA/bdZnf1WcNuqv6yP/DpL6nmgtkLmvTslRM9BFJFflgjy42+y/8mvU/I",
-	"S9GuC2MVpdkt3w28x77MbTvsBvXmBLquXjQ5HovKl41JhR+j1Lfo6F0SWJfuZ80sob2lqz/DvG5NSR2q",
-	"WRzvVLN4UiJfT/XCCk1f4xTPzmPb02XwygreH3qCx1eS6fxJfAadmS1bThQquQQ2cqRgZ1zIEVVO5NBD",
-	"BSu00XWIYEWlbu8UYTVX1I4NFVbW39o0FimjnsRBgiMO1Z75Gh8ZqSyyzaqjyvqM4iwSJInsTNgqVTaj",
-	"cITO7IzUSmjSmaQdabM7Ez3XXWOqaae3BDJ3UvEd71nOBNstgMvzWaM0U75FsyyKlj+Kwqhx1cWo6nDt",
-	"7+rayLZ0keZg+o4tJK/Y+7irYBBP4Lyrgz10+rsWW3qjw+uW6Hq8W16+71Ouznnqfc7VuAyqSRgfP13b",
-	"0iLW2vp3DJcnoUr03PpzU/Fg7UFW7K84KAvNcJ1BlOkZ/yTqwmq28hZNQU3dppQEoSdoxaI2TDXQyStc",
-	"WoFONLI9hcBKK7pjXUDlg3cbUJ+EBlBNKLJiKNXW9t4ylFyNmIbGMpwiIoxV1BL5G+QrZ6aZjuU2Vuby",
-	"flKVpPcTEKic1O4UoyRdGyWojVLueBe437e01DAJvWUkFxsrshc/ai62JRQNZX87gcGTkIJa2Z+52qjR",
-	"2qGDJlITzIMEQ9cvdO5AQabqglXG9X3wq2CR9d7q8JvmWddmFMzFaMZ4fJhfQ9J08q3CRNzJj1U+8dXE",
-	"zVNCdX6m9vvVVLPrnYOfbBDGlcTarjiaMt/oHjGlImtMXFVjaI0e5ShgdEZ43AyvK5iTVPKIAmALLNA9",
-	"TovvRHfEjjK7ubo0eZyRBMsUpzq/vworSxckQYLj4NYVffNaD6a8kSyHy1ZkMt1ZPql7kcu6EWVm00wT",
-	"hEa00XOyP7FND8ea9WJldwFuIeLoULDDJJy1OP8EASQiRe/G7y+RobSPUkyJIH8omc7PbylV9xR8PH9r",
-	"rrpYAA4jSFP0esFZDCaVnGGRA3njOxFHY/YxnG0JgUX7TxZ9kq5FCKNFyl0Cz/d+2qAo0BgCKT+1vAgY",
-	"zTCJXLCXkNOwNLAridID/MV6GRi5mwfs6suMTH8azi5p3L7Sscue8SuOwY7FrWzTLnOGdf9A/9hcv/Ga",
-	"CVcccC240LrfwB3sZQOCBQLEYSo44NjbbcZF512aLrBVZnYlSHjn3FyqI6ucvC0ydwE40tdGtGf0XADS",
-	"RS0PYeUvDPzO5cTyThV+vYDg1nvkJDVdGlOGeLLbPrep1KfuWg8ekdR83LJyw4Z3+umLTVv9TSgwH5XT",
-	"Uz+W9KzWrd7L8emLXDj5/QyfVu4yqN0W4NeuTHBcXlC7M6F+U0HtUoT6JQNf5DKS8+hmKmcfL5B+W1wB",
-	"INmgUjANCZockoqAZus2gIITvLazSDZkaDEJg9z1rVxHTQMojotcDVxZfg9NDQg8d9Yda0toUzVXlYsy",
-	"cLOpWiVgslrNeOI4o5FzNQUVS9Cqb1Z7vaKNZgQ0TBihwqpoYN082jKdsJZjkyIO37RQpoR9+PLwfwEA",
-	"AP//74a1Z1qoAAA=",
+	"H4sIAAAAAAAC/+y963IbOZY/+CoI/meipN0UJdnlucixEStbdpW67bLXkrt7tlmrBjNBEq1MgA0gJbEq",
+	"/GWfZ7/sK+yj7JP8A+cAmcgkkkxS1KWma2JmymJm4npwcK6/8+sglcVcCiaMHpz8OphTRQtmmIK/TsuM",
+	"m9PUcCnsnxnTqeJz/HPwSeQLwoRRnGlyy82MmBnXhOLryYDbl/5RMrUYJANBCzY4GVQPdTpjBbWNMlEW",
+	"g5O/DlLFqGGDZFDOM/yHNtSU+iqdUTG1f2csZ4YNfk4GZjG3rWmjuJgOvn1LcKTvRLZmmIqlUmUsI9QQ",
+	"qciYTaRiOG7DC9YxaiayxpAnUhXUDE4GdqAH7sOOMX3gBTfLo/pI73hRFkSUxZgpIifVEI0kiplSideE",
+	"3TCFY1+Q2xkTRBbcGJZ1DDOHrsKBFlzYXgYnx9X4uDBsylQ9wAtDldls2ejEMLV21TQ0vMW6vaWGTaVa",
+	"nEd20z8j52e+2zk1s7pXbldHsX+UXLFscGJUycIhRFbhrSzmVMR7w0c77Oy9VCk7Q0Je6u4LK+SNJUfm",
+	"VpxMlCzgby5uJE9hKyZMMZFyMSXcEC60YTSzBKTYpNT2ZyMJHhXCjR93a28mdhiNvcnYhJa5GZxMaK5Z",
+	"tS1jKXNGBYz9HMfw7m5ORXyxCnqgmWUhhmVEsZzaR0DSuaQZMglG05mfzglJ3X4mJMW1TuzUGb9hKiHc",
+	"sEInI2HoVCeEGkPTWcGE0UNymudBB1Qx6IFljXPymlBBWDE3C3JD8xLf0URIwYa2VTVl5ooWshSGcA0j",
+	"KA0LVx0GQLSEpda+XVKKnGmNj6FzBmvCsuFIDJIBu6PFPIethwbs+LtYC3w4iFBNcCDcwsco1D3aIYX2",
+	"Zlg4+4pd9WZKFaW9OkoGBTY7ODk+OkrW8asPMqV55Ny8efuZfP/vJIfHZI8Np0PCxMHXi4Rk7ODsXUL+",
+	"Tg/+8Hl/SP5sqWPKb5hI6iNFc7vBIs3LjBEkhytkVYZlI0FFRhq0Uj9MSPVP+y+ScT3P6YJwQcyMGjei",
+	"NlHAmLqWC6e4mh4+MrsHXzVTMZKwv5PzM7tFloYLeDlOHaVm6qofiQTd/yTf0nQW5V/uCEHHVNB8YXiq",
+	"QyalmboBHjVjRX3O7K9MfaeJnkllDnJ+wzKS2k6GIwGdWXaiy9zgccuUnM/dYbeXJMmooQQFBTyvcDnZ",
+	"E2uvMcGYZQ1AqhPF9GwkJnxaKqZxmzI2ZyIjUsBg0lIpJgxcbbh1sY0S8goGuCkT/TSZaBY5Xz8tnyt9",
+	"zecdvUtsJdp3eI6Ooufok5pSwX8B5hmjoPD5DjnLF8fYY136Zzvs7pJOYz1d0unOOvlm39ZzKTQDefkN",
+	"zb6wf5RMwwanUhgm4J90Ps95Cgt6+HeN0nTd7r8oNhmcDP7HYS2LH+JTffhOKem6ajE9as8EdgZ3xFfN",
+	"dtYrtNbZ9bnnnNrwPK9EklByeV2LIHjtg8SBRxCEHG6q418MgKmY97IU2c6m0Dn6L0zL0g5GSEMm0Cf2",
+	"/1FmfMJZhGZ+koYU7umQXJRpyrSelDmpdp+kVKkFoeSW0WvyzhLZjNGMqdeE+m0itzOpGTmfHPwkBTv4",
+	"SE06G4mZzDPdZDx0SqbMaGRiKL/4jkJear8pBXK9jIxlthgJO5WvgpZmJhX/hT3CcjZ6s4/dF6A/Ztlp",
+	"JbUFJ2Ou5Jwpw/HUXLPF8pL/kS3sHCmZ8JyRuWI3XJY6X5By7iS9G07JIZ3zQ/zFKiapFBOuiuWHh+5J",
+	"VN+oD/xfYSy1ginHf2cpHK/TLDs3rOicg5dj7W3arbS4TeOGFSiockMyPpkwpZdE/Uo0JnuOtcOlEHtj",
+	"f7DM5pMBklMaWdu37smG4/FfdY/HvbG/vMwtqlkSY+0Iwp9iDXCdgviFT1aT65l7+dK+G34MikDXANxL",
+	"9szOmUqZ1TwY2Ts6OD462gfNVxCvL4h66fy8EydJWAFHCtIccBKov7Ic54HuizK1HeY/SioMN4vGhX7c",
+	"PnL/h3vrNSnogowZEWxKDb9hIIRaPVDAcaDZ30tt7NkjORdMD8mRlYmu2dygYAR7XgpurubKbiB3wvBR",
+	"v9HaLyPyp+DGUlbBqC4V80Tmp4byeUJmslQJuZ4mZJ5qSzEFvfvAxNTMBicvjiL7X4+zLe5E+of3Nl2f",
+	"PrNu8Yuw6xV8Q3cyDpD24vQI54tmmVVViFQZSvH+/VXU3+JW30AiPMcva9WKKkUXSzPCDqJz8QL9m8UP",
+	"SpbzCBfsZDlvqA44CM1zd45QnldsLpVhGeHRk89EdgU2wZ42pGCV+i2XnxhMy67T4FvVqFulZDBnisss",
+	"ohIlaOracIilcOzbX9ObjvDbqi2q31veJJlLtbxDP7I7Ao/Inj0lfnBMR7k5z2ICcTJwV8EVML74Kyhr",
+	"R1ZxTnnmVOzmMnYyICMNzTf7pBSbdrNynS/KoqBq8YyPwuTuqhS6nGOz9cZ0iPTuXoOVRckd9VEuCK1H",
+	"bR/I0hB2h+InUdRYzRtaZxk5PjkeJNuRh7xhKivZZrvqP1rR7ubUBV+MIzt4Rp2Jw75BxmV6zcA8OOG5",
+	"YYqBJWHPT9Vujr1s5nRRMIFcInqkoLtVE6j4T0uh5QUj+JDs/XuWkOMiIcdxIWwbRvUoh6z6pnMBoscQ",
+	"PCxy+k6Y2BmkledqB06mxDYn1ZUux8t7cFHCmCqtTDNltT1S0AwppWp/qVUcUnZFTf8tsTI6TDDLuB0B",
+	"zT8HE0ezRUvkd1rihDOrbhYU7GdGkl9HAyvpjwYnROZZQkYDI+0fgt1+G46Efxpav6UgOGhiTaP4Qes5",
+	"riJaz5Z2jYEcGNWUarOlV2u9biEVMKIoV3ENer3Ab7b7dFCzHWjh5y2us/jztuSUDZpjCafaaCupHaE1",
+	"UbltbVDEz11Ef6koz784o8Ay5Vt7aH/xp3GKYpIPnXJB/Vla1dTn+s0lSdkOqdFWbHJvFKPXmbwVcdll",
+	"I47ScdUFllZ36cWvqlVWylpAss7BhNCxZsKAeuEbre5IKdgg6S8HxfjcmzKbMnPf5XADXreH3loRfnPV",
+	"dUC24V6haNT7JOIF10v3x9X6DB8Mvnlev8kYYwc7XIrmcBK/D8HUfu7cxQ9cmx0dXGxw+cR2k9DnSobw",
+	"TLKQwszyxQBsD8owBf9eMKrycBb1BmFDF3Bt3pMix9BUN22tJT7/QqdKsZLUrNB4Na6OVttZU20yE1lz",
+	"QquI232jfVTFRl9tQ92KFZQL286ypgGveotVwUWpiZ4zYcheZRFBf7nlabgS+/1MP9BMRA6qzF/uFvdM",
+	"kjd9azjfxP+MXVfqRU87TAeNI2nu9Ihhk/0O2tuAzW6oeKcyc+7rBG5KY5iyb/xf/+OvRwf/eXrwnh5M",
+	"fv713779y87kSDSiXfUzFQt2W99usHNc1zuMnlQpvjOWxlI+Wdibj+x5qRAITUhDNBJZ52AMvbtSNBYf",
+	"c0nvQNEke6F9dn/3Y+txAFaasyuD/xqTNl8b4dQtNnR8BY8jXW1+CSZVeMCyg/hWMIVazvnZ8perjsUO",
+	"r79QUGmLrLmPYlleQFl54GNGgm0kXacj99Va3+ZSMGdwCezCrSWeo2IHvFnxjGmQLoGJ2u8rzWiQtNew",
+	"ZJtaS51mwkS2IYX4L+G62/BbXYkQqz3R0EPAgTEUpwfLAmfzwdi6qKtls7wBA4R+/OPZ/pC8leKGKYOx",
+	"XKSsXAcadNsJv7MRQN6RUzvFuAoseKZxtb3/C/CoBDVYF+uCzTs7Xysc6Mc/nsWWx3CT99UPXJxiRBzL",
+	"MsW07o5s9C/s6EJhBeV5rDdhaGoIPg74q/+hH2cMozF7M0b3URdfFNKwyPqcVpYVgm9EPp3PpGDdk8XH",
+	"sZ2ld1Guaq83njFh+MT56F203VPz82Rwy8aamxXL618I9rZUvOfVgG1Uqn+nd53Hduqyslbrec4NGS+c",
+	"U7aKLM0tx9CGTLjSpq8LrWmIiNwwLl61Wzl5Nr6w+7hk+ticc6qtTRlWWpurW8au3T9BuXT/tiplVO7z",
+	"8b799raODn6YbX0UC/mKU7BL+Qhb/M2JRxiq8xUCd7oDbjCoqVKZW9HK5x/fEfvIK6ITHu5D4B3jOYtf",
+	"HJ8Ut1PISfVK5PNo6NLFS4KzIdds4aKjfVT5XDHNp/bPr18+ECayueTCxJrW/JfIqN7znBH7yAoy44Vp",
+	"Bi1wYf7t+0GyzlBtRx1MPWkupuv65/jW2IPKpfis2A1nt11+T3NVbXlMODOVWR9PtzcDhIyxnxpmF3WF",
+	"LGjdIlIHboSg9XsGDVgGsrwekbMW12XfhZ7TOthq3jVgH2u1xRoZuWKFLp276ju91HScz3XnIHTvZZUx",
+	"FDjCNo20ae50c1Zukf0WJi0q9CPvRdLdHGdzKiN75xefyPcvjv8dbDv7Dbn/3dcvay3PK+3Jb0FARwtV",
+	"56i3chFsIdS0YwrHDdujDxm0bkLTQXH7OzWMtheyYb13i9K9qN60sOL66WHLu6fJbe/lQc6MPThNKtrW",
+	"3vUsjG7Rk9+RBXT0UHa3rWxoLYqCl1ZQEApf3eey1sy7tej1evI9ld5unXaF1rpKO1yr/W2whOtMZO4B",
+	"RL6DcQwsFtbiQoWnyyH5SUKwDq14EdBonpY5rXL93Ms+oU/Y5CMhpLGxo5oZknHFUpMvhkvGtvUcc2s1",
+	"8bx1mbwm7rBWcde+8+80abOVhLBcM/L14qxHLPYjh1q7efn3CCQlsMwJCbositBqpjeJxm4t2f0Dsjc3",
+	"prK7OUvBMlPE4w8uQUwKh8s18V/ZrZ3RG5Z0T0mX6YxQHYTpzRUXkKroMtgymZYQ1WyTb6gmTGBYmqV1",
+	"lwNpX3Nr15H2WiUCjBcjUWCaPA29eKmlO13QPLeHsBTcJO1ZYX6QMyTCuTKQIYTq7UikVEF+OSW3VAm7",
+	"SxBxOJZmhhm7GkOIemzU09iwub7KFJ2YWPJfiyXDIjQWiNqJw+cJydnEEIgWmQSJk3bF/Ns510aTUhhu",
+	"VVJBc4gbTiJu6830GMdrm+HibR1GBhmBcQti8ALkLs6oas6Wi4Tczng6qyPkilIDi6WCSLBASuXSVYmc",
+	"DMlZi905oXHOlEbPSNBn1CIsnQp/ZQ0+1ppwlXNxvf6aSgY+WLNgZiajTjqFGQQpsrBwovbEQWAo0DI6",
+	"G0aD04LdkR9kno0G+69dUlfldvT4Cs0sCBCGOs1lnTfK1n6V6RXPdFfCJOwC1Vqm3NKxw/tggReqorbl",
+	"IbXJabW0GTD79rqmM6qmlpWYXreh7yeBk3QU+NK4ua8gWvlnOpRheLxOAsK3VohAv+ec/Z5z9nvO2e85",
+	"Z5vknCHrCG/kThbSZfquP92JMuzjZvtowy3TmtRWwnXPE1hcPaeCaHbDFM2rRWzem7G9HFNxfeUu7JhT",
+	"TlxX13nGDOU5Rlw4UUC7m/z8zelPbcp59WpLV3hCoE0bosDF9H93tsFhKos+PXB91RCB1sqgf54xM3N2",
+	"WC9GwPkTHbJUIFTGKcVvbKeloY+P3GPKgExBDckZ1Ya8IhmfcqPdGv2vx+TVq1cHR8dHR821eXW0oYtd",
+	"KvKn00ui2JRro1p+9jXi12Zkf0mn9zMgbh0MGN8tK8l1bhQFg/nqpAxDAV1KMG0c/BedklIAzIEsOAbA",
+	"U2Lk/CBnNyy3z9e7ozpX8Yzq2VhSlS0v33hx1TeefSlt1fKCxVVax8xs+jVTSirdnYDz6xpJZHDBUoeM",
+	"Ze1SE8pz1PytjpJYryHLrK9b42uwZ61gRRs1kAMOwn4sxcblw8UuSlBNKqvtnGrjApqykpEMhGWZZ3aH",
+	"/Q+bedidALw6g7Y7msCSmcakShDax95hr0lqZ7U2xU2xNBqabB1dhdSgbDFh8kVll/CLkViD9sYRBSvm",
+	"q+vk0F4k5pNJ2wfErVv0iIQy5zI3kbekKYUSxbIyZTowAA2SKhnACaDgHL5jWTT+H4FAlg4k8z+33Jz2",
+	"Z1IwremU9QsHenc3l8qcORvWumCge0eKIh/YqLXuuAp259JstzBA6RVZuV7d5iqwUVvmW2Hv6B3QayMS",
+	"p1dj/v6PUj+8c+W8q8uT+xM+8HcLLp0DVIsqlwCj13dkl3QajdcPz1VrhD93EuMf5Dh2g1thbdPN3ipM",
+	"35uvSpXHvM9hSIlbzV/4nIxLkeWWnXtopr/LMZlRTaqRxzrrOMh/ni0a2wR31ibIALVZqtkwu5tzzJl2",
+	"o8RhI/hPNVIYO9cusTQLAgrc65eXHxqMDDTiQTJQpRD4r3DW1fBd73FQ2aUcMDeHtSma72nKzLtKq27T",
+	"Tc+0RGtuw401DtAO00n7RPx070N32uHSdKvooFKsmOefvHVj22nW2MFoKOk1vcqmUgdURJ1drXn5HlbM",
+	"iefszB24r18+rIjF63kq/XtwPPeQ4MBdfgz2iP21MbOeSrVjGi2JzsaR2efO9o48pCc2xENHv/W78X9k",
+	"NDezrpTCjBpqA0V6yxyfrS0MnqGsjKcWvGzwwcpcBM9B5PXA88K1vMF9HaOmyV3sZDj4ySzGZlFFrwyf",
+	"aRWfBJr6DeU5bRiJAh0d4mx1hU53NWEmnS338QFkfl6wFnwHuWWKEfgo9AbOlbzhCD60Re5sMNnY+nQs",
+	"fCnqmf4cBk3B00gwvD1gyytdN9K50BcvgcQdrBzCAFcjjiJ4hrNrjLI1uTiRJDU9A3VUg4+tzo+myC/l",
+	"52zSaUZYcYJLMy9NdX6Thr9kygSze54N59kktqIzU0SY2o+XHz8QFyxqm0HihH9+PnsfayenItMpjSkn",
+	"H/wjIhVnwgD/ag4TrFhRUi+omnJxNZbGyCJizYPfCb5F4H/TGdPN1o+G3/ezObvOrI82Mg3rud1tR4pP",
+	"Z7F4F/vzjrsych6LUJjvqps5nTN1NWPxGX22Twk+7erq+HiTnm55ZmZdHcHDrn7+Y/hqC1s8nJPY0T0v",
+	"rJz8FvI6IlcAyo8dkvI1n89ZH8QR30z9TfdQvgB+8jp1eqXmGE6prTlv8mGo8G7yXUM/3eRDrzn2/yYe",
+	"PspBza7nHQ7J9RLMLroXNbDujkwokQSftRJ3WESgxuyNW2ojU4BWVoUaxwKefCTw6tg7wNUP/bvefJUQ",
+	"xWh2YF2I+xaCt8DXFL1t5FZ6sP6C3zHtpSgoUAJWU3ipCpK7sm/BnW9UyYb9+Ey0jcikVenAFbQsWFAq",
+	"oAmkJp1DhsajvV6TUrMm+ryzsWsupjk7CNIDMNLdrpKt3eFhoJavzjaIfST5seoI3+iKRasykh3AMcs8",
+	"4j2xQ6hTX6poEXxMAM2cVLVmMDyjCoG69QFquGgFvws2ctiIoT8evnj5ffLq38j//3//P7Gj4ebKxdWt",
+	"VJnunKqes9za4G33PmLnk2Dkx1JkimXk8pYJsyCXM8UYOZN5ThXa4L5/dXh8dDQa7LenPF6QKavzXGAF",
+	"XI2Bq9aotp/+BkOMrk5dUWNlBqyVIbWrv+GtEdHInx52xxoPOmqMvT9G0WZoCj29QIHJtxnQu1GG8n3B",
+	"kirvrjN1dETYBJ5DGwC8RWSM571PGRzzW40SbgueED1Q+dL6m2Ywcu2q1FEOfcOUnWYQTKW/I+E35Bak",
+	"auRE6DloXiOezdGbKeZ3HA2PX/wHRif+o6S5v18Nqz2OyJEwtlMKlpCjOOSZT8NaXrqO66leSr6uyE03",
+	"SF8Y+ttSBzHAgqSLNGeEiWyzvfAduFEum7zs9ScMpzmZlQUVB3aW1irgIxtc6MhPfzp4cfTi+4Ojo6Pj",
+	"/aQ273pARS7FkFQ+H++edEXKsCmIkaaacGGUtJ68zF05bo/Pz5o3RKPP7vVfFw29ajnhzQ0XtBE23RWi",
+	"EgSSUzLPacpsrQSmMGZ6SM7sf1zxp67w6cSSv+ObyepY6uEOgql9paaO5PINw6ibawDHzspivjCcC5VO",
+	"IRqKEW4SjLDjxhGPxIfOxsfNhkHSHRZhPyQwm3398qGH/RrRYOPbLZaCfAuqri2jxzDq16QOfLA9YiUu",
+	"IQ087U1yDx3RvQQlFcR0d8Zwb+JebcV9ryqfs7zJUCqNZVdNPNOOyOUZ1E0CmrOkABKfi8IKV4VaIsu4",
+	"sbNlLsJRd/duSb6PoFBlfeE3Xl7YPqo9HtKOwX0VBLsPfNvklEN4l3O/x6Pcg/s24ri5ODsQlnaB+7hU",
+	"nl5a8nfNq7ypGl9GlGdgH3MFdWNuPHeNtNRTBe6ohrY8xSXFtalPNnPEd6RMOv2pVdyvrTa+/P4oOToi",
+	"/9KFz3PP7ATHJF6gfPTi6F9tyOF+UhfSdEHBSDyvRyJEgPHpWmGLIrOPbC5tVe/HMvoh+dTmO9dC3oqu",
+	"tKkHz2/YMTDRVw+k7YWhpuK51FBnsMm5SBUrmHD41HiB4lBfE81EZu+VMU2vfWbdTR2dQoV7EytrGpYa",
+	"6/nwgF+4IbpbuAo4oUtz01EMgXFupesgs8b6bzCLmmd267WR8wbRAWsYM5DFcIHq9EA43yPIYqUZ6Dbl",
+	"3E6glZ8YsVtgU1UG7UhEknuC07IWEdNr/tBfzTF72w/xQ9LglZ0oAj2zBTtM75uAri0bNNaC1OwkGih0",
+	"+fXCTatHuE6D2kL50i+v4mEARoKGes2qlJ/KgNQFxrNLyJstYZ/X7/IOAZp6mMRWDAmCb/RmYGlvq2eN",
+	"4KIq6B4REqF50Jxc5Eev2YRBT+viLGNmsycZVGVY7cxY4xisU2qWYPwwWFc2ChEOwqTWRV3GxfrHXxgU",
+	"t2PLcuGePOSixFFw0ONWjSzp45X7ufv8rHGLrvLQuvDB6DMlbze3F+BQZBQ/6j7u4CDSEca1fjnkbW/H",
+	"pA8JVfJ2VTzoirvF6iqtKPyEODWA3XENYB61vulmBR1mJVay7KjekPNY2tEHXmcbuUvJtuXUEXstIYQB",
+	"lu50kpVtisTLGS1HL8W2oGcUGQw5WRVMFlqXtvS+VhmR/0uQg5kEbtcwJ7UnFPaWecht8xYgPNJUSa2D",
+	"6mOtvJeqCWBBGyQm39v10pXMXC8jamm40BtkNved3++Jzvf00kzurgoqSpqv8tY3DQchesp4QWZUZK+b",
+	"bhYENHPjLdBF5cDolo3J/su4rwjKiu3913/9138dfPx4cHa2D42+/0sVgUn+UUqwCIUDsJaEiobsH8cn",
+	"x0HUKPqAcd41/sH+5i6nJmBh1XXdU+9NsBm9bNUeRBYYMGTRtoEDGLOUlpoRIRsrlMoyty4TohjoGl3b",
+	"EJTEW0kNbSokXFtNvAJks4trUyqEbAbTYhH6lhFqJALYnVLg0tlt22vM+Hgfmi2FYjkHN1LMXuYU97nU",
+	"mo9zNhKV4aBR7s+PWzMDt6lmEK45p1pfmZmS5XTWKNMVLFNUG7SLsYUW+Zk2YD87WphLzeM87IzreU4X",
+	"WAYVbCQtZ+4e1Slyh/gF0EQ5iCEbbGH77LRV1FtrPBkEHN855fv31j8I4LLVlz2i3nCCXqW9rpCAHSIr",
+	"LAHcaGYGPeAV4pAK/ZZqp+q+JfN+iv4KgdaE5jzwzfq8PVVDY26atxd3LcZqra2QIHdvw9gQZFqwO6Bq",
+	"HdMr3sLvlT/AvkvmdMpeo3tzrphGXkKwBVLIzPFrwCmzmg6qDzGae1R862Vs8HbdyKJSROitJwn7E4Rb",
+	"+FCAgpp05sNdsMqoJnv2YFkwEfTY2RXaT0bC3nt2bbht51YEoYSwegWjgovppMyrG27hIjrqsMS+oGs4",
+	"uTUs0c1xuwn5q29bU++KM/5Byutyvs0Jr0bv52NlWuyR5NAqyATsjlrQSId6er/TtOEBb3hPo2nhNUQm",
+	"QecwBMFbosE/w/oyXrlFHy+mP2bcXAlpMJdOKUQqiCaMN32yga7s/PdY+HVQgxasbaQrqr4T9aAOgXav",
+	"hKUfe5iSYYArWm1gKvRrshTrGi3Fxs22qGT9Ai8RTsOnvQyrzwUvaN7MPPdhrRlmVniawmOllxBTedYF",
+	"MbdBVZduJJPOPNbopKMY7r1NK+d14LnnuJu5ofoUMWoWDyK3XsGxhYzaPL4ynnXCyO93GzrMOkbusfub",
+	"SvoW3rd18LN2vp3whhvB6Td1t+0h9NeqrFWs5LK6KsX9tNV+GsdDwe77vWjuWqzkaRcdtWfgtjB2Hj8y",
+	"Na3AwnRn0mOmFleq7AF45U40rEBh264iVKviXFQsrC45fd2ApnVVeGzaCDXh57BhmYxulJalAvU3huRx",
+	"BlJd5ZiwtIhNcuHI0qkFe2bGNAvevLUYumPmoQ/2V0NtFhxK22iA8OuISFoN8uR7tkO8ZmxO9hqimx9O",
+	"IW8CZAL/0f76W6keRGPJ+tBD3FXTIIf48RQSNtlH6YhpE47YVSagZO6ugNj2+hW4cppmz0yrJohDtc1+",
+	"waKXHlBGtj6hqyYS/CLa2DbBhrgvKz2S2GObfPsKut3AMTGJ/SMWKb5UrhRv38Ic96q0tZEaHI7ws+Tx",
+	"7BbDC/ZLFA/v0j1BVmPbsvGJeS5v+5ktlrtfWqWwcNiSR1cZf/pB24YRWKyKNC81v2H7G4fKryg2Bo3H",
+	"HEU5ExlVvnNnB9/vjifepLJJs6zXivlj702ts9q35KEKgnkhvTtYFB4Dz1qlwmyiBn9qYUdG/d+bAVmt",
+	"i2jfSMbvgUKaDDwY+FVnCOEFM8QF00eRw3HjuYbNDiDKuWrWN5lKy8HrbILYaJTM1zrPGris9v2dVajv",
+	"VHPCLj8yn8Ny//2OpDPE4iG2XBPdxjjvmHt7FPWnrvN1S/JFxmR7RKQvqKBTpl2uhasNAkulA9xAn4mx",
+	"9MC+bgUKptD6phlzXqBw1N/VnwzJuzC3w74P9xZypwJdNRVUih3iIBngy4NkgF1FDSifG6bNtnMGROGC",
+	"GWovPZ9pMsYrM+faVIKxHpJTAoZdO6Qjq29C7ADGj2oXWKvkbTISGgUDa8dD7Eb3GEUx5zm7ApMt14j1",
+	"gfNrUqZ/qTtZqDb4VhqPsx+SvaaV2J5v/CawQNvem0XJQ0yd7SoldpRKC0Q3O+aoGdSFQNilt1OIx7fg",
+	"DYPPVxqmLPHKCcZP4r7tHXsMbvi7loQVQ7FI3mqb2ef1WvezkIL1kO5xvarF8SvRHHFSb2rscLqkyY+Q",
+	"qtPPV1HF9v+1zssZJIjqbBQVeoLnYoM45l72VjfUS0XTay6mH2UWpVNqSMZSqDzgCTAwwHJMLzrxbnqr",
+	"AvprZp8U9NqFgjggK82MS+ocCaijAc9AYgc+oUozs9UzFL/xtOW+xBKUHtAa1La/Y9kZxF4m3x8dYVrc",
+	"SFQXny1H4z9yCQy6yYdw2HG242HQVkKp9SzmuSsMMo+51AdP0Xqh8O2taht/CZSvnUKeb5hxtkPw8w17",
+	"fqgy4hsOY5usud8CwDoAiFzZpzEMB3ujCCxDAK8c0pxTbaPq5nIe5pc5bd4PWu9vkPOxEcr7htu2HZD7",
+	"hp08Zi31VUziDE5eDMZuupky+Wxqj0NEeJV1uvO65QB4uF3r3SXPN7YtwBcrRvmYRdRXVYXaoq75/Kob",
+	"nPqDq7/u37AyiXU/VJhEYXarjz/GHLRX+5uixrRyx2LGtcewuVQujP6tp70bT13bfUC4PMvYYSTPKizv",
+	"51wv/guDoEDwenS6jJwbq9szE/g4XOSWswFmTCM+tUIM1N5lzuKOtLij44KZZSNN52S2M6m0xtNpGrng",
+	"Bc+pcidPP3ScWF9NywK6P3IVlV3ZQHcY3tCzUAu+ZOM0alGoX22Wp5eGLul0h1wtWgfgeTM0yNjRX5jH",
+	"gIja5x34Ati7el537hPEE+pO9F0NluXhiFQ9PEDX36BEa2fydQNWYpOZNb/smmAQbAlxIc043HgExbaz",
+	"bTP/eurtfUiaW9kxmfjqxPjk1zr63wcRfZY5TxcddqkZnc+ZwOi/qvCAyOpLMECrBDCCSAaElu0kiJFQ",
+	"1LATZ2BqWbRsHKFLbyoCy9PrRlYC0UYqpptVTwyhNr8FW0/IJKfTKSbEBEkPTeMUjgCYf9141Fb1FRjf",
+	"syj9v2VxfR93n+aMKt1AfHrCkv+vyVFjRL/d+v/Lhw1J5nFr/T+ncv4dK7Jx6X4QOn7Dpft/L7EfScsc",
+	"Eut855BwdmT/n2I2agza8S8OH6oO/9MUhP+95Ph/55LjpfDBg3zi9aqd4Wz1B7NqVU7jFXAf80BVHFNl",
+	"AOtqD/iki22Upa58+Q4srv6k6Q38/ug/lxP1Z0EApeYWuwzWwR1y15QNmmUeZ87DZNVVbIY9bVzuKllV",
+	"Ap2WRl5VV8LVqnTJLoeNAPD3xN5CGLAZqF2wwo0k3lIDJAQ19vZ6/5fuVO+NQRGslKSYZka7xYyBG+yg",
+	"6Ppry9ORGSCprei1SjV+68OluQlWiOn26ggEkmj8yDWZ8hsmhj2AC/6b4RLs8AIM85fvn6b8sYkHACLY",
+	"14uzykwv5wj9nhB7wg4CoYtPEHsVUxiy/Yet2h6SqZGoRWxet32r8EjkPpHK5S0Dl7eucZZnGgPmUWUF",
+	"ogtOW+q8oehfb2hEvxdDf5hi6L9N33x9ke45XYZmGWjeUjDtQJ5Zxs0hspMH89X/RiqydxzdC0S86PYJ",
+	"WQlphTGmsnClYWGFBo7sj388i/oNnOLYeZB9nQj3QgiiTzJX+FgPydeIuLl8fVeMZLhqLB0mDzcQ+3SH",
+	"o9iWH/wkDZ/wFCkA3vFL1HcYGdcWMQUAwqumWui/BWsxlzWA/1dzBFDtyIctC3cu/P2lLcGJFLwwJsgG",
+	"cjTdMZfGGL9vqBCBBnG8CnjFD1exCb+LBrZN+F3LiucHRfYKekdevgB9h6bGxgC9Jr8uGFXfUDcABH5f",
+	"TqIS6+0LPSYEhQiwtYPYiudyKq96YokCHi8Wvyf2O6fhoPpjfydMZHPJhdnfzRkqLO+y7lt7v3bKVFU8",
+	"RJCtS9OUzQGWNw7hEx9doAiMfHqV02PInmWCR/h/+50wz6sUzmo23ZA4jan416rJ9Bg2WRp1PeZ7jHgV",
+	"XkxjzGUFHrNmC157w+HYyuXcuDAopwdTPRI5v2b5wsafSr3VzO+5Xd4uZFyI8VUhs7WaUCwseWUC2vnp",
+	"T6dVnhOEC3MNNTSmSpZzktGFJlz0PU6N1fh6+bbJCk41p4c/SjG9+qMU0zgu0DKC1bopd/u92s645r3/",
+	"c7cAAYaqTvFhK6NX/8rBOAbAY7iPI2rr4IcHikwwFDQ5wTzMi/2hFBlT3YcLI+C5aYcvDMnpyGrr1uT/",
+	"HVj8BRYOgPYIN5rlEytdWpq297DR4BliIqNWtAlxz9ZYouy18iDx67uqIj4k7yG+Y6KYnsFLaOuvS4Mn",
+	"UEvwh3eX5JDO+SEUdTv89Zotvh36xnuUcnmCkuEbAYOvSU/BDhqLHszJ9ZQ0NzR6OjVTXsHYkWYRDUoA",
+	"SNG6oBHk4QQw+A2+Gq1/v6U2EqDgysmyVtBCLXUQb/s7UEC27ji4X9KCkTM4OOSDeeikhFO3auCndIaF",
+	"lvpR1WQAwYDyfFHFfFYT5CC89JjdU2svZO8XpuSBbRXNf6HS8jC6SX895KeqSJpi4L5DagZMtAzwpFO7",
+	"SSJjimUEB/N4ekpUwe7Y89erOXWVcEmrLKV4tMdD6S5kDw62yxMt6FRwU2asQRDW9gj/07Mi+T31kg2G",
+	"tNmAdq92bLJ6PaufPx8t4WmF/R1k069XEP7ERCaVFepZvPLLP012Xy6tR/RqTHMahYyTcyaCF8g8LzWR",
+	"pdGGgs9skDxlQtPu8ww3z5EKM2x6hTS3iO+dMCpaw6MzXqy1JxFRvt4ffxuEoEZZWJc0SCzqtZXh3i91",
+	"jEk81gXEMlJwUWri88Z51q/9h8tFfJgcq8dIcAyXta8DuV72bT2oUTrtD664FI7eRjvsRw+dRP6lFAKc",
+	"9gGxu5dDGIk62qhPZ9US94mt3wKIsF+sR428FwG1ilUecNWpE4vCkeNCpNfgca8Vpd1WrlZQT68da9WA",
+	"TV+GM3SB5O6T73SjUsD+brIQlos9R1NEO4EYHWjrPZAmnVVx+RT3Lk5n22FpqbhZXNhLA0/aG0YVU6cl",
+	"om+N4a/3fkR/+PPlEpj8H/58SfAjYuQ1EzYYZMaEcXrtcCRG4tPYUEgSsC/jW+CNWchSkU+2s8NP52dv",
+	"a8xLyC1AxFio5SsQyMK+WRVi9RYAqk/I3xpPTvyARuXR0csUOoR/sr/Z0dh4NjuQotTmZCQOyBtGnAEN",
+	"fNlfLl68+reEfLl4+R/f2/+8On6RkHf44zv8USryzv5uv/6R3jBCbSQHz8jfdDn+G9nTJSzyPklzygvC",
+	"M7sgk4WPpy01U/bTnzAEGQ11GayUi6nBDzUM729K5kz/zXYK//zbCYGSl/AzZmyFs4dPdCrnDD/R6fxv",
+	"J7jKBH7WYNIEQQFCGGCtajKbGTMH9CP7xYvIvQ8tvRgetXaaTBCJzv7Hx93Vo3rrVI3Gj19V7jrUJ4eH",
+	"9tEwMFsc+nfB5gYjty14CeNEMZpZFs1oAwC5en6ruLETegvsKXHxEonDyAw/sS2dhCXxsNHgF/9OXZ/O",
+	"vdIoKEazk6BQG75R/5AMYETNjjoG1+jafRb03fVVMBr8KBxOx0f1K3CjX7N12wLvNDgKBUr59g0440R6",
+	"azdN4cpGEXPw5e6SpTPygY4HyaBsdDHlZlaOoXF1Z1g6O8jp+NBt0AHCa/nShy1++vkcTgC8E6KtJ8ES",
+	"JvXCINoWVCVHs4seVDyzuoA/Vh2S08/ngyDIdnA8PBoeefGYzvngZPByeDR8ia6TGRAo2GMqe+zheHFQ",
+	"RWae/DqYsmiqAxpqeEMEcCqzK7jq2vApmjUuwABGg6LfuT0RPzBz6rt/s3hbh4VW9Y/14OSvq5AGoA/f",
+	"BJypwckAaih7CLmTQdU5qhzNsiPHRZBd9e/2LfjleBEtcRZXZerRHv4k39J0xgbffk4GNWr4ya+DF0dH",
+	"gW/F/hOyGJAjHf5dY5BXPcJVKlOwZj/YdUeCbtGbfyfcEksP3x8dd7VfDfjwq6hYWoYXcFkUVC1wz+rd",
+	"rzqJ7P/A1yv/az2Ywc+2sQjdoeH8XmSHTWxOda7r34lu10TnFvZRaK7axN4kFwIJb0tzvo2Nia5Cp/id",
+	"6nZMdSrA/Xhwsgthr/vSnaHT+5CcDRBYorYh+TM3M6+IXKUznmeKiQRdRYZOv7M4nZCIT2iupX8zVFlt",
+	"MqJSC4civxxgYJsBAaUUrdRGIkXKRmLOlH0HBZcaskFXKPVVR+ib4wrsH1QxAOUENVliFMOqo3MJQA/P",
+	"99S0dlTmeXOV5YTA/uDalPMKc5yrcNU6htre4vigXXZQO/T7N3uoDZ0+ynl2ICK9jnLV7JqzDMcuAUNK",
+	"4iohhpVwqjO+2RVy4Xr/7ZyEPwMUNSQCgM9bN+sMeSOTZ2ghDlpdPjZrm/ZGwtv2jC9WZzV8fMcGO8Lv",
+	"kLpAxmV6zYx+7T1F2HaKy984o3ZkWIKyMSpbGPAWUgFlnmGtMaqwOCbMxW+l9YKxu5QxeAkpABlbdM0t",
+	"rtZ40bHo4ToEy9/6OZzRb+E29+S78uD7E7bjk+9+bZyFfkfe+IoNKw+8FFiH296HabMMgA8Jgno6P+GP",
+	"2l3G3uTmQ0CkYImlM6bNSADyYIJWP/fV0q0KgSwTMNkPycew6kIM/b8qoUpFFsDjUuWOJ/DDrNOSvnTa",
+	"huQ08FRyw4qRwOivqxVugnXXPdbIWMPkaoBotzSQmmg3o+PE4WvxA3f8IkxWeLEmW+FBT0ujTkjkpLjn",
+	"BMkSTsnR+lPyhmY+BndHB6tw4/AHzLhNW3WoxmU2ZUavPUzWBe7erU6PpeQlqrEgW29cow+4J9hFA9Er",
+	"sjP2uaVHP8sdLDQ0Oa4m6NfWT/lnrDccq3PmYPyp1VZKBeFk2qf4YoNO9ggMN821xSawqwEGlzBt3shs",
+	"sbN1DbuoyLMZyWJUyb4tbe3xjrc2tp34xHsPn+ik4QoR6vYsSgOt03VYu9+ih+wtRm1pVBMdLTjmXpGI",
+	"UwQrq25DIsI0YA6eZ6qv5GQ4Em445HYmdZ3rT4QkuRRTCOLm2t0T+prP5x7jaukawJZcssGaS+CdTVCG",
+	"UuotbrE8UAzUt5Lxns9rEfJ2v+OygGk17opeMVg/PzgT8gkd3WzI0a2uIEp2we3HjUb7UOGvPPuGxJcz",
+	"9Nc3d/oMfq/Yy8ptdlM6P/O7ZZ0ZgXqcDdosI9y5Htf394OTjj5x+NmW62g/+n79Rz9J816Wor3wuET9",
+	"Dn/otVt3uxIHAWljet2dVX+O4qYHTyCaUZXOohfv29AJuHL/LqARG1N8KxXqpGkLqCx2CN37g8hmbqDj",
+	"fACYzB4vfkLQzAc9xN7b1VeWCLZ1V+JEw3frCSrYyz5CRRjgvkaACPx7DydCtAEPH1mIqOYY2Un/7HkI",
+	"EhE3XWPrl9lJhJG3Aq/gdx2IkkPyHuJzAwgm69GujaGqDXmnGAbHJR5+B5CMXFGj4RJlYZfdnuM1B91/",
+	"eJ71YQvv7VCwx0G/qyOAcHzUy8N+8J/rPzgXXzWLXzXryCNZd7NUbH28wOt6Sbzbya49BoteeZhdCPqT",
+	"iAVWHlu/UfMyhlYEsTUAGAPyOOAGdPHvJpbs/fdr98w/jnbbi/k/Mr34ertPw/xxnfoz/zqUaxtR0n+9",
+	"gSQZBIZtLEgG2Zf/RHIkzrq3GFkt8M6kyGDLKmKqfusrQ7rNO7yBMPsuCbIK83hAAbIJf/zY8qObYYyD",
+	"4KNnIj0uBdyEW77EPjYRHbHldZKj8tVzNpYVu+K91l1i+N2DSYpud397guJKSlgvJrp5d0uJ99+vR2C/",
+	"qw7sk0uIa3aov3xYNRQVD3e0UQ8mHG7B2B+VTp6HZLgFYz8cK0avbTL/+miYWdXFdy42pm2pb1arbyWa",
+	"W1jXumr5fkL0POe2gOxIVMGYVDTikIfEF3+qfOa0jtykilURQAi0Mxp8FVjvgbNsNOjwTbhNe1PN/H73",
+	"yeqwHfCbBz1tHLpTl94LYkh8kb5BMqiq9A2S5rtVnb5YVMkj8NV6fVccnHppHu3obHHRvtrh6rxTSqrY",
+	"klyGlGJjm/KMuPoRtpnSsBU3RIPGlo9/EvXkZ1TPxpKq9YExYbl0Un1GBGOZJlIQQALhAgNo3BKeoDMS",
+	"R5tgcl1lWbIHfWnoGt5qQ9K4fBr7pJAaoW2EyRcj4cTpoGb9BUsR6AZCUxHxJJXCBebkC4utrfEdxMmZ",
+	"gKRqpJuBHgmfz2z7DLL2yd+Y3Tj9NyfNVrFp2Jc2PM9d6EqnU/SsWu8NY/+ChUQWWa3YP0sweb10kZNT",
+	"PSRQke1bMnjZ84R/lBncFbvysGbNkawOpGF3lrp6mGc0F9OckT9cfPqpgutpesWrO7cjIa3Kv0sAp84d",
+	"qUoj2wNVra5cYyPVCzqfczHVrjhD3S8VliUppo1ULp11JD5/unAgQbyws4qdgHcw3zNcmAejFNeLG26M",
+	"XPCNaka72HvXZIVt0tz8NzS9LudLOw9TjxtYLhAyikLQnpVxREbwI4+O5fbb9uRYVS2l/V2OcdPGpchy",
+	"0Kop+YXP3V5hQ0O7rJjGrmkRbDDVNeITvprUyEbjBWlv9X4zDnGY6psh+WyD51vNoMSJZf39OLGOh7R5",
+	"nybON91ljyu8TDgvdkw4f5DjFTRjR/y0RhzXFCp3MCbc5B7kVlly1or5GCHioMNYPXUqsgRSRgg3zZ1L",
+	"sK5LDCPSEWw1zKVrsV74dYFC9UgeLork6LEJ6smMC429XUU/UYTOLjr6gQmm0P7QRREYs2hbHZJPtoaA",
+	"pQ/7p80qgtBrARwHwAyxTM8S0VjMzTPX6NcvH9b6HEJkT0+Stss4GSE651o6ehR1qjXTVZ6Cs3CVp24j",
+	"7mOQfPnwas97qcY8y5ggB1ieN5MIWwkpZhDwB/u0A4IHEgspMSB6RNYNiB4vt+4r+gtzdUHrY1RdoT4v",
+	"zN/SXi7gopbmjKJCU1BFhlBjjCpbrJRZuatSP7D6k57xuYbDxNSNTRF4u07K81KcC+UcCUvXhOaK0WwR",
+	"RnEqVmrQb7RhNAMvE15vr8PkwnI6M5hUgNvPSMYMqlEjEQaDklMBweSAU1Lb+enY3j+wIrczaSWSTiHx",
+	"vGgIibu3KMbkw8ezJeL0vjBd5q7vFj4TPK/v1SeSMtww+sqzIXLcxq5mHpr47Bk1CJyK9XyVK3S87G8+",
+	"r7FVNnU3V+kO3NhLR7XKtN7D/dy+5B28UDVFCKyOdev1ObzyqtFaeM4xr2CD/M9Y/e30p7Ou0GeGPV9t",
+	"Nez3sAcNQJDzs46OwvJyKyWtVb04Q1B3J3UB1G37qKzGnZ2EqHrb9mJcvUi7bQU90MxSpmnBEw+OkxfJ",
+	"y45R+FKUW26YcbD4kSG8Jk1aqnuqR2YUvWF5Mrb0xbTuHuOGA/Q1r6qDIBjccYsqjh8L3OV5AM/vyvW5",
+	"admxVtfaisUrqElnjdHVli/0jnjTF/5F87xXEmy9xlU2oo+jjw2letjzXmhXZ+junt0BfCSmjRKs0hpU",
+	"voBqbwRWgWnC2/4TKVhnMmuj7utG+3vuQAkyRScmMNzeQuYwGGPZxBBZohjhNmR1njy0pTfOkm8BiVn1",
+	"Am6aJoQB141ia0PyphoWpnlyjRpuIMVZebRRmd4AN5cTNI83Gqy+I2Nmc2c0YuTH5ht+tg3vYTmgFGqw",
+	"BVhA5wop0cNw4b8xlzMhvoJxgvfQPiDNedDfFtMYCVdO0APHjxxMZlL3MhrY7sEkTQxn2gOi59QSrBTW",
+	"Ln9pf0cS8J47qRD73Pr/eObRKiDFTVopgWlXwF2xOaMGBnnN54Gx/6u4FnZP3BDDgjndKdt2mbpTthso",
+	"k2up/sIuOcxjVWf+hVh/tr2QI8Ff8ONWpviNo9uWz8uc/qMEr62WinQV7P1OE8HuoL6tlmpI3gmsdXbN",
+	"FpoZL+OBdlBvcwDhidbn7DWRMI6EuF1JKqEPVw32lE+FVKu2FEexGcP6Y3ukrj478AJXT8MauWq3s1sS",
+	"p5JoZ0BQGhqByHf3RiEzNlw51Kuqr8age1NBhMVVQJZNSdOXUdXM//sKTss+2IR9PUVgh3BvdAy74OKq",
+	"gnKNZdN1gvHucrCF7DVWerejsToc1QZAfrUQh3U/w1ad4Vqk4bpZJQWdoM24CC3rdeCWDCegNRv/huWc",
+	"bgjWW6nAiVkVNLZUqOjtSKwujt99eMKF7mBSjdkF3Kr9u/vHEs0mg7sD+83BDVWuBulfGxrcZ8uYNC43",
+	"3GeX0PKpb3jlu+6tn/vwR9fIu7s5Fb2CAT/IlOYP7Np0g+obBVzp2lu7OR/dmvAhlL8CO4Lf042z1Zox",
+	"6TkXLgepI/b4vELOfrjYY9fHE8Ue+xnGLEqeFTyH2OMawzxCA21r0uGEpn2AJizDgxtBO+sR+XquwYkg",
+	"LS9tgE98V6tEJwFmC3BZcGOi3oa8uNSsjj1ZAfVa2V0J1c7fYWR9vUrBKveow3Vz/nZdM2q8SDAwN2uq",
+	"GUwYDoI1Vt0y+HJnlIlb0fe4eA/PuFxHK0jP7eOOwYAmfoJ9SGmdNb/iMzU0Hgi/qNlZTCDrDCVvL/6E",
+	"XgTYwOCmBbe39wOkMi8LocEHPxIOQty2gZYZoCZ8BYryAGafFXdfO8ug3XRf+wiUGmQjSGxAP67XkQC8",
+	"hMqnALV/sASTJl44eduLcJW3GYCcYwc6HIl3ti87cK6dyR5joXwBhMCH0YyXqugbeDOKWCeeBSUj4fwF",
+	"VqOkgVdBThpBy9WZAfgzAgFYQ2L9YZrkVkSw55oK8oJ85G/sSxjfUEjF8IGtXGTH31QOa/wlmJKDTYTo",
+	"tm6PRF9rM+LYV1EavuxW6GpsSWBOMu1QSPVNIHHZv/qoBM084dU7D2FwqGnDwoe+IBsd5wOMlLztmABu",
+	"61XBtUZxbwODzcqw8aLMDZ9TZQ7tGh2AF6LBnZoFPGCNl0+2P7JGug0P6x+MuUAsvtWFmKDp5fpLj+w7",
+	"QhJc50L6zNQBnFl4jyh4UT+tI6m6z5xFwW9KT+6dS2kdTl2CwHsussDUifYnrlrV+yyDkL40aOUFzrm4",
+	"Dkkevzw/Sywfhch0KVI8BXRK7YsE89zCyvQ/8BuGdtl84Wu8uk6pwD6G5NT/5GyzI+F1WvdFh6nxdWv1",
+	"XLkjUVUYnMp6ynbgcH2MhCgLpnja6NW+PpZmFt5zwUDRyifI+Rmo3MWYT0tr99n7/ug/9+0MYLVSKkYC",
+	"mqvMhtUI/RywnBhjiQeIFeyWaYMWkxiX/QBb3JfLnjfGnhAsG/bTnw5eHL34/uDo6Oi4g1fhB5vZij5F",
+	"iSap7ku38R092ncHTxU74nVLWNxV2uVHTx2hevls4/Fd4tvDx+M3TyypJQt7ZHmldDWUXSmvERu4ZkcR",
+	"BuTIog/3Q5XkANFO12pDM3lLCgcDHdF6ECYSEG0RzRb5hYu5TxohKkDJVgwEIRiHQbiLRBEe1NKCV/rq",
+	"XxVTsa4T3qXQoGcHA1566zPoGnuLi/DwR6bR3Sq1Gt4gY78+O1GWnSmvJqAlyK5V9NI7YVcEkMoZqqNR",
+	"Kwp+UFtRNsuW8mJL1jOd1q/sMwDtWmmuWJcfW68uJMgGWLHxVa5J/T5LvI0RtGX2zrUHYQUG4jRRPWdQ",
+	"DBGBa72BnosrG1+yDvN8+e2dQp8/opl2FS8IsoWfr2X2/mGUa05F75zkup1YTvKu2M1D5SRvY/B9VGp8",
+	"9JzkR5TLwlqNhTtCVmpJMTEP44ZcRS14Ceq4RbOmNzNJQ840NYamM9D9eqEgQ/w8wa+cbVh0Un/g7DoN",
+	"+tnprbtzOqxH2teNFa7hUzCy0CfVGMxG7imcN9NBkES+aFr91mz3aZYtreEz5HmnWVaP72mdXME6xWoQ",
+	"VE8JzbIn83edZlmEurZkMoe/1n+cr5btv7BC3uA9W3/jrG5Ncb8UVgXVde4NvFT9BakGKpKNZ9vfKcUm",
+	"v3ZvYVeaV7geDwAbHIxAwYSfRgvBxb4vHZUZN/3APWZUTJkmBc1aXKupHyZNYx5B8wATxkKM6yoJfiQc",
+	"tFPOCw7BKnAt+6BQDPEzM1YMCfiZsIEZuIQgHOUgZzcsh4gYb8zAITrHmlGU5+iXy5pmBrtrEEtPbyjP",
+	"bWzaatvCqV2jS9vcw6pe0M8pRn71fR1SgXu//U70H8izwsKrt2CV9ABvkWrjAwfsb1CBIrSezSYnOs2l",
+	"YD1c2Y2QGX8FVNoXFKlJ5RzKxtmzDb7nJMxJSdx5r3E7vB9xUSebJb66nAs1Q3siaHs27hfaRPNV8MSh",
+	"CIyEtTsqSP/DjHWYm+UVrtSWYyE0sD4CFxkSz7kswBx33CGHUIvKnxxVN8kepLtX0dD2vaZPdB/qif8Z",
+	"cyQgxM3Pre4FGd0BltnHqDtahewtTtATXwpuyFx5u6cNpr5jGcm4Tut6O3WpeWoaVYTe/wVq00ONKvs7",
+	"NOnrVCEjHIk9X8oKAkP+XgI+Sk7HLGfZfjswURu60L2L+by183y+Wng4vEAgfeooKzuq7HfnCewOWX0S",
+	"21bvPHSqbMIP8QQdggbGblegasxsKIgn/oP6VMMpqc5WcKt850QecgtwTDN6wzyzaYfYjsQtU15Csd4V",
+	"7SMngN/gIMEe4bJKaGpKmrsPhrZOPzrgNNH0Ju4N+YwzfOu6fFu1+RzPZzU4N+onQ+9rjSMaM4GPEC3K",
+	"vf5bkSjc2OuIcqSoTU7QxLok+S8rhIpLl77bSPDCPHdKFJuWOVUoUmhJuKlKM8pbqiBvz1cGhDADOIfg",
+	"I62asmECyz6R925gD+J7elRrrF/i34yl3y99e9M3oSt0dHUS1WlmSaOO3+5tKjs3rHieRjI7sqc1j8Ha",
+	"xAgRxMdnYhLjuIEtQiLnQC8rqelwDPm9q2nKhyVVlKVb9gwHVRjEr4JCAzCL/tr27wLS6UhIkbIhjhDk",
+	"bTqfM5Gh8O+S1SaGYaR5qGTpITmfQIwvkDjXHh8jIQLUFWgsy+I3fpPm9fMlev30VL/O9eD27hkdAVDG",
+	"xmV+veVZALqDsxDzuV4wJ8xmXM9z6oLMXZB1S8Adwn8gx76wSiTkP2Pwu33gjC1VGkMQ7Jhi0BD0w7Td",
+	"dOwnjuUGj545RftRbkzVjyNQAN0o5hJrfyvihFvUJvlvTPaKpTRPy5yaFbLqR8rtFlBh6VRkc8nBjj+n",
+	"HMJlgZ/7uHfFJ4ZlaB3zRhbt4kmRnxdUWDUto4aCyYRl3OjhSHxx1wXT1YdtRTJu0NFVSlGzjnx7ECPR",
+	"xnV0I3cBwPYpDDF+0qqFcit7CR8/VwkaR1ePGvSviO8/vgKkpguIqX0S+q4WvCk5bBK1d6h5wXOqerlr",
+	"fKh4E1gFAspdM5hxzDUqZuPKZZO4mjvCsDsbjP2Wioy7+BzFiE6lS3emRM8g9blCz9l7SeA86X3Eaobn",
+	"cDtA5hJEd0EuvCwKm/6/V87tKF7UX8GmtQww7gC4qv3/3/97fPSvFUxHfVG5to6xrWQkfGVzK+ZRlS8q",
+	"ddOOjGXTKqpeWYV4P0CTt1O0Xx41sUngYHIbmj+VvppugDntxxI7cDYH4QKXvTuI/R5O0Y9Y2LwKJQ5w",
+	"s9bVTAf/Wzzk7lVQMf3VUxZMby3dKjHOvRqgzDRoHij8N6RkZ0S3JrQRw6jKRM+9XhQPvJMeZ7OB7dor",
+	"BK+rivMzCcTzxZSfaxyeW/BnUSJkCbpqQ0I7mHFtpFr0uqDQvcmEgYrETXctil+aAa6Ps5mDF9K7CsMQ",
+	"gwSCBbKRyPk1C5oGz+kJfAY5iKDGu+XGNrWHQHLynu+pPgiJj20YCQgiAACYOh7hO43xBx4KyrXeRwRr",
+	"py786Jbu9wiD5xxhgHtFHJ3/Nwgy0I0JbXLk8cU1xlsLOLjWbHtJp5fyaV3JzVRhxBOMuDkAvxEmlGWD",
+	"iAzUTAt2zTyTxOCowkSnaPGyc/qNUbG1ljnyWvY9XNLpaso9/NXQad9oSeinFSXZEft4SafvlSx2k6rT",
+	"RX0YdRiPfYRpPR+U+zXEhzNxFpYGAT5NMGW10ZuQFP7rqja8/uqspT0LY9YernU01ki1i7u54lJmZ0GE",
+	"auybkcwyxKcdfmcvuBwPEIoL3d4vFXBFZt86R9S6bKZgZwHIpZ9C9c+wrw+WdrWpg/XoUR2sz0rL6+ll",
+	"DQFo+6FtNb6oMR+gzlDBnIEqqUGSnKlSSRsMOq7KfC8nPn1qDOWee1lFH6za1LBHuwWOmqlSNFrxszHC",
+	"MMd+Z0B5srUGfvuaa9MDMk80mmruBoH8fI0FVzpg8xpL85DYeWFHT+RGbpLB6m1/HlB6srk7XVQSPeQo",
+	"JeNJ7Xfi3bvObLgO6TpButLOGLvupH90A9lUlg7b2IWDamOGgQPflG34xXy6vEcZG819aOjwV0sB6wTi",
+	"Wt0Ceqn8nU0k9k9IOhZACJUHGzwjBVrbPB3WT0fCzFihWX7DdELGpcNvB+BFX03qO4MlC+37WeAGqkjX",
+	"nWiIkHfRDCPRGFbUx2rbi9DDfel4vb0MO/qqmeoNq4GfNPPZnqB6HWxohP5WX3RltwEK9s/56uL3HWaD",
+	"o/BRWZtx8+2PmEGGoxiOBCBUy5oGM0m0dG7LFuej+a1NqLhmbK4bcJ/4fYxmLph5LgSz+9s8OrknEtZj",
+	"bDoCewVPnIVMqicW30+zYBAbHhLPo10VjwOs4tHvcs8gWnGpqIjugJBCuP4aKDR6v3/Gpj66YTzgTjd6",
+	"WhcD+Lk5w50J7a2VW21mr8A3t6qXVX3dgsHWUOcquhlfqg43r5Xlu/NbfY/iWLuuXvGQZk2/ZH3xLOo9",
+	"3RVJqWDTPDHVG7kpZLpvrUPX+1I/fjg9z3fyRDpeNcfINvpnz0O3CzYrtvNLfOSwYGq6KiLSPiYIp5uz",
+	"gINAAo8UbEhO87yFM6plqVLWYDd5jnJ0CHOOxbog7tG/ulywFQYQcqGHILJmJ08kd7QH0YXSW71CYO8A",
+	"ezVlWk/KPF/8Vjx0SFfrGNUyufZGKKxJiry30Gp45dmU79sZz4MKMnWtUW4SnzU+kZaAuSaamWGHqyVg",
+	"fJvJ4P7DfvL3ezsU7LGnvlZxpEfGQawShFd/cC6+ahb3razhXutwE6vvETcxFlezk017DOlh5VUTwAU+",
+	"SYTI2n3qjeTXKVzgy7vbrofyKm0lmTwyuTwL19LGkkkVPMgKt0xrTn/1bhW87doKQYzHzNwyJuzLyrgi",
+	"KVlCZJ4FQYNwV9CRUKUQAHxOcwpJfKcuPwND5wHPOayq4fDXgwocXIR6cAM5YyT2vl6cBVUt94fks0Uu",
+	"qcaKJZSpJnC1A/bya3tflcIVNE0Vs9GMQprwbcGm1PAbNnRQJFiy4H+bZ5MqEBGXCZBIBFbZA/Ckz2fv",
+	"w1LkAG3fEaDo9+6i2qB7XoNLwXSq2sd6xHOmuMzIntdNspIRrEjpI/rHNL22wmVdMnC/u86qMiv903Xl",
+	"N2rYgeEF61Pb8Z3IVg08zUvNb1jXqJjIHmBMXg91tLBNPRHgS3VBEffnPJvE6oo85A35JyiiUNOdZR1h",
+	"a3ZIjcbWl+zoZp01/3nOwCyvjo4eHpjFMgdkF/ac2QIvbJVsECxdjOMng1OP7LCa+1tBIV1v7/p6cXYQ",
+	"VEysvwRTVAVMX2NPhaDarihQs9bcSpbnRrVTnnfJC+YZhR20DvuJnVd8t+O8Wj/WVSGFmQWnFn7MqG0D",
+	"/nnL2PUgab4LfywYVY99sP3inIF0u/ZYuqX5pz+XNTlaESDPAM9rzIhLMl17RisS63tINRa51ZvkGvpv",
+	"bF0HoFBfWMsA4qOvAiEYIiWNQUQDMKPYSbzwI3hAarQer6qfyLLb59W0dlWhrmw0Wm9JNZC1ylV0zd9a",
+	"x6VHp2gWkaWTCUuDcoIQyTAS3q0twwxZ5nJWPDJPFtZBXIRQPbC1VdW3mAjpMrDCjXywNC/XyROpaOsI",
+	"yT97HmpaDwr0fMDQHjwg5oeyH/Z3QUFw9ebeJxuu/c/leLqk074+J9i6XbmbDG1QiguG38zJZOi0w790",
+	"CU8ezrV0SadP5FWyM+vIfXgWviTck44cB0yU6W2Nt6cRQSkwkIt7aPHAedRhZ0cC2EzOvoRMl37mcrve",
+	"z6BiUHS111q87bp2Grt3unJHj0H3T23Y7tiE3ubsGBvD9+67Fw8lHG3K/h6FDJ6FJLSS/WGhjm6/+Vd4",
+	"7m2qUhFe0Clg41+8PLADooaPc0a0kYo6XHosn8A10UYxWqCT3L2AUfeEa1tYlGYY0UoXVs/ztUC/fv7w",
+	"6fTs6uPpX64uzv/Pd1cf35A9Zw4gx0f75OOb11a6A5l9rpjzw3/98gHrlbqyyHYMWH+auF0mViyyw4IP",
+	"qTLfafIWHx1cLuYYGqkFn0xCOCT/sVXsbKAttYMnrtKv/SIkHJkaZg5w2nFlwa7meyz7+sBVf9+7yitu",
+	"hx+14u/xDg+3Hf0qURDm6evNPKoV5fjl45R6guMECiwMmIxltiDsLmXMAf04ABu3CkTzXzDB9PjVIw6Q",
+	"azDYVIyCks8//ZCQP3x+90NCfjh/D8frz2z8GVnIEq+CobcqIuOvS+zqMJViwlXRzba+sCnXBsq64+jg",
+	"4NoyWJ5SyA2nLfbhMfw8zpnVvjAMesbnxCiaXmN175Z4j4P57Nv66g/cA2FK2878sXgSeX/9mXS76baJ",
+	"ZU5kxj15OnUAhxPsesUb1xHczBT5gZEHzifTgQeRpmxuNPnx8uMHf28kRFPBDf8FdIXE1zoAyCp7UBAj",
+	"fcZoBvE6b2dKFgxj7Ut39XbdtR23y4+myC/l52zyQBRYtf9sqc+u65QJuzQsC5byca+HR/NlBbj6UWcW",
+	"wr8bJEtHdlRsQPzVeek0kv3gVttVgWuKZCTjiqXG305AzjEtr2agXz6ss5P9RIsK2m7SFnSiLmGeM/hn",
+	"jzzubvfzx/OP71CMDPru6NFt/BU0GndtdYmOg8f1V4ULv/JcNXa2OmFPxM2tmtvm5ARJJ0rQM0ZzM+vl",
+	"68FXA5w4M8NycGEhsIwB+rVIOUMwVDvmzNmDXx29RFdQQ6CAoj7KBtpQ4OOSSJXOmDaKGqmwJJBiGNFj",
+	"AHNJG4jXGYn3f4GOL176gl4852bhQnNQskcDtH0rkyiKgUskBO1KZRaFbvwRJvx2xtLrh3RFYTcOTC/q",
+	"QcAl5tptwQIZ6ctHG8FZY6uq2mlIeiwtFTeLwclffw4JEdskqVs9T3z4syW+5re/Dt4wqpg6LS01/vVn",
+	"y2U+2T9e2K+8DfHEaseDpP77VnGD3ItmJ64YFQdbIzxp/oQvQZ2qxjvBL/BKGLeMr6gglM3OEmogxjjw",
+	"6efzukJiqfLBCdwZYOVxS9AF6OFLaZGCCjr1oRWObb6t57HMf99i6a3DGwidiX9fzfFb0jUAP8loA1+C",
+	"NJauBqy1MvbtJZ3GPmsiJugZVUENoDqUz8wYV0Eysmu08fWKQcUG5J6t+qyuELD0mcPJWP420LlJxUmC",
+	"7x3jXf4wPCsVMnXwIT5fMdpmGRX0zaJS5lqoHf3LjXxt+QTdJ7VTc5ngPKmOy2zKTKgEuo/fwIPoIpV5",
+	"TmiK4Yzszo4UL4/C/jNogabX5Xzw7edv/3MAFw85QgLSAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
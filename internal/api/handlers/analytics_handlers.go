@@ -1,11 +1,26 @@
 package handlers
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"log"
+	"sync"
 
 	"github.com/rxtech-lab/invoice-management/internal/api/generated"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
 )
 
+// analytics returns the analytics service, bypassing its cache when the request sets no_cache
+func (h *StrictHandlers) analytics(noCache *bool) services.AnalyticsService {
+	if deref(noCache) {
+		return h.analyticsService.WithoutCache()
+	}
+	return h.analyticsService
+}
+
 // GetAnalyticsSummary implements generated.StrictServerInterface
 func (h *StrictHandlers) GetAnalyticsSummary(
 	ctx context.Context,
@@ -21,7 +36,12 @@ func (h *StrictHandlers) GetAnalyticsSummary(
 		period = string(*request.Params.Period)
 	}
 
-	summary, err := h.analyticsService.GetSummary(userID, periodParamToService(period))
+	analyticsService := h.analytics(request.Params.NoCache)
+	getSummary := analyticsService.GetSummary
+	if request.Params.PaidBy != nil && *request.Params.PaidBy == generated.PaymentDate {
+		getSummary = analyticsService.GetSummaryByPaymentDate
+	}
+	summary, err := getSummary(userID, periodParamToService(period))
 	if err != nil {
 		return nil, err
 	}
@@ -44,7 +64,7 @@ func (h *StrictHandlers) GetAnalyticsByCategory(
 		period = string(*request.Params.Period)
 	}
 
-	result, err := h.analyticsService.GetByCategory(userID, periodParamToService(period))
+	result, err := h.analytics(request.Params.NoCache).GetByCategory(userID, periodParamToService(period))
 	if err != nil {
 		return nil, err
 	}
@@ -67,7 +87,7 @@ func (h *StrictHandlers) GetAnalyticsByCompany(
 		period = string(*request.Params.Period)
 	}
 
-	result, err := h.analyticsService.GetByCompany(userID, periodParamToService(period))
+	result, err := h.analytics(request.Params.NoCache).GetByCompany(userID, periodParamToService(period))
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +110,7 @@ func (h *StrictHandlers) GetAnalyticsByReceiver(
 		period = string(*request.Params.Period)
 	}
 
-	result, err := h.analyticsService.GetByReceiver(userID, periodParamToService(period))
+	result, err := h.analytics(request.Params.NoCache).GetByReceiver(userID, periodParamToService(period))
 	if err != nil {
 		return nil, err
 	}
@@ -113,10 +133,250 @@ func (h *StrictHandlers) GetAnalyticsByTag(
 		period = string(*request.Params.Period)
 	}
 
-	result, err := h.analyticsService.GetByTag(userID, periodParamToService(period))
+	analytics := h.analytics(request.Params.NoCache)
+	getByTag := analytics.GetByTag
+	if request.Params.IncludeChildren != nil && *request.Params.IncludeChildren {
+		getByTag = analytics.GetByTagWithChildren
+	}
+	result, err := getByTag(userID, periodParamToService(period))
 	if err != nil {
 		return nil, err
 	}
 
 	return generated.GetAnalyticsByTag200JSONResponse(analyticsByGroupToGenerated(result)), nil
 }
+
+// GetAnalyticsTrend implements generated.StrictServerInterface
+func (h *StrictHandlers) GetAnalyticsTrend(
+	ctx context.Context,
+	request generated.GetAnalyticsTrendRequestObject,
+) (generated.GetAnalyticsTrendResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.GetAnalyticsTrend401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	months := derefInt(request.Params.Months, services.DefaultTrendMonths)
+	if months < 1 {
+		return generated.GetAnalyticsTrend400JSONResponse{BadRequestJSONResponse: badRequest("months must be at least 1")}, nil
+	}
+
+	points, err := h.analyticsService.GetMonthlyTrend(userID, months)
+	if err != nil {
+		return generated.GetAnalyticsTrend400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+
+	baseCurrency, err := h.settingsService.GetBaseCurrency(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return generated.GetAnalyticsTrend200JSONResponse(monthlyTrendToGenerated(
+		points,
+		baseCurrency,
+		h.settingsService.GetLocation(userID).String(),
+	)), nil
+}
+
+// GetReceiverStatistics implements generated.StrictServerInterface
+func (h *StrictHandlers) GetReceiverStatistics(
+	ctx context.Context,
+	request generated.GetReceiverStatisticsRequestObject,
+) (generated.GetReceiverStatisticsResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.GetReceiverStatistics401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	period := services.PeriodLastMonth
+	if request.Params.Period != nil {
+		switch *request.Params.Period {
+		case generated.GetReceiverStatisticsParamsPeriodLastDay, generated.GetReceiverStatisticsParamsPeriodLastWeek,
+			generated.GetReceiverStatisticsParamsPeriodLastMonth, generated.GetReceiverStatisticsParamsPeriodLastYear:
+			period = services.StatisticsPeriod(*request.Params.Period)
+		default:
+			return generated.GetReceiverStatistics400JSONResponse{BadRequestJSONResponse: badRequest("invalid period: " + string(*request.Params.Period))}, nil
+		}
+	}
+
+	detail, err := h.analyticsService.GetReceiverDetail(userID, uint(request.Id), period)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return generated.GetReceiverStatistics404JSONResponse{NotFoundJSONResponse: notFound("Receiver not found")}, nil
+	}
+	if err != nil {
+		return generated.GetReceiverStatistics500JSONResponse{Error: ptr("Failed to get receiver statistics: " + err.Error())}, nil
+	}
+
+	return generated.GetReceiverStatistics200JSONResponse(receiverDetailToGenerated(detail)), nil
+}
+
+// GetCompanyBreakdown implements generated.StrictServerInterface
+func (h *StrictHandlers) GetCompanyBreakdown(
+	ctx context.Context,
+	request generated.GetCompanyBreakdownRequestObject,
+) (generated.GetCompanyBreakdownResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.GetCompanyBreakdown401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	period := services.PeriodLastMonth
+	if request.Params.Period != nil {
+		switch *request.Params.Period {
+		case generated.GetCompanyBreakdownParamsPeriodLastDay, generated.GetCompanyBreakdownParamsPeriodLastWeek,
+			generated.GetCompanyBreakdownParamsPeriodLastMonth, generated.GetCompanyBreakdownParamsPeriodLastYear:
+			period = services.StatisticsPeriod(*request.Params.Period)
+		default:
+			return generated.GetCompanyBreakdown400JSONResponse{BadRequestJSONResponse: badRequest("invalid period: " + string(*request.Params.Period))}, nil
+		}
+	}
+
+	breakdown, err := h.analyticsService.GetCompanyBreakdown(userID, uint(request.Id), period)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return generated.GetCompanyBreakdown404JSONResponse{NotFoundJSONResponse: notFound("Company not found")}, nil
+	}
+	if err != nil {
+		return generated.GetCompanyBreakdown500JSONResponse{Error: ptr("Failed to get company breakdown: " + err.Error())}, nil
+	}
+
+	return generated.GetCompanyBreakdown200JSONResponse(companyBreakdownToGenerated(breakdown)), nil
+}
+
+// GetReceiverStatement implements generated.StrictServerInterface
+func (h *StrictHandlers) GetReceiverStatement(
+	ctx context.Context,
+	request generated.GetReceiverStatementRequestObject,
+) (generated.GetReceiverStatementResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.GetReceiverStatement401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if request.Params.End.Before(request.Params.Start) {
+		return generated.GetReceiverStatement400JSONResponse{BadRequestJSONResponse: badRequest("end must not be before start")}, nil
+	}
+
+	statement, err := h.analyticsService.GenerateVendorStatement(userID, uint(request.Id), request.Params.Start, request.Params.End)
+	if err != nil {
+		return generated.GetReceiverStatement404JSONResponse{NotFoundJSONResponse: notFound("Receiver not found")}, nil
+	}
+
+	if request.Params.Format == nil || *request.Params.Format != generated.Pdf {
+		return generated.GetReceiverStatement200JSONResponse(vendorStatementToGenerated(statement)), nil
+	}
+
+	if h.pdfService == nil {
+		return generated.GetReceiverStatement500JSONResponse{Error: ptr("PDF service not configured")}, nil
+	}
+	pdfContent, err := h.pdfService.RenderVendorStatement(ctx, statement)
+	if errors.Is(err, services.ErrPDFRenderingNotConfigured) {
+		return generated.GetReceiverStatement500JSONResponse{Error: ptr("PDF service not configured")}, nil
+	}
+	if err != nil {
+		return generated.GetReceiverStatement500JSONResponse{Error: ptr("Failed to render statement: " + err.Error())}, nil
+	}
+
+	return generated.GetReceiverStatement200ApplicationpdfResponse{
+		Body:          bytes.NewReader(pdfContent),
+		ContentLength: int64(len(pdfContent)),
+	}, nil
+}
+
+// dashboardRecentLimit is the number of most recently created invoices returned by the dashboard
+const dashboardRecentLimit = 10
+
+// GetDashboard implements generated.StrictServerInterface.
+// Sections are loaded concurrently; a failing section is reported in Errors instead of
+// failing the whole response.
+func (h *StrictHandlers) GetDashboard(
+	ctx context.Context,
+	request generated.GetDashboardRequestObject,
+) (generated.GetDashboardResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.GetDashboard401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	period := "1m"
+	if request.Params.Period != nil {
+		period = string(*request.Params.Period)
+	}
+	analyticsPeriod := periodParamToService(period)
+	analyticsService := h.analytics(request.Params.NoCache)
+
+	dashboard := generated.Dashboard{Period: period}
+	var mu sync.Mutex
+	sectionErrors := make(map[string]string)
+
+	g, gctx := errgroup.WithContext(ctx)
+	// load runs one section; each section writes only its own field of dashboard
+	load := func(section string, fn func() error) {
+		g.Go(func() error {
+			// Stop early if the request was cancelled
+			if err := gctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(); err != nil {
+				log.Printf("Warning: Failed to load dashboard %s for user %s: %v", section, userID, err)
+				mu.Lock()
+				sectionErrors[section] = "failed to load " + section
+				mu.Unlock()
+			}
+			return nil
+		})
+	}
+
+	load("summary", func() error {
+		summary, err := analyticsService.GetSummary(userID, analyticsPeriod)
+		if err != nil {
+			return err
+		}
+		dashboard.Summary = ptr(analyticsSummaryToGenerated(summary))
+		return nil
+	})
+	load("by_category", func() error {
+		byCategory, err := analyticsService.GetByCategory(userID, analyticsPeriod)
+		if err != nil {
+			return err
+		}
+		dashboard.ByCategory = ptr(analyticsByGroupToGenerated(byCategory))
+		return nil
+	})
+	load("by_company", func() error {
+		byCompany, err := analyticsService.GetByCompany(userID, analyticsPeriod)
+		if err != nil {
+			return err
+		}
+		dashboard.ByCompany = ptr(analyticsByGroupToGenerated(byCompany))
+		return nil
+	})
+	load("overdue", func() error {
+		overdue, err := h.invoiceService.GetOverdueInvoices(userID)
+		if err != nil {
+			return err
+		}
+		dashboard.Overdue = ptr(invoiceListToGenerated(overdue))
+		return nil
+	})
+	load("recent", func() error {
+		recent, _, err := h.invoiceService.ListInvoices(userID, services.InvoiceListOptions{
+			SortBy:    "created_at",
+			SortOrder: "desc",
+			Limit:     dashboardRecentLimit,
+		})
+		if err != nil {
+			return err
+		}
+		dashboard.Recent = ptr(invoiceListToGenerated(recent))
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	if len(sectionErrors) > 0 {
+		dashboard.Errors = &sectionErrors
+	}
+
+	return generated.GetDashboard200JSONResponse(dashboard), nil
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/rxtech-lab/invoice-management/internal/api/generated"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
+)
+
+// ListInvoiceAttachments implements generated.StrictServerInterface
+func (h *StrictHandlers) ListInvoiceAttachments(
+	ctx context.Context,
+	request generated.ListInvoiceAttachmentsRequestObject,
+) (generated.ListInvoiceAttachmentsResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.ListInvoiceAttachments401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	attachments, err := h.fileUploadService.ListAttachments(userID, uint(request.Id))
+	if err != nil {
+		return generated.ListInvoiceAttachments404JSONResponse{NotFoundJSONResponse: notFound("Invoice not found")}, nil
+	}
+
+	data := invoiceAttachmentListToGenerated(attachments)
+
+	return generated.ListInvoiceAttachments200JSONResponse{
+		Data: &data,
+	}, nil
+}
+
+// AddInvoiceAttachment implements generated.StrictServerInterface
+func (h *StrictHandlers) AddInvoiceAttachment(
+	ctx context.Context,
+	request generated.AddInvoiceAttachmentRequestObject,
+) (generated.AddInvoiceAttachmentResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.AddInvoiceAttachment401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if request.Body == nil || request.Body.Key == "" {
+		return generated.AddInvoiceAttachment400JSONResponse{BadRequestJSONResponse: badRequest("Key is required")}, nil
+	}
+
+	attachment, err := h.fileUploadService.AddAttachment(userID, uint(request.Id), request.Body.Key)
+	if err != nil {
+		return generated.AddInvoiceAttachment400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+
+	return generated.AddInvoiceAttachment201JSONResponse(invoiceAttachmentModelToGenerated(attachment)), nil
+}
+
+// RemoveInvoiceAttachment implements generated.StrictServerInterface
+func (h *StrictHandlers) RemoveInvoiceAttachment(
+	ctx context.Context,
+	request generated.RemoveInvoiceAttachmentRequestObject,
+) (generated.RemoveInvoiceAttachmentResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.RemoveInvoiceAttachment401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	// Forward the Authorization header so the file server can unlink the file
+	authHeader, _ := utils.GetAuthorizationHeader(ctx)
+
+	if err := h.fileUploadService.RemoveAttachment(ctx, userID, uint(request.Id), uint(request.AttachmentId), authHeader); err != nil {
+		return generated.RemoveInvoiceAttachment404JSONResponse{NotFoundJSONResponse: notFound(err.Error())}, nil
+	}
+
+	return generated.RemoveInvoiceAttachment204Response{}, nil
+}
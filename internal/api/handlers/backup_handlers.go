@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/rxtech-lab/invoice-management/internal/api/generated"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+)
+
+// ExportData implements generated.StrictServerInterface
+func (h *StrictHandlers) ExportData(
+	ctx context.Context,
+	request generated.ExportDataRequestObject,
+) (generated.ExportDataResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.ExportData401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	doc, err := h.backupService.Export(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return generated.ExportData200JSONResponse(exportDocumentToGenerated(doc)), nil
+}
+
+// ImportData implements generated.StrictServerInterface
+func (h *StrictHandlers) ImportData(
+	ctx context.Context,
+	request generated.ImportDataRequestObject,
+) (generated.ImportDataResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.ImportData401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	result, err := h.backupService.Import(userID, exportDocumentFromGenerated(request.Body))
+	if err != nil {
+		return generated.ImportData400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+
+	return generated.ImportData200JSONResponse(importResultToGenerated(result)), nil
+}
+
+// RequestExport implements generated.StrictServerInterface
+func (h *StrictHandlers) RequestExport(
+	ctx context.Context,
+	request generated.RequestExportRequestObject,
+) (generated.RequestExportResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.RequestExport401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	job, err := h.exportService.RequestExport(userID)
+	if err != nil {
+		return generated.RequestExport400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+
+	return generated.RequestExport202JSONResponse(exportJobToGenerated(&services.ExportJobDetail{ExportJob: job})), nil
+}
+
+// GetExportJob implements generated.StrictServerInterface
+func (h *StrictHandlers) GetExportJob(
+	ctx context.Context,
+	request generated.GetExportJobRequestObject,
+) (generated.GetExportJobResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.GetExportJob401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	detail, err := h.exportService.GetExportJob(ctx, userID, uint(request.Id))
+	if err != nil {
+		return generated.GetExportJob404JSONResponse{NotFoundJSONResponse: notFound("Export job not found")}, nil
+	}
+
+	return generated.GetExportJob200JSONResponse(exportJobToGenerated(detail)), nil
+}
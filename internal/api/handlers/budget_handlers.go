@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/api/generated"
+	"github.com/rxtech-lab/invoice-management/internal/models"
+)
+
+// ListBudgets implements generated.StrictServerInterface
+func (h *StrictHandlers) ListBudgets(
+	ctx context.Context,
+	request generated.ListBudgetsRequestObject,
+) (generated.ListBudgetsResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.ListBudgets401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	budgets, err := h.budgetService.ListBudgets(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := budgetListToGenerated(budgets)
+
+	return generated.ListBudgets200JSONResponse{
+		Data: &data,
+	}, nil
+}
+
+// CreateBudget implements generated.StrictServerInterface
+func (h *StrictHandlers) CreateBudget(
+	ctx context.Context,
+	request generated.CreateBudgetRequestObject,
+) (generated.CreateBudgetResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.CreateBudget401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	budget := &models.Budget{
+		CategoryID: uint(request.Body.CategoryId),
+		Amount:     request.Body.Amount,
+		Currency:   deref(request.Body.Currency),
+	}
+	if request.Body.PeriodType != nil {
+		budget.PeriodType = models.BudgetPeriod(*request.Body.PeriodType)
+	}
+
+	if err := h.budgetService.CreateBudget(userID, budget); err != nil {
+		return generated.CreateBudget400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+
+	return generated.CreateBudget201JSONResponse(budgetModelToGenerated(budget)), nil
+}
+
+// DeleteBudget implements generated.StrictServerInterface
+func (h *StrictHandlers) DeleteBudget(
+	ctx context.Context,
+	request generated.DeleteBudgetRequestObject,
+) (generated.DeleteBudgetResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.DeleteBudget401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if err := h.budgetService.DeleteBudget(userID, uint(request.Id)); err != nil {
+		return generated.DeleteBudget404JSONResponse{NotFoundJSONResponse: notFound(err.Error())}, nil
+	}
+
+	return generated.DeleteBudget204Response{}, nil
+}
+
+// GetBudgetStatus implements generated.StrictServerInterface
+func (h *StrictHandlers) GetBudgetStatus(
+	ctx context.Context,
+	request generated.GetBudgetStatusRequestObject,
+) (generated.GetBudgetStatusResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.GetBudgetStatus401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	asOf := time.Now()
+	if request.Params.AsOf != nil {
+		asOf = *request.Params.AsOf
+	}
+
+	statuses, err := h.budgetService.EvaluateBudgets(userID, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	data := budgetStatusListToGenerated(statuses)
+
+	return generated.GetBudgetStatus200JSONResponse{
+		Data: &data,
+	}, nil
+}
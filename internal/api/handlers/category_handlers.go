@@ -2,9 +2,11 @@ package handlers
 
 import (
 	"context"
+	"errors"
 
 	"github.com/rxtech-lab/invoice-management/internal/api/generated"
 	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
 )
 
 // ListCategories implements generated.StrictServerInterface
@@ -29,10 +31,11 @@ func (h *StrictHandlers) ListCategories(
 	data := categoryListToGenerated(categories)
 
 	return generated.ListCategories200JSONResponse{
-		Data:   &data,
-		Total:  ptr(int(total)),
-		Limit:  ptr(limit),
-		Offset: ptr(offset),
+		Data:       &data,
+		Total:      ptr(int(total)),
+		Limit:      ptr(limit),
+		Offset:     ptr(offset),
+		Pagination: pagination(limit, offset, total),
 	}, nil
 }
 
@@ -51,9 +54,11 @@ func (h *StrictHandlers) CreateCategory(
 	}
 
 	category := &models.InvoiceCategory{
-		Name:        request.Body.Name,
-		Description: deref(request.Body.Description),
-		Color:       deref(request.Body.Color),
+		Name:            request.Body.Name,
+		Description:     deref(request.Body.Description),
+		Color:           deref(request.Body.Color),
+		DefaultCurrency: deref(request.Body.DefaultCurrency),
+		DefaultTaxRate:  deref(request.Body.DefaultTaxRate),
 	}
 
 	if err := h.categoryService.CreateCategory(userID, category); err != nil {
@@ -107,6 +112,12 @@ func (h *StrictHandlers) UpdateCategory(
 	if request.Body.Color != nil {
 		existing.Color = *request.Body.Color
 	}
+	if request.Body.DefaultCurrency != nil {
+		existing.DefaultCurrency = *request.Body.DefaultCurrency
+	}
+	if request.Body.DefaultTaxRate != nil {
+		existing.DefaultTaxRate = *request.Body.DefaultTaxRate
+	}
 
 	if err := h.categoryService.UpdateCategory(userID, existing); err != nil {
 		return generated.UpdateCategory400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
@@ -126,7 +137,11 @@ func (h *StrictHandlers) DeleteCategory(
 		return generated.DeleteCategory401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
 	}
 
-	if err := h.categoryService.DeleteCategory(userID, uint(request.Id)); err != nil {
+	if err := h.categoryService.DeleteCategory(userID, uint(request.Id), deref(request.Params.Force)); err != nil {
+		var inUseErr *services.InUseError
+		if errors.As(err, &inUseErr) {
+			return generated.DeleteCategory409JSONResponse{InUseJSONResponse: inUse(inUseErr)}, nil
+		}
 		return generated.DeleteCategory404JSONResponse{NotFoundJSONResponse: notFound(err.Error())}, nil
 	}
 
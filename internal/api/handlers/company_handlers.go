@@ -2,9 +2,11 @@ package handlers
 
 import (
 	"context"
+	"errors"
 
 	"github.com/rxtech-lab/invoice-management/internal/api/generated"
 	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
 )
 
 // ListCompanies implements generated.StrictServerInterface
@@ -29,10 +31,11 @@ func (h *StrictHandlers) ListCompanies(
 	data := companyListToGenerated(companies)
 
 	return generated.ListCompanies200JSONResponse{
-		Data:   &data,
-		Total:  ptr(int(total)),
-		Limit:  ptr(limit),
-		Offset: ptr(offset),
+		Data:       &data,
+		Total:      ptr(int(total)),
+		Limit:      ptr(limit),
+		Offset:     ptr(offset),
+		Pagination: pagination(limit, offset, total),
 	}, nil
 }
 
@@ -146,7 +149,11 @@ func (h *StrictHandlers) DeleteCompany(
 		return generated.DeleteCompany401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
 	}
 
-	if err := h.companyService.DeleteCompany(userID, uint(request.Id)); err != nil {
+	if err := h.companyService.DeleteCompany(userID, uint(request.Id), deref(request.Params.Force)); err != nil {
+		var inUseErr *services.InUseError
+		if errors.As(err, &inUseErr) {
+			return generated.DeleteCompany409JSONResponse{InUseJSONResponse: inUse(inUseErr)}, nil
+		}
 		return generated.DeleteCompany404JSONResponse{NotFoundJSONResponse: notFound(err.Error())}, nil
 	}
 
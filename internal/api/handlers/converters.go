@@ -5,20 +5,26 @@ import (
 	"github.com/rxtech-lab/invoice-management/internal/api/generated"
 	"github.com/rxtech-lab/invoice-management/internal/models"
 	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
 )
 
 // Category converters
 
 func categoryModelToGenerated(cat *models.InvoiceCategory) generated.Category {
-	return generated.Category{
-		Id:          ptr(int(cat.ID)),
-		UserId:      ptr(cat.UserID),
-		Name:        ptr(cat.Name),
-		Description: ptr(cat.Description),
-		Color:       ptr(cat.Color),
-		CreatedAt:   ptr(cat.CreatedAt),
-		UpdatedAt:   ptr(cat.UpdatedAt),
+	result := generated.Category{
+		Id:              ptr(int(cat.ID)),
+		UserId:          ptr(cat.UserID),
+		Name:            ptr(cat.Name),
+		Description:     ptr(cat.Description),
+		Color:           ptr(cat.Color),
+		DefaultCurrency: ptrIfNotEmpty(cat.DefaultCurrency),
+		CreatedAt:       ptr(cat.CreatedAt),
+		UpdatedAt:       ptr(cat.UpdatedAt),
 	}
+	if cat.DefaultTaxRate != 0 {
+		result.DefaultTaxRate = ptr(cat.DefaultTaxRate)
+	}
+	return result
 }
 
 func categoryListToGenerated(categories []models.InvoiceCategory) []generated.Category {
@@ -77,6 +83,11 @@ func receiverModelToGenerated(rec *models.InvoiceReceiver) generated.Receiver {
 		Name:           ptr(rec.Name),
 		OtherNames:     otherNames,
 		IsOrganization: ptr(rec.IsOrganization),
+		Email:          ptrIfNotEmpty(rec.Email),
+		Phone:          ptrIfNotEmpty(rec.Phone),
+		Address:        ptrIfNotEmpty(rec.Address),
+		BankAccount:    ptrIfNotEmpty(rec.BankAccount),
+		TaxId:          ptrIfNotEmpty(rec.TaxID),
 		CreatedAt:      ptr(rec.CreatedAt),
 		UpdatedAt:      ptr(rec.UpdatedAt),
 	}
@@ -93,7 +104,7 @@ func receiverListToGenerated(receivers []models.InvoiceReceiver) []generated.Rec
 // Invoice converters
 
 func invoiceModelToGenerated(inv *models.Invoice) generated.Invoice {
-	var categoryID, companyID, receiverID *int
+	var categoryID, companyID, receiverID, organizationID *int
 	var category *generated.Category
 	var company *generated.Company
 	var receiver *generated.Receiver
@@ -107,6 +118,9 @@ func invoiceModelToGenerated(inv *models.Invoice) generated.Invoice {
 	if inv.ReceiverID != nil {
 		receiverID = ptr(int(*inv.ReceiverID))
 	}
+	if inv.OrganizationID != nil {
+		organizationID = ptr(int(*inv.OrganizationID))
+	}
 
 	if inv.Category != nil {
 		cat := categoryModelToGenerated(inv.Category)
@@ -127,14 +141,18 @@ func invoiceModelToGenerated(inv *models.Invoice) generated.Invoice {
 	if len(inv.Items) > 0 {
 		itemList := invoiceItemListToGenerated(inv.Items)
 		items = &itemList
-		// Calculate USD-normalized total from item target_amounts
+		// Calculate base-currency-normalized total from item target_amounts
 		for _, item := range inv.Items {
-			if item.TargetAmount > 0 {
+			// Items without a target currency predate FX conversion and have no target amount
+			if item.TargetCurrency != "" {
 				targetAmount += item.TargetAmount
 			} else {
 				targetAmount += item.Amount
 			}
 		}
+		// Item target amounts are already rounded, so the total is their exact sum;
+		// rounding only drops floating-point noise from the addition
+		targetAmount = utils.RoundToCurrency(targetAmount, inv.Items[0].TargetCurrency)
 	} else {
 		// Fallback to invoice amount if no items
 		targetAmount = inv.Amount
@@ -153,6 +171,21 @@ func invoiceModelToGenerated(inv *models.Invoice) generated.Invoice {
 		tags = &t
 	}
 
+	// Convert attachments
+	var attachments *[]generated.InvoiceAttachment
+	if len(inv.Attachments) > 0 {
+		a := invoiceAttachmentListToGenerated(inv.Attachments)
+		attachments = &a
+	}
+
+	// Convert link to a related invoice
+	var relatedInvoiceID *int
+	var relationType *generated.InvoiceRelationType
+	if inv.RelatedInvoiceID != nil {
+		relatedInvoiceID = ptr(int(*inv.RelatedInvoiceID))
+		relationType = ptr(generated.InvoiceRelationType(inv.RelationType))
+	}
+
 	// Convert status
 	var status *generated.InvoiceStatus
 	if inv.Status != "" {
@@ -163,24 +196,38 @@ func invoiceModelToGenerated(inv *models.Invoice) generated.Invoice {
 	return generated.Invoice{
 		Id:                   ptr(int(inv.ID)),
 		UserId:               ptr(inv.UserID),
+		InvoiceNumber:        ptr(inv.DisplayNumber()),
 		Title:                ptr(inv.Title),
 		Description:          ptr(inv.Description),
 		InvoiceStartedAt:     inv.InvoiceStartedAt,
 		InvoiceEndedAt:       inv.InvoiceEndedAt,
 		Amount:               ptr(inv.Amount),
+		AmountCurrencyMixed:  ptr(inv.AmountCurrencyMixed),
+		FxRateUsed:           ptr(inv.FXRateUsed),
 		TargetAmount:         ptr(targetAmount),
 		Currency:             ptr(inv.Currency),
+		DiscountType:         discountTypeToGenerated(inv.DiscountType),
+		DiscountValue:        ptr(inv.DiscountValue),
+		TaxRate:              inv.TaxRate,
 		CategoryId:           categoryID,
 		Category:             category,
 		CompanyId:            companyID,
 		Company:              company,
 		ReceiverId:           receiverID,
 		Receiver:             receiver,
+		OrganizationId:       organizationID,
+		RelatedInvoiceId:     relatedInvoiceID,
+		RelationType:         relationType,
 		Items:                items,
 		OriginalDownloadLink: ptr(inv.OriginalDownloadLink),
+		Attachments:          attachments,
 		Tags:                 tags,
 		Status:               status,
 		DueDate:              inv.DueDate,
+		PaidAt:               inv.PaidAt,
+		PaymentMethod:        ptrIfNotEmpty(inv.PaymentMethod),
+		IsDraft:              ptr(inv.IsDraft),
+		Version:              ptr(inv.Version),
 		CreatedAt:            ptr(inv.CreatedAt),
 		UpdatedAt:            ptr(inv.UpdatedAt),
 	}
@@ -194,24 +241,73 @@ func invoiceListToGenerated(invoices []models.Invoice) []generated.Invoice {
 	return result
 }
 
+func invoiceFacetsToGenerated(facets *services.InvoiceFacets) generated.InvoiceFacets {
+	return generated.InvoiceFacets{
+		Currencies: facetValuesToGenerated(facets.Currencies),
+		Statuses:   facetValuesToGenerated(facets.Statuses),
+		Categories: facetEntitiesToGenerated(facets.Categories),
+		Companies:  facetEntitiesToGenerated(facets.Companies),
+		Receivers:  facetEntitiesToGenerated(facets.Receivers),
+	}
+}
+
+func facetValuesToGenerated(values []services.FacetValue) []generated.FacetValue {
+	result := make([]generated.FacetValue, len(values))
+	for i, v := range values {
+		result[i] = generated.FacetValue{Value: v.Value, Count: v.Count}
+	}
+	return result
+}
+
+func facetEntitiesToGenerated(entities []services.FacetEntity) []generated.FacetEntity {
+	result := make([]generated.FacetEntity, len(entities))
+	for i, e := range entities {
+		result[i] = generated.FacetEntity{Id: int(e.ID), Name: e.Name, Count: e.Count}
+	}
+	return result
+}
+
 // InvoiceItem converters
 
 func invoiceItemModelToGenerated(item *models.InvoiceItem) generated.InvoiceItem {
+	var categoryID *int
+	if item.CategoryID != nil {
+		categoryID = ptr(int(*item.CategoryID))
+	}
+
 	return generated.InvoiceItem{
 		Id:             ptr(int(item.ID)),
 		InvoiceId:      ptr(int(item.InvoiceID)),
 		Description:    ptr(item.Description),
 		Quantity:       ptr(item.Quantity),
+		Unit:           ptrIfNotEmpty(item.Unit),
 		UnitPrice:      ptr(item.UnitPrice),
 		Amount:         ptr(item.Amount),
+		DiscountType:   discountTypeToGenerated(item.DiscountType),
+		DiscountValue:  ptr(item.DiscountValue),
+		Currency:       ptrIfNotEmpty(item.Currency),
+		CategoryId:     categoryID,
+		Position:       ptr(item.Position),
 		TargetCurrency: ptr(item.TargetCurrency),
 		TargetAmount:   ptr(item.TargetAmount),
 		FxRateUsed:     ptr(item.FXRateUsed),
+		FxStale:        ptr(item.FXStale),
+		FxRateDate:     ptrIfNotEmpty(item.FXRateDate),
+		FxManual:       ptr(item.FXManual),
+		FxUnsupported:  ptr(item.FXUnsupported),
 		CreatedAt:      ptr(item.CreatedAt),
 		UpdatedAt:      ptr(item.UpdatedAt),
 	}
 }
 
+// discountTypeToGenerated converts a discount type, returning nil when there is no discount
+func discountTypeToGenerated(discountType models.DiscountType) *generated.DiscountType {
+	if discountType == "" {
+		return nil
+	}
+	return ptr(generated.DiscountType(discountType))
+}
+
 func invoiceItemListToGenerated(items []models.InvoiceItem) []generated.InvoiceItem {
 	result := make([]generated.InvoiceItem, len(items))
 	for i, item := range items {
@@ -220,21 +316,89 @@ func invoiceItemListToGenerated(items []models.InvoiceItem) []generated.InvoiceI
 	return result
 }
 
+// InvoiceAttachment converters
+
+func invoiceAttachmentModelToGenerated(attachment *models.InvoiceAttachment) generated.InvoiceAttachment {
+	return generated.InvoiceAttachment{
+		Id:          ptr(int(attachment.ID)),
+		InvoiceId:   ptr(int(attachment.InvoiceID)),
+		S3Key:       ptr(attachment.S3Key),
+		Filename:    ptr(attachment.Filename),
+		ContentType: ptr(attachment.ContentType),
+		Size:        ptr(attachment.Size),
+		CreatedAt:   ptr(attachment.CreatedAt),
+		UpdatedAt:   ptr(attachment.UpdatedAt),
+	}
+}
+
+func invoiceAttachmentListToGenerated(attachments []models.InvoiceAttachment) []generated.InvoiceAttachment {
+	result := make([]generated.InvoiceAttachment, len(attachments))
+	for i, attachment := range attachments {
+		result[i] = invoiceAttachmentModelToGenerated(&attachment)
+	}
+	return result
+}
+
+func auditLogListToGenerated(entries []models.AuditLog) []generated.AuditLogEntry {
+	result := make([]generated.AuditLogEntry, len(entries))
+	for i, entry := range entries {
+		diff := map[string]interface{}(entry.Diff)
+		if diff == nil {
+			diff = map[string]interface{}{}
+		}
+		result[i] = generated.AuditLogEntry{
+			Id:         int(entry.ID),
+			EntityType: generated.AuditLogEntryEntityType(entry.EntityType),
+			EntityId:   int(entry.EntityID),
+			InvoiceId:  int(entry.InvoiceID),
+			Action:     generated.AuditLogEntryAction(entry.Action),
+			ActorSub:   entry.ActorSub,
+			Diff:       diff,
+			CreatedAt:  entry.CreatedAt,
+		}
+	}
+	return result
+}
+
 // Analytics converters
 
 func analyticsSummaryToGenerated(summary *services.AnalyticsSummary) generated.AnalyticsSummary {
 	return generated.AnalyticsSummary{
-		Period:        ptr(summary.Period),
-		StartDate:     ptr(summary.StartDate),
-		EndDate:       ptr(summary.EndDate),
-		TotalAmount:   ptr(summary.TotalAmount),
-		PaidAmount:    ptr(summary.PaidAmount),
-		UnpaidAmount:  ptr(summary.UnpaidAmount),
-		OverdueAmount: ptr(summary.OverdueAmount),
-		InvoiceCount:  ptr(int(summary.InvoiceCount)),
-		PaidCount:     ptr(int(summary.PaidCount)),
-		UnpaidCount:   ptr(int(summary.UnpaidCount)),
-		OverdueCount:  ptr(int(summary.OverdueCount)),
+		Period:             ptr(summary.Period),
+		StartDate:          ptr(summary.StartDate),
+		EndDate:            ptr(summary.EndDate),
+		Currency:           ptr(summary.Currency),
+		PaidBy:             ptr(summary.PaidBy),
+		TotalAmount:        ptr(summary.TotalAmount),
+		PaidAmount:         ptr(summary.PaidAmount),
+		UnpaidAmount:       ptr(summary.UnpaidAmount),
+		OverdueAmount:      ptr(summary.OverdueAmount),
+		InvoiceCount:       ptr(int(summary.InvoiceCount)),
+		PaidCount:          ptr(int(summary.PaidCount)),
+		UnpaidCount:        ptr(int(summary.UnpaidCount)),
+		OverdueCount:       ptr(int(summary.OverdueCount)),
+		FxUnsupportedCount: ptr(int(summary.FXUnsupportedCount)),
+	}
+}
+
+func monthlyTrendToGenerated(points []services.MonthlyTrendPoint, currency, timezone string) generated.MonthlyTrend {
+	data := make([]generated.MonthlyTrendPoint, len(points))
+	for i, point := range points {
+		data[i] = generated.MonthlyTrendPoint{
+			Month:        ptr(point.Month),
+			StartDate:    ptr(point.StartDate),
+			EndDate:      ptr(point.EndDate),
+			TotalAmount:  ptr(point.TotalAmount),
+			PaidAmount:   ptr(point.PaidAmount),
+			UnpaidAmount: ptr(point.UnpaidAmount),
+			InvoiceCount: ptr(int(point.InvoiceCount)),
+		}
+	}
+
+	return generated.MonthlyTrend{
+		Currency: ptr(currency),
+		Timezone: ptr(timezone),
+		Data:     &data,
 	}
 }
 
@@ -266,11 +430,350 @@ func analyticsByGroupToGenerated(group *services.AnalyticsByGroup) generated.Ana
 		Period:        ptr(group.Period),
 		StartDate:     ptr(group.StartDate),
 		EndDate:       ptr(group.EndDate),
+		Currency:      ptr(group.Currency),
 		Items:         &items,
 		Uncategorized: uncategorized,
 	}
 }
 
+func receiverDetailToGenerated(detail *services.ReceiverDetail) generated.ReceiverDetail {
+	topInvoices := make([]generated.InvoiceAmountReference, len(detail.TopInvoices))
+	for i, invoice := range detail.TopInvoices {
+		topInvoices[i] = generated.InvoiceAmountReference{
+			Id:     ptr(int(invoice.ID)),
+			Title:  ptr(invoice.Title),
+			Amount: ptr(invoice.Amount),
+		}
+	}
+
+	return generated.ReceiverDetail{
+		ReceiverId:       ptr(int(detail.ReceiverID)),
+		Name:             ptr(detail.Name),
+		Period:           ptr(detail.Period),
+		StartDate:        ptr(detail.StartDate),
+		EndDate:          ptr(detail.EndDate),
+		Currency:         ptr(detail.Currency),
+		TotalAmount:      ptr(detail.TotalAmount),
+		PaidAmount:       ptr(detail.PaidAmount),
+		UnpaidAmount:     ptr(detail.UnpaidAmount),
+		InvoiceCount:     ptr(int(detail.InvoiceCount)),
+		PaidCount:        ptr(int(detail.PaidCount)),
+		UnpaidCount:      ptr(int(detail.UnpaidCount)),
+		AvgAmount:        ptr(detail.AvgAmount),
+		FirstInvoiceDate: detail.FirstInvoiceDate,
+		LastInvoiceDate:  detail.LastInvoiceDate,
+		TopInvoices:      &topInvoices,
+	}
+}
+
+func breakdownItemsToGenerated(items []services.BreakdownItem) []generated.BreakdownItem {
+	result := make([]generated.BreakdownItem, len(items))
+	for i, item := range items {
+		result[i] = generated.BreakdownItem{
+			Name:   ptr(item.Name),
+			Amount: ptr(item.Amount),
+			Count:  ptr(int(item.Count)),
+		}
+		if item.ID != 0 {
+			result[i].Id = ptr(int(item.ID))
+		}
+	}
+	return result
+}
+
+func companyBreakdownToGenerated(breakdown *services.CompanyBreakdown) generated.CompanyBreakdown {
+	receivers := breakdownItemsToGenerated(breakdown.Receivers)
+	categories := breakdownItemsToGenerated(breakdown.Categories)
+	return generated.CompanyBreakdown{
+		CompanyId:    ptr(int(breakdown.CompanyID)),
+		Name:         ptr(breakdown.Name),
+		Period:       ptr(breakdown.Period),
+		StartDate:    ptr(breakdown.StartDate),
+		EndDate:      ptr(breakdown.EndDate),
+		Currency:     ptr(breakdown.Currency),
+		TotalAmount:  ptr(breakdown.TotalAmount),
+		InvoiceCount: ptr(int(breakdown.InvoiceCount)),
+		Receivers:    &receivers,
+		Categories:   &categories,
+	}
+}
+
+func vendorStatementToGenerated(statement *services.VendorStatement) generated.VendorStatement {
+	invoices := make([]generated.VendorStatementEntry, len(statement.Invoices))
+	for i, entry := range statement.Invoices {
+		invoices[i] = generated.VendorStatementEntry{
+			InvoiceId:     ptr(int(entry.InvoiceID)),
+			InvoiceNumber: ptr(entry.InvoiceNumber),
+			Title:         ptr(entry.Title),
+			Date:          ptr(entry.Date),
+			Status:        ptr(entry.Status),
+			Currency:      ptr(entry.Currency),
+			Amount:        ptr(entry.Amount),
+			FxRateUsed:    ptr(entry.FXRateUsed),
+			Billed:        ptr(entry.Billed),
+			Paid:          ptr(entry.Paid),
+			Balance:       ptr(entry.Balance),
+		}
+	}
+
+	return generated.VendorStatement{
+		ReceiverId:     ptr(int(statement.ReceiverID)),
+		Name:           ptr(statement.Name),
+		Email:          ptrIfNotEmpty(statement.Email),
+		Phone:          ptrIfNotEmpty(statement.Phone),
+		Address:        ptrIfNotEmpty(statement.Address),
+		BankAccount:    ptrIfNotEmpty(statement.BankAccount),
+		TaxId:          ptrIfNotEmpty(statement.TaxID),
+		StartDate:      ptr(statement.StartDate),
+		EndDate:        ptr(statement.EndDate),
+		Currency:       ptr(statement.Currency),
+		OpeningBalance: ptr(statement.OpeningBalance),
+		TotalBilled:    ptr(statement.TotalBilled),
+		TotalPaid:      ptr(statement.TotalPaid),
+		Outstanding:    ptr(statement.Outstanding),
+		ClosingBalance: ptr(statement.ClosingBalance),
+		Invoices:       &invoices,
+	}
+}
+
+func userSettingsToGenerated(settings *models.UserSettings) generated.UserSettings {
+	result := generated.UserSettings{
+		BaseCurrency:         settings.BaseCurrency,
+		InvoiceNumberPrefix:  ptr(settings.InvoiceNumberPrefix),
+		InvoiceNumberPadding: ptr(settings.InvoiceNumberPadding),
+		Email:                ptrIfNotEmpty(settings.Email),
+		Timezone:             ptrIfNotEmpty(settings.Timezone),
+		CompanyName:          ptrIfNotEmpty(settings.CompanyName),
+		CompanyAddress:       ptrIfNotEmpty(settings.CompanyAddress),
+		LogoS3Key:            ptrIfNotEmpty(settings.LogoS3Key),
+		MaxItemUnitPrice:     ptr(settings.MaxItemUnitPrice),
+		MaxItemQuantity:      ptr(settings.MaxItemQuantity),
+		MaxItemLineAmount:    ptr(settings.MaxItemLineAmount),
+		UnsupportedCurrency:  ptr(generated.UnsupportedCurrencyPolicy(settings.UnsupportedCurrency)),
+		PaymentTrackingMode:  ptr(generated.PaymentTrackingMode(settings.PaymentTrackingMode)),
+	}
+	// Default settings have not been persisted yet
+	if settings.ID != 0 {
+		result.CreatedAt = ptr(settings.CreatedAt)
+		result.UpdatedAt = ptr(settings.UpdatedAt)
+	}
+	return result
+}
+
+// Budget converters
+
+func budgetModelToGenerated(budget *models.Budget) generated.Budget {
+	result := generated.Budget{
+		Id:         int(budget.ID),
+		CategoryId: int(budget.CategoryID),
+		PeriodType: generated.BudgetPeriod(budget.PeriodType),
+		Amount:     budget.Amount,
+		Currency:   budget.Currency,
+		CreatedAt:  ptr(budget.CreatedAt),
+		UpdatedAt:  ptr(budget.UpdatedAt),
+	}
+	if budget.Category != nil {
+		cat := categoryModelToGenerated(budget.Category)
+		result.Category = &cat
+	}
+	return result
+}
+
+func budgetListToGenerated(budgets []models.Budget) []generated.Budget {
+	result := make([]generated.Budget, len(budgets))
+	for i, budget := range budgets {
+		result[i] = budgetModelToGenerated(&budget)
+	}
+	return result
+}
+
+func budgetStatusListToGenerated(statuses []services.BudgetStatus) []generated.BudgetStatus {
+	result := make([]generated.BudgetStatus, len(statuses))
+	for i, status := range statuses {
+		result[i] = generated.BudgetStatus{
+			BudgetId:     ptr(int(status.BudgetID)),
+			CategoryId:   ptr(int(status.CategoryID)),
+			CategoryName: ptr(status.CategoryName),
+			PeriodType:   ptr(generated.BudgetPeriod(status.PeriodType)),
+			PeriodStart:  ptr(status.PeriodStart),
+			PeriodEnd:    ptr(status.PeriodEnd),
+			Currency:     ptr(status.Currency),
+			Amount:       ptr(status.Amount),
+			Spent:        ptr(status.Spent),
+			Remaining:    ptr(status.Remaining),
+			OverBudget:   ptr(status.OverBudget),
+		}
+	}
+	return result
+}
+
+// Backup converters
+
+func exportDocumentToGenerated(doc *services.ExportDocument) generated.ExportDocument {
+	invoices := invoiceListToGenerated(doc.Invoices)
+	// Export stored numbers only; the ID fallback would not survive remapping on import
+	for i := range doc.Invoices {
+		invoices[i].InvoiceNumber = ptrIfNotEmpty(doc.Invoices[i].InvoiceNumber)
+	}
+
+	return generated.ExportDocument{
+		SchemaVersion: doc.SchemaVersion,
+		ExportedAt:    ptr(doc.ExportedAt),
+		Categories:    ptr(categoryListToGenerated(doc.Categories)),
+		Companies:     ptr(companyListToGenerated(doc.Companies)),
+		Receivers:     ptr(receiverListToGenerated(doc.Receivers)),
+		Tags:          ptr(tagListToGenerated(doc.Tags)),
+		Invoices:      ptr(invoices),
+	}
+}
+
+func exportJobToGenerated(detail *services.ExportJobDetail) generated.ExportJob {
+	return generated.ExportJob{
+		Id:          int(detail.ID),
+		Status:      generated.ExportJobStatus(detail.Status),
+		Error:       ptrIfNotEmpty(detail.Error),
+		DownloadUrl: ptrIfNotEmpty(detail.DownloadURL),
+		CreatedAt:   detail.CreatedAt,
+		CompletedAt: detail.CompletedAt,
+	}
+}
+
+// exportDocumentFromGenerated converts an uploaded export document back into models.
+// IDs are kept as-is so the backup service can remap references.
+func exportDocumentFromGenerated(doc *generated.ExportDocument) *services.ExportDocument {
+	result := &services.ExportDocument{
+		SchemaVersion: doc.SchemaVersion,
+		ExportedAt:    deref(doc.ExportedAt),
+	}
+
+	for _, cat := range deref(doc.Categories) {
+		result.Categories = append(result.Categories, models.InvoiceCategory{
+			ID:              uint(derefInt(cat.Id, 0)),
+			Name:            deref(cat.Name),
+			Description:     deref(cat.Description),
+			Color:           deref(cat.Color),
+			DefaultCurrency: deref(cat.DefaultCurrency),
+			DefaultTaxRate:  deref(cat.DefaultTaxRate),
+			CreatedAt:       deref(cat.CreatedAt),
+		})
+	}
+
+	for _, comp := range deref(doc.Companies) {
+		result.Companies = append(result.Companies, models.InvoiceCompany{
+			ID:        uint(derefInt(comp.Id, 0)),
+			Name:      deref(comp.Name),
+			Address:   deref(comp.Address),
+			Email:     string(deref(comp.Email)),
+			Phone:     deref(comp.Phone),
+			Website:   deref(comp.Website),
+			TaxID:     deref(comp.TaxId),
+			Notes:     deref(comp.Notes),
+			CreatedAt: deref(comp.CreatedAt),
+		})
+	}
+
+	for _, rec := range deref(doc.Receivers) {
+		result.Receivers = append(result.Receivers, models.InvoiceReceiver{
+			ID:             uint(derefInt(rec.Id, 0)),
+			Name:           deref(rec.Name),
+			OtherNames:     models.StringArray(deref(rec.OtherNames)),
+			IsOrganization: deref(rec.IsOrganization),
+			Email:          deref(rec.Email),
+			Phone:          deref(rec.Phone),
+			Address:        deref(rec.Address),
+			BankAccount:    deref(rec.BankAccount),
+			TaxID:          deref(rec.TaxId),
+			CreatedAt:      deref(rec.CreatedAt),
+		})
+	}
+
+	for _, tag := range deref(doc.Tags) {
+		result.Tags = append(result.Tags, models.InvoiceTag{
+			ID:        uint(derefInt(tag.Id, 0)),
+			Name:      deref(tag.Name),
+			Color:     deref(tag.Color),
+			ParentID:  optionalID(tag.ParentId),
+			CreatedAt: deref(tag.CreatedAt),
+		})
+	}
+
+	for _, inv := range deref(doc.Invoices) {
+		invoice := models.Invoice{
+			ID:                   uint(derefInt(inv.Id, 0)),
+			InvoiceNumber:        deref(inv.InvoiceNumber),
+			Title:                deref(inv.Title),
+			Description:          deref(inv.Description),
+			InvoiceStartedAt:     inv.InvoiceStartedAt,
+			InvoiceEndedAt:       inv.InvoiceEndedAt,
+			Amount:               deref(inv.Amount),
+			Currency:             deref(inv.Currency),
+			DiscountType:         models.DiscountType(deref(inv.DiscountType)),
+			DiscountValue:        deref(inv.DiscountValue),
+			TaxRate:              inv.TaxRate,
+			OriginalDownloadLink: deref(inv.OriginalDownloadLink),
+			Status:               models.InvoiceStatus(deref(inv.Status)),
+			DueDate:              inv.DueDate,
+			PaidAt:               inv.PaidAt,
+			PaymentMethod:        deref(inv.PaymentMethod),
+			IsDraft:              deref(inv.IsDraft),
+			RelatedInvoiceID:     optionalID(inv.RelatedInvoiceId),
+			RelationType:         models.InvoiceRelationType(deref(inv.RelationType)),
+			CreatedAt:            deref(inv.CreatedAt),
+		}
+		if inv.CategoryId != nil {
+			id := uint(*inv.CategoryId)
+			invoice.CategoryID = &id
+		}
+		if inv.CompanyId != nil {
+			id := uint(*inv.CompanyId)
+			invoice.CompanyID = &id
+		}
+		if inv.ReceiverId != nil {
+			id := uint(*inv.ReceiverId)
+			invoice.ReceiverID = &id
+		}
+		for _, item := range deref(inv.Items) {
+			invoice.Items = append(invoice.Items, models.InvoiceItem{
+				Description:    deref(item.Description),
+				Quantity:       deref(item.Quantity),
+				Unit:           deref(item.Unit),
+				UnitPrice:      deref(item.UnitPrice),
+				DiscountType:   models.DiscountType(deref(item.DiscountType)),
+				DiscountValue:  deref(item.DiscountValue),
+				Currency:       deref(item.Currency),
+				CategoryID:     optionalID(item.CategoryId),
+				Position:       deref(item.Position),
+				TargetCurrency: deref(item.TargetCurrency),
+				TargetAmount:   deref(item.TargetAmount),
+				FXRateUsed:     deref(item.FxRateUsed),
+				FXStale:        deref(item.FxStale),
+				FXRateDate:     deref(item.FxRateDate),
+				FXManual:       deref(item.FxManual),
+				FXUnsupported:  deref(item.FxUnsupported),
+			})
+		}
+		for _, tag := range deref(inv.Tags) {
+			invoice.Tags = append(invoice.Tags, models.InvoiceTag{ID: uint(tag.Id), Name: tag.Name})
+		}
+		result.Invoices = append(result.Invoices, invoice)
+	}
+
+	return result
+}
+
+func importResultToGenerated(result *services.ImportResult) generated.ImportResult {
+	counts := func(c services.ImportCounts) generated.ImportCounts {
+		return generated.ImportCounts{Created: c.Created, Skipped: c.Skipped}
+	}
+	return generated.ImportResult{
+		Categories: counts(result.Categories),
+		Companies:  counts(result.Companies),
+		Receivers:  counts(result.Receivers),
+		Tags:       counts(result.Tags),
+		Invoices:   counts(result.Invoices),
+	}
+}
+
 // Period converter
 func periodParamToService(period string) services.AnalyticsPeriod {
 	switch period {
@@ -282,3 +785,85 @@ func periodParamToService(period string) services.AnalyticsPeriod {
 		return services.Period1Month
 	}
 }
+
+func healthStatusToGenerated(status *services.HealthStatus) generated.HealthStatus {
+	health := generated.HealthStatus{
+		Status: generated.HealthStatusStatusOk,
+	}
+	health.Database.Status = generated.HealthStatusDatabaseStatusOk
+	if !status.Healthy {
+		health.Status = generated.HealthStatusStatusUnavailable
+		health.Database.Status = generated.HealthStatusDatabaseStatusError
+		health.Database.Error = ptrIfNotEmpty(status.DatabaseError)
+	}
+	health.Fx.Configured = status.FXConfigured
+	health.Fx.LastSuccessfulFetch = status.FXLastSuccessfulFetch
+	health.Upload.Available = status.UploadAvailable
+	return health
+}
+
+func conversionPreviewToGenerated(preview *services.ConversionPreview) generated.ConversionPreview {
+	items := make([]generated.ItemConversionPreview, len(preview.Items))
+	for i, item := range preview.Items {
+		items[i] = generated.ItemConversionPreview{
+			ItemId:              int(item.ItemID),
+			Description:         item.Description,
+			Amount:              item.Amount,
+			Currency:            item.Currency,
+			CurrentTargetAmount: item.CurrentTargetAmount,
+			TargetAmount:        item.TargetAmount,
+			Rate:                item.Rate,
+			Stale:               ptr(item.Stale),
+		}
+	}
+	return generated.ConversionPreview{
+		FromCurrency: preview.FromCurrency,
+		ToCurrency:   preview.ToCurrency,
+		Rate:         preview.Rate,
+		Items:        items,
+		CurrentTotal: preview.CurrentTotal,
+		Total:        preview.Total,
+	}
+}
+
+func invoiceImportResultToGenerated(result *services.CSVImportResult) generated.InvoiceImportResult {
+	rows := make([]generated.InvoiceImportRow, len(result.Rows))
+	for i, row := range result.Rows {
+		rows[i] = generated.InvoiceImportRow{
+			Line:   row.Line,
+			Status: generated.InvoiceImportRowStatus(row.Status),
+			Error:  ptrIfNotEmpty(row.Error),
+		}
+		if row.InvoiceID != 0 {
+			rows[i].InvoiceId = ptr(int(row.InvoiceID))
+		}
+	}
+	return generated.InvoiceImportResult{
+		Created: result.Created,
+		Skipped: result.Skipped,
+		Failed:  result.Failed,
+		Rows:    rows,
+	}
+}
+
+// Organization converters
+
+func userOrganizationToGenerated(organization *services.UserOrganization) generated.Organization {
+	return generated.Organization{
+		Id:             int(organization.ID),
+		Name:           organization.Name,
+		PersonalUserId: organization.PersonalUserID,
+		Role:           ptr(generated.OrganizationRole(organization.Role)),
+		CreatedAt:      ptr(organization.CreatedAt),
+		UpdatedAt:      ptr(organization.UpdatedAt),
+	}
+}
+
+func organizationMemberToGenerated(member *models.OrganizationMember) generated.OrganizationMember {
+	return generated.OrganizationMember{
+		OrganizationId: int(member.OrganizationID),
+		UserId:         member.UserID,
+		Role:           generated.OrganizationRole(member.Role),
+		CreatedAt:      ptr(member.CreatedAt),
+	}
+}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/rxtech-lab/invoice-management/internal/api/generated"
 	"github.com/rxtech-lab/invoice-management/internal/services"
@@ -20,16 +21,22 @@ var (
 
 // StrictHandlers implements the generated StrictServerInterface
 type StrictHandlers struct {
-	categoryService   services.CategoryService
-	companyService    services.CompanyService
-	receiverService   services.ReceiverService
-	tagService        services.TagService
-	invoiceService    services.InvoiceService
-	uploadService     services.UploadService
-	fileUploadService services.FileUploadService
-	analyticsService  services.AnalyticsService
-	fileUnlinkService services.FileUnlinkService
-	pdfService        services.PDFService
+	categoryService     services.CategoryService
+	companyService      services.CompanyService
+	receiverService     services.ReceiverService
+	tagService          services.TagService
+	invoiceService      services.InvoiceService
+	uploadService       services.UploadService
+	fileUploadService   services.FileUploadService
+	analyticsService    services.AnalyticsService
+	settingsService     services.SettingsService
+	budgetService       services.BudgetService
+	backupService       services.BackupService
+	exportService       services.ExportService
+	fileUnlinkService   services.FileUnlinkService
+	pdfService          services.PDFService
+	healthService       services.HealthService
+	organizationService services.OrganizationService
 }
 
 // NewStrictHandlers creates a new StrictHandlers instance
@@ -42,20 +49,32 @@ func NewStrictHandlers(
 	uploadService services.UploadService,
 	fileUploadService services.FileUploadService,
 	analyticsService services.AnalyticsService,
+	settingsService services.SettingsService,
+	budgetService services.BudgetService,
+	backupService services.BackupService,
+	exportService services.ExportService,
 	fileUnlinkService services.FileUnlinkService,
 	pdfService services.PDFService,
+	healthService services.HealthService,
+	organizationService services.OrganizationService,
 ) *StrictHandlers {
 	return &StrictHandlers{
-		categoryService:   categoryService,
-		companyService:    companyService,
-		receiverService:   receiverService,
-		tagService:        tagService,
-		invoiceService:    invoiceService,
-		uploadService:     uploadService,
-		fileUploadService: fileUploadService,
-		analyticsService:  analyticsService,
-		fileUnlinkService: fileUnlinkService,
-		pdfService:        pdfService,
+		categoryService:     categoryService,
+		companyService:      companyService,
+		receiverService:     receiverService,
+		tagService:          tagService,
+		invoiceService:      invoiceService,
+		uploadService:       uploadService,
+		fileUploadService:   fileUploadService,
+		analyticsService:    analyticsService,
+		settingsService:     settingsService,
+		budgetService:       budgetService,
+		backupService:       backupService,
+		exportService:       exportService,
+		fileUnlinkService:   fileUnlinkService,
+		pdfService:          pdfService,
+		healthService:       healthService,
+		organizationService: organizationService,
 	}
 }
 
@@ -81,6 +100,26 @@ func ptrIfNotEmpty(s string) *string {
 	return &s
 }
 
+// optionalID converts an optional request ID to a model foreign key; nil and 0 both mean unset
+func optionalID(id *int) *uint {
+	if id == nil || *id <= 0 {
+		return nil
+	}
+	value := uint(*id)
+	return &value
+}
+
+// splitList splits a comma-separated query value, trimming spaces and dropping empty entries
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 // deref safely dereferences a pointer, returning zero value if nil
 func deref[T any](p *T) T {
 	if p == nil {
@@ -98,6 +137,26 @@ func derefInt(p *int, defaultVal int) int {
 	return *p
 }
 
+// pagination builds the paging metadata included in every list response.
+// A limit of 0 or less means the page holds every row.
+func pagination(limit, offset int, total int64) *generated.Pagination {
+	p := &generated.Pagination{
+		Limit:  limit,
+		Offset: offset,
+		Total:  int(total),
+	}
+	if limit <= 0 {
+		if total > 0 {
+			p.TotalPages = 1
+		}
+		return p
+	}
+
+	p.TotalPages = int((total + int64(limit) - 1) / int64(limit))
+	p.HasMore = int64(offset+limit) < total
+	return p
+}
+
 // Error response helpers
 
 func unauthorized() generated.UnauthorizedJSONResponse {
@@ -111,3 +170,7 @@ func badRequest(msg string) generated.BadRequestJSONResponse {
 func notFound(msg string) generated.NotFoundJSONResponse {
 	return generated.NotFoundJSONResponse{Error: ptr(msg)}
 }
+
+func inUse(err *services.InUseError) generated.InUseJSONResponse {
+	return generated.InUseJSONResponse{Error: ptr(err.Error()), InvoiceCount: ptr(int(err.InvoiceCount))}
+}
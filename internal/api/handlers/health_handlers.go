@@ -7,11 +7,14 @@ import (
 )
 
 // HealthCheck implements generated.StrictServerInterface
+// Returns 503 when the database is unreachable
 func (h *StrictHandlers) HealthCheck(
 	ctx context.Context,
 	request generated.HealthCheckRequestObject,
 ) (generated.HealthCheckResponseObject, error) {
-	return generated.HealthCheck200JSONResponse{
-		Status: ptr("ok"),
-	}, nil
+	health := healthStatusToGenerated(h.healthService.Check(ctx))
+	if health.Status != generated.HealthStatusStatusOk {
+		return generated.HealthCheck503JSONResponse(health), nil
+	}
+	return generated.HealthCheck200JSONResponse(health), nil
 }
@@ -2,7 +2,11 @@ package handlers
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/rxtech-lab/invoice-management/internal/api/generated"
@@ -22,11 +26,12 @@ func (h *StrictHandlers) ListInvoices(
 	}
 
 	opts := services.InvoiceListOptions{
-		Keyword:   deref(request.Params.Keyword),
-		Limit:     derefInt(request.Params.Limit, 50),
-		Offset:    derefInt(request.Params.Offset, 0),
-		SortBy:    "created_at",
-		SortOrder: "desc",
+		Keyword:        deref(request.Params.Keyword),
+		ExcludeKeyword: deref(request.Params.ExcludeKeyword),
+		Limit:          derefInt(request.Params.Limit, 50),
+		Offset:         derefInt(request.Params.Offset, 0),
+		SortBy:         "created_at",
+		SortOrder:      "desc",
 	}
 
 	if request.Params.CategoryId != nil {
@@ -41,32 +46,150 @@ func (h *StrictHandlers) ListInvoices(
 		id := uint(*request.Params.ReceiverId)
 		opts.ReceiverID = &id
 	}
+	if request.Params.OrganizationId != nil {
+		id := uint(*request.Params.OrganizationId)
+		opts.OrganizationID = &id
+	}
 	if request.Params.Status != nil {
 		status := models.InvoiceStatus(*request.Params.Status)
 		opts.Status = &status
 	}
+	opts.PaymentMethod = request.Params.PaymentMethod
+	opts.IncludeDrafts = deref(request.Params.IncludeDrafts)
 	if request.Params.SortBy != nil {
 		opts.SortBy = string(*request.Params.SortBy)
 	}
 	if request.Params.SortOrder != nil {
 		opts.SortOrder = string(*request.Params.SortOrder)
 	}
+	if request.Params.Cursor != nil {
+		opts.Cursor = *request.Params.Cursor
+	}
+	if request.Params.CursorDirection != nil {
+		opts.CursorDirection = string(*request.Params.CursorDirection)
+	}
+	opts.MinAmount = request.Params.MinAmount
+	opts.MaxAmount = request.Params.MaxAmount
+	if request.Params.AmountField != nil {
+		switch *request.Params.AmountField {
+		case generated.ListInvoicesParamsAmountFieldTargetAmount, generated.ListInvoicesParamsAmountFieldAmount:
+			opts.AmountField = string(*request.Params.AmountField)
+		default:
+			return generated.ListInvoices400JSONResponse{BadRequestJSONResponse: badRequest("amount_field must be target_amount or amount")}, nil
+		}
+	}
 
-	invoices, total, err := h.invoiceService.ListInvoices(userID, opts)
+	if request.Params.TagMatch != nil {
+		switch *request.Params.TagMatch {
+		case generated.Any, generated.All:
+			opts.TagMatch = string(*request.Params.TagMatch)
+		default:
+			return generated.ListInvoices400JSONResponse{BadRequestJSONResponse: badRequest("tag_match must be any or all")}, nil
+		}
+	}
+	tagIDs, unknownTags, err := h.resolveTagFilter(userID, request.Params.TagIds, request.Params.Tags)
 	if err != nil {
-		return nil, err
+		return generated.ListInvoices400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
 	}
+	opts.TagIDs = tagIDs
+	// Unknown tag names match no invoice: with "all" nothing can match, and with "any"
+	// nothing can match if none of the names exist
+	noTagMatch := unknownTags > 0 && (opts.TagMatch == services.TagMatchAll || len(tagIDs) == 0)
 
-	data := invoiceListToGenerated(invoices)
+	if request.Params.Expand != nil {
+		load, err := services.ParseInvoiceLoadOptions(*request.Params.Expand)
+		if err != nil {
+			return generated.ListInvoices400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		}
+		opts.Load = &load
+	}
+	if request.Params.Locale != nil && !utils.IsValidLocale(*request.Params.Locale) {
+		return generated.ListInvoices400JSONResponse{BadRequestJSONResponse: badRequest("invalid locale: " + *request.Params.Locale)}, nil
+	}
+
+	if _, err := services.ParseInvoiceSort(opts.SortBy, opts.SortOrder); err != nil {
+		return generated.ListInvoices400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+
+	if opts.Cursor != "" || opts.CursorDirection != "" {
+		// Cursor mode ignores offset
+		opts.Offset = 0
+	}
+
+	page := &services.InvoicePage{}
+	if !noTagMatch {
+		page, err = h.invoiceService.ListInvoicesPage(userID, opts)
+		if err != nil {
+			if opts.Cursor != "" {
+				return generated.ListInvoices400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+			}
+			return nil, err
+		}
+	}
+
+	data := invoiceListToGenerated(page.Invoices)
+	if opts.Load != nil && !opts.Load.Items {
+		// target_amount is computed from the items, which weren't loaded
+		for i := range data {
+			data[i].TargetAmount = nil
+		}
+	}
+	if request.Params.Locale != nil {
+		for i := range data {
+			formatInvoiceAmounts(&data[i], &page.Invoices[i], *request.Params.Locale)
+		}
+	}
+	paging := pagination(opts.Limit, opts.Offset, page.Total)
+	if opts.Cursor != "" || opts.CursorDirection != "" {
+		paging.HasMore = page.NextCursor != ""
+	}
 
 	return generated.ListInvoices200JSONResponse{
-		Data:   &data,
-		Total:  ptr(int(total)),
-		Limit:  ptr(opts.Limit),
-		Offset: ptr(opts.Offset),
+		Data:              &data,
+		Total:             ptr(int(page.Total)),
+		TotalAmount:       ptr(page.TotalAmount),
+		TotalTargetAmount: ptr(page.TotalTargetAmount),
+		Limit:             ptr(opts.Limit),
+		Offset:            ptr(opts.Offset),
+		NextCursor:        ptrIfNotEmpty(page.NextCursor),
+		Pagination:        paging,
 	}, nil
 }
 
+// resolveTagFilter combines comma-separated tag IDs and tag names into tag IDs, returning
+// the number of names that don't match any of the user's tags
+func (h *StrictHandlers) resolveTagFilter(userID string, tagIDs, tagNames *string) ([]uint, int, error) {
+	var ids []uint
+	for _, value := range splitList(deref(tagIDs)) {
+		id, err := strconv.ParseUint(value, 10, 64)
+		if err != nil || id == 0 {
+			return nil, 0, fmt.Errorf("invalid tag id %q", value)
+		}
+		ids = append(ids, uint(id))
+	}
+
+	names := splitList(deref(tagNames))
+	if len(names) == 0 {
+		return ids, 0, nil
+	}
+	tags, err := h.tagService.GetTagsByNames(userID, names)
+	if err != nil {
+		return nil, 0, err
+	}
+	found := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		ids = append(ids, tag.ID)
+		found[tag.Name] = true
+	}
+	unknown := 0
+	for _, name := range names {
+		if !found[name] {
+			unknown++
+		}
+	}
+	return ids, unknown, nil
+}
+
 // CreateInvoice implements generated.StrictServerInterface
 func (h *StrictHandlers) CreateInvoice(
 	ctx context.Context,
@@ -82,13 +205,14 @@ func (h *StrictHandlers) CreateInvoice(
 	}
 
 	invoice := &models.Invoice{
-		Title:       request.Body.Title,
-		Description: deref(request.Body.Description),
-		Currency:    deref(request.Body.Currency),
-	}
-
-	if invoice.Currency == "" {
-		invoice.Currency = "USD"
+		Title:         request.Body.Title,
+		Description:   deref(request.Body.Description),
+		Currency:      deref(request.Body.Currency),
+		DiscountType:  models.DiscountType(deref(request.Body.DiscountType)),
+		DiscountValue: deref(request.Body.DiscountValue),
+		TaxRate:       request.Body.TaxRate,
+		PaymentMethod: deref(request.Body.PaymentMethod),
+		IsDraft:       deref(request.Body.IsDraft),
 	}
 
 	if request.Body.InvoiceStartedAt != nil {
@@ -109,6 +233,10 @@ func (h *StrictHandlers) CreateInvoice(
 		id := uint(*request.Body.ReceiverId)
 		invoice.ReceiverID = &id
 	}
+	if request.Body.OrganizationId != nil {
+		id := uint(*request.Body.OrganizationId)
+		invoice.OrganizationID = &id
+	}
 	if request.Body.OriginalDownloadLink != nil {
 		invoice.OriginalDownloadLink = *request.Body.OriginalDownloadLink
 	}
@@ -126,12 +254,14 @@ func (h *StrictHandlers) CreateInvoice(
 	if request.Body.Items != nil {
 		for _, item := range *request.Body.Items {
 			invoiceItem := models.InvoiceItem{
-				Description: item.Description,
-				Quantity:    deref(item.Quantity),
-				UnitPrice:   deref(item.UnitPrice),
-			}
-			if invoiceItem.Quantity == 0 {
-				invoiceItem.Quantity = 1
+				Description:   item.Description,
+				Quantity:      models.ItemQuantity(item.Quantity, deref(item.UnitPrice)),
+				Unit:          deref(item.Unit),
+				UnitPrice:     deref(item.UnitPrice),
+				Currency:      deref(item.Currency),
+				CategoryID:    optionalID(item.CategoryId),
+				DiscountType:  models.DiscountType(deref(item.DiscountType)),
+				DiscountValue: deref(item.DiscountValue),
 			}
 			invoice.Items = append(invoice.Items, invoiceItem)
 		}
@@ -142,9 +272,19 @@ func (h *StrictHandlers) CreateInvoice(
 		return generated.CreateInvoice400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
 	}
 
+	// Items not adding up to the expected amount are reported, not rejected
+	var warnings *[]string
+	if request.Body.ExpectedAmount != nil {
+		if warning := services.ExpectedAmountWarning(result.Invoice, *request.Body.ExpectedAmount); warning != "" {
+			warnings = &[]string{warning}
+		}
+	}
+
 	// If duplicate found, return existing invoice
 	if result.IsDuplicate {
-		return generated.CreateInvoice201JSONResponse(invoiceModelToGenerated(result.Invoice)), nil
+		response := invoiceModelToGenerated(result.Invoice)
+		response.Warnings = warnings
+		return generated.CreateInvoice201JSONResponse(response), nil
 	}
 
 	// Set tags if provided (only for newly created invoices)
@@ -156,7 +296,9 @@ func (h *StrictHandlers) CreateInvoice(
 
 	// Reload to get relationships
 	created, _ := h.invoiceService.GetInvoiceByID(userID, result.Invoice.ID)
-	return generated.CreateInvoice201JSONResponse(invoiceModelToGenerated(created)), nil
+	response := invoiceModelToGenerated(created)
+	response.Warnings = warnings
+	return generated.CreateInvoice201JSONResponse(response), nil
 }
 
 // GetInvoice implements generated.StrictServerInterface
@@ -169,12 +311,50 @@ func (h *StrictHandlers) GetInvoice(
 		return generated.GetInvoice401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
 	}
 
-	invoice, err := h.invoiceService.GetInvoiceByID(userID, uint(request.Id))
+	load := services.AllInvoiceRelations()
+	if request.Params.Expand != nil {
+		load, err = services.ParseInvoiceLoadOptions(*request.Params.Expand)
+		if err != nil {
+			return generated.GetInvoice400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		}
+	}
+	if request.Params.Locale != nil && !utils.IsValidLocale(*request.Params.Locale) {
+		return generated.GetInvoice400JSONResponse{BadRequestJSONResponse: badRequest("invalid locale: " + *request.Params.Locale)}, nil
+	}
+
+	invoice, err := h.invoiceService.GetInvoiceByIDWithOptions(userID, uint(request.Id), load)
 	if err != nil {
 		return generated.GetInvoice404JSONResponse{NotFoundJSONResponse: notFound("Invoice not found")}, nil
 	}
 
-	return generated.GetInvoice200JSONResponse(invoiceModelToGenerated(invoice)), nil
+	result := invoiceModelToGenerated(invoice)
+	if !load.Items {
+		// target_amount is computed from the items, which weren't loaded
+		result.TargetAmount = nil
+	}
+	if request.Params.IncludeAmountInWords != nil && *request.Params.IncludeAmountInWords && !invoice.AmountCurrencyMixed {
+		result.AmountInWords = ptrIfNotEmpty(utils.AmountInWords(invoice.Amount, invoice.Currency))
+	}
+	if request.Params.Locale != nil {
+		formatInvoiceAmounts(&result, invoice, *request.Params.Locale)
+	}
+	return generated.GetInvoice200JSONResponse(result), nil
+}
+
+// formatInvoiceAmounts sets the amounts of an invoice response formatted for display in locale.
+// Like amount_in_words, the amount is left out when the items mix currencies.
+func formatInvoiceAmounts(result *generated.Invoice, invoice *models.Invoice, locale string) {
+	if !invoice.AmountCurrencyMixed {
+		result.AmountFormatted = ptr(utils.FormatCurrency(invoice.Amount, invoice.Currency, locale))
+	}
+	if result.TargetAmount != nil {
+		// target_amount falls back to the invoice amount when there are no items
+		targetCurrency := invoice.Currency
+		if len(invoice.Items) > 0 && invoice.Items[0].TargetCurrency != "" {
+			targetCurrency = invoice.Items[0].TargetCurrency
+		}
+		result.TargetAmountFormatted = ptr(utils.FormatCurrency(*result.TargetAmount, targetCurrency, locale))
+	}
 }
 
 // UpdateInvoice implements generated.StrictServerInterface
@@ -231,8 +411,27 @@ func (h *StrictHandlers) UpdateInvoice(
 	if request.Body.DueDate != nil {
 		existing.DueDate = request.Body.DueDate
 	}
+	if request.Body.PaymentMethod != nil {
+		existing.PaymentMethod = *request.Body.PaymentMethod
+	}
+	if request.Body.DiscountType != nil {
+		existing.DiscountType = models.DiscountType(*request.Body.DiscountType)
+	}
+	if request.Body.DiscountValue != nil {
+		existing.DiscountValue = *request.Body.DiscountValue
+	}
+	if request.Body.TaxRate != nil {
+		existing.TaxRate = request.Body.TaxRate
+	}
+	if request.Body.Version != nil {
+		existing.Version = *request.Body.Version
+	}
 
 	if err := h.invoiceService.UpdateInvoice(userID, existing); err != nil {
+		var conflictErr *services.VersionConflictError
+		if errors.As(err, &conflictErr) {
+			return generated.UpdateInvoice409JSONResponse{Error: ptr(err.Error())}, nil
+		}
 		return generated.UpdateInvoice400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
 	}
 
@@ -269,6 +468,11 @@ func (h *StrictHandlers) DeleteInvoice(
 		// This ensures invoice deletion succeeds even if file server is unavailable
 	}
 
+	// Remove and unlink all additional attachments (unlink failures are logged by the service)
+	if err := h.fileUploadService.RemoveInvoiceAttachments(ctx, userID, uint(request.Id), authHeader); err != nil {
+		log.Printf("Warning: Failed to remove attachments for invoice %d: %v", request.Id, err)
+	}
+
 	if err := h.invoiceService.DeleteInvoice(userID, uint(request.Id)); err != nil {
 		return generated.DeleteInvoice404JSONResponse{NotFoundJSONResponse: notFound(err.Error())}, nil
 	}
@@ -295,6 +499,234 @@ func (h *StrictHandlers) UpdateInvoiceStatus(
 	return generated.UpdateInvoiceStatus200JSONResponse(invoiceModelToGenerated(invoice)), nil
 }
 
+// FinalizeInvoice implements generated.StrictServerInterface
+func (h *StrictHandlers) FinalizeInvoice(
+	ctx context.Context,
+	request generated.FinalizeInvoiceRequestObject,
+) (generated.FinalizeInvoiceResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.FinalizeInvoice401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if _, err := h.invoiceService.GetInvoiceByIDWithOptions(userID, uint(request.Id), services.InvoiceLoadOptions{}); err != nil {
+		return generated.FinalizeInvoice404JSONResponse{NotFoundJSONResponse: notFound("Invoice not found")}, nil
+	}
+	if err := h.invoiceService.FinalizeInvoice(userID, uint(request.Id)); err != nil {
+		return generated.FinalizeInvoice400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+
+	invoice, _ := h.invoiceService.GetInvoiceByID(userID, uint(request.Id))
+	return generated.FinalizeInvoice200JSONResponse(invoiceModelToGenerated(invoice)), nil
+}
+
+// ImportInvoices implements generated.StrictServerInterface
+func (h *StrictHandlers) ImportInvoices(
+	ctx context.Context,
+	request generated.ImportInvoicesRequestObject,
+) (generated.ImportInvoicesResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.ImportInvoices401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if format := deref(request.Params.Format); format != "" && format != generated.Csv {
+		return generated.ImportInvoices400JSONResponse{BadRequestJSONResponse: badRequest(fmt.Sprintf("Unsupported format %q", format))}, nil
+	}
+
+	file, err := request.Body.NextPart()
+	if err != nil {
+		return generated.ImportInvoices400JSONResponse{BadRequestJSONResponse: badRequest("No file provided")}, nil
+	}
+	defer file.Close()
+
+	result, err := h.invoiceService.ImportCSV(userID, file, deref(request.Params.CreateMissing))
+	if err != nil {
+		return generated.ImportInvoices400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+
+	return generated.ImportInvoices200JSONResponse(invoiceImportResultToGenerated(result)), nil
+}
+
+// RecalculateInvoiceTotals implements generated.StrictServerInterface
+func (h *StrictHandlers) RecalculateInvoiceTotals(
+	ctx context.Context,
+	request generated.RecalculateInvoiceTotalsRequestObject,
+) (generated.RecalculateInvoiceTotalsResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.RecalculateInvoiceTotals401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	recalculation, err := h.invoiceService.RecalculateTotals(userID, uint(request.Id))
+	if err != nil {
+		return generated.RecalculateInvoiceTotals404JSONResponse{NotFoundJSONResponse: notFound("Invoice not found")}, nil
+	}
+
+	return generated.RecalculateInvoiceTotals200JSONResponse{
+		InvoiceId:          int(recalculation.InvoiceID),
+		AmountBefore:       recalculation.AmountBefore,
+		AmountAfter:        recalculation.AmountAfter,
+		TargetAmountBefore: recalculation.TargetAmountBefore,
+		TargetAmountAfter:  recalculation.TargetAmountAfter,
+	}, nil
+}
+
+// GetInvoiceAuditTrail implements generated.StrictServerInterface
+func (h *StrictHandlers) GetInvoiceAuditTrail(
+	ctx context.Context,
+	request generated.GetInvoiceAuditTrailRequestObject,
+) (generated.GetInvoiceAuditTrailResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.GetInvoiceAuditTrail401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	opts := auditTrailOptions((*string)(request.Params.Action), request.Params.Start, request.Params.End, request.Params.Limit, request.Params.Offset)
+	if err := opts.Validate(); err != nil {
+		return generated.GetInvoiceAuditTrail400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+
+	entries, total, err := h.invoiceService.GetAuditTrail(userID, uint(request.Id), opts)
+	if errors.Is(err, services.ErrInvoiceNotFound) {
+		return generated.GetInvoiceAuditTrail404JSONResponse{NotFoundJSONResponse: notFound("Invoice not found")}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return generated.GetInvoiceAuditTrail200JSONResponse{
+		Data:       auditLogListToGenerated(entries),
+		Pagination: *pagination(opts.Limit, opts.Offset, total),
+	}, nil
+}
+
+// GetInvoiceStatusHistory implements generated.StrictServerInterface
+func (h *StrictHandlers) GetInvoiceStatusHistory(
+	ctx context.Context,
+	request generated.GetInvoiceStatusHistoryRequestObject,
+) (generated.GetInvoiceStatusHistoryResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.GetInvoiceStatusHistory401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	opts := auditTrailOptions((*string)(request.Params.Action), request.Params.Start, request.Params.End, request.Params.Limit, request.Params.Offset)
+	if err := opts.Validate(); err != nil {
+		return generated.GetInvoiceStatusHistory400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+
+	entries, total, err := h.invoiceService.GetStatusHistory(userID, uint(request.Id), opts)
+	if errors.Is(err, services.ErrInvoiceNotFound) {
+		return generated.GetInvoiceStatusHistory404JSONResponse{NotFoundJSONResponse: notFound("Invoice not found")}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return generated.GetInvoiceStatusHistory200JSONResponse{
+		Data:       auditLogListToGenerated(entries),
+		Pagination: *pagination(opts.Limit, opts.Offset, total),
+	}, nil
+}
+
+// auditTrailOptions builds the filters of an audit listing from its query parameters. Without a
+// limit every entry is returned, as the audit trail did before it was paged.
+func auditTrailOptions(action *string, start, end *time.Time, limit, offset *int) services.AuditTrailOptions {
+	return services.AuditTrailOptions{
+		Action: deref(action),
+		Start:  start,
+		End:    end,
+		Limit:  derefInt(limit, 0),
+		Offset: derefInt(offset, 0),
+	}
+}
+
+// FindSimilarInvoices implements generated.StrictServerInterface
+func (h *StrictHandlers) FindSimilarInvoices(
+	ctx context.Context,
+	request generated.FindSimilarInvoicesRequestObject,
+) (generated.FindSimilarInvoicesResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.FindSimilarInvoices401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if _, err := h.invoiceService.GetInvoiceByIDWithOptions(userID, uint(request.Id), services.InvoiceLoadOptions{}); err != nil {
+		return generated.FindSimilarInvoices404JSONResponse{NotFoundJSONResponse: notFound("Invoice not found")}, nil
+	}
+
+	invoices, err := h.invoiceService.FindSimilar(userID, uint(request.Id), derefInt(request.Params.Limit, services.DefaultSimilarLimit))
+	if err != nil {
+		return generated.FindSimilarInvoices400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+
+	return generated.FindSimilarInvoices200JSONResponse{
+		Data: invoiceListToGenerated(invoices),
+	}, nil
+}
+
+// PreviewCurrencyConversion implements generated.StrictServerInterface
+func (h *StrictHandlers) PreviewCurrencyConversion(
+	ctx context.Context,
+	request generated.PreviewCurrencyConversionRequestObject,
+) (generated.PreviewCurrencyConversionResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.PreviewCurrencyConversion401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if _, err := h.invoiceService.GetInvoiceByIDWithOptions(userID, uint(request.Id), services.InvoiceLoadOptions{}); err != nil {
+		return generated.PreviewCurrencyConversion404JSONResponse{NotFoundJSONResponse: notFound("Invoice not found")}, nil
+	}
+
+	preview, err := h.invoiceService.PreviewCurrencyChange(ctx, userID, uint(request.Id), request.Body.Currency)
+	if err != nil {
+		return generated.PreviewCurrencyConversion400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+
+	return generated.PreviewCurrencyConversion200JSONResponse(conversionPreviewToGenerated(preview)), nil
+}
+
+// CloneInvoice implements generated.StrictServerInterface
+func (h *StrictHandlers) CloneInvoice(
+	ctx context.Context,
+	request generated.CloneInvoiceRequestObject,
+) (generated.CloneInvoiceResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.CloneInvoice401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if _, err := h.invoiceService.GetInvoiceByID(userID, uint(request.Id)); err != nil {
+		return generated.CloneInvoice404JSONResponse{NotFoundJSONResponse: notFound("Invoice not found")}, nil
+	}
+
+	var overrides services.CloneOptions
+	if request.Body != nil {
+		overrides.Title = request.Body.Title
+		overrides.InvoiceStartedAt = request.Body.InvoiceStartedAt
+		overrides.InvoiceEndedAt = request.Body.InvoiceEndedAt
+		overrides.DueDate = request.Body.DueDate
+		overrides.TargetCurrency = request.Body.TargetCurrency
+		if request.Body.Status != nil {
+			status := models.InvoiceStatus(*request.Body.Status)
+			overrides.Status = &status
+		}
+	}
+
+	clone, err := h.invoiceService.CloneInvoice(userID, uint(request.Id), overrides)
+	if err != nil {
+		var duplicateErr *services.DuplicateInvoiceError
+		if errors.As(err, &duplicateErr) {
+			return generated.CloneInvoice409JSONResponse{Error: ptr(err.Error())}, nil
+		}
+		return generated.CloneInvoice400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+
+	return generated.CloneInvoice201JSONResponse(invoiceModelToGenerated(clone)), nil
+}
+
 // Helper to convert string to time if needed
 func parseTime(s string) *time.Time {
 	if s == "" {
@@ -306,3 +738,99 @@ func parseTime(s string) *time.Time {
 	}
 	return &t
 }
+
+// ListPaymentMethods implements generated.StrictServerInterface
+func (h *StrictHandlers) ListPaymentMethods(
+	ctx context.Context,
+	request generated.ListPaymentMethodsRequestObject,
+) (generated.ListPaymentMethodsResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.ListPaymentMethods401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	methods, err := h.invoiceService.ListPaymentMethods(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return generated.ListPaymentMethods200JSONResponse{Data: methods}, nil
+}
+
+// GetInvoiceFacets implements generated.StrictServerInterface
+func (h *StrictHandlers) GetInvoiceFacets(
+	ctx context.Context,
+	request generated.GetInvoiceFacetsRequestObject,
+) (generated.GetInvoiceFacetsResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.GetInvoiceFacets401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	facets, err := h.invoiceService.GetFacets(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return generated.GetInvoiceFacets200JSONResponse(invoiceFacetsToGenerated(facets)), nil
+}
+
+// GetInvoiceStatusCounts implements generated.StrictServerInterface
+func (h *StrictHandlers) GetInvoiceStatusCounts(
+	ctx context.Context,
+	request generated.GetInvoiceStatusCountsRequestObject,
+) (generated.GetInvoiceStatusCountsResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.GetInvoiceStatusCounts401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	counts, err := h.invoiceService.CountByStatus(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return generated.GetInvoiceStatusCounts200JSONResponse{
+		Paid:    counts[models.InvoiceStatusPaid],
+		Unpaid:  counts[models.InvoiceStatusUnpaid],
+		Overdue: counts[models.InvoiceStatusOverdue],
+	}, nil
+}
+
+// LookupInvoices implements generated.StrictServerInterface
+func (h *StrictHandlers) LookupInvoices(
+	ctx context.Context,
+	request generated.LookupInvoicesRequestObject,
+) (generated.LookupInvoicesResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.LookupInvoices401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	number := strings.TrimSpace(deref(request.Params.Number))
+	link := strings.TrimSpace(deref(request.Params.Link))
+	if (number == "") == (link == "") {
+		return generated.LookupInvoices400JSONResponse{BadRequestJSONResponse: badRequest("Exactly one of number and link is required")}, nil
+	}
+
+	var invoices []models.Invoice
+	if number != "" {
+		var invoice *models.Invoice
+		if invoice, err = h.invoiceService.GetByNumber(userID, number); err == nil {
+			invoices = []models.Invoice{*invoice}
+		}
+	} else {
+		invoices, err = h.invoiceService.GetByOriginalLink(userID, link)
+	}
+	if errors.Is(err, services.ErrInvoiceNotFound) {
+		return generated.LookupInvoices404JSONResponse{NotFoundJSONResponse: notFound(err.Error())}, nil
+	}
+	if errors.Is(err, services.ErrAmbiguousInvoiceNumber) {
+		return generated.LookupInvoices409JSONResponse{Error: ptr(err.Error())}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return generated.LookupInvoices200JSONResponse{Data: invoiceListToGenerated(invoices)}, nil
+}
@@ -18,13 +18,14 @@ func (h *StrictHandlers) AddInvoiceItem(
 	}
 
 	item := &models.InvoiceItem{
-		Description: request.Body.Description,
-		Quantity:    deref(request.Body.Quantity),
-		UnitPrice:   deref(request.Body.UnitPrice),
-	}
-
-	if item.Quantity == 0 {
-		item.Quantity = 1
+		Description:   request.Body.Description,
+		Quantity:      models.ItemQuantity(request.Body.Quantity, deref(request.Body.UnitPrice)),
+		Unit:          deref(request.Body.Unit),
+		UnitPrice:     deref(request.Body.UnitPrice),
+		Currency:      deref(request.Body.Currency),
+		CategoryID:    optionalID(request.Body.CategoryId),
+		DiscountType:  models.DiscountType(deref(request.Body.DiscountType)),
+		DiscountValue: deref(request.Body.DiscountValue),
 	}
 
 	if err := h.invoiceService.AddInvoiceItem(userID, uint(request.Id), item); err != nil {
@@ -34,6 +35,72 @@ func (h *StrictHandlers) AddInvoiceItem(
 	return generated.AddInvoiceItem201JSONResponse(invoiceItemModelToGenerated(item)), nil
 }
 
+// AddInvoiceItems implements generated.StrictServerInterface
+func (h *StrictHandlers) AddInvoiceItems(
+	ctx context.Context,
+	request generated.AddInvoiceItemsRequestObject,
+) (generated.AddInvoiceItemsResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.AddInvoiceItems401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	items := make([]models.InvoiceItem, len(request.Body.Items))
+	for i, body := range request.Body.Items {
+		items[i] = models.InvoiceItem{
+			Description:   body.Description,
+			Quantity:      models.ItemQuantity(body.Quantity, deref(body.UnitPrice)),
+			Unit:          deref(body.Unit),
+			UnitPrice:     deref(body.UnitPrice),
+			Currency:      deref(body.Currency),
+			CategoryID:    optionalID(body.CategoryId),
+			DiscountType:  models.DiscountType(deref(body.DiscountType)),
+			DiscountValue: deref(body.DiscountValue),
+		}
+	}
+
+	if err := h.invoiceService.AddInvoiceItems(userID, uint(request.Id), items); err != nil {
+		return generated.AddInvoiceItems400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+
+	data := make([]generated.InvoiceItem, len(items))
+	for i := range items {
+		data[i] = invoiceItemModelToGenerated(&items[i])
+	}
+	return generated.AddInvoiceItems201JSONResponse{Data: data}, nil
+}
+
+// ReorderInvoiceItems implements generated.StrictServerInterface
+func (h *StrictHandlers) ReorderInvoiceItems(
+	ctx context.Context,
+	request generated.ReorderInvoiceItemsRequestObject,
+) (generated.ReorderInvoiceItemsResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.ReorderInvoiceItems401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if _, err := h.invoiceService.GetInvoiceByID(userID, uint(request.Id)); err != nil {
+		return generated.ReorderInvoiceItems404JSONResponse{NotFoundJSONResponse: notFound("Invoice not found")}, nil
+	}
+
+	itemIDs := make([]uint, len(request.Body.ItemIds))
+	for i, id := range request.Body.ItemIds {
+		itemIDs[i] = uint(id)
+	}
+
+	if err := h.invoiceService.ReorderItems(userID, uint(request.Id), itemIDs); err != nil {
+		return generated.ReorderInvoiceItems400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+
+	invoice, err := h.invoiceService.GetInvoiceByID(userID, uint(request.Id))
+	if err != nil {
+		return generated.ReorderInvoiceItems404JSONResponse{NotFoundJSONResponse: notFound("Invoice not found")}, nil
+	}
+
+	return generated.ReorderInvoiceItems200JSONResponse(invoiceModelToGenerated(invoice)), nil
+}
+
 // UpdateInvoiceItem implements generated.StrictServerInterface
 func (h *StrictHandlers) UpdateInvoiceItem(
 	ctx context.Context,
@@ -57,9 +124,24 @@ func (h *StrictHandlers) UpdateInvoiceItem(
 	if request.Body.Quantity != nil {
 		existing.Quantity = *request.Body.Quantity
 	}
+	if request.Body.Unit != nil {
+		existing.Unit = *request.Body.Unit
+	}
 	if request.Body.UnitPrice != nil {
 		existing.UnitPrice = *request.Body.UnitPrice
 	}
+	if request.Body.Currency != nil {
+		existing.Currency = *request.Body.Currency
+	}
+	if request.Body.CategoryId != nil {
+		existing.CategoryID = optionalID(request.Body.CategoryId)
+	}
+	if request.Body.DiscountType != nil {
+		existing.DiscountType = models.DiscountType(*request.Body.DiscountType)
+	}
+	if request.Body.DiscountValue != nil {
+		existing.DiscountValue = *request.Body.DiscountValue
+	}
 
 	// Determine if we should force recalculation
 	forceRecalculate := request.Body.AutoCalculateTargetCurrency != nil && *request.Body.AutoCalculateTargetCurrency
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rxtech-lab/invoice-management/internal/api/generated"
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+)
+
+// ListOrganizations implements generated.StrictServerInterface
+func (h *StrictHandlers) ListOrganizations(
+	ctx context.Context,
+	request generated.ListOrganizationsRequestObject,
+) (generated.ListOrganizationsResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.ListOrganizations401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	// Make sure the personal organization is listed even before the user's first invoice
+	if _, err := h.organizationService.GetPersonalOrganization(userID); err != nil {
+		return nil, err
+	}
+	organizations, err := h.organizationService.ListOrganizations(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(generated.ListOrganizations200JSONResponse, len(organizations))
+	for i := range organizations {
+		result[i] = userOrganizationToGenerated(&organizations[i])
+	}
+	return result, nil
+}
+
+// CreateOrganization implements generated.StrictServerInterface
+func (h *StrictHandlers) CreateOrganization(
+	ctx context.Context,
+	request generated.CreateOrganizationRequestObject,
+) (generated.CreateOrganizationResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.CreateOrganization401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	organization, err := h.organizationService.CreateOrganization(userID, request.Body.Name)
+	if err != nil {
+		return generated.CreateOrganization400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+
+	return generated.CreateOrganization201JSONResponse(userOrganizationToGenerated(&services.UserOrganization{
+		Organization: *organization,
+		Role:         models.OrganizationRoleOwner,
+	})), nil
+}
+
+// ListOrganizationMembers implements generated.StrictServerInterface
+func (h *StrictHandlers) ListOrganizationMembers(
+	ctx context.Context,
+	request generated.ListOrganizationMembersRequestObject,
+) (generated.ListOrganizationMembersResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.ListOrganizationMembers401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	members, err := h.organizationService.ListMembers(userID, uint(request.Id))
+	if err != nil {
+		return generated.ListOrganizationMembers404JSONResponse{NotFoundJSONResponse: notFound("Organization not found")}, nil
+	}
+
+	result := make(generated.ListOrganizationMembers200JSONResponse, len(members))
+	for i := range members {
+		result[i] = organizationMemberToGenerated(&members[i])
+	}
+	return result, nil
+}
+
+// SetOrganizationMember implements generated.StrictServerInterface
+func (h *StrictHandlers) SetOrganizationMember(
+	ctx context.Context,
+	request generated.SetOrganizationMemberRequestObject,
+) (generated.SetOrganizationMemberResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.SetOrganizationMember401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	role := models.OrganizationRole(strings.ToLower(string(request.Body.Role)))
+	member, err := h.organizationService.SetMember(userID, uint(request.Id), request.UserId, role)
+	if err != nil {
+		return generated.SetOrganizationMember400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+
+	return generated.SetOrganizationMember200JSONResponse(organizationMemberToGenerated(member)), nil
+}
+
+// RemoveOrganizationMember implements generated.StrictServerInterface
+func (h *StrictHandlers) RemoveOrganizationMember(
+	ctx context.Context,
+	request generated.RemoveOrganizationMemberRequestObject,
+) (generated.RemoveOrganizationMemberResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.RemoveOrganizationMember401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	if err := h.organizationService.RemoveMember(userID, uint(request.Id), request.UserId); err != nil {
+		return generated.RemoveOrganizationMember400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+
+	return generated.RemoveOrganizationMember204Response{}, nil
+}
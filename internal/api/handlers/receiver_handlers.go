@@ -2,9 +2,11 @@ package handlers
 
 import (
 	"context"
+	"errors"
 
 	"github.com/rxtech-lab/invoice-management/internal/api/generated"
 	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
 )
 
 // ListReceivers implements generated.StrictServerInterface
@@ -29,10 +31,11 @@ func (h *StrictHandlers) ListReceivers(
 	data := receiverListToGenerated(receivers)
 
 	return generated.ListReceivers200JSONResponse{
-		Data:   &data,
-		Total:  ptr(int(total)),
-		Limit:  ptr(limit),
-		Offset: ptr(offset),
+		Data:       &data,
+		Total:      ptr(int(total)),
+		Limit:      ptr(limit),
+		Offset:     ptr(offset),
+		Pagination: pagination(limit, offset, total),
 	}, nil
 }
 
@@ -49,6 +52,11 @@ func (h *StrictHandlers) CreateReceiver(
 	receiver := &models.InvoiceReceiver{
 		Name:           request.Body.Name,
 		IsOrganization: deref(request.Body.IsOrganization),
+		Email:          deref(request.Body.Email),
+		Phone:          deref(request.Body.Phone),
+		Address:        deref(request.Body.Address),
+		BankAccount:    deref(request.Body.BankAccount),
+		TaxID:          deref(request.Body.TaxId),
 	}
 
 	if err := h.receiverService.CreateReceiver(userID, receiver); err != nil {
@@ -102,6 +110,21 @@ func (h *StrictHandlers) UpdateReceiver(
 	if request.Body.OtherNames != nil {
 		existing.OtherNames = *request.Body.OtherNames
 	}
+	if request.Body.Email != nil {
+		existing.Email = *request.Body.Email
+	}
+	if request.Body.Phone != nil {
+		existing.Phone = *request.Body.Phone
+	}
+	if request.Body.Address != nil {
+		existing.Address = *request.Body.Address
+	}
+	if request.Body.BankAccount != nil {
+		existing.BankAccount = *request.Body.BankAccount
+	}
+	if request.Body.TaxId != nil {
+		existing.TaxID = *request.Body.TaxId
+	}
 
 	if err := h.receiverService.UpdateReceiver(userID, existing); err != nil {
 		return generated.UpdateReceiver400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
@@ -121,7 +144,11 @@ func (h *StrictHandlers) DeleteReceiver(
 		return generated.DeleteReceiver401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
 	}
 
-	if err := h.receiverService.DeleteReceiver(userID, uint(request.Id)); err != nil {
+	if err := h.receiverService.DeleteReceiver(userID, uint(request.Id), deref(request.Params.Force)); err != nil {
+		var inUseErr *services.InUseError
+		if errors.As(err, &inUseErr) {
+			return generated.DeleteReceiver409JSONResponse{InUseJSONResponse: inUse(inUseErr)}, nil
+		}
 		return generated.DeleteReceiver404JSONResponse{NotFoundJSONResponse: notFound(err.Error())}, nil
 	}
 
@@ -156,14 +183,16 @@ func (h *StrictHandlers) MergeReceivers(
 		sourceIDs[i] = uint(id)
 	}
 
-	receiver, affectedCount, err := h.receiverService.MergeReceivers(userID, uint(request.Body.TargetId), sourceIDs)
+	merge, err := h.receiverService.MergeReceivers(userID, uint(request.Body.TargetId), sourceIDs, deref(request.Body.DryRun))
 	if err != nil {
 		return generated.MergeReceivers404JSONResponse{NotFoundJSONResponse: notFound(err.Error())}, nil
 	}
 
 	return generated.MergeReceivers200JSONResponse{
-		Receiver:        ptr(receiverModelToGenerated(receiver)),
-		MergedCount:     ptr(len(sourceIDs)),
-		InvoicesUpdated: ptr(int(affectedCount)),
+		Receiver:        ptr(receiverModelToGenerated(merge.Target)),
+		Sources:         ptr(receiverListToGenerated(merge.Sources)),
+		MergedCount:     ptr(len(merge.Sources)),
+		InvoicesUpdated: ptr(int(merge.InvoicesAffected)),
+		DryRun:          ptr(merge.DryRun),
 	}, nil
 }
@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/rxtech-lab/invoice-management/internal/api/generated"
+	"github.com/rxtech-lab/invoice-management/internal/models"
+)
+
+// GetSettings implements generated.StrictServerInterface
+func (h *StrictHandlers) GetSettings(
+	ctx context.Context,
+	request generated.GetSettingsRequestObject,
+) (generated.GetSettingsResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.GetSettings401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	settings, err := h.settingsService.GetSettings(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return generated.GetSettings200JSONResponse(userSettingsToGenerated(settings)), nil
+}
+
+// UpdateSettings implements generated.StrictServerInterface
+func (h *StrictHandlers) UpdateSettings(
+	ctx context.Context,
+	request generated.UpdateSettingsRequestObject,
+) (generated.UpdateSettingsResponseObject, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return generated.UpdateSettings401JSONResponse{UnauthorizedJSONResponse: unauthorized()}, nil
+	}
+
+	existing, err := h.settingsService.GetSettings(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Everything but the base currency is optional in the request; keep the current values when omitted
+	settings := &models.UserSettings{
+		BaseCurrency:         request.Body.BaseCurrency,
+		InvoiceNumberPrefix:  existing.InvoiceNumberPrefix,
+		InvoiceNumberPadding: existing.InvoiceNumberPadding,
+		Email:                existing.Email,
+		Timezone:             existing.Timezone,
+		CompanyName:          existing.CompanyName,
+		CompanyAddress:       existing.CompanyAddress,
+		LogoS3Key:            existing.LogoS3Key,
+		MaxItemUnitPrice:     existing.MaxItemUnitPrice,
+		MaxItemQuantity:      existing.MaxItemQuantity,
+		MaxItemLineAmount:    existing.MaxItemLineAmount,
+		UnsupportedCurrency:  existing.UnsupportedCurrency,
+		PaymentTrackingMode:  existing.PaymentTrackingMode,
+	}
+	if request.Body.InvoiceNumberPrefix != nil {
+		settings.InvoiceNumberPrefix = *request.Body.InvoiceNumberPrefix
+	}
+	if request.Body.InvoiceNumberPadding != nil {
+		settings.InvoiceNumberPadding = *request.Body.InvoiceNumberPadding
+	}
+	if request.Body.Email != nil {
+		settings.Email = *request.Body.Email
+	}
+	if request.Body.Timezone != nil {
+		settings.Timezone = *request.Body.Timezone
+	}
+	if request.Body.CompanyName != nil {
+		settings.CompanyName = *request.Body.CompanyName
+	}
+	if request.Body.CompanyAddress != nil {
+		settings.CompanyAddress = *request.Body.CompanyAddress
+	}
+	if request.Body.LogoS3Key != nil {
+		settings.LogoS3Key = *request.Body.LogoS3Key
+	}
+	if request.Body.MaxItemUnitPrice != nil {
+		settings.MaxItemUnitPrice = *request.Body.MaxItemUnitPrice
+	}
+	if request.Body.MaxItemQuantity != nil {
+		settings.MaxItemQuantity = *request.Body.MaxItemQuantity
+	}
+	if request.Body.MaxItemLineAmount != nil {
+		settings.MaxItemLineAmount = *request.Body.MaxItemLineAmount
+	}
+	if request.Body.UnsupportedCurrency != nil {
+		settings.UnsupportedCurrency = models.UnsupportedCurrencyPolicy(*request.Body.UnsupportedCurrency)
+	}
+	if request.Body.PaymentTrackingMode != nil {
+		settings.PaymentTrackingMode = models.PaymentTrackingMode(*request.Body.PaymentTrackingMode)
+	}
+
+	// A new base currency recalculates every invoice in it, or fails without saving anything
+	if _, err := h.invoiceService.SaveSettings(userID, settings); err != nil {
+		return generated.UpdateSettings400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+	}
+
+	return generated.UpdateSettings200JSONResponse(userSettingsToGenerated(settings)), nil
+}
@@ -29,10 +29,11 @@ func (h *StrictHandlers) ListTags(
 	data := tagListToGenerated(tags)
 
 	return generated.ListTags200JSONResponse{
-		Data:   &data,
-		Total:  ptr(int(total)),
-		Limit:  ptr(limit),
-		Offset: ptr(offset),
+		Data:       &data,
+		Total:      ptr(int(total)),
+		Limit:      ptr(limit),
+		Offset:     ptr(offset),
+		Pagination: pagination(limit, offset, total),
 	}, nil
 }
 
@@ -51,12 +52,9 @@ func (h *StrictHandlers) CreateTag(
 	}
 
 	tag := &models.InvoiceTag{
-		Name:  request.Body.Name,
-		Color: deref(request.Body.Color),
-	}
-
-	if tag.Color == "" {
-		tag.Color = "#6B7280" // Default gray color
+		Name:     request.Body.Name,
+		Color:    deref(request.Body.Color),
+		ParentID: optionalID(request.Body.ParentId),
 	}
 
 	if err := h.tagService.CreateTag(userID, tag); err != nil {
@@ -107,6 +105,9 @@ func (h *StrictHandlers) UpdateTag(
 	if request.Body.Color != nil {
 		existing.Color = *request.Body.Color
 	}
+	if request.Body.ParentId != nil {
+		existing.ParentID = optionalID(request.Body.ParentId)
+	}
 
 	if err := h.tagService.UpdateTag(userID, existing); err != nil {
 		return generated.UpdateTag400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
@@ -182,11 +183,16 @@ func (h *StrictHandlers) RemoveTagFromInvoice(
 // Tag converters
 
 func tagModelToGenerated(tag *models.InvoiceTag) generated.Tag {
+	var parentID *int
+	if tag.ParentID != nil {
+		parentID = ptr(int(*tag.ParentID))
+	}
 	return generated.Tag{
 		Id:        ptr(int(tag.ID)),
 		UserId:    ptr(tag.UserID),
 		Name:      ptr(tag.Name),
 		Color:     ptr(tag.Color),
+		ParentId:  parentID,
 		CreatedAt: ptr(tag.CreatedAt),
 		UpdatedAt: ptr(tag.UpdatedAt),
 	}
@@ -2,7 +2,8 @@ package handlers
 
 import (
 	"context"
-	"io"
+	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -28,25 +29,29 @@ func (h *StrictHandlers) UploadFile(
 	defer file.Close()
 
 	filename := file.FileName()
-	contentType := file.Header.Get("Content-Type")
-	if contentType == "" {
-		contentType = "application/octet-stream"
+	contentType, content, err := services.DetectUploadContentType(services.LimitUpload(file))
+	if errors.Is(err, services.ErrUploadTooLarge) {
+		return uploadTooLarge(), nil
 	}
-
-	// Read file content
-	content, err := io.ReadAll(file)
 	if err != nil {
 		return generated.UploadFile400JSONResponse{BadRequestJSONResponse: badRequest("Failed to read file")}, nil
 	}
+	if !services.AllowedUploadContentTypes[contentType] {
+		return generated.UploadFile415JSONResponse{Error: ptr(fmt.Sprintf("Unsupported file type %q: upload a PDF or a PNG, JPEG, GIF, or WebP image", contentType))}, nil
+	}
 
-	// Upload to S3 - returns the key
-	key, err := h.uploadService.UploadFile(ctx, userID, filename, content, contentType)
+	// Upload the file to S3 - returns the key and size. The request body is already in memory
+	// (at most bodyLimit), so this only avoids a second copy of the file
+	key, size, err := h.uploadService.UploadStream(ctx, userID, filename, content, contentType)
+	if errors.Is(err, services.ErrUploadTooLarge) {
+		return uploadTooLarge(), nil
+	}
 	if err != nil {
 		return generated.UploadFile400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
 	}
 
 	// Save file metadata to database for ownership tracking
-	_, err = h.fileUploadService.CreateFileUpload(userID, key, filename, contentType, int64(len(content)))
+	_, err = h.fileUploadService.CreateFileUpload(userID, key, filename, contentType, size)
 	if err != nil {
 		// Try to clean up S3 file if DB save fails
 		_ = h.uploadService.DeleteFile(ctx, key)
@@ -64,11 +69,18 @@ func (h *StrictHandlers) UploadFile(
 		Key:         key,
 		DownloadUrl: downloadURL,
 		Filename:    filename,
-		Size:        len(content),
+		Size:        int(size),
 		ContentType: contentType,
 	}, nil
 }
 
+// uploadTooLarge is the response to a file over services.MaxUploadBytes
+func uploadTooLarge() generated.UploadFile413JSONResponse {
+	return generated.UploadFile413JSONResponse{
+		Error: ptr(fmt.Sprintf("File exceeds the maximum upload size of %d bytes; use a presigned URL for larger files", services.MaxUploadBytes)),
+	}
+}
+
 // GetPresignedURL implements generated.StrictServerInterface
 func (h *StrictHandlers) GetPresignedURL(
 	ctx context.Context,
@@ -246,6 +258,9 @@ func (h *StrictHandlers) UploadHtmlToPdf(
 
 	// Convert HTML to PDF (sanitization happens inside the service)
 	pdfContent, err := h.pdfService.ConvertHTMLToPDF(ctx, request.Body.Html, options)
+	if errors.Is(err, services.ErrPDFRenderingNotConfigured) {
+		return generated.UploadHtmlToPdf500JSONResponse{Error: ptr("PDF service not configured")}, nil
+	}
 	if err != nil {
 		return generated.UploadHtmlToPdf500JSONResponse{Error: ptr("Failed to convert HTML to PDF: " + err.Error())}, nil
 	}
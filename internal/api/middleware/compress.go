@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/valyala/fasthttp"
+)
+
+// Environment variables configuring response compression
+const (
+	CompressionLevelEnvVar   = "COMPRESSION_LEVEL"
+	CompressionMinSizeEnvVar = "COMPRESSION_MIN_SIZE"
+)
+
+// DefaultCompressionMinSize is the smallest response body in bytes that is compressed by default;
+// smaller bodies gain little and cost CPU on both ends
+const DefaultCompressionMinSize = 1024
+
+// CompressionLevel selects the compression trade-off
+type CompressionLevel string
+
+const (
+	CompressionDisabled        CompressionLevel = "disabled"
+	CompressionDefault         CompressionLevel = "default"
+	CompressionBestSpeed       CompressionLevel = "best_speed"
+	CompressionBestCompression CompressionLevel = "best_compression"
+)
+
+// CompressionConfig configures CompressionMiddleware
+type CompressionConfig struct {
+	Level CompressionLevel
+	// MinSize is the smallest response body in bytes that is compressed
+	MinSize int
+}
+
+// CompressionConfigFromEnv builds the compression configuration from COMPRESSION_LEVEL
+// (disabled, default, best_speed, or best_compression) and COMPRESSION_MIN_SIZE (bytes)
+func CompressionConfigFromEnv() (CompressionConfig, error) {
+	config := CompressionConfig{Level: CompressionDefault, MinSize: DefaultCompressionMinSize}
+
+	if value := strings.TrimSpace(os.Getenv(CompressionLevelEnvVar)); value != "" {
+		switch level := CompressionLevel(strings.ToLower(value)); level {
+		case CompressionDisabled, CompressionDefault, CompressionBestSpeed, CompressionBestCompression:
+			config.Level = level
+		default:
+			return config, fmt.Errorf("invalid %s %q: must be disabled, default, best_speed, or best_compression", CompressionLevelEnvVar, value)
+		}
+	}
+
+	if value := strings.TrimSpace(os.Getenv(CompressionMinSizeEnvVar)); value != "" {
+		minSize, err := strconv.Atoi(value)
+		if err != nil || minSize < 0 {
+			return config, fmt.Errorf("invalid %s %q: must be a non-negative number of bytes", CompressionMinSizeEnvVar, value)
+		}
+		config.MinSize = minSize
+	}
+
+	return config, nil
+}
+
+// CompressionMiddleware compresses response bodies of at least config.MinSize bytes with the best
+// encoding the client accepts (brotli, gzip, or deflate). MCP endpoints, event streams, and other
+// streamed bodies are passed through so their chunked framing reaches the client as written.
+func CompressionMiddleware(config CompressionConfig) fiber.Handler {
+	var compressor fasthttp.RequestHandler
+	noop := func(*fasthttp.RequestCtx) {}
+	switch config.Level {
+	case CompressionDisabled:
+		return func(c *fiber.Ctx) error {
+			return c.Next()
+		}
+	case CompressionBestSpeed:
+		compressor = fasthttp.CompressHandlerBrotliLevel(noop, fasthttp.CompressBrotliBestSpeed, fasthttp.CompressBestSpeed)
+	case CompressionBestCompression:
+		compressor = fasthttp.CompressHandlerBrotliLevel(noop, fasthttp.CompressBrotliBestCompression, fasthttp.CompressBestCompression)
+	default:
+		compressor = fasthttp.CompressHandlerBrotliLevel(noop, fasthttp.CompressBrotliDefaultCompression, fasthttp.CompressDefaultCompression)
+	}
+
+	return func(c *fiber.Ctx) error {
+		if path := c.Path(); path == "/mcp" || strings.HasPrefix(path, "/mcp/") {
+			return c.Next()
+		}
+
+		if err := c.Next(); err != nil {
+			return err
+		}
+
+		resp := c.Response()
+		if resp.IsBodyStream() || len(resp.Header.ContentEncoding()) > 0 ||
+			strings.HasPrefix(string(resp.Header.ContentType()), "text/event-stream") ||
+			len(resp.Body()) < config.MinSize {
+			return nil
+		}
+		compressor(c.Context())
+		return nil
+	}
+}
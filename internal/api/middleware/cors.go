@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/gofiber/fiber/v2/middleware/cors"
+)
+
+// Environment variables configuring CORS. Lists are comma-separated; unset variables keep
+// Fiber's permissive defaults (any origin, no credentials).
+const (
+	CORSAllowOriginsEnvVar     = "CORS_ALLOW_ORIGINS"
+	CORSAllowCredentialsEnvVar = "CORS_ALLOW_CREDENTIALS"
+	CORSAllowMethodsEnvVar     = "CORS_ALLOW_METHODS"
+	CORSAllowHeadersEnvVar     = "CORS_ALLOW_HEADERS"
+)
+
+// CORSConfigFromEnv builds the CORS configuration from the CORS_* environment variables.
+// Origins are "*" or a list of schemes and hosts such as "https://app.example.com". Allowing
+// credentials requires explicit origins, so it is an error when the origins are "*" or unset.
+func CORSConfigFromEnv() (cors.Config, error) {
+	config := cors.ConfigDefault
+
+	if origins := splitList(os.Getenv(CORSAllowOriginsEnvVar)); len(origins) > 0 {
+		if len(origins) > 1 || origins[0] != "*" {
+			for _, origin := range origins {
+				if err := validateOrigin(origin); err != nil {
+					return cors.ConfigDefault, err
+				}
+			}
+		}
+		config.AllowOrigins = strings.Join(origins, ",")
+	}
+
+	switch value := os.Getenv(CORSAllowCredentialsEnvVar); value {
+	case "", "false":
+	case "true":
+		if config.AllowOrigins == "*" {
+			return cors.ConfigDefault, fmt.Errorf("%s=true requires explicit origins in %s, not a wildcard", CORSAllowCredentialsEnvVar, CORSAllowOriginsEnvVar)
+		}
+		config.AllowCredentials = true
+	default:
+		return cors.ConfigDefault, fmt.Errorf("invalid %s %q: must be true or false", CORSAllowCredentialsEnvVar, value)
+	}
+
+	if methods := splitList(os.Getenv(CORSAllowMethodsEnvVar)); len(methods) > 0 {
+		for i, method := range methods {
+			methods[i] = strings.ToUpper(method)
+		}
+		config.AllowMethods = strings.Join(methods, ",")
+	}
+	if headers := splitList(os.Getenv(CORSAllowHeadersEnvVar)); len(headers) > 0 {
+		config.AllowHeaders = strings.Join(headers, ",")
+	}
+
+	return config, nil
+}
+
+// validateOrigin checks that origin is a bare http(s) origin, optionally with a subdomain
+// wildcard such as "https://*.example.com"; the Fiber CORS middleware panics on anything else
+func validateOrigin(origin string) error {
+	u, err := url.Parse(strings.Replace(origin, "://*.", "://", 1))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || strings.Contains(u.Host, "*") ||
+		(u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return fmt.Errorf("invalid origin %q in %s: expected scheme and host, e.g. https://app.example.com", origin, CORSAllowOriginsEnvVar)
+	}
+	return nil
+}
+
+// splitList splits a comma-separated value, dropping blank entries
+func splitList(value string) []string {
+	var values []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
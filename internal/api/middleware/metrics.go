@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/invoice-management/internal/metrics"
+)
+
+// statusLabels caches the label value of every HTTP status code so recording a request doesn't allocate
+var statusLabels = func() [600]string {
+	var labels [600]string
+	for code := range labels {
+		labels[code] = strconv.Itoa(code)
+	}
+	return labels
+}()
+
+// unmatchedRoute labels requests that never reached a registered route
+const unmatchedRoute = "unmatched"
+
+// methodLabel maps a request method to a constant label value
+func methodLabel(method string) string {
+	switch method {
+	case fiber.MethodGet:
+		return fiber.MethodGet
+	case fiber.MethodHead:
+		return fiber.MethodHead
+	case fiber.MethodPost:
+		return fiber.MethodPost
+	case fiber.MethodPut:
+		return fiber.MethodPut
+	case fiber.MethodPatch:
+		return fiber.MethodPatch
+	case fiber.MethodDelete:
+		return fiber.MethodDelete
+	case fiber.MethodOptions:
+		return fiber.MethodOptions
+	default:
+		return "OTHER"
+	}
+}
+
+// MetricsMiddleware creates a Fiber middleware that records the count and latency of every request.
+// Requests are labeled by the matched route pattern (e.g. /api/invoices/:id) rather than the raw
+// path, which keeps the number of series bounded.
+func MetricsMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+		err := c.Next()
+
+		status := c.Response().StatusCode()
+		if err != nil {
+			// The app's error handler sets the final status after middleware returns
+			status = fiber.StatusInternalServerError
+			if e, ok := err.(*fiber.Error); ok {
+				status = e.Code
+			}
+		}
+
+		statusLabel := "unknown"
+		if status >= 0 && status < len(statusLabels) {
+			statusLabel = statusLabels[status]
+		}
+		// Fiber's method and path strings alias the request buffer, which is reused after the
+		// handler returns, so only strings owned by the app are used as label values
+		route := unmatchedRoute
+		if r := c.Route(); len(r.Handlers) > 0 {
+			route = r.Path
+		}
+		method := methodLabel(c.Method())
+
+		metrics.HTTPRequestsTotal.WithLabelValues(method, route, statusLabel).Inc()
+		metrics.HTTPRequestDuration.WithLabelValues(method, route, statusLabel).Observe(time.Since(start).Seconds())
+		return err
+	}
+}
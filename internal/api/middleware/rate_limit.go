@@ -0,0 +1,132 @@
+package middleware
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
+)
+
+// tokenBucket holds the remaining request tokens of a single client
+type tokenBucket struct {
+	mu       sync.Mutex
+	tokens   float64
+	lastFill time.Time
+}
+
+// take refills the bucket for the elapsed time and consumes one token.
+// When the bucket is empty it returns false and how long until a token is available.
+func (b *tokenBucket) take(now time.Time, capacity, perSecond float64) (bool, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens = math.Min(capacity, b.tokens+now.Sub(b.lastFill).Seconds()*perSecond)
+	b.lastFill = now
+
+	if b.tokens < 1 {
+		wait := time.Duration((1 - b.tokens) / perSecond * float64(time.Second))
+		return false, wait
+	}
+
+	b.tokens--
+	return true, 0
+}
+
+// idle reports whether the bucket was last used before cutoff
+func (b *tokenBucket) idle(cutoff time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.lastFill.Before(cutoff)
+}
+
+// RateLimiter limits each client to perMinute requests per minute using a token bucket, allowing
+// bursts of up to perMinute requests. A client idle for a minute has a full bucket again, so its
+// bucket is dropped by the next sweep, which the handler runs at most once a minute.
+type RateLimiter struct {
+	capacity  float64
+	perSecond float64
+	buckets   sync.Map
+	lastSweep atomic.Int64
+}
+
+// NewRateLimiter creates a RateLimiter allowing perMinute requests per minute to each client
+func NewRateLimiter(perMinute int) *RateLimiter {
+	limiter := &RateLimiter{capacity: float64(perMinute), perSecond: float64(perMinute) / 60}
+	limiter.lastSweep.Store(time.Now().UnixNano())
+	return limiter
+}
+
+// refillTime is how long an empty bucket takes to fill up
+func (l *RateLimiter) refillTime() time.Duration {
+	return time.Duration(l.capacity / l.perSecond * float64(time.Second))
+}
+
+// Sweep drops the buckets of clients idle for the refill time as of now, which would be full
+// again anyway, and returns how many were dropped
+func (l *RateLimiter) Sweep(now time.Time) int {
+	l.lastSweep.Store(now.UnixNano())
+	cutoff := now.Add(-l.refillTime())
+	dropped := 0
+	l.buckets.Range(func(key, value interface{}) bool {
+		if value.(*tokenBucket).idle(cutoff) {
+			l.buckets.Delete(key)
+			dropped++
+		}
+		return true
+	})
+	return dropped
+}
+
+// Clients returns how many clients currently have a bucket
+func (l *RateLimiter) Clients() int {
+	count := 0
+	l.buckets.Range(func(interface{}, interface{}) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+// Handler returns the Fiber middleware. Clients are keyed by the authenticated user's Sub, falling
+// back to the client IP when unauthenticated, so it must be registered after the authentication
+// middleware. /health, /metrics, and /.well-known/* are not rate limited.
+func (l *RateLimiter) Handler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		path := c.Path()
+		if path == "/health" || path == "/metrics" || strings.HasPrefix(path, "/.well-known/") {
+			return c.Next()
+		}
+
+		key := "ip:" + c.IP()
+		if user, ok := c.Locals(AuthenticatedUserContextKey).(*utils.AuthenticatedUser); ok && user != nil && user.Sub != "" {
+			key = "user:" + user.Sub
+		}
+
+		now := time.Now()
+		if last := l.lastSweep.Load(); now.Sub(time.Unix(0, last)) >= l.refillTime() && l.lastSweep.CompareAndSwap(last, now.UnixNano()) {
+			l.Sweep(now)
+		}
+
+		value, _ := l.buckets.LoadOrStore(key, &tokenBucket{tokens: l.capacity, lastFill: now})
+		allowed, wait := value.(*tokenBucket).take(now, l.capacity, l.perSecond)
+		if !allowed {
+			c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+			return c.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+				"error": "Rate limit exceeded",
+			})
+		}
+
+		return c.Next()
+	}
+}
+
+// RateLimitMiddleware creates a Fiber middleware that limits each client to perMinute requests
+// per minute (see RateLimiter)
+func RateLimitMiddleware(perMinute int) fiber.Handler {
+	return NewRateLimiter(perMinute).Handler()
+}
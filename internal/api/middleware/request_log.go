@@ -0,0 +1,110 @@
+package middleware
+
+import (
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/logger"
+	"github.com/google/uuid"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
+)
+
+// RequestIDHeader is the header carrying the request ID, both on requests and responses
+const RequestIDHeader = "X-Request-ID"
+
+// LogFormatEnvVar is the environment variable selecting the request log format.
+// "json" writes one structured line per request; anything else keeps the text format.
+const LogFormatEnvVar = "LOG_FORMAT"
+
+// maxRequestIDLength bounds client-supplied request IDs so they can't bloat the logs
+const maxRequestIDLength = 128
+
+// RequestIDMiddleware creates a Fiber middleware that assigns every request an ID.
+// The client's X-Request-ID is reused when it is a reasonable value, otherwise a UUID is generated.
+// The ID is echoed in the response header and stored in both the Fiber locals and the Go context.
+func RequestIDMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		requestID := c.Get(RequestIDHeader)
+		if !validRequestID(requestID) {
+			requestID = uuid.NewString()
+		} else {
+			// Header values alias the request buffer, which is reused after the handler returns
+			requestID = string([]byte(requestID))
+		}
+
+		c.Set(RequestIDHeader, requestID)
+		c.Locals(utils.RequestIDContextKey, requestID)
+		c.SetUserContext(utils.WithRequestID(c.UserContext(), requestID))
+		return c.Next()
+	}
+}
+
+// validRequestID reports whether a client-supplied request ID is short printable ASCII
+func validRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(requestID); i++ {
+		if requestID[i] < 0x21 || requestID[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// RequestLoggerMiddleware creates a Fiber middleware that logs one line per request.
+// The format is chosen by LOG_FORMAT: "json" logs structured lines with the request ID, user,
+// route, status, and latency; otherwise the text format prefixed with the request ID is used.
+// Must be registered after RequestIDMiddleware.
+func RequestLoggerMiddleware() fiber.Handler {
+	if os.Getenv(LogFormatEnvVar) == "json" {
+		return jsonRequestLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+	}
+	return logger.New(logger.Config{
+		Format:     "[${time}] ${locals:" + utils.RequestIDContextKey + "} ${status} - ${latency} ${method} ${path}\n",
+		TimeFormat: "15:04:05",
+		TimeZone:   "Local",
+	})
+}
+
+// jsonRequestLogger logs every request as a structured line once the handler chain has finished.
+// The user is read after the chain runs because authentication is registered later.
+func jsonRequestLogger(log *slog.Logger) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+		err := c.Next()
+
+		status := c.Response().StatusCode()
+		if err != nil {
+			// The app's error handler sets the final status after middleware returns
+			status = fiber.StatusInternalServerError
+			if e, ok := err.(*fiber.Error); ok {
+				status = e.Code
+			}
+		}
+
+		attrs := []slog.Attr{
+			slog.String("request_id", utils.GetRequestID(c.UserContext())),
+			slog.String("method", c.Method()),
+			slog.String("route", c.Route().Path),
+			slog.String("path", c.Path()),
+			slog.Int("status", status),
+			slog.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+		}
+		if user, ok := c.Locals(AuthenticatedUserContextKey).(*utils.AuthenticatedUser); ok && user != nil {
+			attrs = append(attrs, slog.String("user", user.Sub))
+		}
+		if err != nil {
+			attrs = append(attrs, slog.String("error", err.Error()))
+		}
+
+		level := slog.LevelInfo
+		if status >= fiber.StatusInternalServerError {
+			level = slog.LevelError
+		}
+		log.LogAttrs(c.UserContext(), level, "request", attrs...)
+		return err
+	}
+}
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
+)
+
+// RequireScope creates a Fiber middleware that returns 403 unless the authenticated user
+// has the given scope (see utils.UserHasScope). Unauthenticated requests are passed through
+// so the authentication check can reject them with 401.
+func RequireScope(scope string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		user, ok := c.Locals(AuthenticatedUserContextKey).(*utils.AuthenticatedUser)
+		if !ok || user == nil {
+			return c.Next()
+		}
+
+		if !utils.UserHasScope(user, scope) {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": "Missing required scope: " + scope,
+			})
+		}
+
+		return c.Next()
+	}
+}
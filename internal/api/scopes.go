@@ -0,0 +1,137 @@
+package api
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/invoice-management/internal/api/generated"
+	"github.com/rxtech-lab/invoice-management/internal/api/middleware"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
+)
+
+// operationScopes is the scope each API operation requires, keyed by operation ID. Reading
+// invoices or anything derived from them (analytics, exports, statements) needs invoices:read;
+// every change needs invoices:write. Conversion previews are POSTs but read-only. An empty
+// scope leaves the operation open, and operations missing from the map need invoices:write.
+var operationScopes = map[string]string{
+	"HealthCheck": "",
+
+	// Analytics and dashboard
+	"GetAnalyticsSummary":    utils.ScopeInvoicesRead,
+	"GetAnalyticsByCategory": utils.ScopeInvoicesRead,
+	"GetAnalyticsByCompany":  utils.ScopeInvoicesRead,
+	"GetAnalyticsByReceiver": utils.ScopeInvoicesRead,
+	"GetAnalyticsByTag":      utils.ScopeInvoicesRead,
+	"GetAnalyticsTrend":      utils.ScopeInvoicesRead,
+	"GetCompanyBreakdown":    utils.ScopeInvoicesRead,
+	"GetReceiverStatistics":  utils.ScopeInvoicesRead,
+	"GetReceiverStatement":   utils.ScopeInvoicesRead,
+	"GetDashboard":           utils.ScopeInvoicesRead,
+
+	// Budgets
+	"ListBudgets":     utils.ScopeInvoicesRead,
+	"GetBudgetStatus": utils.ScopeInvoicesRead,
+	"CreateBudget":    utils.ScopeInvoicesWrite,
+	"DeleteBudget":    utils.ScopeInvoicesWrite,
+
+	// Categories, companies, receivers, and tags
+	"ListCategories": utils.ScopeInvoicesRead,
+	"GetCategory":    utils.ScopeInvoicesRead,
+	"CreateCategory": utils.ScopeInvoicesWrite,
+	"UpdateCategory": utils.ScopeInvoicesWrite,
+	"DeleteCategory": utils.ScopeInvoicesWrite,
+	"ListCompanies":  utils.ScopeInvoicesRead,
+	"GetCompany":     utils.ScopeInvoicesRead,
+	"CreateCompany":  utils.ScopeInvoicesWrite,
+	"UpdateCompany":  utils.ScopeInvoicesWrite,
+	"DeleteCompany":  utils.ScopeInvoicesWrite,
+	"ListReceivers":  utils.ScopeInvoicesRead,
+	"GetReceiver":    utils.ScopeInvoicesRead,
+	"CreateReceiver": utils.ScopeInvoicesWrite,
+	"UpdateReceiver": utils.ScopeInvoicesWrite,
+	"DeleteReceiver": utils.ScopeInvoicesWrite,
+	"MergeReceivers": utils.ScopeInvoicesWrite,
+	"ListTags":       utils.ScopeInvoicesRead,
+	"GetTag":         utils.ScopeInvoicesRead,
+	"CreateTag":      utils.ScopeInvoicesWrite,
+	"UpdateTag":      utils.ScopeInvoicesWrite,
+	"DeleteTag":      utils.ScopeInvoicesWrite,
+
+	// Backup
+	"ExportData":    utils.ScopeInvoicesRead,
+	"RequestExport": utils.ScopeInvoicesRead,
+	"GetExportJob":  utils.ScopeInvoicesRead,
+	"ImportData":    utils.ScopeInvoicesWrite,
+
+	// Invoices
+	"ListInvoices":              utils.ScopeInvoicesRead,
+	"GetInvoice":                utils.ScopeInvoicesRead,
+	"GetInvoiceFacets":          utils.ScopeInvoicesRead,
+	"LookupInvoices":            utils.ScopeInvoicesRead,
+	"GetInvoiceStatusCounts":    utils.ScopeInvoicesRead,
+	"ListInvoiceAttachments":    utils.ScopeInvoicesRead,
+	"GetInvoiceAuditTrail":      utils.ScopeInvoicesRead,
+	"GetInvoiceStatusHistory":   utils.ScopeInvoicesRead,
+	"FindSimilarInvoices":       utils.ScopeInvoicesRead,
+	"PreviewCurrencyConversion": utils.ScopeInvoicesRead,
+	"ListPaymentMethods":        utils.ScopeInvoicesRead,
+	"CreateInvoice":             utils.ScopeInvoicesWrite,
+	"ImportInvoices":            utils.ScopeInvoicesWrite,
+	"UpdateInvoice":             utils.ScopeInvoicesWrite,
+	"DeleteInvoice":             utils.ScopeInvoicesWrite,
+	"CloneInvoice":              utils.ScopeInvoicesWrite,
+	"FinalizeInvoice":           utils.ScopeInvoicesWrite,
+	"UpdateInvoiceStatus":       utils.ScopeInvoicesWrite,
+	"RecalculateInvoiceTotals":  utils.ScopeInvoicesWrite,
+	"AddInvoiceAttachment":      utils.ScopeInvoicesWrite,
+	"RemoveInvoiceAttachment":   utils.ScopeInvoicesWrite,
+	"AddInvoiceItem":            utils.ScopeInvoicesWrite,
+	"AddInvoiceItems":           utils.ScopeInvoicesWrite,
+	"UpdateInvoiceItem":         utils.ScopeInvoicesWrite,
+	"DeleteInvoiceItem":         utils.ScopeInvoicesWrite,
+	"ReorderInvoiceItems":       utils.ScopeInvoicesWrite,
+	"AddTagToInvoice":           utils.ScopeInvoicesWrite,
+	"RemoveTagFromInvoice":      utils.ScopeInvoicesWrite,
+
+	// Organizations
+	"ListOrganizations":        utils.ScopeInvoicesRead,
+	"ListOrganizationMembers":  utils.ScopeInvoicesRead,
+	"CreateOrganization":       utils.ScopeInvoicesWrite,
+	"SetOrganizationMember":    utils.ScopeInvoicesWrite,
+	"RemoveOrganizationMember": utils.ScopeInvoicesWrite,
+
+	// Settings and uploads
+	"GetSettings":            utils.ScopeInvoicesRead,
+	"UpdateSettings":         utils.ScopeInvoicesWrite,
+	"GetFileDownloadURL":     utils.ScopeInvoicesRead,
+	"UploadFile":             utils.ScopeInvoicesWrite,
+	"GetPresignedURL":        utils.ScopeInvoicesWrite,
+	"ConfirmPresignedUpload": utils.ScopeInvoicesWrite,
+	"UploadHtmlToPdf":        utils.ScopeInvoicesWrite,
+}
+
+// operationScope returns the scope required by an operation (see operationScopes)
+func operationScope(operationID string) string {
+	scope, ok := operationScopes[operationID]
+	if !ok {
+		return utils.ScopeInvoicesWrite
+	}
+	return scope
+}
+
+// scopeStrictMiddleware answers 403 unless the authenticated user has the scope the operation
+// requires (see utils.UserHasScope). Unauthenticated requests were already rejected with 401.
+func scopeStrictMiddleware(f generated.StrictHandlerFunc, operationID string) generated.StrictHandlerFunc {
+	scope := operationScope(operationID)
+	if scope == "" {
+		return f
+	}
+	return func(c *fiber.Ctx, request interface{}) (interface{}, error) {
+		user, ok := c.Locals(middleware.AuthenticatedUserContextKey).(*utils.AuthenticatedUser)
+		if ok && user != nil && !utils.UserHasScope(user, scope) {
+			// The response is written here; a nil response leaves it as is
+			return nil, c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": "Missing required scope: " + scope,
+			})
+		}
+		return f(c, request)
+	}
+}
@@ -11,12 +11,12 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/adaptor"
 	"github.com/gofiber/fiber/v2/middleware/cors"
-	"github.com/gofiber/fiber/v2/middleware/logger"
 	mcpserver "github.com/mark3labs/mcp-go/server"
 	"github.com/rxtech-lab/invoice-management/internal/api/generated"
 	"github.com/rxtech-lab/invoice-management/internal/api/handlers"
 	"github.com/rxtech-lab/invoice-management/internal/api/middleware"
 	"github.com/rxtech-lab/invoice-management/internal/assets"
+	"github.com/rxtech-lab/invoice-management/internal/metrics"
 	"github.com/rxtech-lab/invoice-management/internal/services"
 	"github.com/rxtech-lab/invoice-management/internal/utils"
 	auth "github.com/rxtech-lab/mcprouter-authenticator/authenticator"
@@ -24,6 +24,10 @@ import (
 	"github.com/rxtech-lab/mcprouter-authenticator/types"
 )
 
+// OpenAPICacheControl lets clients and proxies reuse the OpenAPI spec for five minutes before
+// revalidating it with its ETag; the spec only changes when a new build is deployed
+const OpenAPICacheControl = "public, max-age=300"
+
 type APIServer struct {
 	app                    *fiber.App
 	dbService              services.DBService
@@ -35,15 +39,35 @@ type APIServer struct {
 	uploadService          services.UploadService
 	fileUploadService      services.FileUploadService
 	analyticsService       services.AnalyticsService
+	settingsService        services.SettingsService
+	budgetService          services.BudgetService
+	backupService          services.BackupService
+	exportService          services.ExportService
 	fileUnlinkService      services.FileUnlinkService
 	pdfService             services.PDFService
+	healthService          services.HealthService
+	organizationService    services.OrganizationService
 	mcpServer              *mcpserver.MCPServer
 	mcprouterAuthenticator *auth.ApikeyAuthenticator
 	oauthAuthenticator     *middleware.OAuthAuthenticator
+	jobManager             *services.JobManager
 	port                   int
 	authenticationEnabled  bool
 }
 
+// multipartOverhead is room for the multipart envelope around a file upload in the body limit
+const multipartOverhead = 64 << 10
+
+// bodyLimit is the largest request body accepted: enough for an upload of
+// services.MaxUploadBytes, and never less than Fiber's default
+func bodyLimit() int {
+	limit := services.MaxUploadBytes + multipartOverhead
+	if limit < fiber.DefaultBodyLimit {
+		return fiber.DefaultBodyLimit
+	}
+	return int(limit)
+}
+
 // NewAPIServer creates a new API server instance
 func NewAPIServer(
 	dbService services.DBService,
@@ -55,12 +79,22 @@ func NewAPIServer(
 	uploadService services.UploadService,
 	fileUploadService services.FileUploadService,
 	analyticsService services.AnalyticsService,
+	settingsService services.SettingsService,
+	budgetService services.BudgetService,
+	backupService services.BackupService,
+	exportService services.ExportService,
 	fileUnlinkService services.FileUnlinkService,
 	pdfService services.PDFService,
+	healthService services.HealthService,
+	organizationService services.OrganizationService,
 	mcpServer *mcpserver.MCPServer,
 ) *APIServer {
 	app := fiber.New(fiber.Config{
 		DisableStartupMessage: true,
+		// Request bodies are buffered in memory, so this caps what an upload can hold: bodies that
+		// announce a larger Content-Length get 413 before being read, and chunked ones are cut off
+		// at the limit
+		BodyLimit: bodyLimit(),
 		// Custom error handler to properly handle errors from generated code
 		ErrorHandler: func(c *fiber.Ctx, err error) error {
 			// Check if it's already a Fiber error
@@ -77,13 +111,25 @@ func NewAPIServer(
 		},
 	})
 
+	// CORS is permissive unless restricted with the CORS_* environment variables
+	corsConfig, err := middleware.CORSConfigFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid CORS configuration: %v", err)
+	}
+
+	// Responses are compressed for clients that accept it unless COMPRESSION_LEVEL=disabled
+	compressionConfig, err := middleware.CompressionConfigFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid compression configuration: %v", err)
+	}
+
 	// Add middleware
-	app.Use(cors.New())
-	app.Use(logger.New(logger.Config{
-		Format:     "[${time}] ${status} - ${latency} ${method} ${path}\n",
-		TimeFormat: "15:04:05",
-		TimeZone:   "Local",
-	}))
+	app.Use(middleware.RequestIDMiddleware())
+	app.Use(middleware.MetricsMiddleware())
+	app.Use(cors.New(corsConfig))
+	app.Use(middleware.CompressionMiddleware(compressionConfig))
+	app.Use(middleware.ETagMiddleware())
+	app.Use(middleware.RequestLoggerMiddleware())
 
 	// Initialize MCPRouter authenticator
 	var mcprouterAuthenticator *auth.ApikeyAuthenticator
@@ -115,6 +161,13 @@ func NewAPIServer(
 		log.Println("Warning: No authentication configured (MCPROUTER_SERVER_URL or OAUTH_SERVER_URL not set)")
 	}
 
+	// Report the connection pool of the database in /metrics
+	if dbService != nil {
+		if sqlDB, err := dbService.GetDB().DB(); err == nil {
+			metrics.SetDB(sqlDB)
+		}
+	}
+
 	srv := &APIServer{
 		app:                    app,
 		dbService:              dbService,
@@ -126,8 +179,14 @@ func NewAPIServer(
 		uploadService:          uploadService,
 		fileUploadService:      fileUploadService,
 		analyticsService:       analyticsService,
+		settingsService:        settingsService,
+		budgetService:          budgetService,
+		backupService:          backupService,
+		exportService:          exportService,
 		fileUnlinkService:      fileUnlinkService,
 		pdfService:             pdfService,
+		healthService:          healthService,
+		organizationService:    organizationService,
 		mcpServer:              mcpServer,
 		mcprouterAuthenticator: mcprouterAuthenticator,
 		oauthAuthenticator:     oauthAuthenticator,
@@ -137,17 +196,13 @@ func NewAPIServer(
 
 // SetupRoutes configures all API routes
 func (s *APIServer) SetupRoutes() {
-	// OpenAPI spec (no auth required)
-	s.app.Get("/openapi", func(c *fiber.Ctx) error {
-		c.Set("Content-Type", "application/yaml")
-		return c.Send(assets.OpenAPISpec)
-	})
+	// OpenAPI spec (no auth required), cached by clients and revalidated with its ETag
+	openAPISpec := middleware.StaticHandler("application/yaml", assets.OpenAPISpec, assets.OpenAPISpecETag, OpenAPICacheControl)
+	s.app.Get("/openapi", openAPISpec)
+	s.app.Get("/openapi.yaml", openAPISpec)
 
-	// OpenAPI spec as JSON endpoint (no auth required)
-	s.app.Get("/openapi.yaml", func(c *fiber.Ctx) error {
-		c.Set("Content-Type", "application/yaml")
-		return c.Send(assets.OpenAPISpec)
-	})
+	// Prometheus metrics (no auth required)
+	s.app.Get("/metrics", metrics.Handler())
 
 	// Authentication check endpoint
 	s.app.Get("/authentication", func(c *fiber.Ctx) error {
@@ -169,12 +224,19 @@ func (s *APIServer) SetupRoutes() {
 		s.uploadService,
 		s.fileUploadService,
 		s.analyticsService,
+		s.settingsService,
+		s.budgetService,
+		s.backupService,
+		s.exportService,
 		s.fileUnlinkService,
 		s.pdfService,
+		s.healthService,
+		s.organizationService,
 	)
 
 	// Create strict handler wrapper (converts StrictServerInterface to ServerInterface)
-	strictHandler := generated.NewStrictHandler(strictHandlers, nil)
+	// Every operation checks the scope it requires (see operationScopes)
+	strictHandler := generated.NewStrictHandler(strictHandlers, []generated.StrictMiddlewareFunc{scopeStrictMiddleware})
 
 	// Register all API routes using generated handlers
 	// Middleware checks authentication and passes user to Go context
@@ -246,6 +308,13 @@ func (s *APIServer) EnableAuthentication() error {
 	return nil
 }
 
+// EnableRateLimit limits each user (or IP when unauthenticated) to perMinute requests per minute.
+// Must be called after EnableAuthentication and before SetupRoutes.
+func (s *APIServer) EnableRateLimit(perMinute int) {
+	log.Printf("Rate limiting enabled (%d requests per minute)", perMinute)
+	s.app.Use(middleware.RateLimitMiddleware(perMinute))
+}
+
 // EnableStreamableHTTP enables the MCP Streamable HTTP server
 func (s *APIServer) EnableStreamableHTTP() {
 	if s.mcpServer == nil {
@@ -301,12 +370,24 @@ func (s *APIServer) StartAsync(port *int) (int, error) {
 	return s.port, nil
 }
 
-// Shutdown gracefully shuts down the server
+// SetJobManager sets the background jobs Shutdown drains
+func (s *APIServer) SetJobManager(jobs *services.JobManager) {
+	s.jobManager = jobs
+}
+
+// Shutdown gracefully shuts down the server: in-flight requests are finished first, then the
+// background jobs of the job manager are drained (see JobManager.Shutdown)
 func (s *APIServer) Shutdown() error {
 	if s.oauthAuthenticator != nil {
 		s.oauthAuthenticator.Close()
 	}
-	return s.app.Shutdown()
+	err := s.app.Shutdown()
+	if s.jobManager != nil {
+		if drainErr := s.jobManager.Shutdown(); drainErr != nil && err == nil {
+			err = drainErr
+		}
+	}
+	return err
 }
 
 // GetPort returns the server port
@@ -340,8 +421,10 @@ func (s *APIServer) createAuthenticatedMCPHandler(streamableServer *mcpserver.St
 		}
 		authenticatedUser := user.(*utils.AuthenticatedUser)
 
+		requestID := utils.GetRequestID(c.UserContext())
+
 		httpHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			ctx := r.Context()
+			ctx := utils.WithRequestID(r.Context(), requestID)
 			if authenticatedUser != nil {
 				ctx = utils.WithAuthenticatedUser(ctx, authenticatedUser)
 			}
@@ -356,8 +439,10 @@ func (s *APIServer) createAuthenticatedMCPHandler(streamableServer *mcpserver.St
 // createUnauthenticatedMCPHandler creates a Fiber handler without authentication
 func (s *APIServer) createUnauthenticatedMCPHandler(streamableServer *mcpserver.StreamableHTTPServer) fiber.Handler {
 	return func(c *fiber.Ctx) error {
+		requestID := utils.GetRequestID(c.UserContext())
+
 		httpHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			streamableServer.ServeHTTP(w, r)
+			streamableServer.ServeHTTP(w, r.WithContext(utils.WithRequestID(r.Context(), requestID)))
 		})
 		return adaptor.HTTPHandler(httpHandler)(c)
 	}
@@ -1,8 +1,20 @@
 package assets
 
 import (
+	"crypto/sha256"
 	_ "embed"
+	"encoding/hex"
 )
 
 //go:embed openapi.yaml
 var OpenAPISpec []byte
+
+// OpenAPISpecETag is the strong entity tag of OpenAPISpec, a hash of the spec embedded at build
+// time, so it changes exactly when a new build ships a different spec
+var OpenAPISpecETag = contentETag(OpenAPISpec)
+
+// contentETag returns a strong entity tag for content
+func contentETag(content []byte) string {
+	sum := sha256.Sum256(content)
+	return `"` + hex.EncodeToString(sum[:8]) + `"`
+}
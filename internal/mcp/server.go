@@ -27,11 +27,14 @@ func NewMCPServer(
 	uploadService services.UploadService,
 	analyticsService services.AnalyticsService,
 	tagService services.TagService,
+	budgetService services.BudgetService,
+	templateService services.TemplateService,
+	pdfService services.PDFService,
 ) *MCPServer {
 	mcpServer := &MCPServer{
 		dbService: dbService,
 	}
-	mcpServer.initializeTools(categoryService, companyService, receiverService, invoiceService, uploadService, analyticsService, tagService)
+	mcpServer.initializeTools(categoryService, companyService, receiverService, invoiceService, uploadService, analyticsService, tagService, budgetService, templateService, pdfService)
 	return mcpServer
 }
 
@@ -44,6 +47,9 @@ func (s *MCPServer) initializeTools(
 	uploadService services.UploadService,
 	analyticsService services.AnalyticsService,
 	tagService services.TagService,
+	budgetService services.BudgetService,
+	templateService services.TemplateService,
+	pdfService services.PDFService,
 ) {
 	srv := server.NewMCPServer(
 		"Invoice Management MCP Server",
@@ -94,6 +100,9 @@ func (s *MCPServer) initializeTools(
 	deleteCategoryTool := tools.NewDeleteCategoryTool(categoryService)
 	srv.AddTool(deleteCategoryTool.GetTool(), deleteCategoryTool.GetHandler())
 
+	mergeCategoriesTool := tools.NewMergeCategoriesTool(categoryService)
+	srv.AddTool(mergeCategoriesTool.GetTool(), mergeCategoriesTool.GetHandler())
+
 	// Company Tools
 	createCompanyTool := tools.NewCreateCompanyTool(companyService)
 	srv.AddTool(createCompanyTool.GetTool(), createCompanyTool.GetHandler())
@@ -110,6 +119,9 @@ func (s *MCPServer) initializeTools(
 	deleteCompanyTool := tools.NewDeleteCompanyTool(companyService)
 	srv.AddTool(deleteCompanyTool.GetTool(), deleteCompanyTool.GetHandler())
 
+	mergeCompaniesTool := tools.NewMergeCompaniesTool(companyService)
+	srv.AddTool(mergeCompaniesTool.GetTool(), mergeCompaniesTool.GetHandler())
+
 	// Receiver Tools
 	createReceiverTool := tools.NewCreateReceiverTool(receiverService)
 	srv.AddTool(createReceiverTool.GetTool(), createReceiverTool.GetHandler())
@@ -136,6 +148,9 @@ func (s *MCPServer) initializeTools(
 	getInvoiceTool := tools.NewGetInvoiceTool(invoiceService)
 	srv.AddTool(getInvoiceTool.GetTool(), getInvoiceTool.GetHandler())
 
+	lookupInvoiceTool := tools.NewLookupInvoiceTool(invoiceService)
+	srv.AddTool(lookupInvoiceTool.GetTool(), lookupInvoiceTool.GetHandler())
+
 	updateInvoiceTool := tools.NewUpdateInvoiceTool(invoiceService)
 	srv.AddTool(updateInvoiceTool.GetTool(), updateInvoiceTool.GetHandler())
 
@@ -145,13 +160,71 @@ func (s *MCPServer) initializeTools(
 	searchInvoicesTool := tools.NewSearchInvoicesTool(invoiceService)
 	srv.AddTool(searchInvoicesTool.GetTool(), searchInvoicesTool.GetHandler())
 
+	findIncompleteInvoicesTool := tools.NewFindIncompleteInvoicesTool(invoiceService)
+	srv.AddTool(findIncompleteInvoicesTool.GetTool(), findIncompleteInvoicesTool.GetHandler())
+
+	findSimilarInvoicesTool := tools.NewFindSimilarInvoicesTool(invoiceService)
+	srv.AddTool(findSimilarInvoicesTool.GetTool(), findSimilarInvoicesTool.GetHandler())
+
+	reconcileStatementTool := tools.NewReconcileStatementTool(invoiceService)
+	srv.AddTool(reconcileStatementTool.GetTool(), reconcileStatementTool.GetHandler())
+
+	listUpcomingInvoicesTool := tools.NewListUpcomingInvoicesTool(invoiceService)
+	srv.AddTool(listUpcomingInvoicesTool.GetTool(), listUpcomingInvoicesTool.GetHandler())
+
+	listPaymentMethodsTool := tools.NewListPaymentMethodsTool(invoiceService)
+	srv.AddTool(listPaymentMethodsTool.GetTool(), listPaymentMethodsTool.GetHandler())
+
+	invoiceStatusCountsTool := tools.NewInvoiceStatusCountsTool(invoiceService)
+	srv.AddTool(invoiceStatusCountsTool.GetTool(), invoiceStatusCountsTool.GetHandler())
+
 	updateInvoiceStatusTool := tools.NewUpdateInvoiceStatusTool(invoiceService)
 	srv.AddTool(updateInvoiceStatusTool.GetTool(), updateInvoiceStatusTool.GetHandler())
 
+	finalizeInvoiceTool := tools.NewFinalizeInvoiceTool(invoiceService)
+	srv.AddTool(finalizeInvoiceTool.GetTool(), finalizeInvoiceTool.GetHandler())
+
+	cloneInvoiceTool := tools.NewCloneInvoiceTool(invoiceService)
+	srv.AddTool(cloneInvoiceTool.GetTool(), cloneInvoiceTool.GetHandler())
+
+	linkInvoicesTool := tools.NewLinkInvoicesTool(invoiceService)
+	srv.AddTool(linkInvoicesTool.GetTool(), linkInvoicesTool.GetHandler())
+
+	recalculateInvoiceTotalsTool := tools.NewRecalculateInvoiceTotalsTool(invoiceService)
+	srv.AddTool(recalculateInvoiceTotalsTool.GetTool(), recalculateInvoiceTotalsTool.GetHandler())
+
+	refreshFXRatesTool := tools.NewRefreshFXRatesTool(invoiceService)
+	srv.AddTool(refreshFXRatesTool.GetTool(), refreshFXRatesTool.GetHandler())
+
+	resetItemFXTool := tools.NewResetItemFXTool(invoiceService)
+	srv.AddTool(resetItemFXTool.GetTool(), resetItemFXTool.GetHandler())
+
+	explainInvoiceTotalTool := tools.NewExplainInvoiceTotalTool(invoiceService)
+	srv.AddTool(explainInvoiceTotalTool.GetTool(), explainInvoiceTotalTool.GetHandler())
+
+	cleanupOrphansTool := tools.NewCleanupOrphansTool(invoiceService)
+	srv.AddTool(cleanupOrphansTool.GetTool(), cleanupOrphansTool.GetHandler())
+
+	previewCurrencyConversionTool := tools.NewPreviewCurrencyConversionTool(invoiceService)
+	srv.AddTool(previewCurrencyConversionTool.GetTool(), previewCurrencyConversionTool.GetHandler())
+
+	// Invoice Template Tools
+	createInvoiceTemplateTool := tools.NewCreateInvoiceTemplateTool(templateService)
+	srv.AddTool(createInvoiceTemplateTool.GetTool(), createInvoiceTemplateTool.GetHandler())
+
+	listInvoiceTemplatesTool := tools.NewListInvoiceTemplatesTool(templateService)
+	srv.AddTool(listInvoiceTemplatesTool.GetTool(), listInvoiceTemplatesTool.GetHandler())
+
+	applyInvoiceTemplateTool := tools.NewApplyInvoiceTemplateTool(invoiceService)
+	srv.AddTool(applyInvoiceTemplateTool.GetTool(), applyInvoiceTemplateTool.GetHandler())
+
 	// Invoice Item Tools
 	addInvoiceItemTool := tools.NewAddInvoiceItemTool(invoiceService)
 	srv.AddTool(addInvoiceItemTool.GetTool(), addInvoiceItemTool.GetHandler())
 
+	addInvoiceItemsTool := tools.NewAddInvoiceItemsTool(invoiceService)
+	srv.AddTool(addInvoiceItemsTool.GetTool(), addInvoiceItemsTool.GetHandler())
+
 	updateInvoiceItemTool := tools.NewUpdateInvoiceItemTool(invoiceService)
 	srv.AddTool(updateInvoiceItemTool.GetTool(), updateInvoiceItemTool.GetHandler())
 
@@ -162,6 +235,9 @@ func (s *MCPServer) initializeTools(
 	getPresignedURLTool := tools.NewGetPresignedURLTool(uploadService)
 	srv.AddTool(getPresignedURLTool.GetTool(), getPresignedURLTool.GetHandler())
 
+	extractInvoiceFromPDFTool := tools.NewExtractInvoiceFromPDFTool(pdfService)
+	srv.AddTool(extractInvoiceFromPDFTool.GetTool(), extractInvoiceFromPDFTool.GetHandler())
+
 	// Statistics Tools
 	invoiceStatisticsTool := tools.NewInvoiceStatisticsTool(analyticsService)
 	srv.AddTool(invoiceStatisticsTool.GetTool(), invoiceStatisticsTool.GetHandler())
@@ -169,6 +245,34 @@ func (s *MCPServer) initializeTools(
 	advancedSearchTool := tools.NewAdvancedInvoiceSearchTool(analyticsService, invoiceService, tagService, categoryService, companyService, receiverService)
 	srv.AddTool(advancedSearchTool.GetTool(), advancedSearchTool.GetHandler())
 
+	receiverDetailTool := tools.NewReceiverDetailTool(analyticsService)
+	srv.AddTool(receiverDetailTool.GetTool(), receiverDetailTool.GetHandler())
+
+	companyBreakdownTool := tools.NewCompanyBreakdownTool(analyticsService)
+	srv.AddTool(companyBreakdownTool.GetTool(), companyBreakdownTool.GetHandler())
+
+	currencyExposureTool := tools.NewCurrencyExposureTool(analyticsService)
+	srv.AddTool(currencyExposureTool.GetTool(), currencyExposureTool.GetHandler())
+
+	forecastSpendingTool := tools.NewForecastSpendingTool(analyticsService)
+	srv.AddTool(forecastSpendingTool.GetTool(), forecastSpendingTool.GetHandler())
+
+	detectSpendingAnomaliesTool := tools.NewDetectSpendingAnomaliesTool(analyticsService)
+	srv.AddTool(detectSpendingAnomaliesTool.GetTool(), detectSpendingAnomaliesTool.GetHandler())
+
+	monthlyTrendTool := tools.NewMonthlyTrendTool(analyticsService)
+	srv.AddTool(monthlyTrendTool.GetTool(), monthlyTrendTool.GetHandler())
+
+	spendingByWeekdayTool := tools.NewSpendingByWeekdayTool(analyticsService)
+	srv.AddTool(spendingByWeekdayTool.GetTool(), spendingByWeekdayTool.GetHandler())
+
+	// Budget Tools
+	createBudgetTool := tools.NewCreateBudgetTool(budgetService)
+	srv.AddTool(createBudgetTool.GetTool(), createBudgetTool.GetHandler())
+
+	checkBudgetsTool := tools.NewCheckBudgetsTool(budgetService)
+	srv.AddTool(checkBudgetsTool.GetTool(), checkBudgetsTool.GetHandler())
+
 	// Tag Tools
 	createTagTool := tools.NewCreateTagTool(tagService)
 	srv.AddTool(createTagTool.GetTool(), createTagTool.GetHandler())
@@ -194,6 +298,18 @@ func (s *MCPServer) initializeTools(
 	searchInvoicesByTagsTool := tools.NewSearchInvoicesByTagsTool(tagService)
 	srv.AddTool(searchInvoicesByTagsTool.GetTool(), searchInvoicesByTagsTool.GetHandler())
 
+	bulkTagInvoicesTool := tools.NewBulkTagInvoicesTool(invoiceService)
+	srv.AddTool(bulkTagInvoicesTool.GetTool(), bulkTagInvoicesTool.GetHandler())
+
+	tagUsageTool := tools.NewTagUsageTool(tagService)
+	srv.AddTool(tagUsageTool.GetTool(), tagUsageTool.GetHandler())
+
+	tagSpendingTool := tools.NewTagSpendingTool(analyticsService)
+	srv.AddTool(tagSpendingTool.GetTool(), tagSpendingTool.GetHandler())
+
+	cleanupUnusedTagsTool := tools.NewCleanupUnusedTagsTool(tagService)
+	srv.AddTool(cleanupUnusedTagsTool.GetTool(), cleanupUnusedTagsTool.GetHandler())
+
 	// Merge Receivers Tool
 	mergeReceiversTool := tools.NewMergeReceiversTool(receiverService)
 	srv.AddTool(mergeReceiversTool.GetTool(), mergeReceiversTool.GetHandler())
@@ -227,7 +343,8 @@ func getToolInstructions(category string) string {
 		return `Category Management Tools:
 
 1. create_category - Create a new invoice category
-   Parameters: name (required), description, color
+   Parameters: name (required), description, color,
+               default_currency, default_tax_rate (used for new invoices in the category that don't specify them)
 
 2. list_categories - List all categories with optional search
    Parameters: keyword, limit, offset
@@ -236,10 +353,14 @@ func getToolInstructions(category string) string {
    Parameters: category_id (required)
 
 4. update_category - Update an existing category
-   Parameters: category_id (required), name, description, color
+   Parameters: category_id (required), name, description, color, default_currency, default_tax_rate
+
+5. delete_category - Delete a category; refused while invoices use it unless force is set
+   Parameters: category_id (required), force (remove it from those invoices first)
 
-5. delete_category - Delete a category
-   Parameters: category_id (required)`
+6. merge_categories - Merge multiple categories into one
+   Parameters: target_id (required), source_ids (required array), dry_run (preview without changing anything)
+   All invoices from source categories will be moved to the target category.`
 
 	case "company":
 		return `Company Management Tools:
@@ -256,14 +377,18 @@ func getToolInstructions(category string) string {
 4. update_company - Update an existing company
    Parameters: company_id (required), name, address, email, phone, website, tax_id, notes
 
-5. delete_company - Delete a company
-   Parameters: company_id (required)`
+5. delete_company - Delete a company; refused while invoices use it unless force is set
+   Parameters: company_id (required), force (remove it from those invoices first)
+
+6. merge_companies - Merge multiple companies into one
+   Parameters: target_id (required), source_ids (required array), dry_run (preview without changing anything)
+   All invoices from source companies will be moved to the target company.`
 
 	case "receiver":
 		return `Receiver Management Tools:
 
 1. create_receiver - Create a new invoice receiver
-   Parameters: name (required), is_organization (boolean, default false)
+   Parameters: name (required), is_organization (boolean, default false), email, phone, address, bank_account, tax_id
 
 2. list_receivers - List all receivers with optional search
    Parameters: keyword, limit, offset
@@ -272,20 +397,21 @@ func getToolInstructions(category string) string {
    Parameters: receiver_id (required)
 
 4. update_receiver - Update an existing receiver
-   Parameters: receiver_id (required), name, is_organization
+   Parameters: receiver_id (required), name, is_organization, other_names, email, phone, address, bank_account, tax_id
+   Contact details are only changed when given; an empty string clears one.
 
-5. delete_receiver - Delete a receiver
-   Parameters: receiver_id (required)
+5. delete_receiver - Delete a receiver; refused while invoices use it unless force is set
+   Parameters: receiver_id (required), force (remove it from those invoices first)
 
 6. merge_receivers - Merge multiple receivers into one
-   Parameters: target_id (required), source_ids (required array)
+   Parameters: target_id (required), source_ids (required array), dry_run (preview without changing anything)
    All invoices from source receivers will be moved to the target receiver.`
 
 	case "tag":
 		return `Tag Management Tools:
 
 1. create_tag - Create a new invoice tag
-   Parameters: name (required), color (hex code, default #6B7280)
+   Parameters: name (required), color (hex code, auto-assigned from a palette if omitted)
 
 2. list_tags - List all tags with optional search
    Parameters: keyword, limit, offset
@@ -306,54 +432,180 @@ func getToolInstructions(category string) string {
    Parameters: invoice_id (required), tag_id (required)
 
 8. search_invoices_by_tag - Find invoices with a specific tag
-   Parameters: tag_id (required), limit, offset`
+   Parameters: tag_id (required), limit, offset
+
+9. bulk_tag_invoices - Add tags to every invoice matching a filter (e.g. all invoices from a company)
+   Parameters: tag_ids (required), keyword, category_id, company_id, receiver_id, status,
+               min_amount, max_amount, amount_field
+
+10. tag_usage - List tags with their invoice count and last-used date, most used first
+
+11. cleanup_unused_tags - Delete tags no invoice carries (tags in use are never deleted)
+   Parameters: older_than (RFC3339, only tags created before it)`
 
 	case "invoice":
 		return `Invoice Management Tools:
 
 1. create_invoice - Create a new invoice
-   Parameters: title (required), description, amount, currency, category_id, company_id,
+   Parameters: title (required), description, amount, currency (default: the category's default_currency, else USD),
+               category_id, company_id,
                invoice_started_at, invoice_ended_at, original_download_link, tags,
-               status (paid/unpaid/overdue), due_date, items
+               status (paid/unpaid/overdue), due_date, payment_method, discount_type (percent/fixed),
+               discount_value, tax_rate (default: the category's default_tax_rate),
+               items (each with optional discount_type and discount_value),
+               is_draft (placeholder left out of statistics and list_invoices until finalized),
+               expected_amount (document total; a mismatch with the items adds warnings but still creates)
 
 2. list_invoices - List invoices with filtering and sorting
-   Parameters: keyword, category_id, company_id, status, sort_by, sort_order, limit, offset
+   Parameters: keyword, category_id, company_id, status, payment_method, min_amount, max_amount,
+               amount_field, include_drafts, sort_by, sort_order, limit, offset
+   Returns total_amount and total_target_amount (base currency) across all matching invoices
 
 3. get_invoice - Get an invoice by ID with all details
-   Parameters: invoice_id (required)
+   Parameters: invoice_id (required), include_amount_in_words (spells out the total, e.g. for invoices that legally require it)
 
 4. update_invoice - Update an existing invoice
    Parameters: invoice_id (required), and any fields to update
+   Pass the version from get_invoice to reject the update if the invoice changed in the meantime
 
 5. delete_invoice - Delete an invoice
    Parameters: invoice_id (required)
 
 6. search_invoices - Full-text search across invoices
-   Parameters: query (required)
+   Parameters: query (required), highlight (adds matched_fields and **highlighted** snippets)
+
+7. find_incomplete_invoices - Find invoices missing a category, company, and/or receiver (flags are combined with OR)
+   Parameters: missing_category, missing_company, missing_receiver (booleans, at least one true)
+
+8. find_similar_invoices - Find past invoices related to an invoice for context, best first
+   (same receiver 3 points, shared title words up to 2, base-currency amount within ±10% up to 1)
+   Parameters: invoice_id (required), limit (default 5, max 50)
+
+9. list_upcoming_invoices - List unpaid invoices due in the next N days, soonest first, with the count and total due
+   Parameters: days (default 7)
 
-7. update_invoice_status - Update only the status of an invoice
+10. list_payment_methods - List the distinct payment methods (cards or accounts) recorded on invoices
+
+11. invoice_status_counts - Count invoices per status (paid, unpaid, overdue), zero for statuses with none;
+    drafts are not counted. Quick answer to "how many invoices are still unpaid?"
+
+12. update_invoice_status - Update only the status of an invoice
    Parameters: invoice_id (required), status (required: paid/unpaid/overdue)
 
+13. finalize_invoice - Finalize a draft invoice so it counts towards statistics and shows up in list_invoices
+    Parameters: invoice_id (required)
+
+14. clone_invoice - Create a new invoice from an existing one (copies items, category, company, receiver, tags, currency)
+    Parameters: invoice_id (required), title, status, invoice_started_at, invoice_ended_at, due_date,
+                target_currency (re-bills in another currency, converting the item amounts at the current rate)
+
+15. link_invoices - Link a refund, credit note, or correction to the invoice it relates to
+    Parameters: invoice_id (required), related_invoice_id (required, 0 removes the link),
+                relation_type (refund/credit_note/correction)
+
+16. recalculate_invoice_totals - Recompute invoice totals from their items to repair drift (maintenance)
+    Parameters: invoice_id (omit to recalculate every invoice)
+
+17. refresh_fx_rates - Re-price every invoice in a currency at the current FX rates (item target amounts and totals),
+    in batches; items with a manual target amount override are kept unless include_overrides is true
+    Parameters: currency (required), include_overrides
+
+18. reset_item_fx - Drop manual target amount overrides so items follow live FX again: recalculates each item's
+    target amount and rate at the current FX rate and updates the invoice total
+    Parameters: invoice_id (required), item_id (only reset this item)
+
+19. explain_invoice_total - Show how an invoice's totals derive from its items: each item's amount, currency,
+    FX rate and rate date, and base-currency amount, flagging manual overrides, stale rates, and stored
+    totals that differ from the recomputed ones (read-only; use recalculate_invoice_totals to repair)
+    Parameters: invoice_id (required)
+
+20. cleanup_orphans - Report (and with dry_run false, repair) tag mappings and items of deleted invoices
+    and invoices referencing deleted categories, companies, or receivers (maintenance)
+    Parameters: dry_run (default true)
+
+21. lookup_invoice - Find invoices by invoice number or original download link instead of ID, for reconciling
+    against vendor documents. A number matches one of the user's own invoices; a link can match several,
+    returned newest first
+    Parameters: number or link (exactly one)
+
+22. reconcile_statement - Match bank or card statement lines to invoices (read-only: proposes, never changes anything)
+    A line matches an invoice with a base-currency amount within 1% (sign ignored) dated at most 7 days apart
+    (paid date, else due date, else creation date). Returns matched (one invoice), ambiguous (candidates to
+    choose from), and unmatched lines (candidates for create_invoice); confirm with the user before acting
+    Parameters: lines (required: objects with date (YYYY-MM-DD or RFC3339), amount in the base currency, description)
+
 Invoice Item Tools:
-8. add_invoice_item - Add an item to an invoice
-   Parameters: invoice_id (required), description (required), quantity, unit_price
+23. add_invoice_item - Add an item to an invoice
+    Parameters: invoice_id (required), description (required), quantity, unit, unit_price,
+                discount_type (percent/fixed), discount_value
 
-9. update_invoice_item - Update an invoice item
-   Parameters: item_id (required), description, quantity, unit_price
+24. add_invoice_items - Add several items to an invoice at once, recomputing the total once
+    (all or nothing; returns the created items with their IDs and target amounts)
+    Parameters: invoice_id (required), items (required, same format as create_invoice items)
 
-10. delete_invoice_item - Delete an invoice item
+25. update_invoice_item - Update an invoice item
+    Parameters: item_id (required), description, quantity, unit, unit_price,
+                discount_type (percent/fixed), discount_value
+
+26. delete_invoice_item - Delete an invoice item
     Parameters: item_id (required)
 
 Statistics Tools:
-11. invoice_statistics - Get invoice statistics with time period filtering, grouping, and aggregations
+27. invoice_statistics - Get invoice statistics with time period filtering, grouping, and aggregations
     Parameters: period (last_day/last_week/last_month/last_year), days, category_id, company_id,
-                receiver_id, status, keyword, group_by (day/week/month/category/company/receiver),
-                include_aggregations
+                receiver_id, status, keyword, exclude_keyword (drops matching invoices),
+                tag_ids with tag_match (any/all),
+                group_by (day/week/month/quarter/category/company/receiver/payment_method),
+                include_aggregations, date_field (created_at/invoice_started_at/due_date),
+                net_refunds (subtract linked refunds and credit notes)
     Examples:
     - "How much did I spend last week?" → period: "last_week"
     - "Show daily spending for 7 days" → period: "last_week", group_by: "day"
     - "Max spend last week?" → period: "last_week", include_aggregations: true
-    - "Electricity invoices last month" → period: "last_month", keyword: "electricity"`
+    - "Electricity invoices last month" → period: "last_month", keyword: "electricity"
+    - "Travel except flights" → keyword: "travel", exclude_keyword: "flight"
+    - "Spending tagged travel" → tag_ids: [<travel tag ID>]
+
+28. receiver_detail - Get statistics for a single receiver (totals, paid vs unpaid, first/last invoice, top 5 invoices)
+    Parameters: receiver_id (required), period (last_day/last_week/last_month/last_year)
+
+29. company_breakdown - Roll a company up across its receivers: total, split by receiver and by category
+    (invoices without a receiver under "Unspecified")
+    Parameters: company_id (required), period (last_day/last_week/last_month/last_year)
+
+30. currency_exposure - FX exposure: spending per invoice currency (original and base-currency amounts, count, share of total)
+    Parameters: period (7d/1m/1y)
+
+31. forecast_spending - Project next period's spending as the average of the last N equivalent periods (heuristic, not statistical)
+    Parameters: period (7d/1m/1y), windows (default 3)
+
+32. detect_spending_anomalies - Flag invoices above mean + k * std_dev of their category or receiver, with each group's baseline
+    Parameters: period (7d/1m/1y, default 1y), group_by (category/receiver), k (default 2), min_samples (default 5)
+
+33. monthly_trend - One row per calendar month (total, paid, unpaid, count) for the last N months, zero-filled,
+    in the base currency and the user's timezone
+    Parameters: months (default 12, max 120)
+
+34. spending_by_weekday - Spending per day of the week (amount, count), all seven days Monday first,
+    in the base currency and the user's timezone
+    Parameters: period (7d/1m/1y), date_field (created_at/invoice_started_at/due_date)
+
+Budget Tools:
+35. create_budget - Set a recurring spending budget for a category
+    Parameters: category_id (required), amount (required), period_type (monthly/quarterly/yearly), currency
+
+36. check_budgets - Compare each budget to spending in its current period (spent, remaining, over_budget)
+    Parameters: as_of (ISO 8601, default now)
+
+Invoice Template Tools:
+37. create_invoice_template - Save reusable invoice defaults under a name (never creates invoices by itself)
+    Parameters: name (required), title, description, currency, category_id, company_id, receiver_id,
+                items (same format as create_invoice), tags (tag names)
+
+38. list_invoice_templates - List the user's invoice templates
+
+39. apply_invoice_template - Create an invoice from a template (duplicates are detected like create_invoice)
+    Parameters: template_id (required), title, status, invoice_started_at, invoice_ended_at, due_date`
 
 	case "upload":
 		return `File Upload Tools:
@@ -363,26 +615,35 @@ Statistics Tools:
 
    Usage: Use this to get a URL for directly uploading invoice attachments to S3.
    The returned URL can be used with PUT request to upload the file.
-   After upload, use the returned key as the original_download_link in invoices.`
+   After upload, use the returned key as the original_download_link in invoices.
+
+2. extract_invoice_from_pdf - Read total, currency, dates, and vendor from an uploaded PDF
+   Parameters: key (required, the file key returned by an upload)
+
+   Usage: Returns a draft with a confidence score (0-1) for each field; nothing is created.
+   Confirm the values with the user, then call create_invoice.
+   Only text-based PDFs are supported; scanned documents have no extractable text.`
 
 	case "all":
 		return `Invoice Management MCP Tools Overview:
 
 This MCP server provides tools for managing invoices, categories, companies, receivers, tags, and file uploads.
 
-CATEGORY MANAGEMENT (5 tools):
+CATEGORY MANAGEMENT (6 tools):
 - create_category: Create a new category
 - list_categories: List categories with search
 - get_category: Get category details
 - update_category: Update a category
 - delete_category: Delete a category
+- merge_categories: Merge multiple categories into one
 
-COMPANY MANAGEMENT (5 tools):
+COMPANY MANAGEMENT (6 tools):
 - create_company: Create a new company
 - list_companies: List companies with search
 - get_company: Get company details
 - update_company: Update a company
 - delete_company: Delete a company
+- merge_companies: Merge multiple companies into one
 
 RECEIVER MANAGEMENT (6 tools):
 - create_receiver: Create a new receiver
@@ -392,7 +653,7 @@ RECEIVER MANAGEMENT (6 tools):
 - delete_receiver: Delete a receiver
 - merge_receivers: Merge multiple receivers into one
 
-TAG MANAGEMENT (8 tools):
+TAG MANAGEMENT (11 tools):
 - create_tag: Create a new tag with name and color
 - list_tags: List tags with search
 - get_tag: Get tag details
@@ -401,27 +662,63 @@ TAG MANAGEMENT (8 tools):
 - add_tag_to_invoice: Associate a tag with an invoice
 - remove_tag_from_invoice: Remove a tag from an invoice
 - search_invoices_by_tag: Find invoices with a specific tag
+- bulk_tag_invoices: Add tags to every invoice matching a filter
+- tag_usage: Tags with their invoice count and last-used date
+- cleanup_unused_tags: Delete tags no invoice carries
 
-INVOICE MANAGEMENT (10 tools):
+INVOICE MANAGEMENT (26 tools):
 - create_invoice: Create a new invoice with items
 - list_invoices: List with filters and sorting
 - get_invoice: Get invoice with all details
+- lookup_invoice: Find invoices by number or original link
+- reconcile_statement: Match bank statement lines to invoices (read-only proposals)
 - update_invoice: Update an invoice
 - delete_invoice: Delete an invoice
 - search_invoices: Full-text search
+- find_incomplete_invoices: Find invoices missing a category, company, or receiver
+- find_similar_invoices: Past invoices from the same receiver or with a similar title/amount
+- list_upcoming_invoices: What's due in the next N days (cash-flow planning)
+- list_payment_methods: Cards or accounts invoices were paid with
+- invoice_status_counts: How many invoices are paid, unpaid, and overdue
 - update_invoice_status: Change invoice status
+- finalize_invoice: Turn a draft invoice into a regular one
+- clone_invoice: Copy an invoice into a new one (e.g. next month's rent)
+- link_invoices: Link a credit note or refund to the original invoice
+- recalculate_invoice_totals: Repair invoice totals that drifted from their items
+- refresh_fx_rates: Re-price a currency's invoices at current FX rates
+- reset_item_fx: Undo manual target amount overrides on an invoice's items
+- explain_invoice_total: Explain an invoice's base-currency total item by item
+- cleanup_orphans: Find and repair broken tag, item, and category/company/receiver references
 - add_invoice_item: Add item to invoice
+- add_invoice_items: Add several items to an invoice in one call
 - update_invoice_item: Update an item
 - delete_invoice_item: Delete an item
 
-FILE UPLOAD (1 tool):
+FILE UPLOAD (2 tools):
 - get_presigned_url: Get URL for file upload
+- extract_invoice_from_pdf: Draft invoice fields from an uploaded PDF for confirmation
 
-STATISTICS (2 tools):
+STATISTICS (9 tools):
 - invoice_statistics: Get statistics with period/grouping/aggregations
   Supports: "last_week day by day", "max spend last month", "compare by category"
 - advanced_invoice_search: Search across title, category, company, receiver, tags
   Supports: "How much did I spend on Marriott?", "Total travel expenses"
+- receiver_detail: Full statistics for a single receiver including its largest invoices
+- company_breakdown: A company's total split by receiver and by category
+- currency_exposure: Spending per invoice currency and its share of the total
+- forecast_spending: Project next period's spending from a moving average (heuristic)
+- detect_spending_anomalies: Flag bills far above the usual amount for their category or receiver
+- monthly_trend: Month-by-month totals for an annual review ("last 12 months as rows")
+- spending_by_weekday: Spending per day of the week ("which weekday do I spend most on?")
+
+BUDGETS (2 tools):
+- create_budget: Set a monthly, quarterly, or yearly budget for a category
+- check_budgets: See spent, remaining, and over-budget status for every budget
+
+INVOICE TEMPLATES (3 tools):
+- create_invoice_template: Save reusable invoice defaults (title, items, category, company, receiver, currency, tags)
+- list_invoice_templates: List saved templates
+- apply_invoice_template: Create an invoice from a template on demand
 
 All tools require authentication. Invoices are user-scoped.`
 
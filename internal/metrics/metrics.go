@@ -0,0 +1,76 @@
+package metrics
+
+import (
+	"database/sql"
+	"sync/atomic"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// namespace prefixes every metric exported by the server
+const namespace = "invoice_management"
+
+// Collectors registered with the default Prometheus registry
+var (
+	HTTPRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "Number of HTTP requests by method, route, and status code.",
+	}, []string{"method", "route", "status"})
+
+	HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency by method, route, and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	InvoicesCreatedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "invoices_created_total",
+		Help:      "Number of invoices created (duplicates that were skipped are not counted).",
+	})
+
+	FXCacheHitsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "fx_cache_hits_total",
+		Help:      "Number of exchange rate lookups served from the cache.",
+	})
+
+	FXCacheMissesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "fx_cache_misses_total",
+		Help:      "Number of exchange rate lookups that missed the cache.",
+	})
+)
+
+// currentDB is the connection pool reported by the open connections gauge
+var currentDB atomic.Pointer[sql.DB]
+
+func init() {
+	promauto.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "db_open_connections",
+		Help:      "Number of established database connections, both in use and idle.",
+	}, func() float64 {
+		db := currentDB.Load()
+		if db == nil {
+			return 0
+		}
+		return float64(db.Stats().OpenConnections)
+	})
+}
+
+// SetDB sets the database whose connection pool is reported by the open connections gauge
+func SetDB(db *sql.DB) {
+	currentDB.Store(db)
+}
+
+// Handler returns a Fiber handler serving the default registry in the Prometheus text format
+func Handler() fiber.Handler {
+	return adaptor.HTTPHandler(promhttp.Handler())
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+)
+
+// Audit entity types
+const (
+	AuditEntityInvoice     = "invoice"
+	AuditEntityInvoiceItem = "invoice_item"
+	// AuditEntityOrganizationMember entries have no invoice (InvoiceID 0)
+	AuditEntityOrganizationMember = "organization_member"
+)
+
+// Audit actions
+const (
+	AuditActionCreate       = "create"
+	AuditActionUpdate       = "update"
+	AuditActionStatusChange = "status_change"
+	AuditActionDelete       = "delete"
+)
+
+// AuditLog records a change made to an invoice or one of its items.
+// Diff maps each changed field to {"from": old, "to": new}; from is omitted on create
+// and to is omitted on delete. Entries are append-only and survive deletion of the invoice.
+type AuditLog struct {
+	ID         uint   `gorm:"primaryKey" json:"id"`
+	UserID     string `gorm:"index;not null;type:varchar(255)" json:"user_id"`
+	EntityType string `gorm:"not null;type:varchar(32)" json:"entity_type"`
+	EntityID   uint   `gorm:"not null;index:idx_audit_logs_entity_created,priority:1" json:"entity_id"`
+	// InvoiceID groups item entries with the trail of their invoice
+	InvoiceID uint   `gorm:"index;not null;index:idx_audit_logs_invoice_created,priority:1" json:"invoice_id"`
+	Action    string `gorm:"not null;type:varchar(32)" json:"action"`
+	ActorSub  string `gorm:"not null;type:varchar(255)" json:"actor_sub"`
+	Diff      JSON   `gorm:"type:text" json:"diff"`
+	// StatusChanged marks the invoice entries whose diff has the invoice status, which make up
+	// its status history
+	StatusChanged bool `gorm:"not null;default:false" json:"-"`
+
+	// Trails are read newest first per invoice or entity, hence the composite indexes
+	CreatedAt time.Time `gorm:"index:idx_audit_logs_entity_created,priority:2;index:idx_audit_logs_invoice_created,priority:2" json:"created_at"`
+}
+
+// TableName returns the table name for AuditLog
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
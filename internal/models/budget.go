@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// BudgetPeriod represents the recurring period a budget applies to
+type BudgetPeriod string
+
+const (
+	BudgetPeriodMonthly   BudgetPeriod = "monthly"
+	BudgetPeriodQuarterly BudgetPeriod = "quarterly"
+	BudgetPeriodYearly    BudgetPeriod = "yearly"
+)
+
+// Budget represents a spending limit for a category over a recurring period
+type Budget struct {
+	ID         uint             `gorm:"primaryKey" json:"id"`
+	UserID     string           `gorm:"index;not null;type:varchar(255)" json:"user_id"`
+	CategoryID uint             `gorm:"index;not null" json:"category_id"`
+	Category   *InvoiceCategory `gorm:"foreignKey:CategoryID" json:"category,omitempty"`
+	PeriodType BudgetPeriod     `gorm:"type:varchar(20);not null;default:'monthly'" json:"period_type"`
+	Amount     float64          `gorm:"not null" json:"amount"`
+	Currency   string           `gorm:"not null;type:varchar(3);default:'USD'" json:"currency"`
+
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+}
+
+// TableName returns the table name for Budget
+func (Budget) TableName() string {
+	return "budgets"
+}
@@ -0,0 +1,41 @@
+package models
+
+import "time"
+
+// ExportJobStatus is the progress of an export job
+type ExportJobStatus string
+
+const (
+	ExportJobPending   ExportJobStatus = "pending"
+	ExportJobRunning   ExportJobStatus = "running"
+	ExportJobCompleted ExportJobStatus = "completed"
+	ExportJobFailed    ExportJobStatus = "failed"
+	// ExportJobExpired is a completed job whose bundle was deleted after the bundle TTL
+	ExportJobExpired ExportJobStatus = "expired"
+)
+
+// ExportJob tracks a background export of a user's data to a bundle in S3
+type ExportJob struct {
+	ID     uint            `gorm:"primaryKey" json:"id"`
+	UserID string          `gorm:"index;not null;type:varchar(255)" json:"user_id"`
+	Status ExportJobStatus `gorm:"type:varchar(20);not null;default:'pending'" json:"status"`
+
+	// S3Key is the key of the bundle, set once the job has completed
+	S3Key string `gorm:"type:text" json:"s3_key,omitempty"`
+	// Error is why the job failed
+	Error string `gorm:"type:text" json:"error,omitempty"`
+
+	// Owner is the server instance running the job. It renews LeaseExpiresAt while the job is
+	// pending or running, so other instances only fail the job once the lease has expired.
+	Owner          string     `gorm:"type:varchar(255)" json:"-"`
+	LeaseExpiresAt *time.Time `gorm:"index" json:"-"`
+
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// TableName specifies the table name for ExportJob
+func (ExportJob) TableName() string {
+	return "export_jobs"
+}
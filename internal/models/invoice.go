@@ -4,6 +4,8 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
+	"math"
+	"strconv"
 	"time"
 
 	"gorm.io/gorm"
@@ -18,6 +20,58 @@ const (
 	InvoiceStatusOverdue InvoiceStatus = "overdue"
 )
 
+// DiscountType is how a discount value reduces an amount
+type DiscountType string
+
+const (
+	// DiscountTypePercent takes DiscountValue percent (0-100) off the amount
+	DiscountTypePercent DiscountType = "percent"
+	// DiscountTypeFixed takes DiscountValue off the amount, in the amount's currency
+	DiscountTypeFixed DiscountType = "fixed"
+)
+
+// ApplyDiscount returns amount less the discount. A fixed discount reduces the size of the
+// amount without crossing zero, so it shrinks a negative (refund) amount towards zero too;
+// an empty discount type leaves the amount unchanged.
+func ApplyDiscount(amount float64, discountType DiscountType, value float64) float64 {
+	switch discountType {
+	case DiscountTypePercent:
+		return amount - amount*value/100
+	case DiscountTypeFixed:
+		if amount < 0 {
+			return math.Min(amount+value, 0)
+		}
+		return math.Max(amount-value, 0)
+	}
+	return amount
+}
+
+// InvoiceRelationType is how an invoice relates to the invoice it is linked to
+type InvoiceRelationType string
+
+const (
+	// InvoiceRelationRefund is a refund of the related invoice
+	InvoiceRelationRefund InvoiceRelationType = "refund"
+	// InvoiceRelationCreditNote is a credit note issued against the related invoice
+	InvoiceRelationCreditNote InvoiceRelationType = "credit_note"
+	// InvoiceRelationCorrection replaces or corrects the related invoice
+	InvoiceRelationCorrection InvoiceRelationType = "correction"
+)
+
+// Valid reports whether the relation type is one of the known types
+func (t InvoiceRelationType) Valid() bool {
+	switch t {
+	case InvoiceRelationRefund, InvoiceRelationCreditNote, InvoiceRelationCorrection:
+		return true
+	}
+	return false
+}
+
+// Offsets reports whether an invoice with this relation gives money back on the related invoice
+func (t InvoiceRelationType) Offsets() bool {
+	return t == InvoiceRelationRefund || t == InvoiceRelationCreditNote
+}
+
 // StringArray is a custom type for storing string arrays in SQLite/databases
 type StringArray []string
 
@@ -54,13 +108,25 @@ func (s *StringArray) Scan(value interface{}) error {
 	return json.Unmarshal(bytes, s)
 }
 
+// MaxPaymentMethodLength is the maximum length of an invoice's payment method
+const MaxPaymentMethodLength = 100
+
 // Invoice represents a billing invoice
 type Invoice struct {
 	ID          uint   `gorm:"primaryKey" json:"id"`
-	UserID      string `gorm:"index;not null;type:varchar(255)" json:"user_id"`
+	UserID      string `gorm:"index;uniqueIndex:idx_invoices_user_number,where:invoice_number <> '';not null;type:varchar(255)" json:"user_id"`
 	Title       string `gorm:"not null;type:varchar(255)" json:"title"`
 	Description string `gorm:"type:text" json:"description"`
 
+	// OrganizationID is the organization the invoice is shared in; UserID is the user who
+	// created it. Nil only for invoices of users not yet migrated to organizations.
+	OrganizationID *uint `gorm:"index" json:"organization_id,omitempty"`
+
+	// InvoiceNumber is the human-readable sequential number (e.g. INV-2024-0001), unique per user
+	// (deleted invoices included). Empty for invoices created before numbering was introduced;
+	// see DisplayNumber.
+	InvoiceNumber string `gorm:"index;uniqueIndex:idx_invoices_user_number;type:varchar(64)" json:"invoice_number"`
+
 	// Billing cycle dates
 	InvoiceStartedAt *time.Time `json:"invoice_started_at"`
 	InvoiceEndedAt   *time.Time `json:"invoice_ended_at"`
@@ -69,8 +135,26 @@ type Invoice struct {
 	Amount   float64 `gorm:"not null;default:0" json:"amount"`
 	Currency string  `gorm:"not null;type:varchar(3);default:'USD'" json:"currency"`
 
+	// AmountCurrencyMixed is set when an item has a currency other than the invoice's,
+	// in which case Amount sums different currencies and only the items' target amounts add up
+	AmountCurrencyMixed bool `gorm:"not null;default:false" json:"amount_currency_mixed"`
+
+	// Invoice-level discount, applied to the sum of the (already discounted) items.
+	// Amount is the total after this discount; empty DiscountType means no discount.
+	DiscountType  DiscountType `gorm:"type:varchar(10);default:''" json:"discount_type,omitempty"`
+	DiscountValue float64      `gorm:"not null;default:0" json:"discount_value"`
+
+	// TaxRate is the tax percentage (0-100, e.g. 20 for 20% VAT) the invoice was charged at. It is
+	// recorded for reference: amounts are entered as charged, so it doesn't change them. Nil when
+	// not known.
+	TaxRate *float64 `json:"tax_rate,omitempty"`
+
+	// FXRateUsed is the average of the item FX rates weighted by their target amounts (see
+	// AverageFXRate), updated whenever the total is. 0 until the invoice has items.
+	FXRateUsed float64 `gorm:"not null;default:0" json:"fx_rate_used"`
+
 	// Note: target_amount column exists in DB but is deprecated.
-	// Analytics now calculate USD-normalized amounts from invoice_items.target_amount
+	// Analytics now calculate base-currency-normalized amounts from invoice_items.target_amount
 
 	// Relationships
 	CategoryID *uint            `gorm:"index" json:"category_id"`
@@ -80,12 +164,20 @@ type Invoice struct {
 	ReceiverID *uint            `gorm:"index" json:"receiver_id"`
 	Receiver   *InvoiceReceiver `gorm:"foreignKey:ReceiverID" json:"receiver,omitempty"`
 
+	// Link to another invoice of the same user, e.g. the invoice a credit note refunds.
+	// RelationType is empty when the invoice isn't linked.
+	RelatedInvoiceID *uint               `gorm:"index" json:"related_invoice_id,omitempty"`
+	RelationType     InvoiceRelationType `gorm:"type:varchar(20);default:''" json:"relation_type,omitempty"`
+
 	// Items (one-to-many)
 	Items []InvoiceItem `gorm:"foreignKey:InvoiceID" json:"items,omitempty"`
 
 	// File attachment
 	OriginalDownloadLink string `gorm:"type:text" json:"original_download_link"`
 
+	// Additional attachments (one-to-many)
+	Attachments []InvoiceAttachment `gorm:"foreignKey:InvoiceID" json:"attachments,omitempty"`
+
 	// Tags - many-to-many relationship
 	Tags []InvoiceTag `gorm:"many2many:invoice_tag_mappings" json:"tags,omitempty"`
 
@@ -96,6 +188,20 @@ type Invoice struct {
 	Status  InvoiceStatus `gorm:"type:varchar(20);default:'unpaid'" json:"status"`
 	DueDate *time.Time    `json:"due_date"`
 
+	// PaidAt is when the invoice was marked as paid; nil while it is not paid
+	PaidAt *time.Time `gorm:"index" json:"paid_at,omitempty"`
+
+	// PaymentMethod is the card or account the invoice was paid with (e.g. "Amex Gold"),
+	// at most MaxPaymentMethodLength characters; empty when not recorded
+	PaymentMethod string `gorm:"index;type:varchar(100);default:''" json:"payment_method,omitempty"`
+
+	// IsDraft marks a placeholder invoice that is left out of analytics and, by default,
+	// invoice lists until it is finalized
+	IsDraft bool `gorm:"not null;default:false;index" json:"is_draft"`
+
+	// Version is incremented on every update; updates based on an older version are rejected
+	Version int `gorm:"not null;default:1" json:"version"`
+
 	// Timestamps
 	CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
@@ -107,11 +213,49 @@ func (Invoice) TableName() string {
 	return "invoices"
 }
 
-// CalculateTotalFromItems calculates and updates the invoice amount from its items
+// DisplayNumber returns the invoice number, falling back to the ID for invoices without one
+func (i *Invoice) DisplayNumber() string {
+	if i.InvoiceNumber != "" {
+		return i.InvoiceNumber
+	}
+	return strconv.FormatUint(uint64(i.ID), 10)
+}
+
+// HasMixedCurrencies reports whether any item has a currency other than the invoice's
+func (i *Invoice) HasMixedCurrencies() bool {
+	for _, item := range i.Items {
+		if item.EffectiveCurrency(i.Currency) != i.Currency {
+			return true
+		}
+	}
+	return false
+}
+
+// CalculateTotalFromItems calculates and updates the invoice amount from its items, less the invoice discount
 func (i *Invoice) CalculateTotalFromItems() {
 	var total float64
 	for _, item := range i.Items {
 		total += item.Amount
 	}
-	i.Amount = total
+	i.Amount = ApplyDiscount(total, i.DiscountType, i.DiscountValue)
+}
+
+// AverageFXRate returns the FX rates of items averaged with the size of their target amounts as
+// weights, so it equals the shared rate when all items use one. Items whose target amounts are
+// all zero are averaged evenly; no items give 0.
+func AverageFXRate(items []InvoiceItem) float64 {
+	if len(items) == 0 {
+		return 0
+	}
+	var weighted, weights, sum float64
+	for _, item := range items {
+		weight := math.Abs(item.TargetAmount)
+		weighted += item.FXRateUsed * weight
+		weights += weight
+		sum += item.FXRateUsed
+	}
+	if weights == 0 {
+		return sum / float64(len(items))
+	}
+	return weighted / weights
 }
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// InvoiceAttachment represents an additional file (e.g. supporting receipt) attached to an invoice
+type InvoiceAttachment struct {
+	ID          uint   `gorm:"primaryKey" json:"id"`
+	InvoiceID   uint   `gorm:"index;not null" json:"invoice_id"`
+	S3Key       string `gorm:"not null;type:text" json:"s3_key"`
+	Filename    string `gorm:"not null;type:varchar(255)" json:"filename"`
+	ContentType string `gorm:"type:varchar(255)" json:"content_type"`
+	Size        int64  `json:"size"`
+
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+}
+
+// TableName returns the table name for InvoiceAttachment
+func (InvoiceAttachment) TableName() string {
+	return "invoice_attachments"
+}
@@ -8,14 +8,20 @@ import (
 
 // InvoiceCategory represents a category for organizing invoices
 type InvoiceCategory struct {
-	ID          uint           `gorm:"primaryKey" json:"id"`
-	UserID      string         `gorm:"index;not null;type:varchar(255)" json:"user_id"`
-	Name        string         `gorm:"not null;type:varchar(255)" json:"name"`
-	Description string         `gorm:"type:text" json:"description"`
-	Color       string         `gorm:"type:varchar(7)" json:"color"` // Hex color for UI (e.g., #FF5733)
-	CreatedAt   time.Time      `json:"created_at"`
-	UpdatedAt   time.Time      `json:"updated_at"`
-	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
+	ID          uint   `gorm:"primaryKey" json:"id"`
+	UserID      string `gorm:"index;not null;type:varchar(255)" json:"user_id"`
+	Name        string `gorm:"not null;type:varchar(255)" json:"name"`
+	Description string `gorm:"type:text" json:"description"`
+	Color       string `gorm:"type:varchar(7)" json:"color"` // Hex color for UI (e.g., #FF5733)
+	// DefaultCurrency is used for new invoices in this category that don't specify a currency;
+	// empty means no default
+	DefaultCurrency string `gorm:"type:varchar(3);default:''" json:"default_currency,omitempty"`
+	// DefaultTaxRate is the tax rate (a percentage) of new invoices in this category that don't
+	// specify one; 0 means no default
+	DefaultTaxRate float64        `gorm:"not null;default:0" json:"default_tax_rate,omitempty"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+	DeletedAt      gorm.DeletedAt `gorm:"index" json:"-"`
 }
 
 // TableName returns the table name for InvoiceCategory
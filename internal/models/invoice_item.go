@@ -6,19 +6,48 @@ import (
 	"gorm.io/gorm"
 )
 
+// MaxItemUnitLength is the maximum length of an item's unit of measure
+const MaxItemUnitLength = 20
+
 // InvoiceItem represents a line item within an invoice
 type InvoiceItem struct {
 	ID          uint    `gorm:"primaryKey" json:"id"`
 	InvoiceID   uint    `gorm:"index;not null" json:"invoice_id"`
 	Description string  `gorm:"not null;type:varchar(255)" json:"description"`
-	Quantity    float64 `gorm:"not null;default:1" json:"quantity"`
+	Quantity    float64 `gorm:"not null" json:"quantity"`
 	UnitPrice   float64 `gorm:"not null;default:0" json:"unit_price"`
-	Amount      float64 `gorm:"not null;default:0" json:"amount"` // Computed: Quantity * UnitPrice
+	Amount      float64 `gorm:"not null;default:0" json:"amount"` // Computed: Quantity * UnitPrice less the discount
+
+	// Unit of measure of Quantity (e.g., "hour", "kg"); purely descriptive, empty when not set
+	Unit string `gorm:"type:varchar(20);default:''" json:"unit,omitempty"`
+
+	// Item discount; empty DiscountType means no discount
+	DiscountType  DiscountType `gorm:"type:varchar(10);default:''" json:"discount_type,omitempty"`
+	DiscountValue float64      `gorm:"not null;default:0" json:"discount_value"`
+
+	// Currency of UnitPrice and Amount; empty means the item uses the invoice currency
+	Currency string `gorm:"type:varchar(3);default:''" json:"currency,omitempty"`
+
+	// Category of the item for split invoices; nil means the item uses the invoice category
+	CategoryID *uint `gorm:"index" json:"category_id,omitempty"`
 
-	// Currency conversion fields (for analytics normalization to USD)
+	// Display order within the invoice (ascending)
+	Position int `gorm:"not null;default:0" json:"position"`
+
+	// Currency conversion fields (for analytics normalization to the user's base currency)
 	TargetCurrency string  `gorm:"type:varchar(3);default:'USD'" json:"target_currency"`
 	TargetAmount   float64 `gorm:"default:0" json:"target_amount"`
 	FXRateUsed     float64 `gorm:"default:1" json:"fx_rate_used"`
+	// FXStale is set when FXRateUsed is the last known rate because no FX provider could be reached
+	FXStale bool `gorm:"not null;default:false" json:"fx_stale"`
+	// FXRateDate is the date (YYYY-MM-DD) the FX provider quoted FXRateUsed for; empty for 1:1
+	// conversions, manual overrides, and items converted before the date was recorded
+	FXRateDate string `gorm:"type:varchar(10);default:''" json:"fx_rate_date,omitempty"`
+	// FXManual is set when TargetAmount was entered by hand; FXRateUsed is then the implied rate
+	FXManual bool `gorm:"not null;default:false" json:"fx_manual"`
+	// FXUnsupported is set when the item's currency isn't ISO 4217, so TargetAmount is Amount
+	// unconverted (FXRateUsed 1) and unreliable in the base currency
+	FXUnsupported bool `gorm:"not null;default:false" json:"fx_unsupported"`
 
 	CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
@@ -30,9 +59,27 @@ func (InvoiceItem) TableName() string {
 	return "invoice_items"
 }
 
-// CalculateAmount calculates and sets the amount based on quantity and unit price
+// ItemQuantity returns the quantity of a new item given the supplied one (nil when omitted).
+// An omitted quantity, or 0 on a priced line, defaults to 1; an explicit 0 on a line with a zero
+// unit price and negative quantities (refund or adjustment lines) are kept.
+func ItemQuantity(quantity *float64, unitPrice float64) float64 {
+	if quantity == nil || (*quantity == 0 && unitPrice != 0) {
+		return 1
+	}
+	return *quantity
+}
+
+// CalculateAmount calculates and sets the amount based on quantity, unit price, and discount
 func (i *InvoiceItem) CalculateAmount() {
-	i.Amount = i.Quantity * i.UnitPrice
+	i.Amount = ApplyDiscount(i.Quantity*i.UnitPrice, i.DiscountType, i.DiscountValue)
+}
+
+// EffectiveCurrency returns the item's own currency, falling back to the invoice currency
+func (i *InvoiceItem) EffectiveCurrency(invoiceCurrency string) string {
+	if i.Currency != "" {
+		return i.Currency
+	}
+	return invoiceCurrency
 }
 
 // BeforeCreate hook to calculate amount before saving
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+)
+
+// InvoiceNumberSequence tracks the last invoice number issued for a user and rendered prefix.
+// Keying by the rendered prefix restarts numbering when the prefix (or the year in it) changes.
+type InvoiceNumberSequence struct {
+	ID        uint   `gorm:"primaryKey" json:"id"`
+	UserID    string `gorm:"uniqueIndex:idx_invoice_number_sequences_user_prefix;not null;type:varchar(255)" json:"user_id"`
+	Prefix    string `gorm:"uniqueIndex:idx_invoice_number_sequences_user_prefix;not null;type:varchar(64)" json:"prefix"`
+	LastValue int64  `gorm:"not null;default:0" json:"last_value"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName returns the table name for InvoiceNumberSequence
+func (InvoiceNumberSequence) TableName() string {
+	return "invoice_number_sequences"
+}
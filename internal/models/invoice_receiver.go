@@ -6,16 +6,33 @@ import (
 	"gorm.io/gorm"
 )
 
+// Maximum lengths of the receiver contact details
+const (
+	MaxReceiverEmailLength       = 255
+	MaxReceiverPhoneLength       = 50
+	MaxReceiverAddressLength     = 1000
+	MaxReceiverBankAccountLength = 255
+	MaxReceiverTaxIDLength       = 100
+)
+
 // InvoiceReceiver represents a receiver entity for invoices
 type InvoiceReceiver struct {
-	ID             uint           `gorm:"primaryKey" json:"id"`
-	UserID         string         `gorm:"index;not null;type:varchar(255)" json:"user_id"`
-	Name           string         `gorm:"not null;type:varchar(255)" json:"name"`
-	OtherNames     StringArray    `gorm:"type:text" json:"other_names"`
-	IsOrganization bool           `gorm:"not null;default:false" json:"is_organization"`
-	CreatedAt      time.Time      `json:"created_at"`
-	UpdatedAt      time.Time      `json:"updated_at"`
-	DeletedAt      gorm.DeletedAt `gorm:"index" json:"-"`
+	ID             uint        `gorm:"primaryKey" json:"id"`
+	UserID         string      `gorm:"index;not null;type:varchar(255)" json:"user_id"`
+	Name           string      `gorm:"not null;type:varchar(255)" json:"name"`
+	OtherNames     StringArray `gorm:"type:text" json:"other_names"`
+	IsOrganization bool        `gorm:"not null;default:false" json:"is_organization"`
+
+	// Optional contact and payment details, shown on vendor statements; empty when not recorded
+	Email       string `gorm:"type:varchar(255);default:''" json:"email,omitempty"`
+	Phone       string `gorm:"type:varchar(50);default:''" json:"phone,omitempty"`
+	Address     string `gorm:"type:text;default:''" json:"address,omitempty"`
+	BankAccount string `gorm:"type:varchar(255);default:''" json:"bank_account,omitempty"`
+	TaxID       string `gorm:"type:varchar(100);default:''" json:"tax_id,omitempty"`
+
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
 }
 
 // TableName returns the table name for InvoiceReceiver
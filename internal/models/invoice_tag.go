@@ -7,11 +7,13 @@ import (
 )
 
 // InvoiceTag represents a tag for organizing invoices
+// Tags form a hierarchy through ParentID; a tag without a parent is a top-level tag
 type InvoiceTag struct {
 	ID        uint           `gorm:"primaryKey" json:"id"`
 	UserID    string         `gorm:"index;not null;type:varchar(255)" json:"user_id"`
 	Name      string         `gorm:"not null;type:varchar(100)" json:"name"`
 	Color     string         `gorm:"type:varchar(7)" json:"color"` // Hex color code (e.g., #FF5733)
+	ParentID  *uint          `gorm:"index" json:"parent_id,omitempty"`
 	CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
 	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
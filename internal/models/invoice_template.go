@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// InvoiceTemplateItem is a default line item of an invoice template
+type InvoiceTemplateItem struct {
+	Description   string       `json:"description"`
+	Quantity      float64      `json:"quantity"`
+	Unit          string       `json:"unit,omitempty"`
+	UnitPrice     float64      `json:"unit_price"`
+	Currency      string       `json:"currency,omitempty"`
+	CategoryID    *uint        `json:"category_id,omitempty"`
+	DiscountType  DiscountType `json:"discount_type,omitempty"`
+	DiscountValue float64      `json:"discount_value,omitempty"`
+}
+
+// InvoiceTemplate holds the defaults of invoices the user creates by hand again and again.
+// Templates are only applied on request and never create invoices on their own.
+type InvoiceTemplate struct {
+	ID     uint   `gorm:"primaryKey" json:"id"`
+	UserID string `gorm:"index;not null;type:varchar(255)" json:"user_id"`
+	Name   string `gorm:"not null;type:varchar(255)" json:"name"`
+
+	// Defaults of invoices created from the template
+	Title       string                `gorm:"type:varchar(255)" json:"title"`
+	Description string                `gorm:"type:text" json:"description"`
+	Currency    string                `gorm:"type:varchar(3);default:'USD'" json:"currency"`
+	CategoryID  *uint                 `json:"category_id,omitempty"`
+	CompanyID   *uint                 `json:"company_id,omitempty"`
+	ReceiverID  *uint                 `json:"receiver_id,omitempty"`
+	Items       []InvoiceTemplateItem `gorm:"type:text;serializer:json" json:"items"`
+	// Tags are tag names, created on apply if they no longer exist
+	Tags []string `gorm:"type:text;serializer:json" json:"tags"`
+
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+}
+
+// TableName returns the table name for InvoiceTemplate
+func (InvoiceTemplate) TableName() string {
+	return "invoice_templates"
+}
@@ -0,0 +1,62 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// OrganizationRole is what a member may do with the invoices of an organization
+type OrganizationRole string
+
+const (
+	// OrganizationRoleOwner manages the organization's members and creates invoices in it
+	OrganizationRoleOwner OrganizationRole = "owner"
+	// OrganizationRoleMember creates invoices in the organization and sees all of its invoices
+	OrganizationRoleMember OrganizationRole = "member"
+	// OrganizationRoleViewer only sees the organization's invoices
+	OrganizationRoleViewer OrganizationRole = "viewer"
+)
+
+// Valid reports whether the role is one of the known roles
+func (r OrganizationRole) Valid() bool {
+	return r == OrganizationRoleOwner || r == OrganizationRoleMember || r == OrganizationRoleViewer
+}
+
+// CanCreateInvoices reports whether the role may create invoices in the organization
+func (r OrganizationRole) CanCreateInvoices() bool {
+	return r == OrganizationRoleOwner || r == OrganizationRoleMember
+}
+
+// Organization groups users who share invoices. Invoices belong to an organization and are seen
+// by all of its members, while Invoice.UserID keeps tracking the user who created them.
+type Organization struct {
+	ID   uint   `gorm:"primaryKey" json:"id"`
+	Name string `gorm:"not null;type:varchar(255)" json:"name"`
+	// PersonalUserID is set on the organization created for a user's own invoices, which new
+	// invoices go to by default; it is nil on organizations created explicitly
+	PersonalUserID *string        `gorm:"uniqueIndex;type:varchar(255)" json:"personal_user_id,omitempty"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+	DeletedAt      gorm.DeletedAt `gorm:"index" json:"-"`
+}
+
+// TableName returns the table name for Organization
+func (Organization) TableName() string {
+	return "organizations"
+}
+
+// OrganizationMember is a user's membership of an organization
+type OrganizationMember struct {
+	ID             uint             `gorm:"primaryKey" json:"id"`
+	OrganizationID uint             `gorm:"not null;uniqueIndex:idx_organization_member" json:"organization_id"`
+	UserID         string           `gorm:"not null;type:varchar(255);uniqueIndex:idx_organization_member;index" json:"user_id"`
+	Role           OrganizationRole `gorm:"not null;type:varchar(20)" json:"role"`
+	CreatedAt      time.Time        `json:"created_at"`
+	UpdatedAt      time.Time        `json:"updated_at"`
+}
+
+// TableName returns the table name for OrganizationMember
+func (OrganizationMember) TableName() string {
+	return "organization_members"
+}
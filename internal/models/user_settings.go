@@ -0,0 +1,73 @@
+package models
+
+import (
+	"time"
+)
+
+// UnsupportedCurrencyPolicy is how invoices in a currency without exchange rates are handled
+type UnsupportedCurrencyPolicy string
+
+const (
+	// UnsupportedCurrencyReject refuses invoices and items in such a currency
+	UnsupportedCurrencyReject UnsupportedCurrencyPolicy = "reject"
+	// UnsupportedCurrencyPassThrough stores their items at a 1:1 rate, flagged FXUnsupported
+	UnsupportedCurrencyPassThrough UnsupportedCurrencyPolicy = "pass_through"
+)
+
+// PaymentTrackingMode is what decides whether an invoice is paid
+type PaymentTrackingMode string
+
+const (
+	// PaymentTrackingManual makes the invoice status, set by the user, the source of truth
+	PaymentTrackingManual PaymentTrackingMode = "manual"
+	// PaymentTrackingPayments would derive the status from recorded payments. It is rejected until
+	// payment records exist.
+	PaymentTrackingPayments PaymentTrackingMode = "payments"
+)
+
+// UserSettings holds per-user preferences
+type UserSettings struct {
+	ID     uint   `gorm:"primaryKey" json:"id"`
+	UserID string `gorm:"uniqueIndex;not null;type:varchar(255)" json:"user_id"`
+
+	// BaseCurrency is the currency invoice item target amounts and analytics are normalized to
+	BaseCurrency string `gorm:"not null;type:varchar(3);default:'USD'" json:"base_currency"`
+
+	// InvoiceNumberPrefix is prepended to invoice sequence numbers; {year} is replaced by the current year
+	InvoiceNumberPrefix string `gorm:"not null;type:varchar(32);default:'INV-{year}-'" json:"invoice_number_prefix"`
+	// InvoiceNumberPadding is the minimum number of digits of the sequence number (zero-padded)
+	InvoiceNumberPadding int `gorm:"not null;default:4" json:"invoice_number_padding"`
+
+	// Email receives notifications such as the overdue invoice digest; empty disables them
+	Email string `gorm:"type:varchar(255)" json:"email"`
+
+	// Timezone is the IANA name of the timezone statistics bucket days in
+	Timezone string `gorm:"not null;type:varchar(64);default:'UTC'" json:"timezone"`
+
+	// Branding for invoice documents the user renders; all optional
+	CompanyName    string `gorm:"type:varchar(255)" json:"company_name"`
+	CompanyAddress string `gorm:"type:text" json:"company_address"`
+	// LogoS3Key is the storage key of an uploaded logo image
+	LogoS3Key string `gorm:"type:text" json:"logo_s3_key"`
+
+	// Sanity bounds on the magnitude of invoice item values, to catch typos such as an extra
+	// few zeros. Unit prices and line amounts are compared in the base currency.
+	MaxItemUnitPrice  float64 `gorm:"not null;default:10000000" json:"max_item_unit_price"`
+	MaxItemQuantity   float64 `gorm:"not null;default:1000000" json:"max_item_quantity"`
+	MaxItemLineAmount float64 `gorm:"not null;default:100000000" json:"max_item_line_amount"`
+
+	// UnsupportedCurrency is what happens to amounts in a currency that isn't ISO 4217 and so has
+	// no exchange rate
+	UnsupportedCurrency UnsupportedCurrencyPolicy `gorm:"not null;type:varchar(20);default:'reject'" json:"unsupported_currency"`
+
+	// PaymentTrackingMode decides whether an invoice is paid; only manual is supported for now
+	PaymentTrackingMode PaymentTrackingMode `gorm:"not null;type:varchar(20);default:'manual'" json:"payment_tracking_mode"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName returns the table name for UserSettings
+func (UserSettings) TableName() string {
+	return "user_settings"
+}
@@ -0,0 +1,341 @@
+package services
+
+import (
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AnalyticsCacheTTL is how long a cached analytics summary is served before it is recomputed
+const AnalyticsCacheTTL = 60 * time.Second
+
+// analyticsCacheKey identifies one cached result: the method that computed it, for a user and period
+type analyticsCacheKey struct {
+	userID string
+	period AnalyticsPeriod
+	method string
+}
+
+type analyticsCacheEntry struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+// analyticsCache is an in-process cache of analytics results, safe for concurrent use
+type analyticsCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[analyticsCacheKey]analyticsCacheEntry
+}
+
+func newAnalyticsCache(ttl time.Duration) *analyticsCache {
+	return &analyticsCache{ttl: ttl, entries: make(map[analyticsCacheKey]analyticsCacheEntry)}
+}
+
+// get returns the unexpired value cached under key
+func (c *analyticsCache) get(key analyticsCacheKey) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (c *analyticsCache) set(key analyticsCacheKey, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = analyticsCacheEntry{value: value, expiresAt: time.Now().Add(c.ttl)}
+}
+
+// clear drops every cached value
+func (c *analyticsCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[analyticsCacheKey]analyticsCacheEntry)
+}
+
+// clearUsers drops the values cached for the given users
+func (c *analyticsCache) clearUsers(userIDs []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.entries {
+		if slices.Contains(userIDs, key.userID) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// analyticsUnreadTables are the tables no cached result is computed from, so writing them drops nothing
+var analyticsUnreadTables = map[string]bool{
+	"organizations":            true,
+	"organization_members":     true,
+	"audit_logs":               true,
+	"export_jobs":              true,
+	"file_uploads":             true,
+	"invoice_attachments":      true,
+	"invoice_number_sequences": true,
+}
+
+var (
+	analyticsCachesMu sync.Mutex
+	// analyticsCaches holds the cache of each database, by its config, which holds its callbacks
+	analyticsCaches = make(map[*gorm.Config]*analyticsCache)
+)
+
+// analyticsCacheFor returns the analytics cache shared by every service on db, creating it and
+// registering its invalidation the first time
+func analyticsCacheFor(db *gorm.DB) (*analyticsCache, error) {
+	analyticsCachesMu.Lock()
+	defer analyticsCachesMu.Unlock()
+	if cache, ok := analyticsCaches[db.Config]; ok {
+		return cache, nil
+	}
+	cache := newAnalyticsCache(AnalyticsCacheTTL)
+	if err := cache.registerInvalidation(db); err != nil {
+		return nil, err
+	}
+	analyticsCaches[db.Config] = cache
+	return cache, nil
+}
+
+// registerInvalidation drops cached results after every create, update, delete, and raw statement
+// run through db, whichever service runs it, so they never outlive a change to the data. Only the
+// results of the users owning the changed rows are dropped (see changedUserIDs), and none for
+// writes to analyticsUnreadTables; raw statements and writes whose owner can't be told clear the
+// whole cache. A write in a transaction that is still open when the cache is repopulated can leave
+// a stale result for at most the TTL.
+func (c *analyticsCache) registerInvalidation(db *gorm.DB) error {
+	const name = "analytics_cache:invalidate"
+	invalidate := func(tx *gorm.DB) {
+		if tx.Error != nil || analyticsUnreadTables[tx.Statement.Table] {
+			return
+		}
+		if userIDs, ok := changedUserIDs(tx); ok {
+			c.clearUsers(userIDs)
+			return
+		}
+		c.clear()
+	}
+
+	callbacks := db.Callback()
+	if err := callbacks.Create().After("gorm:create").Register(name, invalidate); err != nil {
+		return err
+	}
+	if err := callbacks.Update().After("gorm:update").Register(name, invalidate); err != nil {
+		return err
+	}
+	if err := callbacks.Delete().After("gorm:delete").Register(name, invalidate); err != nil {
+		return err
+	}
+	return callbacks.Raw().After("gorm:raw").Register(name, invalidate)
+}
+
+// changedUserIDs returns the users owning the rows a create, update, or delete changed: from the
+// rows' user_id, else from a user_id = ? condition, else from the owners of the invoices the rows
+// are or belong to through invoice_id. It reports false when the owner can't be told.
+func changedUserIDs(tx *gorm.DB) ([]string, bool) {
+	stmt := tx.Statement
+	if stmt.Schema == nil || stmt.SQL.Len() == 0 {
+		return nil, false
+	}
+
+	if values, ok := statementColumnValues(stmt, "user_id"); ok {
+		userIDs := make([]string, 0, len(values))
+		for _, value := range values {
+			userID, ok := value.(string)
+			if !ok {
+				return nil, false
+			}
+			userIDs = append(userIDs, userID)
+		}
+		return userIDs, true
+	}
+
+	invoiceColumn := "invoice_id"
+	if stmt.Table == "invoices" {
+		invoiceColumn = "id"
+	}
+	if values, ok := statementColumnValues(stmt, invoiceColumn); ok {
+		var userIDs []string
+		if err := tx.Session(&gorm.Session{NewDB: true}).Unscoped().Model(&models.Invoice{}).
+			Where("id IN ?", values).Distinct().Pluck("user_id", &userIDs).Error; err != nil {
+			return nil, false
+		}
+		return userIDs, true
+	}
+	return nil, false
+}
+
+// userConditionPattern matches a "column = ?" condition, optionally qualified by its table
+var userConditionPattern = regexp.MustCompile(`(?i)(?:^|[^\w.])(?:\w+\.)?(\w+)\s*=\s*\?`)
+
+// orPattern matches an OR, which makes a condition on a column say nothing about the rows changed
+var orPattern = regexp.MustCompile(`(?i)\bor\b`)
+
+// statementColumnValues returns the non-zero values of column for the rows stmt changed, taken from
+// the rows themselves or from a column = ? condition ANDed into its WHERE clause
+func statementColumnValues(stmt *gorm.Statement, column string) ([]interface{}, bool) {
+	if field := stmt.Schema.LookUpField(column); field != nil && stmt.ReflectValue.IsValid() {
+		var values []interface{}
+		collect := func(row reflect.Value) bool {
+			value, zero := field.ValueOf(stmt.Context, row)
+			values = append(values, value)
+			return !zero
+		}
+		complete := true
+		switch stmt.ReflectValue.Kind() {
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < stmt.ReflectValue.Len() && complete; i++ {
+				complete = collect(reflect.Indirect(stmt.ReflectValue.Index(i)))
+			}
+			complete = complete && len(values) > 0
+		case reflect.Struct:
+			complete = collect(stmt.ReflectValue)
+		default:
+			complete = false
+		}
+		if complete {
+			return values, true
+		}
+	}
+
+	where, ok := stmt.Clauses["WHERE"].Expression.(clause.Where)
+	if !ok || hasOrCondition(where.Exprs) {
+		return nil, false
+	}
+	return conditionValue(where.Exprs, column)
+}
+
+// hasOrCondition reports whether any of the WHERE expressions is ORed with the others
+func hasOrCondition(exprs []clause.Expression) bool {
+	for _, expr := range exprs {
+		if _, ok := expr.(clause.OrConditions); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// conditionValue returns the value of a column = ? condition among the ANDed expressions
+func conditionValue(exprs []clause.Expression, column string) ([]interface{}, bool) {
+	for _, expr := range exprs {
+		switch e := expr.(type) {
+		case clause.Eq:
+			name, ok := e.Column.(string)
+			if col, isColumn := e.Column.(clause.Column); isColumn {
+				name, ok = col.Name, true
+			}
+			if ok && strings.EqualFold(name[strings.LastIndex(name, ".")+1:], column) && e.Value != nil {
+				return []interface{}{e.Value}, true
+			}
+		case clause.AndConditions:
+			if hasOrCondition(e.Exprs) {
+				continue
+			}
+			if values, ok := conditionValue(e.Exprs, column); ok {
+				return values, true
+			}
+		case clause.Expr:
+			if orPattern.MatchString(e.SQL) {
+				continue
+			}
+			for _, match := range userConditionPattern.FindAllStringSubmatchIndex(e.SQL, -1) {
+				if !strings.EqualFold(e.SQL[match[2]:match[3]], column) {
+					continue
+				}
+				// The condition's placeholder is the one ending the match
+				index := strings.Count(e.SQL[:match[1]], "?") - 1
+				if index < len(e.Vars) {
+					return []interface{}{e.Vars[index]}, true
+				}
+			}
+		}
+	}
+	return nil, false
+}
+
+// cachedAnalyticsService serves the period summaries and breakdowns of the wrapped service from an
+// analyticsCache. Cached results are shared between callers and must not be modified.
+type cachedAnalyticsService struct {
+	AnalyticsService
+	cache *analyticsCache
+}
+
+// cached returns the result of load cached under (userID, period, method), computing and caching
+// it on a miss. Errors are not cached.
+func (s *cachedAnalyticsService) cached(userID string, period AnalyticsPeriod, method string, load func() (interface{}, error)) (interface{}, error) {
+	key := analyticsCacheKey{userID: userID, period: period, method: method}
+	if value, ok := s.cache.get(key); ok {
+		return value, nil
+	}
+	value, err := load()
+	if err != nil {
+		return nil, err
+	}
+	s.cache.set(key, value)
+	return value, nil
+}
+
+// cachedSummary caches a method returning an *AnalyticsSummary
+func (s *cachedAnalyticsService) cachedSummary(userID string, period AnalyticsPeriod, method string, load func(string, AnalyticsPeriod) (*AnalyticsSummary, error)) (*AnalyticsSummary, error) {
+	value, err := s.cached(userID, period, method, func() (interface{}, error) { return load(userID, period) })
+	if err != nil {
+		return nil, err
+	}
+	return value.(*AnalyticsSummary), nil
+}
+
+// cachedByGroup caches a method returning an *AnalyticsByGroup
+func (s *cachedAnalyticsService) cachedByGroup(userID string, period AnalyticsPeriod, method string, load func(string, AnalyticsPeriod) (*AnalyticsByGroup, error)) (*AnalyticsByGroup, error) {
+	value, err := s.cached(userID, period, method, func() (interface{}, error) { return load(userID, period) })
+	if err != nil {
+		return nil, err
+	}
+	return value.(*AnalyticsByGroup), nil
+}
+
+func (s *cachedAnalyticsService) GetSummary(userID string, period AnalyticsPeriod) (*AnalyticsSummary, error) {
+	return s.cachedSummary(userID, period, "summary", s.AnalyticsService.GetSummary)
+}
+
+func (s *cachedAnalyticsService) GetSummaryByPaymentDate(userID string, period AnalyticsPeriod) (*AnalyticsSummary, error) {
+	return s.cachedSummary(userID, period, "summary_by_payment_date", s.AnalyticsService.GetSummaryByPaymentDate)
+}
+
+func (s *cachedAnalyticsService) GetByCategory(userID string, period AnalyticsPeriod) (*AnalyticsByGroup, error) {
+	return s.cachedByGroup(userID, period, "by_category", s.AnalyticsService.GetByCategory)
+}
+
+func (s *cachedAnalyticsService) GetByCompany(userID string, period AnalyticsPeriod) (*AnalyticsByGroup, error) {
+	return s.cachedByGroup(userID, period, "by_company", s.AnalyticsService.GetByCompany)
+}
+
+func (s *cachedAnalyticsService) GetByReceiver(userID string, period AnalyticsPeriod) (*AnalyticsByGroup, error) {
+	return s.cachedByGroup(userID, period, "by_receiver", s.AnalyticsService.GetByReceiver)
+}
+
+func (s *cachedAnalyticsService) GetByTag(userID string, period AnalyticsPeriod) (*AnalyticsByGroup, error) {
+	return s.cachedByGroup(userID, period, "by_tag", s.AnalyticsService.GetByTag)
+}
+
+func (s *cachedAnalyticsService) GetByTagWithChildren(userID string, period AnalyticsPeriod) (*AnalyticsByGroup, error) {
+	return s.cachedByGroup(userID, period, "by_tag_with_children", s.AnalyticsService.GetByTagWithChildren)
+}
+
+// WithoutCache returns the wrapped service, which always recomputes
+func (s *cachedAnalyticsService) WithoutCache() AnalyticsService {
+	return s.AnalyticsService
+}
@@ -1,6 +1,10 @@
 package services
 
 import (
+	"fmt"
+	"log"
+	"math"
+	"sort"
 	"time"
 
 	"github.com/rxtech-lab/invoice-management/internal/models"
@@ -16,11 +20,23 @@ const (
 	Period1Year  AnalyticsPeriod = "1y"
 )
 
+// SummaryPaidBasis selects which date places paid invoices in a summary period
+type SummaryPaidBasis string
+
+const (
+	// PaidByInvoiceDate counts paid invoices by due date, falling back to created_at, like every other bucket
+	PaidByInvoiceDate SummaryPaidBasis = "invoice_date"
+	// PaidByPaymentDate counts invoices paid within the period by paid_at, however old they are
+	PaidByPaymentDate SummaryPaidBasis = "payment_date"
+)
+
 // AnalyticsSummary represents aggregated invoice statistics
 type AnalyticsSummary struct {
 	Period        string    `json:"period"`
 	StartDate     time.Time `json:"start_date"`
 	EndDate       time.Time `json:"end_date"`
+	Currency      string    `json:"currency"`
+	PaidBy        string    `json:"paid_by"`
 	TotalAmount   float64   `json:"total_amount"`
 	PaidAmount    float64   `json:"paid_amount"`
 	UnpaidAmount  float64   `json:"unpaid_amount"`
@@ -29,6 +45,9 @@ type AnalyticsSummary struct {
 	PaidCount     int64     `json:"paid_count"`
 	UnpaidCount   int64     `json:"unpaid_count"`
 	OverdueCount  int64     `json:"overdue_count"`
+	// FXUnsupportedCount is the number of invoices in the total with items in a currency without
+	// exchange rates, which are counted 1:1 (see models.InvoiceItem.FXUnsupported)
+	FXUnsupportedCount int64 `json:"fx_unsupported_count"`
 }
 
 // AnalyticsGroupItem represents a single group's statistics
@@ -47,6 +66,7 @@ type AnalyticsByGroup struct {
 	Period        string               `json:"period"`
 	StartDate     time.Time            `json:"start_date"`
 	EndDate       time.Time            `json:"end_date"`
+	Currency      string               `json:"currency"`
 	Items         []AnalyticsGroupItem `json:"items"`
 	Uncategorized *AnalyticsGroupItem  `json:"uncategorized,omitempty"`
 }
@@ -70,11 +90,41 @@ const (
 	GroupByDay      StatisticsGroupBy = "day"
 	GroupByWeek     StatisticsGroupBy = "week"
 	GroupByMonth    StatisticsGroupBy = "month"
+	GroupByQuarter  StatisticsGroupBy = "quarter"
 	GroupByCategory StatisticsGroupBy = "category"
 	GroupByCompany  StatisticsGroupBy = "company"
 	GroupByReceiver StatisticsGroupBy = "receiver"
+	// GroupByPaymentMethod groups by the invoice payment method, with invoices without
+	// one under UnspecifiedPaymentMethod
+	GroupByPaymentMethod StatisticsGroupBy = "payment_method"
+)
+
+// StatisticsDateField selects which invoice date statistics filter and group on
+type StatisticsDateField string
+
+const (
+	// DateFieldDefault uses the due date, falling back to created_at when it is not set
+	DateFieldDefault          StatisticsDateField = ""
+	DateFieldCreatedAt        StatisticsDateField = "created_at"
+	DateFieldInvoiceStartedAt StatisticsDateField = "invoice_started_at"
+	DateFieldDueDate          StatisticsDateField = "due_date"
 )
 
+// column returns the SQL expression for the date field, with columns prefixed by prefix (e.g. "invoices.")
+func (f StatisticsDateField) column(prefix string) string {
+	switch f {
+	case DateFieldCreatedAt, DateFieldInvoiceStartedAt, DateFieldDueDate:
+		return prefix + string(f)
+	default:
+		return "COALESCE(" + prefix + "due_date, " + prefix + "created_at)"
+	}
+}
+
+// nullable reports whether invoices may have no value for the date field
+func (f StatisticsDateField) nullable() bool {
+	return f == DateFieldInvoiceStartedAt || f == DateFieldDueDate
+}
+
 // StatisticsOptions contains filtering and grouping options for statistics
 type StatisticsOptions struct {
 	Period              StatisticsPeriod
@@ -83,10 +133,53 @@ type StatisticsOptions struct {
 	CompanyID           *uint
 	ReceiverID          *uint
 	TagIDs              []uint
+	TagMatch            string // "any" (default) or "all": whether invoices need any or all of TagIDs
 	Status              *models.InvoiceStatus
 	Keyword             string
+	ExcludeKeyword      string // Drops invoices whose title or description contains it (ANDed with Keyword)
 	GroupBy             StatisticsGroupBy
 	IncludeAggregations bool
+	DateField           StatisticsDateField
+
+	// Limit keeps only the top N breakdown items by amount for entity groupings
+	// (category, company, receiver, payment method); 0 returns all of them
+	Limit int
+	// OthersBucket collapses the items cut by Limit into a single "Other" item
+	OthersBucket bool
+	// NetRefunds counts refunds and credit notes as negative amounts, netting them out
+	// against the category and company of the invoice they are linked to
+	NetRefunds bool
+	// Timezone is the IANA name of the timezone day grouping buckets days in;
+	// empty uses the user's timezone setting
+	Timezone string
+	// StartDate and EndDate, which must be set together, replace the window derived from Period
+	// and Days, e.g. to query a past month. StartDate must not be after EndDate, and the window may span
+	// at most MaxStatisticsRangeDays days.
+	StartDate *time.Time
+	EndDate   *time.Time
+}
+
+// MaxStatisticsRangeDays is the longest explicit StatisticsOptions StartDate to EndDate window,
+// in days. It is set from STATISTICS_MAX_RANGE_DAYS at startup.
+var MaxStatisticsRangeDays = DefaultMaxStatisticsRangeDays
+
+// DefaultMaxStatisticsRangeDays allows explicit statistics windows of up to about ten years
+const DefaultMaxStatisticsRangeDays = 3660
+
+// OthersBreakdownName is the name of the breakdown item summing the groups cut by StatisticsOptions.Limit
+const OthersBreakdownName = "Other"
+
+// UnspecifiedPaymentMethod is the breakdown name of invoices without a payment method,
+// like "Uncategorized" for invoices without a category
+const UnspecifiedPaymentMethod = "Unspecified"
+
+// UnspecifiedReceiver is the name of a company breakdown's receiver bucket for the company's
+// invoices without a receiver
+const UnspecifiedReceiver = "Unspecified"
+
+// isEntity reports whether the grouping is by entity rather than by time
+func (g StatisticsGroupBy) isEntity() bool {
+	return g == GroupByCategory || g == GroupByCompany || g == GroupByReceiver || g == GroupByPaymentMethod
 }
 
 // StatusStats represents count and amount for a status
@@ -143,47 +236,233 @@ type AggregationStats struct {
 	MaxDay      *DayReference     `json:"max_day,omitempty"`
 	MaxCategory *EntityReference  `json:"max_category,omitempty"`
 	MaxCompany  *EntityReference  `json:"max_company,omitempty"`
+
+	// Minimum references skip zero amounts unless every candidate is zero
+	MinInvoice  *InvoiceReference `json:"min_invoice,omitempty"`
+	MinDay      *DayReference     `json:"min_day,omitempty"`
+	MinCategory *EntityReference  `json:"min_category,omitempty"`
+	MinCompany  *EntityReference  `json:"min_company,omitempty"`
 }
 
 // StatisticsFilters represents the applied filters
 type StatisticsFilters struct {
-	CategoryID *uint                 `json:"category_id,omitempty"`
-	CompanyID  *uint                 `json:"company_id,omitempty"`
-	ReceiverID *uint                 `json:"receiver_id,omitempty"`
-	Status     *models.InvoiceStatus `json:"status,omitempty"`
-	Keyword    string                `json:"keyword,omitempty"`
+	CategoryID     *uint                 `json:"category_id,omitempty"`
+	CompanyID      *uint                 `json:"company_id,omitempty"`
+	ReceiverID     *uint                 `json:"receiver_id,omitempty"`
+	TagIDs         []uint                `json:"tag_ids,omitempty"`
+	TagMatch       string                `json:"tag_match,omitempty"`
+	Status         *models.InvoiceStatus `json:"status,omitempty"`
+	Keyword        string                `json:"keyword,omitempty"`
+	ExcludeKeyword string                `json:"exclude_keyword,omitempty"`
 }
 
 // InvoiceStatistics represents aggregated invoice statistics with optional grouping
 type InvoiceStatistics struct {
 	Period       string            `json:"period"`
+	DateField    string            `json:"date_field,omitempty"`
+	Timezone     string            `json:"timezone,omitempty"`
 	StartDate    time.Time         `json:"start_date"`
 	EndDate      time.Time         `json:"end_date"`
+	Currency     string            `json:"currency"`
 	TotalAmount  float64           `json:"total_amount"`
 	InvoiceCount int64             `json:"invoice_count"`
 	ByStatus     *StatusBreakdown  `json:"by_status,omitempty"`
 	Breakdown    []BreakdownItem   `json:"breakdown,omitempty"`
 	Aggregations *AggregationStats `json:"aggregations,omitempty"`
 	Filters      StatisticsFilters `json:"filters"`
+
+	// DailyAverage is the total divided by the number of days in the period
+	DailyAverage float64 `json:"daily_average"`
+	// ProjectedMonthEnd extrapolates the daily average over every day of the month containing EndDate
+	ProjectedMonthEnd float64 `json:"projected_month_end"`
+
+	// ExcludedCount is the number of invoices matching the filters that were left out
+	// because they have no value for the selected date field
+	ExcludedCount int64 `json:"excluded_count"`
+	// FXUnsupportedCount is the number of invoices in the total with items in a currency without
+	// exchange rates, which are counted 1:1
+	FXUnsupportedCount int64 `json:"fx_unsupported_count"`
+}
+
+// InvoiceAmountReference represents a reference to an invoice with its base-currency-normalized amount
+type InvoiceAmountReference struct {
+	ID     uint    `json:"id"`
+	Title  string  `json:"title"`
+	Amount float64 `json:"amount"`
+}
+
+// ReceiverDetail represents statistics for a single receiver (amounts in the user's base currency)
+type ReceiverDetail struct {
+	ReceiverID       uint                     `json:"receiver_id"`
+	Name             string                   `json:"name"`
+	Period           string                   `json:"period"`
+	StartDate        time.Time                `json:"start_date"`
+	EndDate          time.Time                `json:"end_date"`
+	Currency         string                   `json:"currency"`
+	TotalAmount      float64                  `json:"total_amount"`
+	PaidAmount       float64                  `json:"paid_amount"`
+	UnpaidAmount     float64                  `json:"unpaid_amount"`
+	InvoiceCount     int64                    `json:"invoice_count"`
+	PaidCount        int64                    `json:"paid_count"`
+	UnpaidCount      int64                    `json:"unpaid_count"`
+	AvgAmount        float64                  `json:"avg_amount"`
+	FirstInvoiceDate *time.Time               `json:"first_invoice_date,omitempty"`
+	LastInvoiceDate  *time.Time               `json:"last_invoice_date,omitempty"`
+	TopInvoices      []InvoiceAmountReference `json:"top_invoices"`
+}
+
+// CompanyBreakdown rolls a company's invoices up across its receivers (amounts in the user's
+// base currency). Receivers splits the total by receiver, with invoices without one under
+// UnspecifiedReceiver; Categories splits it by item category like GroupByCategory.
+type CompanyBreakdown struct {
+	CompanyID    uint            `json:"company_id"`
+	Name         string          `json:"name"`
+	Period       string          `json:"period"`
+	StartDate    time.Time       `json:"start_date"`
+	EndDate      time.Time       `json:"end_date"`
+	Currency     string          `json:"currency"`
+	TotalAmount  float64         `json:"total_amount"`
+	InvoiceCount int64           `json:"invoice_count"`
+	Receivers    []BreakdownItem `json:"receivers"`
+	Categories   []BreakdownItem `json:"categories"`
+}
+
+// DefaultForecastWindows is the number of past windows averaged by ForecastNextPeriod
+const DefaultForecastWindows = 3
+
+// MaxForecastWindows is the largest number of past windows a forecast may sample
+const MaxForecastWindows = 24
+
+// ForecastWindow is one past window sampled by a forecast
+type ForecastWindow struct {
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+	Amount    float64   `json:"amount"`
+}
+
+// Forecast is a projected spending total for the next window (amounts in the user's base currency).
+// It is a simple moving average of past windows, not a statistical model; Min and Max are the
+// smallest and largest sampled windows and serve as a naive confidence range.
+type Forecast struct {
+	Period         string           `json:"period"`
+	StartDate      time.Time        `json:"start_date"`
+	EndDate        time.Time        `json:"end_date"`
+	Currency       string           `json:"currency"`
+	ProjectedTotal float64          `json:"projected_total"`
+	Min            float64          `json:"min"`
+	Max            float64          `json:"max"`
+	Windows        []ForecastWindow `json:"windows"`
+}
+
+// DefaultTrendMonths is the number of months GetMonthlyTrend returns when none are requested
+const DefaultTrendMonths = 12
+
+// MaxTrendMonths is the largest number of months a monthly trend may cover
+const MaxTrendMonths = 120
+
+// MonthlyTrendPoint is one calendar month of a monthly trend, from StartDate up to but excluding
+// EndDate in the user's timezone. Amounts are in the user's base currency; UnpaidAmount covers
+// unpaid and overdue invoices.
+type MonthlyTrendPoint struct {
+	Month        string    `json:"month"`
+	StartDate    time.Time `json:"start_date"`
+	EndDate      time.Time `json:"end_date"`
+	TotalAmount  float64   `json:"total_amount"`
+	PaidAmount   float64   `json:"paid_amount"`
+	UnpaidAmount float64   `json:"unpaid_amount"`
+	InvoiceCount int64     `json:"invoice_count"`
+}
+
+// CurrencyExposureItem is the spending held in one invoice currency. Amount sums the invoice
+// amounts in that currency; the other amounts are in the user's base currency.
+type CurrencyExposureItem struct {
+	Currency     string  `json:"currency"`
+	Amount       float64 `json:"amount"`
+	TargetAmount float64 `json:"target_amount"`
+	PaidAmount   float64 `json:"paid_amount"`
+	UnpaidAmount float64 `json:"unpaid_amount"`
+	InvoiceCount int64   `json:"invoice_count"`
+	// Percentage is the currency's share of TotalTargetAmount (0-100)
+	Percentage float64 `json:"percentage"`
+}
+
+// CurrencyExposure breaks a period's spending down by invoice currency, largest base-currency value first
+type CurrencyExposure struct {
+	Period            string                 `json:"period"`
+	StartDate         time.Time              `json:"start_date"`
+	EndDate           time.Time              `json:"end_date"`
+	Currency          string                 `json:"currency"`
+	TotalTargetAmount float64                `json:"total_target_amount"`
+	Items             []CurrencyExposureItem `json:"items"`
+}
+
+// WeekdaySpending is the spending of invoices dated on one day of the week, in the user's base currency
+type WeekdaySpending struct {
+	Weekday      string  `json:"weekday"`
+	Amount       float64 `json:"amount"`
+	InvoiceCount int64   `json:"invoice_count"`
+}
+
+// SpendingByWeekday breaks a period's spending down by day of the week. Days holds all seven
+// weekdays, Monday first, including days without spending.
+type SpendingByWeekday struct {
+	Period    string              `json:"period"`
+	StartDate time.Time           `json:"start_date"`
+	EndDate   time.Time           `json:"end_date"`
+	DateField StatisticsDateField `json:"date_field,omitempty"`
+	Timezone  string              `json:"timezone"`
+	Currency  string              `json:"currency"`
+	Days      []WeekdaySpending   `json:"days"`
 }
 
 // AnalyticsService handles analytics business logic
 type AnalyticsService interface {
 	GetSummary(userID string, period AnalyticsPeriod) (*AnalyticsSummary, error)
+	GetSummaryByPaymentDate(userID string, period AnalyticsPeriod) (*AnalyticsSummary, error)
 	GetByCategory(userID string, period AnalyticsPeriod) (*AnalyticsByGroup, error)
 	GetByCompany(userID string, period AnalyticsPeriod) (*AnalyticsByGroup, error)
 	GetByReceiver(userID string, period AnalyticsPeriod) (*AnalyticsByGroup, error)
 	GetByTag(userID string, period AnalyticsPeriod) (*AnalyticsByGroup, error)
+	GetByTagWithChildren(userID string, period AnalyticsPeriod) (*AnalyticsByGroup, error)
+	GetCurrencyExposure(userID string, period AnalyticsPeriod) (*CurrencyExposure, error)
+	GetByWeekday(userID string, period AnalyticsPeriod, dateField StatisticsDateField) (*SpendingByWeekday, error)
 	GetStatistics(userID string, opts StatisticsOptions) (*InvoiceStatistics, error)
+	GetReceiverDetail(userID string, receiverID uint, period StatisticsPeriod) (*ReceiverDetail, error)
+	GetCompanyBreakdown(userID string, companyID uint, period StatisticsPeriod) (*CompanyBreakdown, error)
+	GenerateVendorStatement(userID string, receiverID uint, start, end time.Time) (*VendorStatement, error)
+	GetCategorySpending(userID string, categoryID uint, start, end time.Time) (float64, error)
+	ForecastNextPeriod(userID string, period AnalyticsPeriod) (*Forecast, error)
+	ForecastNextPeriodWithWindows(userID string, period AnalyticsPeriod, windows int) (*Forecast, error)
+	GetMonthlyTrend(userID string, months int) ([]MonthlyTrendPoint, error)
+	DetectAnomalies(userID string, period AnalyticsPeriod, opts AnomalyOptions) (*SpendingAnomalies, error)
+	// WithoutCache returns a service that recomputes the results NewAnalyticsService caches
+	WithoutCache() AnalyticsService
 }
 
 type analyticsService struct {
-	db *gorm.DB
+	db              *gorm.DB
+	settingsService SettingsService
 }
 
 // NewAnalyticsService creates a new AnalyticsService instance
+// Amounts are reported in the user's base currency (see SettingsService)
+// The period summaries and breakdowns (GetSummary, GetSummaryByPaymentDate, and GetByCategory,
+// GetByCompany, GetByReceiver, GetByTag, and GetByTagWithChildren) are cached per user for AnalyticsCacheTTL, in a
+// cache shared by every service on db, and dropped whenever that user's data is written through db.
 func NewAnalyticsService(db *gorm.DB) AnalyticsService {
-	return &analyticsService{db: db}
+	service := &analyticsService{db: db, settingsService: NewSettingsService(db)}
+
+	cache, err := analyticsCacheFor(db)
+	if err != nil {
+		log.Printf("Warning: Analytics cache disabled, failed to register invalidation: %v", err)
+		return service
+	}
+	return &cachedAnalyticsService{AnalyticsService: service, cache: cache}
+}
+
+// WithoutCache returns the service itself, which never caches
+func (s *analyticsService) WithoutCache() AnalyticsService {
+	return s
 }
 
 // getDateRange returns start and end dates for a period
@@ -206,22 +485,80 @@ func (s *analyticsService) getDateRange(period AnalyticsPeriod) (time.Time, time
 	return start, end
 }
 
-// itemTargetAmountSubquery returns the subquery to calculate USD-normalized amount from invoice_items
+// itemTargetAmountSubquery returns the subquery to calculate base-currency-normalized amount from invoice_items
 // This replaces the deprecated invoices.target_amount field
 const itemTargetAmountSubquery = "(SELECT COALESCE(SUM(target_amount), 0) FROM invoice_items WHERE invoice_id = invoices.id AND deleted_at IS NULL)"
 
+// itemCategoryJoin joins each invoice's items and the category each item is attributed to, so an
+// invoice split across categories counts towards each of them. Invoices without items keep a single
+// row attributed to the invoice category.
+const itemCategoryJoin = "LEFT JOIN invoice_items ON invoice_items.invoice_id = invoices.id AND invoice_items.deleted_at IS NULL " +
+	"LEFT JOIN invoice_categories ON invoice_categories.id = " + itemCategoryColumn
+
+// itemCategoryColumn is the category an item is attributed to: its own category, falling back to the invoice category
+const itemCategoryColumn = "COALESCE(invoice_items.category_id, invoices.category_id)"
+
+// itemTargetAmountColumn sums the joined items' base-currency amounts (invoices without items contribute 0)
+const itemTargetAmountColumn = "COALESCE(invoice_items.target_amount, 0)"
+
+// refundSignColumn is -1 for refunds and credit notes and 1 for every other invoice
+const refundSignColumn = "(CASE WHEN invoices.relation_type IN ('refund', 'credit_note') THEN -1 ELSE 1 END)"
+
+// invoiceAmount returns the base-currency amount of an invoice, negated for refunds and credit notes with NetRefunds
+func (o StatisticsOptions) invoiceAmount() string {
+	amount := "COALESCE(" + itemTargetAmountSubquery + ", invoices.amount)"
+	if o.NetRefunds {
+		return refundSignColumn + " * " + amount
+	}
+	return amount
+}
+
+// itemAmount returns itemTargetAmountColumn, negated for refunds and credit notes with NetRefunds
+func (o StatisticsOptions) itemAmount() string {
+	if o.NetRefunds {
+		return refundSignColumn + " * " + itemTargetAmountColumn
+	}
+	return itemTargetAmountColumn
+}
+
+// fxUnsupportedInvoices matches the invoices with items taken 1:1 because their currency has no
+// exchange rates
+const fxUnsupportedInvoices = "invoices.id IN (SELECT invoice_id FROM invoice_items WHERE fx_unsupported = ? AND deleted_at IS NULL)"
+
+// excludeDrafts leaves draft invoices out of an invoices query; drafts never count towards analytics
+func excludeDrafts(db *gorm.DB) *gorm.DB {
+	return db.Where("invoices.is_draft = ?", false)
+}
+
 // GetSummary returns aggregated invoice statistics for a period
 func (s *analyticsService) GetSummary(userID string, period AnalyticsPeriod) (*AnalyticsSummary, error) {
+	return s.getSummary(userID, period, PaidByInvoiceDate)
+}
+
+// GetSummaryByPaymentDate returns aggregated invoice statistics for a period, with the paid bucket
+// holding the invoices paid within the period rather than the paid invoices dated within it. The
+// paid bucket may then include invoices outside the total.
+func (s *analyticsService) GetSummaryByPaymentDate(userID string, period AnalyticsPeriod) (*AnalyticsSummary, error) {
+	return s.getSummary(userID, period, PaidByPaymentDate)
+}
+
+func (s *analyticsService) getSummary(userID string, period AnalyticsPeriod, paidBy SummaryPaidBasis) (*AnalyticsSummary, error) {
 	start, end := s.getDateRange(period)
 
+	baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
+	if err != nil {
+		return nil, err
+	}
 	summary := &AnalyticsSummary{
 		Period:    string(period),
 		StartDate: start,
 		EndDate:   end,
+		Currency:  baseCurrency,
+		PaidBy:    string(paidBy),
 	}
 
 	// Base query for invoices in the period (use due_date with created_at fallback)
-	baseQuery := s.db.Model(&models.Invoice{}).
+	baseQuery := s.db.Model(&models.Invoice{}).Scopes(excludeDrafts).
 		Where("user_id = ? AND COALESCE(due_date, created_at) >= ? AND COALESCE(due_date, created_at) <= ?", userID, start, end)
 
 	// Get total count and amount (calculate from invoice_items.target_amount, fallback to amount)
@@ -236,9 +573,20 @@ func (s *analyticsService) GetSummary(userID string, period AnalyticsPeriod) (*A
 	summary.InvoiceCount = result.Count
 	summary.TotalAmount = result.Amount
 
+	if err := s.db.Model(&models.Invoice{}).Scopes(excludeDrafts).
+		Where("user_id = ? AND COALESCE(due_date, created_at) >= ? AND COALESCE(due_date, created_at) <= ?", userID, start, end).
+		Where(fxUnsupportedInvoices, true).
+		Count(&summary.FXUnsupportedCount).Error; err != nil {
+		return nil, err
+	}
+
 	// Get paid count and amount
-	if err := s.db.Model(&models.Invoice{}).
-		Where("user_id = ? AND COALESCE(due_date, created_at) >= ? AND COALESCE(due_date, created_at) <= ? AND status = ?",
+	paidDate := "COALESCE(due_date, created_at)"
+	if paidBy == PaidByPaymentDate {
+		paidDate = "paid_at"
+	}
+	if err := s.db.Model(&models.Invoice{}).Scopes(excludeDrafts).
+		Where("user_id = ? AND "+paidDate+" >= ? AND "+paidDate+" <= ? AND status = ?",
 			userID, start, end, models.InvoiceStatusPaid).
 		Select(selectExpr).
 		Scan(&result).Error; err != nil {
@@ -248,7 +596,7 @@ func (s *analyticsService) GetSummary(userID string, period AnalyticsPeriod) (*A
 	summary.PaidAmount = result.Amount
 
 	// Get unpaid count and amount
-	if err := s.db.Model(&models.Invoice{}).
+	if err := s.db.Model(&models.Invoice{}).Scopes(excludeDrafts).
 		Where("user_id = ? AND COALESCE(due_date, created_at) >= ? AND COALESCE(due_date, created_at) <= ? AND status = ?",
 			userID, start, end, models.InvoiceStatusUnpaid).
 		Select(selectExpr).
@@ -259,7 +607,7 @@ func (s *analyticsService) GetSummary(userID string, period AnalyticsPeriod) (*A
 	summary.UnpaidAmount = result.Amount
 
 	// Get overdue count and amount
-	if err := s.db.Model(&models.Invoice{}).
+	if err := s.db.Model(&models.Invoice{}).Scopes(excludeDrafts).
 		Where("user_id = ? AND COALESCE(due_date, created_at) >= ? AND COALESCE(due_date, created_at) <= ? AND status = ?",
 			userID, start, end, models.InvoiceStatusOverdue).
 		Select(selectExpr).
@@ -276,10 +624,15 @@ func (s *analyticsService) GetSummary(userID string, period AnalyticsPeriod) (*A
 func (s *analyticsService) GetByCategory(userID string, period AnalyticsPeriod) (*AnalyticsByGroup, error) {
 	start, end := s.getDateRange(period)
 
+	baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
+	if err != nil {
+		return nil, err
+	}
 	response := &AnalyticsByGroup{
 		Period:    string(period),
 		StartDate: start,
 		EndDate:   end,
+		Currency:  baseCurrency,
 		Items:     []AnalyticsGroupItem{},
 	}
 
@@ -295,18 +648,18 @@ func (s *analyticsService) GetByCategory(userID string, period AnalyticsPeriod)
 	}
 
 	var results []groupResult
-	err := s.db.Table("invoices").
+	err = s.db.Table("invoices").Scopes(excludeDrafts).
 		Select(`
 			invoice_categories.id,
 			invoice_categories.name,
 			invoice_categories.color,
-			COUNT(invoices.id) as invoice_count,
-			COALESCE(SUM(COALESCE(`+itemTargetAmountSubquery+`, invoices.amount)), 0) as total_amount,
-			COALESCE(SUM(CASE WHEN invoices.status = 'paid' THEN COALESCE(`+itemTargetAmountSubquery+`, invoices.amount) ELSE 0 END), 0) as paid_amount,
-			COALESCE(SUM(CASE WHEN invoices.status IN ('unpaid', 'overdue') THEN COALESCE(`+itemTargetAmountSubquery+`, invoices.amount) ELSE 0 END), 0) as unpaid_amount
+			COUNT(DISTINCT invoices.id) as invoice_count,
+			COALESCE(SUM(`+itemTargetAmountColumn+`), 0) as total_amount,
+			COALESCE(SUM(CASE WHEN invoices.status = 'paid' THEN `+itemTargetAmountColumn+` ELSE 0 END), 0) as paid_amount,
+			COALESCE(SUM(CASE WHEN invoices.status IN ('unpaid', 'overdue') THEN `+itemTargetAmountColumn+` ELSE 0 END), 0) as unpaid_amount
 		`).
-		Joins("INNER JOIN invoice_categories ON invoices.category_id = invoice_categories.id").
-		Where("invoices.user_id = ? AND COALESCE(invoices.due_date, invoices.created_at) >= ? AND COALESCE(invoices.due_date, invoices.created_at) <= ? AND invoices.deleted_at IS NULL",
+		Joins(itemCategoryJoin).
+		Where("invoices.user_id = ? AND COALESCE(invoices.due_date, invoices.created_at) >= ? AND COALESCE(invoices.due_date, invoices.created_at) <= ? AND invoices.deleted_at IS NULL AND invoice_categories.id IS NOT NULL",
 			userID, start, end).
 		Group("invoice_categories.id, invoice_categories.name, invoice_categories.color").
 		Order("total_amount DESC").
@@ -328,16 +681,17 @@ func (s *analyticsService) GetByCategory(userID string, period AnalyticsPeriod)
 		})
 	}
 
-	// Get uncategorized invoices
+	// Get uncategorized invoices (and items of invoices without a category)
 	var uncategorized groupResult
-	err = s.db.Table("invoices").
+	err = s.db.Table("invoices").Scopes(excludeDrafts).
 		Select(`
-			COUNT(id) as invoice_count,
-			COALESCE(SUM(COALESCE(`+itemTargetAmountSubquery+`, amount)), 0) as total_amount,
-			COALESCE(SUM(CASE WHEN status = 'paid' THEN COALESCE(`+itemTargetAmountSubquery+`, amount) ELSE 0 END), 0) as paid_amount,
-			COALESCE(SUM(CASE WHEN status IN ('unpaid', 'overdue') THEN COALESCE(`+itemTargetAmountSubquery+`, amount) ELSE 0 END), 0) as unpaid_amount
+			COUNT(DISTINCT invoices.id) as invoice_count,
+			COALESCE(SUM(`+itemTargetAmountColumn+`), 0) as total_amount,
+			COALESCE(SUM(CASE WHEN invoices.status = 'paid' THEN `+itemTargetAmountColumn+` ELSE 0 END), 0) as paid_amount,
+			COALESCE(SUM(CASE WHEN invoices.status IN ('unpaid', 'overdue') THEN `+itemTargetAmountColumn+` ELSE 0 END), 0) as unpaid_amount
 		`).
-		Where("user_id = ? AND COALESCE(due_date, created_at) >= ? AND COALESCE(due_date, created_at) <= ? AND category_id IS NULL AND deleted_at IS NULL",
+		Joins(itemCategoryJoin).
+		Where("invoices.user_id = ? AND COALESCE(invoices.due_date, invoices.created_at) >= ? AND COALESCE(invoices.due_date, invoices.created_at) <= ? AND "+itemCategoryColumn+" IS NULL AND invoices.deleted_at IS NULL",
 			userID, start, end).
 		Scan(&uncategorized).Error
 
@@ -363,10 +717,15 @@ func (s *analyticsService) GetByCategory(userID string, period AnalyticsPeriod)
 func (s *analyticsService) GetByCompany(userID string, period AnalyticsPeriod) (*AnalyticsByGroup, error) {
 	start, end := s.getDateRange(period)
 
+	baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
+	if err != nil {
+		return nil, err
+	}
 	response := &AnalyticsByGroup{
 		Period:    string(period),
 		StartDate: start,
 		EndDate:   end,
+		Currency:  baseCurrency,
 		Items:     []AnalyticsGroupItem{},
 	}
 
@@ -380,7 +739,7 @@ func (s *analyticsService) GetByCompany(userID string, period AnalyticsPeriod) (
 	}
 
 	var results []groupResult
-	err := s.db.Table("invoices").
+	err = s.db.Table("invoices").Scopes(excludeDrafts).
 		Select(`
 			invoice_companies.id,
 			invoice_companies.name,
@@ -413,7 +772,7 @@ func (s *analyticsService) GetByCompany(userID string, period AnalyticsPeriod) (
 
 	// Get invoices without company
 	var uncategorized groupResult
-	err = s.db.Table("invoices").
+	err = s.db.Table("invoices").Scopes(excludeDrafts).
 		Select(`
 			COUNT(id) as invoice_count,
 			COALESCE(SUM(COALESCE(`+itemTargetAmountSubquery+`, amount)), 0) as total_amount,
@@ -446,10 +805,15 @@ func (s *analyticsService) GetByCompany(userID string, period AnalyticsPeriod) (
 func (s *analyticsService) GetByReceiver(userID string, period AnalyticsPeriod) (*AnalyticsByGroup, error) {
 	start, end := s.getDateRange(period)
 
+	baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
+	if err != nil {
+		return nil, err
+	}
 	response := &AnalyticsByGroup{
 		Period:    string(period),
 		StartDate: start,
 		EndDate:   end,
+		Currency:  baseCurrency,
 		Items:     []AnalyticsGroupItem{},
 	}
 
@@ -463,7 +827,7 @@ func (s *analyticsService) GetByReceiver(userID string, period AnalyticsPeriod)
 	}
 
 	var results []groupResult
-	err := s.db.Table("invoices").
+	err = s.db.Table("invoices").Scopes(excludeDrafts).
 		Select(`
 			invoice_receivers.id,
 			invoice_receivers.name,
@@ -496,7 +860,7 @@ func (s *analyticsService) GetByReceiver(userID string, period AnalyticsPeriod)
 
 	// Get invoices without receiver
 	var uncategorized groupResult
-	err = s.db.Table("invoices").
+	err = s.db.Table("invoices").Scopes(excludeDrafts).
 		Select(`
 			COUNT(id) as invoice_count,
 			COALESCE(SUM(COALESCE(`+itemTargetAmountSubquery+`, amount)), 0) as total_amount,
@@ -529,10 +893,15 @@ func (s *analyticsService) GetByReceiver(userID string, period AnalyticsPeriod)
 func (s *analyticsService) GetByTag(userID string, period AnalyticsPeriod) (*AnalyticsByGroup, error) {
 	start, end := s.getDateRange(period)
 
+	baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
+	if err != nil {
+		return nil, err
+	}
 	response := &AnalyticsByGroup{
 		Period:    string(period),
 		StartDate: start,
 		EndDate:   end,
+		Currency:  baseCurrency,
 		Items:     []AnalyticsGroupItem{},
 	}
 
@@ -547,7 +916,7 @@ func (s *analyticsService) GetByTag(userID string, period AnalyticsPeriod) (*Ana
 	}
 
 	var results []groupResult
-	err := s.db.Table("invoices").
+	err = s.db.Table("invoices").Scopes(excludeDrafts).
 		Select(`
 			invoice_tags.id,
 			invoice_tags.name,
@@ -581,9 +950,126 @@ func (s *analyticsService) GetByTag(userID string, period AnalyticsPeriod) (*Ana
 		})
 	}
 
-	// Get invoices without tags (untagged)
+	response.Uncategorized, err = s.getUntagged(userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetByTagWithChildren returns invoice analytics grouped by tag like GetByTag, with each tag's
+// totals also including the invoices carrying any of its descendant tags. An invoice counts once
+// per tag however many tags of the subtree it carries, and parent tags without invoices of their
+// own are listed when a descendant has some.
+func (s *analyticsService) GetByTagWithChildren(userID string, period AnalyticsPeriod) (*AnalyticsByGroup, error) {
+	start, end := s.getDateRange(period)
+
+	baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
+	if err != nil {
+		return nil, err
+	}
+	response := &AnalyticsByGroup{
+		Period:    string(period),
+		StartDate: start,
+		EndDate:   end,
+		Currency:  baseCurrency,
+		Items:     []AnalyticsGroupItem{},
+	}
+
+	var tags []models.InvoiceTag
+	if err := s.db.Where("user_id = ?", userID).Find(&tags).Error; err != nil {
+		return nil, err
+	}
+	tagsByID := make(map[uint]*models.InvoiceTag, len(tags))
+	for i := range tags {
+		tagsByID[tags[i].ID] = &tags[i]
+	}
+
+	type taggedInvoice struct {
+		InvoiceID uint
+		TagID     uint
+		Status    models.InvoiceStatus
+		Amount    float64
+	}
+
+	var rows []taggedInvoice
+	err = s.db.Table("invoices").Scopes(excludeDrafts).
+		Select(`
+			invoices.id as invoice_id,
+			invoice_tag_mappings.invoice_tag_id as tag_id,
+			invoices.status,
+			`+itemTargetAmountSubquery+` as amount
+		`).
+		Joins("INNER JOIN invoice_tag_mappings ON invoices.id = invoice_tag_mappings.invoice_id").
+		Where("invoices.user_id = ? AND COALESCE(invoices.due_date, invoices.created_at) >= ? AND COALESCE(invoices.due_date, invoices.created_at) <= ? AND invoices.deleted_at IS NULL",
+			userID, start, end).
+		Order("invoices.id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// Credit every invoice to each of its tags and their ancestors, once per tag
+	totals := make(map[uint]*AnalyticsGroupItem)
+	credited := make(map[[2]uint]bool)
+	for _, row := range rows {
+		visited := make(map[uint]bool)
+		for tag := tagsByID[row.TagID]; tag != nil && !visited[tag.ID]; {
+			visited[tag.ID] = true
+			if key := [2]uint{row.InvoiceID, tag.ID}; !credited[key] {
+				credited[key] = true
+				item, ok := totals[tag.ID]
+				if !ok {
+					item = &AnalyticsGroupItem{ID: tag.ID, Name: tag.Name, Color: tag.Color}
+					totals[tag.ID] = item
+				}
+				item.TotalAmount += row.Amount
+				switch row.Status {
+				case models.InvoiceStatusPaid:
+					item.PaidAmount += row.Amount
+				case models.InvoiceStatusUnpaid, models.InvoiceStatusOverdue:
+					item.UnpaidAmount += row.Amount
+				}
+				item.InvoiceCount++
+			}
+			if tag.ParentID == nil {
+				break
+			}
+			tag = tagsByID[*tag.ParentID]
+		}
+	}
+
+	for _, item := range totals {
+		response.Items = append(response.Items, *item)
+	}
+	sort.Slice(response.Items, func(i, j int) bool {
+		if response.Items[i].TotalAmount != response.Items[j].TotalAmount {
+			return response.Items[i].TotalAmount > response.Items[j].TotalAmount
+		}
+		return response.Items[i].ID < response.Items[j].ID
+	})
+
+	response.Uncategorized, err = s.getUntagged(userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// getUntagged returns the totals of the invoices without tags between start and end, or nil
+// when there are none
+func (s *analyticsService) getUntagged(userID string, start, end time.Time) (*AnalyticsGroupItem, error) {
+	type groupResult struct {
+		TotalAmount  float64
+		PaidAmount   float64
+		UnpaidAmount float64
+		InvoiceCount int64
+	}
+
 	var untagged groupResult
-	err = s.db.Table("invoices").
+	err := s.db.Table("invoices").Scopes(excludeDrafts).
 		Select(`
 			COUNT(id) as invoice_count,
 			COALESCE(SUM(COALESCE(`+itemTargetAmountSubquery+`, amount)), 0) as total_amount,
@@ -598,22 +1084,117 @@ func (s *analyticsService) GetByTag(userID string, period AnalyticsPeriod) (*Ana
 		return nil, err
 	}
 
-	if untagged.InvoiceCount > 0 {
-		response.Uncategorized = &AnalyticsGroupItem{
-			ID:           0,
-			Name:         "Untagged",
-			TotalAmount:  untagged.TotalAmount,
-			PaidAmount:   untagged.PaidAmount,
-			UnpaidAmount: untagged.UnpaidAmount,
-			InvoiceCount: untagged.InvoiceCount,
+	if untagged.InvoiceCount == 0 {
+		return nil, nil
+	}
+	return &AnalyticsGroupItem{
+		ID:           0,
+		Name:         "Untagged",
+		TotalAmount:  untagged.TotalAmount,
+		PaidAmount:   untagged.PaidAmount,
+		UnpaidAmount: untagged.UnpaidAmount,
+		InvoiceCount: untagged.InvoiceCount,
+	}, nil
+}
+
+// GetCurrencyExposure returns the period's spending grouped by invoice currency. Every currency with
+// an invoice in the period is listed, whatever the status of its invoices.
+func (s *analyticsService) GetCurrencyExposure(userID string, period AnalyticsPeriod) (*CurrencyExposure, error) {
+	start, end := s.getDateRange(period)
+
+	baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
+	if err != nil {
+		return nil, err
+	}
+	response := &CurrencyExposure{
+		Period:    string(period),
+		StartDate: start,
+		EndDate:   end,
+		Currency:  baseCurrency,
+		Items:     []CurrencyExposureItem{},
+	}
+
+	err = s.db.Table("invoices").Scopes(excludeDrafts).
+		Select(`
+			currency,
+			COUNT(id) as invoice_count,
+			COALESCE(SUM(amount), 0) as amount,
+			COALESCE(SUM(COALESCE(`+itemTargetAmountSubquery+`, amount)), 0) as target_amount,
+			COALESCE(SUM(CASE WHEN status = 'paid' THEN COALESCE(`+itemTargetAmountSubquery+`, amount) ELSE 0 END), 0) as paid_amount,
+			COALESCE(SUM(CASE WHEN status IN ('unpaid', 'overdue') THEN COALESCE(`+itemTargetAmountSubquery+`, amount) ELSE 0 END), 0) as unpaid_amount
+		`).
+		Where("user_id = ? AND COALESCE(due_date, created_at) >= ? AND COALESCE(due_date, created_at) <= ? AND deleted_at IS NULL",
+			userID, start, end).
+		Group("currency").
+		Order("target_amount DESC, currency ASC").
+		Scan(&response.Items).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range response.Items {
+		response.TotalTargetAmount += item.TargetAmount
+	}
+	if response.TotalTargetAmount != 0 {
+		for i := range response.Items {
+			response.Items[i].Percentage = math.Round(response.Items[i].TargetAmount/response.TotalTargetAmount*10000) / 100
 		}
 	}
 
 	return response, nil
 }
 
-// getStatisticsDateRange returns start and end dates for a statistics period
-func (s *analyticsService) getStatisticsDateRange(opts StatisticsOptions) (time.Time, time.Time) {
+// GetByWeekday returns the period's spending summed by the weekday the invoices' dateField falls
+// on in the user's timezone. Invoices without a value for dateField are left out.
+func (s *analyticsService) GetByWeekday(userID string, period AnalyticsPeriod, dateField StatisticsDateField) (*SpendingByWeekday, error) {
+	start, end := s.getDateRange(period)
+	loc := s.settingsService.GetLocation(userID)
+
+	var rows []struct {
+		Unix   int64
+		Amount float64
+	}
+	opts := StatisticsOptions{DateField: dateField}
+	selectExpr := "CAST(strftime('%s', " + dateField.column("") + ") AS INTEGER) as unix, COALESCE(" + opts.invoiceAmount() + ", 0) as amount"
+	if err := s.buildStatisticsQuery(userID, start, end, opts).
+		Select(selectExpr).
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	// Index 0 is Monday; time.Weekday starts the week on Sunday
+	days := make([]WeekdaySpending, 7)
+	for i := range days {
+		days[i].Weekday = time.Weekday((i + 1) % 7).String()
+	}
+	for _, row := range rows {
+		i := (int(time.Unix(row.Unix, 0).In(loc).Weekday()) + 6) % 7
+		days[i].Amount += row.Amount
+		days[i].InvoiceCount++
+	}
+
+	baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
+	if err != nil {
+		return nil, err
+	}
+	return &SpendingByWeekday{
+		Period:    string(period),
+		StartDate: start,
+		EndDate:   end,
+		DateField: dateField,
+		Timezone:  loc.String(),
+		Currency:  baseCurrency,
+		Days:      days,
+	}, nil
+}
+
+// getStatisticsDateRange returns start and end dates for a statistics period, or the explicit
+// StartDate and EndDate of opts when both are set
+func (s *analyticsService) getStatisticsDateRange(opts StatisticsOptions) (time.Time, time.Time, error) {
+	if opts.StartDate != nil || opts.EndDate != nil {
+		return explicitDateRange(opts.StartDate, opts.EndDate)
+	}
+
 	now := time.Now()
 	end := now
 	var start time.Time
@@ -637,52 +1218,139 @@ func (s *analyticsService) getStatisticsDateRange(opts StatisticsOptions) (time.
 		start = now.AddDate(0, -1, 0) // Default to last month
 	}
 
-	return start, end
+	return start, end, nil
+}
+
+// explicitDateRange validates an explicit statistics window and normalizes it to UTC, which
+// stored dates are compared in
+func explicitDateRange(start, end *time.Time) (time.Time, time.Time, error) {
+	if start == nil || end == nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("start date and end date must be set together")
+	}
+	if start.After(*end) {
+		return time.Time{}, time.Time{}, fmt.Errorf("start date %s is after end date %s",
+			start.Format(time.RFC3339), end.Format(time.RFC3339))
+	}
+	if end.Sub(*start) > time.Duration(MaxStatisticsRangeDays)*24*time.Hour {
+		return time.Time{}, time.Time{}, fmt.Errorf("date range exceeds %d days", MaxStatisticsRangeDays)
+	}
+	return start.UTC(), end.UTC(), nil
+}
+
+// periodDays returns the number of days between start and end, rounded to absorb DST shifts, with a minimum of one
+func periodDays(start, end time.Time) int {
+	days := int(math.Round(end.Sub(start).Hours() / 24))
+	if days < 1 {
+		return 1
+	}
+	return days
+}
+
+// maxMinDays returns the days with the highest and lowest totals, preferring non-zero totals
+// for the minimum; ties go to the earliest day. Both are nil when there are no days.
+func maxMinDays(totals map[string]dayTotal) (*DayReference, *DayReference) {
+	days := make([]string, 0, len(totals))
+	for day := range totals {
+		days = append(days, day)
+	}
+	sort.Strings(days)
+
+	var maxDay, minDay *DayReference
+	for _, day := range days {
+		amount := totals[day].Amount
+		if maxDay == nil || amount > maxDay.Amount {
+			maxDay = &DayReference{Date: day, Amount: amount}
+		}
+		if minDay == nil || (minDay.Amount == 0 && amount != 0) || (amount != 0 && amount < minDay.Amount) {
+			minDay = &DayReference{Date: day, Amount: amount}
+		}
+	}
+	return maxDay, minDay
+}
+
+// daysInMonth returns the number of days in the month containing t
+func daysInMonth(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+}
+
+// statisticsLocation returns the timezone days are bucketed in: the requested one, or the user's setting
+func (s *analyticsService) statisticsLocation(userID, timezone string) (*time.Location, error) {
+	if timezone == "" {
+		return s.settingsService.GetLocation(userID), nil
+	}
+	return loadTimezone(timezone)
 }
 
 // buildStatisticsQuery builds a filtered query for statistics
 func (s *analyticsService) buildStatisticsQuery(userID string, start, end time.Time, opts StatisticsOptions) *gorm.DB {
-	query := s.db.Model(&models.Invoice{}).
-		Where("user_id = ? AND COALESCE(due_date, created_at) >= ? AND COALESCE(due_date, created_at) <= ? AND deleted_at IS NULL",
+	dateColumn := opts.DateField.column("")
+	query := s.db.Model(&models.Invoice{}).Scopes(excludeDrafts).
+		Where("user_id = ? AND "+dateColumn+" >= ? AND "+dateColumn+" <= ? AND deleted_at IS NULL",
 			userID, start, end)
 
+	return applyStatisticsFilters(query, opts, "")
+}
+
+// applyStatisticsFilters applies the category, company, receiver, status, keyword, and tag filters
+// to a query on invoices whose columns are prefixed with prefix ("" or "invoices." when joined)
+func applyStatisticsFilters(query *gorm.DB, opts StatisticsOptions, prefix string) *gorm.DB {
 	if opts.CategoryID != nil {
-		query = query.Where("category_id = ?", *opts.CategoryID)
+		query = query.Where(prefix+"category_id = ?", *opts.CategoryID)
 	}
 	if opts.CompanyID != nil {
-		query = query.Where("company_id = ?", *opts.CompanyID)
+		query = query.Where(prefix+"company_id = ?", *opts.CompanyID)
 	}
 	if opts.ReceiverID != nil {
-		query = query.Where("receiver_id = ?", *opts.ReceiverID)
+		query = query.Where(prefix+"receiver_id = ?", *opts.ReceiverID)
 	}
 	if opts.Status != nil {
-		query = query.Where("status = ?", *opts.Status)
+		query = query.Where(prefix+"status = ?", *opts.Status)
 	}
-	if opts.Keyword != "" {
-		searchPattern := "%" + opts.Keyword + "%"
-		query = query.Where("(title LIKE ? OR description LIKE ?)", searchPattern, searchPattern)
+	query = keywordFilter(query, prefix, opts.Keyword, opts.ExcludeKeyword)
+
+	// Tags narrow the invoices per TagMatch; an invalid TagMatch fails the query
+	if len(opts.TagIDs) == 0 {
+		return query
 	}
-	if len(opts.TagIDs) > 0 {
-		query = query.Where("id IN (SELECT invoice_id FROM invoice_tag_mappings WHERE invoice_tag_id IN ?)", opts.TagIDs)
+	condition, args, err := tagMatchCondition(prefix+"id", opts.TagIDs, opts.TagMatch)
+	if err != nil {
+		_ = query.AddError(err)
+		return query
 	}
-
-	return query
+	return query.Where(condition, args...)
 }
 
 // GetStatistics returns aggregated invoice statistics with optional grouping and filters
 func (s *analyticsService) GetStatistics(userID string, opts StatisticsOptions) (*InvoiceStatistics, error) {
-	start, end := s.getStatisticsDateRange(opts)
+	start, end, err := s.getStatisticsDateRange(opts)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := s.statisticsLocation(userID, opts.Timezone)
+	if err != nil {
+		return nil, err
+	}
 
+	baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
+	if err != nil {
+		return nil, err
+	}
 	stats := &InvoiceStatistics{
 		Period:    string(opts.Period),
+		DateField: string(opts.DateField),
+		Timezone:  loc.String(),
 		StartDate: start,
 		EndDate:   end,
+		Currency:  baseCurrency,
 		Filters: StatisticsFilters{
-			CategoryID: opts.CategoryID,
-			CompanyID:  opts.CompanyID,
-			ReceiverID: opts.ReceiverID,
-			Status:     opts.Status,
-			Keyword:    opts.Keyword,
+			CategoryID:     opts.CategoryID,
+			CompanyID:      opts.CompanyID,
+			ReceiverID:     opts.ReceiverID,
+			Status:         opts.Status,
+			TagIDs:         opts.TagIDs,
+			TagMatch:       opts.TagMatch,
+			Keyword:        opts.Keyword,
+			ExcludeKeyword: opts.ExcludeKeyword,
 		},
 	}
 
@@ -691,7 +1359,7 @@ func (s *analyticsService) GetStatistics(userID string, opts StatisticsOptions)
 		Count  int64
 		Amount float64
 	}
-	selectExpr := "COUNT(*) as count, COALESCE(SUM(COALESCE(" + itemTargetAmountSubquery + ", amount)), 0) as amount"
+	selectExpr := "COUNT(*) as count, COALESCE(SUM(" + opts.invoiceAmount() + "), 0) as amount"
 	if err := s.buildStatisticsQuery(userID, start, end, opts).
 		Select(selectExpr).
 		Scan(&result).Error; err != nil {
@@ -699,11 +1367,28 @@ func (s *analyticsService) GetStatistics(userID string, opts StatisticsOptions)
 	}
 	stats.InvoiceCount = result.Count
 	stats.TotalAmount = result.Amount
+	stats.DailyAverage = result.Amount / float64(periodDays(start, end))
+	stats.ProjectedMonthEnd = stats.DailyAverage * float64(daysInMonth(end))
+
+	if err := s.buildStatisticsQuery(userID, start, end, opts).
+		Where(fxUnsupportedInvoices, true).
+		Count(&stats.FXUnsupportedCount).Error; err != nil {
+		return nil, err
+	}
+
+	// Invoices without the selected date can't be placed in the period, so report how many were left out
+	if opts.DateField.nullable() {
+		query := s.db.Model(&models.Invoice{}).Scopes(excludeDrafts).
+			Where("user_id = ? AND "+opts.DateField.column("")+" IS NULL AND deleted_at IS NULL", userID)
+		if err := applyStatisticsFilters(query, opts, "").Count(&stats.ExcludedCount).Error; err != nil {
+			return nil, err
+		}
+	}
 
 	// Handle grouping
 	switch opts.GroupBy {
 	case GroupByDay:
-		breakdown, err := s.getGroupedByDay(userID, start, end, opts)
+		breakdown, err := s.getGroupedByDay(userID, start, end, opts, loc)
 		if err != nil {
 			return nil, err
 		}
@@ -720,8 +1405,14 @@ func (s *analyticsService) GetStatistics(userID string, opts StatisticsOptions)
 			return nil, err
 		}
 		stats.Breakdown = breakdown
-	case GroupByCategory:
-		breakdown, err := s.getGroupedByCategory(userID, start, end, opts)
+	case GroupByQuarter:
+		breakdown, err := s.getGroupedByQuarter(userID, start, end, opts)
+		if err != nil {
+			return nil, err
+		}
+		stats.Breakdown = breakdown
+	case GroupByCategory:
+		breakdown, err := s.getGroupedByCategory(userID, start, end, opts)
 		if err != nil {
 			return nil, err
 		}
@@ -738,6 +1429,12 @@ func (s *analyticsService) GetStatistics(userID string, opts StatisticsOptions)
 			return nil, err
 		}
 		stats.Breakdown = breakdown
+	case GroupByPaymentMethod:
+		breakdown, err := s.getGroupedByPaymentMethod(userID, start, end, opts)
+		if err != nil {
+			return nil, err
+		}
+		stats.Breakdown = breakdown
 	default:
 		// No grouping - include status breakdown
 		byStatus, err := s.getStatusBreakdown(userID, start, end, opts)
@@ -747,9 +1444,13 @@ func (s *analyticsService) GetStatistics(userID string, opts StatisticsOptions)
 		stats.ByStatus = byStatus
 	}
 
+	if opts.GroupBy.isEntity() {
+		stats.Breakdown = limitBreakdown(stats.Breakdown, opts.Limit, opts.OthersBucket)
+	}
+
 	// Include aggregations if requested
 	if opts.IncludeAggregations {
-		aggs, err := s.getAggregations(userID, start, end, opts)
+		aggs, err := s.getAggregations(userID, start, end, opts, loc)
 		if err != nil {
 			return nil, err
 		}
@@ -762,7 +1463,7 @@ func (s *analyticsService) GetStatistics(userID string, opts StatisticsOptions)
 // getStatusBreakdown returns breakdown by status
 func (s *analyticsService) getStatusBreakdown(userID string, start, end time.Time, opts StatisticsOptions) (*StatusBreakdown, error) {
 	breakdown := &StatusBreakdown{}
-	selectExpr := "COUNT(*) as count, COALESCE(SUM(COALESCE(" + itemTargetAmountSubquery + ", amount)), 0) as amount"
+	selectExpr := "COUNT(*) as count, COALESCE(SUM(" + opts.invoiceAmount() + "), 0) as amount"
 
 	var result struct {
 		Count  int64
@@ -805,64 +1506,55 @@ func (s *analyticsService) getStatusBreakdown(userID string, start, end time.Tim
 	return breakdown, nil
 }
 
-// getGroupedByDay returns statistics grouped by day
-func (s *analyticsService) getGroupedByDay(userID string, start, end time.Time, opts StatisticsOptions) ([]BreakdownItem, error) {
-	type dayResult struct {
-		Date   string
-		Amount float64
-		Count  int64
-	}
-
-	var results []dayResult
-
-	query := s.db.Table("invoices").
-		Select("DATE(COALESCE(due_date, created_at)) as date, COALESCE(SUM(COALESCE("+itemTargetAmountSubquery+", amount)), 0) as amount, COUNT(*) as count").
-		Where("user_id = ? AND COALESCE(due_date, created_at) >= ? AND COALESCE(due_date, created_at) <= ? AND deleted_at IS NULL", userID, start, end)
+// dayTotal is the amount and invoice count of one day
+type dayTotal struct {
+	Amount float64
+	Count  int64
+}
 
-	if opts.CategoryID != nil {
-		query = query.Where("category_id = ?", *opts.CategoryID)
-	}
-	if opts.CompanyID != nil {
-		query = query.Where("company_id = ?", *opts.CompanyID)
-	}
-	if opts.ReceiverID != nil {
-		query = query.Where("receiver_id = ?", *opts.ReceiverID)
-	}
-	if opts.Status != nil {
-		query = query.Where("status = ?", *opts.Status)
+// sumByLocalDay sums the matching invoices by the day (YYYY-MM-DD) their date falls on in loc.
+// Rows are bucketed in Go because SQLite's DATE() only knows UTC and fixed offsets, which
+// would misplace invoices across DST changes.
+func (s *analyticsService) sumByLocalDay(userID string, start, end time.Time, opts StatisticsOptions, loc *time.Location) (map[string]dayTotal, error) {
+	var rows []struct {
+		Unix   int64
+		Amount float64
 	}
-	if opts.Keyword != "" {
-		searchPattern := "%" + opts.Keyword + "%"
-		query = query.Where("(title LIKE ? OR description LIKE ?)", searchPattern, searchPattern)
+	selectExpr := "CAST(strftime('%s', " + opts.DateField.column("") + ") AS INTEGER) as unix, COALESCE(" + opts.invoiceAmount() + ", 0) as amount"
+	if err := s.buildStatisticsQuery(userID, start, end, opts).
+		Select(selectExpr).
+		Scan(&rows).Error; err != nil {
+		return nil, err
 	}
 
-	if err := query.Group("DATE(COALESCE(due_date, created_at))").Order("date ASC").Scan(&results).Error; err != nil {
-		return nil, err
+	totals := make(map[string]dayTotal)
+	for _, row := range rows {
+		day := time.Unix(row.Unix, 0).In(loc).Format("2006-01-02")
+		total := totals[day]
+		total.Amount += row.Amount
+		total.Count++
+		totals[day] = total
 	}
+	return totals, nil
+}
 
-	// Create a map of existing dates
-	dateMap := make(map[string]dayResult)
-	for _, r := range results {
-		dateMap[r.Date] = r
+// getGroupedByDay returns statistics grouped by day in loc, with a zero item for days without invoices
+func (s *analyticsService) getGroupedByDay(userID string, start, end time.Time, opts StatisticsOptions, loc *time.Location) ([]BreakdownItem, error) {
+	totals, err := s.sumByLocalDay(userID, start, end, opts, loc)
+	if err != nil {
+		return nil, err
 	}
 
 	// Fill in all days in the range
 	var breakdown []BreakdownItem
-	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+	for d := start.In(loc); !d.After(end); d = d.AddDate(0, 0, 1) {
 		dateStr := d.Format("2006-01-02")
-		if r, ok := dateMap[dateStr]; ok {
-			breakdown = append(breakdown, BreakdownItem{
-				Date:   r.Date,
-				Amount: r.Amount,
-				Count:  r.Count,
-			})
-		} else {
-			breakdown = append(breakdown, BreakdownItem{
-				Date:   dateStr,
-				Amount: 0,
-				Count:  0,
-			})
-		}
+		total := totals[dateStr]
+		breakdown = append(breakdown, BreakdownItem{
+			Date:   dateStr,
+			Amount: total.Amount,
+			Count:  total.Count,
+		})
 	}
 
 	return breakdown, nil
@@ -870,6 +1562,8 @@ func (s *analyticsService) getGroupedByDay(userID string, start, end time.Time,
 
 // getGroupedByWeek returns statistics grouped by week
 func (s *analyticsService) getGroupedByWeek(userID string, start, end time.Time, opts StatisticsOptions) ([]BreakdownItem, error) {
+	dateColumn := opts.DateField.column("")
+
 	type weekResult struct {
 		Date   string
 		Amount float64
@@ -879,28 +1573,13 @@ func (s *analyticsService) getGroupedByWeek(userID string, start, end time.Time,
 	var results []weekResult
 
 	// Use strftime to get week start (Monday)
-	query := s.db.Table("invoices").
-		Select("strftime('%Y-%W', COALESCE(due_date, created_at)) as date, COALESCE(SUM(COALESCE("+itemTargetAmountSubquery+", amount)), 0) as amount, COUNT(*) as count").
-		Where("user_id = ? AND COALESCE(due_date, created_at) >= ? AND COALESCE(due_date, created_at) <= ? AND deleted_at IS NULL", userID, start, end)
+	query := s.db.Table("invoices").Scopes(excludeDrafts).
+		Select("strftime('%Y-%W', "+dateColumn+") as date, COALESCE(SUM("+opts.invoiceAmount()+"), 0) as amount, COUNT(*) as count").
+		Where("user_id = ? AND "+dateColumn+" >= ? AND "+dateColumn+" <= ? AND deleted_at IS NULL", userID, start, end)
 
-	if opts.CategoryID != nil {
-		query = query.Where("category_id = ?", *opts.CategoryID)
-	}
-	if opts.CompanyID != nil {
-		query = query.Where("company_id = ?", *opts.CompanyID)
-	}
-	if opts.ReceiverID != nil {
-		query = query.Where("receiver_id = ?", *opts.ReceiverID)
-	}
-	if opts.Status != nil {
-		query = query.Where("status = ?", *opts.Status)
-	}
-	if opts.Keyword != "" {
-		searchPattern := "%" + opts.Keyword + "%"
-		query = query.Where("(title LIKE ? OR description LIKE ?)", searchPattern, searchPattern)
-	}
+	query = applyStatisticsFilters(query, opts, "")
 
-	if err := query.Group("strftime('%Y-%W', COALESCE(due_date, created_at))").Order("date ASC").Scan(&results).Error; err != nil {
+	if err := query.Group("strftime('%Y-%W', " + dateColumn + ")").Order("date ASC").Scan(&results).Error; err != nil {
 		return nil, err
 	}
 
@@ -918,6 +1597,8 @@ func (s *analyticsService) getGroupedByWeek(userID string, start, end time.Time,
 
 // getGroupedByMonth returns statistics grouped by month
 func (s *analyticsService) getGroupedByMonth(userID string, start, end time.Time, opts StatisticsOptions) ([]BreakdownItem, error) {
+	dateColumn := opts.DateField.column("")
+
 	type monthResult struct {
 		Date   string
 		Amount float64
@@ -926,28 +1607,50 @@ func (s *analyticsService) getGroupedByMonth(userID string, start, end time.Time
 
 	var results []monthResult
 
-	query := s.db.Table("invoices").
-		Select("strftime('%Y-%m', COALESCE(due_date, created_at)) as date, COALESCE(SUM(COALESCE("+itemTargetAmountSubquery+", amount)), 0) as amount, COUNT(*) as count").
-		Where("user_id = ? AND COALESCE(due_date, created_at) >= ? AND COALESCE(due_date, created_at) <= ? AND deleted_at IS NULL", userID, start, end)
+	query := s.db.Table("invoices").Scopes(excludeDrafts).
+		Select("strftime('%Y-%m', "+dateColumn+") as date, COALESCE(SUM("+opts.invoiceAmount()+"), 0) as amount, COUNT(*) as count").
+		Where("user_id = ? AND "+dateColumn+" >= ? AND "+dateColumn+" <= ? AND deleted_at IS NULL", userID, start, end)
 
-	if opts.CategoryID != nil {
-		query = query.Where("category_id = ?", *opts.CategoryID)
-	}
-	if opts.CompanyID != nil {
-		query = query.Where("company_id = ?", *opts.CompanyID)
-	}
-	if opts.ReceiverID != nil {
-		query = query.Where("receiver_id = ?", *opts.ReceiverID)
+	query = applyStatisticsFilters(query, opts, "")
+
+	if err := query.Group("strftime('%Y-%m', " + dateColumn + ")").Order("date ASC").Scan(&results).Error; err != nil {
+		return nil, err
 	}
-	if opts.Status != nil {
-		query = query.Where("status = ?", *opts.Status)
+
+	var breakdown []BreakdownItem
+	for _, r := range results {
+		breakdown = append(breakdown, BreakdownItem{
+			Date:   r.Date,
+			Amount: r.Amount,
+			Count:  r.Count,
+		})
 	}
-	if opts.Keyword != "" {
-		searchPattern := "%" + opts.Keyword + "%"
-		query = query.Where("(title LIKE ? OR description LIKE ?)", searchPattern, searchPattern)
+
+	return breakdown, nil
+}
+
+// getGroupedByQuarter returns statistics grouped by calendar quarter (e.g. "2024-Q1")
+func (s *analyticsService) getGroupedByQuarter(userID string, start, end time.Time, opts StatisticsOptions) ([]BreakdownItem, error) {
+	dateColumn := opts.DateField.column("")
+	// Months 1-3 map to Q1, 4-6 to Q2, and so on; the year prefix keeps quarters of
+	// different years apart and makes the labels sort chronologically
+	quarter := "strftime('%Y', " + dateColumn + ") || '-Q' || ((CAST(strftime('%m', " + dateColumn + ") AS INTEGER) + 2) / 3)"
+
+	type quarterResult struct {
+		Date   string
+		Amount float64
+		Count  int64
 	}
 
-	if err := query.Group("strftime('%Y-%m', COALESCE(due_date, created_at))").Order("date ASC").Scan(&results).Error; err != nil {
+	var results []quarterResult
+
+	query := s.db.Table("invoices").Scopes(excludeDrafts).
+		Select(quarter+" as date, COALESCE(SUM("+opts.invoiceAmount()+"), 0) as amount, COUNT(*) as count").
+		Where("user_id = ? AND "+dateColumn+" >= ? AND "+dateColumn+" <= ? AND deleted_at IS NULL", userID, start, end)
+
+	query = applyStatisticsFilters(query, opts, "")
+
+	if err := query.Group(quarter).Order("date ASC").Scan(&results).Error; err != nil {
 		return nil, err
 	}
 
@@ -965,6 +1668,8 @@ func (s *analyticsService) getGroupedByMonth(userID string, start, end time.Time
 
 // getGroupedByCategory returns statistics grouped by category
 func (s *analyticsService) getGroupedByCategory(userID string, start, end time.Time, opts StatisticsOptions) ([]BreakdownItem, error) {
+	joinedDateColumn := opts.DateField.column("invoices.")
+
 	type categoryResult struct {
 		ID     uint
 		Name   string
@@ -974,28 +1679,20 @@ func (s *analyticsService) getGroupedByCategory(userID string, start, end time.T
 
 	var results []categoryResult
 
-	query := s.db.Table("invoices").
-		Select("invoice_categories.id, invoice_categories.name, COALESCE(SUM(COALESCE("+itemTargetAmountSubquery+", invoices.amount)), 0) as amount, COUNT(invoices.id) as count").
-		Joins("LEFT JOIN invoice_categories ON invoices.category_id = invoice_categories.id").
-		Where("invoices.user_id = ? AND COALESCE(invoices.due_date, invoices.created_at) >= ? AND COALESCE(invoices.due_date, invoices.created_at) <= ? AND invoices.deleted_at IS NULL", userID, start, end)
+	query := s.db.Table("invoices").Scopes(excludeDrafts).
+		Select("invoice_categories.id, invoice_categories.name, COALESCE(SUM("+opts.itemAmount()+"), 0) as amount, COUNT(DISTINCT invoices.id) as count").
+		Joins(itemCategoryJoin).
+		Where("invoices.user_id = ? AND "+joinedDateColumn+" >= ? AND "+joinedDateColumn+" <= ? AND invoices.deleted_at IS NULL", userID, start, end)
 
 	if opts.CategoryID != nil {
-		query = query.Where("invoices.category_id = ?", *opts.CategoryID)
-	}
-	if opts.CompanyID != nil {
-		query = query.Where("invoices.company_id = ?", *opts.CompanyID)
-	}
-	if opts.ReceiverID != nil {
-		query = query.Where("invoices.receiver_id = ?", *opts.ReceiverID)
-	}
-	if opts.Status != nil {
-		query = query.Where("invoices.status = ?", *opts.Status)
-	}
-	if opts.Keyword != "" {
-		searchPattern := "%" + opts.Keyword + "%"
-		query = query.Where("(invoices.title LIKE ? OR invoices.description LIKE ?)", searchPattern, searchPattern)
+		query = query.Where(itemCategoryColumn+" = ?", *opts.CategoryID)
 	}
 
+	// The category filter matches item categories above rather than the invoice's
+	filters := opts
+	filters.CategoryID = nil
+	query = applyStatisticsFilters(query, filters, "invoices.")
+
 	if err := query.Group("invoice_categories.id, invoice_categories.name").Order("amount DESC").Scan(&results).Error; err != nil {
 		return nil, err
 	}
@@ -1019,6 +1716,8 @@ func (s *analyticsService) getGroupedByCategory(userID string, start, end time.T
 
 // getGroupedByCompany returns statistics grouped by company
 func (s *analyticsService) getGroupedByCompany(userID string, start, end time.Time, opts StatisticsOptions) ([]BreakdownItem, error) {
+	joinedDateColumn := opts.DateField.column("invoices.")
+
 	type companyResult struct {
 		ID     uint
 		Name   string
@@ -1028,27 +1727,12 @@ func (s *analyticsService) getGroupedByCompany(userID string, start, end time.Ti
 
 	var results []companyResult
 
-	query := s.db.Table("invoices").
-		Select("invoice_companies.id, invoice_companies.name, COALESCE(SUM(COALESCE("+itemTargetAmountSubquery+", invoices.amount)), 0) as amount, COUNT(invoices.id) as count").
+	query := s.db.Table("invoices").Scopes(excludeDrafts).
+		Select("invoice_companies.id, invoice_companies.name, COALESCE(SUM("+opts.invoiceAmount()+"), 0) as amount, COUNT(invoices.id) as count").
 		Joins("LEFT JOIN invoice_companies ON invoices.company_id = invoice_companies.id").
-		Where("invoices.user_id = ? AND COALESCE(invoices.due_date, invoices.created_at) >= ? AND COALESCE(invoices.due_date, invoices.created_at) <= ? AND invoices.deleted_at IS NULL", userID, start, end)
+		Where("invoices.user_id = ? AND "+joinedDateColumn+" >= ? AND "+joinedDateColumn+" <= ? AND invoices.deleted_at IS NULL", userID, start, end)
 
-	if opts.CategoryID != nil {
-		query = query.Where("invoices.category_id = ?", *opts.CategoryID)
-	}
-	if opts.CompanyID != nil {
-		query = query.Where("invoices.company_id = ?", *opts.CompanyID)
-	}
-	if opts.ReceiverID != nil {
-		query = query.Where("invoices.receiver_id = ?", *opts.ReceiverID)
-	}
-	if opts.Status != nil {
-		query = query.Where("invoices.status = ?", *opts.Status)
-	}
-	if opts.Keyword != "" {
-		searchPattern := "%" + opts.Keyword + "%"
-		query = query.Where("(invoices.title LIKE ? OR invoices.description LIKE ?)", searchPattern, searchPattern)
-	}
+	query = applyStatisticsFilters(query, opts, "invoices.")
 
 	if err := query.Group("invoice_companies.id, invoice_companies.name").Order("amount DESC").Scan(&results).Error; err != nil {
 		return nil, err
@@ -1073,6 +1757,8 @@ func (s *analyticsService) getGroupedByCompany(userID string, start, end time.Ti
 
 // getGroupedByReceiver returns statistics grouped by receiver
 func (s *analyticsService) getGroupedByReceiver(userID string, start, end time.Time, opts StatisticsOptions) ([]BreakdownItem, error) {
+	joinedDateColumn := opts.DateField.column("invoices.")
+
 	type receiverResult struct {
 		ID     uint
 		Name   string
@@ -1082,27 +1768,12 @@ func (s *analyticsService) getGroupedByReceiver(userID string, start, end time.T
 
 	var results []receiverResult
 
-	query := s.db.Table("invoices").
-		Select("invoice_receivers.id, invoice_receivers.name, COALESCE(SUM(COALESCE("+itemTargetAmountSubquery+", invoices.amount)), 0) as amount, COUNT(invoices.id) as count").
+	query := s.db.Table("invoices").Scopes(excludeDrafts).
+		Select("invoice_receivers.id, invoice_receivers.name, COALESCE(SUM("+opts.invoiceAmount()+"), 0) as amount, COUNT(invoices.id) as count").
 		Joins("LEFT JOIN invoice_receivers ON invoices.receiver_id = invoice_receivers.id").
-		Where("invoices.user_id = ? AND COALESCE(invoices.due_date, invoices.created_at) >= ? AND COALESCE(invoices.due_date, invoices.created_at) <= ? AND invoices.deleted_at IS NULL", userID, start, end)
+		Where("invoices.user_id = ? AND "+joinedDateColumn+" >= ? AND "+joinedDateColumn+" <= ? AND invoices.deleted_at IS NULL", userID, start, end)
 
-	if opts.CategoryID != nil {
-		query = query.Where("invoices.category_id = ?", *opts.CategoryID)
-	}
-	if opts.CompanyID != nil {
-		query = query.Where("invoices.company_id = ?", *opts.CompanyID)
-	}
-	if opts.ReceiverID != nil {
-		query = query.Where("invoices.receiver_id = ?", *opts.ReceiverID)
-	}
-	if opts.Status != nil {
-		query = query.Where("invoices.status = ?", *opts.Status)
-	}
-	if opts.Keyword != "" {
-		searchPattern := "%" + opts.Keyword + "%"
-		query = query.Where("(invoices.title LIKE ? OR invoices.description LIKE ?)", searchPattern, searchPattern)
-	}
+	query = applyStatisticsFilters(query, opts, "invoices.")
 
 	if err := query.Group("invoice_receivers.id, invoice_receivers.name").Order("amount DESC").Scan(&results).Error; err != nil {
 		return nil, err
@@ -1125,9 +1796,63 @@ func (s *analyticsService) getGroupedByReceiver(userID string, start, end time.T
 	return breakdown, nil
 }
 
+// getGroupedByPaymentMethod returns statistics grouped by payment method
+func (s *analyticsService) getGroupedByPaymentMethod(userID string, start, end time.Time, opts StatisticsOptions) ([]BreakdownItem, error) {
+	var results []struct {
+		Name   string
+		Amount float64
+		Count  int64
+	}
+
+	method := "COALESCE(payment_method, '')"
+	if err := s.buildStatisticsQuery(userID, start, end, opts).
+		Select(method + " as name, COALESCE(SUM(" + opts.invoiceAmount() + "), 0) as amount, COUNT(*) as count").
+		Group(method).
+		Order("amount DESC, name ASC").
+		Scan(&results).Error; err != nil {
+		return nil, err
+	}
+
+	var breakdown []BreakdownItem
+	for _, r := range results {
+		name := r.Name
+		if name == "" {
+			name = UnspecifiedPaymentMethod
+		}
+		breakdown = append(breakdown, BreakdownItem{
+			Name:   name,
+			Amount: r.Amount,
+			Count:  r.Count,
+		})
+	}
+
+	return breakdown, nil
+}
+
+// limitBreakdown keeps the first limit items of a breakdown sorted by amount descending.
+// With others set, the remaining items are summed into a trailing "Other" item.
+func limitBreakdown(breakdown []BreakdownItem, limit int, others bool) []BreakdownItem {
+	if limit <= 0 || len(breakdown) <= limit {
+		return breakdown
+	}
+
+	top := breakdown[:limit:limit]
+	if !others {
+		return top
+	}
+
+	other := BreakdownItem{Name: OthersBreakdownName}
+	for _, item := range breakdown[limit:] {
+		other.Amount += item.Amount
+		other.Count += item.Count
+	}
+	return append(top, other)
+}
+
 // getAggregations returns aggregation statistics
-func (s *analyticsService) getAggregations(userID string, start, end time.Time, opts StatisticsOptions) (*AggregationStats, error) {
+func (s *analyticsService) getAggregations(userID string, start, end time.Time, opts StatisticsOptions, loc *time.Location) (*AggregationStats, error) {
 	aggs := &AggregationStats{}
+	joinedDateColumn := opts.DateField.column("invoices.")
 
 	var result struct {
 		MaxAmount float64
@@ -1136,7 +1861,7 @@ func (s *analyticsService) getAggregations(userID string, start, end time.Time,
 	}
 
 	if err := s.buildStatisticsQuery(userID, start, end, opts).
-		Select("COALESCE(MAX(COALESCE(" + itemTargetAmountSubquery + ", amount)), 0) as max_amount, COALESCE(MIN(COALESCE(" + itemTargetAmountSubquery + ", amount)), 0) as min_amount, COALESCE(AVG(COALESCE(" + itemTargetAmountSubquery + ", amount)), 0) as avg_amount").
+		Select("COALESCE(MAX(" + opts.invoiceAmount() + "), 0) as max_amount, COALESCE(MIN(" + opts.invoiceAmount() + "), 0) as min_amount, COALESCE(AVG(" + opts.invoiceAmount() + "), 0) as avg_amount").
 		Scan(&result).Error; err != nil {
 		return nil, err
 	}
@@ -1145,10 +1870,10 @@ func (s *analyticsService) getAggregations(userID string, start, end time.Time,
 	aggs.MinAmount = result.MinAmount
 	aggs.AvgAmount = result.AvgAmount
 
-	// Get max invoice reference (by target_amount for USD normalization)
+	// Get max invoice reference (by target_amount for base currency normalization)
 	var maxInvoice models.Invoice
 	if err := s.buildStatisticsQuery(userID, start, end, opts).
-		Order("COALESCE(" + itemTargetAmountSubquery + ", amount) DESC").
+		Order(opts.invoiceAmount() + " DESC").
 		Limit(1).
 		Find(&maxInvoice).Error; err != nil {
 		return nil, err
@@ -1160,75 +1885,53 @@ func (s *analyticsService) getAggregations(userID string, start, end time.Time,
 		}
 	}
 
-	// If grouped by day, find max day
-	if opts.GroupBy == GroupByDay {
-		type dayResult struct {
-			Date   string
-			Amount float64
-		}
-		var maxDay dayResult
-
-		query := s.db.Table("invoices").
-			Select("DATE(COALESCE(due_date, created_at)) as date, COALESCE(SUM(COALESCE("+itemTargetAmountSubquery+", amount)), 0) as amount").
-			Where("user_id = ? AND COALESCE(due_date, created_at) >= ? AND COALESCE(due_date, created_at) <= ? AND deleted_at IS NULL", userID, start, end)
-
-		if opts.CategoryID != nil {
-			query = query.Where("category_id = ?", *opts.CategoryID)
-		}
-		if opts.CompanyID != nil {
-			query = query.Where("company_id = ?", *opts.CompanyID)
-		}
-		if opts.ReceiverID != nil {
-			query = query.Where("receiver_id = ?", *opts.ReceiverID)
-		}
-		if opts.Status != nil {
-			query = query.Where("status = ?", *opts.Status)
-		}
-		if opts.Keyword != "" {
-			searchPattern := "%" + opts.Keyword + "%"
-			query = query.Where("(title LIKE ? OR description LIKE ?)", searchPattern, searchPattern)
+	// Get min invoice reference, preferring non-zero amounts
+	amountExpr := opts.invoiceAmount()
+	var minInvoice models.Invoice
+	if err := s.buildStatisticsQuery(userID, start, end, opts).
+		Order(amountExpr + " = 0 ASC, " + amountExpr + " ASC").
+		Limit(1).
+		Find(&minInvoice).Error; err != nil {
+		return nil, err
+	}
+	if minInvoice.ID != 0 {
+		aggs.MinInvoice = &InvoiceReference{
+			ID:    minInvoice.ID,
+			Title: minInvoice.Title,
 		}
+	}
 
-		if err := query.Group("DATE(COALESCE(due_date, created_at))").Order("amount DESC").Limit(1).Scan(&maxDay).Error; err != nil {
+	// If grouped by day, find max day
+	if opts.GroupBy == GroupByDay {
+		totals, err := s.sumByLocalDay(userID, start, end, opts, loc)
+		if err != nil {
 			return nil, err
 		}
-		if maxDay.Date != "" {
-			aggs.MaxDay = &DayReference{
-				Date:   maxDay.Date,
-				Amount: maxDay.Amount,
-			}
-		}
+		aggs.MaxDay, aggs.MinDay = maxMinDays(totals)
 	}
 
 	// If grouped by category, find max category
 	if opts.GroupBy == GroupByCategory {
+		// category_amount avoids clashing with the joined items' amount column
 		type catResult struct {
 			ID     uint
 			Name   string
-			Amount float64
+			Amount float64 `gorm:"column:category_amount"`
 		}
 		var maxCat catResult
 
-		query := s.db.Table("invoices").
-			Select("invoice_categories.id, invoice_categories.name, COALESCE(SUM(COALESCE("+itemTargetAmountSubquery+", invoices.amount)), 0) as amount").
-			Joins("LEFT JOIN invoice_categories ON invoices.category_id = invoice_categories.id").
-			Where("invoices.user_id = ? AND COALESCE(invoices.due_date, invoices.created_at) >= ? AND COALESCE(invoices.due_date, invoices.created_at) <= ? AND invoices.deleted_at IS NULL", userID, start, end)
+		query := s.db.Table("invoices").Scopes(excludeDrafts).
+			Select("invoice_categories.id, invoice_categories.name, COALESCE(SUM("+opts.itemAmount()+"), 0) as category_amount").
+			Joins(itemCategoryJoin).
+			Where("invoices.user_id = ? AND "+joinedDateColumn+" >= ? AND "+joinedDateColumn+" <= ? AND invoices.deleted_at IS NULL", userID, start, end)
 
-		if opts.CompanyID != nil {
-			query = query.Where("invoices.company_id = ?", *opts.CompanyID)
-		}
-		if opts.ReceiverID != nil {
-			query = query.Where("invoices.receiver_id = ?", *opts.ReceiverID)
-		}
-		if opts.Status != nil {
-			query = query.Where("invoices.status = ?", *opts.Status)
-		}
-		if opts.Keyword != "" {
-			searchPattern := "%" + opts.Keyword + "%"
-			query = query.Where("(invoices.title LIKE ? OR invoices.description LIKE ?)", searchPattern, searchPattern)
-		}
+		// Categories are ranked against each other, so the category filter doesn't apply
+		filters := opts
+		filters.CategoryID = nil
+		query = applyStatisticsFilters(query, filters, "invoices.")
 
-		if err := query.Group("invoice_categories.id, invoice_categories.name").Order("amount DESC").Limit(1).Scan(&maxCat).Error; err != nil {
+		query = query.Group("invoice_categories.id, invoice_categories.name").Session(&gorm.Session{})
+		if err := query.Order("category_amount DESC").Limit(1).Scan(&maxCat).Error; err != nil {
 			return nil, err
 		}
 		if maxCat.Name != "" {
@@ -1238,6 +1941,19 @@ func (s *analyticsService) getAggregations(userID string, start, end time.Time,
 				Amount: maxCat.Amount,
 			}
 		}
+
+		// Uncategorized invoices are not a category, so leave them out of the min
+		var minCat catResult
+		if err := query.Where("invoice_categories.id IS NOT NULL").Order("category_amount = 0 ASC, category_amount ASC").Limit(1).Scan(&minCat).Error; err != nil {
+			return nil, err
+		}
+		if minCat.Name != "" {
+			aggs.MinCategory = &EntityReference{
+				ID:     minCat.ID,
+				Name:   minCat.Name,
+				Amount: minCat.Amount,
+			}
+		}
 	}
 
 	// If grouped by company, find max company
@@ -1249,26 +1965,18 @@ func (s *analyticsService) getAggregations(userID string, start, end time.Time,
 		}
 		var maxComp compResult
 
-		query := s.db.Table("invoices").
-			Select("invoice_companies.id, invoice_companies.name, COALESCE(SUM(COALESCE("+itemTargetAmountSubquery+", invoices.amount)), 0) as amount").
+		query := s.db.Table("invoices").Scopes(excludeDrafts).
+			Select("invoice_companies.id, invoice_companies.name, COALESCE(SUM("+opts.invoiceAmount()+"), 0) as amount").
 			Joins("LEFT JOIN invoice_companies ON invoices.company_id = invoice_companies.id").
-			Where("invoices.user_id = ? AND COALESCE(invoices.due_date, invoices.created_at) >= ? AND COALESCE(invoices.due_date, invoices.created_at) <= ? AND invoices.deleted_at IS NULL", userID, start, end)
+			Where("invoices.user_id = ? AND "+joinedDateColumn+" >= ? AND "+joinedDateColumn+" <= ? AND invoices.deleted_at IS NULL", userID, start, end)
 
-		if opts.CategoryID != nil {
-			query = query.Where("invoices.category_id = ?", *opts.CategoryID)
-		}
-		if opts.ReceiverID != nil {
-			query = query.Where("invoices.receiver_id = ?", *opts.ReceiverID)
-		}
-		if opts.Status != nil {
-			query = query.Where("invoices.status = ?", *opts.Status)
-		}
-		if opts.Keyword != "" {
-			searchPattern := "%" + opts.Keyword + "%"
-			query = query.Where("(invoices.title LIKE ? OR invoices.description LIKE ?)", searchPattern, searchPattern)
-		}
+		// Companies are ranked against each other, so the company filter doesn't apply
+		filters := opts
+		filters.CompanyID = nil
+		query = applyStatisticsFilters(query, filters, "invoices.")
 
-		if err := query.Group("invoice_companies.id, invoice_companies.name").Order("amount DESC").Limit(1).Scan(&maxComp).Error; err != nil {
+		query = query.Group("invoice_companies.id, invoice_companies.name").Session(&gorm.Session{})
+		if err := query.Order("amount DESC").Limit(1).Scan(&maxComp).Error; err != nil {
 			return nil, err
 		}
 		if maxComp.Name != "" {
@@ -1278,7 +1986,338 @@ func (s *analyticsService) getAggregations(userID string, start, end time.Time,
 				Amount: maxComp.Amount,
 			}
 		}
+
+		var minComp compResult
+		if err := query.Where("invoice_companies.id IS NOT NULL").Order("amount = 0 ASC, amount ASC").Limit(1).Scan(&minComp).Error; err != nil {
+			return nil, err
+		}
+		if minComp.Name != "" {
+			aggs.MinCompany = &EntityReference{
+				ID:     minComp.ID,
+				Name:   minComp.Name,
+				Amount: minComp.Amount,
+			}
+		}
 	}
 
 	return aggs, nil
 }
+
+// receiverDetailTopInvoices is the number of largest invoices included in a receiver detail
+const receiverDetailTopInvoices = 5
+
+// GetReceiverDetail returns statistics for a single receiver owned by the user
+func (s *analyticsService) GetReceiverDetail(userID string, receiverID uint, period StatisticsPeriod) (*ReceiverDetail, error) {
+	var receiver models.InvoiceReceiver
+	if err := s.db.Where("id = ? AND user_id = ?", receiverID, userID).First(&receiver).Error; err != nil {
+		return nil, fmt.Errorf("receiver not found: %w", err)
+	}
+
+	opts := StatisticsOptions{Period: period, ReceiverID: &receiverID}
+	start, end, err := s.getStatisticsDateRange(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
+	if err != nil {
+		return nil, err
+	}
+	detail := &ReceiverDetail{
+		ReceiverID:  receiver.ID,
+		Name:        receiver.Name,
+		Period:      string(period),
+		StartDate:   start,
+		EndDate:     end,
+		Currency:    baseCurrency,
+		TopInvoices: []InvoiceAmountReference{},
+	}
+
+	var result struct {
+		InvoiceCount int64
+		PaidCount    int64
+		UnpaidCount  int64
+		TotalAmount  float64
+		PaidAmount   float64
+		UnpaidAmount float64
+		AvgAmount    float64
+	}
+	if err := s.buildStatisticsQuery(userID, start, end, opts).
+		Select(`
+			COUNT(*) as invoice_count,
+			COALESCE(SUM(CASE WHEN status = 'paid' THEN 1 ELSE 0 END), 0) as paid_count,
+			COALESCE(SUM(CASE WHEN status IN ('unpaid', 'overdue') THEN 1 ELSE 0 END), 0) as unpaid_count,
+			COALESCE(SUM(COALESCE(` + itemTargetAmountSubquery + `, amount)), 0) as total_amount,
+			COALESCE(SUM(CASE WHEN status = 'paid' THEN COALESCE(` + itemTargetAmountSubquery + `, amount) ELSE 0 END), 0) as paid_amount,
+			COALESCE(SUM(CASE WHEN status IN ('unpaid', 'overdue') THEN COALESCE(` + itemTargetAmountSubquery + `, amount) ELSE 0 END), 0) as unpaid_amount,
+			COALESCE(AVG(COALESCE(` + itemTargetAmountSubquery + `, amount)), 0) as avg_amount
+		`).
+		Scan(&result).Error; err != nil {
+		return nil, err
+	}
+
+	detail.InvoiceCount = result.InvoiceCount
+	detail.PaidCount = result.PaidCount
+	detail.UnpaidCount = result.UnpaidCount
+	detail.TotalAmount = result.TotalAmount
+	detail.PaidAmount = result.PaidAmount
+	detail.UnpaidAmount = result.UnpaidAmount
+	detail.AvgAmount = result.AvgAmount
+
+	if detail.InvoiceCount == 0 {
+		return detail, nil
+	}
+
+	// First and last invoice dates (due date with created_at fallback)
+	var first, last models.Invoice
+	if err := s.buildStatisticsQuery(userID, start, end, opts).
+		Order("COALESCE(due_date, created_at) ASC").
+		Limit(1).
+		Find(&first).Error; err != nil {
+		return nil, err
+	}
+	if err := s.buildStatisticsQuery(userID, start, end, opts).
+		Order("COALESCE(due_date, created_at) DESC").
+		Limit(1).
+		Find(&last).Error; err != nil {
+		return nil, err
+	}
+	detail.FirstInvoiceDate = invoiceEffectiveDate(&first)
+	detail.LastInvoiceDate = invoiceEffectiveDate(&last)
+
+	// Largest invoices by base-currency-normalized amount
+	if err := s.buildStatisticsQuery(userID, start, end, opts).
+		Select("id, title, COALESCE(" + itemTargetAmountSubquery + ", amount) as amount").
+		Order("amount DESC").
+		Limit(receiverDetailTopInvoices).
+		Scan(&detail.TopInvoices).Error; err != nil {
+		return nil, err
+	}
+
+	return detail, nil
+}
+
+// invoiceEffectiveDate returns the date analytics uses for an invoice (due date with created_at fallback)
+func invoiceEffectiveDate(invoice *models.Invoice) *time.Time {
+	if invoice.DueDate != nil {
+		return invoice.DueDate
+	}
+	createdAt := invoice.CreatedAt
+	return &createdAt
+}
+
+// GetCompanyBreakdown returns a company's total for the period with its split by receiver and by category
+func (s *analyticsService) GetCompanyBreakdown(userID string, companyID uint, period StatisticsPeriod) (*CompanyBreakdown, error) {
+	var company models.InvoiceCompany
+	if err := s.db.Where("id = ? AND user_id = ?", companyID, userID).First(&company).Error; err != nil {
+		return nil, fmt.Errorf("company not found: %w", err)
+	}
+
+	opts := StatisticsOptions{Period: period, CompanyID: &companyID}
+	start, end, err := s.getStatisticsDateRange(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
+	if err != nil {
+		return nil, err
+	}
+	breakdown := &CompanyBreakdown{
+		CompanyID:  company.ID,
+		Name:       company.Name,
+		Period:     string(period),
+		StartDate:  start,
+		EndDate:    end,
+		Currency:   baseCurrency,
+		Receivers:  []BreakdownItem{},
+		Categories: []BreakdownItem{},
+	}
+
+	var result struct {
+		InvoiceCount int64
+		TotalAmount  float64
+	}
+	if err := s.buildStatisticsQuery(userID, start, end, opts).
+		Select("COUNT(*) as invoice_count, COALESCE(SUM(" + opts.invoiceAmount() + "), 0) as total_amount").
+		Scan(&result).Error; err != nil {
+		return nil, err
+	}
+	breakdown.InvoiceCount = result.InvoiceCount
+	breakdown.TotalAmount = result.TotalAmount
+
+	if breakdown.InvoiceCount == 0 {
+		return breakdown, nil
+	}
+
+	receivers, err := s.getGroupedByReceiver(userID, start, end, opts)
+	if err != nil {
+		return nil, err
+	}
+	for _, receiver := range receivers {
+		if receiver.ID == 0 {
+			receiver.Name = UnspecifiedReceiver
+		}
+		breakdown.Receivers = append(breakdown.Receivers, receiver)
+	}
+
+	categories, err := s.getGroupedByCategory(userID, start, end, opts)
+	if err != nil {
+		return nil, err
+	}
+	breakdown.Categories = append(breakdown.Categories, categories...)
+
+	return breakdown, nil
+}
+
+// GetCategorySpending returns the base-currency-normalized amount attributed to a category between start and end.
+// Items with their own category count towards it; other items count towards their invoice's category.
+func (s *analyticsService) GetCategorySpending(userID string, categoryID uint, start, end time.Time) (float64, error) {
+	var result struct {
+		Amount float64
+	}
+	invoiceIDs := s.buildStatisticsQuery(userID, start, end, StatisticsOptions{}).Select("id")
+	if err := s.db.Table("invoice_items").
+		Select("COALESCE(SUM(invoice_items.target_amount), 0) as amount").
+		Joins("INNER JOIN invoices ON invoices.id = invoice_items.invoice_id").
+		Where("invoice_items.deleted_at IS NULL AND invoices.id IN (?) AND "+itemCategoryColumn+" = ?", invoiceIDs, categoryID).
+		Scan(&result).Error; err != nil {
+		return 0, err
+	}
+	return result.Amount, nil
+}
+
+// shiftPeriod moves t by n windows of the given period (negative n moves back)
+func shiftPeriod(t time.Time, period AnalyticsPeriod, n int) (time.Time, error) {
+	switch period {
+	case Period7Days:
+		return t.AddDate(0, 0, 7*n), nil
+	case Period1Month:
+		return t.AddDate(0, n, 0), nil
+	case Period1Year:
+		return t.AddDate(n, 0, 0), nil
+	default:
+		return time.Time{}, fmt.Errorf("invalid period: %s", period)
+	}
+}
+
+// ForecastNextPeriod projects spending for the next window using the last DefaultForecastWindows windows
+func (s *analyticsService) ForecastNextPeriod(userID string, period AnalyticsPeriod) (*Forecast, error) {
+	return s.ForecastNextPeriodWithWindows(userID, period, DefaultForecastWindows)
+}
+
+// ForecastNextPeriodWithWindows projects spending for the window starting now as the average of
+// the last `windows` equivalent windows (windows <= 0 uses DefaultForecastWindows).
+// This is a heuristic: it assumes spending recurs at a steady rate and ignores trend and seasonality.
+func (s *analyticsService) ForecastNextPeriodWithWindows(userID string, period AnalyticsPeriod, windows int) (*Forecast, error) {
+	if windows <= 0 {
+		windows = DefaultForecastWindows
+	}
+	if windows > MaxForecastWindows {
+		return nil, fmt.Errorf("windows must be at most %d", MaxForecastWindows)
+	}
+
+	now := time.Now()
+	nextEnd, err := shiftPeriod(now, period, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
+	if err != nil {
+		return nil, err
+	}
+	forecast := &Forecast{
+		Period:    string(period),
+		StartDate: now,
+		EndDate:   nextEnd,
+		Currency:  baseCurrency,
+		Windows:   make([]ForecastWindow, 0, windows),
+	}
+
+	// Windows are half-open [start, end) so an invoice is never counted twice
+	var total float64
+	for i := 1; i <= windows; i++ {
+		start, _ := shiftPeriod(now, period, -i)
+		end, _ := shiftPeriod(now, period, -i+1)
+
+		var result struct {
+			Amount float64
+		}
+		if err := s.db.Model(&models.Invoice{}).Scopes(excludeDrafts).
+			Where("user_id = ? AND COALESCE(due_date, created_at) >= ? AND COALESCE(due_date, created_at) < ? AND deleted_at IS NULL",
+				userID, start, end).
+			Select("COALESCE(SUM(COALESCE(" + itemTargetAmountSubquery + ", amount)), 0) as amount").
+			Scan(&result).Error; err != nil {
+			return nil, err
+		}
+
+		forecast.Windows = append(forecast.Windows, ForecastWindow{StartDate: start, EndDate: end, Amount: result.Amount})
+		total += result.Amount
+		if i == 1 || result.Amount < forecast.Min {
+			forecast.Min = result.Amount
+		}
+		if i == 1 || result.Amount > forecast.Max {
+			forecast.Max = result.Amount
+		}
+	}
+	forecast.ProjectedTotal = total / float64(windows)
+
+	return forecast, nil
+}
+
+// GetMonthlyTrend returns one point per calendar month for the last `months` months including the
+// current one, oldest first and zero-filled (months <= 0 uses DefaultTrendMonths). Months follow
+// the user's timezone and invoices are placed by due date, falling back to created_at.
+func (s *analyticsService) GetMonthlyTrend(userID string, months int) ([]MonthlyTrendPoint, error) {
+	if months <= 0 {
+		months = DefaultTrendMonths
+	}
+	if months > MaxTrendMonths {
+		return nil, fmt.Errorf("months must be at most %d", MaxTrendMonths)
+	}
+
+	loc := s.settingsService.GetLocation(userID)
+	now := time.Now().In(loc)
+	points := make([]MonthlyTrendPoint, months)
+	index := make(map[string]int, months)
+	for i := range points {
+		// time.Date normalizes month overflow, so this crosses year boundaries correctly
+		start := time.Date(now.Year(), now.Month()-time.Month(months-1-i), 1, 0, 0, 0, 0, loc)
+		end := time.Date(start.Year(), start.Month()+1, 1, 0, 0, 0, 0, loc)
+		points[i] = MonthlyTrendPoint{Month: start.Format("2006-01"), StartDate: start, EndDate: end}
+		index[points[i].Month] = i
+	}
+
+	// Rows are bucketed in Go for the same reason as sumByLocalDay: SQLite only knows UTC.
+	// Amounts are the items' base-currency total; invoices without items contribute 0.
+	var rows []struct {
+		Unix   int64
+		Status models.InvoiceStatus
+		Amount float64
+	}
+	dateColumn := DateFieldDefault.column("")
+	if err := s.db.Model(&models.Invoice{}).Scopes(excludeDrafts).
+		Select("CAST(strftime('%s', "+dateColumn+") AS INTEGER) as unix, status, "+itemTargetAmountSubquery+" as amount").
+		Where("user_id = ? AND "+dateColumn+" >= ? AND "+dateColumn+" < ?", userID, points[0].StartDate, points[months-1].EndDate).
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		i, ok := index[time.Unix(row.Unix, 0).In(loc).Format("2006-01")]
+		if !ok {
+			continue
+		}
+		point := &points[i]
+		point.TotalAmount += row.Amount
+		point.InvoiceCount++
+		if row.Status == models.InvoiceStatusPaid {
+			point.PaidAmount += row.Amount
+		} else {
+			point.UnpaidAmount += row.Amount
+		}
+	}
+
+	return points, nil
+}
@@ -0,0 +1,166 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
+	"gorm.io/gorm"
+)
+
+// auditIgnoredFields are fields left out of audit diffs because they are ownership or
+// relation bookkeeping rather than user-visible changes
+var auditIgnoredFields = []string{"user_id", "invoice_id"}
+
+// AuditEntry describes a change to record in the audit trail
+type AuditEntry struct {
+	UserID     string
+	ActorSub   string
+	EntityType string
+	EntityID   uint
+	InvoiceID  uint
+	Action     string
+	// Before and After are the entity before and after the change (nil on create and delete)
+	Before interface{}
+	After  interface{}
+}
+
+// AuditTrailOptions filters and pages the entries of an audit trail
+type AuditTrailOptions struct {
+	// Action keeps only entries with this action (one of the models.AuditAction constants); empty
+	// keeps every action
+	Action string
+	// Start and End bound when the entries were recorded, inclusive; nil leaves that side open
+	Start *time.Time
+	End   *time.Time
+	// Limit of 0 or less returns every entry after Offset
+	Limit  int
+	Offset int
+}
+
+// Validate rejects an unknown action, a negative offset, or an End before Start
+func (o AuditTrailOptions) Validate() error {
+	switch o.Action {
+	case "", models.AuditActionCreate, models.AuditActionUpdate, models.AuditActionStatusChange, models.AuditActionDelete:
+	default:
+		return fmt.Errorf("invalid action %q: must be one of create, update, status_change, delete", o.Action)
+	}
+	if o.Offset < 0 {
+		return fmt.Errorf("offset must not be negative")
+	}
+	if o.Start != nil && o.End != nil && o.End.Before(*o.Start) {
+		return fmt.Errorf("end must not be before start")
+	}
+	return nil
+}
+
+// AuditService records and lists the change history of invoices
+type AuditService interface {
+	// Record stores an audit entry with a field-level diff of Before and After.
+	// Failures are logged rather than returned so auditing never fails the audited operation.
+	// Updates that change no fields are not recorded.
+	Record(entry AuditEntry)
+	// ListInvoiceTrail returns a page of the audit entries of an invoice and its items, newest
+	// first, and the number of entries matching opts across all pages
+	ListInvoiceTrail(userID string, invoiceID uint, opts AuditTrailOptions) ([]models.AuditLog, int64, error)
+	// ListStatusHistory is ListInvoiceTrail for the entries of the invoice itself that set or
+	// changed its status
+	ListStatusHistory(userID string, invoiceID uint, opts AuditTrailOptions) ([]models.AuditLog, int64, error)
+}
+
+type auditService struct {
+	db *gorm.DB
+}
+
+// NewAuditService creates a new AuditService instance
+func NewAuditService(db *gorm.DB) AuditService {
+	return &auditService{db: db}
+}
+
+// Record stores an audit entry, logging instead of failing when it can't be stored
+func (s *auditService) Record(entry AuditEntry) {
+	diff, err := utils.DiffFields(entry.Before, entry.After, auditIgnoredFields...)
+	if err != nil {
+		log.Printf("Warning: Failed to diff %s %d for audit: %v", entry.EntityType, entry.EntityID, err)
+		return
+	}
+	if len(diff) == 0 && entry.Before != nil && entry.After != nil {
+		return
+	}
+
+	auditLog := models.AuditLog{
+		UserID:     entry.UserID,
+		EntityType: entry.EntityType,
+		EntityID:   entry.EntityID,
+		InvoiceID:  entry.InvoiceID,
+		Action:     entry.Action,
+		ActorSub:   entry.ActorSub,
+		Diff:       models.JSON(diff),
+	}
+	if _, ok := diff["status"]; ok && entry.EntityType == models.AuditEntityInvoice {
+		auditLog.StatusChanged = true
+	}
+	if err := s.db.Create(&auditLog).Error; err != nil {
+		log.Printf("Warning: Failed to record audit entry for %s %d: %v", entry.EntityType, entry.EntityID, err)
+	}
+}
+
+// auditEntriesVisibleTo scopes audit queries to the entries the user recorded and those of the
+// invoices the user may open (see invoicesVisibleTo), so members of an organization share the
+// trail of its invoices. Soft-deleted invoices still match, keeping their trail available.
+func auditEntriesVisibleTo(userID string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("(audit_logs.user_id = ? OR audit_logs.invoice_id IN (SELECT invoices.id FROM invoices WHERE "+invoicesVisibleCondition+"))",
+			userID, userID, userID)
+	}
+}
+
+// ListInvoiceTrail returns a page of the audit entries of an invoice and its items, newest
+// first. The trail remains available after the invoice is deleted.
+func (s *auditService) ListInvoiceTrail(userID string, invoiceID uint, opts AuditTrailOptions) ([]models.AuditLog, int64, error) {
+	query := s.db.Model(&models.AuditLog{}).Scopes(auditEntriesVisibleTo(userID)).Where("invoice_id = ?", invoiceID)
+	return listAuditEntries(query, opts)
+}
+
+// ListStatusHistory returns a page of the entries of the invoice itself whose diff has its
+// status (StatusChanged): its creation, status changes, updates that changed the status, and its
+// deletion.
+func (s *auditService) ListStatusHistory(userID string, invoiceID uint, opts AuditTrailOptions) ([]models.AuditLog, int64, error) {
+	query := s.db.Model(&models.AuditLog{}).Scopes(auditEntriesVisibleTo(userID)).
+		Where("entity_type = ? AND entity_id = ? AND status_changed = ?", models.AuditEntityInvoice, invoiceID, true)
+	return listAuditEntries(query, opts)
+}
+
+// listAuditEntries applies opts to a query of audit entries, returning the page newest first and
+// the number of entries matching across all pages
+func listAuditEntries(query *gorm.DB, opts AuditTrailOptions) ([]models.AuditLog, int64, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, 0, err
+	}
+	if opts.Action != "" {
+		query = query.Where("action = ?", opts.Action)
+	}
+	if opts.Start != nil {
+		query = query.Where("created_at >= ?", *opts.Start)
+	}
+	if opts.End != nil {
+		query = query.Where("created_at <= ?", *opts.End)
+	}
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count audit entries: %w", err)
+	}
+
+	entries := []models.AuditLog{}
+	query = query.Order("created_at DESC, id DESC").Offset(opts.Offset)
+	if opts.Limit > 0 {
+		query = query.Limit(opts.Limit)
+	}
+	if err := query.Find(&entries).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list audit entries: %w", err)
+	}
+	return entries, total, nil
+}
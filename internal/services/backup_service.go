@@ -0,0 +1,394 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"gorm.io/gorm"
+)
+
+// BackupSchemaVersion is the version of the export document format produced by Export
+const BackupSchemaVersion = 1
+
+// ExportDocument is a full backup of a user's data.
+// Invoices include their items and tags; IDs refer to the exporting account and
+// are remapped on import.
+type ExportDocument struct {
+	SchemaVersion int                      `json:"schema_version"`
+	ExportedAt    time.Time                `json:"exported_at"`
+	Categories    []models.InvoiceCategory `json:"categories"`
+	Companies     []models.InvoiceCompany  `json:"companies"`
+	Receivers     []models.InvoiceReceiver `json:"receivers"`
+	Tags          []models.InvoiceTag      `json:"tags"`
+	Invoices      []models.Invoice         `json:"invoices"`
+}
+
+// ImportCounts reports how many records of one type were created or skipped
+type ImportCounts struct {
+	Created int
+	Skipped int
+}
+
+// ImportResult reports the outcome of an import.
+// Categories, companies, receivers, and tags are skipped when one with the same name
+// already exists (the existing one is reused); invoices are skipped when caught by
+// duplicate detection.
+type ImportResult struct {
+	Categories ImportCounts
+	Companies  ImportCounts
+	Receivers  ImportCounts
+	Tags       ImportCounts
+	Invoices   ImportCounts
+}
+
+// BackupService handles exporting and importing a user's full dataset
+type BackupService interface {
+	Export(userID string) (*ExportDocument, error)
+	Import(userID string, doc *ExportDocument) (*ImportResult, error)
+}
+
+type backupService struct {
+	db *gorm.DB
+}
+
+// NewBackupService creates a new BackupService instance
+func NewBackupService(db *gorm.DB) BackupService {
+	return &backupService{db: db}
+}
+
+// Export returns all categories, companies, receivers, tags, and invoices of a user
+func (s *backupService) Export(userID string) (*ExportDocument, error) {
+	doc := &ExportDocument{
+		SchemaVersion: BackupSchemaVersion,
+		ExportedAt:    time.Now(),
+	}
+
+	if err := s.db.Where("user_id = ?", userID).Order("id ASC").Find(&doc.Categories).Error; err != nil {
+		return nil, err
+	}
+	if err := s.db.Where("user_id = ?", userID).Order("id ASC").Find(&doc.Companies).Error; err != nil {
+		return nil, err
+	}
+	if err := s.db.Where("user_id = ?", userID).Order("id ASC").Find(&doc.Receivers).Error; err != nil {
+		return nil, err
+	}
+	if err := s.db.Where("user_id = ?", userID).Order("id ASC").Find(&doc.Tags).Error; err != nil {
+		return nil, err
+	}
+	if err := s.db.Where("user_id = ?", userID).
+		Preload("Items", orderItemsByPosition).
+		Preload("Tags").
+		Order("id ASC").
+		Find(&doc.Invoices).Error; err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// Import restores an export document into the user's account in a single transaction.
+// Old IDs are remapped to the newly created (or reused) records so relationships are preserved.
+// Any invalid reference aborts the whole import. Invoices keep their numbers, and the number
+// sequences move past them; an invoice whose number the user already has gets a new one.
+func (s *backupService) Import(userID string, doc *ExportDocument) (*ImportResult, error) {
+	if doc.SchemaVersion != BackupSchemaVersion {
+		return nil, fmt.Errorf("unsupported schema version %d (expected %d)", doc.SchemaVersion, BackupSchemaVersion)
+	}
+
+	result := &ImportResult{}
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		categoryIDs := make(map[uint]uint, len(doc.Categories))
+		for _, category := range doc.Categories {
+			var existing models.InvoiceCategory
+			if err := tx.Where("user_id = ? AND name = ?", userID, category.Name).First(&existing).Error; err == nil {
+				categoryIDs[category.ID] = existing.ID
+				result.Categories.Skipped++
+				continue
+			}
+
+			oldID := category.ID
+			category.ID = 0
+			category.UserID = userID
+			if err := tx.Create(&category).Error; err != nil {
+				return fmt.Errorf("failed to import category %q: %w", category.Name, err)
+			}
+			categoryIDs[oldID] = category.ID
+			result.Categories.Created++
+		}
+
+		companyIDs := make(map[uint]uint, len(doc.Companies))
+		for _, company := range doc.Companies {
+			var existing models.InvoiceCompany
+			if err := tx.Where("user_id = ? AND name = ?", userID, company.Name).First(&existing).Error; err == nil {
+				companyIDs[company.ID] = existing.ID
+				result.Companies.Skipped++
+				continue
+			}
+
+			oldID := company.ID
+			company.ID = 0
+			company.UserID = userID
+			if err := tx.Create(&company).Error; err != nil {
+				return fmt.Errorf("failed to import company %q: %w", company.Name, err)
+			}
+			companyIDs[oldID] = company.ID
+			result.Companies.Created++
+		}
+
+		receiverIDs := make(map[uint]uint, len(doc.Receivers))
+		for _, receiver := range doc.Receivers {
+			var existing models.InvoiceReceiver
+			if err := tx.Where("user_id = ? AND name = ?", userID, receiver.Name).First(&existing).Error; err == nil {
+				receiverIDs[receiver.ID] = existing.ID
+				result.Receivers.Skipped++
+				continue
+			}
+
+			oldID := receiver.ID
+			receiver.ID = 0
+			receiver.UserID = userID
+			if err := tx.Create(&receiver).Error; err != nil {
+				return fmt.Errorf("failed to import receiver %q: %w", receiver.Name, err)
+			}
+			receiverIDs[oldID] = receiver.ID
+			result.Receivers.Created++
+		}
+
+		tagIDs := make(map[uint]uint, len(doc.Tags))
+		// Parents are linked once every tag has its new ID, as a child may come before its parent
+		tagParents := make(map[uint]uint)
+		for _, tag := range doc.Tags {
+			var existing models.InvoiceTag
+			if err := tx.Where("user_id = ? AND name = ?", userID, tag.Name).First(&existing).Error; err == nil {
+				tagIDs[tag.ID] = existing.ID
+				result.Tags.Skipped++
+				continue
+			}
+
+			oldID := tag.ID
+			oldParentID := tag.ParentID
+			tag.ID = 0
+			tag.UserID = userID
+			tag.ParentID = nil
+			if tag.Color == "" {
+				color, err := nextTagColor(tx, userID)
+				if err != nil {
+					return err
+				}
+				tag.Color = color
+			}
+			if err := tx.Create(&tag).Error; err != nil {
+				return fmt.Errorf("failed to import tag %q: %w", tag.Name, err)
+			}
+			tagIDs[oldID] = tag.ID
+			if oldParentID != nil {
+				tagParents[tag.ID] = *oldParentID
+			}
+			result.Tags.Created++
+		}
+		// Linked in a fixed order so a document with a cycle always fails on the same tag
+		linked := make([]uint, 0, len(tagParents))
+		for tagID := range tagParents {
+			linked = append(linked, tagID)
+		}
+		sort.Slice(linked, func(i, j int) bool { return linked[i] < linked[j] })
+		for _, tagID := range linked {
+			parentID, ok := tagIDs[tagParents[tagID]]
+			if !ok {
+				// The parent wasn't exported; the tag stays a top-level tag
+				continue
+			}
+			if err := validateTagParent(tx, userID, tagID, &parentID); err != nil {
+				return fmt.Errorf("failed to link tag %d to its parent: %w", tagID, err)
+			}
+			if err := tx.Model(&models.InvoiceTag{}).Where("id = ?", tagID).Update("parent_id", parentID).Error; err != nil {
+				return fmt.Errorf("failed to link tag %d to its parent: %w", tagID, err)
+			}
+		}
+
+		// Imported invoices go to the user's personal organization, whatever organization they
+		// were exported from
+		organization, err := personalOrganization(tx, userID)
+		if err != nil {
+			return err
+		}
+
+		// Imported invoices keep their numbers unless the user already has them; those are
+		// renumbered once the sequences have moved past every imported number
+		var renumber []uint
+		invoiceIDs := make(map[uint]uint, len(doc.Invoices))
+		// Related invoices are linked once every invoice has its new ID, as an invoice may come
+		// before the one it is linked to
+		invoiceRelations := make(map[uint]importedRelation)
+		for _, invoice := range doc.Invoices {
+			var err error
+			if invoice.CategoryID, err = remapID(invoice.CategoryID, categoryIDs, "category"); err != nil {
+				return fmt.Errorf("invoice %q: %w", invoice.Title, err)
+			}
+			if invoice.CompanyID, err = remapID(invoice.CompanyID, companyIDs, "company"); err != nil {
+				return fmt.Errorf("invoice %q: %w", invoice.Title, err)
+			}
+			if invoice.ReceiverID, err = remapID(invoice.ReceiverID, receiverIDs, "receiver"); err != nil {
+				return fmt.Errorf("invoice %q: %w", invoice.Title, err)
+			}
+
+			mappings := make([]models.InvoiceTagMapping, 0, len(invoice.Tags))
+			for _, tag := range invoice.Tags {
+				newTagID, ok := tagIDs[tag.ID]
+				if !ok {
+					return fmt.Errorf("invoice %q: unknown tag %d", invoice.Title, tag.ID)
+				}
+				mappings = append(mappings, models.InvoiceTagMapping{TagID: newTagID})
+			}
+
+			oldID := invoice.ID
+			relation := importedRelation{relatedID: invoice.RelatedInvoiceID, relationType: invoice.RelationType}
+			invoice.ID = 0
+			invoice.UserID = userID
+			invoice.OrganizationID = &organization.ID
+			invoice.RelatedInvoiceID = nil
+			invoice.RelationType = ""
+			invoice.Category = nil
+			invoice.Company = nil
+			invoice.Receiver = nil
+			invoice.Tags = nil
+			invoice.Attachments = nil
+			for i := range invoice.Items {
+				if invoice.Items[i].CategoryID, err = remapID(invoice.Items[i].CategoryID, categoryIDs, "category"); err != nil {
+					return fmt.Errorf("invoice %q: %w", invoice.Title, err)
+				}
+				invoice.Items[i].ID = 0
+				invoice.Items[i].InvoiceID = 0
+				invoice.Items[i].CalculateAmount()
+			}
+			if len(invoice.Items) > 0 {
+				invoice.CalculateTotalFromItems()
+			}
+			invoice.AmountCurrencyMixed = invoice.HasMixedCurrencies()
+
+			var count int64
+			if err := duplicateInvoiceQuery(tx.Model(&models.Invoice{}), userID, &invoice).Count(&count).Error; err != nil {
+				return err
+			}
+			if count > 0 {
+				result.Invoices.Skipped++
+				continue
+			}
+
+			numberTaken := false
+			if invoice.InvoiceNumber != "" {
+				var taken int64
+				if err := tx.Unscoped().Model(&models.Invoice{}).
+					Where("user_id = ? AND invoice_number = ?", userID, invoice.InvoiceNumber).
+					Count(&taken).Error; err != nil {
+					return err
+				}
+				if numberTaken = taken > 0; !numberTaken {
+					if err := advanceInvoiceNumberSequence(tx, userID, invoice.InvoiceNumber); err != nil {
+						return err
+					}
+				} else {
+					invoice.InvoiceNumber = ""
+				}
+			}
+
+			if err := tx.Create(&invoice).Error; err != nil {
+				return fmt.Errorf("failed to import invoice %q: %w", invoice.Title, err)
+			}
+			if numberTaken {
+				renumber = append(renumber, invoice.ID)
+			}
+			invoiceIDs[oldID] = invoice.ID
+			if relation.relatedID != nil {
+				invoiceRelations[invoice.ID] = relation
+			}
+			for i := range mappings {
+				mappings[i].InvoiceID = invoice.ID
+			}
+			if len(mappings) > 0 {
+				if err := tx.Create(&mappings).Error; err != nil {
+					return err
+				}
+			}
+			result.Invoices.Created++
+		}
+		if err := linkImportedInvoices(tx, invoiceIDs, invoiceRelations); err != nil {
+			return err
+		}
+
+		numbering := NewNumberingService()
+		for _, id := range renumber {
+			number, err := numbering.NextInvoiceNumber(tx, userID)
+			if err != nil {
+				return err
+			}
+			if err := tx.Model(&models.Invoice{}).Where("id = ?", id).Update("invoice_number", number).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// importedRelation is an imported invoice's link as exported, before its related invoice ID is
+// remapped
+type importedRelation struct {
+	relatedID    *uint
+	relationType models.InvoiceRelationType
+}
+
+// linkImportedInvoices restores the links between imported invoices, keyed by their new IDs; a link
+// whose related invoice wasn't imported is dropped. Invoices are linked in a fixed order so a
+// document with a cycle always fails on the same invoice.
+func linkImportedInvoices(tx *gorm.DB, invoiceIDs map[uint]uint, relations map[uint]importedRelation) error {
+	linked := make([]uint, 0, len(relations))
+	for invoiceID := range relations {
+		linked = append(linked, invoiceID)
+	}
+	sort.Slice(linked, func(i, j int) bool { return linked[i] < linked[j] })
+
+	linkedTo := make(map[uint]uint, len(linked))
+	for _, invoiceID := range linked {
+		relation := relations[invoiceID]
+		relatedID, ok := invoiceIDs[*relation.relatedID]
+		if !ok {
+			// The related invoice wasn't exported, or was skipped as a duplicate
+			continue
+		}
+		if !relation.relationType.Valid() {
+			return fmt.Errorf("invoice %d: invalid relation type %q: must be refund, credit_note, or correction", invoiceID, relation.relationType)
+		}
+		for id, ok := relatedID, true; ok; id, ok = linkedTo[id] {
+			if id == invoiceID {
+				return fmt.Errorf("invoice %d: linking it to invoice %d would create a cycle", invoiceID, relatedID)
+			}
+		}
+		if err := tx.Model(&models.Invoice{}).Where("id = ?", invoiceID).Updates(map[string]interface{}{
+			"related_invoice_id": relatedID,
+			"relation_type":      relation.relationType,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to link invoice %d: %w", invoiceID, err)
+		}
+		linkedTo[invoiceID] = relatedID
+	}
+	return nil
+}
+
+// remapID translates an exported ID to the ID of the imported record
+func remapID(id *uint, ids map[uint]uint, kind string) (*uint, error) {
+	if id == nil {
+		return nil, nil
+	}
+	newID, ok := ids[*id]
+	if !ok {
+		return nil, fmt.Errorf("unknown %s %d", kind, *id)
+	}
+	return &newID, nil
+}
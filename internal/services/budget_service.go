@@ -0,0 +1,189 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"gorm.io/gorm"
+)
+
+// BudgetStatus represents a budget evaluated against spending in its current period
+type BudgetStatus struct {
+	BudgetID     uint                `json:"budget_id"`
+	CategoryID   uint                `json:"category_id"`
+	CategoryName string              `json:"category_name"`
+	PeriodType   models.BudgetPeriod `json:"period_type"`
+	PeriodStart  time.Time           `json:"period_start"`
+	PeriodEnd    time.Time           `json:"period_end"`
+	Currency     string              `json:"currency"`
+	Amount       float64             `json:"amount"`
+	Spent        float64             `json:"spent"`
+	Remaining    float64             `json:"remaining"`
+	OverBudget   bool                `json:"over_budget"`
+}
+
+// BudgetService handles category budget business logic
+type BudgetService interface {
+	CreateBudget(userID string, budget *models.Budget) error
+	ListBudgets(userID string) ([]models.Budget, error)
+	DeleteBudget(userID string, id uint) error
+	// EvaluateBudgets compares every budget to spending in the period containing asOf
+	EvaluateBudgets(userID string, asOf time.Time) ([]BudgetStatus, error)
+}
+
+type budgetService struct {
+	db               *gorm.DB
+	analyticsService AnalyticsService
+	settingsService  SettingsService
+	fxService        FXService
+}
+
+// NewBudgetService creates a new BudgetService instance
+// fxService can be nil (spending is compared 1:1 when the budget currency differs from the base currency)
+func NewBudgetService(db *gorm.DB, analyticsService AnalyticsService, fxService FXService) BudgetService {
+	return &budgetService{
+		db:               db,
+		analyticsService: analyticsService,
+		settingsService:  NewSettingsService(db),
+		fxService:        fxService,
+	}
+}
+
+// CreateBudget creates a new budget for one of the user's categories
+func (s *budgetService) CreateBudget(userID string, budget *models.Budget) error {
+	var category models.InvoiceCategory
+	if err := s.db.Where("id = ? AND user_id = ?", budget.CategoryID, userID).First(&category).Error; err != nil {
+		return fmt.Errorf("category not found: %w", err)
+	}
+
+	if budget.Amount <= 0 {
+		return fmt.Errorf("budget amount must be greater than 0")
+	}
+
+	if budget.PeriodType == "" {
+		budget.PeriodType = models.BudgetPeriodMonthly
+	}
+	switch budget.PeriodType {
+	case models.BudgetPeriodMonthly, models.BudgetPeriodQuarterly, models.BudgetPeriodYearly:
+	default:
+		return fmt.Errorf("invalid budget period: %s", budget.PeriodType)
+	}
+
+	if budget.Currency == "" {
+		baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
+		if err != nil {
+			return err
+		}
+		budget.Currency = baseCurrency
+	}
+
+	budget.UserID = userID
+	if err := s.db.Create(budget).Error; err != nil {
+		return err
+	}
+	budget.Category = &category
+	return nil
+}
+
+// ListBudgets lists all budgets for a user
+func (s *budgetService) ListBudgets(userID string) ([]models.Budget, error) {
+	var budgets []models.Budget
+	err := s.db.Preload("Category").
+		Where("user_id = ?", userID).
+		Order("id ASC").
+		Find(&budgets).Error
+	return budgets, err
+}
+
+// DeleteBudget soft-deletes a budget
+func (s *budgetService) DeleteBudget(userID string, id uint) error {
+	result := s.db.Where("id = ? AND user_id = ?", id, userID).Delete(&models.Budget{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("budget not found: %w", gorm.ErrRecordNotFound)
+	}
+	return nil
+}
+
+// EvaluateBudgets compares every budget to spending in the period containing asOf
+// Budgets whose category no longer exists are skipped
+func (s *budgetService) EvaluateBudgets(userID string, asOf time.Time) ([]BudgetStatus, error) {
+	budgets, err := s.ListBudgets(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
+	if err != nil {
+		return nil, err
+	}
+	statuses := []BudgetStatus{}
+	for _, budget := range budgets {
+		if budget.Category == nil || budget.Category.UserID != userID {
+			continue
+		}
+
+		start, end := budgetPeriodRange(budget.PeriodType, asOf)
+		spent, err := s.analyticsService.GetCategorySpending(userID, budget.CategoryID, start, end)
+		if err != nil {
+			return nil, err
+		}
+		if spent, err = s.convertSpending(spent, baseCurrency, budget.Currency); err != nil {
+			return nil, fmt.Errorf("budget %d: %w", budget.ID, err)
+		}
+
+		statuses = append(statuses, BudgetStatus{
+			BudgetID:     budget.ID,
+			CategoryID:   budget.CategoryID,
+			CategoryName: budget.Category.Name,
+			PeriodType:   budget.PeriodType,
+			PeriodStart:  start,
+			PeriodEnd:    end,
+			Currency:     budget.Currency,
+			Amount:       budget.Amount,
+			Spent:        spent,
+			Remaining:    budget.Amount - spent,
+			OverBudget:   spent > budget.Amount,
+		})
+	}
+
+	return statuses, nil
+}
+
+// convertSpending converts base currency spending into the budget's currency. Without a rate
+// it fails rather than comparing amounts in different currencies.
+func (s *budgetService) convertSpending(amount float64, baseCurrency, budgetCurrency string) (float64, error) {
+	if s.fxService == nil || baseCurrency == budgetCurrency {
+		return amount, nil
+	}
+	converted, _, err := s.fxService.ConvertAmount(context.Background(), amount, baseCurrency, budgetCurrency)
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert spending from %s to %s: %w", baseCurrency, budgetCurrency, err)
+	}
+	return converted, nil
+}
+
+// budgetPeriodRange returns the start and end of the budget period containing asOf
+func budgetPeriodRange(period models.BudgetPeriod, asOf time.Time) (time.Time, time.Time) {
+	year, month, _ := asOf.Date()
+	var start, next time.Time
+
+	switch period {
+	case models.BudgetPeriodQuarterly:
+		quarterMonth := time.Month((int(month)-1)/3*3 + 1)
+		start = time.Date(year, quarterMonth, 1, 0, 0, 0, 0, asOf.Location())
+		next = start.AddDate(0, 3, 0)
+	case models.BudgetPeriodYearly:
+		start = time.Date(year, time.January, 1, 0, 0, 0, 0, asOf.Location())
+		next = start.AddDate(1, 0, 0)
+	default:
+		start = time.Date(year, month, 1, 0, 0, 0, 0, asOf.Location())
+		next = start.AddDate(0, 1, 0)
+	}
+
+	return start, next.Add(-time.Nanosecond)
+}
@@ -1,20 +1,35 @@
 package services
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/rxtech-lab/invoice-management/internal/models"
 	"gorm.io/gorm"
 )
 
+// errMergeDryRun rolls back a merge transaction run as a dry run
+var errMergeDryRun = errors.New("merge dry run")
+
 // CategoryService handles invoice category business logic
 type CategoryService interface {
 	CreateCategory(userID string, category *models.InvoiceCategory) error
 	GetCategoryByID(userID string, id uint) (*models.InvoiceCategory, error)
 	ListCategories(userID string, keyword string, limit, offset int) ([]models.InvoiceCategory, int64, error)
 	UpdateCategory(userID string, category *models.InvoiceCategory) error
-	DeleteCategory(userID string, id uint) error
+	DeleteCategory(userID string, id uint, force bool) error
 	SearchCategories(userID string, query string) ([]models.InvoiceCategory, error)
+	MergeCategories(userID string, targetID uint, sourceIDs []uint, dryRun bool) (*CategoryMergeResult, error)
+}
+
+// CategoryMergeResult is the outcome of merging categories, or with a dry run the outcome it would have
+type CategoryMergeResult struct {
+	Target           *models.InvoiceCategory
+	Sources          []models.InvoiceCategory
+	InvoicesAffected int64
+	BudgetsMoved     int64
+	DryRun           bool
 }
 
 type categoryService struct {
@@ -29,6 +44,9 @@ func NewCategoryService(db *gorm.DB) CategoryService {
 // CreateCategory creates a new invoice category
 func (s *categoryService) CreateCategory(userID string, category *models.InvoiceCategory) error {
 	category.UserID = userID
+	if err := normalizeCategoryDefaults(category); err != nil {
+		return err
+	}
 	return s.db.Create(category).Error
 }
 
@@ -89,20 +107,20 @@ func (s *categoryService) UpdateCategory(userID string, category *models.Invoice
 	existing.Name = category.Name
 	existing.Description = category.Description
 	existing.Color = category.Color
+	existing.DefaultCurrency = category.DefaultCurrency
+	existing.DefaultTaxRate = category.DefaultTaxRate
+	if err := normalizeCategoryDefaults(existing); err != nil {
+		return err
+	}
 
 	return s.db.Save(existing).Error
 }
 
-// DeleteCategory soft-deletes a category
-func (s *categoryService) DeleteCategory(userID string, id uint) error {
-	result := s.db.Where("id = ? AND user_id = ?", id, userID).Delete(&models.InvoiceCategory{})
-	if result.Error != nil {
-		return result.Error
-	}
-	if result.RowsAffected == 0 {
-		return fmt.Errorf("category not found")
-	}
-	return nil
+// DeleteCategory soft-deletes a category and its budgets. Invoices or items still in the category
+// block the deletion with an *InUseError unless force is set, which removes the category from them
+// first.
+func (s *categoryService) DeleteCategory(userID string, id uint, force bool) error {
+	return deleteReferencedEntity(s.db, userID, id, force, "category", &models.InvoiceCategory{}, "category_id", true)
 }
 
 // SearchCategories performs a text search on categories
@@ -117,3 +135,91 @@ func (s *categoryService) SearchCategories(userID string, query string) ([]model
 
 	return categories, err
 }
+
+// MergeCategories merges source categories into the target category.
+// All invoices and budgets from the sources are reassigned to the target and the sources are
+// soft-deleted. Returns the target category, the sources, and the number of invoices and budgets
+// reassigned.
+// With dryRun nothing is changed and the result reports what the merge would do.
+func (s *categoryService) MergeCategories(userID string, targetID uint, sourceIDs []uint, dryRun bool) (*CategoryMergeResult, error) {
+	for _, id := range sourceIDs {
+		if id == targetID {
+			return nil, fmt.Errorf("target category cannot be one of the source categories")
+		}
+	}
+
+	result := &CategoryMergeResult{DryRun: dryRun}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		// Verify target category ownership
+		var targetCategory models.InvoiceCategory
+		if err := tx.Where("id = ? AND user_id = ?", targetID, userID).First(&targetCategory).Error; err != nil {
+			return fmt.Errorf("target category not found: %w", err)
+		}
+
+		// Verify all source categories belong to the user
+		var sourceCategories []models.InvoiceCategory
+		if err := tx.Where("id IN ? AND user_id = ?", sourceIDs, userID).Find(&sourceCategories).Error; err != nil {
+			return fmt.Errorf("error finding source categories: %w", err)
+		}
+
+		if len(sourceCategories) != len(sourceIDs) {
+			return fmt.Errorf("some source categories not found or don't belong to user")
+		}
+
+		// Update all invoices from source categories to target category
+		updated := tx.Model(&models.Invoice{}).
+			Where("category_id IN ? AND user_id = ?", sourceIDs, userID).
+			Update("category_id", targetID)
+		if updated.Error != nil {
+			return updated.Error
+		}
+
+		// Move items categorized separately from their invoice as well
+		if err := tx.Model(&models.InvoiceItem{}).
+			Where("category_id IN ? AND invoice_id IN (?)", sourceIDs, tx.Model(&models.Invoice{}).Select("id").Where("user_id = ?", userID)).
+			Update("category_id", targetID).Error; err != nil {
+			return err
+		}
+
+		// Budgets follow their category, so spending limits keep applying to the merged spending
+		budgets := tx.Model(&models.Budget{}).
+			Where("category_id IN ? AND user_id = ?", sourceIDs, userID).
+			Update("category_id", targetID)
+		if budgets.Error != nil {
+			return budgets.Error
+		}
+
+		// Soft-delete source categories
+		if err := tx.Where("id IN ? AND user_id = ?", sourceIDs, userID).Delete(&models.InvoiceCategory{}).Error; err != nil {
+			return err
+		}
+
+		result.Target = &targetCategory
+		result.Sources = sourceCategories
+		result.InvoicesAffected = updated.RowsAffected
+		result.BudgetsMoved = budgets.RowsAffected
+
+		// A dry run rolls everything back once the outcome is known
+		if dryRun {
+			return errMergeDryRun
+		}
+		return nil
+	})
+
+	if err != nil && !errors.Is(err, errMergeDryRun) {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// normalizeCategoryDefaults upper-cases the category's default currency and rejects one that is
+// not a 3-letter ISO 4217 code, or a default tax rate outside 0-100
+func normalizeCategoryDefaults(category *models.InvoiceCategory) error {
+	category.DefaultCurrency = strings.ToUpper(strings.TrimSpace(category.DefaultCurrency))
+	if category.DefaultCurrency != "" && !currencyCodePattern.MatchString(category.DefaultCurrency) {
+		return fmt.Errorf("invalid default currency %q: must be a 3-letter ISO 4217 code", category.DefaultCurrency)
+	}
+	return validateTaxRate("default_tax_rate", &category.DefaultTaxRate)
+}
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/rxtech-lab/invoice-management/internal/models"
@@ -13,8 +14,17 @@ type CompanyService interface {
 	GetCompanyByID(userID string, id uint) (*models.InvoiceCompany, error)
 	ListCompanies(userID string, keyword string, limit, offset int) ([]models.InvoiceCompany, int64, error)
 	UpdateCompany(userID string, company *models.InvoiceCompany) error
-	DeleteCompany(userID string, id uint) error
+	DeleteCompany(userID string, id uint, force bool) error
 	SearchCompanies(userID string, query string) ([]models.InvoiceCompany, error)
+	MergeCompanies(userID string, targetID uint, sourceIDs []uint, dryRun bool) (*CompanyMergeResult, error)
+}
+
+// CompanyMergeResult is the outcome of merging companies, or with a dry run the outcome it would have
+type CompanyMergeResult struct {
+	Target           *models.InvoiceCompany
+	Sources          []models.InvoiceCompany
+	InvoicesAffected int64
+	DryRun           bool
 }
 
 type companyService struct {
@@ -97,16 +107,10 @@ func (s *companyService) UpdateCompany(userID string, company *models.InvoiceCom
 	return s.db.Save(existing).Error
 }
 
-// DeleteCompany soft-deletes a company
-func (s *companyService) DeleteCompany(userID string, id uint) error {
-	result := s.db.Where("id = ? AND user_id = ?", id, userID).Delete(&models.InvoiceCompany{})
-	if result.Error != nil {
-		return result.Error
-	}
-	if result.RowsAffected == 0 {
-		return fmt.Errorf("company not found")
-	}
-	return nil
+// DeleteCompany soft-deletes a company. Invoices still referencing it block the deletion with an
+// *InUseError unless force is set, which removes the company from them first.
+func (s *companyService) DeleteCompany(userID string, id uint, force bool) error {
+	return deleteReferencedEntity(s.db, userID, id, force, "company", &models.InvoiceCompany{}, "company_id", false)
 }
 
 // SearchCompanies performs a text search on companies
@@ -121,3 +125,64 @@ func (s *companyService) SearchCompanies(userID string, query string) ([]models.
 
 	return companies, err
 }
+
+// MergeCompanies merges source companies into the target company.
+// All invoices from the sources are reassigned to the target and the sources are soft-deleted.
+// Returns the target company, the sources, and the number of invoices reassigned.
+// With dryRun nothing is changed and the result reports what the merge would do.
+func (s *companyService) MergeCompanies(userID string, targetID uint, sourceIDs []uint, dryRun bool) (*CompanyMergeResult, error) {
+	for _, id := range sourceIDs {
+		if id == targetID {
+			return nil, fmt.Errorf("target company cannot be one of the source companies")
+		}
+	}
+
+	result := &CompanyMergeResult{DryRun: dryRun}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		// Verify target company ownership
+		var targetCompany models.InvoiceCompany
+		if err := tx.Where("id = ? AND user_id = ?", targetID, userID).First(&targetCompany).Error; err != nil {
+			return fmt.Errorf("target company not found: %w", err)
+		}
+
+		// Verify all source companies belong to the user
+		var sourceCompanies []models.InvoiceCompany
+		if err := tx.Where("id IN ? AND user_id = ?", sourceIDs, userID).Find(&sourceCompanies).Error; err != nil {
+			return fmt.Errorf("error finding source companies: %w", err)
+		}
+
+		if len(sourceCompanies) != len(sourceIDs) {
+			return fmt.Errorf("some source companies not found or don't belong to user")
+		}
+
+		// Update all invoices from source companies to target company
+		updated := tx.Model(&models.Invoice{}).
+			Where("company_id IN ? AND user_id = ?", sourceIDs, userID).
+			Update("company_id", targetID)
+		if updated.Error != nil {
+			return updated.Error
+		}
+
+		// Soft-delete source companies
+		if err := tx.Where("id IN ? AND user_id = ?", sourceIDs, userID).Delete(&models.InvoiceCompany{}).Error; err != nil {
+			return err
+		}
+
+		result.Target = &targetCompany
+		result.Sources = sourceCompanies
+		result.InvoicesAffected = updated.RowsAffected
+
+		// A dry run rolls everything back once the outcome is known
+		if dryRun {
+			return errMergeDryRun
+		}
+		return nil
+	})
+
+	if err != nil && !errors.Is(err, errMergeDryRun) {
+		return nil, err
+	}
+
+	return result, nil
+}
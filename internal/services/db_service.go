@@ -7,6 +7,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"sync/atomic"
 	"time"
 
 	"github.com/rxtech-lab/invoice-management/internal/models"
@@ -40,6 +41,9 @@ func createGormLogger() logger.Interface {
 	)
 }
 
+// memoryDBCounter gives each in-memory database a unique name
+var memoryDBCounter atomic.Int64
+
 // NewSqliteDBService creates a new DBService with SQLite connection
 func NewSqliteDBService(dbPath string) (DBService, error) {
 	// Handle in-memory database for testing
@@ -50,7 +54,14 @@ func NewSqliteDBService(dbPath string) (DBService, error) {
 		}
 	}
 
-	db, err := gorm.Open(sqlite.Open(dbPath), &gorm.Config{
+	dsn := dbPath
+	if dbPath == ":memory:" {
+		// Every connection to :memory: opens its own empty database; name it and share the
+		// cache so concurrent queries on other pooled connections see the same data
+		dsn = fmt.Sprintf("file:memdb%d?mode=memory&cache=shared", memoryDBCounter.Add(1))
+	}
+
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{
 		Logger: createGormLogger(),
 	})
 	if err != nil {
@@ -110,6 +121,12 @@ func (s *dbService) GetDB() *gorm.DB {
 
 // migrate runs database migrations for invoice management models
 func (s *dbService) migrate() error {
+	if err := s.clearDuplicateInvoiceNumbers(); err != nil {
+		return err
+	}
+	// Entries recorded before status_changed existed are flagged from their diff once it's added
+	backfillStatusChanges := s.db.Migrator().HasTable(&models.AuditLog{}) &&
+		!s.db.Migrator().HasColumn(&models.AuditLog{}, "status_changed")
 	if err := s.db.AutoMigrate(
 		&models.InvoiceCategory{},
 		&models.InvoiceCompany{},
@@ -119,14 +136,84 @@ func (s *dbService) migrate() error {
 		&models.Invoice{},
 		&models.InvoiceItem{},
 		&models.FileUpload{},
+		&models.InvoiceAttachment{},
+		&models.UserSettings{},
+		&models.Budget{},
+		&models.InvoiceNumberSequence{},
+		&models.AuditLog{},
+		&models.ExportJob{},
+		&models.InvoiceTemplate{},
+		&models.Organization{},
+		&models.OrganizationMember{},
 	); err != nil {
 		return err
 	}
 
+	if err := s.backfillPaidAt(); err != nil {
+		return err
+	}
+	if err := s.backfillOrganizations(); err != nil {
+		return err
+	}
+	if backfillStatusChanges {
+		if err := s.backfillStatusChanges(); err != nil {
+			return err
+		}
+	}
+
 	// Migrate legacy tags from JSON array to many-to-many relationship
 	return s.migrateLegacyTags()
 }
 
+// clearDuplicateInvoiceNumbers makes invoice numbers unique per user before their unique index is
+// created: of the invoices sharing a number, the first keeps it and the others lose theirs, so
+// they are shown by ID like invoices from before numbering
+func (s *dbService) clearDuplicateInvoiceNumbers() error {
+	if !s.db.Migrator().HasColumn(&models.Invoice{}, "invoice_number") {
+		return nil
+	}
+	return s.db.Exec(`UPDATE invoices SET invoice_number = '' WHERE invoice_number <> '' AND id NOT IN (
+		SELECT MIN(id) FROM invoices WHERE invoice_number <> '' GROUP BY user_id, invoice_number)`).Error
+}
+
+// backfillPaidAt sets paid_at for invoices that were marked as paid before it was tracked. The
+// last update is the closest record of when that happened.
+func (s *dbService) backfillPaidAt() error {
+	return s.db.Exec("UPDATE invoices SET paid_at = updated_at WHERE status = ? AND paid_at IS NULL",
+		models.InvoiceStatusPaid).Error
+}
+
+// backfillStatusChanges flags the invoice audit entries whose diff has the status, as Record does
+// for new entries
+func (s *dbService) backfillStatusChanges() error {
+	return s.db.Exec("UPDATE audit_logs SET status_changed = ? WHERE entity_type = ? AND json_extract(diff, '$.status') IS NOT NULL",
+		true, models.AuditEntityInvoice).Error
+}
+
+// backfillOrganizations moves the invoices of users from before organizations into each user's
+// personal organization, created with the user as its only member. Deleted invoices are moved too,
+// so restoring one keeps it shared the same way.
+func (s *dbService) backfillOrganizations() error {
+	var userIDs []string
+	if err := s.db.Unscoped().Model(&models.Invoice{}).
+		Where("organization_id IS NULL").
+		Distinct().Pluck("user_id", &userIDs).Error; err != nil {
+		return err
+	}
+
+	for _, userID := range userIDs {
+		organization, err := personalOrganization(s.db, userID)
+		if err != nil {
+			return err
+		}
+		if err := s.db.Exec("UPDATE invoices SET organization_id = ? WHERE user_id = ? AND organization_id IS NULL",
+			organization.ID, userID).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // migrateLegacyTags migrates existing JSON tags to the new many-to-many relationship
 func (s *dbService) migrateLegacyTags() error {
 	// Check if the tags column exists by querying the schema
@@ -0,0 +1,190 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"gorm.io/gorm"
+)
+
+// InUseError is returned when deleting a category, company, or receiver that invoices still
+// reference. Deleting it with force clears the references instead.
+type InUseError struct {
+	Entity       string // "category", "company", or "receiver"
+	ID           uint
+	InvoiceCount int64
+}
+
+func (e *InUseError) Error() string {
+	return fmt.Sprintf("%s %d is used by %d invoice(s); delete it with force to remove it from them first",
+		e.Entity, e.ID, e.InvoiceCount)
+}
+
+// deleteReferencedEntity soft-deletes the user's category, company, or receiver (model) with the
+// given ID, which invoices reference through column. While invoices reference it the deletion fails
+// with an *InUseError, unless force is set, in which case their references are cleared first.
+// Deleted invoices never block the deletion and always have their references cleared, so restoring
+// one never leaves it pointing at a missing record. With withItems, invoice items attributed to the
+// record through column count as references as well.
+//
+// Every invoice touched gets its version bumped and an audit entry (one per item cleared as well).
+// Budgets can't exist without their category, so a category's budgets are deleted with it.
+// Invoice templates keep their other defaults; only the reference is cleared.
+func deleteReferencedEntity(db *gorm.DB, userID string, id uint, force bool, entity string, model interface{}, column string, withItems bool) error {
+	var entries []AuditEntry
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("id = ? AND user_id = ?", id, userID).First(model).Error; err != nil {
+			return fmt.Errorf("%s not found", entity)
+		}
+
+		referencing := tx.Where(column+" = ?", id)
+		if withItems {
+			referencing = referencing.Or("id IN (SELECT invoice_id FROM invoice_items WHERE "+column+" = ? AND deleted_at IS NULL)", id)
+		}
+		if !force {
+			var count int64
+			if err := tx.Model(&models.Invoice{}).Where("user_id = ?", userID).Where(referencing).Count(&count).Error; err != nil {
+				return err
+			}
+			if count > 0 {
+				return &InUseError{Entity: entity, ID: id, InvoiceCount: count}
+			}
+		}
+
+		var err error
+		if entries, err = clearInvoiceReferences(tx, userID, id, column, withItems); err != nil {
+			return err
+		}
+		if column == "category_id" {
+			if err := tx.Where("user_id = ? AND category_id = ?", userID, id).Delete(&models.Budget{}).Error; err != nil {
+				return err
+			}
+		}
+		if err := clearTemplateReferences(tx, userID, id, column); err != nil {
+			return err
+		}
+
+		return tx.Delete(model).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	auditService := NewAuditService(db)
+	for _, entry := range entries {
+		auditService.Record(entry)
+	}
+	return nil
+}
+
+// clearInvoiceReferences clears column on the user's invoices (deleted ones included) and, with
+// withItems, their items that reference id, bumping the version of every invoice touched. It
+// returns the audit entries to record once the change is committed.
+func clearInvoiceReferences(tx *gorm.DB, userID string, id uint, column string, withItems bool) ([]AuditEntry, error) {
+	var invoiceIDs []uint
+	if err := tx.Unscoped().Model(&models.Invoice{}).
+		Where("user_id = ? AND "+column+" = ?", userID, id).
+		Pluck("id", &invoiceIDs).Error; err != nil {
+		return nil, err
+	}
+
+	var items []models.InvoiceItem
+	if withItems {
+		if err := tx.Unscoped().
+			Where(column+" = ? AND invoice_id IN (?)", id,
+				tx.Unscoped().Model(&models.Invoice{}).Select("id").Where("user_id = ?", userID)).
+			Find(&items).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	var entries []AuditEntry
+	touched := make(map[uint]bool, len(invoiceIDs)+len(items))
+	for _, invoiceID := range invoiceIDs {
+		touched[invoiceID] = true
+		entries = append(entries, AuditEntry{
+			UserID:     userID,
+			ActorSub:   userID,
+			EntityType: models.AuditEntityInvoice,
+			EntityID:   invoiceID,
+			InvoiceID:  invoiceID,
+			Action:     models.AuditActionUpdate,
+			Before:     map[string]interface{}{column: id},
+			After:      map[string]interface{}{column: nil},
+		})
+	}
+	for _, item := range items {
+		touched[item.InvoiceID] = true
+		entries = append(entries, AuditEntry{
+			UserID:     userID,
+			ActorSub:   userID,
+			EntityType: models.AuditEntityInvoiceItem,
+			EntityID:   item.ID,
+			InvoiceID:  item.InvoiceID,
+			Action:     models.AuditActionUpdate,
+			Before:     map[string]interface{}{column: id},
+			After:      map[string]interface{}{column: nil},
+		})
+	}
+
+	if len(invoiceIDs) > 0 {
+		if err := tx.Unscoped().Model(&models.Invoice{}).Where("id IN ?", invoiceIDs).
+			Update(column, nil).Error; err != nil {
+			return nil, err
+		}
+	}
+	if len(items) > 0 {
+		itemIDs := make([]uint, len(items))
+		for i, item := range items {
+			itemIDs[i] = item.ID
+		}
+		if err := tx.Unscoped().Model(&models.InvoiceItem{}).Where("id IN ?", itemIDs).
+			Update(column, nil).Error; err != nil {
+			return nil, err
+		}
+	}
+	if len(touched) > 0 {
+		ids := make([]uint, 0, len(touched))
+		for invoiceID := range touched {
+			ids = append(ids, invoiceID)
+		}
+		if err := tx.Unscoped().Model(&models.Invoice{}).Where("id IN ?", ids).
+			Update("version", gorm.Expr("version + 1")).Error; err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
+// clearTemplateReferences clears column on the user's invoice templates that default to id, and for
+// categories on the template items attributed to it
+func clearTemplateReferences(tx *gorm.DB, userID string, id uint, column string) error {
+	if err := tx.Model(&models.InvoiceTemplate{}).
+		Where("user_id = ? AND "+column+" = ?", userID, id).
+		Update(column, nil).Error; err != nil {
+		return err
+	}
+	if column != "category_id" {
+		return nil
+	}
+
+	var templates []models.InvoiceTemplate
+	if err := tx.Where("user_id = ?", userID).Find(&templates).Error; err != nil {
+		return err
+	}
+	for i := range templates {
+		changed := false
+		for j := range templates[i].Items {
+			if item := &templates[i].Items[j]; item.CategoryID != nil && *item.CategoryID == id {
+				item.CategoryID = nil
+				changed = true
+			}
+		}
+		if changed {
+			if err := tx.Model(&templates[i]).Select("items").Updates(&templates[i]).Error; err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
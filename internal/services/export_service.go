@@ -0,0 +1,379 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"gorm.io/gorm"
+)
+
+// maxConcurrentExports is the number of export jobs that build bundles at the same time;
+// further jobs wait in the pending state
+const maxConcurrentExports = 2
+
+// exportBundleTimeout bounds building and uploading one bundle
+const exportBundleTimeout = 5 * time.Minute
+
+// exportLeaseDuration is how long a job stays with its instance without a heartbeat; the
+// instance renews the lease every exportHeartbeatInterval while the job is pending or running
+const (
+	exportLeaseDuration     = 2 * time.Minute
+	exportHeartbeatInterval = exportLeaseDuration / 4
+)
+
+// DefaultExportBundleTTL is how long completed bundles are kept when no TTL is configured
+const DefaultExportBundleTTL = 7 * 24 * time.Hour
+
+// ExportService runs full data exports in the background. Each export is a zip bundle with
+// export.json (the BackupService export document, which POST /api/import accepts) and
+// invoices.csv, uploaded through UploadService.
+type ExportService interface {
+	// RequestExport records a pending export job for the user and starts it in the background
+	RequestExport(userID string) (*models.ExportJob, error)
+	// GetExportJob returns one of the user's export jobs, with a download URL once it has completed
+	GetExportJob(ctx context.Context, userID string, id uint) (*ExportJobDetail, error)
+	// FailInterruptedJobs marks pending or running jobs whose lease has expired as failed: the
+	// instance running them stopped without finishing them. Jobs of live instances are left alone.
+	FailInterruptedJobs() error
+	// ExpireBundles deletes the bundles of jobs completed more than ttl ago and marks the jobs
+	// expired, returning how many were expired
+	ExpireBundles(ctx context.Context, ttl time.Duration) (int, error)
+	// StartSweeper fails interrupted jobs and expires bundles older than bundleTTL every lease
+	// period until ctx is cancelled, e.g. by JobManager.Shutdown
+	StartSweeper(ctx context.Context, bundleTTL time.Duration)
+}
+
+// ExportJobDetail is an export job and, once it has completed, a presigned URL of its bundle
+type ExportJobDetail struct {
+	*models.ExportJob
+	DownloadURL string `json:"download_url,omitempty"`
+}
+
+type exportService struct {
+	db            *gorm.DB
+	backupService BackupService
+	uploadService UploadService
+	jobs          *JobManager
+	slots         chan struct{}
+	// instanceID identifies this process as the owner of the jobs it runs
+	instanceID string
+}
+
+// NewExportService creates a new ExportService instance running its exports on jobs
+func NewExportService(db *gorm.DB, backupService BackupService, uploadService UploadService, jobs *JobManager) ExportService {
+	hostname, _ := os.Hostname()
+	return &exportService{
+		db:            db,
+		backupService: backupService,
+		uploadService: uploadService,
+		jobs:          jobs,
+		slots:         make(chan struct{}, maxConcurrentExports),
+		instanceID:    fmt.Sprintf("%s-%d-%s", hostname, os.Getpid(), uuid.New().String()),
+	}
+}
+
+// RequestExport records a pending export job for the user and starts it in the background
+func (s *exportService) RequestExport(userID string) (*models.ExportJob, error) {
+	if s.uploadService == nil {
+		return nil, fmt.Errorf("file storage not configured")
+	}
+
+	leaseExpiresAt := time.Now().Add(exportLeaseDuration)
+	job := &models.ExportJob{
+		UserID:         userID,
+		Status:         models.ExportJobPending,
+		Owner:          s.instanceID,
+		LeaseExpiresAt: &leaseExpiresAt,
+	}
+	if err := s.db.Create(job).Error; err != nil {
+		return nil, fmt.Errorf("failed to create export job: %w", err)
+	}
+
+	if !s.jobs.Go(func(ctx context.Context) { s.run(ctx, job.ID, userID) }) {
+		s.failJob(job.ID, exportShutdownError)
+		return nil, fmt.Errorf("server is shutting down")
+	}
+	return job, nil
+}
+
+// GetExportJob returns one of the user's export jobs, with a download URL once it has completed
+func (s *exportService) GetExportJob(ctx context.Context, userID string, id uint) (*ExportJobDetail, error) {
+	var job models.ExportJob
+	if err := s.db.Where("id = ? AND user_id = ?", id, userID).First(&job).Error; err != nil {
+		return nil, fmt.Errorf("export job not found: %w", err)
+	}
+
+	detail := &ExportJobDetail{ExportJob: &job}
+	if job.Status == models.ExportJobCompleted {
+		url, err := s.uploadService.GetPresignedDownloadURL(ctx, job.S3Key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate download URL: %w", err)
+		}
+		detail.DownloadURL = url
+	}
+	return detail, nil
+}
+
+// FailInterruptedJobs marks pending or running jobs whose lease has expired as failed. Jobs
+// recorded before leases have none and count as expired.
+func (s *exportService) FailInterruptedJobs() error {
+	now := time.Now()
+	return s.db.Model(&models.ExportJob{}).
+		Where("status IN ?", []models.ExportJobStatus{models.ExportJobPending, models.ExportJobRunning}).
+		Where("lease_expires_at IS NULL OR lease_expires_at < ?", now).
+		Updates(map[string]interface{}{
+			"status":       models.ExportJobFailed,
+			"error":        "interrupted by a server restart",
+			"completed_at": now,
+		}).Error
+}
+
+// ExpireBundles deletes the bundles of jobs completed more than ttl ago and marks the jobs
+// expired. A bundle that can't be deleted is logged and retried on the next run.
+func (s *exportService) ExpireBundles(ctx context.Context, ttl time.Duration) (int, error) {
+	var jobs []models.ExportJob
+	if err := s.db.Where("status = ? AND completed_at < ?", models.ExportJobCompleted, time.Now().Add(-ttl)).
+		Find(&jobs).Error; err != nil {
+		return 0, fmt.Errorf("failed to list expired export jobs: %w", err)
+	}
+
+	expired := 0
+	for _, job := range jobs {
+		if err := ctx.Err(); err != nil {
+			return expired, err
+		}
+		if job.S3Key != "" && s.uploadService != nil {
+			if err := s.uploadService.DeleteFile(ctx, job.S3Key); err != nil {
+				log.Printf("Warning: Failed to delete the bundle of export job %d: %v", job.ID, err)
+				continue
+			}
+		}
+		if err := s.db.Model(&models.ExportJob{}).Where("id = ?", job.ID).Updates(map[string]interface{}{
+			"status": models.ExportJobExpired,
+			"s3_key": "",
+		}).Error; err != nil {
+			return expired, fmt.Errorf("failed to expire export job %d: %w", job.ID, err)
+		}
+		expired++
+	}
+	return expired, nil
+}
+
+// StartSweeper fails interrupted jobs and expires old bundles every lease period until ctx is
+// cancelled
+func (s *exportService) StartSweeper(ctx context.Context, bundleTTL time.Duration) {
+	ticker := time.NewTicker(exportLeaseDuration)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.FailInterruptedJobs(); err != nil {
+				log.Printf("Warning: Failed to fail interrupted export jobs: %v", err)
+			}
+			if _, err := s.ExpireBundles(ctx, bundleTTL); err != nil && ctx.Err() == nil {
+				log.Printf("Warning: Failed to expire export bundles: %v", err)
+			}
+		}
+	}
+}
+
+// heartbeat renews the lease of a job until the returned function is called
+func (s *exportService) heartbeat(jobID uint) func() {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(exportHeartbeatInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := s.db.Model(&models.ExportJob{}).Where("id = ? AND owner = ?", jobID, s.instanceID).
+					Update("lease_expires_at", time.Now().Add(exportLeaseDuration)).Error; err != nil {
+					log.Printf("Warning: Failed to renew the lease of export job %d: %v", jobID, err)
+				}
+			}
+		}
+	}()
+	return func() { close(done) }
+}
+
+// exportShutdownError is the error recorded on export jobs the server shut down before starting
+const exportShutdownError = "interrupted by a server shutdown"
+
+// run builds and uploads the bundle of a job, recording the outcome on the job. A job still
+// waiting for a slot when ctx is cancelled is failed instead of started; a running one is finished.
+func (s *exportService) run(ctx context.Context, jobID uint, userID string) {
+	stop := s.heartbeat(jobID)
+	defer stop()
+
+	select {
+	case s.slots <- struct{}{}:
+	case <-ctx.Done():
+		s.failJob(jobID, exportShutdownError)
+		return
+	}
+	defer func() { <-s.slots }()
+
+	if err := s.db.Model(&models.ExportJob{}).Where("id = ?", jobID).
+		Update("status", models.ExportJobRunning).Error; err != nil {
+		log.Printf("Warning: Failed to start export job %d: %v", jobID, err)
+		return
+	}
+
+	// The bundle isn't tied to ctx: a started export runs to completion while the server drains
+	bundleCtx, cancel := context.WithTimeout(context.Background(), exportBundleTimeout)
+	defer cancel()
+
+	updates := map[string]interface{}{"completed_at": time.Now()}
+	key, err := s.buildAndUpload(bundleCtx, userID)
+	if err != nil {
+		log.Printf("Warning: Export job %d failed: %v", jobID, err)
+		updates["status"] = models.ExportJobFailed
+		updates["error"] = err.Error()
+	} else {
+		updates["status"] = models.ExportJobCompleted
+		updates["s3_key"] = key
+	}
+
+	if err := s.db.Model(&models.ExportJob{}).Where("id = ?", jobID).Updates(updates).Error; err != nil {
+		log.Printf("Warning: Failed to record the result of export job %d: %v", jobID, err)
+	}
+}
+
+// failJob marks a job that never ran as failed with the given error
+func (s *exportService) failJob(jobID uint, reason string) {
+	if err := s.db.Model(&models.ExportJob{}).Where("id = ?", jobID).Updates(map[string]interface{}{
+		"status":       models.ExportJobFailed,
+		"error":        reason,
+		"completed_at": time.Now(),
+	}).Error; err != nil {
+		log.Printf("Warning: Failed to fail export job %d: %v", jobID, err)
+	}
+}
+
+// buildAndUpload exports the user's data, zips it, and uploads the bundle, returning its key
+func (s *exportService) buildAndUpload(ctx context.Context, userID string) (string, error) {
+	doc, err := s.backupService.Export(userID)
+	if err != nil {
+		return "", fmt.Errorf("failed to export data: %w", err)
+	}
+
+	bundle, err := buildExportBundle(doc)
+	if err != nil {
+		return "", err
+	}
+
+	filename := fmt.Sprintf("export-%s.zip", doc.ExportedAt.UTC().Format("20060102-150405"))
+	key, err := s.uploadService.UploadFile(ctx, userID, filename, bundle, "application/zip")
+	if err != nil {
+		return "", fmt.Errorf("failed to upload export bundle: %w", err)
+	}
+	return key, nil
+}
+
+// buildExportBundle zips the export document as export.json and its invoices as invoices.csv
+func buildExportBundle(doc *ExportDocument) ([]byte, error) {
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+
+	jsonFile, err := archive.Create("export.json")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create export.json: %w", err)
+	}
+	encoder := json.NewEncoder(jsonFile)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return nil, fmt.Errorf("failed to write export.json: %w", err)
+	}
+
+	csvFile, err := archive.Create("invoices.csv")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create invoices.csv: %w", err)
+	}
+	if err := writeInvoicesCSV(csvFile, doc); err != nil {
+		return nil, fmt.Errorf("failed to write invoices.csv: %w", err)
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("failed to finish export bundle: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// invoicesCSVHeader is the header row of invoices.csv
+var invoicesCSVHeader = []string{
+	"id", "invoice_number", "title", "status", "currency", "amount",
+	"category", "company", "receiver", "due_date", "created_at", "paid_at",
+}
+
+// writeInvoicesCSV writes one row per invoice, with categories, companies, and receivers by name
+func writeInvoicesCSV(w io.Writer, doc *ExportDocument) error {
+	categories := make(map[uint]string, len(doc.Categories))
+	for _, category := range doc.Categories {
+		categories[category.ID] = category.Name
+	}
+	companies := make(map[uint]string, len(doc.Companies))
+	for _, company := range doc.Companies {
+		companies[company.ID] = company.Name
+	}
+	receivers := make(map[uint]string, len(doc.Receivers))
+	for _, receiver := range doc.Receivers {
+		receivers[receiver.ID] = receiver.Name
+	}
+
+	name := func(names map[uint]string, id *uint) string {
+		if id == nil {
+			return ""
+		}
+		return names[*id]
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(invoicesCSVHeader); err != nil {
+		return err
+	}
+	for i := range doc.Invoices {
+		invoice := &doc.Invoices[i]
+		var dueDate, paidAt string
+		if invoice.DueDate != nil {
+			dueDate = invoice.DueDate.UTC().Format(time.RFC3339)
+		}
+		if invoice.PaidAt != nil {
+			paidAt = invoice.PaidAt.UTC().Format(time.RFC3339)
+		}
+		if err := writer.Write([]string{
+			strconv.FormatUint(uint64(invoice.ID), 10),
+			invoice.DisplayNumber(),
+			invoice.Title,
+			string(invoice.Status),
+			invoice.Currency,
+			strconv.FormatFloat(invoice.Amount, 'f', -1, 64),
+			name(categories, invoice.CategoryID),
+			name(companies, invoice.CompanyID),
+			name(receivers, invoice.ReceiverID),
+			dueDate,
+			invoice.CreatedAt.UTC().Format(time.RFC3339),
+			paidAt,
+		}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
@@ -12,6 +12,7 @@ import (
 // FileUnlinkService handles unlinking files from an external file server
 type FileUnlinkService interface {
 	UnlinkInvoiceFile(ctx context.Context, invoiceID uint, authToken string) error
+	UnlinkFile(ctx context.Context, key string, authToken string) error
 }
 
 type fileUnlinkService struct {
@@ -62,6 +63,38 @@ func (s *fileUnlinkService) UnlinkInvoiceFile(ctx context.Context, invoiceID uin
 	query.Set("invoice_id", strconv.FormatUint(uint64(invoiceID), 10))
 	reqURL.RawQuery = query.Encode()
 
+	return s.deleteWithRetry(ctx, reqURL.String(), authToken)
+}
+
+// UnlinkFile attempts to unlink a single file from the file server by its storage key
+// It uses the same retry logic as UnlinkInvoiceFile
+// If the file server URL is not configured or auth token is empty, it returns nil
+func (s *fileUnlinkService) UnlinkFile(ctx context.Context, key string, authToken string) error {
+	// Skip if file server URL is not configured
+	if s.fileServerURL == "" {
+		return nil
+	}
+
+	// Skip if no auth token (user authenticated via other means)
+	if authToken == "" {
+		return nil
+	}
+
+	// Build request URL with key query parameter
+	reqURL, err := url.Parse(s.fileServerURL + "/api/files")
+	if err != nil {
+		return fmt.Errorf("invalid file server URL: %w", err)
+	}
+	query := reqURL.Query()
+	query.Set("key", key)
+	reqURL.RawQuery = query.Encode()
+
+	return s.deleteWithRetry(ctx, reqURL.String(), authToken)
+}
+
+// deleteWithRetry sends a DELETE request to the file server
+// It implements retry logic with exponential backoff (3 attempts: 100ms, 200ms, 400ms)
+func (s *fileUnlinkService) deleteWithRetry(ctx context.Context, reqURL string, authToken string) error {
 	// Retry configuration: 3 attempts with exponential backoff
 	maxAttempts := 3
 	backoffDurations := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond}
@@ -69,7 +102,7 @@ func (s *fileUnlinkService) UnlinkInvoiceFile(ctx context.Context, invoiceID uin
 	var lastErr error
 	for attempt := 0; attempt < maxAttempts; attempt++ {
 		// Create DELETE request
-		req, err := http.NewRequestWithContext(ctx, http.MethodDelete, reqURL.String(), nil)
+		req, err := http.NewRequestWithContext(ctx, http.MethodDelete, reqURL, nil)
 		if err != nil {
 			return fmt.Errorf("failed to create request: %w", err)
 		}
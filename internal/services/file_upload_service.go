@@ -1,9 +1,12 @@
 package services
 
 import (
+	"context"
 	"errors"
+	"fmt"
 
 	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
 	"gorm.io/gorm"
 )
 
@@ -19,15 +22,23 @@ type FileUploadService interface {
 	DeleteFileUpload(userID string, key string) error
 	// ListFileUploads lists all file uploads for a user
 	ListFileUploads(userID string, limit, offset int) ([]models.FileUpload, int64, error)
+
+	// Invoice attachments
+	AddAttachment(userID string, invoiceID uint, key string) (*models.InvoiceAttachment, error)
+	ListAttachments(userID string, invoiceID uint) ([]models.InvoiceAttachment, error)
+	RemoveAttachment(ctx context.Context, userID string, invoiceID uint, attachmentID uint, authToken string) error
+	RemoveInvoiceAttachments(ctx context.Context, userID string, invoiceID uint, authToken string) error
 }
 
 type fileUploadService struct {
-	db *gorm.DB
+	db                *gorm.DB
+	fileUnlinkService FileUnlinkService
 }
 
 // NewFileUploadService creates a new FileUploadService
-func NewFileUploadService(db *gorm.DB) FileUploadService {
-	return &fileUploadService{db: db}
+// fileUnlinkService can be nil (removed attachments will not be unlinked from the file server)
+func NewFileUploadService(db *gorm.DB, fileUnlinkService FileUnlinkService) FileUploadService {
+	return &fileUploadService{db: db, fileUnlinkService: fileUnlinkService}
 }
 
 // CreateFileUpload stores file metadata in the database
@@ -104,3 +115,100 @@ func (s *fileUploadService) ListFileUploads(userID string, limit, offset int) ([
 
 	return files, total, nil
 }
+
+// verifyInvoiceOwnership checks that the invoice exists and belongs to the user
+func (s *fileUploadService) verifyInvoiceOwnership(userID string, invoiceID uint) error {
+	var invoice models.Invoice
+	if err := s.db.Select("id").Where("id = ? AND user_id = ?", invoiceID, userID).First(&invoice).Error; err != nil {
+		return fmt.Errorf("invoice not found: %w", err)
+	}
+	return nil
+}
+
+// AddAttachment attaches an uploaded file to an invoice
+// The file must have been uploaded (or confirmed) by the same user
+func (s *fileUploadService) AddAttachment(userID string, invoiceID uint, key string) (*models.InvoiceAttachment, error) {
+	if err := s.verifyInvoiceOwnership(userID, invoiceID); err != nil {
+		return nil, err
+	}
+
+	fileUpload, err := s.GetFileUploadByKeyForUser(userID, key)
+	if err != nil {
+		return nil, err
+	}
+	if fileUpload == nil {
+		return nil, fmt.Errorf("file not found: %s", key)
+	}
+
+	attachment := &models.InvoiceAttachment{
+		InvoiceID:   invoiceID,
+		S3Key:       fileUpload.Key,
+		Filename:    fileUpload.Filename,
+		ContentType: fileUpload.ContentType,
+		Size:        fileUpload.Size,
+	}
+
+	if err := s.db.Create(attachment).Error; err != nil {
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+// ListAttachments lists all attachments of an invoice
+func (s *fileUploadService) ListAttachments(userID string, invoiceID uint) ([]models.InvoiceAttachment, error) {
+	if err := s.verifyInvoiceOwnership(userID, invoiceID); err != nil {
+		return nil, err
+	}
+
+	var attachments []models.InvoiceAttachment
+	if err := s.db.Where("invoice_id = ?", invoiceID).
+		Order("created_at ASC").
+		Find(&attachments).Error; err != nil {
+		return nil, err
+	}
+
+	return attachments, nil
+}
+
+// RemoveAttachment removes an attachment from an invoice and unlinks it from the file server
+// Unlink failures are logged but don't prevent removal
+func (s *fileUploadService) RemoveAttachment(ctx context.Context, userID string, invoiceID uint, attachmentID uint, authToken string) error {
+	if err := s.verifyInvoiceOwnership(userID, invoiceID); err != nil {
+		return err
+	}
+
+	var attachment models.InvoiceAttachment
+	if err := s.db.Where("id = ? AND invoice_id = ?", attachmentID, invoiceID).First(&attachment).Error; err != nil {
+		return fmt.Errorf("attachment not found: %w", err)
+	}
+
+	s.unlinkAttachment(ctx, &attachment, authToken)
+
+	return s.db.Delete(&attachment).Error
+}
+
+// RemoveInvoiceAttachments removes all attachments of an invoice and unlinks them from the file server
+// Unlink failures are logged but don't prevent removal
+func (s *fileUploadService) RemoveInvoiceAttachments(ctx context.Context, userID string, invoiceID uint, authToken string) error {
+	attachments, err := s.ListAttachments(userID, invoiceID)
+	if err != nil {
+		return err
+	}
+
+	for i := range attachments {
+		s.unlinkAttachment(ctx, &attachments[i], authToken)
+	}
+
+	return s.db.Where("invoice_id = ?", invoiceID).Delete(&models.InvoiceAttachment{}).Error
+}
+
+// unlinkAttachment unlinks an attachment's file from the file server, logging any failure
+func (s *fileUploadService) unlinkAttachment(ctx context.Context, attachment *models.InvoiceAttachment, authToken string) {
+	if s.fileUnlinkService == nil {
+		return
+	}
+	if err := s.fileUnlinkService.UnlinkFile(ctx, attachment.S3Key, authToken); err != nil {
+		utils.Logf(ctx, "Warning: Failed to unlink attachment %d (%s): %v", attachment.ID, attachment.S3Key, err)
+	}
+}
@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// defaultFXHTTPTimeout bounds a single provider request
+const defaultFXHTTPTimeout = 10 * time.Second
+
+type frankfurterProvider struct {
+	httpClient *http.Client
+}
+
+// frankfurterResponse represents the API response from Frankfurter
+type frankfurterResponse struct {
+	Amount float64            `json:"amount"`
+	Base   string             `json:"base"`
+	Date   string             `json:"date"`
+	Rates  map[string]float64 `json:"rates"`
+}
+
+// NewFrankfurterProvider creates an FXProvider backed by the Frankfurter API (ECB reference rates)
+// httpClient can be nil (a client with a 10 second timeout is used)
+func NewFrankfurterProvider(httpClient *http.Client) FXProvider {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultFXHTTPTimeout}
+	}
+	return &frankfurterProvider{httpClient: httpClient}
+}
+
+func (p *frankfurterProvider) Name() string {
+	return FXProviderFrankfurter
+}
+
+func (p *frankfurterProvider) FetchRate(ctx context.Context, from, to string) (*ExchangeRate, error) {
+	url := fmt.Sprintf("%s/latest?base=%s&symbols=%s", frankfurterBaseURL, from, to)
+
+	var apiResp frankfurterResponse
+	if err := getJSON(ctx, p.httpClient, url, &apiResp); err != nil {
+		return nil, err
+	}
+
+	rate, ok := apiResp.Rates[to]
+	if !ok {
+		return nil, fmt.Errorf("rate for %s not found in response", to)
+	}
+
+	return &ExchangeRate{
+		From:     from,
+		To:       to,
+		Rate:     rate,
+		Date:     apiResp.Date,
+		CachedAt: time.Now(),
+	}, nil
+}
+
+type openERAPIProvider struct {
+	httpClient *http.Client
+}
+
+// openERAPIResponse represents the API response from ExchangeRate-API's open access endpoint
+type openERAPIResponse struct {
+	Result             string             `json:"result"`
+	ErrorType          string             `json:"error-type"`
+	BaseCode           string             `json:"base_code"`
+	TimeLastUpdateUnix int64              `json:"time_last_update_unix"`
+	Rates              map[string]float64 `json:"rates"`
+}
+
+// NewOpenERAPIProvider creates an FXProvider backed by ExchangeRate-API's open access endpoint
+// httpClient can be nil (a client with a 10 second timeout is used)
+func NewOpenERAPIProvider(httpClient *http.Client) FXProvider {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultFXHTTPTimeout}
+	}
+	return &openERAPIProvider{httpClient: httpClient}
+}
+
+func (p *openERAPIProvider) Name() string {
+	return FXProviderOpenERAPI
+}
+
+func (p *openERAPIProvider) FetchRate(ctx context.Context, from, to string) (*ExchangeRate, error) {
+	url := fmt.Sprintf("%s/latest/%s", openERAPIBaseURL, from)
+
+	var apiResp openERAPIResponse
+	if err := getJSON(ctx, p.httpClient, url, &apiResp); err != nil {
+		return nil, err
+	}
+	if apiResp.Result != "success" {
+		return nil, fmt.Errorf("API returned %s: %s", apiResp.Result, apiResp.ErrorType)
+	}
+
+	rate, ok := apiResp.Rates[to]
+	if !ok {
+		return nil, fmt.Errorf("rate for %s not found in response", to)
+	}
+
+	return &ExchangeRate{
+		From:     from,
+		To:       to,
+		Rate:     rate,
+		Date:     time.Unix(apiResp.TimeLastUpdateUnix, 0).UTC().Format("2006-01-02"),
+		CachedAt: time.Now(),
+	}, nil
+}
+
+// getJSON fetches url and decodes a 200 response body into out
+func getJSON(ctx context.Context, httpClient *http.Client, url string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch exchange rate: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API returned status %d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
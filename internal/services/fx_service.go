@@ -3,18 +3,40 @@ package services
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/metrics"
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
 )
 
 const (
 	frankfurterBaseURL = "https://api.frankfurter.dev/v1"
+	openERAPIBaseURL   = "https://open.er-api.com/v6"
 	defaultCacheTTL    = time.Minute
 )
 
+// FX provider names accepted in the provider order configuration
+const (
+	FXProviderFrankfurter = "frankfurter"
+	FXProviderOpenERAPI   = "open_er_api"
+)
+
+// DefaultFXProviders is the provider order used when none is configured
+var DefaultFXProviders = []string{FXProviderFrankfurter, FXProviderOpenERAPI}
+
+// ErrFXRateUnavailable is returned when no provider returns a rate and no earlier rate is known
+var ErrFXRateUnavailable = errors.New("exchange rate unavailable")
+
+// ErrUnsupportedCurrency is returned for a currency that isn't ISO 4217, which no provider has
+// rates for
+var ErrUnsupportedCurrency = errors.New("unsupported currency")
+
 // ExchangeRate represents an exchange rate between two currencies
 type ExchangeRate struct {
 	From     string    `json:"from"`
@@ -22,6 +44,16 @@ type ExchangeRate struct {
 	Rate     float64   `json:"rate"`
 	Date     string    `json:"date"`
 	CachedAt time.Time `json:"cached_at"`
+	// Stale is set when every provider failed and the last known rate was used instead
+	Stale bool `json:"stale,omitempty"`
+}
+
+// FXProvider fetches live exchange rates from one rates source
+type FXProvider interface {
+	// Name identifies the provider in configuration and logs
+	Name() string
+	// FetchRate fetches the current rate from one currency to another (both upper case)
+	FetchRate(ctx context.Context, from, to string) (*ExchangeRate, error)
 }
 
 // FXService provides currency conversion functionality
@@ -29,47 +61,170 @@ type FXService interface {
 	// GetExchangeRate gets the exchange rate from one currency to another
 	GetExchangeRate(ctx context.Context, fromCurrency, toCurrency string) (*ExchangeRate, error)
 	// ConvertAmount converts an amount from one currency to another
-	// Returns (convertedAmount, rateUsed, error)
+	// Returns (convertedAmount, rateUsed, error); fails with ErrFXRateUnavailable when no rate is known
 	ConvertAmount(ctx context.Context, amount float64, fromCurrency, toCurrency string) (float64, float64, error)
+	// PreviewConversion computes the target amounts items would have in toCurrency, without saving anything.
+	// fromCurrency is the currency of items that don't have their own (i.e. the invoice currency).
+	PreviewConversion(ctx context.Context, items []models.InvoiceItem, fromCurrency, toCurrency string) (*ConversionPreview, error)
+	// LastSuccessfulFetch returns when rates were last fetched from the provider, or nil if never
+	LastSuccessfulFetch() *time.Time
 }
 
-type fxService struct {
-	redis      RedisService
-	httpClient *http.Client
-	cacheTTL   time.Duration
+// ConversionPreview describes the target amounts a set of invoice items would be converted to
+type ConversionPreview struct {
+	FromCurrency string `json:"from_currency"`
+	ToCurrency   string `json:"to_currency"`
+	// Rate converts FromCurrency to ToCurrency; items in their own currency report their own rate
+	Rate         float64                 `json:"rate"`
+	Items        []ItemConversionPreview `json:"items"`
+	CurrentTotal float64                 `json:"current_total"`
+	Total        float64                 `json:"total"`
+}
+
+// ItemConversionPreview is the previewed conversion of a single invoice item
+type ItemConversionPreview struct {
+	ItemID              uint    `json:"item_id"`
+	Description         string  `json:"description"`
+	Amount              float64 `json:"amount"`
+	Currency            string  `json:"currency"`
+	CurrentTargetAmount float64 `json:"current_target_amount"`
+	TargetAmount        float64 `json:"target_amount"`
+	Rate                float64 `json:"rate"`
+	// Stale is set when the rate is the last known one because every provider failed
+	Stale bool `json:"stale,omitempty"`
+}
+
+// convertItemAmount converts an item's amount to toCurrency the same way persisted target amounts
+// are calculated, returning the converted amount and the rate used. A nil fx converts 1:1.
+// The amount is rounded to the precision of toCurrency so totals can be summed from the items.
+// Fails with ErrUnsupportedCurrency when the item currency isn't ISO 4217, even without fx.
+func convertItemAmount(ctx context.Context, fx FXService, item *models.InvoiceItem, invoiceCurrency, toCurrency string) (float64, *ExchangeRate, error) {
+	currency := item.EffectiveCurrency(invoiceCurrency)
+	if err := checkISOCurrency(currency); err != nil {
+		return 0, nil, err
+	}
+	if fx == nil || currency == toCurrency {
+		return utils.RoundToCurrency(item.Amount, toCurrency), &ExchangeRate{From: currency, To: toCurrency, Rate: 1.0}, nil
+	}
+	rate, err := fx.GetExchangeRate(ctx, currency, toCurrency)
+	if err != nil {
+		return 0, nil, err
+	}
+	return utils.RoundToCurrency(item.Amount*rate.Rate, toCurrency), rate, nil
+}
+
+// checkISOCurrency fails with ErrUnsupportedCurrency when currency isn't ISO 4217
+func checkISOCurrency(currency string) error {
+	if !utils.IsISOCurrency(currency) {
+		return fmt.Errorf("%w %q: not an ISO 4217 currency code", ErrUnsupportedCurrency, currency)
+	}
+	return nil
+}
+
+// previewConversion builds a ConversionPreview using convertItemAmount for every item
+func previewConversion(ctx context.Context, fx FXService, items []models.InvoiceItem, fromCurrency, toCurrency string) (*ConversionPreview, error) {
+	preview := &ConversionPreview{
+		FromCurrency: fromCurrency,
+		ToCurrency:   toCurrency,
+		Rate:         1.0,
+		Items:        make([]ItemConversionPreview, 0, len(items)),
+	}
+	if fx != nil && fromCurrency != toCurrency {
+		rate, err := fx.GetExchangeRate(ctx, fromCurrency, toCurrency)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get exchange rate: %w", err)
+		}
+		preview.Rate = rate.Rate
+	}
+
+	for i := range items {
+		item := &items[i]
+		targetAmount, rate, err := convertItemAmount(ctx, fx, item, fromCurrency, toCurrency)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert item %q: %w", item.Description, err)
+		}
+		preview.Items = append(preview.Items, ItemConversionPreview{
+			ItemID:              item.ID,
+			Description:         item.Description,
+			Amount:              item.Amount,
+			Currency:            item.EffectiveCurrency(fromCurrency),
+			CurrentTargetAmount: item.TargetAmount,
+			TargetAmount:        targetAmount,
+			Rate:                rate.Rate,
+			Stale:               rate.Stale,
+		})
+		preview.CurrentTotal += item.TargetAmount
+		preview.Total += targetAmount
+	}
+	// The items are already rounded; this only drops floating-point noise from the sums
+	preview.CurrentTotal = utils.RoundToCurrency(preview.CurrentTotal, toCurrency)
+	preview.Total = utils.RoundToCurrency(preview.Total, toCurrency)
+	return preview, nil
 }
 
-// frankfurterResponse represents the API response from Frankfurter
-type frankfurterResponse struct {
-	Amount float64            `json:"amount"`
-	Base   string             `json:"base"`
-	Date   string             `json:"date"`
-	Rates  map[string]float64 `json:"rates"`
+type fxService struct {
+	redis     RedisService
+	providers []FXProvider
+	cacheTTL  time.Duration
+
+	mu          sync.RWMutex
+	lastFetched *time.Time
+	// lastKnown holds the last rate fetched per currency pair, used when every provider fails
+	lastKnown map[string]ExchangeRate
 }
 
-// NewFXService creates a new FX service
-// redis can be nil (caching will be disabled)
-func NewFXService(redis RedisService, httpClient *http.Client, cacheTTL time.Duration) FXService {
-	if httpClient == nil {
-		httpClient = &http.Client{Timeout: 10 * time.Second}
+// NewFXService creates a new FX service that tries providers in order until one returns a rate.
+// redis can be nil (caching will be disabled); no providers means Frankfurter alone.
+func NewFXService(redis RedisService, providers []FXProvider, cacheTTL time.Duration) FXService {
+	if len(providers) == 0 {
+		providers = []FXProvider{NewFrankfurterProvider(nil)}
 	}
 	if cacheTTL == 0 {
 		cacheTTL = defaultCacheTTL
 	}
 	return &fxService{
-		redis:      redis,
-		httpClient: httpClient,
-		cacheTTL:   cacheTTL,
+		redis:     redis,
+		providers: providers,
+		cacheTTL:  cacheTTL,
+		lastKnown: make(map[string]ExchangeRate),
+	}
+}
+
+// NewFXProviders creates the named providers in order, sharing one HTTP client.
+// httpClient can be nil (a client with a 10 second timeout is used).
+func NewFXProviders(names []string, httpClient *http.Client) ([]FXProvider, error) {
+	providers := make([]FXProvider, 0, len(names))
+	for _, name := range names {
+		switch strings.TrimSpace(name) {
+		case FXProviderFrankfurter:
+			providers = append(providers, NewFrankfurterProvider(httpClient))
+		case FXProviderOpenERAPI:
+			providers = append(providers, NewOpenERAPIProvider(httpClient))
+		case "":
+		default:
+			return nil, fmt.Errorf("unknown FX provider %q", name)
+		}
 	}
+	return providers, nil
 }
 
 func (f *fxService) cacheKey(from, to string) string {
 	return fmt.Sprintf("fx_rate:%s:%s", strings.ToUpper(from), strings.ToUpper(to))
 }
 
+// lastKnownKey is the cache key of the last rate fetched for a pair, kept without expiry
+func (f *fxService) lastKnownKey(from, to string) string {
+	return fmt.Sprintf("fx_rate_last:%s:%s", strings.ToUpper(from), strings.ToUpper(to))
+}
+
 func (f *fxService) GetExchangeRate(ctx context.Context, fromCurrency, toCurrency string) (*ExchangeRate, error) {
 	from := strings.ToUpper(fromCurrency)
 	to := strings.ToUpper(toCurrency)
+	for _, currency := range []string{from, to} {
+		if err := checkISOCurrency(currency); err != nil {
+			return nil, err
+		}
+	}
 
 	// Same currency - return 1.0
 	if from == to {
@@ -84,87 +239,102 @@ func (f *fxService) GetExchangeRate(ctx context.Context, fromCurrency, toCurrenc
 
 	// Try to get from cache
 	if f.redis != nil {
-		cached, err := f.redis.Get(ctx, f.cacheKey(from, to))
-		if err == nil && cached != "" {
-			var rate ExchangeRate
-			if err := json.Unmarshal([]byte(cached), &rate); err == nil {
-				return &rate, nil
-			}
+		if rate := f.getCached(ctx, f.cacheKey(from, to)); rate != nil {
+			metrics.FXCacheHitsTotal.Inc()
+			return rate, nil
 		}
+		metrics.FXCacheMissesTotal.Inc()
 	}
 
-	// Fetch from API
-	rate, err := f.fetchFromAPI(ctx, from, to)
-	if err != nil {
-		log.Printf("Warning: Failed to fetch exchange rate from API: %v", err)
-		// Return 1.0 rate on failure (fail-soft)
-		return &ExchangeRate{
-			From:     from,
-			To:       to,
-			Rate:     1.0,
-			Date:     time.Now().Format("2006-01-02"),
-			CachedAt: time.Now(),
-		}, nil
-	}
-
-	// Cache the result
-	if f.redis != nil {
-		data, err := json.Marshal(rate)
-		if err == nil {
-			if err := f.redis.Set(ctx, f.cacheKey(from, to), string(data), f.cacheTTL); err != nil {
-				log.Printf("Warning: Failed to cache exchange rate: %v", err)
-			}
+	// Try each provider in order
+	var errs []error
+	for _, provider := range f.providers {
+		rate, err := provider.FetchRate(ctx, from, to)
+		if err != nil {
+			utils.Logf(ctx, "Warning: FX provider %s failed for %s/%s: %v", provider.Name(), from, to, err)
+			errs = append(errs, fmt.Errorf("%s: %w", provider.Name(), err))
+			continue
 		}
+		f.storeRate(ctx, rate)
+		return rate, nil
 	}
 
-	return rate, nil
+	// Every provider failed; fall back to the last rate we fetched
+	if rate := f.getLastKnown(ctx, from, to); rate != nil {
+		utils.Logf(ctx, "Warning: Using stale %s/%s exchange rate from %s", from, to, rate.Date)
+		rate.Stale = true
+		return rate, nil
+	}
+	return nil, fmt.Errorf("%w for %s to %s: %w", ErrFXRateUnavailable, from, to, errors.Join(errs...))
 }
 
-func (f *fxService) fetchFromAPI(ctx context.Context, from, to string) (*ExchangeRate, error) {
-	url := fmt.Sprintf("%s/latest?base=%s&symbols=%s", frankfurterBaseURL, from, to)
+// storeRate records a freshly fetched rate as the last successful fetch, in the cache,
+// and as the last known rate of its pair
+func (f *fxService) storeRate(ctx context.Context, rate *ExchangeRate) {
+	f.mu.Lock()
+	f.lastFetched = &rate.CachedAt
+	f.lastKnown[f.cacheKey(rate.From, rate.To)] = *rate
+	f.mu.Unlock()
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+	if f.redis == nil {
+		return
 	}
-
-	resp, err := f.httpClient.Do(req)
+	data, err := json.Marshal(rate)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch exchange rate: %w", err)
+		return
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API returned status %d", resp.StatusCode)
+	if err := f.redis.Set(ctx, f.cacheKey(rate.From, rate.To), string(data), f.cacheTTL); err != nil {
+		utils.Logf(ctx, "Warning: Failed to cache exchange rate: %v", err)
 	}
-
-	var apiResp frankfurterResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	if err := f.redis.Set(ctx, f.lastKnownKey(rate.From, rate.To), string(data), 0); err != nil {
+		utils.Logf(ctx, "Warning: Failed to store last known exchange rate: %v", err)
 	}
+}
 
-	rate, ok := apiResp.Rates[to]
-	if !ok {
-		return nil, fmt.Errorf("rate for %s not found in response", to)
+// getLastKnown returns the last rate fetched for a pair by this process or, failing that,
+// by any instance sharing the cache; nil if none is known
+func (f *fxService) getLastKnown(ctx context.Context, from, to string) *ExchangeRate {
+	f.mu.RLock()
+	rate, ok := f.lastKnown[f.cacheKey(from, to)]
+	f.mu.RUnlock()
+	if ok {
+		return &rate
 	}
+	if f.redis == nil {
+		return nil
+	}
+	return f.getCached(ctx, f.lastKnownKey(from, to))
+}
 
-	return &ExchangeRate{
-		From:     from,
-		To:       to,
-		Rate:     rate,
-		Date:     apiResp.Date,
-		CachedAt: time.Now(),
-	}, nil
+// getCached reads a rate from the cache, returning nil when it is missing or unreadable
+func (f *fxService) getCached(ctx context.Context, key string) *ExchangeRate {
+	cached, err := f.redis.Get(ctx, key)
+	if err != nil || cached == "" {
+		return nil
+	}
+	var rate ExchangeRate
+	if err := json.Unmarshal([]byte(cached), &rate); err != nil {
+		return nil
+	}
+	return &rate
 }
 
 func (f *fxService) ConvertAmount(ctx context.Context, amount float64, fromCurrency, toCurrency string) (float64, float64, error) {
 	rate, err := f.GetExchangeRate(ctx, fromCurrency, toCurrency)
 	if err != nil {
-		// This shouldn't happen as GetExchangeRate always returns a valid rate
-		// but handle it just in case
-		return amount, 1.0, nil
+		return 0, 0, err
 	}
 
 	convertedAmount := amount * rate.Rate
 	return convertedAmount, rate.Rate, nil
 }
+
+func (f *fxService) PreviewConversion(ctx context.Context, items []models.InvoiceItem, fromCurrency, toCurrency string) (*ConversionPreview, error) {
+	return previewConversion(ctx, f, items, fromCurrency, toCurrency)
+}
+
+func (f *fxService) LastSuccessfulFetch() *time.Time {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.lastFetched
+}
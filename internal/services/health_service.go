@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"time"
+)
+
+// healthDBPingTimeout bounds the database ping so health checks stay fast
+const healthDBPingTimeout = 500 * time.Millisecond
+
+// HealthStatus reports the availability of the server's dependencies
+type HealthStatus struct {
+	// Healthy is false when a required dependency (the database) is unavailable
+	Healthy bool
+	// DatabaseError is the database ping error, empty when the ping succeeded
+	DatabaseError string
+	// FXConfigured reports whether currency conversion is available
+	FXConfigured bool
+	// FXLastSuccessfulFetch is the last time exchange rates were fetched from the provider
+	FXLastSuccessfulFetch *time.Time
+	// UploadAvailable reports whether S3 file upload is configured
+	UploadAvailable bool
+}
+
+// HealthService checks the status of the server's dependencies
+type HealthService interface {
+	Check(ctx context.Context) *HealthStatus
+}
+
+type healthService struct {
+	dbService     DBService
+	fxService     FXService
+	uploadService UploadService
+}
+
+// NewHealthService creates a new HealthService instance
+// fxService and uploadService can be nil (reported as not configured)
+func NewHealthService(dbService DBService, fxService FXService, uploadService UploadService) HealthService {
+	return &healthService{
+		dbService:     dbService,
+		fxService:     fxService,
+		uploadService: uploadService,
+	}
+}
+
+// Check pings the database and reports which optional services are configured
+func (s *healthService) Check(ctx context.Context) *HealthStatus {
+	status := &HealthStatus{
+		FXConfigured:    s.fxService != nil,
+		UploadAvailable: s.uploadService != nil,
+	}
+
+	if err := s.pingDB(ctx); err != nil {
+		status.DatabaseError = err.Error()
+	} else {
+		status.Healthy = true
+	}
+
+	if s.fxService != nil {
+		status.FXLastSuccessfulFetch = s.fxService.LastSuccessfulFetch()
+	}
+
+	return status
+}
+
+func (s *healthService) pingDB(ctx context.Context) error {
+	sqlDB, err := s.dbService.GetDB().DB()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, healthDBPingTimeout)
+	defer cancel()
+	return sqlDB.PingContext(ctx)
+}
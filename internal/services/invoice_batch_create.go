@@ -0,0 +1,223 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"gorm.io/gorm"
+)
+
+// MaxBatchCreateInvoices is the largest number of invoices BatchCreateInvoices accepts at once
+const MaxBatchCreateInvoices = 1000
+
+// Batch create invoice statuses
+const (
+	BatchInvoiceCreated   = "created"
+	BatchInvoiceDuplicate = "duplicate"
+	BatchInvoiceError     = "error"
+)
+
+// BatchCreateInvoiceResult is the outcome of one invoice of a batch. Index is the invoice's
+// position in the batch. Invoice is the created invoice, or the existing one for a duplicate.
+type BatchCreateInvoiceResult struct {
+	Index   int             `json:"index"`
+	Status  string          `json:"status"`
+	Invoice *models.Invoice `json:"invoice,omitempty"`
+	Error   string          `json:"error,omitempty"`
+}
+
+// BatchCreateResult counts the invoices of a batch by outcome and lists every invoice
+type BatchCreateResult struct {
+	Created    int `json:"created"`
+	Duplicates int `json:"duplicates"`
+	Failed     int `json:"failed"`
+	// Rates are the exchange rates the batch was converted at, keyed "FROM:TO"
+	Rates    map[string]float64         `json:"rates,omitempty"`
+	Invoices []BatchCreateInvoiceResult `json:"invoices"`
+}
+
+// BatchCreateInvoices creates invoices the way CreateInvoice does, converting them all at the same
+// exchange rates. The rate of every distinct currency is resolved once up front (through the FX
+// cache) and reused for each invoice, so a large import makes one provider request per currency
+// instead of one per item and never mixes rates fetched at different times. The invoices are
+// created in a single transaction, each in its own savepoint: an invoice that fails, including one
+// in a currency without a rate, is reported without affecting the others, and one matching an
+// existing invoice or an earlier invoice of the batch is reported as a duplicate.
+func (s *invoiceService) BatchCreateInvoices(userID string, invoices []*models.Invoice) (*BatchCreateResult, error) {
+	if len(invoices) > MaxBatchCreateInvoices {
+		return nil, fmt.Errorf("batch exceeds %d invoices", MaxBatchCreateInvoices)
+	}
+
+	batch := s
+	var rates *batchFXRates
+	if s.fxService != nil {
+		rates = newBatchFXRates(s.fxService)
+		baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
+		if err != nil {
+			return nil, err
+		}
+		for _, currency := range batchCurrencies(invoices) {
+			if currency != baseCurrency {
+				// Failures are remembered and reported on the invoices in that currency
+				_, _ = rates.GetExchangeRate(context.Background(), currency, baseCurrency)
+			}
+		}
+		pinned := *s
+		pinned.fxService = rates
+		batch = &pinned
+	}
+
+	result := &BatchCreateResult{Invoices: make([]BatchCreateInvoiceResult, 0, len(invoices))}
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		for i, invoice := range invoices {
+			entry := BatchCreateInvoiceResult{Index: i}
+			if invoice == nil {
+				entry.Status = BatchInvoiceError
+				entry.Error = "invoice is required"
+				result.Failed++
+				result.Invoices = append(result.Invoices, entry)
+				continue
+			}
+
+			var created *CreateInvoiceResult
+			err := tx.Transaction(func(savepoint *gorm.DB) error {
+				var err error
+				created, err = batch.withTx(savepoint).CreateInvoice(userID, invoice)
+				return err
+			})
+			switch {
+			case err != nil:
+				entry.Status = BatchInvoiceError
+				entry.Error = err.Error()
+				result.Failed++
+			case created.IsDuplicate:
+				entry.Status = BatchInvoiceDuplicate
+				entry.Invoice = created.Invoice
+				result.Duplicates++
+			default:
+				entry.Status = BatchInvoiceCreated
+				entry.Invoice = created.Invoice
+				result.Created++
+			}
+			result.Invoices = append(result.Invoices, entry)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create invoices: %w", err)
+	}
+
+	if rates != nil {
+		result.Rates = rates.resolved()
+	}
+	return result, nil
+}
+
+// batchCurrencies returns the distinct currencies of a batch's invoices and items, sorted.
+// Invoices without a currency get their category's default when created, whose rate is then
+// resolved on first use.
+func batchCurrencies(invoices []*models.Invoice) []string {
+	seen := make(map[string]bool)
+	for _, invoice := range invoices {
+		if invoice == nil {
+			continue
+		}
+		currency := strings.ToUpper(strings.TrimSpace(invoice.Currency))
+		if currency != "" {
+			seen[currency] = true
+		}
+		for _, item := range invoice.Items {
+			if itemCurrency := strings.ToUpper(strings.TrimSpace(item.Currency)); itemCurrency != "" {
+				seen[itemCurrency] = true
+			} else if currency != "" {
+				seen[currency] = true
+			}
+		}
+	}
+
+	currencies := make([]string, 0, len(seen))
+	for currency := range seen {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+	return currencies
+}
+
+// batchFXRates is an FXService that asks the wrapped service for each currency pair once and
+// answers every later request with that first result, failures included. It is safe for
+// concurrent use; concurrent requests for a pair wait for the first one instead of all reaching
+// the provider.
+type batchFXRates struct {
+	fx     FXService
+	mu     sync.Mutex
+	rates  map[string]*ExchangeRate
+	errors map[string]error
+}
+
+func newBatchFXRates(fx FXService) *batchFXRates {
+	return &batchFXRates{
+		fx:     fx,
+		rates:  make(map[string]*ExchangeRate),
+		errors: make(map[string]error),
+	}
+}
+
+// GetExchangeRate implements FXService
+func (r *batchFXRates) GetExchangeRate(ctx context.Context, fromCurrency, toCurrency string) (*ExchangeRate, error) {
+	key := fromCurrency + ":" + toCurrency
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if rate, ok := r.rates[key]; ok {
+		return rate, nil
+	}
+	if err, ok := r.errors[key]; ok {
+		return nil, err
+	}
+	rate, err := r.fx.GetExchangeRate(ctx, fromCurrency, toCurrency)
+	if err != nil {
+		r.errors[key] = err
+		return nil, err
+	}
+	r.rates[key] = rate
+	return rate, nil
+}
+
+// ConvertAmount implements FXService
+func (r *batchFXRates) ConvertAmount(ctx context.Context, amount float64, fromCurrency, toCurrency string) (float64, float64, error) {
+	if fromCurrency == toCurrency {
+		return amount, 1.0, nil
+	}
+	rate, err := r.GetExchangeRate(ctx, fromCurrency, toCurrency)
+	if err != nil {
+		return 0, 0, err
+	}
+	return amount * rate.Rate, rate.Rate, nil
+}
+
+// PreviewConversion implements FXService
+func (r *batchFXRates) PreviewConversion(ctx context.Context, items []models.InvoiceItem, fromCurrency, toCurrency string) (*ConversionPreview, error) {
+	return previewConversion(ctx, r, items, fromCurrency, toCurrency)
+}
+
+// LastSuccessfulFetch implements FXService
+func (r *batchFXRates) LastSuccessfulFetch() *time.Time {
+	return r.fx.LastSuccessfulFetch()
+}
+
+// resolved returns the rates resolved so far, keyed "FROM:TO"
+func (r *batchFXRates) resolved() map[string]float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rates := make(map[string]float64, len(r.rates))
+	for key, rate := range r.rates {
+		rates[key] = rate.Rate
+	}
+	return rates
+}
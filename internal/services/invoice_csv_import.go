@@ -0,0 +1,296 @@
+package services
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"gorm.io/gorm"
+)
+
+// MaxCSVImportBytes is the largest CSV file ImportCSV accepts
+const MaxCSVImportBytes = 2 << 20
+
+// MaxCSVImportRows is the largest number of invoice rows ImportCSV accepts in one file
+const MaxCSVImportRows = 1000
+
+// CSV import row statuses
+const (
+	CSVRowCreated = "created"
+	CSVRowSkipped = "skipped"
+	CSVRowError   = "error"
+)
+
+// CSVImportRow is the outcome of one CSV row. Line is the row's line number in the file, the
+// header being line 1.
+type CSVImportRow struct {
+	Line      int    `json:"line"`
+	Status    string `json:"status"`
+	InvoiceID uint   `json:"invoice_id,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// CSVImportResult counts the rows of a CSV import by outcome and lists every row
+type CSVImportResult struct {
+	Created int            `json:"created"`
+	Skipped int            `json:"skipped"`
+	Failed  int            `json:"failed"`
+	Rows    []CSVImportRow `json:"rows"`
+}
+
+// csvImportColumns are the invoices.csv export columns ImportCSV understands. id and
+// invoice_number are accepted so an export can be imported as is, but imported invoices get
+// new IDs and numbers.
+var csvImportColumns = map[string]bool{
+	"id": true, "invoice_number": true, "title": true, "status": true, "currency": true, "amount": true,
+	"category": true, "company": true, "receiver": true, "due_date": true, "created_at": true, "paid_at": true,
+}
+
+// errCSVRowDuplicate rolls back a row whose invoice turned out to be a duplicate
+var errCSVRowDuplicate = errors.New("duplicate invoice")
+
+// ImportCSV creates an invoice from every row of a CSV file with the invoices.csv export columns.
+// The header row names the columns, in any order; title and amount are required. Each invoice
+// gets a single item for its amount. Categories and companies are matched by name and receivers by
+// name or alias, and with createMissing they are created when missing. A paid row was paid at its
+// paid_at, else at its created_at (the invoice date), and only without either at the import.
+// Rows are imported in their own transactions, so a bad row is reported without affecting the
+// others; a row matching an existing invoice is skipped and leaves nothing behind. Files over
+// MaxCSVImportBytes or MaxCSVImportRows rows are rejected before anything is imported.
+func (s *invoiceService) ImportCSV(userID string, r io.Reader, createMissing bool) (*CSVImportResult, error) {
+	content, err := io.ReadAll(io.LimitReader(r, MaxCSVImportBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV: %w", err)
+	}
+	if len(content) > MaxCSVImportBytes {
+		return nil, fmt.Errorf("CSV file exceeds %d bytes", MaxCSVImportBytes)
+	}
+
+	reader := csv.NewReader(strings.NewReader(strings.TrimPrefix(string(content), "\ufeff")))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("CSV file is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !csvImportColumns[name] {
+			return nil, fmt.Errorf("unknown CSV column %q", name)
+		}
+		columns[name] = i
+	}
+	for _, required := range []string{"title", "amount"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("CSV column %q is required", required)
+		}
+	}
+
+	type csvRecord struct {
+		line   int
+		fields []string
+	}
+	var records []csvRecord
+	for {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid CSV: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+		records = append(records, csvRecord{line: line, fields: fields})
+		if len(records) > MaxCSVImportRows {
+			return nil, fmt.Errorf("CSV file exceeds %d rows", MaxCSVImportRows)
+		}
+	}
+
+	result := &CSVImportResult{Rows: make([]CSVImportRow, 0, len(records))}
+	for _, record := range records {
+		row := CSVImportRow{Line: record.line}
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record.fields) {
+				return strings.TrimSpace(record.fields[i])
+			}
+			return ""
+		}
+
+		invoiceID, err := s.importCSVRow(userID, field, createMissing)
+		switch {
+		case errors.Is(err, errCSVRowDuplicate):
+			row.Status = CSVRowSkipped
+			row.InvoiceID = invoiceID
+			result.Skipped++
+		case err != nil:
+			row.Status = CSVRowError
+			row.Error = err.Error()
+			result.Failed++
+		default:
+			row.Status = CSVRowCreated
+			row.InvoiceID = invoiceID
+			result.Created++
+		}
+		result.Rows = append(result.Rows, row)
+	}
+
+	return result, nil
+}
+
+// importCSVRow creates the invoice of one CSV row in a transaction and returns its ID. For a
+// duplicate it returns the existing invoice's ID with errCSVRowDuplicate.
+func (s *invoiceService) importCSVRow(userID string, field func(string) string, createMissing bool) (uint, error) {
+	invoice, err := csvRowInvoice(field)
+	if err != nil {
+		return 0, err
+	}
+
+	var invoiceID uint
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		if invoice.CategoryID, err = resolveCSVName(tx, userID, "category", field("category"), createMissing, &models.InvoiceCategory{}); err != nil {
+			return err
+		}
+		if invoice.CompanyID, err = resolveCSVName(tx, userID, "company", field("company"), createMissing, &models.InvoiceCompany{}); err != nil {
+			return err
+		}
+		if invoice.ReceiverID, err = resolveCSVName(tx, userID, "receiver", field("receiver"), createMissing, &models.InvoiceReceiver{}); err != nil {
+			return err
+		}
+
+		created, err := s.withTx(tx).CreateInvoice(userID, invoice)
+		if err != nil {
+			return err
+		}
+		invoiceID = created.Invoice.ID
+		if created.IsDuplicate {
+			return errCSVRowDuplicate
+		}
+		return nil
+	})
+	return invoiceID, err
+}
+
+// csvRowInvoice builds the invoice of a CSV row, without its category, company, and receiver
+func csvRowInvoice(field func(string) string) (*models.Invoice, error) {
+	title := field("title")
+	if title == "" {
+		return nil, fmt.Errorf("title is required")
+	}
+	amount, err := strconv.ParseFloat(field("amount"), 64)
+	if err != nil || math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return nil, fmt.Errorf("invalid amount %q", field("amount"))
+	}
+
+	status := models.InvoiceStatusUnpaid
+	if value := field("status"); value != "" {
+		status = models.InvoiceStatus(strings.ToLower(value))
+		switch status {
+		case models.InvoiceStatusPaid, models.InvoiceStatusUnpaid, models.InvoiceStatusOverdue:
+		default:
+			return nil, fmt.Errorf("invalid status %q (expected paid, unpaid, or overdue)", value)
+		}
+	}
+
+	invoice := &models.Invoice{
+		Title:    title,
+		Status:   status,
+		Currency: strings.ToUpper(field("currency")),
+		Items:    []models.InvoiceItem{{Description: title, Quantity: 1, UnitPrice: amount}},
+	}
+	if invoice.DueDate, err = parseCSVDate("due_date", field("due_date")); err != nil {
+		return nil, err
+	}
+	createdAt, err := parseCSVDate("created_at", field("created_at"))
+	if err != nil {
+		return nil, err
+	}
+	if createdAt != nil {
+		invoice.CreatedAt = *createdAt
+	}
+	paidAt, err := parseCSVDate("paid_at", field("paid_at"))
+	if err != nil {
+		return nil, err
+	}
+	if status == models.InvoiceStatusPaid {
+		if paidAt == nil {
+			paidAt = createdAt
+		}
+		invoice.PaidAt = paidAt
+	}
+	return invoice, nil
+}
+
+// parseCSVDate parses an RFC 3339 timestamp or a YYYY-MM-DD date (midnight UTC); empty is nil
+func parseCSVDate(column, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid %s %q (expected RFC 3339 or YYYY-MM-DD)", column, value)
+}
+
+// resolveCSVName returns the ID of the user's category, company, or receiver (model) with the given
+// name, creating it when createMissing is set. Receivers are found by their aliases too, like
+// ReceiverService.FindByNameOrAlias does. An empty name is nil.
+func resolveCSVName(tx *gorm.DB, userID, entity, name string, createMissing bool, model interface{}) (*uint, error) {
+	if name == "" {
+		return nil, nil
+	}
+
+	var id uint
+	if _, ok := model.(*models.InvoiceReceiver); ok {
+		receiver, err := NewReceiverService(tx).FindByNameOrAlias(userID, name)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		if receiver != nil {
+			id = receiver.ID
+		}
+	} else if err := tx.Model(model).Select("id").Where("user_id = ? AND name = ?", userID, name).Limit(1).Scan(&id).Error; err != nil {
+		return nil, err
+	}
+	if id != 0 {
+		return &id, nil
+	}
+	if !createMissing {
+		return nil, fmt.Errorf("%s %q not found", entity, name)
+	}
+
+	var err error
+	switch m := model.(type) {
+	case *models.InvoiceCategory:
+		m.UserID, m.Name = userID, name
+		err = tx.Create(m).Error
+		id = m.ID
+	case *models.InvoiceCompany:
+		m.UserID, m.Name = userID, name
+		err = tx.Create(m).Error
+		id = m.ID
+	case *models.InvoiceReceiver:
+		m.UserID, m.Name = userID, name
+		err = tx.Create(m).Error
+		id = m.ID
+	default:
+		return nil, fmt.Errorf("unsupported %s model %T", entity, model)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s %q: %w", entity, name, err)
+	}
+	return &id, nil
+}
@@ -0,0 +1,469 @@
+package services
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// extractionPreviewLength is the number of characters of extracted text returned for review
+const extractionPreviewLength = 1000
+
+// ExtractedAmount is a candidate monetary amount with a confidence score between 0 and 1
+type ExtractedAmount struct {
+	Value      float64 `json:"value"`
+	Confidence float64 `json:"confidence"`
+	Source     string  `json:"source"`
+}
+
+// ExtractedText is a candidate text value with a confidence score between 0 and 1
+type ExtractedText struct {
+	Value      string  `json:"value"`
+	Confidence float64 `json:"confidence"`
+	Source     string  `json:"source"`
+}
+
+// ExtractedDate is a candidate date with a confidence score between 0 and 1
+type ExtractedDate struct {
+	Value      time.Time `json:"value"`
+	Confidence float64   `json:"confidence"`
+	Source     string    `json:"source"`
+}
+
+// ExtractedInvoice holds the invoice fields found in a PDF.
+// Every field is a heuristic guess; a nil field means nothing plausible was found.
+// Source is the line of text the value was taken from so it can be double-checked.
+type ExtractedInvoice struct {
+	TotalAmount *ExtractedAmount `json:"total_amount,omitempty"`
+	Currency    *ExtractedText   `json:"currency,omitempty"`
+	InvoiceDate *ExtractedDate   `json:"invoice_date,omitempty"`
+	DueDate     *ExtractedDate   `json:"due_date,omitempty"`
+	VendorName  *ExtractedText   `json:"vendor_name,omitempty"`
+	TextPreview string           `json:"text_preview"`
+}
+
+// currencySymbol maps a currency symbol to the ISO code it most likely stands for
+type currencySymbol struct {
+	symbol     string
+	code       string
+	confidence float64
+}
+
+// extractionLabel is a label that marks the line holding a field, with the confidence it carries
+type extractionLabel struct {
+	pattern    *regexp.Regexp
+	confidence float64
+}
+
+var (
+	totalLabels = []extractionLabel{
+		{regexp.MustCompile(`(?i)\b(amount due|balance due|total due|grand total|total amount|amount payable|invoice total|total payable)\b`), 0.9},
+		{regexp.MustCompile(`(?i)(^|[^a-z])total\b`), 0.7},
+	}
+	invoiceDateLabels = []extractionLabel{
+		{regexp.MustCompile(`(?i)\b(invoice date|date of issue|issue date|issued on|billing date)\b`), 0.9},
+		{regexp.MustCompile(`(?i)(^|[^a-z])date\b`), 0.6},
+	}
+	dueDateLabels = []extractionLabel{
+		{regexp.MustCompile(`(?i)\b(due date|payment due|due by|due on|pay by)\b`), 0.9},
+	}
+	vendorLabels = []extractionLabel{
+		{regexp.MustCompile(`(?i)^\s*(from|bill from|sold by|vendor|supplier|seller)\s*:`), 0.8},
+	}
+	customerLabelPattern = regexp.MustCompile(`(?i)^\s*(bill(ed)? to|ship to|sold to|customer|invoice to)\b`)
+	subtotalPattern      = regexp.MustCompile(`(?i)\bsub[- ]?total\b`)
+	companySuffixPattern = regexp.MustCompile(`(?i)\b(inc|llc|ltd|limited|gmbh|corp|corporation|co|company|plc|pty|s\.a|b\.v|ag|kk)\b\.?`)
+
+	amountPattern = regexp.MustCompile(`-?\d{1,3}(?:[,.']\d{3})+(?:[.,]\d{1,2})?|-?\d+(?:[.,]\d{1,2})?`)
+
+	isoCurrencyPattern = regexp.MustCompile(`\b(USD|EUR|GBP|JPY|CNY|RMB|HKD|CAD|AUD|SGD|CHF|INR|KRW|TWD|NZD|SEK|NOK|DKK|MXN|BRL|THB|MYR|PHP|IDR|VND|ZAR|AED)\b`)
+	currencySymbols    = []currencySymbol{
+		{"US$", "USD", 0.8},
+		{"HK$", "HKD", 0.8},
+		{"NT$", "TWD", 0.8},
+		{"CA$", "CAD", 0.8},
+		{"C$", "CAD", 0.7},
+		{"A$", "AUD", 0.7},
+		{"S$", "SGD", 0.7},
+		{"€", "EUR", 0.8},
+		{"£", "GBP", 0.8},
+		{"₹", "INR", 0.8},
+		{"₩", "KRW", 0.8},
+		{"¥", "JPY", 0.4},
+		{"$", "USD", 0.5},
+	}
+
+	monthNames   = `(Jan(?:uary)?|Feb(?:ruary)?|Mar(?:ch)?|Apr(?:il)?|May|June?|July?|Aug(?:ust)?|Sep(?:t(?:ember)?)?|Oct(?:ober)?|Nov(?:ember)?|Dec(?:ember)?)`
+	isoDate      = regexp.MustCompile(`\b(\d{4})[-/.](\d{1,2})[-/.](\d{1,2})\b`)
+	numericDate  = regexp.MustCompile(`\b(\d{1,2})([/.-])(\d{1,2})[/.-](\d{4})\b`)
+	monthDayDate = regexp.MustCompile(`(?i)\b` + monthNames + `\.?\s+(\d{1,2})(?:st|nd|rd|th)?,?\s+(\d{4})\b`)
+	dayMonthDate = regexp.MustCompile(`(?i)\b(\d{1,2})(?:st|nd|rd|th)?\s+` + monthNames + `\.?,?\s+(\d{4})\b`)
+)
+
+// extractInvoiceFields applies label and pattern heuristics to the text of an invoice
+func extractInvoiceFields(text string) *ExtractedInvoice {
+	lines := strings.Split(text, "\n")
+
+	result := &ExtractedInvoice{TextPreview: text}
+	if runes := []rune(text); len(runes) > extractionPreviewLength {
+		result.TextPreview = string(runes[:extractionPreviewLength])
+	}
+
+	var totalLine string
+	result.TotalAmount, totalLine = extractTotal(lines)
+	result.Currency = extractCurrency(text, totalLine)
+	result.InvoiceDate = extractLabeledDate(lines, invoiceDateLabels, dueDateLabels)
+	result.DueDate = extractLabeledDate(lines, dueDateLabels, nil)
+	if result.InvoiceDate == nil {
+		// Fall back to the first date in the document that isn't the due date
+		for _, line := range lines {
+			if matchesAnyLabel(line, dueDateLabels) {
+				continue
+			}
+			if dates := findDates(line); len(dates) > 0 {
+				result.InvoiceDate = &ExtractedDate{Value: dates[0], Confidence: 0.4, Source: line}
+				break
+			}
+		}
+	}
+	result.VendorName = extractVendor(lines)
+
+	return result
+}
+
+// extractTotal finds the invoice total. Labeled totals win over the largest amount in the document;
+// among lines with the same label strength the largest amount is used, so tax or shipping totals
+// don't shadow the grand total. It also returns the line the amount was found on.
+func extractTotal(lines []string) (*ExtractedAmount, string) {
+	for _, label := range totalLabels {
+		var best *ExtractedAmount
+		var bestLine string
+		for i, line := range lines {
+			loc := label.pattern.FindStringIndex(line)
+			if loc == nil || subtotalPattern.MatchString(line) {
+				continue
+			}
+
+			source := line
+			amounts := findAmounts(line[loc[1]:])
+			if len(amounts) == 0 && i+1 < len(lines) {
+				// Tables often put the value on the line below the label
+				source = lines[i+1]
+				amounts = findAmounts(source)
+			}
+			if len(amounts) == 0 {
+				continue
+			}
+
+			amount := amounts[len(amounts)-1]
+			if best == nil || amount > best.Value {
+				best = &ExtractedAmount{Value: amount, Confidence: label.confidence, Source: source}
+				bestLine = source
+			}
+		}
+		if best != nil {
+			return best, bestLine
+		}
+	}
+
+	var best *ExtractedAmount
+	for _, line := range lines {
+		if len(findDates(line)) > 0 {
+			continue
+		}
+		for _, amount := range findAmounts(line) {
+			if best == nil || amount > best.Value {
+				best = &ExtractedAmount{Value: amount, Confidence: 0.3, Source: line}
+			}
+		}
+	}
+	if best == nil {
+		return nil, ""
+	}
+	return best, best.Source
+}
+
+// findAmounts returns the monetary-looking numbers on a line. Bare integers are only accepted
+// when they are not part of a date or identifier, i.e. they carry decimals or thousand separators
+// or sit next to a currency marker.
+func findAmounts(line string) []float64 {
+	var amounts []float64
+	for _, loc := range amountPattern.FindAllStringIndex(line, -1) {
+		raw := line[loc[0]:loc[1]]
+		if loc[0] > 0 && isIdentifierChar(line[loc[0]-1]) || loc[1] < len(line) && isIdentifierChar(line[loc[1]]) {
+			continue
+		}
+		if !strings.ContainsAny(raw, ".,'") && !hasCurrencyMarker(line, loc[0]) {
+			continue
+		}
+		if value, ok := parseAmount(raw); ok && value > 0 {
+			amounts = append(amounts, value)
+		}
+	}
+	return amounts
+}
+
+// isIdentifierChar reports whether a character adjacent to a number makes it part of a code or date
+func isIdentifierChar(c byte) bool {
+	return c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c == '#' || c == '/' || c == '-' || c == '_'
+}
+
+// hasCurrencyMarker reports whether a currency symbol or code directly precedes position pos
+func hasCurrencyMarker(line string, pos int) bool {
+	prefix := strings.TrimSpace(line[:pos])
+	if isoCurrencyPattern.MatchString(lastWord(prefix)) {
+		return true
+	}
+	for _, symbol := range currencySymbols {
+		if strings.HasSuffix(prefix, symbol.symbol) {
+			return true
+		}
+	}
+	return false
+}
+
+func lastWord(s string) string {
+	if i := strings.LastIndexAny(s, " \t"); i >= 0 {
+		return s[i+1:]
+	}
+	return s
+}
+
+// parseAmount parses a number written with either "," or "." as the decimal separator
+func parseAmount(raw string) (float64, bool) {
+	raw = strings.ReplaceAll(raw, "'", "")
+
+	// The last separator is the decimal separator when it is followed by one or two digits
+	decimal := strings.LastIndexAny(raw, ".,")
+	if decimal >= 0 && len(raw)-decimal-1 <= 2 {
+		integer := strings.NewReplacer(",", "", ".", "").Replace(raw[:decimal])
+		raw = integer + "." + raw[decimal+1:]
+	} else {
+		raw = strings.NewReplacer(",", "", ".", "").Replace(raw)
+	}
+
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil || math.IsInf(value, 0) {
+		return 0, false
+	}
+	return math.Round(value*100) / 100, true
+}
+
+// extractCurrency prefers a currency code or symbol on the total line, then the most frequent
+// ISO code in the document, then the most specific currency symbol found anywhere
+func extractCurrency(text, totalLine string) *ExtractedText {
+	if code := isoCurrencyPattern.FindString(totalLine); code != "" {
+		return &ExtractedText{Value: normalizeCurrencyCode(code), Confidence: 0.9, Source: totalLine}
+	}
+	if symbol, ok := findCurrencySymbol(totalLine); ok {
+		return &ExtractedText{Value: symbol.code, Confidence: math.Min(symbol.confidence+0.1, 0.9), Source: totalLine}
+	}
+
+	counts := make(map[string]int)
+	for _, code := range isoCurrencyPattern.FindAllString(text, -1) {
+		counts[normalizeCurrencyCode(code)]++
+	}
+	if len(counts) > 0 {
+		codes := make([]string, 0, len(counts))
+		for code := range counts {
+			codes = append(codes, code)
+		}
+		sort.Slice(codes, func(i, j int) bool {
+			if counts[codes[i]] != counts[codes[j]] {
+				return counts[codes[i]] > counts[codes[j]]
+			}
+			return codes[i] < codes[j]
+		})
+		return &ExtractedText{Value: codes[0], Confidence: 0.7, Source: codes[0]}
+	}
+
+	if symbol, ok := findCurrencySymbol(text); ok {
+		return &ExtractedText{Value: symbol.code, Confidence: symbol.confidence, Source: symbol.symbol}
+	}
+	return nil
+}
+
+// findCurrencySymbol returns the most specific currency symbol in s (e.g. HK$ before $)
+func findCurrencySymbol(s string) (currencySymbol, bool) {
+	for _, symbol := range currencySymbols {
+		if strings.Contains(s, symbol.symbol) {
+			return symbol, true
+		}
+	}
+	return currencySymbol{}, false
+}
+
+func normalizeCurrencyCode(code string) string {
+	if code == "RMB" {
+		return "CNY"
+	}
+	return code
+}
+
+// extractLabeledDate returns the first date found on (or right below) a labeled line.
+// Lines matching any of the excluded labels are skipped.
+func extractLabeledDate(lines []string, labels, exclude []extractionLabel) *ExtractedDate {
+	for _, label := range labels {
+		for i, line := range lines {
+			loc := label.pattern.FindStringIndex(line)
+			if loc == nil || matchesAnyLabel(line, exclude) {
+				continue
+			}
+
+			source := line
+			dates := findDates(line[loc[1]:])
+			if len(dates) == 0 && i+1 < len(lines) {
+				source = lines[i+1]
+				dates = findDates(source)
+			}
+			if len(dates) > 0 {
+				return &ExtractedDate{Value: dates[0], Confidence: label.confidence, Source: source}
+			}
+		}
+	}
+	return nil
+}
+
+func matchesAnyLabel(line string, labels []extractionLabel) bool {
+	for _, label := range labels {
+		if label.pattern.MatchString(line) {
+			return true
+		}
+	}
+	return false
+}
+
+// findDates returns the dates on a line in order of appearance.
+// Numeric dates are read as month/day unless the first number can only be a day;
+// dotted dates (31.12.2024) are read as day.month.
+func findDates(line string) []time.Time {
+	type found struct {
+		pos  int
+		date time.Time
+	}
+	var dates []found
+	add := func(pos, year, month, day int) {
+		if month < 1 || month > 12 || day < 1 || day > 31 || year < 1900 || year > 2200 {
+			return
+		}
+		date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+		if date.Day() != day {
+			return
+		}
+		dates = append(dates, found{pos: pos, date: date})
+	}
+
+	for _, m := range isoDate.FindAllStringSubmatchIndex(line, -1) {
+		add(m[0], atoi(line[m[2]:m[3]]), atoi(line[m[4]:m[5]]), atoi(line[m[6]:m[7]]))
+	}
+	for _, m := range numericDate.FindAllStringSubmatchIndex(line, -1) {
+		first, second, year := atoi(line[m[2]:m[3]]), atoi(line[m[6]:m[7]]), atoi(line[m[8]:m[9]])
+		if line[m[4]:m[5]] == "." || first > 12 {
+			add(m[0], year, second, first)
+		} else {
+			add(m[0], year, first, second)
+		}
+	}
+	for _, m := range monthDayDate.FindAllStringSubmatchIndex(line, -1) {
+		add(m[0], atoi(line[m[6]:m[7]]), parseMonth(line[m[2]:m[3]]), atoi(line[m[4]:m[5]]))
+	}
+	for _, m := range dayMonthDate.FindAllStringSubmatchIndex(line, -1) {
+		add(m[0], atoi(line[m[6]:m[7]]), parseMonth(line[m[4]:m[5]]), atoi(line[m[2]:m[3]]))
+	}
+
+	sort.SliceStable(dates, func(i, j int) bool { return dates[i].pos < dates[j].pos })
+	result := make([]time.Time, len(dates))
+	for i, d := range dates {
+		result[i] = d.date
+	}
+	return result
+}
+
+func atoi(s string) int {
+	value, _ := strconv.Atoi(s)
+	return value
+}
+
+// parseMonth converts an English month name or abbreviation to its number
+func parseMonth(name string) int {
+	prefix := strings.ToLower(name)
+	if len(prefix) > 3 {
+		prefix = prefix[:3]
+	}
+	for month := time.January; month <= time.December; month++ {
+		if strings.ToLower(month.String()[:3]) == prefix {
+			return int(month)
+		}
+	}
+	return 0
+}
+
+// extractVendor looks for an explicit "From:" style label, then a line with a company suffix near
+// the top of the document, then falls back to the first line that reads like a name.
+// Lines in a "Bill to" block are skipped since they name the customer, not the vendor.
+func extractVendor(lines []string) *ExtractedText {
+	for _, label := range vendorLabels {
+		for i, line := range lines {
+			loc := label.pattern.FindStringIndex(line)
+			if loc == nil {
+				continue
+			}
+			name := strings.TrimSpace(line[loc[1]:])
+			if name == "" && i+1 < len(lines) {
+				name = strings.TrimSpace(lines[i+1])
+			}
+			if name != "" {
+				return &ExtractedText{Value: name, Confidence: label.confidence, Source: line}
+			}
+		}
+	}
+
+	const headerLines = 15
+	customerBlock := 0
+	var fallback *ExtractedText
+	for i, line := range lines {
+		if i >= headerLines {
+			break
+		}
+		if customerLabelPattern.MatchString(line) {
+			customerBlock = 4
+			continue
+		}
+		if customerBlock > 0 {
+			customerBlock--
+			continue
+		}
+		if !looksLikeName(line) {
+			continue
+		}
+		if companySuffixPattern.MatchString(line) {
+			return &ExtractedText{Value: line, Confidence: 0.6, Source: line}
+		}
+		if fallback == nil {
+			fallback = &ExtractedText{Value: line, Confidence: 0.3, Source: line}
+		}
+	}
+	return fallback
+}
+
+var nonNameWords = regexp.MustCompile(`(?i)\b(invoice|receipt|statement|page|date|total|amount|due|tax|vat|no\.|number|qty|quantity|description|price)\b`)
+
+// looksLikeName reports whether a line could be a business name rather than a heading or value
+func looksLikeName(line string) bool {
+	if len(line) < 2 || len(line) > 80 || nonNameWords.MatchString(line) || strings.Contains(line, "@") {
+		return false
+	}
+	letters, digits := 0, 0
+	for _, r := range line {
+		switch {
+		case r >= '0' && r <= '9':
+			digits++
+		case r > ' ' && !strings.ContainsRune(".,:;#-/()", r):
+			letters++
+		}
+	}
+	return letters >= 2 && digits*2 < letters
+}
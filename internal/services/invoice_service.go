@@ -2,11 +2,24 @@ package services
 
 import (
 	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
 
+	"github.com/rxtech-lab/invoice-management/internal/metrics"
 	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // CreateInvoiceResult contains the result of creating an invoice
@@ -16,21 +29,367 @@ type CreateInvoiceResult struct {
 	Message     string
 }
 
+// ErrInvoiceNotFound is returned when an invoice lookup matches nothing
+var ErrInvoiceNotFound = errors.New("invoice not found")
+
+// ErrAmbiguousInvoiceNumber is returned when an invoice number matches more than one invoice
+var ErrAmbiguousInvoiceNumber = errors.New("ambiguous invoice number")
+
+// DuplicateInvoiceError is returned when an invoice would duplicate an existing one
+type DuplicateInvoiceError struct {
+	Invoice *models.Invoice
+}
+
+func (e *DuplicateInvoiceError) Error() string {
+	return fmt.Sprintf("duplicate invoice found with matching amount, dates, and receiver (id %d)", e.Invoice.ID)
+}
+
+// VersionConflictError is returned when an invoice update is based on a version other than the
+// current one, i.e. the invoice was changed since the caller read it
+type VersionConflictError struct {
+	InvoiceID       uint
+	ExpectedVersion int
+	CurrentVersion  int
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("invoice %d was modified concurrently: expected version %d, current version is %d",
+		e.InvoiceID, e.ExpectedVersion, e.CurrentVersion)
+}
+
+// CloneOptions contains field overrides applied when cloning an invoice or creating one from a
+// template. Nil fields keep the value of the source invoice or template (status defaults to unpaid)
+type CloneOptions struct {
+	Title            *string
+	Status           *models.InvoiceStatus
+	InvoiceStartedAt *time.Time
+	InvoiceEndedAt   *time.Time
+	DueDate          *time.Time
+	// TargetCurrency re-bills the clone in another currency: item unit prices and fixed
+	// discounts are converted through the FX service, so the raw item amounts change rather
+	// than just being relabeled. Ignored by CreateFromTemplate.
+	TargetCurrency *string
+}
+
 // InvoiceListOptions contains options for listing invoices
 type InvoiceListOptions struct {
-	Keyword    string
-	CategoryID *uint
-	CompanyID  *uint
-	ReceiverID *uint
-	Status     *models.InvoiceStatus
-	Tags       []string // Deprecated: use TagIDs instead
-	TagIDs     []uint   // Filter by tag IDs
-	StartDate  *time.Time
-	EndDate    *time.Time
-	SortBy     string // "created_at", "amount", "due_date", "title"
-	SortOrder  string // "asc", "desc"
-	Limit      int
-	Offset     int
+	Keyword        string
+	ExcludeKeyword string // Drops invoices whose title or description contains it (ANDed with Keyword)
+	CategoryID     *uint
+	CompanyID      *uint
+	ReceiverID     *uint
+	Status         *models.InvoiceStatus
+	// PaymentMethod filters by exact payment method; an empty string matches invoices without one
+	PaymentMethod *string
+	Tags          []string // Deprecated: use TagIDs instead
+	TagIDs        []uint   // Filter by tag IDs
+	TagMatch      string   // "any" (default) or "all": whether invoices need any or all of TagIDs
+	StartDate     *time.Time
+	EndDate       *time.Time
+	DueAfter      *time.Time // Inclusive; invoices without a due date are excluded when set
+	DueBefore     *time.Time // Inclusive; invoices without a due date are excluded when set
+	IncludeDrafts bool       // Draft invoices are left out unless set
+	SortBy        string     // "created_at", "updated_at", "amount", "due_date", "title", or several comma-separated
+	SortOrder     string     // "asc", "desc"
+	Limit         int
+	Offset        int
+
+	// OrganizationID narrows the invoices the user may see to those of one organization
+	OrganizationID *uint
+
+	// Amount range filtering with inclusive bounds. AmountField selects the
+	// compared field: "target_amount" (default; base currency, so cross-currency
+	// comparisons are fair) or "amount" (raw amount in the invoice currency).
+	MinAmount   *float64
+	MaxAmount   *float64
+	AmountField string
+
+	// Cursor-based (keyset) pagination. Cursor mode is enabled when Cursor or
+	// CursorDirection is set; results are then ordered by (created_at, id) and
+	// Offset, SortBy, and SortOrder are ignored.
+	Cursor          string // Opaque cursor returned as the next cursor of the previous page
+	CursorDirection string // "desc" (default), "asc"
+
+	// Load selects the relations preloaded with each invoice; nil loads all of them
+	Load *InvoiceLoadOptions
+}
+
+// InvoiceLoadOptions selects which relations are preloaded with an invoice.
+// The zero value loads none; AllInvoiceRelations loads every relation.
+type InvoiceLoadOptions struct {
+	Category    bool
+	Company     bool
+	Receiver    bool
+	Items       bool
+	Tags        bool
+	Attachments bool
+}
+
+// AllInvoiceRelations returns load options that preload every invoice relation
+func AllInvoiceRelations() InvoiceLoadOptions {
+	return InvoiceLoadOptions{
+		Category:    true,
+		Company:     true,
+		Receiver:    true,
+		Items:       true,
+		Tags:        true,
+		Attachments: true,
+	}
+}
+
+// ParseInvoiceLoadOptions parses a comma-separated list of relation names
+// (category, company, receiver, items, tags, attachments) into load options.
+// An empty list loads no relations.
+func ParseInvoiceLoadOptions(expand string) (InvoiceLoadOptions, error) {
+	var load InvoiceLoadOptions
+	for _, name := range strings.Split(expand, ",") {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "":
+		case "category":
+			load.Category = true
+		case "company":
+			load.Company = true
+		case "receiver":
+			load.Receiver = true
+		case "items":
+			load.Items = true
+		case "tags":
+			load.Tags = true
+		case "attachments":
+			load.Attachments = true
+		default:
+			return InvoiceLoadOptions{}, fmt.Errorf("unknown invoice relation %q: must be one of category, company, receiver, items, tags, attachments", strings.TrimSpace(name))
+		}
+	}
+	return load, nil
+}
+
+// ParseInvoiceSort turns a comma-separated list of sort fields (created_at, updated_at, amount,
+// due_date, title; e.g. "amount,created_at") into an ORDER BY clause sorting by each field in
+// turn, all in sortOrder ("asc", else descending). An empty list sorts by created_at. "id DESC"
+// always comes last, so invoices with equal sort values keep the same order from page to page.
+func ParseInvoiceSort(sortBy, sortOrder string) (string, error) {
+	direction := "DESC"
+	if sortOrder == "asc" {
+		direction = "ASC"
+	}
+
+	var keys []string
+	seen := make(map[string]bool)
+	for _, name := range strings.Split(sortBy, ",") {
+		field := strings.ToLower(strings.TrimSpace(name))
+		if field == "" {
+			continue
+		}
+		switch field {
+		case "created_at", "updated_at", "amount", "due_date", "title":
+		default:
+			return "", fmt.Errorf("unknown sort field %q: must be one of created_at, updated_at, amount, due_date, title", strings.TrimSpace(name))
+		}
+		if seen[field] {
+			return "", fmt.Errorf("sort field %q is listed more than once", field)
+		}
+		seen[field] = true
+		keys = append(keys, field+" "+direction)
+	}
+	if len(keys) == 0 {
+		keys = append(keys, "created_at "+direction)
+	}
+	return strings.Join(append(keys, "id DESC"), ", "), nil
+}
+
+// preload adds the selected relations to query
+func (o InvoiceLoadOptions) preload(query *gorm.DB) *gorm.DB {
+	if o.Category {
+		query = query.Preload("Category")
+	}
+	if o.Company {
+		query = query.Preload("Company")
+	}
+	if o.Receiver {
+		query = query.Preload("Receiver")
+	}
+	if o.Items {
+		query = query.Preload("Items", orderItemsByPosition)
+	}
+	if o.Tags {
+		query = query.Preload("Tags")
+	}
+	if o.Attachments {
+		query = query.Preload("Attachments")
+	}
+	return query
+}
+
+// Tag match modes for InvoiceListOptions.TagMatch and StatisticsOptions.TagMatch
+const (
+	TagMatchAny = "any"
+	TagMatchAll = "all"
+)
+
+// Amount fields that can be filtered on with InvoiceListOptions.MinAmount/MaxAmount
+const (
+	AmountFieldTargetAmount = "target_amount"
+	AmountFieldAmount       = "amount"
+)
+
+// InvoicePage is a page of invoices together with aggregates over all rows matching the filters
+type InvoicePage struct {
+	Invoices []models.Invoice
+	Total    int64
+
+	// TotalAmount sums the raw invoice amounts in their own currencies, so it is only
+	// meaningful when the filtered invoices share a currency. TotalTargetAmount sums the
+	// item target amounts in the user's base currency.
+	TotalAmount       float64
+	TotalTargetAmount float64
+
+	NextCursor string // Cursor for the next page; only set in cursor mode when more rows exist
+}
+
+// TotalsRecalculation is an invoice's amount (in the invoice currency) and target amount
+// (the sum of its item target amounts, in the base currency) before and after RecalculateTotals
+type TotalsRecalculation struct {
+	InvoiceID          uint    `json:"invoice_id"`
+	AmountBefore       float64 `json:"amount_before"`
+	AmountAfter        float64 `json:"amount_after"`
+	TargetAmountBefore float64 `json:"target_amount_before"`
+	TargetAmountAfter  float64 `json:"target_amount_after"`
+}
+
+// TotalExplanation shows how an invoice's amount and base currency total derive from its items,
+// as returned by ExplainTotal. Mismatches describes every stored value that differs from the one
+// recomputed from the items; RecalculateTotals repairs them.
+type TotalExplanation struct {
+	InvoiceID     uint                `json:"invoice_id"`
+	Currency      string              `json:"currency"`
+	DiscountType  models.DiscountType `json:"discount_type,omitempty"`
+	DiscountValue float64             `json:"discount_value,omitempty"`
+	// Amount is the stored invoice amount and ExpectedAmount the item amounts summed less the
+	// invoice discount, both in the invoice currency
+	Amount         float64 `json:"amount"`
+	ExpectedAmount float64 `json:"expected_amount"`
+	// TargetCurrency is the user's base currency; TotalTargetAmount sums the item target amounts
+	TargetCurrency    string            `json:"target_currency"`
+	TotalTargetAmount float64           `json:"total_target_amount"`
+	Items             []ItemExplanation `json:"items"`
+	HasManualOverride bool              `json:"has_manual_override"`
+	HasStaleRate      bool              `json:"has_stale_rate"`
+	Mismatches        []string          `json:"mismatches"`
+}
+
+// ItemExplanation is one item's conversion to the base currency in a TotalExplanation.
+// ExpectedTargetAmount is Amount at FXRateUsed with the item's share of the invoice discount.
+type ItemExplanation struct {
+	ItemID               uint    `json:"item_id"`
+	Description          string  `json:"description"`
+	Amount               float64 `json:"amount"`
+	Currency             string  `json:"currency"`
+	FXRateUsed           float64 `json:"fx_rate_used"`
+	FXRateDate           string  `json:"fx_rate_date,omitempty"`
+	TargetCurrency       string  `json:"target_currency"`
+	TargetAmount         float64 `json:"target_amount"`
+	ExpectedTargetAmount float64 `json:"expected_target_amount"`
+	Manual               bool    `json:"manual"`
+	Stale                bool    `json:"stale"`
+}
+
+// InvoiceFacets lists the distinct values of a user's invoices that filters can choose from,
+// each with the number of invoices having it
+type InvoiceFacets struct {
+	Currencies []FacetValue  `json:"currencies"`
+	Statuses   []FacetValue  `json:"statuses"`
+	Categories []FacetEntity `json:"categories"`
+	Companies  []FacetEntity `json:"companies"`
+	Receivers  []FacetEntity `json:"receivers"`
+}
+
+// FacetValue is a distinct column value and its invoice count
+type FacetValue struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+// FacetEntity is a category, company, or receiver and its invoice count
+type FacetEntity struct {
+	ID    uint   `json:"id"`
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
+
+// CleanupReport counts the broken relations of a user's data found (and, unless DryRun,
+// repaired) by CleanupOrphans
+type CleanupReport struct {
+	DryRun bool `json:"dry_run"`
+	// OrphanTagMappings are tag mappings whose invoice no longer exists (deleted)
+	OrphanTagMappings int64 `json:"orphan_tag_mappings"`
+	// OrphanItems are items left behind on deleted invoices (deleted)
+	OrphanItems int64 `json:"orphan_items"`
+	// MissingCategories, MissingCompanies, and MissingReceivers are invoices referencing a
+	// category, company, or receiver that no longer exists (the reference is cleared)
+	MissingCategories int64 `json:"missing_categories"`
+	MissingCompanies  int64 `json:"missing_companies"`
+	MissingReceivers  int64 `json:"missing_receivers"`
+}
+
+// Changed reports whether recalculating corrected either total
+func (r TotalsRecalculation) Changed() bool {
+	return r.AmountBefore != r.AmountAfter || r.TargetAmountBefore != r.TargetAmountAfter
+}
+
+// IncompleteFilter selects which missing links ListIncomplete looks for.
+// Selected conditions are combined with OR; at least one must be set.
+type IncompleteFilter struct {
+	MissingCategory bool
+	MissingCompany  bool
+	MissingReceiver bool
+}
+
+// invoiceCursor is the decoded form of a keyset pagination cursor
+type invoiceCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        uint      `json:"id"`
+}
+
+// encodeInvoiceCursor encodes the position of an invoice as an opaque cursor
+func encodeInvoiceCursor(invoice *models.Invoice) string {
+	data, _ := json.Marshal(invoiceCursor{CreatedAt: invoice.CreatedAt, ID: invoice.ID})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeInvoiceCursor decodes an opaque cursor produced by encodeInvoiceCursor
+func decodeInvoiceCursor(cursor string) (*invoiceCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+	var decoded invoiceCursor
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+	return &decoded, nil
+}
+
+// searchSnippetContext is the number of characters kept on either side of a match in search snippets
+const searchSnippetContext = 40
+
+// Fields reported in InvoiceSearchResult.MatchedFields
+const (
+	SearchFieldTitle       = "title"
+	SearchFieldDescription = "description"
+	SearchFieldCategory    = "category"
+	SearchFieldCompany     = "company"
+	SearchFieldReceiver    = "receiver"
+)
+
+// InvoiceSearchResult is an invoice found by SearchInvoices. With highlighting, it also reports
+// which fields matched and snippets of the matching title and description.
+type InvoiceSearchResult struct {
+	models.Invoice
+	MatchedFields []string `json:"matched_fields,omitempty"`
+	// Snippets maps title/description to the text around the match, with matches wrapped in
+	// utils.HighlightStart and utils.HighlightEnd
+	Snippets map[string]string `json:"snippets,omitempty"`
 }
 
 // InvoiceService handles invoice business logic
@@ -38,35 +397,100 @@ type InvoiceService interface {
 	// Invoice CRUD
 	CreateInvoice(userID string, invoice *models.Invoice) (*CreateInvoiceResult, error)
 	GetInvoiceByID(userID string, id uint) (*models.Invoice, error)
+	GetInvoiceByIDWithOptions(userID string, id uint, load InvoiceLoadOptions) (*models.Invoice, error)
+	GetByNumber(userID string, number string) (*models.Invoice, error)
+	GetByOriginalLink(userID string, link string) ([]models.Invoice, error)
 	ListInvoices(userID string, opts InvoiceListOptions) ([]models.Invoice, int64, error)
+	ListInvoicesWithCursor(userID string, opts InvoiceListOptions) ([]models.Invoice, int64, string, error)
+	ListInvoicesPage(userID string, opts InvoiceListOptions) (*InvoicePage, error)
 	UpdateInvoice(userID string, invoice *models.Invoice) error
 	DeleteInvoice(userID string, id uint) error
-	SearchInvoices(userID string, query string) ([]models.Invoice, error)
+	SearchInvoices(userID string, query string, highlight bool) ([]InvoiceSearchResult, error)
+	CloneInvoice(userID string, id uint, overrides CloneOptions) (*models.Invoice, error)
+	CreateFromTemplate(userID string, templateID uint, overrides CloneOptions) (*CreateInvoiceResult, error)
+	ListIncomplete(userID string, missing IncompleteFilter) ([]models.Invoice, error)
+	ListPaymentMethods(userID string) ([]string, error)
+	GetFacets(userID string) (*InvoiceFacets, error)
+	CountByStatus(userID string) (map[models.InvoiceStatus]int64, error)
+	FindSimilar(userID string, id uint, limit int) ([]models.Invoice, error)
+	// Reconcile proposes the invoices the lines of a bank statement pay, without changing anything
+	Reconcile(userID string, lines []StatementLine) (*ReconcileResult, error)
 
 	// Invoice Items
 	AddInvoiceItem(userID string, invoiceID uint, item *models.InvoiceItem) error
+	AddInvoiceItems(userID string, invoiceID uint, items []models.InvoiceItem) error
 	UpdateInvoiceItem(userID string, itemID uint, item *models.InvoiceItem, targetAmountOverride *float64, forceRecalculate bool) error
 	DeleteInvoiceItem(userID string, itemID uint) error
 	GetInvoiceItem(userID string, itemID uint) (*models.InvoiceItem, error)
+	ReorderItems(userID string, invoiceID uint, orderedItemIDs []uint) error
 
 	// Status management
 	UpdateInvoiceStatus(userID string, id uint, status models.InvoiceStatus) error
+	FinalizeInvoice(userID string, id uint) error
+	// ImportCSV creates invoices from the rows of a CSV file in the invoices.csv export format,
+	// reporting the outcome of each row
+	ImportCSV(userID string, r io.Reader, createMissing bool) (*CSVImportResult, error)
+	// BatchCreateInvoices creates invoices in one transaction, converting them at exchange rates
+	// resolved once per currency, and reports the outcome of each invoice
+	BatchCreateInvoices(userID string, invoices []*models.Invoice) (*BatchCreateResult, error)
 	GetOverdueInvoices(userID string) ([]models.Invoice, error)
 
+	// Invoice links
+	LinkInvoices(userID string, invoiceID, relatedInvoiceID uint, relationType models.InvoiceRelationType) error
+
+	// Maintenance
+	RecalculateTotals(userID string, invoiceID uint) (*TotalsRecalculation, error)
+	RecalculateAllTotals(userID string) ([]TotalsRecalculation, error)
+	SaveSettings(userID string, settings *models.UserSettings) ([]TotalsRecalculation, error)
+	RefreshFXForCurrency(userID string, currency string, includeOverrides bool) (int64, error)
+	ClearTargetOverrides(userID string, invoiceID uint) error
+	ClearItemTargetOverride(userID string, itemID uint) error
+	ExplainTotal(userID string, invoiceID uint) (*TotalExplanation, error)
+	CleanupOrphans(userID string, dryRun bool) (*CleanupReport, error)
+
+	// Audit trail
+	GetAuditTrail(userID string, invoiceID uint, opts AuditTrailOptions) ([]models.AuditLog, int64, error)
+	GetStatusHistory(userID string, invoiceID uint, opts AuditTrailOptions) ([]models.AuditLog, int64, error)
+
+	// Currency
+	PreviewCurrencyChange(ctx context.Context, userID string, invoiceID uint, currency string) (*ConversionPreview, error)
+
 	// Tag management
 	SetInvoiceTags(userID string, invoiceID uint, tagNames []string) error
 	SetInvoiceTagsByID(userID string, invoiceID uint, tagIDs []int) error
+	BulkAddTags(userID string, opts InvoiceListOptions, tagIDs []uint) (int64, error)
 }
 
 type invoiceService struct {
-	db        *gorm.DB
-	fxService FXService
+	db               *gorm.DB
+	fxService        FXService
+	settingsService  SettingsService
+	numberingService NumberingService
+	auditService     AuditService
 }
 
 // NewInvoiceService creates a new InvoiceService instance
 // fxService can be nil (currency conversion will default to 1:1)
 func NewInvoiceService(db *gorm.DB, fxService FXService) InvoiceService {
-	return &invoiceService{db: db, fxService: fxService}
+	return &invoiceService{
+		db:               db,
+		fxService:        fxService,
+		settingsService:  NewSettingsService(db),
+		numberingService: NewNumberingService(),
+		auditService:     NewAuditService(db),
+	}
+}
+
+// withTx returns a copy of the service whose queries, settings lookups, and audit entries all run
+// in tx
+func (s *invoiceService) withTx(tx *gorm.DB) *invoiceService {
+	return &invoiceService{
+		db:               tx,
+		fxService:        s.fxService,
+		settingsService:  NewSettingsService(tx),
+		numberingService: s.numberingService,
+		auditService:     NewAuditService(tx),
+	}
 }
 
 // CreateInvoice creates a new invoice with optional items
@@ -74,41 +498,67 @@ func NewInvoiceService(db *gorm.DB, fxService FXService) InvoiceService {
 // Returns existing invoice if a duplicate is found (same amount, dates, and receiver)
 func (s *invoiceService) CreateInvoice(userID string, invoice *models.Invoice) (*CreateInvoiceResult, error) {
 	invoice.UserID = userID
+	if err := s.assignOrganization(userID, invoice); err != nil {
+		return nil, err
+	}
+	if err := validateDiscount(invoice.DiscountType, invoice.DiscountValue); err != nil {
+		return nil, err
+	}
+	if err := normalizePaymentMethod(invoice); err != nil {
+		return nil, err
+	}
+	syncPaidAt(invoice, "")
+	s.applyCategoryDefaults(userID, invoice)
+	if err := validateTaxRate("tax_rate", invoice.TaxRate); err != nil {
+		return nil, err
+	}
+	if err := s.checkCurrencySupported(userID, invoice.Currency); err != nil {
+		return nil, err
+	}
 
 	// Calculate item amounts, target amounts, and totals
-	var totalAmount float64
+	baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
+	if err != nil {
+		return nil, err
+	}
+	limits := s.settingsService.GetItemLimits(userID)
 	for i := range invoice.Items {
+		if err := normalizeItemCurrency(&invoice.Items[i]); err != nil {
+			return nil, err
+		}
+		if err := s.checkCurrencySupported(userID, invoice.Items[i].Currency); err != nil {
+			return nil, fmt.Errorf("item %q: %w", invoice.Items[i].Description, err)
+		}
+		if err := s.checkItemCategory(userID, &invoice.Items[i]); err != nil {
+			return nil, fmt.Errorf("item %q: %w", invoice.Items[i].Description, err)
+		}
+		if err := normalizeItemUnit(&invoice.Items[i]); err != nil {
+			return nil, err
+		}
+		if err := validateDiscount(invoice.Items[i].DiscountType, invoice.Items[i].DiscountValue); err != nil {
+			return nil, fmt.Errorf("item %q: %w", invoice.Items[i].Description, err)
+		}
+		invoice.Items[i].Position = i
 		invoice.Items[i].CalculateAmount()
-		s.calculateItemTargetAmount(&invoice.Items[i], invoice.Currency)
-		totalAmount += invoice.Items[i].Amount
+		if err := s.calculateItemTargetAmount(userID, &invoice.Items[i], invoice.Currency, baseCurrency); err != nil {
+			return nil, err
+		}
+		if err := checkItemLimits(&invoice.Items[i], limits); err != nil {
+			return nil, fmt.Errorf("item %q: %w", invoice.Items[i].Description, err)
+		}
+	}
+	invoice.CalculateTotalFromItems()
+	invoice.AmountCurrencyMixed = invoice.HasMixedCurrencies()
+	if invoice.DiscountType != "" {
+		if err := s.applyInvoiceDiscount(invoice, invoice.Items); err != nil {
+			return nil, err
+		}
 	}
-	invoice.Amount = totalAmount
+	invoice.FXRateUsed = models.AverageFXRate(invoice.Items)
 
 	// Check for duplicate invoice
 	var existing models.Invoice
-	query := s.db.Where("user_id = ? AND amount = ?", userID, totalAmount)
-
-	// Handle nullable dates - null matches null
-	if invoice.InvoiceStartedAt != nil {
-		query = query.Where("invoice_started_at = ?", *invoice.InvoiceStartedAt)
-	} else {
-		query = query.Where("invoice_started_at IS NULL")
-	}
-
-	if invoice.InvoiceEndedAt != nil {
-		query = query.Where("invoice_ended_at = ?", *invoice.InvoiceEndedAt)
-	} else {
-		query = query.Where("invoice_ended_at IS NULL")
-	}
-
-	// Handle nullable receiver_id
-	if invoice.ReceiverID != nil {
-		query = query.Where("receiver_id = ?", *invoice.ReceiverID)
-	} else {
-		query = query.Where("receiver_id IS NULL")
-	}
-
-	err := query.Preload("Category").Preload("Company").Preload("Receiver").Preload("Items").Preload("Tags").First(&existing).Error
+	err = duplicateInvoiceQuery(s.db, userID, invoice).Preload("Category").Preload("Company").Preload("Receiver").Preload("Items", orderItemsByPosition).Preload("Tags").First(&existing).Error
 	if err == nil {
 		// Duplicate found - return existing invoice
 		return &CreateInvoiceResult{
@@ -118,10 +568,30 @@ func (s *invoiceService) CreateInvoice(userID string, invoice *models.Invoice) (
 		}, nil
 	}
 
-	// No duplicate found, create new invoice
-	if err := s.db.Create(invoice).Error; err != nil {
+	// No duplicate found, create new invoice.
+	// The number is reserved in the same transaction so failed creates leave no gaps.
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		if invoice.InvoiceNumber == "" {
+			number, err := s.numberingService.NextInvoiceNumber(tx, userID)
+			if err != nil {
+				return err
+			}
+			invoice.InvoiceNumber = number
+		}
+		return tx.Create(invoice).Error
+	}); err != nil {
 		return nil, err
 	}
+	metrics.InvoicesCreatedTotal.Inc()
+	s.auditService.Record(AuditEntry{
+		UserID:     userID,
+		ActorSub:   userID,
+		EntityType: models.AuditEntityInvoice,
+		EntityID:   invoice.ID,
+		InvoiceID:  invoice.ID,
+		Action:     models.AuditActionCreate,
+		After:      invoice,
+	})
 
 	return &CreateInvoiceResult{
 		Invoice:     invoice,
@@ -129,33 +599,40 @@ func (s *invoiceService) CreateInvoice(userID string, invoice *models.Invoice) (
 	}, nil
 }
 
-// GetInvoiceByID retrieves an invoice by ID with related data
-func (s *invoiceService) GetInvoiceByID(userID string, id uint) (*models.Invoice, error) {
-	var invoice models.Invoice
-	err := s.db.Where("id = ? AND user_id = ?", id, userID).
-		Preload("Category").
-		Preload("Company").
-		Preload("Receiver").
-		Preload("Items").
-		Preload("Tags").
-		First(&invoice).Error
+// assignOrganization puts a new invoice in the user's personal organization unless it names an
+// organization, which the user must then be allowed to create invoices in
+func (s *invoiceService) assignOrganization(userID string, invoice *models.Invoice) error {
+	if invoice.OrganizationID == nil {
+		organization, err := personalOrganization(s.db, userID)
+		if err != nil {
+			return err
+		}
+		invoice.OrganizationID = &organization.ID
+		return nil
+	}
+
+	role, err := organizationRole(s.db, userID, *invoice.OrganizationID)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return &invoice, nil
+	if !role.CanCreateInvoices() {
+		return fmt.Errorf("%s members can't create invoices in organization %d", role, *invoice.OrganizationID)
+	}
+	return nil
 }
 
-// ListInvoices lists invoices with filtering, sorting, and pagination
-func (s *invoiceService) ListInvoices(userID string, opts InvoiceListOptions) ([]models.Invoice, int64, error) {
-	var invoices []models.Invoice
-	var total int64
-
-	query := s.db.Model(&models.Invoice{}).Where("user_id = ?", userID)
-
+// applyInvoiceFilters narrows an invoice query by the filter fields of opts
+// (drafts, keyword and excluded keyword, relations, status, date range, tags, and amount range).
+// Sorting and pagination fields are ignored.
+func applyInvoiceFilters(query *gorm.DB, opts InvoiceListOptions) (*gorm.DB, error) {
 	// Apply filters
-	if opts.Keyword != "" {
-		searchPattern := "%" + opts.Keyword + "%"
-		query = query.Where("title LIKE ? OR description LIKE ?", searchPattern, searchPattern)
+	if !opts.IncludeDrafts {
+		query = query.Where("is_draft = ?", false)
+	}
+	query = keywordFilter(query, "", opts.Keyword, opts.ExcludeKeyword)
+
+	if opts.OrganizationID != nil {
+		query = query.Where("organization_id = ?", *opts.OrganizationID)
 	}
 
 	if opts.CategoryID != nil {
@@ -174,6 +651,10 @@ func (s *invoiceService) ListInvoices(userID string, opts InvoiceListOptions) ([
 		query = query.Where("status = ?", *opts.Status)
 	}
 
+	if opts.PaymentMethod != nil {
+		query = query.Where("COALESCE(payment_method, '') = ?", strings.TrimSpace(*opts.PaymentMethod))
+	}
+
 	if opts.StartDate != nil {
 		query = query.Where("created_at >= ?", *opts.StartDate)
 	}
@@ -182,318 +663,2228 @@ func (s *invoiceService) ListInvoices(userID string, opts InvoiceListOptions) ([
 		query = query.Where("created_at <= ?", *opts.EndDate)
 	}
 
-	// Filter by tag IDs using subquery
-	if len(opts.TagIDs) > 0 {
-		query = query.Where("id IN (SELECT invoice_id FROM invoice_tag_mappings WHERE invoice_tag_id IN ?)", opts.TagIDs)
+	if opts.DueAfter != nil {
+		query = query.Where("due_date >= ?", *opts.DueAfter)
 	}
 
-	// Get total count
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
+	if opts.DueBefore != nil {
+		query = query.Where("due_date <= ?", *opts.DueBefore)
 	}
 
-	// Apply sorting
-	sortBy := "created_at"
-	if opts.SortBy != "" {
-		switch opts.SortBy {
-		case "created_at", "amount", "due_date", "title":
-			sortBy = opts.SortBy
+	// Filter by tag IDs using subquery
+	if len(opts.TagIDs) > 0 {
+		condition, args, err := tagMatchCondition("id", opts.TagIDs, opts.TagMatch)
+		if err != nil {
+			return nil, err
 		}
+		query = query.Where(condition, args...)
 	}
 
-	sortOrder := "DESC"
-	if opts.SortOrder == "asc" {
-		sortOrder = "ASC"
+	// Filter by amount range (inclusive)
+	if opts.MinAmount != nil || opts.MaxAmount != nil {
+		var amountExpr string
+		switch opts.AmountField {
+		case "", AmountFieldTargetAmount:
+			amountExpr = itemTargetAmountSubquery
+		case AmountFieldAmount:
+			amountExpr = "amount"
+		default:
+			return nil, fmt.Errorf("invalid amount field: %s", opts.AmountField)
+		}
+		if opts.MinAmount != nil {
+			query = query.Where(amountExpr+" >= ?", *opts.MinAmount)
+		}
+		if opts.MaxAmount != nil {
+			query = query.Where(amountExpr+" <= ?", *opts.MaxAmount)
+		}
 	}
 
-	query = query.Order(fmt.Sprintf("%s %s", sortBy, sortOrder))
+	return query, nil
+}
 
-	// Apply pagination
-	if opts.Limit > 0 {
-		query = query.Limit(opts.Limit)
+// keywordFilter narrows a query on invoices, whose columns are prefixed with prefix, to those
+// whose title or description contains keyword and contains excludeKeyword in neither. Empty
+// keywords are skipped.
+func keywordFilter(query *gorm.DB, prefix, keyword, excludeKeyword string) *gorm.DB {
+	if keyword != "" {
+		searchPattern := "%" + keyword + "%"
+		query = query.Where("("+prefix+"title LIKE ? OR "+prefix+"description LIKE ?)", searchPattern, searchPattern)
 	}
-	if opts.Offset > 0 {
-		query = query.Offset(opts.Offset)
+	if excludeKeyword != "" {
+		excludePattern := "%" + excludeKeyword + "%"
+		query = query.Where("NOT ("+prefix+"title LIKE ? OR COALESCE("+prefix+"description, '') LIKE ?)", excludePattern, excludePattern)
 	}
+	return query
+}
 
-	// Preload relationships
-	query = query.Preload("Category").Preload("Company").Preload("Receiver").Preload("Items").Preload("Tags")
+// tagMatchCondition returns a condition matching the invoices whose ID, in idColumn, is mapped to
+// any or, with TagMatchAll, all of tagIDs
+func tagMatchCondition(idColumn string, tagIDs []uint, match string) (string, []interface{}, error) {
+	switch match {
+	case "", TagMatchAny:
+		return idColumn + " IN (SELECT invoice_id FROM invoice_tag_mappings WHERE invoice_tag_id IN ?)",
+			[]interface{}{tagIDs}, nil
+	case TagMatchAll:
+		// An invoice matches when it is mapped to every distinct requested tag
+		distinct := make(map[uint]struct{}, len(tagIDs))
+		for _, id := range tagIDs {
+			distinct[id] = struct{}{}
+		}
+		return idColumn + " IN (SELECT invoice_id FROM invoice_tag_mappings WHERE invoice_tag_id IN ? GROUP BY invoice_id HAVING COUNT(DISTINCT invoice_tag_id) = ?)",
+			[]interface{}{tagIDs, len(distinct)}, nil
+	default:
+		return "", nil, fmt.Errorf("invalid tag match: %s", match)
+	}
+}
 
-	if err := query.Find(&invoices).Error; err != nil {
-		return nil, 0, err
+// duplicateInvoiceQuery returns a query matching the user's invoices that duplicate the given
+// invoice: same amount, billing dates, and receiver (null matches null). Drafts only match
+// drafts, so a placeholder never blocks or is blocked by a finalized invoice.
+func duplicateInvoiceQuery(db *gorm.DB, userID string, invoice *models.Invoice) *gorm.DB {
+	query := db.Where("user_id = ? AND amount = ? AND is_draft = ?", userID, invoice.Amount, invoice.IsDraft)
+
+	// Handle nullable dates - null matches null
+	if invoice.InvoiceStartedAt != nil {
+		query = query.Where("invoice_started_at = ?", *invoice.InvoiceStartedAt)
+	} else {
+		query = query.Where("invoice_started_at IS NULL")
+	}
+
+	if invoice.InvoiceEndedAt != nil {
+		query = query.Where("invoice_ended_at = ?", *invoice.InvoiceEndedAt)
+	} else {
+		query = query.Where("invoice_ended_at IS NULL")
+	}
+
+	// Handle nullable receiver_id
+	if invoice.ReceiverID != nil {
+		query = query.Where("receiver_id = ?", *invoice.ReceiverID)
+	} else {
+		query = query.Where("receiver_id IS NULL")
 	}
 
-	return invoices, total, nil
+	return query
 }
 
-// UpdateInvoice updates an existing invoice
-// Note: Amount is NOT updated here - it's calculated from items
-// If currency changes, all item target_amounts are recalculated
-func (s *invoiceService) UpdateInvoice(userID string, invoice *models.Invoice) error {
-	// Verify ownership
-	existing, err := s.GetInvoiceByID(userID, invoice.ID)
+// CloneInvoice creates a new invoice from an existing one, copying its title, description,
+// items, category, company, receiver, tags, currency, and dates. Item target amounts are
+// recalculated at the current FX rate. Returns a *DuplicateInvoiceError if the clone
+// matches an existing invoice (e.g. it was already cloned for the same period). The clone and
+// its tags are created in one transaction, so a failure leaves no untagged clone behind.
+func (s *invoiceService) CloneInvoice(userID string, id uint, overrides CloneOptions) (*models.Invoice, error) {
+	if overrides.Status != nil {
+		if err := validateInvoiceStatus(*overrides.Status); err != nil {
+			return nil, err
+		}
+	}
+
+	source, err := s.getOwnInvoice(userID, id)
 	if err != nil {
-		return fmt.Errorf("invoice not found: %w", err)
+		return nil, fmt.Errorf("invoice not found: %w", err)
 	}
 
-	currencyChanged := existing.Currency != invoice.Currency
+	clone := &models.Invoice{
+		OrganizationID:   source.OrganizationID,
+		Title:            source.Title,
+		Description:      source.Description,
+		InvoiceStartedAt: source.InvoiceStartedAt,
+		InvoiceEndedAt:   source.InvoiceEndedAt,
+		Currency:         source.Currency,
+		CategoryID:       source.CategoryID,
+		CompanyID:        source.CompanyID,
+		ReceiverID:       source.ReceiverID,
+		Status:           models.InvoiceStatusUnpaid,
+		DueDate:          source.DueDate,
+		DiscountType:     source.DiscountType,
+		DiscountValue:    source.DiscountValue,
+		TaxRate:          source.TaxRate,
+		PaymentMethod:    source.PaymentMethod,
+	}
 
-	// Update fields (amount is NOT updated - it's calculated from items)
-	// Tags are updated separately via SetInvoiceTags
-	existing.Title = invoice.Title
-	existing.Description = invoice.Description
-	existing.InvoiceStartedAt = invoice.InvoiceStartedAt
-	existing.InvoiceEndedAt = invoice.InvoiceEndedAt
-	existing.ReceiverID = invoice.ReceiverID
-	existing.Currency = invoice.Currency
-	existing.CategoryID = invoice.CategoryID
-	existing.CompanyID = invoice.CompanyID
-	existing.OriginalDownloadLink = invoice.OriginalDownloadLink
-	existing.Status = invoice.Status
-	existing.DueDate = invoice.DueDate
+	if overrides.Title != nil {
+		clone.Title = *overrides.Title
+	}
+	if overrides.Status != nil {
+		clone.Status = *overrides.Status
+	}
+	if overrides.InvoiceStartedAt != nil {
+		clone.InvoiceStartedAt = overrides.InvoiceStartedAt
+	}
+	if overrides.InvoiceEndedAt != nil {
+		clone.InvoiceEndedAt = overrides.InvoiceEndedAt
+	}
+	if overrides.DueDate != nil {
+		clone.DueDate = overrides.DueDate
+	}
 
-	// If currency changed, recalculate all item target_amounts
-	if currencyChanged {
-		return s.db.Transaction(func(tx *gorm.DB) error {
-			// Save invoice first
-			if err := tx.Save(existing).Error; err != nil {
-				return err
-			}
-			// Recalculate all item FX and update invoice total
-			return s.recalculateAllItemFX(tx, existing.ID, existing.Currency)
+	for _, item := range source.Items {
+		clone.Items = append(clone.Items, models.InvoiceItem{
+			Description:   item.Description,
+			Quantity:      item.Quantity,
+			Unit:          item.Unit,
+			UnitPrice:     item.UnitPrice,
+			Currency:      item.Currency,
+			CategoryID:    item.CategoryID,
+			DiscountType:  item.DiscountType,
+			DiscountValue: item.DiscountValue,
 		})
 	}
 
-	return s.db.Save(existing).Error
-}
-
-// DeleteInvoice soft-deletes an invoice and its items
-func (s *invoiceService) DeleteInvoice(userID string, id uint) error {
-	return s.db.Transaction(func(tx *gorm.DB) error {
-		// Verify ownership
-		var invoice models.Invoice
-		if err := tx.Where("id = ? AND user_id = ?", id, userID).First(&invoice).Error; err != nil {
-			return fmt.Errorf("invoice not found: %w", err)
+	if overrides.TargetCurrency != nil {
+		if err := s.convertClone(clone, *overrides.TargetCurrency); err != nil {
+			return nil, err
 		}
+	}
 
-		// Delete items first
-		if err := tx.Where("invoice_id = ?", id).Delete(&models.InvoiceItem{}).Error; err != nil {
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		service := s.withTx(tx)
+		result, err := service.CreateInvoice(userID, clone)
+		if err != nil {
 			return err
 		}
+		if result.IsDuplicate {
+			return &DuplicateInvoiceError{Invoice: result.Invoice}
+		}
 
-		// Delete invoice
-		return tx.Delete(&invoice).Error
+		if len(source.Tags) == 0 {
+			return nil
+		}
+		tagIDs := make([]int, len(source.Tags))
+		for i, tag := range source.Tags {
+			tagIDs[i] = int(tag.ID)
+		}
+		return service.SetInvoiceTagsByID(userID, clone.ID, tagIDs)
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetInvoiceByID(userID, clone.ID)
 }
 
-// SearchInvoices performs a text search on invoices
-func (s *invoiceService) SearchInvoices(userID string, query string) ([]models.Invoice, error) {
-	var invoices []models.Invoice
-	searchPattern := "%" + query + "%"
+// convertClone moves a cloned invoice into currency, converting the unit prices and fixed
+// discounts of its items (and of the invoice) from their currency at the current FX rate.
+// Converted items take the invoice currency; their target amounts are computed as usual on
+// create.
+func (s *invoiceService) convertClone(clone *models.Invoice, currency string) error {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if !currencyCodePattern.MatchString(currency) {
+		return fmt.Errorf("invalid target currency %q: must be a 3-letter ISO 4217 code", currency)
+	}
+	if currency == clone.Currency {
+		return nil
+	}
+	if s.fxService == nil {
+		return fmt.Errorf("converting to %s requires exchange rates, which are not configured", currency)
+	}
 
-	err := s.db.Where("user_id = ? AND (title LIKE ? OR description LIKE ?)",
-		userID, searchPattern, searchPattern).
+	rates := map[string]float64{currency: 1}
+	rateFrom := func(from string) (float64, error) {
+		if rate, ok := rates[from]; ok {
+			return rate, nil
+		}
+		rate, err := s.fxService.GetExchangeRate(context.Background(), from, currency)
+		if err != nil {
+			return 0, fmt.Errorf("failed to convert %s to %s: %w", from, currency, err)
+		}
+		rates[from] = rate.Rate
+		return rate.Rate, nil
+	}
+
+	for i := range clone.Items {
+		item := &clone.Items[i]
+		rate, err := rateFrom(item.EffectiveCurrency(clone.Currency))
+		if err != nil {
+			return err
+		}
+		item.UnitPrice = utils.RoundToCurrency(item.UnitPrice*rate, currency)
+		if item.DiscountType == models.DiscountTypeFixed {
+			item.DiscountValue = utils.RoundToCurrency(item.DiscountValue*rate, currency)
+		}
+		item.Currency = ""
+	}
+
+	if clone.DiscountType == models.DiscountTypeFixed {
+		rate, err := rateFrom(clone.Currency)
+		if err != nil {
+			return err
+		}
+		clone.DiscountValue = utils.RoundToCurrency(clone.DiscountValue*rate, currency)
+	}
+	clone.Currency = currency
+	return nil
+}
+
+// CreateFromTemplate creates an invoice from one of the user's templates, copying its title,
+// description, items, category, company, receiver, currency, and tags. Like CreateInvoice it
+// returns the existing invoice with IsDuplicate set if the new one would duplicate it, in
+// which case the template's tags are not applied. The invoice and its tags are created in one
+// transaction.
+func (s *invoiceService) CreateFromTemplate(userID string, templateID uint, overrides CloneOptions) (*CreateInvoiceResult, error) {
+	if overrides.Status != nil {
+		if err := validateInvoiceStatus(*overrides.Status); err != nil {
+			return nil, err
+		}
+	}
+
+	var template models.InvoiceTemplate
+	if err := s.db.Where("id = ? AND user_id = ?", templateID, userID).First(&template).Error; err != nil {
+		return nil, fmt.Errorf("template not found: %w", err)
+	}
+
+	invoice := &models.Invoice{
+		Title:            template.Title,
+		Description:      template.Description,
+		Currency:         template.Currency,
+		CategoryID:       template.CategoryID,
+		CompanyID:        template.CompanyID,
+		ReceiverID:       template.ReceiverID,
+		Status:           models.InvoiceStatusUnpaid,
+		InvoiceStartedAt: overrides.InvoiceStartedAt,
+		InvoiceEndedAt:   overrides.InvoiceEndedAt,
+		DueDate:          overrides.DueDate,
+	}
+	if overrides.Title != nil {
+		invoice.Title = *overrides.Title
+	}
+	if overrides.Status != nil {
+		invoice.Status = *overrides.Status
+	}
+
+	for _, item := range template.Items {
+		invoice.Items = append(invoice.Items, models.InvoiceItem{
+			Description:   item.Description,
+			Quantity:      item.Quantity,
+			Unit:          item.Unit,
+			UnitPrice:     item.UnitPrice,
+			Currency:      item.Currency,
+			CategoryID:    item.CategoryID,
+			DiscountType:  item.DiscountType,
+			DiscountValue: item.DiscountValue,
+		})
+	}
+
+	var result *CreateInvoiceResult
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		service := s.withTx(tx)
+		var err error
+		if result, err = service.CreateInvoice(userID, invoice); err != nil {
+			return err
+		}
+		if result.IsDuplicate || len(template.Tags) == 0 {
+			return nil
+		}
+		return service.SetInvoiceTags(userID, invoice.ID, template.Tags)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.IsDuplicate {
+		return result, nil
+	}
+
+	created, err := s.GetInvoiceByID(userID, invoice.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateInvoiceResult{Invoice: created}, nil
+}
+
+// GetInvoiceByID retrieves an invoice by ID with all related data. Besides the user's own
+// invoices, it finds those of the organizations the user belongs to.
+func (s *invoiceService) GetInvoiceByID(userID string, id uint) (*models.Invoice, error) {
+	return s.GetInvoiceByIDWithOptions(userID, id, AllInvoiceRelations())
+}
+
+// GetInvoiceByIDWithOptions retrieves an invoice by ID like GetInvoiceByID, preloading only the
+// selected relations
+func (s *invoiceService) GetInvoiceByIDWithOptions(userID string, id uint, load InvoiceLoadOptions) (*models.Invoice, error) {
+	var invoice models.Invoice
+	err := load.preload(s.db.Scopes(invoicesVisibleTo(userID)).Where("id = ?", id)).
+		First(&invoice).Error
+	if err != nil {
+		return nil, err
+	}
+	return &invoice, nil
+}
+
+// getOwnInvoice retrieves an invoice the user created, with all related data. Changes to an
+// invoice are still limited to its creator, so they load it with this rather than GetInvoiceByID.
+func (s *invoiceService) getOwnInvoice(userID string, id uint) (*models.Invoice, error) {
+	var invoice models.Invoice
+	err := AllInvoiceRelations().preload(s.db.Where("id = ? AND user_id = ?", id, userID)).
+		First(&invoice).Error
+	if err != nil {
+		return nil, err
+	}
+	return &invoice, nil
+}
+
+// GetByNumber retrieves the user's own invoice with the given invoice number, with all related
+// data. Numbers are unique per user, so invoices of other organization members aren't matched.
+// Invoices from before numbering go by their ID, as DisplayNumber shows them; a number matching
+// more than one invoice that way fails with ErrAmbiguousInvoiceNumber rather than picking one.
+func (s *invoiceService) GetByNumber(userID string, number string) (*models.Invoice, error) {
+	number = strings.TrimSpace(number)
+	if number == "" {
+		return nil, fmt.Errorf("invoice number is required")
+	}
+
+	query := s.db.Where("user_id = ?", userID)
+	if id, err := strconv.ParseUint(number, 10, 64); err == nil {
+		query = query.Where("(invoice_number = ? OR (invoice_number = '' AND id = ?))", number, id)
+	} else {
+		query = query.Where("invoice_number = ?", number)
+	}
+
+	var matches []uint
+	if err := query.Session(&gorm.Session{}).Model(&models.Invoice{}).Limit(2).Pluck("id", &matches).Error; err != nil {
+		return nil, err
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("%w: no invoice numbered %q", ErrInvoiceNotFound, number)
+	case 1:
+	default:
+		return nil, fmt.Errorf("%w: %q matches more than one invoice", ErrAmbiguousInvoiceNumber, number)
+	}
+
+	var invoice models.Invoice
+	if err := AllInvoiceRelations().preload(s.db).Where("id = ?", matches[0]).First(&invoice).Error; err != nil {
+		return nil, err
+	}
+	return &invoice, nil
+}
+
+// GetByOriginalLink retrieves the user's own invoices whose original
+// download link is link, newest first. Several invoices can share a link, such as the monthly
+// invoices of a vendor that always serves the latest document from the same URL.
+func (s *invoiceService) GetByOriginalLink(userID string, link string) ([]models.Invoice, error) {
+	link = strings.TrimSpace(link)
+	if link == "" {
+		return nil, fmt.Errorf("original link is required")
+	}
+
+	var invoices []models.Invoice
+	err := AllInvoiceRelations().preload(s.db.Scopes(invoicesInScope(userID, nil))).
+		Where("original_download_link = ?", link).
+		Order("created_at DESC, id DESC").
+		Find(&invoices).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(invoices) == 0 {
+		return nil, fmt.Errorf("%w: no invoice with original link %q", ErrInvoiceNotFound, link)
+	}
+	return invoices, nil
+}
+
+// ListInvoices lists the user's own invoices or, with an OrganizationID, the invoices of that
+// organization (see invoicesInScope) with filtering, sorting, and pagination
+func (s *invoiceService) ListInvoices(userID string, opts InvoiceListOptions) ([]models.Invoice, int64, error) {
+	invoices, total, _, err := s.ListInvoicesWithCursor(userID, opts)
+	return invoices, total, err
+}
+
+// ListInvoicesWithCursor lists invoices like ListInvoices and additionally returns
+// the cursor for the next page when cursor mode is enabled and more rows exist.
+// Cursor mode ignores Offset; Total always counts all rows matching the filters.
+func (s *invoiceService) ListInvoicesWithCursor(userID string, opts InvoiceListOptions) ([]models.Invoice, int64, string, error) {
+	page, err := s.ListInvoicesPage(userID, opts)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	return page.Invoices, page.Total, page.NextCursor, nil
+}
+
+// invoicePageTotals are the count and sums of the rows of an invoice listing
+type invoicePageTotals struct {
+	Currency          string
+	Total             int64
+	TotalAmount       float64
+	TotalTargetAmount float64
+}
+
+// sumInvoicePage counts and sums the invoices matched by query. Item target amounts are in the
+// base currency of the invoice's creator, so an organization's invoices are summed per creator's
+// base currency and converted to the user's; an unavailable rate fails the listing rather than
+// mixing currencies.
+func (s *invoiceService) sumInvoicePage(userID string, query *gorm.DB, organization bool) (*invoicePageTotals, error) {
+	const sums = "COUNT(*) AS total, COALESCE(SUM(amount), 0) AS total_amount, COALESCE(SUM(" + itemTargetAmountSubquery + "), 0) AS total_target_amount"
+	if !organization {
+		var totals invoicePageTotals
+		if err := query.Select(sums).Scan(&totals).Error; err != nil {
+			return nil, err
+		}
+		return &totals, nil
+	}
+
+	var rows []invoicePageTotals
+	if err := query.
+		Select("COALESCE((SELECT NULLIF(user_settings.base_currency, '') FROM user_settings WHERE user_settings.user_id = invoices.user_id), ?) AS currency, "+sums, DefaultBaseCurrency).
+		Group("currency").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
+	if err != nil {
+		return nil, err
+	}
+	totals := &invoicePageTotals{Currency: baseCurrency}
+	for _, row := range rows {
+		rate := 1.0
+		if row.Currency != baseCurrency {
+			if s.fxService == nil {
+				return nil, fmt.Errorf("%w: %s to %s", ErrFXRateUnavailable, row.Currency, baseCurrency)
+			}
+			exchangeRate, err := s.fxService.GetExchangeRate(context.Background(), row.Currency, baseCurrency)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert the %s totals of members: %w", row.Currency, err)
+			}
+			rate = exchangeRate.Rate
+		}
+		totals.Total += row.Total
+		totals.TotalAmount += row.TotalAmount
+		totals.TotalTargetAmount += row.TotalTargetAmount * rate
+	}
+	totals.TotalTargetAmount = utils.RoundToCurrency(totals.TotalTargetAmount, baseCurrency)
+	return totals, nil
+}
+
+// ListInvoicesPage lists invoices like ListInvoicesWithCursor and additionally returns the
+// summed amounts of all rows matching the filters (not just the current page), computed
+// in the same aggregate query as the count.
+func (s *invoiceService) ListInvoicesPage(userID string, opts InvoiceListOptions) (*InvoicePage, error) {
+	var invoices []models.Invoice
+
+	query, err := applyInvoiceFilters(s.db.Model(&models.Invoice{}).Scopes(invoicesInScope(userID, opts.OrganizationID)), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Count and sum all matching rows in a single aggregate query
+	totals, err := s.sumInvoicePage(userID, query.Session(&gorm.Session{}), opts.OrganizationID != nil)
+	if err != nil {
+		return nil, err
+	}
+
+	cursorMode := opts.Cursor != "" || opts.CursorDirection != ""
+	if cursorMode {
+		sortOrder := "DESC"
+		comparison := "<"
+		if opts.CursorDirection == "asc" {
+			sortOrder = "ASC"
+			comparison = ">"
+		}
+
+		if opts.Cursor != "" {
+			cursor, err := decodeInvoiceCursor(opts.Cursor)
+			if err != nil {
+				return nil, err
+			}
+			query = query.Where(fmt.Sprintf("(created_at, id) %s (?, ?)", comparison), cursor.CreatedAt, cursor.ID)
+		}
+
+		query = query.Order(fmt.Sprintf("created_at %s, id %s", sortOrder, sortOrder))
+
+		// Fetch one extra row to detect whether another page exists
+		if opts.Limit > 0 {
+			query = query.Limit(opts.Limit + 1)
+		}
+	} else {
+		// Apply sorting
+		order, err := ParseInvoiceSort(opts.SortBy, opts.SortOrder)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Order(order)
+
+		// Apply pagination
+		if opts.Limit > 0 {
+			query = query.Limit(opts.Limit)
+		}
+		if opts.Offset > 0 {
+			query = query.Offset(opts.Offset)
+		}
+	}
+
+	// Preload relationships
+	load := AllInvoiceRelations()
+	if opts.Load != nil {
+		load = *opts.Load
+	}
+	query = load.preload(query)
+
+	if err := query.Find(&invoices).Error; err != nil {
+		return nil, err
+	}
+
+	var nextCursor string
+	if cursorMode && opts.Limit > 0 && len(invoices) > opts.Limit {
+		invoices = invoices[:opts.Limit]
+		nextCursor = encodeInvoiceCursor(&invoices[len(invoices)-1])
+	}
+
+	return &InvoicePage{
+		Invoices:          invoices,
+		Total:             totals.Total,
+		TotalAmount:       totals.TotalAmount,
+		TotalTargetAmount: totals.TotalTargetAmount,
+		NextCursor:        nextCursor,
+	}, nil
+}
+
+// UpdateInvoice updates an existing invoice
+// Note: Amount is NOT updated here - it's calculated from items
+// If currency changes, all item target_amounts are recalculated
+// invoice.Version must be the version the update is based on; otherwise a *VersionConflictError is returned
+func (s *invoiceService) UpdateInvoice(userID string, invoice *models.Invoice) error {
+	// Verify ownership
+	existing, err := s.getOwnInvoice(userID, invoice.ID)
+	if err != nil {
+		return fmt.Errorf("invoice not found: %w", err)
+	}
+
+	if err := validateDiscount(invoice.DiscountType, invoice.DiscountValue); err != nil {
+		return err
+	}
+	if err := validateTaxRate("tax_rate", invoice.TaxRate); err != nil {
+		return err
+	}
+	if err := normalizePaymentMethod(invoice); err != nil {
+		return err
+	}
+
+	if invoice.Version != existing.Version {
+		return &VersionConflictError{InvoiceID: existing.ID, ExpectedVersion: invoice.Version, CurrentVersion: existing.Version}
+	}
+
+	currencyChanged := existing.Currency != invoice.Currency
+	if currencyChanged {
+		if err := s.checkCurrencySupported(userID, invoice.Currency); err != nil {
+			return err
+		}
+	}
+	discountChanged := existing.DiscountType != invoice.DiscountType || existing.DiscountValue != invoice.DiscountValue
+	discountRemoved := discountChanged && invoice.DiscountType == ""
+	before := *existing
+
+	// Update fields (amount is NOT updated - it's calculated from items)
+	// Tags are updated separately via SetInvoiceTags
+	existing.Title = invoice.Title
+	existing.Description = invoice.Description
+	existing.InvoiceStartedAt = invoice.InvoiceStartedAt
+	existing.InvoiceEndedAt = invoice.InvoiceEndedAt
+	existing.ReceiverID = invoice.ReceiverID
+	existing.Currency = invoice.Currency
+	existing.CategoryID = invoice.CategoryID
+	existing.CompanyID = invoice.CompanyID
+	existing.OriginalDownloadLink = invoice.OriginalDownloadLink
+	existing.Status = invoice.Status
+	syncPaidAt(existing, before.Status)
+	existing.DueDate = invoice.DueDate
+	existing.DiscountType = invoice.DiscountType
+	existing.DiscountValue = invoice.DiscountValue
+	existing.TaxRate = invoice.TaxRate
+	existing.PaymentMethod = invoice.PaymentMethod
+
+	// If currency or discount changed, recalculate all item target_amounts and the total
+	if currencyChanged || discountChanged {
+		err = s.db.Transaction(func(tx *gorm.DB) error {
+			// Save invoice first
+			if err := saveInvoiceVersion(tx, existing, invoice.Version); err != nil {
+				return err
+			}
+			if currencyChanged {
+				baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
+				if err != nil {
+					return err
+				}
+				if err := s.recalculateAllItemFX(tx, userID, existing.ID, existing.Currency, baseCurrency, false); err != nil {
+					return err
+				}
+			} else if discountRemoved {
+				// updateInvoiceTotal only rescales discounted invoices, so restore the undiscounted targets here
+				if err := s.rescaleItemTargets(tx, existing); err != nil {
+					return err
+				}
+			}
+			return s.updateInvoiceTotal(tx, existing.ID)
+		})
+	} else {
+		err = saveInvoiceVersion(s.db, existing, invoice.Version)
+	}
+	if err != nil {
+		return err
+	}
+	invoice.Version = existing.Version
+
+	s.auditService.Record(AuditEntry{
+		UserID:     userID,
+		ActorSub:   userID,
+		EntityType: models.AuditEntityInvoice,
+		EntityID:   existing.ID,
+		InvoiceID:  existing.ID,
+		Action:     models.AuditActionUpdate,
+		Before:     &before,
+		After:      existing,
+	})
+	return nil
+}
+
+// saveInvoiceVersion saves all invoice columns (not its associations) if the stored row is still
+// at expectedVersion, bumping the version. The version check and the write are one statement,
+// so of two concurrent updates based on the same version only the first succeeds.
+func saveInvoiceVersion(tx *gorm.DB, invoice *models.Invoice, expectedVersion int) error {
+	invoice.Version = expectedVersion + 1
+	result := tx.Model(invoice).
+		Where("version = ?", expectedVersion).
+		Select("*").
+		Omit(clause.Associations).
+		Updates(invoice)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		conflict := &VersionConflictError{InvoiceID: invoice.ID, ExpectedVersion: expectedVersion}
+		tx.Model(&models.Invoice{}).Where("id = ?", invoice.ID).Pluck("version", &conflict.CurrentVersion)
+		invoice.Version = expectedVersion
+		return conflict
+	}
+	return nil
+}
+
+// DeleteInvoice soft-deletes an invoice and its items
+func (s *invoiceService) DeleteInvoice(userID string, id uint) error {
+	var invoice models.Invoice
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		// Verify ownership
+		if err := tx.Where("id = ? AND user_id = ?", id, userID).First(&invoice).Error; err != nil {
+			return fmt.Errorf("invoice not found: %w", err)
+		}
+
+		// Delete items first
+		if err := tx.Where("invoice_id = ?", id).Delete(&models.InvoiceItem{}).Error; err != nil {
+			return err
+		}
+
+		// Delete attachments
+		if err := tx.Where("invoice_id = ?", id).Delete(&models.InvoiceAttachment{}).Error; err != nil {
+			return err
+		}
+
+		// Delete invoice
+		return tx.Delete(&invoice).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	s.auditService.Record(AuditEntry{
+		UserID:     userID,
+		ActorSub:   userID,
+		EntityType: models.AuditEntityInvoice,
+		EntityID:   invoice.ID,
+		InvoiceID:  invoice.ID,
+		Action:     models.AuditActionDelete,
+		Before:     &invoice,
+	})
+	return nil
+}
+
+// SearchInvoices performs a text search on invoices
+// Matches the query against the invoice title and description as well as
+// the names of the linked category, company, and receiver. With highlight, each
+// result also explains the match; this is done on the fetched rows, not in SQL.
+func (s *invoiceService) SearchInvoices(userID string, query string, highlight bool) ([]InvoiceSearchResult, error) {
+	var invoices []models.Invoice
+	searchPattern := "%" + query + "%"
+
+	err := s.db.Model(&models.Invoice{}).
+		Select("invoices.*").
+		Joins("LEFT JOIN invoice_categories ON invoices.category_id = invoice_categories.id AND invoice_categories.deleted_at IS NULL").
+		Joins("LEFT JOIN invoice_companies ON invoices.company_id = invoice_companies.id AND invoice_companies.deleted_at IS NULL").
+		Joins("LEFT JOIN invoice_receivers ON invoices.receiver_id = invoice_receivers.id AND invoice_receivers.deleted_at IS NULL").
+		Where("invoices.user_id = ?", userID).
+		Where("invoices.title LIKE ? OR invoices.description LIKE ? OR invoice_categories.name LIKE ? OR invoice_companies.name LIKE ? OR invoice_receivers.name LIKE ?",
+			searchPattern, searchPattern, searchPattern, searchPattern, searchPattern).
+		Preload("Category").
+		Preload("Company").
+		Preload("Receiver").
+		Preload("Items", orderItemsByPosition).
+		Preload("Tags").
+		Order("invoices.created_at DESC").
+		Find(&invoices).Error
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]InvoiceSearchResult, len(invoices))
+	for i := range invoices {
+		results[i].Invoice = invoices[i]
+		if highlight {
+			highlightSearchResult(&results[i], query)
+		}
+	}
+	return results, nil
+}
+
+// highlightSearchResult fills in the fields of an invoice that contain query,
+// with snippets for the title and description
+func highlightSearchResult(result *InvoiceSearchResult, query string) {
+	result.MatchedFields = []string{}
+	result.Snippets = make(map[string]string)
+
+	texts := []struct {
+		field string
+		text  string
+	}{
+		{SearchFieldTitle, result.Title},
+		{SearchFieldDescription, result.Description},
+	}
+	for _, t := range texts {
+		if snippet, ok := utils.HighlightSnippet(t.text, query, searchSnippetContext); ok {
+			result.MatchedFields = append(result.MatchedFields, t.field)
+			result.Snippets[t.field] = snippet
+		}
+	}
+
+	if result.Category != nil && utils.ContainsFold(result.Category.Name, query) {
+		result.MatchedFields = append(result.MatchedFields, SearchFieldCategory)
+	}
+	if result.Company != nil && utils.ContainsFold(result.Company.Name, query) {
+		result.MatchedFields = append(result.MatchedFields, SearchFieldCompany)
+	}
+	if result.Receiver != nil && utils.ContainsFold(result.Receiver.Name, query) {
+		result.MatchedFields = append(result.MatchedFields, SearchFieldReceiver)
+	}
+}
+
+// ListIncomplete returns invoices that have no category, company, and/or receiver
+// according to the filter, most recent first
+func (s *invoiceService) ListIncomplete(userID string, missing IncompleteFilter) ([]models.Invoice, error) {
+	var conditions []string
+	if missing.MissingCategory {
+		conditions = append(conditions, "category_id IS NULL")
+	}
+	if missing.MissingCompany {
+		conditions = append(conditions, "company_id IS NULL")
+	}
+	if missing.MissingReceiver {
+		conditions = append(conditions, "receiver_id IS NULL")
+	}
+	if len(conditions) == 0 {
+		return nil, fmt.Errorf("at least one missing field must be selected")
+	}
+
+	var invoices []models.Invoice
+	err := s.db.Where("user_id = ?", userID).
+		Where(strings.Join(conditions, " OR ")).
+		Preload("Category").
+		Preload("Company").
+		Preload("Receiver").
+		Preload("Items", orderItemsByPosition).
+		Preload("Tags").
+		Order("created_at DESC").
+		Find(&invoices).Error
+
+	return invoices, err
+}
+
+// ListPaymentMethods returns the distinct payment methods of the user's invoices, sorted by name.
+// Invoices without a payment method are not included.
+func (s *invoiceService) ListPaymentMethods(userID string) ([]string, error) {
+	methods := []string{}
+	err := s.db.Model(&models.Invoice{}).
+		Where("user_id = ? AND COALESCE(payment_method, '') <> ''", userID).
+		Distinct().
+		Order("payment_method ASC").
+		Pluck("payment_method", &methods).Error
+	return methods, err
+}
+
+// GetFacets returns the currencies and statuses of the user's invoices, and the categories,
+// companies, and receivers used by at least one of them, each with its invoice count.
+// Values are sorted by count, entities by name.
+func (s *invoiceService) GetFacets(userID string) (*InvoiceFacets, error) {
+	facets := &InvoiceFacets{
+		Currencies: []FacetValue{},
+		Statuses:   []FacetValue{},
+		Categories: []FacetEntity{},
+		Companies:  []FacetEntity{},
+		Receivers:  []FacetEntity{},
+	}
+
+	for column, values := range map[string]*[]FacetValue{
+		"currency": &facets.Currencies,
+		"status":   &facets.Statuses,
+	} {
+		if err := s.db.Model(&models.Invoice{}).
+			Select("invoices."+column+" as value, COUNT(*) as count").
+			Where("invoices.user_id = ?", userID).
+			Group("invoices." + column).
+			Order("count DESC, value ASC").
+			Scan(values).Error; err != nil {
+			return nil, fmt.Errorf("failed to count invoices by %s: %w", column, err)
+		}
+	}
+
+	for column, entities := range map[string]struct {
+		table  string
+		values *[]FacetEntity
+	}{
+		"category_id": {"invoice_categories", &facets.Categories},
+		"company_id":  {"invoice_companies", &facets.Companies},
+		"receiver_id": {"invoice_receivers", &facets.Receivers},
+	} {
+		table := entities.table
+		if err := s.db.Model(&models.Invoice{}).
+			Select(table+".id, "+table+".name, COUNT(*) as count").
+			Joins("JOIN "+table+" ON "+table+".id = invoices."+column+" AND "+table+".deleted_at IS NULL").
+			Where("invoices.user_id = ?", userID).
+			Group(table + ".id, " + table + ".name").
+			Order(table + ".name ASC, " + table + ".id ASC").
+			Scan(entities.values).Error; err != nil {
+			return nil, fmt.Errorf("failed to count invoices by %s: %w", column, err)
+		}
+	}
+
+	return facets, nil
+}
+
+// CountByStatus returns how many of the user's invoices have each status, with a zero count for
+// statuses no invoice has. Drafts are left out, as they are from list_invoices.
+func (s *invoiceService) CountByStatus(userID string) (map[models.InvoiceStatus]int64, error) {
+	var rows []struct {
+		Status models.InvoiceStatus
+		Count  int64
+	}
+	if err := s.db.Model(&models.Invoice{}).Scopes(excludeDrafts).
+		Select("invoices.status, COUNT(*) as count").
+		Where("invoices.user_id = ?", userID).
+		Group("invoices.status").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to count invoices by status: %w", err)
+	}
+
+	counts := map[models.InvoiceStatus]int64{
+		models.InvoiceStatusPaid:    0,
+		models.InvoiceStatusUnpaid:  0,
+		models.InvoiceStatusOverdue: 0,
+	}
+	for _, row := range rows {
+		counts[row.Status] += row.Count
+	}
+	return counts, nil
+}
+
+// AddInvoiceItem adds an item to an invoice
+func (s *invoiceService) AddInvoiceItem(userID string, invoiceID uint, item *models.InvoiceItem) error {
+	// Verify invoice ownership and get currency
+	invoice, err := s.getOwnInvoice(userID, invoiceID)
+	if err != nil {
+		return fmt.Errorf("invoice not found: %w", err)
+	}
+
+	baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
+	if err != nil {
+		return err
+	}
+	if err := s.prepareNewItem(userID, item, invoice, baseCurrency, s.settingsService.GetItemLimits(userID)); err != nil {
+		return err
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		// Append the item after the existing ones
+		maxPosition, err := maxItemPosition(tx, invoiceID)
+		if err != nil {
+			return err
+		}
+		item.Position = maxPosition + 1
+
+		// Create item
+		if err := tx.Create(item).Error; err != nil {
+			return err
+		}
+
+		// Update invoice total
+		if err := s.updateInvoiceTotal(tx, invoiceID); err != nil {
+			return err
+		}
+		// An invoice discount rescales the target amount of the new item too
+		return tx.Select("target_amount").First(item, item.ID).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	s.auditService.Record(AuditEntry{
+		UserID:     userID,
+		ActorSub:   userID,
+		EntityType: models.AuditEntityInvoiceItem,
+		EntityID:   item.ID,
+		InvoiceID:  invoiceID,
+		Action:     models.AuditActionCreate,
+		After:      item,
+	})
+	return nil
+}
+
+// AddInvoiceItems adds several items to an invoice in one transaction, recomputing the invoice
+// total once. Items are appended in order after the existing ones, and on success carry their
+// assigned IDs and target amounts. If any item is invalid, none are added.
+func (s *invoiceService) AddInvoiceItems(userID string, invoiceID uint, items []models.InvoiceItem) error {
+	if len(items) == 0 {
+		return fmt.Errorf("at least one item is required")
+	}
+
+	invoice, err := s.getOwnInvoice(userID, invoiceID)
+	if err != nil {
+		return fmt.Errorf("invoice not found: %w", err)
+	}
+
+	baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
+	if err != nil {
+		return err
+	}
+	limits := s.settingsService.GetItemLimits(userID)
+	for i := range items {
+		if err := s.prepareNewItem(userID, &items[i], invoice, baseCurrency, limits); err != nil {
+			return fmt.Errorf("item %d: %w", i+1, err)
+		}
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		maxPosition, err := maxItemPosition(tx, invoiceID)
+		if err != nil {
+			return err
+		}
+		for i := range items {
+			items[i].Position = maxPosition + 1 + i
+		}
+
+		if err := tx.Create(&items).Error; err != nil {
+			return err
+		}
+
+		if err := s.updateInvoiceTotal(tx, invoiceID); err != nil {
+			return err
+		}
+		// An invoice discount rescales the target amounts of the new items too
+		for i := range items {
+			if err := tx.Select("target_amount").First(&items[i], items[i].ID).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i := range items {
+		s.auditService.Record(AuditEntry{
+			UserID:     userID,
+			ActorSub:   userID,
+			EntityType: models.AuditEntityInvoiceItem,
+			EntityID:   items[i].ID,
+			InvoiceID:  invoiceID,
+			Action:     models.AuditActionCreate,
+			After:      &items[i],
+		})
+	}
+	return nil
+}
+
+// prepareNewItem validates an item about to be added to invoice and computes its amount and
+// target amount
+func (s *invoiceService) prepareNewItem(userID string, item *models.InvoiceItem, invoice *models.Invoice, baseCurrency string, limits ItemLimits) error {
+	if err := normalizeItemCurrency(item); err != nil {
+		return err
+	}
+	if err := s.checkCurrencySupported(userID, item.Currency); err != nil {
+		return err
+	}
+	if err := s.checkItemCategory(userID, item); err != nil {
+		return err
+	}
+	if err := normalizeItemUnit(item); err != nil {
+		return err
+	}
+	if err := validateDiscount(item.DiscountType, item.DiscountValue); err != nil {
+		return err
+	}
+	item.ID = 0
+	item.InvoiceID = invoice.ID
+	item.CalculateAmount()
+	if err := s.calculateItemTargetAmount(userID, item, invoice.Currency, baseCurrency); err != nil {
+		return err
+	}
+	return checkItemLimits(item, limits)
+}
+
+// maxItemPosition returns the highest item position of an invoice, or -1 when it has no items
+func maxItemPosition(tx *gorm.DB, invoiceID uint) (int, error) {
+	var maxPosition int
+	err := tx.Model(&models.InvoiceItem{}).
+		Where("invoice_id = ?", invoiceID).
+		Select("COALESCE(MAX(position), -1)").
+		Scan(&maxPosition).Error
+	return maxPosition, err
+}
+
+// UpdateInvoiceItem updates an invoice item
+// targetAmountOverride allows manual override of the base currency amount (nil = preserve existing)
+// forceRecalculate forces recalculation of target_amount using latest FX rate
+func (s *invoiceService) UpdateInvoiceItem(userID string, itemID uint, item *models.InvoiceItem, targetAmountOverride *float64, forceRecalculate bool) error {
+	// Get existing item and verify ownership
+	existing, err := s.GetInvoiceItem(userID, itemID)
+	if err != nil {
+		return err
+	}
+
+	// Get invoice to access currency
+	var invoice models.Invoice
+	if err := s.db.First(&invoice, existing.InvoiceID).Error; err != nil {
+		return err
+	}
+
+	if err := normalizeItemCurrency(item); err != nil {
+		return err
+	}
+	if err := normalizeItemUnit(item); err != nil {
+		return err
+	}
+	if err := validateDiscount(item.DiscountType, item.DiscountValue); err != nil {
+		return err
+	}
+	if err := s.checkItemCategory(userID, item); err != nil {
+		return err
+	}
+
+	before := *existing
+	currencyChanged := existing.EffectiveCurrency(invoice.Currency) != item.EffectiveCurrency(invoice.Currency)
+	if currencyChanged {
+		if err := s.checkCurrencySupported(userID, item.Currency); err != nil {
+			return err
+		}
+	}
+	discountChanged := existing.DiscountType != item.DiscountType || existing.DiscountValue != item.DiscountValue
+	baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
+	if err != nil {
+		return err
+	}
+
+	// Update fields
+	existing.Description = item.Description
+	existing.Quantity = item.Quantity
+	existing.Unit = item.Unit
+	existing.UnitPrice = item.UnitPrice
+	existing.Currency = item.Currency
+	existing.CategoryID = item.CategoryID
+	existing.DiscountType = item.DiscountType
+	existing.DiscountValue = item.DiscountValue
+	existing.CalculateAmount()
+
+	// Handle target_amount: forceRecalculate takes precedence, then override, then preserve existing.
+	// A changed item currency or discount invalidates the existing target_amount, so it is recalculated too.
+	if forceRecalculate || ((currencyChanged || discountChanged) && targetAmountOverride == nil) {
+		// Force recalculation using latest FX rate, ignoring any override
+		if err := s.calculateItemTargetAmount(userID, existing, invoice.Currency, baseCurrency); err != nil {
+			return err
+		}
+	} else if targetAmountOverride != nil {
+		// Manual override - use the provided value
+		existing.TargetCurrency = baseCurrency
+		existing.TargetAmount = *targetAmountOverride
+		existing.FXStale = false
+		existing.FXRateDate = ""
+		existing.FXManual = true
+		existing.FXUnsupported = false
+		// Calculate the implied FX rate from the override
+		if existing.Amount != 0 {
+			existing.FXRateUsed = *targetAmountOverride / existing.Amount
+		} else {
+			existing.FXRateUsed = 1.0
+		}
+	}
+	// else: preserve existing target_amount, target_currency, and fx_rate_used
+
+	// Only changed values are checked, so lowering a limit doesn't lock existing items
+	if existing.Quantity != before.Quantity || existing.UnitPrice != before.UnitPrice || existing.Amount != before.Amount {
+		if err := checkItemLimits(existing, s.settingsService.GetItemLimits(userID)); err != nil {
+			return err
+		}
+	}
+
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(existing).Error; err != nil {
+			return err
+		}
+		return s.updateInvoiceTotal(tx, existing.InvoiceID)
+	}); err != nil {
+		return err
+	}
+
+	s.auditService.Record(AuditEntry{
+		UserID:     userID,
+		ActorSub:   userID,
+		EntityType: models.AuditEntityInvoiceItem,
+		EntityID:   existing.ID,
+		InvoiceID:  existing.InvoiceID,
+		Action:     models.AuditActionUpdate,
+		Before:     &before,
+		After:      existing,
+	})
+	return nil
+}
+
+// DeleteInvoiceItem deletes an invoice item
+func (s *invoiceService) DeleteInvoiceItem(userID string, itemID uint) error {
+	// Get existing item and verify ownership
+	existing, err := s.GetInvoiceItem(userID, itemID)
+	if err != nil {
+		return err
+	}
+
+	invoiceID := existing.InvoiceID
+
+	if err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(existing).Error; err != nil {
+			return err
+		}
+		return s.updateInvoiceTotal(tx, invoiceID)
+	}); err != nil {
+		return err
+	}
+
+	s.auditService.Record(AuditEntry{
+		UserID:     userID,
+		ActorSub:   userID,
+		EntityType: models.AuditEntityInvoiceItem,
+		EntityID:   existing.ID,
+		InvoiceID:  invoiceID,
+		Action:     models.AuditActionDelete,
+		Before:     existing,
+	})
+	return nil
+}
+
+// GetInvoiceItem retrieves an invoice item and verifies user ownership
+func (s *invoiceService) GetInvoiceItem(userID string, itemID uint) (*models.InvoiceItem, error) {
+	var item models.InvoiceItem
+	err := s.db.First(&item, itemID).Error
+	if err != nil {
+		return nil, fmt.Errorf("item not found: %w", err)
+	}
+
+	// Verify invoice ownership
+	var invoice models.Invoice
+	err = s.db.Where("id = ? AND user_id = ?", item.InvoiceID, userID).First(&invoice).Error
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	return &item, nil
+}
+
+// ReorderItems rewrites the positions of an invoice's items to match orderedItemIDs.
+// orderedItemIDs must list every item of the invoice exactly once. A changed order is audited
+// as an update of the invoice's item_order.
+func (s *invoiceService) ReorderItems(userID string, invoiceID uint, orderedItemIDs []uint) error {
+	// Verify invoice ownership
+	var invoice models.Invoice
+	if err := s.db.Where("id = ? AND user_id = ?", invoiceID, userID).First(&invoice).Error; err != nil {
+		return fmt.Errorf("invoice not found: %w", err)
+	}
+
+	var itemIDs []uint
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.InvoiceItem{}).Where("invoice_id = ?", invoiceID).
+			Order("position, id").Pluck("id", &itemIDs).Error; err != nil {
+			return err
+		}
+
+		if len(orderedItemIDs) != len(itemIDs) {
+			return fmt.Errorf("item_ids must contain all %d items of the invoice", len(itemIDs))
+		}
+
+		remaining := make(map[uint]bool, len(itemIDs))
+		for _, id := range itemIDs {
+			remaining[id] = true
+		}
+		for _, id := range orderedItemIDs {
+			if !remaining[id] {
+				return fmt.Errorf("item %d does not belong to the invoice or is listed twice", id)
+			}
+			delete(remaining, id)
+		}
+
+		for position, id := range orderedItemIDs {
+			if err := tx.Model(&models.InvoiceItem{}).Where("id = ?", id).Update("position", position).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if !slices.Equal(itemIDs, orderedItemIDs) {
+		s.auditService.Record(AuditEntry{
+			UserID:     userID,
+			ActorSub:   userID,
+			EntityType: models.AuditEntityInvoice,
+			EntityID:   invoiceID,
+			InvoiceID:  invoiceID,
+			Action:     models.AuditActionUpdate,
+			Before:     map[string]interface{}{"item_order": joinItemIDs(itemIDs)},
+			After:      map[string]interface{}{"item_order": joinItemIDs(orderedItemIDs)},
+		})
+	}
+	return nil
+}
+
+// joinItemIDs formats item IDs as a comma-separated list; audit diffs skip nested values, so
+// an item order is recorded as a string
+func joinItemIDs(ids []uint) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.FormatUint(uint64(id), 10)
+	}
+	return strings.Join(parts, ",")
+}
+
+// UpdateInvoiceStatus updates only the status of an invoice
+func (s *invoiceService) UpdateInvoiceStatus(userID string, id uint, status models.InvoiceStatus) error {
+	var previous models.Invoice
+	if err := s.db.Select("status", "paid_at").Where("id = ? AND user_id = ?", id, userID).First(&previous).Error; err != nil {
+		return fmt.Errorf("invoice not found: %w", err)
+	}
+
+	updated := models.Invoice{Status: status, PaidAt: previous.PaidAt}
+	syncPaidAt(&updated, previous.Status)
+
+	result := s.db.Model(&models.Invoice{}).
+		Where("id = ? AND user_id = ?", id, userID).
+		Updates(map[string]interface{}{"status": status, "paid_at": updated.PaidAt, "version": gorm.Expr("version + 1")})
+
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("invoice not found")
+	}
+
+	s.auditService.Record(AuditEntry{
+		UserID:     userID,
+		ActorSub:   userID,
+		EntityType: models.AuditEntityInvoice,
+		EntityID:   id,
+		InvoiceID:  id,
+		Action:     models.AuditActionStatusChange,
+		Before:     map[string]interface{}{"status": previous.Status},
+		After:      map[string]interface{}{"status": status},
+	})
+	return nil
+}
+
+// FinalizeInvoice turns a draft invoice into a regular one, so it counts towards analytics and
+// shows up in invoice lists. Finalizing an invoice that is not a draft is an error.
+func (s *invoiceService) FinalizeInvoice(userID string, id uint) error {
+	var invoice models.Invoice
+	if err := s.db.Select("id", "is_draft").Where("id = ? AND user_id = ?", id, userID).First(&invoice).Error; err != nil {
+		return fmt.Errorf("invoice not found: %w", err)
+	}
+	if !invoice.IsDraft {
+		return fmt.Errorf("invoice %d is not a draft", id)
+	}
+
+	result := s.db.Model(&models.Invoice{}).
+		Where("id = ? AND user_id = ? AND is_draft = ?", id, userID, true).
+		Updates(map[string]interface{}{"is_draft": false, "version": gorm.Expr("version + 1")})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("invoice %d is not a draft", id)
+	}
+
+	s.auditService.Record(AuditEntry{
+		UserID:     userID,
+		ActorSub:   userID,
+		EntityType: models.AuditEntityInvoice,
+		EntityID:   id,
+		InvoiceID:  id,
+		Action:     models.AuditActionUpdate,
+		Before:     map[string]interface{}{"is_draft": true},
+		After:      map[string]interface{}{"is_draft": false},
+	})
+	return nil
+}
+
+// syncPaidAt stamps PaidAt when an invoice transitions to paid from previousStatus and clears it
+// when the invoice is no longer paid. A new invoice (no previousStatus) created as paid keeps the
+// PaidAt it was given, e.g. by an import.
+func syncPaidAt(invoice *models.Invoice, previousStatus models.InvoiceStatus) {
+	if invoice.Status != models.InvoiceStatusPaid {
+		invoice.PaidAt = nil
+		return
+	}
+	if invoice.PaidAt == nil || (previousStatus != "" && previousStatus != models.InvoiceStatusPaid) {
+		now := time.Now()
+		invoice.PaidAt = &now
+	}
+}
+
+// LinkInvoices links an invoice to a related invoice of the same user, e.g. a credit note to the
+// invoice it refunds. A relatedInvoiceID of 0 removes the link. Refunds and credit notes without a
+// category or company take the related invoice's, so they net out against the same totals.
+func (s *invoiceService) LinkInvoices(userID string, invoiceID, relatedInvoiceID uint, relationType models.InvoiceRelationType) error {
+	var invoice models.Invoice
+	if err := s.db.Where("id = ? AND user_id = ?", invoiceID, userID).First(&invoice).Error; err != nil {
+		return fmt.Errorf("invoice not found: %w", err)
+	}
+
+	updates := map[string]interface{}{"related_invoice_id": nil, "relation_type": "", "version": gorm.Expr("version + 1")}
+	if relatedInvoiceID != 0 {
+		if relatedInvoiceID == invoiceID {
+			return fmt.Errorf("an invoice cannot be linked to itself")
+		}
+		if !relationType.Valid() {
+			return fmt.Errorf("invalid relation type %q: must be refund, credit_note, or correction", relationType)
+		}
+
+		var related models.Invoice
+		if err := s.db.Where("id = ? AND user_id = ?", relatedInvoiceID, userID).First(&related).Error; err != nil {
+			return fmt.Errorf("related invoice not found: %w", err)
+		}
+		cycle, err := s.relationReaches(userID, relatedInvoiceID, invoiceID)
+		if err != nil {
+			return err
+		}
+		if cycle {
+			return fmt.Errorf("invoice %d is already linked to invoice %d, directly or through other invoices", relatedInvoiceID, invoiceID)
+		}
+
+		updates["related_invoice_id"] = relatedInvoiceID
+		updates["relation_type"] = relationType
+		if relationType.Offsets() {
+			if invoice.CategoryID == nil && related.CategoryID != nil {
+				updates["category_id"] = *related.CategoryID
+			}
+			if invoice.CompanyID == nil && related.CompanyID != nil {
+				updates["company_id"] = *related.CompanyID
+			}
+		}
+	}
+
+	before := map[string]interface{}{
+		"related_invoice_id": invoice.RelatedInvoiceID,
+		"relation_type":      invoice.RelationType,
+	}
+	if _, ok := updates["category_id"]; ok {
+		before["category_id"] = invoice.CategoryID
+	}
+	if _, ok := updates["company_id"]; ok {
+		before["company_id"] = invoice.CompanyID
+	}
+
+	if err := s.db.Model(&invoice).Updates(updates).Error; err != nil {
+		return fmt.Errorf("failed to link invoices: %w", err)
+	}
+
+	s.auditService.Record(AuditEntry{
+		UserID:     userID,
+		ActorSub:   userID,
+		EntityType: models.AuditEntityInvoice,
+		EntityID:   invoiceID,
+		InvoiceID:  invoiceID,
+		Action:     models.AuditActionUpdate,
+		Before:     before,
+		After:      updates,
+	})
+	return nil
+}
+
+// maxRelationDepth bounds how many links relationReaches follows
+const maxRelationDepth = 100
+
+// relationReaches reports whether following related invoices from startID, breadth first, reaches
+// targetID, so that linking targetID to startID would close a cycle. Deleted invoices are followed
+// too, as restoring one brings its link back. A chain longer than maxRelationDepth is an error.
+func (s *invoiceService) relationReaches(userID string, startID, targetID uint) (bool, error) {
+	visited := map[uint]bool{startID: true}
+	frontier := []uint{startID}
+	for depth := 0; len(frontier) > 0; depth++ {
+		if depth >= maxRelationDepth {
+			return false, fmt.Errorf("invoice %d is linked through more than %d invoices", startID, maxRelationDepth)
+		}
+		var next []uint
+		if err := s.db.Unscoped().Model(&models.Invoice{}).
+			Where("id IN ? AND user_id = ? AND related_invoice_id IS NOT NULL", frontier, userID).
+			Pluck("related_invoice_id", &next).Error; err != nil {
+			return false, err
+		}
+		frontier = frontier[:0]
+		for _, id := range next {
+			if id == targetID {
+				return true, nil
+			}
+			if !visited[id] {
+				visited[id] = true
+				frontier = append(frontier, id)
+			}
+		}
+	}
+	return false, nil
+}
+
+// PreviewCurrencyChange shows the base-currency target amounts the invoice's items would have if the
+// invoice currency were changed to currency, without saving anything. Items keeping their own
+// currency are recalculated too, as they are when the currency actually changes.
+func (s *invoiceService) PreviewCurrencyChange(ctx context.Context, userID string, invoiceID uint, currency string) (*ConversionPreview, error) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if !currencyCodePattern.MatchString(currency) {
+		return nil, fmt.Errorf("invalid currency %q: must be a 3-letter ISO 4217 code", currency)
+	}
+
+	invoice, err := s.GetInvoiceByIDWithOptions(userID, invoiceID, InvoiceLoadOptions{Items: true})
+	if err != nil {
+		return nil, err
+	}
+
+	baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
+	if err != nil {
+		return nil, err
+	}
+	var preview *ConversionPreview
+	if s.fxService == nil {
+		preview, err = previewConversion(ctx, nil, invoice.Items, currency, baseCurrency)
+	} else {
+		preview, err = s.fxService.PreviewConversion(ctx, invoice.Items, currency, baseCurrency)
+	}
+	if err != nil || invoice.DiscountType == "" {
+		return preview, err
+	}
+
+	// Apply the invoice discount like a saved currency change would
+	items := make([]models.InvoiceItem, len(preview.Items))
+	for i, item := range preview.Items {
+		items[i] = models.InvoiceItem{TargetAmount: item.TargetAmount, TargetCurrency: baseCurrency}
+	}
+	invoice.Currency = currency
+	if err := s.applyInvoiceDiscount(invoice, items); err != nil {
+		return nil, err
+	}
+	preview.Total = 0
+	for i := range items {
+		preview.Items[i].TargetAmount = items[i].TargetAmount
+		preview.Total += items[i].TargetAmount
+	}
+	preview.Total = utils.RoundToCurrency(preview.Total, baseCurrency)
+	return preview, nil
+}
+
+// GetAuditTrail returns a page of the audit trail of an invoice and its items, newest first, and
+// the number of entries matching opts. The trail of a deleted invoice remains available.
+func (s *invoiceService) GetAuditTrail(userID string, invoiceID uint, opts AuditTrailOptions) ([]models.AuditLog, int64, error) {
+	entries, total, err := s.auditService.ListInvoiceTrail(userID, invoiceID, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	return entries, total, s.checkTrailFound(userID, invoiceID, total)
+}
+
+// GetStatusHistory returns a page of the status changes of an invoice, newest first, and the
+// number of entries matching opts: the audit entries of the invoice that set or changed its
+// status, from its creation to its deletion.
+func (s *invoiceService) GetStatusHistory(userID string, invoiceID uint, opts AuditTrailOptions) ([]models.AuditLog, int64, error) {
+	entries, total, err := s.auditService.ListStatusHistory(userID, invoiceID, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	return entries, total, s.checkTrailFound(userID, invoiceID, total)
+}
+
+// checkTrailFound fails with ErrInvoiceNotFound when an audit listing matched nothing and the
+// invoice doesn't exist. Invoices created before auditing have no entries, and filters can match
+// none, so only unknown invoices fail.
+func (s *invoiceService) checkTrailFound(userID string, invoiceID uint, total int64) error {
+	if total > 0 {
+		return nil
+	}
+	var count int64
+	if err := s.db.Model(&models.AuditLog{}).Scopes(auditEntriesVisibleTo(userID)).Where("invoice_id = ?", invoiceID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+	if _, err := s.GetInvoiceByID(userID, invoiceID); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvoiceNotFound, err)
+	}
+	return nil
+}
+
+// GetOverdueInvoices returns all overdue invoices for a user
+func (s *invoiceService) GetOverdueInvoices(userID string) ([]models.Invoice, error) {
+	var invoices []models.Invoice
+	now := time.Now()
+
+	err := s.db.Where("user_id = ? AND status = ? AND due_date < ?",
+		userID, models.InvoiceStatusUnpaid, now).
 		Preload("Category").
 		Preload("Company").
 		Preload("Receiver").
-		Preload("Items").
+		Preload("Items", orderItemsByPosition).
 		Preload("Tags").
-		Order("created_at DESC").
+		Order("due_date ASC").
 		Find(&invoices).Error
 
 	return invoices, err
 }
 
-// AddInvoiceItem adds an item to an invoice
-func (s *invoiceService) AddInvoiceItem(userID string, invoiceID uint, item *models.InvoiceItem) error {
-	// Verify invoice ownership and get currency
-	invoice, err := s.GetInvoiceByID(userID, invoiceID)
+// orderItemsByPosition orders preloaded invoice items by their display position
+func orderItemsByPosition(db *gorm.DB) *gorm.DB {
+	return db.Order("position ASC, id ASC")
+}
+
+// updateInvoiceTotal recalculates and updates the invoice total amount from items,
+// flagging the invoice when its items are in more than one currency
+func (s *invoiceService) updateInvoiceTotal(tx *gorm.DB, invoiceID uint) error {
+	var invoice models.Invoice
+	if err := tx.Select("id", "user_id", "currency", "discount_type", "discount_value").First(&invoice, invoiceID).Error; err != nil {
+		return err
+	}
+
+	var result struct {
+		TotalAmount  float64
+		ForeignItems int64
+	}
+	err := tx.Model(&models.InvoiceItem{}).
+		Where("invoice_id = ?", invoiceID).
+		Select("COALESCE(SUM(amount), 0) as total_amount, "+
+			"COUNT(CASE WHEN currency <> '' AND currency <> ? THEN 1 END) as foreign_items", invoice.Currency).
+		Scan(&result).Error
 	if err != nil {
-		return fmt.Errorf("invoice not found: %w", err)
+		return err
 	}
 
-	item.InvoiceID = invoiceID
-	item.CalculateAmount()
-	s.calculateItemTargetAmount(item, invoice.Currency)
+	var rates []models.InvoiceItem
+	if err := tx.Select("fx_rate_used", "target_amount").Where("invoice_id = ?", invoiceID).Find(&rates).Error; err != nil {
+		return err
+	}
 
-	return s.db.Transaction(func(tx *gorm.DB) error {
-		// Create item
-		if err := tx.Create(item).Error; err != nil {
+	// Save updates
+	if err := tx.Model(&models.Invoice{}).
+		Where("id = ?", invoiceID).
+		Updates(map[string]interface{}{
+			"amount":                models.ApplyDiscount(result.TotalAmount, invoice.DiscountType, invoice.DiscountValue),
+			"amount_currency_mixed": result.ForeignItems > 0,
+			"fx_rate_used":          models.AverageFXRate(rates),
+		}).Error; err != nil {
+		return err
+	}
+
+	// The invoice discount is spread over the item target amounts, which change with every item
+	if invoice.DiscountType != "" {
+		return s.rescaleItemTargets(tx, &invoice)
+	}
+	return nil
+}
+
+// rescaleItemTargets recalculates the target amounts of an invoice's items from their stored
+// FX rates with the invoice discount applied, and saves them. Items whose target amount was
+// overridden by hand keep it.
+func (s *invoiceService) rescaleItemTargets(tx *gorm.DB, invoice *models.Invoice) error {
+	var items []models.InvoiceItem
+	if err := tx.Where("invoice_id = ?", invoice.ID).Find(&items).Error; err != nil {
+		return err
+	}
+	for i := range items {
+		if !items[i].FXManual {
+			items[i].TargetAmount = utils.RoundToCurrency(items[i].Amount*items[i].FXRateUsed, items[i].TargetCurrency)
+		}
+	}
+	if err := s.applyInvoiceDiscount(invoice, items); err != nil {
+		return err
+	}
+
+	for i := range items {
+		if items[i].FXManual {
+			continue
+		}
+		if err := tx.Model(&models.InvoiceItem{}).
+			Where("id = ?", items[i].ID).
+			Update("target_amount", items[i].TargetAmount).Error; err != nil {
 			return err
 		}
+	}
+	return nil
+}
 
-		// Update invoice total
-		return s.updateInvoiceTotal(tx, invoiceID)
-	})
+// applyInvoiceDiscount spreads the invoice discount over the item target amounts in proportion
+// to them, so the items still add up to the discounted invoice total in the owner's base currency
+// and category analytics split the discount like the items. The target amounts must be
+// undiscounted. Target amounts overridden by hand are final and take no share of the discount.
+// A fixed discount that can't be converted to the base currency fails rather than being applied
+// at 1:1.
+func (s *invoiceService) applyInvoiceDiscount(invoice *models.Invoice, items []models.InvoiceItem) error {
+	if invoice.DiscountType == "" || len(items) == 0 {
+		return nil
+	}
+
+	var subtotal float64
+	for i := range items {
+		if !items[i].FXManual {
+			subtotal += items[i].TargetAmount
+		}
+	}
+	if subtotal == 0 {
+		return nil
+	}
+	baseCurrency, err := s.settingsService.GetBaseCurrency(invoice.UserID)
+	if err != nil {
+		return err
+	}
+
+	discounted := models.ApplyDiscount(subtotal, invoice.DiscountType, invoice.DiscountValue)
+	if invoice.DiscountType == models.DiscountTypeFixed && s.fxService != nil && invoice.Currency != baseCurrency {
+		// A fixed discount is in the invoice currency
+		discount, _, err := s.fxService.ConvertAmount(context.Background(), invoice.DiscountValue, invoice.Currency, baseCurrency)
+		if err != nil {
+			return fmt.Errorf("failed to convert the invoice discount from %s to %s: %w", invoice.Currency, baseCurrency, err)
+		}
+		discounted = models.ApplyDiscount(subtotal, models.DiscountTypeFixed, discount)
+	}
+
+	factor := discounted / subtotal
+	for i := range items {
+		if items[i].FXManual {
+			continue
+		}
+		items[i].TargetAmount = utils.RoundToCurrency(items[i].TargetAmount*factor, items[i].TargetCurrency)
+	}
+	return nil
 }
 
-// UpdateInvoiceItem updates an invoice item
-// targetAmountOverride allows manual override of the USD amount (nil = preserve existing)
-// forceRecalculate forces recalculation of target_amount using latest FX rate
-func (s *invoiceService) UpdateInvoiceItem(userID string, itemID uint, item *models.InvoiceItem, targetAmountOverride *float64, forceRecalculate bool) error {
-	// Get existing item and verify ownership
-	existing, err := s.GetInvoiceItem(userID, itemID)
+// recalculateAllItemFX recalculates FX for all items of the user's invoice when currency changes.
+// With keepOverrides, items whose target amount was overridden by hand keep it.
+func (s *invoiceService) recalculateAllItemFX(tx *gorm.DB, userID string, invoiceID uint, currency, baseCurrency string, keepOverrides bool) error {
+	// Get all items for this invoice
+	query := tx.Where("invoice_id = ?", invoiceID)
+	if keepOverrides {
+		query = query.Where("fx_manual = ?", false)
+	}
+	var items []models.InvoiceItem
+	if err := query.Find(&items).Error; err != nil {
+		return err
+	}
+
+	// Recalculate FX for each item
+	for i := range items {
+		if err := s.calculateItemTargetAmount(userID, &items[i], currency, baseCurrency); err != nil {
+			return err
+		}
+
+		// Update item
+		if err := tx.Model(&models.InvoiceItem{}).
+			Where("id = ?", items[i].ID).
+			Updates(map[string]interface{}{
+				"target_currency": items[i].TargetCurrency,
+				"target_amount":   items[i].TargetAmount,
+				"fx_rate_used":    items[i].FXRateUsed,
+				"fx_stale":        items[i].FXStale,
+				"fx_rate_date":    items[i].FXRateDate,
+				"fx_manual":       items[i].FXManual,
+				"fx_unsupported":  items[i].FXUnsupported,
+			}).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RecalculateTotals recomputes an invoice's item target amounts at the current FX rates and
+// its amount from the items, repairing totals that drifted from the items (e.g. after manual
+// database edits). Returns the totals before and after.
+func (s *invoiceService) RecalculateTotals(userID string, invoiceID uint) (*TotalsRecalculation, error) {
+	baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
 	if err != nil {
+		return nil, err
+	}
+
+	var recalculation *TotalsRecalculation
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		recalculation, err = s.recalculateTotals(tx, userID, invoiceID, baseCurrency)
 		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditRecalculation(userID, *recalculation)
+	return recalculation, nil
+}
+
+// RecalculateAllTotals runs RecalculateTotals over every invoice of the user in one transaction
+func (s *invoiceService) RecalculateAllTotals(userID string) ([]TotalsRecalculation, error) {
+	baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var invoiceIDs []uint
+	if err := s.db.Model(&models.Invoice{}).Where("user_id = ?", userID).Order("id ASC").Pluck("id", &invoiceIDs).Error; err != nil {
+		return nil, fmt.Errorf("failed to list invoices: %w", err)
+	}
+
+	recalculations := make([]TotalsRecalculation, 0, len(invoiceIDs))
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		for _, id := range invoiceIDs {
+			recalculation, err := s.recalculateTotals(tx, userID, id, baseCurrency)
+			if err != nil {
+				return fmt.Errorf("invoice %d: %w", id, err)
+			}
+			recalculations = append(recalculations, *recalculation)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, recalculation := range recalculations {
+		s.auditRecalculation(userID, recalculation)
 	}
+	return recalculations, nil
+}
+
+// SaveSettings saves the user's settings with SettingsService.UpdateSettings. When the base
+// currency changes, every invoice is recalculated in the new one, like RecalculateAllTotals, in the
+// same transaction, so item target amounts never mix base currencies; if an invoice can't be
+// converted (e.g. ErrFXRateUnavailable) nothing is saved. Returns the recalculations, none when the
+// base currency is unchanged.
+func (s *invoiceService) SaveSettings(userID string, settings *models.UserSettings) ([]TotalsRecalculation, error) {
+	previous, err := s.settingsService.GetBaseCurrency(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var recalculations []TotalsRecalculation
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		service := s.withTx(tx)
+		if err := service.settingsService.UpdateSettings(userID, settings); err != nil {
+			return err
+		}
+		if settings.BaseCurrency == previous {
+			return nil
+		}
+		var err error
+		if recalculations, err = service.RecalculateAllTotals(userID); err != nil {
+			return fmt.Errorf("failed to recalculate invoices in %s: %w", settings.BaseCurrency, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return recalculations, nil
+}
+
+// FXRefreshBatchSize is the number of invoices RefreshFXForCurrency re-prices per transaction
+const FXRefreshBatchSize = 100
+
+// RefreshFXForCurrency re-prices the user's invoices in the given currency at the current FX rates:
+// the target amount and rate of every item are recalculated, and the invoice totals updated.
+// Items whose target amount was overridden by hand keep it unless includeOverrides is set.
+// Invoices are re-priced FXRefreshBatchSize at a time, each batch in its own transaction, so a
+// failure (e.g. ErrFXRateUnavailable) rolls back only its batch; the returned count then covers
+// the batches already committed. Returns the number of invoices re-priced.
+func (s *invoiceService) RefreshFXForCurrency(userID string, currency string, includeOverrides bool) (int64, error) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if !currencyCodePattern.MatchString(currency) {
+		return 0, fmt.Errorf("invalid currency %q: must be a 3-letter ISO 4217 code", currency)
+	}
+	baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
+	if err != nil {
+		return 0, err
+	}
+
+	var invoiceIDs []uint
+	if err := s.db.Model(&models.Invoice{}).
+		Where("user_id = ? AND currency = ?", userID, currency).
+		Order("id ASC").
+		Pluck("id", &invoiceIDs).Error; err != nil {
+		return 0, fmt.Errorf("failed to list invoices: %w", err)
+	}
+
+	var refreshed int64
+	for start := 0; start < len(invoiceIDs); start += FXRefreshBatchSize {
+		batch := invoiceIDs[start:min(start+FXRefreshBatchSize, len(invoiceIDs))]
+		err := s.db.Transaction(func(tx *gorm.DB) error {
+			for _, id := range batch {
+				if err := s.recalculateAllItemFX(tx, userID, id, currency, baseCurrency, !includeOverrides); err != nil {
+					return fmt.Errorf("invoice %d: %w", id, err)
+				}
+				if err := s.updateInvoiceTotal(tx, id); err != nil {
+					return fmt.Errorf("invoice %d: %w", id, err)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return refreshed, err
+		}
+		refreshed += int64(len(batch))
+	}
+	return refreshed, nil
+}
 
-	// Get invoice to access currency
+// ClearTargetOverrides puts every item of an invoice back on live FX: target amounts overridden
+// by hand are dropped and each item's target amount and rate recalculated at the current rate,
+// as UpdateInvoiceItem does with forceRecalculate, and the invoice total is updated
+func (s *invoiceService) ClearTargetOverrides(userID string, invoiceID uint) error {
 	var invoice models.Invoice
-	if err := s.db.First(&invoice, existing.InvoiceID).Error; err != nil {
+	if err := s.db.Select("id", "currency").Where("id = ? AND user_id = ?", invoiceID, userID).First(&invoice).Error; err != nil {
+		return fmt.Errorf("%w: %w", ErrInvoiceNotFound, err)
+	}
+	baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
+	if err != nil {
 		return err
 	}
 
-	// Update fields
-	existing.Description = item.Description
-	existing.Quantity = item.Quantity
-	existing.UnitPrice = item.UnitPrice
-	existing.CalculateAmount()
-
-	// Handle target_amount: forceRecalculate takes precedence, then override, then preserve existing
-	if forceRecalculate {
-		// Force recalculation using latest FX rate, ignoring any override
-		s.calculateItemTargetAmount(existing, invoice.Currency)
-	} else if targetAmountOverride != nil {
-		// Manual override - use the provided value
-		existing.TargetCurrency = "USD"
-		existing.TargetAmount = *targetAmountOverride
-		// Calculate the implied FX rate from the override
-		if existing.Amount > 0 {
-			existing.FXRateUsed = *targetAmountOverride / existing.Amount
-		} else {
-			existing.FXRateUsed = 1.0
+	var before, after []models.InvoiceItem
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("invoice_id = ?", invoiceID).Order("id ASC").Find(&before).Error; err != nil {
+			return err
 		}
-	}
-	// else: preserve existing target_amount, target_currency, and fx_rate_used
-
-	return s.db.Transaction(func(tx *gorm.DB) error {
-		if err := tx.Save(existing).Error; err != nil {
+		if err := s.recalculateAllItemFX(tx, userID, invoiceID, invoice.Currency, baseCurrency, false); err != nil {
 			return err
 		}
-		return s.updateInvoiceTotal(tx, existing.InvoiceID)
+		if err := s.updateInvoiceTotal(tx, invoiceID); err != nil {
+			return err
+		}
+		return tx.Where("invoice_id = ?", invoiceID).Order("id ASC").Find(&after).Error
 	})
+	if err != nil {
+		return err
+	}
+
+	for i := range after {
+		if after[i].TargetAmount == before[i].TargetAmount && after[i].FXRateUsed == before[i].FXRateUsed && after[i].FXManual == before[i].FXManual {
+			continue
+		}
+		s.auditService.Record(AuditEntry{
+			UserID:     userID,
+			ActorSub:   userID,
+			EntityType: models.AuditEntityInvoiceItem,
+			EntityID:   after[i].ID,
+			InvoiceID:  invoiceID,
+			Action:     models.AuditActionUpdate,
+			Before:     &before[i],
+			After:      &after[i],
+		})
+	}
+	return nil
 }
 
-// DeleteInvoiceItem deletes an invoice item
-func (s *invoiceService) DeleteInvoiceItem(userID string, itemID uint) error {
-	// Get existing item and verify ownership
-	existing, err := s.GetInvoiceItem(userID, itemID)
+// ClearItemTargetOverride is ClearTargetOverrides for a single item
+func (s *invoiceService) ClearItemTargetOverride(userID string, itemID uint) error {
+	item, err := s.GetInvoiceItem(userID, itemID)
 	if err != nil {
 		return err
 	}
+	return s.UpdateInvoiceItem(userID, itemID, item, nil, true)
+}
 
-	invoiceID := existing.InvoiceID
+// orphanTagMappingsCondition matches tag mappings of the user's tags or invoices whose invoice
+// is not one of the user's live invoices
+const orphanTagMappingsCondition = "(invoice_tag_id IN (SELECT id FROM invoice_tags WHERE user_id = @user) " +
+	"OR invoice_id IN (SELECT id FROM invoices WHERE user_id = @user)) " +
+	"AND invoice_id NOT IN (SELECT id FROM invoices WHERE user_id = @user AND deleted_at IS NULL)"
+
+// orphanItemsCondition matches live items of the user's deleted invoices. Items of invoices
+// removed from the database entirely can't be attributed to a user and are left alone.
+const orphanItemsCondition = "invoice_id IN (SELECT id FROM invoices WHERE user_id = @user AND deleted_at IS NOT NULL)"
+
+// missingReferences lists the invoice columns CleanupOrphans checks with the table they reference
+var missingReferences = []struct {
+	column string
+	table  string
+}{
+	{"category_id", "invoice_categories"},
+	{"company_id", "invoice_companies"},
+	{"receiver_id", "invoice_receivers"},
+}
 
-	return s.db.Transaction(func(tx *gorm.DB) error {
-		if err := tx.Delete(existing).Error; err != nil {
-			return err
+// CleanupOrphans finds broken relations in the user's data: tag mappings pointing at deleted
+// invoices, live items of deleted invoices, and invoices referencing a category, company, or
+// receiver that was deleted (or belongs to another user). Unless dryRun, the mappings and items
+// are deleted and the references cleared in one transaction, and every row deleted or cleared is
+// audited on its invoice.
+func (s *invoiceService) CleanupOrphans(userID string, dryRun bool) (*CleanupReport, error) {
+	report := &CleanupReport{DryRun: dryRun}
+	user := sql.Named("user", userID)
+	counts := []*int64{&report.MissingCategories, &report.MissingCompanies, &report.MissingReceivers}
+
+	var entries []AuditEntry
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.InvoiceTagMapping{}).Where(orphanTagMappingsCondition, user).
+			Count(&report.OrphanTagMappings).Error; err != nil {
+			return fmt.Errorf("failed to count orphan tag mappings: %w", err)
+		}
+		if err := tx.Model(&models.InvoiceItem{}).Where(orphanItemsCondition, user).
+			Count(&report.OrphanItems).Error; err != nil {
+			return fmt.Errorf("failed to count orphan items: %w", err)
 		}
-		return s.updateInvoiceTotal(tx, invoiceID)
-	})
-}
 
-// GetInvoiceItem retrieves an invoice item and verifies user ownership
-func (s *invoiceService) GetInvoiceItem(userID string, itemID uint) (*models.InvoiceItem, error) {
-	var item models.InvoiceItem
-	err := s.db.First(&item, itemID).Error
+		brokenInvoices := func(column, table string) *gorm.DB {
+			return tx.Model(&models.Invoice{}).
+				Where("user_id = ?", userID).
+				Where(column+" IS NOT NULL AND "+column+" NOT IN (SELECT id FROM "+table+" WHERE user_id = ? AND deleted_at IS NULL)", userID)
+		}
+		for i, ref := range missingReferences {
+			if err := brokenInvoices(ref.column, ref.table).Count(counts[i]).Error; err != nil {
+				return fmt.Errorf("failed to count invoices with a missing %s: %w", ref.column, err)
+			}
+		}
+
+		if dryRun {
+			return nil
+		}
+
+		var mappings []models.InvoiceTagMapping
+		if err := tx.Where(orphanTagMappingsCondition, user).Find(&mappings).Error; err != nil {
+			return fmt.Errorf("failed to load orphan tag mappings: %w", err)
+		}
+		for _, mapping := range mappings {
+			entries = append(entries, AuditEntry{
+				UserID:     userID,
+				ActorSub:   userID,
+				EntityType: models.AuditEntityInvoice,
+				EntityID:   mapping.InvoiceID,
+				InvoiceID:  mapping.InvoiceID,
+				Action:     models.AuditActionUpdate,
+				Before:     map[string]interface{}{"tag_id": mapping.TagID},
+				After:      map[string]interface{}{"tag_id": nil},
+			})
+		}
+		if err := tx.Where(orphanTagMappingsCondition, user).Delete(&models.InvoiceTagMapping{}).Error; err != nil {
+			return fmt.Errorf("failed to delete orphan tag mappings: %w", err)
+		}
+
+		var items []models.InvoiceItem
+		if err := tx.Where(orphanItemsCondition, user).Find(&items).Error; err != nil {
+			return fmt.Errorf("failed to load orphan items: %w", err)
+		}
+		for i := range items {
+			entries = append(entries, AuditEntry{
+				UserID:     userID,
+				ActorSub:   userID,
+				EntityType: models.AuditEntityInvoiceItem,
+				EntityID:   items[i].ID,
+				InvoiceID:  items[i].InvoiceID,
+				Action:     models.AuditActionDelete,
+				Before:     &items[i],
+			})
+		}
+		if err := tx.Where(orphanItemsCondition, user).Delete(&models.InvoiceItem{}).Error; err != nil {
+			return fmt.Errorf("failed to delete orphan items: %w", err)
+		}
+
+		for _, ref := range missingReferences {
+			var broken []struct {
+				ID        uint
+				Reference uint
+			}
+			if err := brokenInvoices(ref.column, ref.table).Select("id, " + ref.column + " AS reference").
+				Scan(&broken).Error; err != nil {
+				return fmt.Errorf("failed to load invoices with a missing %s: %w", ref.column, err)
+			}
+			for _, invoice := range broken {
+				entries = append(entries, AuditEntry{
+					UserID:     userID,
+					ActorSub:   userID,
+					EntityType: models.AuditEntityInvoice,
+					EntityID:   invoice.ID,
+					InvoiceID:  invoice.ID,
+					Action:     models.AuditActionUpdate,
+					Before:     map[string]interface{}{ref.column: invoice.Reference},
+					After:      map[string]interface{}{ref.column: nil},
+				})
+			}
+			if err := brokenInvoices(ref.column, ref.table).Updates(map[string]interface{}{
+				ref.column: nil,
+				"version":  gorm.Expr("version + 1"),
+			}).Error; err != nil {
+				return fmt.Errorf("failed to clear missing %s: %w", ref.column, err)
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("item not found: %w", err)
+		return nil, err
 	}
 
-	// Verify invoice ownership
+	for _, entry := range entries {
+		s.auditService.Record(entry)
+	}
+	return report, nil
+}
+
+// recalculateTotals recalculates one invoice's totals within tx
+func (s *invoiceService) recalculateTotals(tx *gorm.DB, userID string, invoiceID uint, baseCurrency string) (*TotalsRecalculation, error) {
 	var invoice models.Invoice
-	err = s.db.Where("id = ? AND user_id = ?", item.InvoiceID, userID).First(&invoice).Error
-	if err != nil {
-		return nil, fmt.Errorf("unauthorized: %w", err)
+	if err := tx.Select("id", "currency", "amount").Where("id = ? AND user_id = ?", invoiceID, userID).First(&invoice).Error; err != nil {
+		return nil, fmt.Errorf("invoice not found: %w", err)
 	}
 
-	return &item, nil
-}
+	recalculation := &TotalsRecalculation{InvoiceID: invoiceID, AmountBefore: invoice.Amount}
+	var err error
+	if recalculation.TargetAmountBefore, err = sumItemTargetAmounts(tx, invoiceID, baseCurrency); err != nil {
+		return nil, err
+	}
 
-// UpdateInvoiceStatus updates only the status of an invoice
-func (s *invoiceService) UpdateInvoiceStatus(userID string, id uint, status models.InvoiceStatus) error {
-	result := s.db.Model(&models.Invoice{}).
-		Where("id = ? AND user_id = ?", id, userID).
-		Update("status", status)
+	// Item target amounts come first: the invoice discount is spread over them when the total is updated
+	if err := s.recalculateAllItemFX(tx, userID, invoiceID, invoice.Currency, baseCurrency, false); err != nil {
+		return nil, err
+	}
+	if err := s.updateInvoiceTotal(tx, invoiceID); err != nil {
+		return nil, err
+	}
 
-	if result.Error != nil {
-		return result.Error
+	if err := tx.Model(&models.Invoice{}).Where("id = ?", invoiceID).Pluck("amount", &recalculation.AmountAfter).Error; err != nil {
+		return nil, err
 	}
-	if result.RowsAffected == 0 {
-		return fmt.Errorf("invoice not found")
+	if recalculation.TargetAmountAfter, err = sumItemTargetAmounts(tx, invoiceID, baseCurrency); err != nil {
+		return nil, err
 	}
-	return nil
+	return recalculation, nil
 }
 
-// GetOverdueInvoices returns all overdue invoices for a user
-func (s *invoiceService) GetOverdueInvoices(userID string) ([]models.Invoice, error) {
-	var invoices []models.Invoice
-	now := time.Now()
-
-	err := s.db.Where("user_id = ? AND status = ? AND due_date < ?",
-		userID, models.InvoiceStatusUnpaid, now).
-		Preload("Category").
-		Preload("Company").
-		Preload("Receiver").
-		Preload("Items").
-		Preload("Tags").
-		Order("due_date ASC").
-		Find(&invoices).Error
+// auditRecalculation records a corrected invoice amount in the audit trail
+func (s *invoiceService) auditRecalculation(userID string, recalculation TotalsRecalculation) {
+	s.auditService.Record(AuditEntry{
+		UserID:     userID,
+		ActorSub:   userID,
+		EntityType: models.AuditEntityInvoice,
+		EntityID:   recalculation.InvoiceID,
+		InvoiceID:  recalculation.InvoiceID,
+		Action:     models.AuditActionUpdate,
+		Before:     map[string]interface{}{"amount": recalculation.AmountBefore},
+		After:      map[string]interface{}{"amount": recalculation.AmountAfter},
+	})
+}
 
-	return invoices, err
+// sumItemTargetAmounts returns the sum of an invoice's item target amounts, rounded to the base currency
+func sumItemTargetAmounts(tx *gorm.DB, invoiceID uint, baseCurrency string) (float64, error) {
+	var total float64
+	if err := tx.Model(&models.InvoiceItem{}).
+		Where("invoice_id = ?", invoiceID).
+		Select("COALESCE(SUM(target_amount), 0)").
+		Scan(&total).Error; err != nil {
+		return 0, err
+	}
+	return utils.RoundToCurrency(total, baseCurrency), nil
 }
 
-// updateInvoiceTotal recalculates and updates the invoice total amount from items
-func (s *invoiceService) updateInvoiceTotal(tx *gorm.DB, invoiceID uint) error {
-	var result struct {
-		TotalAmount float64
+// ExplainTotal breaks an invoice's totals down by item without changing anything: the rate and
+// rate date each item was converted at, whether it was overridden by hand or converted at a stale
+// rate, and where the stored amounts differ from the ones recomputed from the items. Items are
+// converted to the base currency of the invoice's creator, which members of its organization
+// may not share.
+func (s *invoiceService) ExplainTotal(userID string, invoiceID uint) (*TotalExplanation, error) {
+	invoice, err := s.GetInvoiceByID(userID, invoiceID)
+	if err != nil {
+		return nil, err
 	}
-	err := tx.Model(&models.InvoiceItem{}).
-		Where("invoice_id = ?", invoiceID).
-		Select("COALESCE(SUM(amount), 0) as total_amount").
-		Scan(&result).Error
+	baseCurrency, err := s.settingsService.GetBaseCurrency(invoice.UserID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// Save updates
-	return tx.Model(&models.Invoice{}).
-		Where("id = ?", invoiceID).
-		Update("amount", result.TotalAmount).Error
-}
-
-// recalculateAllItemFX recalculates FX for all items when currency changes
-func (s *invoiceService) recalculateAllItemFX(tx *gorm.DB, invoiceID uint, currency string) error {
-	// Get all items for this invoice
-	var items []models.InvoiceItem
-	if err := tx.Where("invoice_id = ?", invoiceID).Find(&items).Error; err != nil {
-		return err
+	explanation := &TotalExplanation{
+		InvoiceID:      invoice.ID,
+		Currency:       invoice.Currency,
+		DiscountType:   invoice.DiscountType,
+		DiscountValue:  invoice.DiscountValue,
+		Amount:         invoice.Amount,
+		TargetCurrency: baseCurrency,
+		Items:          make([]ItemExplanation, 0, len(invoice.Items)),
+		Mismatches:     []string{},
 	}
 
-	// Recalculate FX for each item
-	for i := range items {
-		s.calculateItemTargetAmount(&items[i], currency)
+	// Recompute the target amounts the way rescaleItemTargets does, from the stored rates
+	expected := make([]models.InvoiceItem, len(invoice.Items))
+	var itemsAmount float64
+	for i, item := range invoice.Items {
+		itemsAmount += item.Amount
+		expected[i] = item
+		if !item.FXManual {
+			expected[i].TargetAmount = utils.RoundToCurrency(item.Amount*item.FXRateUsed, item.TargetCurrency)
+		}
+	}
+	if err := s.applyInvoiceDiscount(invoice, expected); err != nil {
+		return nil, err
+	}
 
-		// Update item
-		if err := tx.Model(&models.InvoiceItem{}).
-			Where("id = ?", items[i].ID).
-			Updates(map[string]interface{}{
-				"target_currency": items[i].TargetCurrency,
-				"target_amount":   items[i].TargetAmount,
-				"fx_rate_used":    items[i].FXRateUsed,
-			}).Error; err != nil {
-			return err
+	number := func(v float64) string { return strconv.FormatFloat(v, 'f', -1, 64) }
+	var totalTarget float64
+	for i, item := range invoice.Items {
+		explanation.Items = append(explanation.Items, ItemExplanation{
+			ItemID:               item.ID,
+			Description:          item.Description,
+			Amount:               item.Amount,
+			Currency:             item.EffectiveCurrency(invoice.Currency),
+			FXRateUsed:           item.FXRateUsed,
+			FXRateDate:           item.FXRateDate,
+			TargetCurrency:       item.TargetCurrency,
+			TargetAmount:         item.TargetAmount,
+			ExpectedTargetAmount: expected[i].TargetAmount,
+			Manual:               item.FXManual,
+			Stale:                item.FXStale,
+		})
+		totalTarget += item.TargetAmount
+		explanation.HasManualOverride = explanation.HasManualOverride || item.FXManual
+		explanation.HasStaleRate = explanation.HasStaleRate || item.FXStale
+
+		if item.TargetCurrency != baseCurrency {
+			explanation.Mismatches = append(explanation.Mismatches, fmt.Sprintf(
+				"item %d (%s) is converted to %s instead of the base currency %s",
+				item.ID, item.Description, item.TargetCurrency, baseCurrency))
+		} else if utils.RoundToCurrency(item.TargetAmount-expected[i].TargetAmount, baseCurrency) != 0 {
+			explanation.Mismatches = append(explanation.Mismatches, fmt.Sprintf(
+				"item %d (%s) has target amount %s %s but %s %s at rate %s gives %s %s",
+				item.ID, item.Description, number(item.TargetAmount), item.TargetCurrency,
+				number(item.Amount), item.EffectiveCurrency(invoice.Currency),
+				number(item.FXRateUsed),
+				number(expected[i].TargetAmount), item.TargetCurrency))
 		}
 	}
+	explanation.TotalTargetAmount = utils.RoundToCurrency(totalTarget, baseCurrency)
+
+	explanation.ExpectedAmount = utils.RoundToCurrency(
+		models.ApplyDiscount(itemsAmount, invoice.DiscountType, invoice.DiscountValue), invoice.Currency)
+	if utils.RoundToCurrency(invoice.Amount-explanation.ExpectedAmount, invoice.Currency) != 0 {
+		explanation.Mismatches = append(explanation.Mismatches, fmt.Sprintf(
+			"stored amount %s %s differs from the items' total %s %s",
+			number(invoice.Amount), invoice.Currency, number(explanation.ExpectedAmount), invoice.Currency))
+	}
 
-	return nil
+	return explanation, nil
 }
 
 // SetInvoiceTags sets the tags for an invoice by tag names
 // It will look up existing tags or create new ones as needed
 func (s *invoiceService) SetInvoiceTags(userID string, invoiceID uint, tagNames []string) error {
 	// Verify invoice ownership
-	_, err := s.GetInvoiceByID(userID, invoiceID)
+	_, err := s.getOwnInvoice(userID, invoiceID)
 	if err != nil {
 		return fmt.Errorf("invoice not found: %w", err)
 	}
@@ -519,10 +2910,14 @@ func (s *invoiceService) SetInvoiceTags(userID string, invoiceID uint, tagNames
 			err := tx.Where("user_id = ? AND name = ?", userID, tagName).First(&tag).Error
 			if err != nil {
 				// Create new tag
+				color, err := nextTagColor(tx, userID)
+				if err != nil {
+					return err
+				}
 				tag = models.InvoiceTag{
 					UserID: userID,
 					Name:   tagName,
-					Color:  "#6B7280", // Default gray color
+					Color:  color,
 				}
 				if err := tx.Create(&tag).Error; err != nil {
 					return err
@@ -546,7 +2941,7 @@ func (s *invoiceService) SetInvoiceTags(userID string, invoiceID uint, tagNames
 // SetInvoiceTagsByID sets tags for an invoice using tag IDs
 func (s *invoiceService) SetInvoiceTagsByID(userID string, invoiceID uint, tagIDs []int) error {
 	// Verify invoice ownership
-	_, err := s.GetInvoiceByID(userID, invoiceID)
+	_, err := s.getOwnInvoice(userID, invoiceID)
 	if err != nil {
 		return fmt.Errorf("invoice not found: %w", err)
 	}
@@ -583,28 +2978,274 @@ func (s *invoiceService) SetInvoiceTagsByID(userID string, invoiceID uint, tagID
 	})
 }
 
-// calculateItemTargetAmount calculates and sets the target amount (USD) for an invoice item
-func (s *invoiceService) calculateItemTargetAmount(item *models.InvoiceItem, invoiceCurrency string) {
-	// Default target currency to USD
-	item.TargetCurrency = "USD"
+// BulkAddTags adds tags to every invoice matching the filters of opts (sorting and pagination
+// are ignored). Existing mappings are left untouched, so repeated calls are safe.
+// All tags must belong to the user. Returns the number of invoices that gained at least one tag.
+func (s *invoiceService) BulkAddTags(userID string, opts InvoiceListOptions, tagIDs []uint) (int64, error) {
+	uniqueTagIDs := make([]uint, 0, len(tagIDs))
+	seen := make(map[uint]bool, len(tagIDs))
+	for _, id := range tagIDs {
+		if !seen[id] {
+			seen[id] = true
+			uniqueTagIDs = append(uniqueTagIDs, id)
+		}
+	}
+	if len(uniqueTagIDs) == 0 {
+		return 0, fmt.Errorf("at least one tag ID is required")
+	}
+
+	var affected int64
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var ownedTags int64
+		if err := tx.Model(&models.InvoiceTag{}).
+			Where("user_id = ? AND id IN ?", userID, uniqueTagIDs).
+			Count(&ownedTags).Error; err != nil {
+			return err
+		}
+		if ownedTags != int64(len(uniqueTagIDs)) {
+			return fmt.Errorf("one or more tags not found or not owned by user")
+		}
 
-	// If no FX service, use 1:1 rate
-	if s.fxService == nil {
-		item.TargetAmount = item.Amount
-		item.FXRateUsed = 1.0
-		return
+		query, err := applyInvoiceFilters(tx.Model(&models.Invoice{}).Where("user_id = ?", userID), opts)
+		if err != nil {
+			return err
+		}
+		var invoiceIDs []uint
+		if err := query.Pluck("id", &invoiceIDs).Error; err != nil {
+			return err
+		}
+		if len(invoiceIDs) == 0 {
+			return nil
+		}
+
+		// Count invoices missing at least one of the tags before inserting
+		if err := tx.Model(&models.Invoice{}).
+			Where("id IN ?", invoiceIDs).
+			Where("(SELECT COUNT(*) FROM invoice_tag_mappings WHERE invoice_id = invoices.id AND invoice_tag_id IN ?) < ?",
+				uniqueTagIDs, len(uniqueTagIDs)).
+			Count(&affected).Error; err != nil {
+			return err
+		}
+
+		mappings := make([]models.InvoiceTagMapping, 0, len(invoiceIDs)*len(uniqueTagIDs))
+		for _, invoiceID := range invoiceIDs {
+			for _, tagID := range uniqueTagIDs {
+				mappings = append(mappings, models.InvoiceTagMapping{InvoiceID: invoiceID, TagID: tagID})
+			}
+		}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(&mappings, 500).Error
+	})
+	if err != nil {
+		return 0, err
 	}
 
-	// If same currency, no conversion needed
-	if invoiceCurrency == "USD" {
-		item.TargetAmount = item.Amount
-		item.FXRateUsed = 1.0
-		return
+	return affected, nil
+}
+
+// calculateItemTargetAmount calculates and sets the target amount (in the user's base currency) for an invoice item,
+// converting from the item's own currency when it has one. Fails when no exchange rate is available,
+// rather than guessing one that would corrupt the totals. An item in a currency that isn't ISO 4217
+// has no rate to wait for: unless the user's unsupported_currency setting rejects it (see
+// checkCurrencySupported), it is taken 1:1 and flagged FXUnsupported. Every path that prices items
+// goes through here, so the setting holds for recalculations and FX refreshes as well.
+func (s *invoiceService) calculateItemTargetAmount(userID string, item *models.InvoiceItem, invoiceCurrency, baseCurrency string) error {
+	// Without an FX service or when already in the base currency, the rate is 1:1
+	targetAmount, rate, err := convertItemAmount(context.Background(), s.fxService, item, invoiceCurrency, baseCurrency)
+	unsupported := errors.Is(err, ErrUnsupportedCurrency)
+	if unsupported {
+		if err := s.checkCurrencySupported(userID, item.EffectiveCurrency(invoiceCurrency)); err != nil {
+			return fmt.Errorf("failed to convert item %q to %s: %w", item.Description, baseCurrency, err)
+		}
+		targetAmount = utils.RoundToCurrency(item.Amount, baseCurrency)
+		rate = &ExchangeRate{Rate: 1.0}
+	} else if err != nil {
+		return fmt.Errorf("failed to convert item %q to %s: %w", item.Description, baseCurrency, err)
+	}
+	item.TargetCurrency = baseCurrency
+	item.TargetAmount = targetAmount
+	item.FXRateUsed = rate.Rate
+	item.FXStale = rate.Stale
+	item.FXRateDate = rate.Date
+	item.FXManual = false
+	item.FXUnsupported = unsupported
+	return nil
+}
+
+// checkItemCategory rejects an item attributed to a category that isn't the user's
+func (s *invoiceService) checkItemCategory(userID string, item *models.InvoiceItem) error {
+	if item.CategoryID == nil {
+		return nil
+	}
+	if err := s.db.Where("id = ? AND user_id = ?", *item.CategoryID, userID).First(&models.InvoiceCategory{}).Error; err != nil {
+		return fmt.Errorf("category not found: %w", err)
 	}
+	return nil
+}
+
+// checkCurrencySupported rejects a currency that isn't ISO 4217, and so has no exchange rate,
+// unless the user's unsupported_currency setting passes such currencies through. An empty
+// currency (an item in the invoice currency) is fine.
+func (s *invoiceService) checkCurrencySupported(userID, currency string) error {
+	if currency == "" || utils.IsISOCurrency(currency) {
+		return nil
+	}
+	if s.settingsService.GetUnsupportedCurrencyPolicy(userID) == models.UnsupportedCurrencyPassThrough {
+		return nil
+	}
+	return fmt.Errorf("%w %q: not an ISO 4217 currency code (set unsupported_currency to %q in settings to store such amounts unconverted)",
+		ErrUnsupportedCurrency, currency, models.UnsupportedCurrencyPassThrough)
+}
+
+// checkItemFinite rejects an item whose quantity or unit price is not a finite number
+func checkItemFinite(item *models.InvoiceItem) error {
+	if math.IsNaN(item.Quantity) || math.IsInf(item.Quantity, 0) {
+		return fmt.Errorf("quantity must be a finite number")
+	}
+	if math.IsNaN(item.UnitPrice) || math.IsInf(item.UnitPrice, 0) {
+		return fmt.Errorf("unit_price must be a finite number")
+	}
+	return nil
+}
+
+// checkItemLimits rejects an item whose quantity or unit price is not a finite number, or whose
+// quantity, unit price, or amount exceeds the user's limits in magnitude. Prices are compared in
+// the base currency at the item's FX rate, so it must run after the target amount is computed.
+func checkItemLimits(item *models.InvoiceItem, limits ItemLimits) error {
+	if err := checkItemFinite(item); err != nil {
+		return err
+	}
+
+	rate := item.FXRateUsed
+	if rate <= 0 {
+		rate = 1
+	}
+	number := func(v float64) string { return strconv.FormatFloat(v, 'f', -1, 64) }
+	if math.Abs(item.Quantity) > limits.MaxQuantity {
+		return fmt.Errorf("quantity %s exceeds the limit of %s (max_item_quantity in settings)",
+			number(item.Quantity), number(limits.MaxQuantity))
+	}
+	if math.Abs(item.UnitPrice*rate) > limits.MaxUnitPrice {
+		return fmt.Errorf("unit_price %s exceeds the limit of %s %s (max_item_unit_price in settings)",
+			number(item.UnitPrice), number(limits.MaxUnitPrice), item.TargetCurrency)
+	}
+	if math.Abs(item.Amount*rate) > limits.MaxLineAmount {
+		return fmt.Errorf("item amount %s exceeds the limit of %s %s (max_item_line_amount in settings)",
+			number(item.Amount), number(limits.MaxLineAmount), item.TargetCurrency)
+	}
+	return nil
+}
+
+// validateTaxRate checks that a tax rate, if set, is a percentage between 0 and 100
+func validateTaxRate(field string, rate *float64) error {
+	if rate == nil {
+		return nil
+	}
+	if math.IsNaN(*rate) || math.IsInf(*rate, 0) || *rate < 0 || *rate > 100 {
+		return fmt.Errorf("%s must be a percentage between 0 and 100", field)
+	}
+	return nil
+}
+
+// validateDiscount checks a discount type and value; percent discounts must be between 0 and 100
+func validateDiscount(discountType models.DiscountType, value float64) error {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return fmt.Errorf("discount_value must be a finite number")
+	}
+	switch discountType {
+	case "":
+		if value != 0 {
+			return fmt.Errorf("discount_value requires a discount_type")
+		}
+	case models.DiscountTypePercent:
+		if value < 0 || value > 100 {
+			return fmt.Errorf("percent discount must be between 0 and 100")
+		}
+	case models.DiscountTypeFixed:
+		if value < 0 {
+			return fmt.Errorf("fixed discount must not be negative")
+		}
+	default:
+		return fmt.Errorf("invalid discount_type %q: must be percent or fixed", discountType)
+	}
+	return nil
+}
+
+// validateInvoiceStatus checks that status is one of the invoice statuses
+func validateInvoiceStatus(status models.InvoiceStatus) error {
+	switch status {
+	case models.InvoiceStatusPaid, models.InvoiceStatusUnpaid, models.InvoiceStatusOverdue:
+		return nil
+	default:
+		return fmt.Errorf("invalid status %q (expected paid, unpaid, or overdue)", status)
+	}
+}
+
+// ExpectedAmountWarning compares an invoice's amount, computed from its items, with the amount the
+// caller expected, such as the total printed on the document the invoice was entered from. Amounts
+// within one unit of the currency's precision (0.01 for USD) match, absorbing rounding; otherwise it
+// returns a warning naming both. Mismatches are only reported and never fail creation.
+func ExpectedAmountWarning(invoice *models.Invoice, expected float64) string {
+	precision := utils.CurrencyPrecision(invoice.Currency)
+	tolerance := math.Pow10(-precision)
+	difference := invoice.Amount - expected
+	if math.Abs(difference) <= tolerance+1e-9 {
+		return ""
+	}
+
+	format := func(amount float64) string { return strconv.FormatFloat(amount, 'f', precision, 64) }
+	return fmt.Sprintf("item total %s %s does not match expected amount %s %s (difference %s)",
+		invoice.Currency, format(invoice.Amount), invoice.Currency, format(expected), format(difference))
+}
+
+// normalizePaymentMethod trims an invoice's payment method and checks it fits models.MaxPaymentMethodLength
+func normalizePaymentMethod(invoice *models.Invoice) error {
+	invoice.PaymentMethod = strings.TrimSpace(invoice.PaymentMethod)
+	if utf8.RuneCountInString(invoice.PaymentMethod) > models.MaxPaymentMethodLength {
+		return fmt.Errorf("invalid payment method: must be at most %d characters", models.MaxPaymentMethodLength)
+	}
+	return nil
+}
+
+// normalizeItemUnit trims an item's unit of measure and checks it fits models.MaxItemUnitLength
+func normalizeItemUnit(item *models.InvoiceItem) error {
+	item.Unit = strings.TrimSpace(item.Unit)
+	if utf8.RuneCountInString(item.Unit) > models.MaxItemUnitLength {
+		return fmt.Errorf("invalid item unit %q: must be at most %d characters", item.Unit, models.MaxItemUnitLength)
+	}
+	return nil
+}
+
+// applyCategoryDefaults fills in the currency and tax rate of a new invoice that has none from its
+// category's defaults, falling back to USD and no tax rate. Values given on the invoice always take
+// precedence, including a tax rate of 0.
+func (s *invoiceService) applyCategoryDefaults(userID string, invoice *models.Invoice) {
+	if (invoice.Currency == "" || invoice.TaxRate == nil) && invoice.CategoryID != nil {
+		var category models.InvoiceCategory
+		if err := s.db.Select("default_currency", "default_tax_rate").
+			Where("id = ? AND user_id = ?", *invoice.CategoryID, userID).
+			First(&category).Error; err == nil {
+			if invoice.Currency == "" {
+				invoice.Currency = category.DefaultCurrency
+			}
+			if invoice.TaxRate == nil && category.DefaultTaxRate != 0 {
+				invoice.TaxRate = &category.DefaultTaxRate
+			}
+		}
+	}
+	if invoice.Currency == "" {
+		invoice.Currency = "USD"
+	}
+}
 
-	// Convert to USD
-	ctx := context.Background()
-	convertedAmount, rate, _ := s.fxService.ConvertAmount(ctx, item.Amount, invoiceCurrency, "USD")
-	item.TargetAmount = convertedAmount
-	item.FXRateUsed = rate
+// normalizeItemCurrency upper-cases an item's own currency and validates it as an ISO 4217 code.
+// An empty currency is left as is, meaning the item uses the invoice currency.
+func normalizeItemCurrency(item *models.InvoiceItem) error {
+	if item.Currency == "" {
+		return nil
+	}
+	item.Currency = strings.ToUpper(strings.TrimSpace(item.Currency))
+	if !currencyCodePattern.MatchString(item.Currency) {
+		return fmt.Errorf("invalid item currency %q: must be a 3-letter ISO 4217 code", item.Currency)
+	}
+	return nil
 }
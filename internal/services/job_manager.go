@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultJobDrainTimeout is how long shutdown waits for running background jobs when no timeout is configured
+const DefaultJobDrainTimeout = 30 * time.Second
+
+// ErrJobDrainTimeout is returned by JobManager.Shutdown when jobs are still running at the drain timeout
+var ErrJobDrainTimeout = errors.New("timed out waiting for background jobs")
+
+// JobManager runs the server's background jobs (overdue reminders, exports) so shutdown can wait
+// for them. Every job gets the manager's context, which is cancelled when Shutdown starts: jobs
+// check it to stop starting new work and finish what they are doing. Once Shutdown has started,
+// no new job is started.
+type JobManager struct {
+	ctx          context.Context
+	cancel       context.CancelFunc
+	drainTimeout time.Duration
+
+	mu       sync.Mutex
+	stopping bool
+	wg       sync.WaitGroup
+	running  atomic.Int64
+}
+
+// NewJobManager creates a JobManager whose Shutdown waits up to drainTimeout for running jobs
+func NewJobManager(drainTimeout time.Duration) *JobManager {
+	if drainTimeout <= 0 {
+		drainTimeout = DefaultJobDrainTimeout
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &JobManager{ctx: ctx, cancel: cancel, drainTimeout: drainTimeout}
+}
+
+// Go runs job in a new goroutine with the manager's context. It reports false, without running
+// the job, once Shutdown has started.
+func (m *JobManager) Go(job func(ctx context.Context)) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.stopping {
+		return false
+	}
+
+	m.wg.Add(1)
+	m.running.Add(1)
+	go func() {
+		defer m.wg.Done()
+		defer m.running.Add(-1)
+		job(m.ctx)
+	}()
+	return true
+}
+
+// Running returns the number of jobs that haven't returned yet
+func (m *JobManager) Running() int64 {
+	return m.running.Load()
+}
+
+// Shutdown stops new jobs from starting, cancels the jobs' context, and waits for the running jobs
+// to return, at most the drain timeout. Jobs still running then are abandoned and ErrJobDrainTimeout
+// is returned.
+func (m *JobManager) Shutdown() error {
+	m.mu.Lock()
+	m.stopping = true
+	m.mu.Unlock()
+	m.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		m.wg.Wait()
+		close(done)
+	}()
+
+	timer := time.NewTimer(m.drainTimeout)
+	defer timer.Stop()
+	select {
+	case <-done:
+		return nil
+	case <-timer.C:
+		return fmt.Errorf("%w: %d still running after %s", ErrJobDrainTimeout, m.Running(), m.drainTimeout)
+	}
+}
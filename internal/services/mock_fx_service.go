@@ -3,6 +3,8 @@ package services
 import (
 	"context"
 	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
 )
 
 // MockFXService implements FXService with configurable exchange rates for testing
@@ -38,7 +40,7 @@ func (m *MockFXService) GetExchangeRate(ctx context.Context, fromCurrency, toCur
 	key := fromCurrency + ":" + toCurrency
 	rate, ok := m.rates[key]
 	if !ok {
-		// Default to 1.0 if rate not configured (fail-soft like real service)
+		// Default to 1.0 if rate not configured so tests only set the rates they check
 		rate = 1.0
 	}
 
@@ -59,3 +61,14 @@ func (m *MockFXService) ConvertAmount(ctx context.Context, amount float64, fromC
 	}
 	return amount * rate.Rate, rate.Rate, nil
 }
+
+// PreviewConversion implements FXService
+func (m *MockFXService) PreviewConversion(ctx context.Context, items []models.InvoiceItem, fromCurrency, toCurrency string) (*ConversionPreview, error) {
+	return previewConversion(ctx, m, items, fromCurrency, toCurrency)
+}
+
+// LastSuccessfulFetch implements FXService
+// The mock never calls a provider, so it always returns nil
+func (m *MockFXService) LastSuccessfulFetch() *time.Time {
+	return nil
+}
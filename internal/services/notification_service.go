@@ -0,0 +1,161 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"net/smtp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+)
+
+// DefaultSMTPPort is the SMTP submission port used when none is configured
+const DefaultSMTPPort = 587
+
+// SMTPConfig holds the SMTP server used to send notification emails
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+
+	// SendMail delivers a message; defaults to smtp.SendMail (overridable for tests)
+	SendMail func(addr string, auth smtp.Auth, from string, to []string, msg []byte) error
+}
+
+// Configured reports whether enough of the config is set to send email
+func (c SMTPConfig) Configured() bool {
+	return c.Host != "" && c.From != ""
+}
+
+// NotificationService sends email notifications to users
+type NotificationService interface {
+	// SendOverdueDigest emails the user one digest listing their overdue invoices.
+	// It does nothing (and logs why) when SMTP is not configured, the user has no
+	// notification email, or there are no invoices.
+	SendOverdueDigest(userID string, invoices []models.Invoice) error
+}
+
+type notificationService struct {
+	config          SMTPConfig
+	settingsService SettingsService
+}
+
+// NewNotificationService creates a new NotificationService instance
+func NewNotificationService(config SMTPConfig, settingsService SettingsService) NotificationService {
+	if config.Port == 0 {
+		config.Port = DefaultSMTPPort
+	}
+	if config.SendMail == nil {
+		config.SendMail = smtp.SendMail
+	}
+	return &notificationService{
+		config:          config,
+		settingsService: settingsService,
+	}
+}
+
+// SendOverdueDigest emails the user one digest listing their overdue invoices
+func (s *notificationService) SendOverdueDigest(userID string, invoices []models.Invoice) error {
+	if !s.config.Configured() {
+		log.Printf("SMTP not configured, skipping overdue digest for user %s", userID)
+		return nil
+	}
+	if len(invoices) == 0 {
+		return nil
+	}
+
+	settings, err := s.settingsService.GetSettings(userID)
+	if err != nil {
+		return fmt.Errorf("failed to get settings: %w", err)
+	}
+	if settings.Email == "" {
+		log.Printf("No notification email set, skipping overdue digest for user %s", userID)
+		return nil
+	}
+
+	subject, body := overdueDigest(invoices, time.Now())
+	msg := buildEmail(s.config.From, settings.Email, subject, body)
+
+	var auth smtp.Auth
+	if s.config.Username != "" {
+		auth = smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host)
+	}
+	addr := s.config.Host + ":" + strconv.Itoa(s.config.Port)
+	if err := s.config.SendMail(addr, auth, s.config.From, []string{settings.Email}, msg); err != nil {
+		return fmt.Errorf("failed to send overdue digest: %w", err)
+	}
+	return nil
+}
+
+// overdueDigest renders the subject and plain-text body of an overdue digest.
+// Invoices are listed most overdue first, followed by the total owed per currency.
+func overdueDigest(invoices []models.Invoice, now time.Time) (string, string) {
+	sorted := make([]models.Invoice, len(invoices))
+	copy(sorted, invoices)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return daysOverdue(&sorted[i], now) > daysOverdue(&sorted[j], now)
+	})
+
+	subject := fmt.Sprintf("You have %d overdue invoice", len(sorted))
+	if len(sorted) != 1 {
+		subject += "s"
+	}
+
+	var body strings.Builder
+	body.WriteString("The following invoices are past their due date:\r\n\r\n")
+
+	totals := make(map[string]float64)
+	for i := range sorted {
+		invoice := &sorted[i]
+		totals[invoice.Currency] += invoice.Amount
+
+		days := daysOverdue(invoice, now)
+		unit := "days"
+		if days == 1 {
+			unit = "day"
+		}
+		fmt.Fprintf(&body, "- %s %s: %.2f %s, due %s (%d %s overdue)\r\n",
+			invoice.DisplayNumber(), invoice.Title, invoice.Amount, invoice.Currency,
+			invoice.DueDate.Format("2006-01-02"), days, unit)
+	}
+
+	currencies := make([]string, 0, len(totals))
+	for currency := range totals {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+
+	body.WriteString("\r\nTotal overdue:\r\n")
+	for _, currency := range currencies {
+		fmt.Fprintf(&body, "- %.2f %s\r\n", totals[currency], currency)
+	}
+
+	return subject, body.String()
+}
+
+// daysOverdue returns the number of whole days since the invoice's due date
+func daysOverdue(invoice *models.Invoice, now time.Time) int {
+	if invoice.DueDate == nil || !now.After(*invoice.DueDate) {
+		return 0
+	}
+	return int(now.Sub(*invoice.DueDate).Hours() / 24)
+}
+
+// buildEmail assembles a plain-text RFC 5322 message
+func buildEmail(from, to, subject, body string) []byte {
+	var msg strings.Builder
+	msg.WriteString("From: " + from + "\r\n")
+	msg.WriteString("To: " + to + "\r\n")
+	msg.WriteString("Subject: " + subject + "\r\n")
+	msg.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(body)
+	return []byte(msg.String())
+}
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// invoiceNumberYearPlaceholder is replaced by the current year in invoice number prefixes
+const invoiceNumberYearPlaceholder = "{year}"
+
+// NumberingService issues sequential, human-readable invoice numbers per user
+type NumberingService interface {
+	// NextInvoiceNumber reserves and returns the user's next invoice number.
+	// tx should be the transaction that creates the invoice so a rolled-back
+	// invoice does not consume a number.
+	NextInvoiceNumber(tx *gorm.DB, userID string) (string, error)
+}
+
+type numberingService struct{}
+
+// NewNumberingService creates a new NumberingService instance
+// Numbers are formatted from the user's InvoiceNumberPrefix and InvoiceNumberPadding settings
+func NewNumberingService() NumberingService {
+	return &numberingService{}
+}
+
+// NextInvoiceNumber increments the user's sequence for the current prefix and formats the result.
+// Numbers the user already has (e.g. restored from a backup) are skipped.
+func (s *numberingService) NextInvoiceNumber(tx *gorm.DB, userID string) (string, error) {
+	settings, err := NewSettingsService(tx).GetSettings(userID)
+	if err != nil {
+		return "", err
+	}
+
+	prefix := renderInvoiceNumberPrefix(settings.InvoiceNumberPrefix, time.Now())
+	for {
+		// Upsert so the first number for a prefix and later increments are a single atomic statement
+		sequence := models.InvoiceNumberSequence{UserID: userID, Prefix: prefix, LastValue: 1}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "user_id"}, {Name: "prefix"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"last_value": gorm.Expr("invoice_number_sequences.last_value + 1"),
+				"updated_at": time.Now(),
+			}),
+		}).Create(&sequence).Error; err != nil {
+			return "", fmt.Errorf("failed to reserve invoice number: %w", err)
+		}
+
+		if err := tx.Where("user_id = ? AND prefix = ?", userID, prefix).First(&sequence).Error; err != nil {
+			return "", fmt.Errorf("failed to reserve invoice number: %w", err)
+		}
+
+		number := formatInvoiceNumber(prefix, settings.InvoiceNumberPadding, sequence.LastValue)
+		var taken int64
+		if err := tx.Unscoped().Model(&models.Invoice{}).
+			Where("user_id = ? AND invoice_number = ?", userID, number).
+			Count(&taken).Error; err != nil {
+			return "", fmt.Errorf("failed to reserve invoice number: %w", err)
+		}
+		if taken == 0 {
+			return number, nil
+		}
+	}
+}
+
+// invoiceNumberPattern splits an invoice number into its rendered prefix and sequence value
+var invoiceNumberPattern = regexp.MustCompile(`^(.*?)(\d+)$`)
+
+// advanceInvoiceNumberSequence moves the user's sequence for the prefix of number up to number's
+// value, so numbers issued later follow it. Numbers without a trailing sequence value are ignored.
+func advanceInvoiceNumberSequence(tx *gorm.DB, userID, number string) error {
+	match := invoiceNumberPattern.FindStringSubmatch(number)
+	if match == nil {
+		return nil
+	}
+	value, err := strconv.ParseInt(match[2], 10, 64)
+	if err != nil {
+		return nil
+	}
+
+	sequence := models.InvoiceNumberSequence{UserID: userID, Prefix: match[1], LastValue: value}
+	if err := tx.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}, {Name: "prefix"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"last_value": gorm.Expr("CASE WHEN invoice_number_sequences.last_value < ? THEN ? ELSE invoice_number_sequences.last_value END", value, value),
+			"updated_at": time.Now(),
+		}),
+	}).Create(&sequence).Error; err != nil {
+		return fmt.Errorf("failed to advance invoice number sequence: %w", err)
+	}
+	return nil
+}
+
+// renderInvoiceNumberPrefix substitutes placeholders in a configured prefix
+func renderInvoiceNumberPrefix(prefix string, now time.Time) string {
+	return strings.ReplaceAll(prefix, invoiceNumberYearPlaceholder, strconv.Itoa(now.Year()))
+}
+
+// formatInvoiceNumber joins a rendered prefix and a zero-padded sequence value
+func formatInvoiceNumber(prefix string, padding int, value int64) string {
+	return fmt.Sprintf("%s%0*d", prefix, padding, value)
+}
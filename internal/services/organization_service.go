@@ -0,0 +1,324 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PersonalOrganizationName is the name of the organization created for a user's own invoices
+const PersonalOrganizationName = "Personal"
+
+// OrganizationService handles organizations and their members. Invoices are shared with every
+// member of their organization: members open them by ID (InvoiceService.GetInvoiceByID, with
+// their audit trail) and list them, keyword search included, in the organization's context (an
+// OrganizationID in InvoiceListOptions). Everything else, including analytics and exports, covers the user's own
+// invoices.
+type OrganizationService interface {
+	CreateOrganization(userID string, name string) (*models.Organization, error)
+	ListOrganizations(userID string) ([]UserOrganization, error)
+	GetPersonalOrganization(userID string) (*models.Organization, error)
+
+	// Members; only owners add, change, or remove members, except that anyone may leave
+	ListMembers(userID string, organizationID uint) ([]models.OrganizationMember, error)
+	SetMember(userID string, organizationID uint, memberUserID string, role models.OrganizationRole) (*models.OrganizationMember, error)
+	RemoveMember(userID string, organizationID uint, memberUserID string) error
+}
+
+// UserOrganization is an organization with the user's role in it
+type UserOrganization struct {
+	models.Organization
+	Role models.OrganizationRole `json:"role"`
+}
+
+type organizationService struct {
+	db           *gorm.DB
+	auditService AuditService
+}
+
+// NewOrganizationService creates a new OrganizationService instance
+func NewOrganizationService(db *gorm.DB) OrganizationService {
+	return &organizationService{db: db, auditService: NewAuditService(db)}
+}
+
+// CreateOrganization creates an organization with the user as its owner
+func (s *organizationService) CreateOrganization(userID string, name string) (*models.Organization, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("organization name is required")
+	}
+
+	organization := &models.Organization{Name: name}
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(organization).Error; err != nil {
+			return err
+		}
+		return tx.Create(&models.OrganizationMember{
+			OrganizationID: organization.ID,
+			UserID:         userID,
+			Role:           models.OrganizationRoleOwner,
+		}).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create organization: %w", err)
+	}
+	return organization, nil
+}
+
+// ListOrganizations lists the organizations the user is a member of, with their role, by name
+func (s *organizationService) ListOrganizations(userID string) ([]UserOrganization, error) {
+	var organizations []UserOrganization
+	err := s.db.Model(&models.Organization{}).
+		Select("organizations.*, organization_members.role").
+		Joins("JOIN organization_members ON organization_members.organization_id = organizations.id").
+		Where("organization_members.user_id = ?", userID).
+		Order("organizations.name ASC, organizations.id ASC").
+		Scan(&organizations).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+	return organizations, nil
+}
+
+// GetPersonalOrganization returns the user's personal organization, creating it on first use
+func (s *organizationService) GetPersonalOrganization(userID string) (*models.Organization, error) {
+	return personalOrganization(s.db, userID)
+}
+
+// ListMembers lists the members of an organization the user belongs to, owners first
+func (s *organizationService) ListMembers(userID string, organizationID uint) ([]models.OrganizationMember, error) {
+	if _, err := organizationRole(s.db, userID, organizationID); err != nil {
+		return nil, err
+	}
+
+	var members []models.OrganizationMember
+	err := s.db.Where("organization_id = ?", organizationID).
+		Order("CASE role WHEN 'owner' THEN 0 WHEN 'member' THEN 1 ELSE 2 END, user_id ASC").
+		Find(&members).Error
+	return members, err
+}
+
+// SetMember adds a user to an organization with the given role, or changes the role of a member.
+// Only owners may do so, and an organization always keeps at least one owner. Only users who have
+// used the service can be added (see userKnown), and every change is recorded in the audit log.
+func (s *organizationService) SetMember(userID string, organizationID uint, memberUserID string, role models.OrganizationRole) (*models.OrganizationMember, error) {
+	memberUserID = strings.TrimSpace(memberUserID)
+	if memberUserID == "" {
+		return nil, fmt.Errorf("member user ID is required")
+	}
+	if !role.Valid() {
+		return nil, fmt.Errorf("invalid role %q (expected owner, member, or viewer)", role)
+	}
+
+	var member models.OrganizationMember
+	var before *models.OrganizationMember
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := requireOrganizationOwner(tx, userID, organizationID); err != nil {
+			return err
+		}
+
+		err := tx.Where("organization_id = ? AND user_id = ?", organizationID, memberUserID).First(&member).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			known, err := userKnown(tx, memberUserID)
+			if err != nil {
+				return err
+			}
+			if !known {
+				return fmt.Errorf("%w: %s", ErrUserNotFound, memberUserID)
+			}
+			member = models.OrganizationMember{OrganizationID: organizationID, UserID: memberUserID, Role: role}
+			return tx.Create(&member).Error
+		}
+		if err != nil {
+			return err
+		}
+
+		if member.Role == models.OrganizationRoleOwner && role != models.OrganizationRoleOwner {
+			if err := requireAnotherOwner(tx, organizationID, memberUserID); err != nil {
+				return err
+			}
+		}
+		previous := member
+		before = &previous
+		member.Role = role
+		return tx.Save(&member).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entry := AuditEntry{
+		UserID:     userID,
+		ActorSub:   userID,
+		EntityType: models.AuditEntityOrganizationMember,
+		EntityID:   member.ID,
+		Action:     models.AuditActionCreate,
+		After:      memberAuditFields(&member),
+	}
+	if before != nil {
+		entry.Action = models.AuditActionUpdate
+		entry.Before = memberAuditFields(before)
+	}
+	s.auditService.Record(entry)
+	return &member, nil
+}
+
+// RemoveMember removes a user from an organization. Owners may remove anyone and members may
+// remove themselves, but the last owner can't be removed. Invoices the user created in the
+// organization stay in it.
+func (s *organizationService) RemoveMember(userID string, organizationID uint, memberUserID string) error {
+	var member models.OrganizationMember
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if memberUserID != userID {
+			if err := requireOrganizationOwner(tx, userID, organizationID); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Where("organization_id = ? AND user_id = ?", organizationID, memberUserID).First(&member).Error; err != nil {
+			return fmt.Errorf("member not found")
+		}
+		if member.Role == models.OrganizationRoleOwner {
+			if err := requireAnotherOwner(tx, organizationID, memberUserID); err != nil {
+				return err
+			}
+		}
+		return tx.Delete(&member).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	s.auditService.Record(AuditEntry{
+		UserID:     userID,
+		ActorSub:   userID,
+		EntityType: models.AuditEntityOrganizationMember,
+		EntityID:   member.ID,
+		Action:     models.AuditActionDelete,
+		Before:     memberAuditFields(&member),
+	})
+	return nil
+}
+
+// memberAuditFields are the audited fields of a membership. The member's user ID is renamed
+// because user_id is left out of audit diffs as ownership bookkeeping.
+func memberAuditFields(member *models.OrganizationMember) map[string]interface{} {
+	return map[string]interface{}{
+		"organization_id": member.OrganizationID,
+		"member_user_id":  member.UserID,
+		"role":            member.Role,
+	}
+}
+
+// userKnown reports whether the user has used the service: users get a personal organization when
+// they first list organizations or create an invoice, and settings when they first save them.
+// Users are authenticated elsewhere, so this is the only record of them.
+func userKnown(db *gorm.DB, userID string) (bool, error) {
+	var count int64
+	err := db.Raw(`SELECT (SELECT COUNT(*) FROM organization_members WHERE user_id = ?) +
+		(SELECT COUNT(*) FROM user_settings WHERE user_id = ?)`, userID, userID).Scan(&count).Error
+	return count > 0, err
+}
+
+// organizationRole returns the user's role in an organization; organizations the user doesn't
+// belong to are not found
+func organizationRole(db *gorm.DB, userID string, organizationID uint) (models.OrganizationRole, error) {
+	var member models.OrganizationMember
+	if err := db.Where("organization_id = ? AND user_id = ?", organizationID, userID).First(&member).Error; err != nil {
+		return "", fmt.Errorf("organization not found")
+	}
+	return member.Role, nil
+}
+
+// requireOrganizationOwner fails unless the user owns the organization
+func requireOrganizationOwner(db *gorm.DB, userID string, organizationID uint) error {
+	role, err := organizationRole(db, userID, organizationID)
+	if err != nil {
+		return err
+	}
+	if role != models.OrganizationRoleOwner {
+		return fmt.Errorf("only owners can manage the members of organization %d", organizationID)
+	}
+	return nil
+}
+
+// requireAnotherOwner fails unless the organization has an owner other than the given user
+func requireAnotherOwner(db *gorm.DB, organizationID uint, userID string) error {
+	var owners int64
+	if err := db.Model(&models.OrganizationMember{}).
+		Where("organization_id = ? AND role = ? AND user_id <> ?", organizationID, models.OrganizationRoleOwner, userID).
+		Count(&owners).Error; err != nil {
+		return err
+	}
+	if owners == 0 {
+		return fmt.Errorf("organization %d must keep at least one owner", organizationID)
+	}
+	return nil
+}
+
+// personalOrganization returns the user's personal organization, creating it with the user as
+// its owner on first use. Concurrent callers get the same organization.
+func personalOrganization(db *gorm.DB, userID string) (*models.Organization, error) {
+	var organization models.Organization
+	err := db.Where("personal_user_id = ?", userID).First(&organization).Error
+	if err == nil {
+		return &organization, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		organization = models.Organization{Name: PersonalOrganizationName, PersonalUserID: &userID}
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&organization)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		return tx.Create(&models.OrganizationMember{
+			OrganizationID: organization.ID,
+			UserID:         userID,
+			Role:           models.OrganizationRoleOwner,
+		}).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create personal organization: %w", err)
+	}
+
+	if err := db.Where("personal_user_id = ?", userID).First(&organization).Error; err != nil {
+		return nil, err
+	}
+	return &organization, nil
+}
+
+// ErrUserNotFound is returned when adding a user who has never used the service to an organization
+var ErrUserNotFound = errors.New("user not found")
+
+// invoicesVisibleCondition matches the invoices a user may open: those the user created and those
+// of an organization the user belongs to. It takes the user ID twice.
+const invoicesVisibleCondition = "(invoices.user_id = ? OR invoices.organization_id IN (SELECT organization_id FROM organization_members WHERE user_id = ?))"
+
+// invoicesVisibleTo scopes invoice queries to the invoices the user may open by ID: those in an
+// organization the user belongs to, and those the user created
+func invoicesVisibleTo(userID string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(invoicesVisibleCondition, userID, userID)
+	}
+}
+
+// invoicesInScope scopes invoice listings to the user's own invoices or, in the context of an
+// organization, to every invoice of that organization when the user is a member of it. Listings
+// and totals without an organization only ever see the user's own invoices, like
+// analytics and exports.
+func invoicesInScope(userID string, organizationID *uint) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if organizationID == nil {
+			return db.Where("invoices.user_id = ?", userID)
+		}
+		return db.Where("invoices.organization_id = ? AND invoices.organization_id IN (SELECT organization_id FROM organization_members WHERE user_id = ?)",
+			*organizationID, userID)
+	}
+}
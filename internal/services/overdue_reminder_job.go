@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"gorm.io/gorm"
+)
+
+// DefaultOverdueReminderInterval is how often overdue digests are sent when no interval is configured
+const DefaultOverdueReminderInterval = 24 * time.Hour
+
+// OverdueReminderJob periodically emails every user with a notification address a digest of
+// their overdue invoices
+type OverdueReminderJob struct {
+	db                  *gorm.DB
+	invoiceService      InvoiceService
+	notificationService NotificationService
+}
+
+// NewOverdueReminderJob creates a new OverdueReminderJob
+func NewOverdueReminderJob(db *gorm.DB, invoiceService InvoiceService, notificationService NotificationService) *OverdueReminderJob {
+	return &OverdueReminderJob{
+		db:                  db,
+		invoiceService:      invoiceService,
+		notificationService: notificationService,
+	}
+}
+
+// Start runs the job every interval until ctx is cancelled, e.g. by JobManager.Shutdown.
+// The first run happens after one interval so restarts don't resend digests.
+func (j *OverdueReminderJob) Start(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := j.RunOnce(ctx); err != nil {
+				log.Printf("Warning: Overdue reminder run failed: %v", err)
+			}
+		}
+	}
+}
+
+// RunOnce sends one overdue digest to each user with a notification email and overdue invoices.
+// A failure for one user is logged and doesn't stop the others. Once ctx is cancelled no further
+// digest is started; the run ends with ctx's error.
+func (j *OverdueReminderJob) RunOnce(ctx context.Context) error {
+	var userIDs []string
+	if err := j.db.Model(&models.UserSettings{}).Where("email <> ''").Pluck("user_id", &userIDs).Error; err != nil {
+		return fmt.Errorf("failed to list users with a notification email: %w", err)
+	}
+
+	for _, userID := range userIDs {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("overdue reminder run interrupted: %w", err)
+		}
+		invoices, err := j.invoiceService.GetOverdueInvoices(userID)
+		if err != nil {
+			log.Printf("Warning: Failed to get overdue invoices for user %s: %v", userID, err)
+			continue
+		}
+		if len(invoices) == 0 {
+			continue
+		}
+		if err := j.notificationService.SendOverdueDigest(userID, invoices); err != nil {
+			log.Printf("Warning: Failed to send overdue digest to user %s: %v", userID, err)
+		}
+	}
+	return nil
+}
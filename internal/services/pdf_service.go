@@ -2,7 +2,9 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/chromedp/cdproto/page"
@@ -10,9 +12,17 @@ import (
 	"github.com/microcosm-cc/bluemonday"
 )
 
-// PDFService handles HTML to PDF conversion
+// MaxExtractPDFSize is the largest file ExtractFields will download (20 MB)
+const MaxExtractPDFSize = 20 << 20
+
+// ErrPDFRenderingNotConfigured is returned by ConvertHTMLToPDF when no Chrome instance is configured
+var ErrPDFRenderingNotConfigured = errors.New("PDF rendering not configured")
+
+// PDFService handles HTML to PDF conversion and reading invoice fields from uploaded PDFs
 type PDFService interface {
 	ConvertHTMLToPDF(ctx context.Context, html string, options PDFOptions) ([]byte, error)
+	ExtractFields(ctx context.Context, s3Key string) (*ExtractedInvoice, error)
+	RenderVendorStatement(ctx context.Context, statement *VendorStatement) ([]byte, error)
 }
 
 // PDFOptions contains options for PDF generation
@@ -41,8 +51,9 @@ func DefaultPDFOptions() PDFOptions {
 
 // PDFServiceConfig holds configuration for the PDF service
 type PDFServiceConfig struct {
-	ChromeURL string        // WebSocket URL to headless Chrome (e.g., ws://localhost:9222)
+	ChromeURL string        // WebSocket URL to headless Chrome (e.g., ws://localhost:9222); HTML to PDF is disabled when empty
 	Timeout   time.Duration // Request timeout (default: 30s)
+	Storage   UploadService // Storage that uploaded files are downloaded from for field extraction
 }
 
 type pdfService struct {
@@ -73,6 +84,10 @@ func NewPDFService(config PDFServiceConfig) PDFService {
 
 // ConvertHTMLToPDF converts HTML to PDF using headless Chrome
 func (s *pdfService) ConvertHTMLToPDF(ctx context.Context, html string, options PDFOptions) ([]byte, error) {
+	if s.config.ChromeURL == "" {
+		return nil, ErrPDFRenderingNotConfigured
+	}
+
 	// Sanitize HTML to prevent XSS and other attacks
 	sanitizedHTML := s.sanitizer.Sanitize(html)
 
@@ -139,6 +154,47 @@ body { font-family: 'Noto Sans CJK SC', Arial, sans-serif; }
 	return pdfContent, nil
 }
 
+// RenderVendorStatement renders a vendor statement as a PDF
+func (s *pdfService) RenderVendorStatement(ctx context.Context, statement *VendorStatement) ([]byte, error) {
+	html, err := renderVendorStatementHTML(statement)
+	if err != nil {
+		return nil, err
+	}
+	return s.ConvertHTMLToPDF(ctx, html, DefaultPDFOptions())
+}
+
+// ExtractFields downloads an uploaded PDF and guesses its total, currency, dates, and vendor
+// from the text layer. It only reads the file; nothing is created.
+// Scanned PDFs without a text layer return ErrNoPDFText since no OCR engine is available.
+func (s *pdfService) ExtractFields(ctx context.Context, s3Key string) (*ExtractedInvoice, error) {
+	if s.config.Storage == nil {
+		return nil, errors.New("file storage not configured")
+	}
+
+	content, contentType, err := s.config.Storage.DownloadFile(ctx, s3Key, MaxExtractPDFSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if contentType != "" && !isPDFContentType(contentType) {
+		return nil, fmt.Errorf("%w: content type is %s", ErrNotPDF, contentType)
+	}
+
+	text, err := extractPDFText(content)
+	if err != nil {
+		return nil, err
+	}
+
+	return extractInvoiceFields(text), nil
+}
+
+// isPDFContentType reports whether a content type may hold a PDF.
+// Generic binary types are allowed since clients often upload without a specific type.
+func isPDFContentType(contentType string) bool {
+	mediaType := strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0]))
+	return mediaType == "application/pdf" || mediaType == "application/x-pdf" || mediaType == "application/octet-stream"
+}
+
 // containsHTMLTag checks if the string contains an html tag
 func containsHTMLTag(s string) bool {
 	for i := 0; i < len(s)-5; i++ {
@@ -155,6 +211,20 @@ func containsHTMLTag(s string) bool {
 // MockPDFService is a mock implementation for testing
 type MockPDFService struct{}
 
+// RenderVendorStatement renders the statement template and returns a minimal valid PDF for testing
+func (m *MockPDFService) RenderVendorStatement(ctx context.Context, statement *VendorStatement) ([]byte, error) {
+	html, err := renderVendorStatementHTML(statement)
+	if err != nil {
+		return nil, err
+	}
+	return m.ConvertHTMLToPDF(ctx, html, DefaultPDFOptions())
+}
+
+// ExtractFields returns an empty extraction for testing
+func (m *MockPDFService) ExtractFields(ctx context.Context, s3Key string) (*ExtractedInvoice, error) {
+	return &ExtractedInvoice{}, nil
+}
+
 // NewMockPDFService creates a new mock PDF service
 func NewMockPDFService() PDFService {
 	return &MockPDFService{}
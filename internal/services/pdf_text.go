@@ -0,0 +1,705 @@
+package services
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// ErrNotPDF is returned when a file is not a PDF document
+var ErrNotPDF = errors.New("file is not a PDF document")
+
+// ErrNoPDFText is returned when a PDF has no text layer, e.g. a scanned document
+var ErrNoPDFText = errors.New("no extractable text found in PDF (scanned or image-only PDFs are not supported)")
+
+// ErrPDFTooLarge is returned when a PDF's compressed streams inflate beyond MaxPDFStreamSize or,
+// all together, MaxPDFDecodedSize, which guards against decompression bombs
+var ErrPDFTooLarge = errors.New("PDF content is too large to extract")
+
+// Limits on the inflated size of a PDF's streams
+const (
+	MaxPDFStreamSize  = 8 << 20  // one stream
+	MaxPDFDecodedSize = 32 << 20 // all streams of a document
+)
+
+// maxCMapRange caps the number of codes expanded from a single bfrange entry
+const maxCMapRange = 1 << 16
+
+var (
+	pdfObjectPattern     = regexp.MustCompile(`(?s)(\d+)\s+\d+\s+obj\b(.*?)\bendobj`)
+	pdfStreamPattern     = regexp.MustCompile(`(?s)^(.*?)\bstream\r?\n(.*)$`)
+	pdfObjStmPattern     = regexp.MustCompile(`/Type\s*/ObjStm\b`)
+	pdfFilterPattern     = regexp.MustCompile(`/Filter\s*(\[[^\]]*\]|/[A-Za-z0-9]+)`)
+	pdfIntEntryPattern   = `/%s\s+(\d+)`
+	pdfFontDictPattern   = regexp.MustCompile(`(?s)/Font\s*<<(.*?)>>`)
+	pdfFontRefPattern    = regexp.MustCompile(`/Font\s+(\d+)\s+\d+\s+R`)
+	pdfNamedRefPattern   = regexp.MustCompile(`/([^\s/<>\[\]()]+)\s+(\d+)\s+\d+\s+R`)
+	pdfToUnicodePattern  = regexp.MustCompile(`/ToUnicode\s+(\d+)\s+\d+\s+R`)
+	pdfCodespacePattern  = regexp.MustCompile(`(?s)begincodespacerange\s*<([0-9A-Fa-f]+)>`)
+	pdfBfCharPattern     = regexp.MustCompile(`(?s)beginbfchar(.*?)endbfchar`)
+	pdfBfRangePattern    = regexp.MustCompile(`(?s)beginbfrange(.*?)endbfrange`)
+	pdfBfCharEntry       = regexp.MustCompile(`<([0-9A-Fa-f]+)>\s*<([0-9A-Fa-f]*)>`)
+	pdfBfRangeEntry      = regexp.MustCompile(`(?s)<([0-9A-Fa-f]+)>\s*<([0-9A-Fa-f]+)>\s*(<[0-9A-Fa-f]*>|\[.*?\])`)
+	pdfHexStringPattern  = regexp.MustCompile(`<([0-9A-Fa-f]*)>`)
+	pdfWhitespacePattern = regexp.MustCompile(`[ \t]+`)
+)
+
+// pdfDocument holds the objects of a parsed PDF, keyed by object number
+type pdfDocument struct {
+	dicts   map[int]string
+	streams map[int][]byte
+}
+
+// pdfCMap maps character codes of a font to Unicode text
+type pdfCMap struct {
+	codeLen int
+	chars   map[uint32]string
+}
+
+// extractPDFText returns the text of a text-based PDF, one line per text line.
+// It understands uncompressed and FlateDecode streams, object streams, and ToUnicode CMaps,
+// which covers PDFs produced by browsers and most accounting software. It does not perform OCR.
+func extractPDFText(data []byte) (string, error) {
+	if !bytes.HasPrefix(bytes.TrimLeft(data, " \t\r\n"), []byte("%PDF-")) {
+		return "", ErrNotPDF
+	}
+
+	doc, err := parsePDF(data)
+	if err != nil {
+		return "", err
+	}
+	fonts := doc.fontCMaps()
+
+	// Content streams are visited in object order, which matches page order for
+	// the vast majority of generated documents
+	numbers := make([]int, 0, len(doc.streams))
+	for num := range doc.streams {
+		numbers = append(numbers, num)
+	}
+	sort.Ints(numbers)
+
+	var text strings.Builder
+	for _, num := range numbers {
+		content := doc.streams[num]
+		if !isPDFContentStream(content) {
+			continue
+		}
+		text.WriteString(extractContentText(content, fonts))
+		text.WriteByte('\n')
+	}
+
+	lines := make([]string, 0)
+	for _, line := range strings.Split(text.String(), "\n") {
+		line = strings.TrimSpace(pdfWhitespacePattern.ReplaceAllString(line, " "))
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if len(lines) == 0 {
+		return "", ErrNoPDFText
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// parsePDF collects all objects of a PDF, including those stored in object streams.
+// It fails with ErrPDFTooLarge when the streams inflate beyond the size limits.
+func parsePDF(data []byte) (*pdfDocument, error) {
+	doc := &pdfDocument{
+		dicts:   make(map[int]string),
+		streams: make(map[int][]byte),
+	}
+	budget := MaxPDFDecodedSize
+
+	var objectStreams []int
+	for _, match := range pdfObjectPattern.FindAllSubmatch(data, -1) {
+		num, err := strconv.Atoi(string(match[1]))
+		if err != nil {
+			continue
+		}
+		body := match[2]
+
+		streamMatch := pdfStreamPattern.FindSubmatch(body)
+		if streamMatch == nil {
+			doc.dicts[num] = string(body)
+			continue
+		}
+
+		dict := string(streamMatch[1])
+		raw := bytes.TrimSuffix(bytes.TrimRight(streamMatch[2], " \t\r\n"), []byte("endstream"))
+		raw = bytes.TrimRight(raw, "\r\n")
+		doc.dicts[num] = dict
+		decoded, ok, err := decodePDFStream(dict, raw, budget)
+		if errors.Is(err, ErrPDFTooLarge) {
+			return nil, err
+		}
+		if err != nil {
+			// Keep whatever was inflated from a truncated stream or one with a bad checksum
+			log.Printf("Warning: PDF stream %d decoded partially: %v", num, err)
+		}
+		if ok {
+			budget -= len(decoded)
+			doc.streams[num] = decoded
+			if pdfObjStmPattern.MatchString(dict) {
+				objectStreams = append(objectStreams, num)
+			}
+		}
+	}
+
+	for _, num := range objectStreams {
+		doc.expandObjectStream(doc.dicts[num], doc.streams[num])
+	}
+	return doc, nil
+}
+
+// decodePDFStream decodes a stream's data, reporting whether there is any. Only unfiltered and
+// FlateDecode streams are supported; other filters (images, fonts) are skipped. A stream may
+// inflate to at most MaxPDFStreamSize and budget bytes, beyond which ErrPDFTooLarge is returned.
+// The data inflated from a truncated or corrupt stream is returned with the decode error.
+func decodePDFStream(dict string, raw []byte, budget int) ([]byte, bool, error) {
+	filter := pdfFilterPattern.FindStringSubmatch(dict)
+	if filter == nil {
+		return raw, true, nil
+	}
+	if strings.TrimSpace(strings.Trim(filter[1], "[]")) != "/FlateDecode" {
+		return nil, false, nil
+	}
+
+	reader, err := zlib.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, false, nil
+	}
+	defer reader.Close()
+
+	limit := min(MaxPDFStreamSize, budget)
+	decoded, err := io.ReadAll(io.LimitReader(reader, int64(limit)+1))
+	if len(decoded) > limit {
+		return nil, false, ErrPDFTooLarge
+	}
+	return decoded, len(decoded) > 0, err
+}
+
+// expandObjectStream registers the objects embedded in an object stream (/Type /ObjStm)
+func (d *pdfDocument) expandObjectStream(dict string, data []byte) {
+	count, ok := pdfIntEntry(dict, "N")
+	if !ok {
+		return
+	}
+	first, ok := pdfIntEntry(dict, "First")
+	if !ok || first > len(data) {
+		return
+	}
+
+	header := strings.Fields(string(data[:first]))
+	if len(header) < count*2 {
+		return
+	}
+	for i := 0; i < count; i++ {
+		num, err1 := strconv.Atoi(header[i*2])
+		offset, err2 := strconv.Atoi(header[i*2+1])
+		if err1 != nil || err2 != nil {
+			return
+		}
+		end := len(data)
+		if i+1 < count {
+			if next, err := strconv.Atoi(header[i*2+3]); err == nil {
+				end = first + next
+			}
+		}
+		start := first + offset
+		if start > end || end > len(data) {
+			return
+		}
+		if _, exists := d.dicts[num]; !exists {
+			d.dicts[num] = string(data[start:end])
+		}
+	}
+}
+
+// fontCMaps returns the ToUnicode CMaps of all fonts, keyed by their resource name (e.g. F1)
+func (d *pdfDocument) fontCMaps() map[string]*pdfCMap {
+	fonts := make(map[string]*pdfCMap)
+	addFonts := func(entries string) {
+		for _, ref := range pdfNamedRefPattern.FindAllStringSubmatch(entries, -1) {
+			fontNum, _ := strconv.Atoi(ref[2])
+			toUnicode := pdfToUnicodePattern.FindStringSubmatch(d.dicts[fontNum])
+			if toUnicode == nil {
+				continue
+			}
+			cmapNum, _ := strconv.Atoi(toUnicode[1])
+			if data, ok := d.streams[cmapNum]; ok {
+				fonts[ref[1]] = parseCMap(data)
+			}
+		}
+	}
+
+	for _, dict := range d.dicts {
+		for _, match := range pdfFontDictPattern.FindAllStringSubmatch(dict, -1) {
+			addFonts(match[1])
+		}
+		for _, match := range pdfFontRefPattern.FindAllStringSubmatch(dict, -1) {
+			num, _ := strconv.Atoi(match[1])
+			addFonts(d.dicts[num])
+		}
+	}
+	return fonts
+}
+
+// pdfIntEntry reads an integer dictionary entry such as /N 5
+func pdfIntEntry(dict, key string) (int, bool) {
+	match := regexp.MustCompile(fmt.Sprintf(pdfIntEntryPattern, key)).FindStringSubmatch(dict)
+	if match == nil {
+		return 0, false
+	}
+	value, err := strconv.Atoi(match[1])
+	return value, err == nil
+}
+
+// parseCMap parses the bfchar and bfrange mappings of a ToUnicode CMap
+func parseCMap(data []byte) *pdfCMap {
+	cmap := &pdfCMap{codeLen: 1, chars: make(map[uint32]string)}
+	text := string(data)
+
+	if match := pdfCodespacePattern.FindStringSubmatch(text); match != nil {
+		cmap.codeLen = max(1, len(match[1])/2)
+	}
+
+	for _, block := range pdfBfCharPattern.FindAllStringSubmatch(text, -1) {
+		for _, entry := range pdfBfCharEntry.FindAllStringSubmatch(block[1], -1) {
+			code, err := strconv.ParseUint(entry[1], 16, 32)
+			if err != nil {
+				continue
+			}
+			cmap.chars[uint32(code)] = decodeUTF16Hex(entry[2])
+		}
+	}
+
+	for _, block := range pdfBfRangePattern.FindAllStringSubmatch(text, -1) {
+		for _, entry := range pdfBfRangeEntry.FindAllStringSubmatch(block[1], -1) {
+			lo, err1 := strconv.ParseUint(entry[1], 16, 32)
+			hi, err2 := strconv.ParseUint(entry[2], 16, 32)
+			if err1 != nil || err2 != nil || hi < lo || hi-lo > maxCMapRange {
+				continue
+			}
+
+			if strings.HasPrefix(entry[3], "[") {
+				for i, dst := range pdfHexStringPattern.FindAllStringSubmatch(entry[3], -1) {
+					if lo+uint64(i) > hi {
+						break
+					}
+					cmap.chars[uint32(lo)+uint32(i)] = decodeUTF16Hex(dst[1])
+				}
+				continue
+			}
+
+			dst := decodeUTF16Units(strings.Trim(entry[3], "<>"))
+			if len(dst) == 0 {
+				continue
+			}
+			for code := lo; code <= hi; code++ {
+				units := append([]uint16(nil), dst...)
+				units[len(units)-1] += uint16(code - lo)
+				cmap.chars[uint32(code)] = string(utf16.Decode(units))
+			}
+		}
+	}
+
+	return cmap
+}
+
+// decodeUTF16Hex decodes a hex-encoded UTF-16BE string
+func decodeUTF16Hex(hex string) string {
+	return string(utf16.Decode(decodeUTF16Units(hex)))
+}
+
+// decodeUTF16Units decodes a hex string into UTF-16 code units
+func decodeUTF16Units(hex string) []uint16 {
+	units := make([]uint16, 0, len(hex)/4)
+	for i := 0; i+4 <= len(hex); i += 4 {
+		unit, err := strconv.ParseUint(hex[i:i+4], 16, 16)
+		if err != nil {
+			return units
+		}
+		units = append(units, uint16(unit))
+	}
+	return units
+}
+
+// isPDFContentStream reports whether a decoded stream looks like a page content stream with text
+func isPDFContentStream(data []byte) bool {
+	return bytes.Contains(data, []byte("BT")) &&
+		(bytes.Contains(data, []byte("Tj")) || bytes.Contains(data, []byte("TJ")))
+}
+
+// pdfToken is a single operand or operator of a content stream
+type pdfToken struct {
+	kind  pdfTokenKind
+	value string
+	str   []byte
+	array []pdfToken
+}
+
+type pdfTokenKind int
+
+const (
+	pdfTokenNumber pdfTokenKind = iota
+	pdfTokenString
+	pdfTokenName
+	pdfTokenArray
+	pdfTokenOperator
+	pdfTokenOther
+)
+
+// number returns the numeric value of a token, or 0 when it is not a number
+func (t pdfToken) number() float64 {
+	if t.kind != pdfTokenNumber {
+		return 0
+	}
+	value, _ := strconv.ParseFloat(t.value, 64)
+	return value
+}
+
+// extractContentText interprets the text operators of a content stream
+func extractContentText(data []byte, fonts map[string]*pdfCMap) string {
+	lexer := &pdfLexer{data: data}
+	var out strings.Builder
+	var operands []pdfToken
+	var font *pdfCMap
+	var lineY float64
+	hasLine := false
+
+	newline := func() {
+		out.WriteByte('\n')
+	}
+	space := func() {
+		if out.Len() > 0 {
+			out.WriteByte(' ')
+		}
+	}
+	show := func(s []byte) {
+		out.WriteString(decodePDFString(s, font))
+	}
+	lastOperand := func() pdfToken {
+		if len(operands) == 0 {
+			return pdfToken{kind: pdfTokenOther}
+		}
+		return operands[len(operands)-1]
+	}
+	moveTo := func(y float64) {
+		if hasLine && abs(y-lineY) > 1 {
+			newline()
+		} else {
+			space()
+		}
+		lineY = y
+		hasLine = true
+	}
+
+	for {
+		token, ok := lexer.next()
+		if !ok {
+			break
+		}
+		if token.kind != pdfTokenOperator {
+			operands = append(operands, token)
+			continue
+		}
+
+		switch token.value {
+		case "Tf":
+			if len(operands) >= 2 && operands[len(operands)-2].kind == pdfTokenName {
+				font = fonts[operands[len(operands)-2].value]
+			}
+		case "Tj":
+			show(lastOperand().str)
+		case "'", "\"":
+			newline()
+			show(lastOperand().str)
+		case "TJ":
+			for _, item := range lastOperand().array {
+				switch item.kind {
+				case pdfTokenString:
+					show(item.str)
+				case pdfTokenNumber:
+					// Large negative adjustments (in thousandths of a text unit) separate words
+					if item.number() < -180 {
+						space()
+					}
+				}
+			}
+		case "Td", "TD":
+			if len(operands) >= 2 {
+				ty := operands[len(operands)-1].number()
+				tx := operands[len(operands)-2].number()
+				if ty != 0 {
+					moveTo(lineY + ty)
+				} else if tx != 0 {
+					space()
+				}
+			}
+		case "Tm":
+			if len(operands) >= 6 {
+				moveTo(operands[len(operands)-1].number())
+			}
+		case "T*":
+			newline()
+		case "ET":
+			space()
+		}
+		operands = operands[:0]
+	}
+
+	return out.String()
+}
+
+// decodePDFString converts the bytes of a PDF string to text using the current font's CMap.
+// Without a CMap the bytes are treated as Latin-1, which matches the standard encodings for ASCII;
+// 0x80 is mapped to the euro sign as in WinAnsiEncoding.
+func decodePDFString(s []byte, font *pdfCMap) string {
+	var out strings.Builder
+	if font == nil || len(font.chars) == 0 {
+		for _, b := range s {
+			switch {
+			case b == 0x80:
+				out.WriteRune('€')
+			case b >= 0x20 || b == '\t':
+				out.WriteRune(rune(b))
+			}
+		}
+		return out.String()
+	}
+
+	for i := 0; i+font.codeLen <= len(s); i += font.codeLen {
+		var code uint32
+		for j := 0; j < font.codeLen; j++ {
+			code = code<<8 | uint32(s[i+j])
+		}
+		if text, ok := font.chars[code]; ok {
+			out.WriteString(text)
+		} else if font.codeLen == 1 && s[i] >= 0x20 {
+			out.WriteRune(rune(s[i]))
+		}
+	}
+	return out.String()
+}
+
+func abs(v float64) float64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// pdfLexer tokenizes a PDF content stream
+type pdfLexer struct {
+	data []byte
+	pos  int
+}
+
+func isPDFWhitespace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == '\f' || c == 0
+}
+
+func isPDFDelimiter(c byte) bool {
+	return strings.IndexByte("()<>[]{}/%", c) >= 0
+}
+
+// next returns the next token, or false at the end of the stream
+func (l *pdfLexer) next() (pdfToken, bool) {
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		switch {
+		case isPDFWhitespace(c):
+			l.pos++
+		case c == '%':
+			for l.pos < len(l.data) && l.data[l.pos] != '\n' && l.data[l.pos] != '\r' {
+				l.pos++
+			}
+		case c == '(':
+			return pdfToken{kind: pdfTokenString, str: l.readLiteralString()}, true
+		case c == '<':
+			if l.pos+1 < len(l.data) && l.data[l.pos+1] == '<' {
+				l.pos += 2
+				return pdfToken{kind: pdfTokenOther}, true
+			}
+			return pdfToken{kind: pdfTokenString, str: l.readHexString()}, true
+		case c == '>':
+			l.pos++
+			if l.pos < len(l.data) && l.data[l.pos] == '>' {
+				l.pos++
+			}
+			return pdfToken{kind: pdfTokenOther}, true
+		case c == '[':
+			l.pos++
+			return pdfToken{kind: pdfTokenArray, array: l.readArray()}, true
+		case c == ']' || c == '{' || c == '}' || c == ')':
+			l.pos++
+			return pdfToken{kind: pdfTokenOther}, true
+		case c == '/':
+			l.pos++
+			return pdfToken{kind: pdfTokenName, value: l.readRegular()}, true
+		default:
+			word := l.readRegular()
+			if word == "" {
+				l.pos++
+				continue
+			}
+			if isPDFNumber(word) {
+				return pdfToken{kind: pdfTokenNumber, value: word}, true
+			}
+			if word == "ID" {
+				l.skipInlineImage()
+			}
+			return pdfToken{kind: pdfTokenOperator, value: word}, true
+		}
+	}
+	return pdfToken{}, false
+}
+
+// readArray reads tokens until the closing bracket of an array
+func (l *pdfLexer) readArray() []pdfToken {
+	var items []pdfToken
+	for l.pos < len(l.data) {
+		for l.pos < len(l.data) && isPDFWhitespace(l.data[l.pos]) {
+			l.pos++
+		}
+		if l.pos < len(l.data) && l.data[l.pos] == ']' {
+			l.pos++
+			break
+		}
+		token, ok := l.next()
+		if !ok {
+			break
+		}
+		items = append(items, token)
+	}
+	return items
+}
+
+// readRegular reads a run of regular (non-delimiter, non-whitespace) characters
+func (l *pdfLexer) readRegular() string {
+	start := l.pos
+	for l.pos < len(l.data) && !isPDFWhitespace(l.data[l.pos]) && !isPDFDelimiter(l.data[l.pos]) {
+		l.pos++
+	}
+	return string(l.data[start:l.pos])
+}
+
+// readLiteralString reads a (...) string, handling nesting and escape sequences
+func (l *pdfLexer) readLiteralString() []byte {
+	l.pos++ // skip (
+	var out []byte
+	depth := 1
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		l.pos++
+		switch c {
+		case '(':
+			depth++
+			out = append(out, c)
+		case ')':
+			depth--
+			if depth == 0 {
+				return out
+			}
+			out = append(out, c)
+		case '\\':
+			if l.pos >= len(l.data) {
+				return out
+			}
+			e := l.data[l.pos]
+			l.pos++
+			switch e {
+			case 'n':
+				out = append(out, '\n')
+			case 'r':
+				out = append(out, '\r')
+			case 't':
+				out = append(out, '\t')
+			case 'b':
+				out = append(out, '\b')
+			case 'f':
+				out = append(out, '\f')
+			case '\r':
+				if l.pos < len(l.data) && l.data[l.pos] == '\n' {
+					l.pos++
+				}
+			case '\n':
+				// Line continuation
+			default:
+				if e >= '0' && e <= '7' {
+					value := int(e - '0')
+					for i := 0; i < 2 && l.pos < len(l.data) && l.data[l.pos] >= '0' && l.data[l.pos] <= '7'; i++ {
+						value = value*8 + int(l.data[l.pos]-'0')
+						l.pos++
+					}
+					out = append(out, byte(value))
+				} else {
+					out = append(out, e)
+				}
+			}
+		default:
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// readHexString reads a <...> string
+func (l *pdfLexer) readHexString() []byte {
+	l.pos++ // skip <
+	var digits []byte
+	for l.pos < len(l.data) && l.data[l.pos] != '>' {
+		if c := l.data[l.pos]; !isPDFWhitespace(c) {
+			digits = append(digits, c)
+		}
+		l.pos++
+	}
+	l.pos++ // skip >
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+
+	out := make([]byte, 0, len(digits)/2)
+	for i := 0; i < len(digits); i += 2 {
+		value, err := strconv.ParseUint(string(digits[i:i+2]), 16, 8)
+		if err != nil {
+			break
+		}
+		out = append(out, byte(value))
+	}
+	return out
+}
+
+// skipInlineImage skips the binary data of an inline image up to its EI operator
+func (l *pdfLexer) skipInlineImage() {
+	end := bytes.Index(l.data[l.pos:], []byte("EI"))
+	for end >= 0 {
+		at := l.pos + end
+		before := at == 0 || isPDFWhitespace(l.data[at-1])
+		after := at+2 >= len(l.data) || isPDFWhitespace(l.data[at+2])
+		if before && after {
+			l.pos = at + 2
+			return
+		}
+		next := bytes.Index(l.data[at+2:], []byte("EI"))
+		if next < 0 {
+			break
+		}
+		end = at + 2 + next - l.pos
+	}
+	l.pos = len(l.data)
+}
+
+// isPDFNumber reports whether a word is a PDF numeric literal
+func isPDFNumber(word string) bool {
+	_, err := strconv.ParseFloat(word, 64)
+	return err == nil
+}
@@ -3,7 +3,10 @@ package services
 import (
 	"errors"
 	"fmt"
+	"net/mail"
+	"regexp"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/rxtech-lab/invoice-management/internal/models"
 	"gorm.io/gorm"
@@ -15,12 +18,20 @@ type ReceiverService interface {
 	GetReceiverByID(userID string, id uint) (*models.InvoiceReceiver, error)
 	ListReceivers(userID string, keyword string, limit, offset int) ([]models.InvoiceReceiver, int64, error)
 	UpdateReceiver(userID string, receiver *models.InvoiceReceiver) error
-	DeleteReceiver(userID string, id uint) error
+	DeleteReceiver(userID string, id uint, force bool) error
 	SearchReceivers(userID string, query string) ([]models.InvoiceReceiver, error)
-	MergeReceivers(userID string, targetID uint, sourceIDs []uint) (*models.InvoiceReceiver, int64, error)
+	MergeReceivers(userID string, targetID uint, sourceIDs []uint, dryRun bool) (*ReceiverMergeResult, error)
 	FindByNameOrAlias(userID string, name string) (*models.InvoiceReceiver, error)
 }
 
+// ReceiverMergeResult is the outcome of merging receivers, or with a dry run the outcome it would have
+type ReceiverMergeResult struct {
+	Target           *models.InvoiceReceiver
+	Sources          []models.InvoiceReceiver
+	InvoicesAffected int64
+	DryRun           bool
+}
+
 type receiverService struct {
 	db *gorm.DB
 }
@@ -33,6 +44,9 @@ func NewReceiverService(db *gorm.DB) ReceiverService {
 // CreateReceiver creates a new invoice receiver
 func (s *receiverService) CreateReceiver(userID string, receiver *models.InvoiceReceiver) error {
 	receiver.UserID = userID
+	if err := normalizeReceiverContact(receiver); err != nil {
+		return err
+	}
 	return s.db.Create(receiver).Error
 }
 
@@ -89,24 +103,27 @@ func (s *receiverService) UpdateReceiver(userID string, receiver *models.Invoice
 		return fmt.Errorf("receiver not found: %w", err)
 	}
 
+	if err := normalizeReceiverContact(receiver); err != nil {
+		return err
+	}
+
 	// Update fields
 	existing.Name = receiver.Name
 	existing.IsOrganization = receiver.IsOrganization
 	existing.OtherNames = receiver.OtherNames
+	existing.Email = receiver.Email
+	existing.Phone = receiver.Phone
+	existing.Address = receiver.Address
+	existing.BankAccount = receiver.BankAccount
+	existing.TaxID = receiver.TaxID
 
 	return s.db.Save(existing).Error
 }
 
-// DeleteReceiver soft-deletes a receiver
-func (s *receiverService) DeleteReceiver(userID string, id uint) error {
-	result := s.db.Where("id = ? AND user_id = ?", id, userID).Delete(&models.InvoiceReceiver{})
-	if result.Error != nil {
-		return result.Error
-	}
-	if result.RowsAffected == 0 {
-		return fmt.Errorf("receiver not found")
-	}
-	return nil
+// DeleteReceiver soft-deletes a receiver. Invoices still referencing it block the deletion with an
+// *InUseError unless force is set, which removes the receiver from them first.
+func (s *receiverService) DeleteReceiver(userID string, id uint, force bool) error {
+	return deleteReferencedEntity(s.db, userID, id, force, "receiver", &models.InvoiceReceiver{}, "receiver_id", false)
 }
 
 // SearchReceivers performs a text search on receivers
@@ -162,10 +179,16 @@ func (s *receiverService) FindByNameOrAlias(userID string, name string) (*models
 // All invoices from source receivers are moved to the target receiver
 // Source receiver names are preserved in target's other_names field
 // Source receivers are then soft-deleted
-// Returns the updated target receiver and count of affected invoices
-func (s *receiverService) MergeReceivers(userID string, targetID uint, sourceIDs []uint) (*models.InvoiceReceiver, int64, error) {
-	var target *models.InvoiceReceiver
-	var affectedCount int64
+// Returns the updated target receiver, the sources, and count of affected invoices
+// With dryRun nothing is changed and the result reports what the merge would do
+func (s *receiverService) MergeReceivers(userID string, targetID uint, sourceIDs []uint, dryRun bool) (*ReceiverMergeResult, error) {
+	for _, id := range sourceIDs {
+		if id == targetID {
+			return nil, fmt.Errorf("target receiver cannot be one of the source receivers")
+		}
+	}
+
+	result := &ReceiverMergeResult{DryRun: dryRun}
 
 	err := s.db.Transaction(func(tx *gorm.DB) error {
 		// Verify target receiver ownership
@@ -214,26 +237,75 @@ func (s *receiverService) MergeReceivers(userID string, targetID uint, sourceIDs
 		}
 
 		// Update all invoices from source receivers to target receiver
-		result := tx.Model(&models.Invoice{}).
+		updated := tx.Model(&models.Invoice{}).
 			Where("receiver_id IN ? AND user_id = ?", sourceIDs, userID).
 			Update("receiver_id", targetID)
-		if result.Error != nil {
-			return result.Error
+		if updated.Error != nil {
+			return updated.Error
 		}
-		affectedCount = result.RowsAffected
 
 		// Soft-delete source receivers
 		if err := tx.Where("id IN ? AND user_id = ?", sourceIDs, userID).Delete(&models.InvoiceReceiver{}).Error; err != nil {
 			return err
 		}
 
-		target = &targetReceiver
+		result.Target = &targetReceiver
+		result.Sources = sourceReceivers
+		result.InvoicesAffected = updated.RowsAffected
+
+		// A dry run rolls everything back once the outcome is known
+		if dryRun {
+			return errMergeDryRun
+		}
 		return nil
 	})
 
-	if err != nil {
-		return nil, 0, err
+	if err != nil && !errors.Is(err, errMergeDryRun) {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// phonePattern loosely matches phone numbers: digits with an optional leading + and common separators
+var phonePattern = regexp.MustCompile(`^\+?[0-9][0-9 ()./-]*$`)
+
+// normalizeReceiverContact trims a receiver's contact details and checks their lengths. Emails must
+// be a bare address and phones a number with at least 5 digits; other fields are free text.
+func normalizeReceiverContact(receiver *models.InvoiceReceiver) error {
+	fields := []struct {
+		name      string
+		value     *string
+		maxLength int
+	}{
+		{"email", &receiver.Email, models.MaxReceiverEmailLength},
+		{"phone", &receiver.Phone, models.MaxReceiverPhoneLength},
+		{"address", &receiver.Address, models.MaxReceiverAddressLength},
+		{"bank account", &receiver.BankAccount, models.MaxReceiverBankAccountLength},
+		{"tax ID", &receiver.TaxID, models.MaxReceiverTaxIDLength},
+	}
+	for _, field := range fields {
+		*field.value = strings.TrimSpace(*field.value)
+		if utf8.RuneCountInString(*field.value) > field.maxLength {
+			return fmt.Errorf("invalid %s: must be at most %d characters", field.name, field.maxLength)
+		}
 	}
 
-	return target, affectedCount, nil
+	if receiver.Email != "" {
+		if address, err := mail.ParseAddress(receiver.Email); err != nil || address.Address != receiver.Email {
+			return fmt.Errorf("invalid email %q: expected an address such as billing@example.com", receiver.Email)
+		}
+	}
+	if receiver.Phone != "" {
+		digits := 0
+		for _, r := range receiver.Phone {
+			if r >= '0' && r <= '9' {
+				digits++
+			}
+		}
+		if !phonePattern.MatchString(receiver.Phone) || digits < 5 {
+			return fmt.Errorf("invalid phone %q: expected a number such as +1 555-0100", receiver.Phone)
+		}
+	}
+	return nil
 }
@@ -0,0 +1,200 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
+)
+
+// Matching rules of Reconcile. A statement line matches an invoice whose base-currency amount is
+// within ReconcileAmountTolerance of the line's (relative, absorbing the difference between the
+// bank's exchange rate and the invoice's; never less than one unit of the base currency's
+// precision), dated at most ReconcileDateWindowDays days apart.
+const (
+	ReconcileAmountTolerance = 0.01
+	ReconcileDateWindowDays  = 7
+)
+
+// MaxReconcileLines is the largest number of statement lines Reconcile accepts at once
+const MaxReconcileLines = 1000
+
+// StatementLine is a line of a bank or card statement. Amount is in the user's base currency;
+// its sign is ignored, as statements show payments as debits.
+type StatementLine struct {
+	Date        time.Time `json:"date"`
+	Amount      float64   `json:"amount"`
+	Description string    `json:"description,omitempty"`
+}
+
+// ReconcileCandidate is an invoice a statement line may pay. Date is the date it was matched
+// on: when it was paid, else its due date, else its creation date.
+type ReconcileCandidate struct {
+	InvoiceID     uint                 `json:"invoice_id"`
+	InvoiceNumber string               `json:"invoice_number,omitempty"`
+	Title         string               `json:"title"`
+	Status        models.InvoiceStatus `json:"status"`
+	Amount        float64              `json:"amount"`
+	Date          time.Time            `json:"date"`
+	// AmountDifference is the invoice amount less the line amount; DaysApart is how many days
+	// the two dates are apart either way
+	AmountDifference float64 `json:"amount_difference"`
+	DaysApart        int     `json:"days_apart"`
+}
+
+// ReconciledLine is a statement line with what Reconcile found for it. Line is the line's
+// position in the request, starting at 1.
+type ReconciledLine struct {
+	Line int `json:"line"`
+	StatementLine
+	Match      *ReconcileCandidate  `json:"match,omitempty"`
+	Candidates []ReconcileCandidate `json:"candidates,omitempty"`
+}
+
+// ReconcileResult sorts statement lines into those matching exactly one invoice, those with
+// several possible invoices to choose from, and those matching none, which may be invoices yet
+// to be entered. Amounts are in Currency, the user's base currency.
+type ReconcileResult struct {
+	Currency  string           `json:"currency"`
+	Matched   []ReconciledLine `json:"matched"`
+	Ambiguous []ReconciledLine `json:"ambiguous"`
+	Unmatched []ReconciledLine `json:"unmatched"`
+}
+
+// reconcileInvoice is an invoice considered by Reconcile with the fields it is matched on
+type reconcileInvoice struct {
+	ID            uint
+	InvoiceNumber string
+	Title         string
+	Status        models.InvoiceStatus
+	TargetAmount  float64
+	PaidAt        *time.Time
+	DueDate       *time.Time
+	CreatedAt     time.Time
+}
+
+// matchDate returns the date a statement line paying the invoice is compared with
+func (i *reconcileInvoice) matchDate() time.Time {
+	if i.PaidAt != nil {
+		return *i.PaidAt
+	}
+	if i.DueDate != nil {
+		return *i.DueDate
+	}
+	return i.CreatedAt
+}
+
+// reconcileDateColumn is reconcileInvoice.matchDate in SQL
+const reconcileDateColumn = "COALESCE(paid_at, due_date, created_at)"
+
+// Reconcile proposes which of the user's invoices the lines of a statement pay, matching on the
+// base-currency amount and the date as documented on ReconcileAmountTolerance. A line is matched
+// when exactly one invoice fits and no other line fits that invoice; lines sharing candidates
+// are ambiguous and list every candidate, closest date first. Drafts are left out. Nothing is
+// changed: the matches are for the caller to confirm, e.g. by marking the invoices paid.
+func (s *invoiceService) Reconcile(userID string, lines []StatementLine) (*ReconcileResult, error) {
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("at least one statement line is required")
+	}
+	if len(lines) > MaxReconcileLines {
+		return nil, fmt.Errorf("statement exceeds %d lines", MaxReconcileLines)
+	}
+	var from, to time.Time
+	for i, line := range lines {
+		if line.Date.IsZero() {
+			return nil, fmt.Errorf("line %d: date is required", i+1)
+		}
+		if line.Amount == 0 || math.IsNaN(line.Amount) || math.IsInf(line.Amount, 0) {
+			return nil, fmt.Errorf("line %d: amount must be a non-zero number", i+1)
+		}
+		if i == 0 || line.Date.Before(from) {
+			from = line.Date
+		}
+		if i == 0 || line.Date.After(to) {
+			to = line.Date
+		}
+	}
+	window := ReconcileDateWindowDays + 1
+	from = reconcileDay(from).AddDate(0, 0, -window)
+	to = reconcileDay(to).AddDate(0, 0, window)
+
+	var invoices []reconcileInvoice
+	if err := s.db.Model(&models.Invoice{}).
+		Select("id, invoice_number, title, status, paid_at, due_date, created_at, "+itemTargetAmountSubquery+" AS target_amount").
+		Where("user_id = ? AND is_draft = ?", userID, false).
+		Where(reconcileDateColumn+" >= ? AND "+reconcileDateColumn+" < ?", from, to).
+		Find(&invoices).Error; err != nil {
+		return nil, fmt.Errorf("failed to load invoices: %w", err)
+	}
+
+	currency, err := s.settingsService.GetBaseCurrency(userID)
+	if err != nil {
+		return nil, err
+	}
+	minTolerance := math.Pow10(-utils.CurrencyPrecision(currency))
+	result := &ReconcileResult{
+		Currency:  currency,
+		Matched:   []ReconciledLine{},
+		Ambiguous: []ReconciledLine{},
+		Unmatched: []ReconciledLine{},
+	}
+
+	candidates := make([][]ReconcileCandidate, len(lines))
+	linesPerInvoice := make(map[uint]int)
+	for i, line := range lines {
+		amount := math.Abs(line.Amount)
+		tolerance := math.Max(amount*ReconcileAmountTolerance, minTolerance) + 1e-9
+		for _, invoice := range invoices {
+			difference := math.Abs(invoice.TargetAmount) - amount
+			daysApart := int(math.Abs(reconcileDay(invoice.matchDate()).Sub(reconcileDay(line.Date)).Hours()) / 24)
+			if math.Abs(difference) > tolerance || daysApart > ReconcileDateWindowDays {
+				continue
+			}
+			candidates[i] = append(candidates[i], ReconcileCandidate{
+				InvoiceID:        invoice.ID,
+				InvoiceNumber:    invoice.InvoiceNumber,
+				Title:            invoice.Title,
+				Status:           invoice.Status,
+				Amount:           invoice.TargetAmount,
+				Date:             invoice.matchDate(),
+				AmountDifference: utils.RoundToCurrency(difference, currency),
+				DaysApart:        daysApart,
+			})
+			linesPerInvoice[invoice.ID]++
+		}
+		sort.SliceStable(candidates[i], func(a, b int) bool {
+			ca, cb := candidates[i][a], candidates[i][b]
+			if ca.DaysApart != cb.DaysApart {
+				return ca.DaysApart < cb.DaysApart
+			}
+			if da, db := math.Abs(ca.AmountDifference), math.Abs(cb.AmountDifference); da != db {
+				return da < db
+			}
+			return ca.InvoiceID < cb.InvoiceID
+		})
+	}
+
+	for i, line := range lines {
+		reconciled := ReconciledLine{Line: i + 1, StatementLine: line}
+		switch {
+		case len(candidates[i]) == 0:
+			result.Unmatched = append(result.Unmatched, reconciled)
+		case len(candidates[i]) == 1 && linesPerInvoice[candidates[i][0].InvoiceID] == 1:
+			reconciled.Match = &candidates[i][0]
+			result.Matched = append(result.Matched, reconciled)
+		default:
+			reconciled.Candidates = candidates[i]
+			result.Ambiguous = append(result.Ambiguous, reconciled)
+		}
+	}
+	return result, nil
+}
+
+// reconcileDay returns the start of the UTC day of t, which stored dates are compared in
+func reconcileDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,259 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/mail"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"gorm.io/gorm"
+)
+
+// DefaultBaseCurrency is used when a user has no settings stored
+const DefaultBaseCurrency = "USD"
+
+// DefaultTimezone is used when a user has no timezone set
+const DefaultTimezone = "UTC"
+
+// Invoice number format defaults and limits
+const (
+	DefaultInvoiceNumberPrefix  = "INV-{year}-"
+	DefaultInvoiceNumberPadding = 4
+	MaxInvoiceNumberPrefixLen   = 32
+	MaxInvoiceNumberPadding     = 10
+	MaxCompanyNameLen           = 255
+)
+
+// Default sanity bounds on invoice items, see ItemLimits
+const (
+	DefaultMaxItemUnitPrice  = 10_000_000
+	DefaultMaxItemQuantity   = 1_000_000
+	DefaultMaxItemLineAmount = 100_000_000
+)
+
+// ItemLimits bounds the magnitude of invoice item values so that a mistyped price doesn't
+// silently skew totals and analytics. Unit prices and line amounts are in the base currency.
+type ItemLimits struct {
+	MaxUnitPrice  float64
+	MaxQuantity   float64
+	MaxLineAmount float64
+}
+
+var currencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// SettingsService handles per-user settings
+type SettingsService interface {
+	// GetSettings returns the user's settings, or defaults if none are stored
+	GetSettings(userID string) (*models.UserSettings, error)
+	// GetBaseCurrency returns the user's base currency (USD if not configured)
+	GetBaseCurrency(userID string) (string, error)
+	// GetLocation returns the user's timezone (UTC if not configured)
+	GetLocation(userID string) *time.Location
+	// GetItemLimits returns the user's invoice item bounds (the defaults if not configured)
+	GetItemLimits(userID string) ItemLimits
+	// GetUnsupportedCurrencyPolicy returns how the user's amounts in non-ISO 4217 currencies are
+	// handled (rejected if not configured)
+	GetUnsupportedCurrencyPolicy(userID string) models.UnsupportedCurrencyPolicy
+	// UpdateSettings creates or updates the user's settings
+	UpdateSettings(userID string, settings *models.UserSettings) error
+}
+
+type settingsService struct {
+	db *gorm.DB
+}
+
+// NewSettingsService creates a new SettingsService instance
+func NewSettingsService(db *gorm.DB) SettingsService {
+	return &settingsService{db: db}
+}
+
+// GetSettings returns the user's settings, or defaults if none are stored
+func (s *settingsService) GetSettings(userID string) (*models.UserSettings, error) {
+	var settings models.UserSettings
+	err := s.db.Where("user_id = ?", userID).First(&settings).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &models.UserSettings{
+			UserID:               userID,
+			BaseCurrency:         DefaultBaseCurrency,
+			InvoiceNumberPrefix:  DefaultInvoiceNumberPrefix,
+			InvoiceNumberPadding: DefaultInvoiceNumberPadding,
+			Timezone:             DefaultTimezone,
+			MaxItemUnitPrice:     DefaultMaxItemUnitPrice,
+			MaxItemQuantity:      DefaultMaxItemQuantity,
+			MaxItemLineAmount:    DefaultMaxItemLineAmount,
+			UnsupportedCurrency:  models.UnsupportedCurrencyReject,
+			PaymentTrackingMode:  models.PaymentTrackingManual,
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// GetBaseCurrency returns the user's base currency (USD if not configured). A failed lookup is an
+// error rather than USD, which would convert amounts into the wrong currency.
+func (s *settingsService) GetBaseCurrency(userID string) (string, error) {
+	settings, err := s.GetSettings(userID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the base currency: %w", err)
+	}
+	if settings.BaseCurrency == "" {
+		return DefaultBaseCurrency, nil
+	}
+	return settings.BaseCurrency, nil
+}
+
+// GetLocation returns the user's timezone (UTC if not configured or on lookup failure)
+func (s *settingsService) GetLocation(userID string) *time.Location {
+	settings, err := s.GetSettings(userID)
+	if err != nil {
+		return time.UTC
+	}
+	loc, err := loadTimezone(settings.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// GetItemLimits returns the user's invoice item bounds (the defaults if not configured or on
+// lookup failure)
+func (s *settingsService) GetItemLimits(userID string) ItemLimits {
+	limits := ItemLimits{
+		MaxUnitPrice:  DefaultMaxItemUnitPrice,
+		MaxQuantity:   DefaultMaxItemQuantity,
+		MaxLineAmount: DefaultMaxItemLineAmount,
+	}
+	settings, err := s.GetSettings(userID)
+	if err != nil {
+		return limits
+	}
+	if settings.MaxItemUnitPrice > 0 {
+		limits.MaxUnitPrice = settings.MaxItemUnitPrice
+	}
+	if settings.MaxItemQuantity > 0 {
+		limits.MaxQuantity = settings.MaxItemQuantity
+	}
+	if settings.MaxItemLineAmount > 0 {
+		limits.MaxLineAmount = settings.MaxItemLineAmount
+	}
+	return limits
+}
+
+// GetUnsupportedCurrencyPolicy returns how the user's amounts in non-ISO 4217 currencies are
+// handled (rejected if not configured or on lookup failure)
+func (s *settingsService) GetUnsupportedCurrencyPolicy(userID string) models.UnsupportedCurrencyPolicy {
+	settings, err := s.GetSettings(userID)
+	if err != nil || settings.UnsupportedCurrency != models.UnsupportedCurrencyPassThrough {
+		return models.UnsupportedCurrencyReject
+	}
+	return models.UnsupportedCurrencyPassThrough
+}
+
+// UpdateSettings creates or updates the user's settings
+func (s *settingsService) UpdateSettings(userID string, settings *models.UserSettings) error {
+	settings.BaseCurrency = strings.ToUpper(strings.TrimSpace(settings.BaseCurrency))
+	if settings.BaseCurrency == "" {
+		settings.BaseCurrency = DefaultBaseCurrency
+	}
+	if !currencyCodePattern.MatchString(settings.BaseCurrency) {
+		return fmt.Errorf("invalid currency code: %s", settings.BaseCurrency)
+	}
+
+	if settings.InvoiceNumberPrefix == "" {
+		settings.InvoiceNumberPrefix = DefaultInvoiceNumberPrefix
+	}
+	if len(settings.InvoiceNumberPrefix) > MaxInvoiceNumberPrefixLen {
+		return fmt.Errorf("invoice number prefix must be at most %d characters", MaxInvoiceNumberPrefixLen)
+	}
+	if settings.InvoiceNumberPadding == 0 {
+		settings.InvoiceNumberPadding = DefaultInvoiceNumberPadding
+	}
+	if settings.InvoiceNumberPadding < 1 || settings.InvoiceNumberPadding > MaxInvoiceNumberPadding {
+		return fmt.Errorf("invoice number padding must be between 1 and %d", MaxInvoiceNumberPadding)
+	}
+
+	settings.Email = strings.TrimSpace(settings.Email)
+	if settings.Email != "" {
+		address, err := mail.ParseAddress(settings.Email)
+		if err != nil || address.Address != settings.Email {
+			return fmt.Errorf("invalid email address: %s", settings.Email)
+		}
+	}
+
+	settings.CompanyName = strings.TrimSpace(settings.CompanyName)
+	if len(settings.CompanyName) > MaxCompanyNameLen {
+		return fmt.Errorf("company name must be at most %d characters", MaxCompanyNameLen)
+	}
+	settings.CompanyAddress = strings.TrimSpace(settings.CompanyAddress)
+	settings.LogoS3Key = strings.TrimSpace(settings.LogoS3Key)
+
+	for _, limit := range []struct {
+		name     string
+		value    *float64
+		fallback float64
+	}{
+		{"max_item_unit_price", &settings.MaxItemUnitPrice, DefaultMaxItemUnitPrice},
+		{"max_item_quantity", &settings.MaxItemQuantity, DefaultMaxItemQuantity},
+		{"max_item_line_amount", &settings.MaxItemLineAmount, DefaultMaxItemLineAmount},
+	} {
+		if *limit.value == 0 {
+			*limit.value = limit.fallback
+		}
+		if *limit.value < 0 || math.IsNaN(*limit.value) || math.IsInf(*limit.value, 0) {
+			return fmt.Errorf("%s must be a positive number", limit.name)
+		}
+	}
+
+	switch settings.UnsupportedCurrency {
+	case "":
+		settings.UnsupportedCurrency = models.UnsupportedCurrencyReject
+	case models.UnsupportedCurrencyReject, models.UnsupportedCurrencyPassThrough:
+	default:
+		return fmt.Errorf("unsupported_currency must be %q or %q", models.UnsupportedCurrencyReject, models.UnsupportedCurrencyPassThrough)
+	}
+
+	switch settings.PaymentTrackingMode {
+	case "":
+		settings.PaymentTrackingMode = models.PaymentTrackingManual
+	case models.PaymentTrackingManual:
+	case models.PaymentTrackingPayments:
+		return fmt.Errorf("payment_tracking_mode %q is not available: there are no payment records to derive the status from, so invoices are marked paid manually",
+			models.PaymentTrackingPayments)
+	default:
+		return fmt.Errorf("payment_tracking_mode must be %q", models.PaymentTrackingManual)
+	}
+
+	settings.Timezone = strings.TrimSpace(settings.Timezone)
+	if settings.Timezone == "" {
+		settings.Timezone = DefaultTimezone
+	}
+	if _, err := loadTimezone(settings.Timezone); err != nil {
+		return err
+	}
+
+	existing, err := s.GetSettings(userID)
+	if err != nil {
+		return err
+	}
+
+	settings.ID = existing.ID
+	settings.UserID = userID
+	settings.CreatedAt = existing.CreatedAt
+	return s.db.Save(settings).Error
+}
+
+// loadTimezone loads an IANA timezone by name; empty means UTC. "Local" is rejected because
+// it depends on the server rather than the user.
+func loadTimezone(name string) (*time.Location, error) {
+	loc, err := time.LoadLocation(name)
+	if err != nil || name == "Local" {
+		return nil, fmt.Errorf("invalid timezone %q: use an IANA name such as Asia/Hong_Kong", name)
+	}
+	return loc, nil
+}
@@ -0,0 +1,183 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+)
+
+// Weights of the signals FindSimilar scores candidate invoices by. A candidate scores
+// SimilarReceiverWeight when it has the same receiver, up to SimilarTitleWeight for the share of
+// title words the two invoices have in common (shared words over all distinct words of both
+// titles), and up to SimilarAmountWeight when its base-currency amount is within
+// SimilarAmountTolerance of the invoice's, falling linearly from an equal amount to the edge of
+// that range. Candidates scoring 0 are left out.
+const (
+	SimilarReceiverWeight  = 3.0
+	SimilarTitleWeight     = 2.0
+	SimilarAmountWeight    = 1.0
+	SimilarAmountTolerance = 0.10
+)
+
+// Number of similar invoices FindSimilar returns by default and at most
+const (
+	DefaultSimilarLimit = 5
+	MaxSimilarLimit     = 50
+)
+
+// similarMinTokenLength is the shortest title word compared by FindSimilar; shorter words
+// ("a", "of", "#1") match too many unrelated titles
+const similarMinTokenLength = 3
+
+// similarMaxTokens caps the title words used to look up candidates
+const similarMaxTokens = 10
+
+// similarCandidate is an invoice considered by FindSimilar with the fields it is scored on
+type similarCandidate struct {
+	ID           uint
+	ReceiverID   *uint
+	Title        string
+	TargetAmount float64
+	CreatedAt    time.Time
+	score        float64
+}
+
+// FindSimilar returns up to limit of the user's other invoices most similar to the given one,
+// best first: same receiver, overlapping title words, and a base-currency amount within ±10%,
+// weighted as documented on SimilarReceiverWeight. Ties go to the most recent invoice.
+// A limit of 0 returns DefaultSimilarLimit invoices.
+func (s *invoiceService) FindSimilar(userID string, id uint, limit int) ([]models.Invoice, error) {
+	if limit == 0 {
+		limit = DefaultSimilarLimit
+	}
+	if limit < 0 || limit > MaxSimilarLimit {
+		return nil, fmt.Errorf("limit must be between 1 and %d", MaxSimilarLimit)
+	}
+
+	var invoice similarCandidate
+	if err := s.db.Model(&models.Invoice{}).
+		Select("id, receiver_id, title, "+itemTargetAmountSubquery+" AS target_amount").
+		Where("id = ? AND user_id = ?", id, userID).
+		First(&invoice).Error; err != nil {
+		return nil, fmt.Errorf("invoice not found: %w", err)
+	}
+	tokens := titleTokens(invoice.Title)
+
+	// Only invoices matching at least one signal can score above 0
+	signals := s.db.Where("1 = 0")
+	if invoice.ReceiverID != nil {
+		signals = signals.Or("receiver_id = ?", *invoice.ReceiverID)
+	}
+	for i, token := range tokens {
+		if i == similarMaxTokens {
+			break
+		}
+		signals = signals.Or("LOWER(title) LIKE ?", "%"+token+"%")
+	}
+	if invoice.TargetAmount != 0 {
+		delta := math.Abs(invoice.TargetAmount) * SimilarAmountTolerance
+		signals = signals.Or(itemTargetAmountSubquery+" BETWEEN ? AND ?", invoice.TargetAmount-delta, invoice.TargetAmount+delta)
+	}
+
+	var candidates []similarCandidate
+	if err := s.db.Model(&models.Invoice{}).
+		Select("id, receiver_id, title, created_at, "+itemTargetAmountSubquery+" AS target_amount").
+		Where("user_id = ? AND id <> ?", userID, id).
+		Where(signals).
+		Find(&candidates).Error; err != nil {
+		return nil, err
+	}
+
+	scored := candidates[:0]
+	for _, candidate := range candidates {
+		candidate.score = similarityScore(&invoice, tokens, &candidate)
+		if candidate.score > 0 {
+			scored = append(scored, candidate)
+		}
+	}
+	sort.SliceStable(scored, func(i, j int) bool {
+		if scored[i].score != scored[j].score {
+			return scored[i].score > scored[j].score
+		}
+		if !scored[i].CreatedAt.Equal(scored[j].CreatedAt) {
+			return scored[i].CreatedAt.After(scored[j].CreatedAt)
+		}
+		return scored[i].ID > scored[j].ID
+	})
+	if len(scored) > limit {
+		scored = scored[:limit]
+	}
+	if len(scored) == 0 {
+		return []models.Invoice{}, nil
+	}
+
+	ids := make([]uint, len(scored))
+	rank := make(map[uint]int, len(scored))
+	for i, candidate := range scored {
+		ids[i] = candidate.ID
+		rank[candidate.ID] = i
+	}
+	var invoices []models.Invoice
+	if err := AllInvoiceRelations().preload(s.db.Where("id IN ?", ids)).Find(&invoices).Error; err != nil {
+		return nil, err
+	}
+	sort.Slice(invoices, func(i, j int) bool {
+		return rank[invoices[i].ID] < rank[invoices[j].ID]
+	})
+	return invoices, nil
+}
+
+// similarityScore scores candidate against invoice, whose title words are tokens
+func similarityScore(invoice *similarCandidate, tokens []string, candidate *similarCandidate) float64 {
+	var score float64
+	if invoice.ReceiverID != nil && candidate.ReceiverID != nil && *invoice.ReceiverID == *candidate.ReceiverID {
+		score += SimilarReceiverWeight
+	}
+
+	if len(tokens) > 0 {
+		candidateTokens := titleTokens(candidate.Title)
+		shared := 0
+		for _, token := range candidateTokens {
+			for _, other := range tokens {
+				if token == other {
+					shared++
+					break
+				}
+			}
+		}
+		if union := len(tokens) + len(candidateTokens) - shared; shared > 0 {
+			score += SimilarTitleWeight * float64(shared) / float64(union)
+		}
+	}
+
+	if invoice.TargetAmount != 0 {
+		difference := math.Abs(candidate.TargetAmount-invoice.TargetAmount) / math.Abs(invoice.TargetAmount)
+		if difference < SimilarAmountTolerance {
+			score += SimilarAmountWeight * (1 - difference/SimilarAmountTolerance)
+		}
+	}
+	return score
+}
+
+// titleTokens splits a title into its distinct lower-cased words of at least
+// similarMinTokenLength letters or digits, in order of appearance
+func titleTokens(title string) []string {
+	words := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	var tokens []string
+	seen := make(map[string]bool, len(words))
+	for _, word := range words {
+		if len([]rune(word)) < similarMinTokenLength || seen[word] {
+			continue
+		}
+		seen[word] = true
+		tokens = append(tokens, word)
+	}
+	return tokens
+}
@@ -0,0 +1,243 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+)
+
+// DefaultAnomalyThreshold is the default number of standard deviations above the mean an
+// invoice must exceed to be flagged
+const DefaultAnomalyThreshold = 2.0
+
+// DefaultAnomalyMinSamples is the default number of invoices a group needs before its
+// invoices are compared to it
+const DefaultAnomalyMinSamples = 5
+
+// AnomalyGroupBy is the grouping invoices are compared within
+type AnomalyGroupBy string
+
+const (
+	AnomalyGroupByCategory AnomalyGroupBy = "category"
+	AnomalyGroupByReceiver AnomalyGroupBy = "receiver"
+)
+
+// AnomalyOptions configures DetectAnomalies; zero values use the defaults
+type AnomalyOptions struct {
+	GroupBy AnomalyGroupBy // Default: category
+	// K is the number of standard deviations above the mean that counts as unusual
+	K float64
+	// MinSamples is the smallest group size that is evaluated; smaller groups are skipped
+	MinSamples int
+}
+
+// AnomalyBaseline is the amount distribution of one group (amounts in the user's base currency)
+type AnomalyBaseline struct {
+	ID           uint    `json:"id"`
+	Name         string  `json:"name"`
+	SampleSize   int     `json:"sample_size"`
+	Mean         float64 `json:"mean"`
+	StdDev       float64 `json:"std_dev"`
+	Threshold    float64 `json:"threshold"`
+	AnomalyCount int     `json:"anomaly_count"`
+}
+
+// SpendingAnomaly is an invoice whose amount is above its group's threshold
+type SpendingAnomaly struct {
+	InvoiceID uint      `json:"invoice_id"`
+	Title     string    `json:"title"`
+	Date      time.Time `json:"date"`
+	Amount    float64   `json:"amount"`
+	GroupID   uint      `json:"group_id"`
+	GroupName string    `json:"group_name"`
+	Mean      float64   `json:"mean"`
+	Threshold float64   `json:"threshold"`
+	// ZScore is how many standard deviations the amount is above the group mean
+	ZScore float64 `json:"z_score"`
+}
+
+// SpendingAnomalies lists the unusually large invoices of a period, largest z-score first.
+// Baselines holds the evaluated groups; SkippedGroups counts groups below MinSamples.
+type SpendingAnomalies struct {
+	Period        string            `json:"period"`
+	StartDate     time.Time         `json:"start_date"`
+	EndDate       time.Time         `json:"end_date"`
+	Currency      string            `json:"currency"`
+	GroupBy       AnomalyGroupBy    `json:"group_by"`
+	K             float64           `json:"k"`
+	MinSamples    int               `json:"min_samples"`
+	Baselines     []AnomalyBaseline `json:"baselines"`
+	SkippedGroups int               `json:"skipped_groups"`
+	Anomalies     []SpendingAnomaly `json:"anomalies"`
+}
+
+// anomalyInvoiceRow is an invoice with its base-currency-normalized amount
+type anomalyInvoiceRow struct {
+	models.Invoice
+	Normalized float64
+}
+
+// DetectAnomalies flags invoices of the period whose base-currency amount exceeds mean + K
+// standard deviations (population) of their category or receiver over the same period.
+// Invoices without a category/receiver, refunds, and credit notes are not sampled.
+func (s *analyticsService) DetectAnomalies(userID string, period AnalyticsPeriod, opts AnomalyOptions) (*SpendingAnomalies, error) {
+	if opts.GroupBy == "" {
+		opts.GroupBy = AnomalyGroupByCategory
+	}
+	if opts.K == 0 {
+		opts.K = DefaultAnomalyThreshold
+	}
+	if opts.MinSamples == 0 {
+		opts.MinSamples = DefaultAnomalyMinSamples
+	}
+	if opts.K < 0 {
+		return nil, fmt.Errorf("k must not be negative")
+	}
+	if opts.MinSamples < 2 {
+		return nil, fmt.Errorf("min_samples must be at least 2")
+	}
+
+	var groupColumn string
+	switch opts.GroupBy {
+	case AnomalyGroupByCategory:
+		groupColumn = "category_id"
+	case AnomalyGroupByReceiver:
+		groupColumn = "receiver_id"
+	default:
+		return nil, fmt.Errorf("invalid group_by: %s", opts.GroupBy)
+	}
+
+	start, end := s.getDateRange(period)
+	baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
+	if err != nil {
+		return nil, err
+	}
+	response := &SpendingAnomalies{
+		Period:     string(period),
+		StartDate:  start,
+		EndDate:    end,
+		Currency:   baseCurrency,
+		GroupBy:    opts.GroupBy,
+		K:          opts.K,
+		MinSamples: opts.MinSamples,
+		Baselines:  []AnomalyBaseline{},
+		Anomalies:  []SpendingAnomaly{},
+	}
+
+	var rows []anomalyInvoiceRow
+	if err := s.db.Model(&models.Invoice{}).Scopes(excludeDrafts).
+		Select("*, COALESCE("+itemTargetAmountSubquery+", amount) as normalized").
+		Where("user_id = ? AND COALESCE(due_date, created_at) >= ? AND COALESCE(due_date, created_at) <= ? AND deleted_at IS NULL",
+			userID, start, end).
+		Where(groupColumn+" IS NOT NULL").
+		Where("COALESCE(relation_type, '') NOT IN ?",
+			[]models.InvoiceRelationType{models.InvoiceRelationRefund, models.InvoiceRelationCreditNote}).
+		Order("id ASC").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	groups := make(map[uint][]*anomalyInvoiceRow)
+	var groupIDs []uint
+	for i := range rows {
+		id := rows[i].CategoryID
+		if opts.GroupBy == AnomalyGroupByReceiver {
+			id = rows[i].ReceiverID
+		}
+		if _, ok := groups[*id]; !ok {
+			groupIDs = append(groupIDs, *id)
+		}
+		groups[*id] = append(groups[*id], &rows[i])
+	}
+
+	names, err := s.anomalyGroupNames(userID, opts.GroupBy, groupIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range groupIDs {
+		members := groups[id]
+		if len(members) < opts.MinSamples {
+			response.SkippedGroups++
+			continue
+		}
+
+		var sum float64
+		for _, row := range members {
+			sum += row.Normalized
+		}
+		mean := sum / float64(len(members))
+		var variance float64
+		for _, row := range members {
+			variance += (row.Normalized - mean) * (row.Normalized - mean)
+		}
+		stdDev := math.Sqrt(variance / float64(len(members)))
+
+		baseline := AnomalyBaseline{
+			ID:         id,
+			Name:       names[id],
+			SampleSize: len(members),
+			Mean:       mean,
+			StdDev:     stdDev,
+			Threshold:  mean + opts.K*stdDev,
+		}
+		// Identical amounts have no spread, so nothing in the group stands out
+		if stdDev > 0 {
+			for _, row := range members {
+				if row.Normalized <= baseline.Threshold {
+					continue
+				}
+				baseline.AnomalyCount++
+				response.Anomalies = append(response.Anomalies, SpendingAnomaly{
+					InvoiceID: row.ID,
+					Title:     row.Title,
+					Date:      *invoiceEffectiveDate(&row.Invoice),
+					Amount:    row.Normalized,
+					GroupID:   id,
+					GroupName: baseline.Name,
+					Mean:      mean,
+					Threshold: baseline.Threshold,
+					ZScore:    (row.Normalized - mean) / stdDev,
+				})
+			}
+		}
+		response.Baselines = append(response.Baselines, baseline)
+	}
+
+	sort.SliceStable(response.Anomalies, func(i, j int) bool {
+		return response.Anomalies[i].ZScore > response.Anomalies[j].ZScore
+	})
+
+	return response, nil
+}
+
+// anomalyGroupNames returns the names of the user's categories or receivers by ID
+func (s *analyticsService) anomalyGroupNames(userID string, groupBy AnomalyGroupBy, ids []uint) (map[uint]string, error) {
+	names := make(map[uint]string, len(ids))
+	if len(ids) == 0 {
+		return names, nil
+	}
+
+	var model interface{} = &models.InvoiceCategory{}
+	if groupBy == AnomalyGroupByReceiver {
+		model = &models.InvoiceReceiver{}
+	}
+
+	var rows []struct {
+		ID   uint
+		Name string
+	}
+	if err := s.db.Model(model).
+		Select("id, name").
+		Where("user_id = ? AND id IN ?", userID, ids).
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		names[row.ID] = row.Name
+	}
+	return names, nil
+}
@@ -1,7 +1,11 @@
 package services
 
 import (
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/rxtech-lab/invoice-management/internal/models"
 	"gorm.io/gorm"
@@ -13,6 +17,7 @@ type TagService interface {
 	CreateTag(userID string, tag *models.InvoiceTag) error
 	GetTagByID(userID string, id uint) (*models.InvoiceTag, error)
 	GetTagByName(userID string, name string) (*models.InvoiceTag, error)
+	GetTagsByNames(userID string, names []string) ([]models.InvoiceTag, error)
 	ListTags(userID string, keyword string, limit, offset int) ([]models.InvoiceTag, int64, error)
 	UpdateTag(userID string, tag *models.InvoiceTag) error
 	DeleteTag(userID string, id uint) error
@@ -23,6 +28,100 @@ type TagService interface {
 	RemoveTagFromInvoice(userID string, invoiceID, tagID uint) error
 	GetInvoicesByTagID(userID string, tagID uint, limit, offset int) ([]models.Invoice, int64, error)
 	GetOrCreateTagByName(userID string, name string) (*models.InvoiceTag, error)
+
+	// Usage and cleanup
+	GetTagUsage(userID string) ([]TagUsage, error)
+	DeleteUnusedTags(userID string, olderThan time.Time) (int64, error)
+}
+
+// TagUsage is a tag with the number of invoices carrying it and when it was last used, the
+// creation date of the newest of those invoices (nil for an unused tag). Deleted invoices
+// don't count.
+type TagUsage struct {
+	models.InvoiceTag
+	InvoiceCount int64      `json:"invoice_count"`
+	LastUsedAt   *time.Time `json:"last_used_at"`
+}
+
+// ErrTagCycle is returned when a tag's parent is the tag itself or one of its descendants
+var ErrTagCycle = errors.New("a tag can't be nested under itself or one of its descendants")
+
+// tagInUse matches tags mapped to at least one invoice that is not deleted
+const tagInUse = "EXISTS (SELECT 1 FROM invoice_tag_mappings JOIN invoices ON invoices.id = invoice_tag_mappings.invoice_id " +
+	"WHERE invoice_tag_mappings.invoice_tag_id = invoice_tags.id AND invoices.deleted_at IS NULL)"
+
+// tagHasChildren matches tags that are the parent of a tag that is not deleted
+const tagHasChildren = "EXISTS (SELECT 1 FROM invoice_tags AS children " +
+	"WHERE children.parent_id = invoice_tags.id AND children.deleted_at IS NULL)"
+
+// tagColorPalette is the set of distinct colors auto-assigned to tags created without a color
+var tagColorPalette = []string{
+	"#EF4444", // red
+	"#F97316", // orange
+	"#F59E0B", // amber
+	"#84CC16", // lime
+	"#22C55E", // green
+	"#14B8A6", // teal
+	"#06B6D4", // cyan
+	"#3B82F6", // blue
+	"#6366F1", // indigo
+	"#A855F7", // purple
+	"#EC4899", // pink
+	"#78716C", // stone
+}
+
+// nextTagColor returns the first palette color used least by the user's existing tags,
+// so every palette color is used once before any color repeats
+func nextTagColor(db *gorm.DB, userID string) (string, error) {
+	var colors []string
+	if err := db.Model(&models.InvoiceTag{}).Where("user_id = ?", userID).Pluck("color", &colors).Error; err != nil {
+		return "", err
+	}
+
+	usage := make(map[string]int, len(colors))
+	for _, color := range colors {
+		usage[strings.ToUpper(color)]++
+	}
+
+	best := tagColorPalette[0]
+	for _, color := range tagColorPalette[1:] {
+		if usage[color] < usage[best] {
+			best = color
+		}
+	}
+	return best, nil
+}
+
+// validateTagParent checks that parentID, if set, is one of the user's tags and that nesting
+// tagID under it keeps the hierarchy free of cycles. tagID is 0 for a tag being created.
+func validateTagParent(db *gorm.DB, userID string, tagID uint, parentID *uint) error {
+	if parentID == nil {
+		return nil
+	}
+	if *parentID == tagID {
+		return ErrTagCycle
+	}
+
+	// Walk up from the parent: reaching the tag means the parent is one of its descendants
+	visited := make(map[uint]bool)
+	current := parentID
+	for current != nil && !visited[*current] {
+		visited[*current] = true
+		var ancestor models.InvoiceTag
+		err := db.Select("id, parent_id").Where("id = ? AND user_id = ?", *current, userID).First(&ancestor).Error
+		if err != nil && current == parentID {
+			return fmt.Errorf("parent tag not found: %w", err)
+		}
+		if err != nil {
+			// The chain ends at a tag that no longer exists
+			break
+		}
+		if tagID != 0 && ancestor.ParentID != nil && *ancestor.ParentID == tagID {
+			return ErrTagCycle
+		}
+		current = ancestor.ParentID
+	}
+	return nil
 }
 
 type tagService struct {
@@ -35,6 +134,8 @@ func NewTagService(db *gorm.DB) TagService {
 }
 
 // CreateTag creates a new tag
+// If no color is given, the next unused palette color is assigned
+// A parent, if given, must be one of the user's tags
 func (s *tagService) CreateTag(userID string, tag *models.InvoiceTag) error {
 	tag.UserID = userID
 
@@ -45,6 +146,18 @@ func (s *tagService) CreateTag(userID string, tag *models.InvoiceTag) error {
 		return fmt.Errorf("tag with name '%s' already exists", tag.Name)
 	}
 
+	if err := validateTagParent(s.db, userID, 0, tag.ParentID); err != nil {
+		return err
+	}
+
+	if tag.Color == "" {
+		color, err := nextTagColor(s.db, userID)
+		if err != nil {
+			return err
+		}
+		tag.Color = color
+	}
+
 	return s.db.Create(tag).Error
 }
 
@@ -68,6 +181,16 @@ func (s *tagService) GetTagByName(userID string, name string) (*models.InvoiceTa
 	return &tag, nil
 }
 
+// GetTagsByNames retrieves the user's tags with any of the given names; unknown names are skipped
+func (s *tagService) GetTagsByNames(userID string, names []string) ([]models.InvoiceTag, error) {
+	var tags []models.InvoiceTag
+	if len(names) == 0 {
+		return tags, nil
+	}
+	err := s.db.Where("user_id = ? AND name IN ?", userID, names).Find(&tags).Error
+	return tags, err
+}
+
 // ListTags lists tags with optional keyword search and pagination
 func (s *tagService) ListTags(userID string, keyword string, limit, offset int) ([]models.InvoiceTag, int64, error) {
 	var tags []models.InvoiceTag
@@ -104,6 +227,7 @@ func (s *tagService) ListTags(userID string, keyword string, limit, offset int)
 }
 
 // UpdateTag updates an existing tag
+// Moving a tag under itself or one of its descendants fails with ErrTagCycle
 func (s *tagService) UpdateTag(userID string, tag *models.InvoiceTag) error {
 	// Verify ownership
 	existing, err := s.GetTagByID(userID, tag.ID)
@@ -120,14 +244,20 @@ func (s *tagService) UpdateTag(userID string, tag *models.InvoiceTag) error {
 		}
 	}
 
+	if err := validateTagParent(s.db, userID, tag.ID, tag.ParentID); err != nil {
+		return err
+	}
+
 	// Update fields
 	existing.Name = tag.Name
 	existing.Color = tag.Color
+	existing.ParentID = tag.ParentID
 
 	return s.db.Save(existing).Error
 }
 
 // DeleteTag soft-deletes a tag and removes all its mappings
+// Its child tags move up to the deleted tag's parent
 func (s *tagService) DeleteTag(userID string, id uint) error {
 	return s.db.Transaction(func(tx *gorm.DB) error {
 		// Verify ownership
@@ -141,6 +271,12 @@ func (s *tagService) DeleteTag(userID string, id uint) error {
 			return err
 		}
 
+		if err := tx.Model(&models.InvoiceTag{}).
+			Where("user_id = ? AND parent_id = ?", userID, id).
+			Update("parent_id", tag.ParentID).Error; err != nil {
+			return err
+		}
+
 		// Delete the tag
 		return tx.Delete(&tag).Error
 	})
@@ -210,7 +346,8 @@ func (s *tagService) RemoveTagFromInvoice(userID string, invoiceID, tagID uint)
 	return nil
 }
 
-// GetInvoicesByTagID retrieves invoices that have a specific tag
+// GetInvoicesByTagID retrieves invoices that have a specific tag. Drafts are left out, as they
+// are from analytics.
 func (s *tagService) GetInvoicesByTagID(userID string, tagID uint, limit, offset int) ([]models.Invoice, int64, error) {
 	var invoices []models.Invoice
 	var total int64
@@ -222,7 +359,7 @@ func (s *tagService) GetInvoicesByTagID(userID string, tagID uint, limit, offset
 	}
 
 	// Build query for invoices with this tag
-	query := s.db.Model(&models.Invoice{}).
+	query := s.db.Model(&models.Invoice{}).Scopes(excludeDrafts).
 		Where("user_id = ?", userID).
 		Where("id IN (SELECT invoice_id FROM invoice_tag_mappings WHERE invoice_tag_id = ?)", tagID)
 
@@ -243,7 +380,7 @@ func (s *tagService) GetInvoicesByTagID(userID string, tagID uint, limit, offset
 	query = query.Preload("Category").
 		Preload("Company").
 		Preload("Receiver").
-		Preload("Items").
+		Preload("Items", orderItemsByPosition).
 		Preload("Tags").
 		Order("created_at DESC")
 
@@ -263,13 +400,93 @@ func (s *tagService) GetOrCreateTagByName(userID string, name string) (*models.I
 	}
 
 	// Create new tag
+	color, err := nextTagColor(s.db, userID)
+	if err != nil {
+		return nil, err
+	}
 	tag = models.InvoiceTag{
 		UserID: userID,
 		Name:   name,
-		Color:  "#6B7280", // Default gray color
+		Color:  color,
 	}
 	if err := s.db.Create(&tag).Error; err != nil {
 		return nil, err
 	}
 	return &tag, nil
 }
+
+// GetTagUsage returns every tag of the user with its usage, most used first and then by name
+func (s *tagService) GetTagUsage(userID string) ([]TagUsage, error) {
+	var tags []models.InvoiceTag
+	if err := s.db.Where("user_id = ?", userID).Order("name ASC").Find(&tags).Error; err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	// Last-used dates are compared in Go, since SQLite returns MAX() over timestamps as text
+	var rows []struct {
+		TagID     uint
+		CreatedAt time.Time
+	}
+	if err := s.db.Table("invoice_tag_mappings").
+		Select("invoice_tag_mappings.invoice_tag_id AS tag_id, invoices.created_at").
+		Joins("JOIN invoices ON invoices.id = invoice_tag_mappings.invoice_id").
+		Where("invoices.user_id = ? AND invoices.deleted_at IS NULL", userID).
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to count tag usage: %w", err)
+	}
+
+	usage := make(map[uint]*TagUsage, len(tags))
+	result := make([]TagUsage, len(tags))
+	for i, tag := range tags {
+		result[i] = TagUsage{InvoiceTag: tag}
+		usage[tag.ID] = &result[i]
+	}
+	for _, row := range rows {
+		tag, ok := usage[row.TagID]
+		if !ok {
+			continue
+		}
+		tag.InvoiceCount++
+		if tag.LastUsedAt == nil || row.CreatedAt.After(*tag.LastUsedAt) {
+			createdAt := row.CreatedAt
+			tag.LastUsedAt = &createdAt
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].InvoiceCount > result[j].InvoiceCount
+	})
+	return result, nil
+}
+
+// DeleteUnusedTags soft-deletes the user's tags that no invoice carries, only those created
+// before olderThan unless it is zero, and returns how many were deleted. Mappings to deleted
+// invoices don't count as use and are removed with the tag. Tags in use, and parent tags, are
+// never touched: they are matched and deleted in one transaction.
+func (s *tagService) DeleteUnusedTags(userID string, olderThan time.Time) (int64, error) {
+	var deleted int64
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&models.InvoiceTag{}).Where("user_id = ? AND NOT "+tagInUse+" AND NOT "+tagHasChildren, userID)
+		if !olderThan.IsZero() {
+			query = query.Where("created_at < ?", olderThan)
+		}
+		var ids []uint
+		if err := query.Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		if err := tx.Where("invoice_tag_id IN ?", ids).Delete(&models.InvoiceTagMapping{}).Error; err != nil {
+			return err
+		}
+		result := tx.Where("id IN ?", ids).Delete(&models.InvoiceTag{})
+		deleted = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete unused tags: %w", err)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,141 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"gorm.io/gorm"
+)
+
+// TemplateService handles invoice template business logic
+// Invoices are created from templates with InvoiceService.CreateFromTemplate
+type TemplateService interface {
+	CreateTemplate(userID string, template *models.InvoiceTemplate) error
+	GetTemplateByID(userID string, id uint) (*models.InvoiceTemplate, error)
+	ListTemplates(userID string) ([]models.InvoiceTemplate, error)
+	UpdateTemplate(userID string, template *models.InvoiceTemplate) error
+	DeleteTemplate(userID string, id uint) error
+}
+
+type templateService struct {
+	db *gorm.DB
+}
+
+// NewTemplateService creates a new TemplateService instance
+func NewTemplateService(db *gorm.DB) TemplateService {
+	return &templateService{db: db}
+}
+
+// CreateTemplate creates a new invoice template
+func (s *templateService) CreateTemplate(userID string, template *models.InvoiceTemplate) error {
+	if err := s.validateTemplate(userID, template); err != nil {
+		return err
+	}
+
+	template.ID = 0
+	template.UserID = userID
+	return s.db.Create(template).Error
+}
+
+// GetTemplateByID retrieves one of the user's templates
+func (s *templateService) GetTemplateByID(userID string, id uint) (*models.InvoiceTemplate, error) {
+	var template models.InvoiceTemplate
+	if err := s.db.Where("id = ? AND user_id = ?", id, userID).First(&template).Error; err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+// ListTemplates lists all templates for a user by name
+func (s *templateService) ListTemplates(userID string) ([]models.InvoiceTemplate, error) {
+	var templates []models.InvoiceTemplate
+	err := s.db.Where("user_id = ?", userID).
+		Order("name ASC, id ASC").
+		Find(&templates).Error
+	return templates, err
+}
+
+// UpdateTemplate replaces the name and defaults of an existing template
+func (s *templateService) UpdateTemplate(userID string, template *models.InvoiceTemplate) error {
+	existing, err := s.GetTemplateByID(userID, template.ID)
+	if err != nil {
+		return fmt.Errorf("template not found: %w", err)
+	}
+	if err := s.validateTemplate(userID, template); err != nil {
+		return err
+	}
+
+	template.UserID = userID
+	template.CreatedAt = existing.CreatedAt
+	return s.db.Save(template).Error
+}
+
+// DeleteTemplate soft-deletes a template; invoices created from it are kept
+func (s *templateService) DeleteTemplate(userID string, id uint) error {
+	result := s.db.Where("id = ? AND user_id = ?", id, userID).Delete(&models.InvoiceTemplate{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("template not found: %w", gorm.ErrRecordNotFound)
+	}
+	return nil
+}
+
+// validateTemplate normalizes the template and checks that the category, company, and
+// receiver it refers to belong to the user
+func (s *templateService) validateTemplate(userID string, template *models.InvoiceTemplate) error {
+	template.Name = strings.TrimSpace(template.Name)
+	if template.Name == "" {
+		return fmt.Errorf("template name is required")
+	}
+	if template.Currency == "" {
+		template.Currency = "USD"
+	}
+	template.Currency = strings.ToUpper(template.Currency)
+
+	if template.CategoryID != nil {
+		if err := s.db.Where("id = ? AND user_id = ?", *template.CategoryID, userID).First(&models.InvoiceCategory{}).Error; err != nil {
+			return fmt.Errorf("category not found: %w", err)
+		}
+	}
+	if template.CompanyID != nil {
+		if err := s.db.Where("id = ? AND user_id = ?", *template.CompanyID, userID).First(&models.InvoiceCompany{}).Error; err != nil {
+			return fmt.Errorf("company not found: %w", err)
+		}
+	}
+	if template.ReceiverID != nil {
+		if err := s.db.Where("id = ? AND user_id = ?", *template.ReceiverID, userID).First(&models.InvoiceReceiver{}).Error; err != nil {
+			return fmt.Errorf("receiver not found: %w", err)
+		}
+	}
+
+	for i := range template.Items {
+		item := &template.Items[i]
+		if strings.TrimSpace(item.Description) == "" {
+			return fmt.Errorf("item %d: description is required", i+1)
+		}
+		item.Quantity = models.ItemQuantity(&item.Quantity, item.UnitPrice)
+		if err := validateDiscount(item.DiscountType, item.DiscountValue); err != nil {
+			return fmt.Errorf("item %q: %w", item.Description, err)
+		}
+	}
+	if template.Items == nil {
+		template.Items = []models.InvoiceTemplateItem{}
+	}
+
+	// Tag names are applied with InvoiceService.SetInvoiceTags, which expects each name once
+	tags := make([]string, 0, len(template.Tags))
+	seen := make(map[string]bool, len(template.Tags))
+	for _, name := range template.Tags {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		tags = append(tags, name)
+	}
+	template.Tags = tags
+	return nil
+}
@@ -1,25 +1,59 @@
 package services
 
 import (
+	"bufio"
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"mime"
+	"net/http"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/google/uuid"
 )
 
+// DefaultMaxUploadBytes is the default of MaxUploadBytes
+const DefaultMaxUploadBytes = 10 << 20
+
+// MaxUploadBytes is the largest file accepted by POST /api/upload. Larger files are uploaded
+// from the client with a presigned URL.
+var MaxUploadBytes int64 = DefaultMaxUploadBytes
+
+// ErrUploadTooLarge is returned while reading an upload past MaxUploadBytes
+var ErrUploadTooLarge = errors.New("file exceeds the maximum upload size")
+
+// AllowedUploadContentTypes are the content types accepted by POST /api/upload
+var AllowedUploadContentTypes = map[string]bool{
+	"application/pdf": true,
+	"image/png":       true,
+	"image/jpeg":      true,
+	"image/gif":       true,
+	"image/webp":      true,
+}
+
+// uploadPartSize is the size of the parts UploadStream sends to S3, which requires parts of at
+// least 5 MiB except for the last one. It is also the most UploadStream holds in memory.
+const uploadPartSize = 5 << 20
+
 // UploadService handles file uploads to S3-compatible storage
 type UploadService interface {
 	UploadFile(ctx context.Context, userID string, filename string, content []byte, contentType string) (string, error)
+	// UploadStream uploads a file of unknown size as it is read and returns its key and size.
+	// An error reading body fails the upload and is returned wrapped.
+	UploadStream(ctx context.Context, userID string, filename string, body io.Reader, contentType string) (string, int64, error)
 	GetPresignedUploadURL(ctx context.Context, userID string, filename string, contentType string) (string, string, error)
 	GetPresignedDownloadURL(ctx context.Context, key string) (string, error)
 	DeleteFile(ctx context.Context, key string) error
+	DownloadFile(ctx context.Context, key string, maxSize int64) ([]byte, string, error)
 }
 
 // S3Config holds S3 configuration
@@ -99,6 +133,129 @@ func (s *uploadService) UploadFile(ctx context.Context, userID string, filename
 	return key, nil
 }
 
+// UploadStream uploads a file as it is read: a file that fits in one part is sent with a single
+// PutObject, and larger files as a multipart upload, one part at a time
+func (s *uploadService) UploadStream(ctx context.Context, userID string, filename string, body io.Reader, contentType string) (string, int64, error) {
+	ext := filepath.Ext(filename)
+	key := fmt.Sprintf("invoices/%s/%s%s", userID, uuid.New().String(), ext)
+
+	part := make([]byte, uploadPartSize)
+	n, err := readPart(body, part)
+	if err != nil {
+		return "", 0, err
+	}
+	if n < len(part) {
+		_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+			Bucket:        aws.String(s.bucket),
+			Key:           aws.String(key),
+			Body:          bytes.NewReader(part[:n]),
+			ContentLength: aws.Int64(int64(n)),
+			ContentType:   aws.String(contentType),
+		})
+		if err != nil {
+			return "", 0, fmt.Errorf("failed to upload file: %w", err)
+		}
+		return key, int64(n), nil
+	}
+
+	upload, err := s.client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(key),
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to upload file: %w", err)
+	}
+	abort := func(err error) (string, int64, error) {
+		_, _ = s.client.AbortMultipartUpload(context.WithoutCancel(ctx), &s3.AbortMultipartUploadInput{
+			Bucket:   aws.String(s.bucket),
+			Key:      aws.String(key),
+			UploadId: upload.UploadId,
+		})
+		return "", 0, err
+	}
+
+	var parts []types.CompletedPart
+	var size int64
+	for number := int32(1); n > 0; number++ {
+		output, err := s.client.UploadPart(ctx, &s3.UploadPartInput{
+			Bucket:        aws.String(s.bucket),
+			Key:           aws.String(key),
+			UploadId:      upload.UploadId,
+			PartNumber:    aws.Int32(number),
+			Body:          bytes.NewReader(part[:n]),
+			ContentLength: aws.Int64(int64(n)),
+		})
+		if err != nil {
+			return abort(fmt.Errorf("failed to upload file: %w", err))
+		}
+		parts = append(parts, types.CompletedPart{ETag: output.ETag, PartNumber: aws.Int32(number)})
+		size += int64(n)
+
+		if n, err = readPart(body, part); err != nil {
+			return abort(err)
+		}
+	}
+
+	_, err = s.client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(s.bucket),
+		Key:             aws.String(key),
+		UploadId:        upload.UploadId,
+		MultipartUpload: &types.CompletedMultipartUpload{Parts: parts},
+	})
+	if err != nil {
+		return abort(fmt.Errorf("failed to upload file: %w", err))
+	}
+	return key, size, nil
+}
+
+// readPart fills part from body and returns how much it read, which is less than len(part) only
+// at the end of body
+func readPart(body io.Reader, part []byte) (int, error) {
+	n, err := io.ReadFull(body, part)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return n, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read file: %w", err)
+	}
+	return n, nil
+}
+
+// LimitUpload wraps an upload so that reading it fails with ErrUploadTooLarge once it exceeds
+// MaxUploadBytes
+func LimitUpload(r io.Reader) io.Reader {
+	return &uploadLimitReader{r: r, remaining: MaxUploadBytes}
+}
+
+type uploadLimitReader struct {
+	r         io.Reader
+	remaining int64
+}
+
+func (l *uploadLimitReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	if l.remaining < 0 {
+		return n, ErrUploadTooLarge
+	}
+	return n, err
+}
+
+// DetectUploadContentType returns the content type sniffed from the first bytes of an upload with
+// http.DetectContentType. The part's declared Content-Type is never trusted, so a file can't pass
+// the allowlist by claiming to be a PDF or an image. The returned reader yields the whole upload,
+// sniffed bytes included.
+func DetectUploadContentType(r io.Reader) (string, io.Reader, error) {
+	buffered := bufio.NewReader(r)
+	head, err := buffered.Peek(512)
+	if err != nil && err != io.EOF {
+		return "", nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	mediaType, _, _ := mime.ParseMediaType(http.DetectContentType(head))
+	return mediaType, buffered, nil
+}
+
 // GetPresignedUploadURL generates a presigned URL for direct upload
 // Returns the presigned URL and the object key
 func (s *uploadService) GetPresignedUploadURL(ctx context.Context, userID string, filename string, contentType string) (string, string, error) {
@@ -148,25 +305,64 @@ func (s *uploadService) DeleteFile(ctx context.Context, key string) error {
 	return nil
 }
 
-// MockUploadService is a mock implementation for testing
+// DownloadFile downloads a file from S3 and returns its content and content type.
+// Files larger than maxSize bytes are rejected.
+func (s *uploadService) DownloadFile(ctx context.Context, key string, maxSize int64) ([]byte, string, error) {
+	output, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to download file: %w", err)
+	}
+	defer output.Body.Close()
+
+	content, err := io.ReadAll(io.LimitReader(output.Body, maxSize+1))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read file: %w", err)
+	}
+	if int64(len(content)) > maxSize {
+		return nil, "", fmt.Errorf("file exceeds the maximum size of %d bytes", maxSize)
+	}
+
+	return content, aws.ToString(output.ContentType), nil
+}
+
+// MockUploadService is a mock implementation for testing.
+// It is safe for concurrent use, since background jobs such as exports upload files too.
 type MockUploadService struct {
-	files map[string][]byte
+	mu           sync.Mutex
+	files        map[string][]byte
+	contentTypes map[string]string
 }
 
 // NewMockUploadService creates a mock upload service for testing
 func NewMockUploadService() UploadService {
 	return &MockUploadService{
-		files: make(map[string][]byte),
+		files:        make(map[string][]byte),
+		contentTypes: make(map[string]string),
 	}
 }
 
 func (m *MockUploadService) UploadFile(ctx context.Context, userID string, filename string, content []byte, contentType string) (string, error) {
 	ext := filepath.Ext(filename)
 	key := fmt.Sprintf("invoices/%s/%s%s", userID, uuid.New().String(), ext)
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	m.files[key] = content
+	m.contentTypes[key] = contentType
 	return key, nil
 }
 
+func (m *MockUploadService) UploadStream(ctx context.Context, userID string, filename string, body io.Reader, contentType string) (string, int64, error) {
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to read file: %w", err)
+	}
+	key, err := m.UploadFile(ctx, userID, filename, content, contentType)
+	return key, int64(len(content)), err
+}
+
 func (m *MockUploadService) GetPresignedUploadURL(ctx context.Context, userID string, filename string, contentType string) (string, string, error) {
 	ext := filepath.Ext(filename)
 	key := fmt.Sprintf("invoices/%s/%s%s", userID, uuid.New().String(), ext)
@@ -179,6 +375,22 @@ func (m *MockUploadService) GetPresignedDownloadURL(ctx context.Context, key str
 }
 
 func (m *MockUploadService) DeleteFile(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	delete(m.files, key)
+	delete(m.contentTypes, key)
 	return nil
 }
+
+func (m *MockUploadService) DownloadFile(ctx context.Context, key string, maxSize int64) ([]byte, string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	content, ok := m.files[key]
+	if !ok {
+		return nil, "", fmt.Errorf("failed to download file: %s not found", key)
+	}
+	if int64(len(content)) > maxSize {
+		return nil, "", fmt.Errorf("file exceeds the maximum size of %d bytes", maxSize)
+	}
+	return content, m.contentTypes[key], nil
+}
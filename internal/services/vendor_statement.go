@@ -0,0 +1,210 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"time"
+
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"gorm.io/gorm"
+)
+
+// VendorStatementEntry is one invoice on a vendor statement. Amount is in the invoice's currency;
+// Billed, Paid and Balance are in the user's base currency.
+type VendorStatementEntry struct {
+	InvoiceID     uint      `json:"invoice_id"`
+	InvoiceNumber string    `json:"invoice_number"`
+	Title         string    `json:"title"`
+	Date          time.Time `json:"date"`
+	Status        string    `json:"status"`
+	Currency      string    `json:"currency"`
+	Amount        float64   `json:"amount"`
+	// FXRateUsed is the invoice's average rate from Currency to the statement currency
+	FXRateUsed float64 `json:"fx_rate_used"`
+	Billed     float64 `json:"billed"`
+	Paid       float64 `json:"paid"`
+	// Balance is the running outstanding balance after this invoice
+	Balance float64 `json:"balance"`
+}
+
+// VendorStatement summarizes the invoices of one receiver between StartDate and EndDate, oldest
+// first (amounts in the user's base currency). OpeningBalance is what was still outstanding on
+// invoices dated before StartDate; ClosingBalance adds the period's Outstanding to it. Paid
+// invoices count as fully paid, and refunds and credit notes count as negative amounts.
+type VendorStatement struct {
+	ReceiverID     uint                   `json:"receiver_id"`
+	Name           string                 `json:"name"`
+	Email          string                 `json:"email,omitempty"`
+	Phone          string                 `json:"phone,omitempty"`
+	Address        string                 `json:"address,omitempty"`
+	BankAccount    string                 `json:"bank_account,omitempty"`
+	TaxID          string                 `json:"tax_id,omitempty"`
+	StartDate      time.Time              `json:"start_date"`
+	EndDate        time.Time              `json:"end_date"`
+	Currency       string                 `json:"currency"`
+	OpeningBalance float64                `json:"opening_balance"`
+	TotalBilled    float64                `json:"total_billed"`
+	TotalPaid      float64                `json:"total_paid"`
+	Outstanding    float64                `json:"outstanding"`
+	ClosingBalance float64                `json:"closing_balance"`
+	Invoices       []VendorStatementEntry `json:"invoices"`
+}
+
+// statementInvoiceRow is an invoice with its base-currency-normalized amount
+type statementInvoiceRow struct {
+	models.Invoice
+	Billed float64
+}
+
+// GenerateVendorStatement returns the statement of a receiver owned by the user between start and end
+func (s *analyticsService) GenerateVendorStatement(userID string, receiverID uint, start, end time.Time) (*VendorStatement, error) {
+	if end.Before(start) {
+		return nil, fmt.Errorf("end must not be before start")
+	}
+
+	var receiver models.InvoiceReceiver
+	if err := s.db.Where("id = ? AND user_id = ?", receiverID, userID).First(&receiver).Error; err != nil {
+		return nil, fmt.Errorf("receiver not found: %w", err)
+	}
+
+	baseCurrency, err := s.settingsService.GetBaseCurrency(userID)
+	if err != nil {
+		return nil, err
+	}
+	statement := &VendorStatement{
+		ReceiverID:  receiver.ID,
+		Name:        receiver.Name,
+		Email:       receiver.Email,
+		Phone:       receiver.Phone,
+		Address:     receiver.Address,
+		BankAccount: receiver.BankAccount,
+		TaxID:       receiver.TaxID,
+		StartDate:   start,
+		EndDate:     end,
+		Currency:    baseCurrency,
+		Invoices:    []VendorStatementEntry{},
+	}
+
+	dateColumn := DateFieldDefault.column("")
+	receiverInvoices := func() *gorm.DB {
+		return s.db.Model(&models.Invoice{}).Scopes(excludeDrafts).
+			Where("user_id = ? AND receiver_id = ? AND deleted_at IS NULL", userID, receiverID)
+	}
+
+	var opening []statementInvoiceRow
+	if err := receiverInvoices().
+		Select("*, COALESCE("+itemTargetAmountSubquery+", amount) as billed").
+		Where(dateColumn+" < ? AND status <> ?", start, models.InvoiceStatusPaid).
+		Scan(&opening).Error; err != nil {
+		return nil, fmt.Errorf("failed to load opening balance: %w", err)
+	}
+	for _, row := range opening {
+		statement.OpeningBalance += statementSign(&row.Invoice) * row.Billed
+	}
+
+	var rows []statementInvoiceRow
+	if err := receiverInvoices().
+		Select("*, COALESCE("+itemTargetAmountSubquery+", amount) as billed").
+		Where(dateColumn+" >= ? AND "+dateColumn+" <= ?", start, end).
+		Order(dateColumn + " ASC, id ASC").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to load invoices: %w", err)
+	}
+
+	balance := statement.OpeningBalance
+	for i := range rows {
+		invoice := &rows[i].Invoice
+		sign := statementSign(invoice)
+		billed := sign * rows[i].Billed
+		amount := sign * invoice.Amount
+		var paid float64
+		if invoice.Status == models.InvoiceStatusPaid {
+			paid = billed
+		}
+		balance += billed - paid
+
+		statement.TotalBilled += billed
+		statement.TotalPaid += paid
+		statement.Invoices = append(statement.Invoices, VendorStatementEntry{
+			InvoiceID:     invoice.ID,
+			InvoiceNumber: invoice.DisplayNumber(),
+			Title:         invoice.Title,
+			Date:          *invoiceEffectiveDate(invoice),
+			Status:        string(invoice.Status),
+			Currency:      invoice.Currency,
+			Amount:        amount,
+			FXRateUsed:    invoice.FXRateUsed,
+			Billed:        billed,
+			Paid:          paid,
+			Balance:       balance,
+		})
+	}
+	statement.Outstanding = statement.TotalBilled - statement.TotalPaid
+	statement.ClosingBalance = balance
+
+	return statement, nil
+}
+
+// statementSign is -1 for invoices that give money back (refunds and credit notes), 1 otherwise
+func statementSign(invoice *models.Invoice) float64 {
+	if invoice.RelatedInvoiceID != nil && invoice.RelationType.Offsets() {
+		return -1
+	}
+	return 1
+}
+
+// vendorStatementTemplate lays out a vendor statement for PDF rendering
+var vendorStatementTemplate = template.Must(template.New("statement").Funcs(template.FuncMap{
+	"date":  func(t time.Time) string { return t.Format("2006-01-02") },
+	"money": func(amount float64) string { return fmt.Sprintf("%.2f", amount) },
+	"rate":  func(rate float64) string { return fmt.Sprintf("%.4g", rate) },
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="UTF-8">
+<style>
+body { font-family: 'Noto Sans CJK SC', Arial, sans-serif; font-size: 12px; }
+h1 { font-size: 20px; margin-bottom: 4px; }
+table { width: 100%; border-collapse: collapse; margin-top: 16px; }
+th, td { padding: 4px 6px; border-bottom: 1px solid #ddd; text-align: left; }
+.num { text-align: right; }
+.from { white-space: pre-line; color: #444; }
+</style>
+</head>
+<body>
+<h1>Statement: {{.Name}}</h1>
+<p>{{date .StartDate}} to {{date .EndDate}} (amounts in {{.Currency}})</p>
+{{if or .Address .Email .Phone .TaxID .BankAccount}}<p class="from"><strong>Bill from: {{.Name}}</strong>
+{{with .Address}}{{.}}
+{{end}}{{with .Email}}Email: {{.}}
+{{end}}{{with .Phone}}Phone: {{.}}
+{{end}}{{with .TaxID}}Tax ID: {{.}}
+{{end}}{{with .BankAccount}}Bank account: {{.}}{{end}}</p>
+{{end}}<table>
+<tr><td>Opening balance</td><td class="num">{{money .OpeningBalance}}</td></tr>
+<tr><td>Total billed</td><td class="num">{{money .TotalBilled}}</td></tr>
+<tr><td>Total paid</td><td class="num">{{money .TotalPaid}}</td></tr>
+<tr><td>Outstanding</td><td class="num">{{money .Outstanding}}</td></tr>
+<tr><th>Closing balance</th><th class="num">{{money .ClosingBalance}}</th></tr>
+</table>
+<table>
+<thead>
+<tr><th>Date</th><th>Invoice</th><th>Title</th><th>Status</th><th class="num">Amount</th><th class="num">Billed</th><th class="num">Paid</th><th class="num">Balance</th></tr>
+</thead>
+<tbody>
+{{range .Invoices}}<tr><td>{{date .Date}}</td><td>{{.InvoiceNumber}}</td><td>{{.Title}}</td><td>{{.Status}}</td><td class="num">{{money .Amount}} {{.Currency}}{{if and (ne .Currency $.Currency) .FXRateUsed}}<br><small>avg rate {{rate .FXRateUsed}}</small>{{end}}</td><td class="num">{{money .Billed}}</td><td class="num">{{money .Paid}}</td><td class="num">{{money .Balance}}</td></tr>
+{{else}}<tr><td colspan="8">No invoices in this period</td></tr>
+{{end}}</tbody>
+</table>
+</body>
+</html>`))
+
+// renderVendorStatementHTML renders a statement as an HTML document
+func renderVendorStatementHTML(statement *VendorStatement) (string, error) {
+	var buf bytes.Buffer
+	if err := vendorStatementTemplate.Execute(&buf, statement); err != nil {
+		return "", fmt.Errorf("failed to render statement: %w", err)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,122 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
+)
+
+// CreateBudgetTool handles budget creation
+type CreateBudgetTool struct {
+	service services.BudgetService
+}
+
+func NewCreateBudgetTool(service services.BudgetService) *CreateBudgetTool {
+	return &CreateBudgetTool{service: service}
+}
+
+func (t *CreateBudgetTool) GetTool() mcp.Tool {
+	return mcp.NewTool("create_budget",
+		mcp.WithDescription("Set a recurring spending budget for a category"),
+		mcp.WithNumber("category_id", mcp.Required(), mcp.Description("Category ID the budget applies to")),
+		mcp.WithNumber("amount", mcp.Required(), mcp.Description("Budget amount per period")),
+		mcp.WithString("period_type", mcp.Description("Budget period: 'monthly', 'quarterly', or 'yearly'. Default: 'monthly'")),
+		mcp.WithString("currency", mcp.Description("Currency code of the budget amount (default: the user's base currency)")),
+	)
+}
+
+func (t *CreateBudgetTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		categoryID, err := getUintArg(args, "category_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if categoryID == 0 {
+			return mcp.NewToolResultError("category_id is required"), nil
+		}
+
+		amount, err := getFloatArg(args, "amount", 0)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		budget := &models.Budget{
+			CategoryID: categoryID,
+			Amount:     amount,
+			PeriodType: models.BudgetPeriod(getStringArg(args, "period_type")),
+			Currency:   getStringArg(args, "currency"),
+		}
+
+		if err := t.service.CreateBudget(userID, budget); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create budget: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(budget)
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
+// CheckBudgetsTool evaluates budgets against current spending
+type CheckBudgetsTool struct {
+	service services.BudgetService
+}
+
+func NewCheckBudgetsTool(service services.BudgetService) *CheckBudgetsTool {
+	return &CheckBudgetsTool{service: service}
+}
+
+func (t *CheckBudgetsTool) GetTool() mcp.Tool {
+	return mcp.NewTool("check_budgets",
+		mcp.WithDescription(`Compare every category budget to spending in its current period.
+Returns spent, remaining, and an over_budget flag for each budget.
+
+EXAMPLE QUERIES:
+- "Am I over budget this month?" → check_budgets()
+- "How much travel budget was left at the end of March?" → check_budgets(as_of: "2024-03-31T23:59:59Z")`),
+		mcp.WithString("as_of", mcp.Description("Evaluate budgets for the period containing this time (ISO 8601, default: now)")),
+	)
+}
+
+func (t *CheckBudgetsTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		asOf := time.Now()
+		if parsed := parseTimeArg(args, "as_of"); parsed != nil {
+			asOf = *parsed
+		}
+
+		statuses, err := t.service.EvaluateBudgets(userID, asOf)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to check budgets: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(map[string]interface{}{
+			"budgets": statuses,
+		})
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
@@ -3,7 +3,11 @@ package tools
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -35,6 +39,8 @@ func (t *CreateCategoryTool) GetTool() mcp.Tool {
 		mcp.WithString("name", mcp.Required(), mcp.Description("Category name"), mcp.MaxLength(100), mcp.Required()),
 		mcp.WithString("description", mcp.Description("Category description"), mcp.Required()),
 		mcp.WithString("color", mcp.Description("Hex color code (e.g., #FF5733). Please use different colors for different categories."), mcp.Required()),
+		mcp.WithString("default_currency", mcp.Description("Currency code used for new invoices in this category that don't specify a currency (e.g., EUR)")),
+		mcp.WithNumber("default_tax_rate", mcp.Description("Tax rate as a percentage (e.g., 20 for 20% VAT) used for new invoices in this category that don't specify one")),
 	)
 }
 
@@ -44,16 +50,25 @@ func (t *CreateCategoryTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
 		name, _ := args["name"].(string)
 		description, _ := args["description"].(string)
 		color, _ := args["color"].(string)
+		defaultTaxRate, err := getFloatArg(args, "default_tax_rate", 0)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 
 		category := &models.InvoiceCategory{
-			Name:        name,
-			Description: description,
-			Color:       color,
+			Name:            name,
+			Description:     description,
+			Color:           color,
+			DefaultCurrency: getStringArg(args, "default_currency"),
+			DefaultTaxRate:  defaultTaxRate,
 		}
 
 		if err := t.service.CreateCategory(userID, category); err != nil {
@@ -89,11 +104,16 @@ func (t *ListCategoriesTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
 		keyword, _ := args["keyword"].(string)
-		limit := getIntArg(args, "limit", 50)
-		offset := getIntArg(args, "offset", 0)
+		limit, offset, err := getPaginationArgs(args, 50)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 
 		categories, total, err := t.service.ListCategories(userID, keyword, limit, offset)
 		if err != nil {
@@ -132,9 +152,15 @@ func (t *GetCategoryTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
-		categoryID := getUintArg(args, "category_id")
+		categoryID, err := getUintArg(args, "category_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if categoryID == 0 {
 			return mcp.NewToolResultError("category_id is required"), nil
 		}
@@ -165,6 +191,8 @@ func (t *UpdateCategoryTool) GetTool() mcp.Tool {
 		mcp.WithString("name", mcp.Description("Category name")),
 		mcp.WithString("description", mcp.Description("Category description")),
 		mcp.WithString("color", mcp.Description("Hex color code")),
+		mcp.WithString("default_currency", mcp.Description("Currency code for new invoices in this category that don't specify one (omit to keep the current value, empty string to clear)")),
+		mcp.WithNumber("default_tax_rate", mcp.Description("Tax rate as a percentage for new invoices in this category that don't specify one (omit to keep the current value, 0 to clear)")),
 	)
 }
 
@@ -174,9 +202,15 @@ func (t *UpdateCategoryTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
-		categoryID := getUintArg(args, "category_id")
+		categoryID, err := getUintArg(args, "category_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if categoryID == 0 {
 			return mcp.NewToolResultError("category_id is required"), nil
 		}
@@ -191,6 +225,16 @@ func (t *UpdateCategoryTool) GetHandler() server.ToolHandlerFunc {
 			Description: description,
 			Color:       color,
 		}
+		if existing, err := t.service.GetCategoryByID(userID, categoryID); err == nil {
+			category.DefaultCurrency = existing.DefaultCurrency
+			category.DefaultTaxRate = existing.DefaultTaxRate
+		}
+		if defaultCurrency, ok := args["default_currency"].(string); ok {
+			category.DefaultCurrency = defaultCurrency
+		}
+		if category.DefaultTaxRate, err = getFloatArg(args, "default_tax_rate", category.DefaultTaxRate); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 
 		if err := t.service.UpdateCategory(userID, category); err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to update category: %v", err)), nil
@@ -213,8 +257,9 @@ func NewDeleteCategoryTool(service services.CategoryService) *DeleteCategoryTool
 
 func (t *DeleteCategoryTool) GetTool() mcp.Tool {
 	return mcp.NewTool("delete_category",
-		mcp.WithDescription("Delete a category"),
+		mcp.WithDescription("Delete a category. Fails with the number of affected invoices while invoices or invoice items are in it; pass force to remove the category from them and delete it anyway"),
 		mcp.WithNumber("category_id", mcp.Required(), mcp.Description("Category ID")),
+		mcp.WithBoolean("force", mcp.Description("Remove the category from the invoices referencing it instead of failing (default: false)")),
 	)
 }
 
@@ -224,14 +269,20 @@ func (t *DeleteCategoryTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
-		categoryID := getUintArg(args, "category_id")
+		categoryID, err := getUintArg(args, "category_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if categoryID == 0 {
 			return mcp.NewToolResultError("category_id is required"), nil
 		}
 
-		if err := t.service.DeleteCategory(userID, categoryID); err != nil {
+		if err := t.service.DeleteCategory(userID, categoryID, getBoolArg(args, "force", false)); err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to delete category: %v", err)), nil
 		}
 
@@ -248,16 +299,178 @@ func getUserIDFromContext(ctx context.Context) string {
 	return user.Sub
 }
 
-func getIntArg(args map[string]interface{}, key string, defaultVal int) int {
-	if v, ok := args[key].(float64); ok {
-		return int(v)
+// requireScope returns an error result when the authenticated user lacks the given scope,
+// or nil when the tool may proceed (see utils.UserHasScope)
+func requireScope(ctx context.Context, scope string) *mcp.CallToolResult {
+	user, _ := utils.GetAuthenticatedUser(ctx)
+	if !utils.UserHasScope(user, scope) {
+		return mcp.NewToolResultError(fmt.Sprintf("Missing required scope: %s", scope))
 	}
-	return defaultVal
+	return nil
 }
 
-func getUintArg(args map[string]interface{}, key string) uint {
-	if v, ok := args[key].(float64); ok {
-		return uint(v)
+// ArgError reports a tool argument that was given but is not a valid value of the expected kind.
+// Tools return it to the client instead of ignoring the argument.
+type ArgError struct {
+	Key   string
+	Value interface{}
+	Want  string // e.g. "a number" or "a non-negative integer"
+}
+
+func (e *ArgError) Error() string {
+	return fmt.Sprintf("Invalid %s '%v': must be %s", e.Key, e.Value, e.Want)
+}
+
+// getNumberArg reads a numeric argument sent as a JSON number or a numeric string. ok is false
+// when the argument is missing, null, or an empty string.
+func getNumberArg(args map[string]interface{}, key string) (value float64, ok bool, err error) {
+	switch v := args[key].(type) {
+	case nil:
+		return 0, false, nil
+	case float64:
+		value = v
+	case float32:
+		value = float64(v)
+	case int:
+		value = float64(v)
+	case int64:
+		value = float64(v)
+	case uint:
+		value = float64(v)
+	case json.Number:
+		value, err = v.Float64()
+	case string:
+		trimmed := strings.TrimSpace(v)
+		if trimmed == "" {
+			return 0, false, nil
+		}
+		value, err = strconv.ParseFloat(trimmed, 64)
+	default:
+		err = errors.New("unsupported type")
+	}
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, false, &ArgError{Key: key, Value: args[key], Want: "a number"}
+	}
+	return value, true, nil
+}
+
+// getWholeNumberArg reads a numeric argument that must be a whole number of at least minValue
+func getWholeNumberArg(args map[string]interface{}, key string, minValue float64, want string) (float64, bool, error) {
+	value, ok, err := getNumberArg(args, key)
+	if !ok && err == nil {
+		return 0, false, nil
+	}
+	if err != nil || value != math.Trunc(value) || value < minValue || value > math.MaxInt64 {
+		return 0, false, &ArgError{Key: key, Value: args[key], Want: want}
+	}
+	return value, true, nil
+}
+
+func getIntArg(args map[string]interface{}, key string, defaultVal int) (int, error) {
+	value, ok, err := getWholeNumberArg(args, key, math.MinInt64, "an integer")
+	if err != nil || !ok {
+		return defaultVal, err
+	}
+	return int(value), nil
+}
+
+// getUintArrayArg reads an array of IDs, skipping zeros
+func getUintArrayArg(args map[string]interface{}, key string) ([]uint, error) {
+	values, _ := args[key].([]interface{})
+	ids := make([]uint, 0, len(values))
+	for _, value := range values {
+		id, err := getUintArg(map[string]interface{}{key: value}, key)
+		if err != nil {
+			return nil, err
+		}
+		if id > 0 {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// getPaginationArgs reads the limit and offset arguments
+func getPaginationArgs(args map[string]interface{}, defaultLimit int) (int, int, error) {
+	limit, err := getIntArg(args, "limit", defaultLimit)
+	if err != nil {
+		return 0, 0, err
+	}
+	offset, err := getIntArg(args, "offset", 0)
+	return limit, offset, err
+}
+
+// getUintArg reads an ID argument; a missing argument is 0
+func getUintArg(args map[string]interface{}, key string) (uint, error) {
+	value, _, err := getWholeNumberArg(args, key, 0, "a non-negative integer")
+	return uint(value), err
+}
+
+// MergeCategoriesTool handles merging multiple categories into one
+type MergeCategoriesTool struct {
+	service services.CategoryService
+}
+
+func NewMergeCategoriesTool(service services.CategoryService) *MergeCategoriesTool {
+	return &MergeCategoriesTool{service: service}
+}
+
+func (t *MergeCategoriesTool) GetTool() mcp.Tool {
+	return mcp.NewTool("merge_categories",
+		mcp.WithDescription("Merge multiple categories into one. All invoices and budgets from source categories will be moved to the target category. Use dry_run to preview the merge first."),
+		mcp.WithNumber("target_id", mcp.Required(), mcp.Description("ID of the category to keep")),
+		mcp.WithArray("source_ids", mcp.Required(), mcp.Description("IDs of categories to merge into target (will be deleted)"), mcp.Items(map[string]any{"type": "number"})),
+		mcp.WithBoolean("dry_run", mcp.Description("Preview the merge without changing anything: returns the same result, including the source categories and the invoices and budgets that would be moved (default: false)")),
+	)
+}
+
+func (t *MergeCategoriesTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		targetID, err := getUintArg(args, "target_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if targetID == 0 {
+			return mcp.NewToolResultError("target_id is required"), nil
+		}
+
+		// Get source IDs from array
+		sourceIDsRaw, ok := args["source_ids"].([]interface{})
+		if !ok || len(sourceIDsRaw) == 0 {
+			return mcp.NewToolResultError("source_ids is required and must be a non-empty array"), nil
+		}
+
+		sourceIDs, err := getUintArrayArg(args, "source_ids")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		if len(sourceIDs) == 0 {
+			return mcp.NewToolResultError("source_ids must contain valid IDs"), nil
+		}
+
+		merge, err := t.service.MergeCategories(userID, targetID, sourceIDs, getBoolArg(args, "dry_run", false))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to merge categories: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(map[string]interface{}{
+			"category":          merge.Target,
+			"sources":           merge.Sources,
+			"merged_count":      len(merge.Sources),
+			"invoices_affected": merge.InvoicesAffected,
+			"budgets_moved":     merge.BudgetsMoved,
+			"dry_run":           merge.DryRun,
+		})
+		return mcp.NewToolResultText(string(result)), nil
 	}
-	return 0
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/rxtech-lab/invoice-management/internal/models"
 	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
 )
 
 // CreateCompanyTool handles company creation
@@ -39,6 +40,9 @@ func (t *CreateCompanyTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
 		name, _ := args["name"].(string)
@@ -92,11 +96,16 @@ func (t *ListCompaniesTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
 		keyword, _ := args["keyword"].(string)
-		limit := getIntArg(args, "limit", 50)
-		offset := getIntArg(args, "offset", 0)
+		limit, offset, err := getPaginationArgs(args, 50)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 
 		companies, total, err := t.service.ListCompanies(userID, keyword, limit, offset)
 		if err != nil {
@@ -135,9 +144,15 @@ func (t *GetCompanyTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
-		companyID := getUintArg(args, "company_id")
+		companyID, err := getUintArg(args, "company_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if companyID == 0 {
 			return mcp.NewToolResultError("company_id is required"), nil
 		}
@@ -181,9 +196,15 @@ func (t *UpdateCompanyTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
-		companyID := getUintArg(args, "company_id")
+		companyID, err := getUintArg(args, "company_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if companyID == 0 {
 			return mcp.NewToolResultError("company_id is required"), nil
 		}
@@ -228,8 +249,9 @@ func NewDeleteCompanyTool(service services.CompanyService) *DeleteCompanyTool {
 
 func (t *DeleteCompanyTool) GetTool() mcp.Tool {
 	return mcp.NewTool("delete_company",
-		mcp.WithDescription("Delete a company"),
+		mcp.WithDescription("Delete a company. Fails with the number of affected invoices while invoices reference it; pass force to remove the company from them and delete it anyway"),
 		mcp.WithNumber("company_id", mcp.Required(), mcp.Description("Company ID")),
+		mcp.WithBoolean("force", mcp.Description("Remove the company from the invoices referencing it instead of failing (default: false)")),
 	)
 }
 
@@ -239,17 +261,91 @@ func (t *DeleteCompanyTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
-		companyID := getUintArg(args, "company_id")
+		companyID, err := getUintArg(args, "company_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if companyID == 0 {
 			return mcp.NewToolResultError("company_id is required"), nil
 		}
 
-		if err := t.service.DeleteCompany(userID, companyID); err != nil {
+		if err := t.service.DeleteCompany(userID, companyID, getBoolArg(args, "force", false)); err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to delete company: %v", err)), nil
 		}
 
 		return mcp.NewToolResultText(`{"success": true, "message": "Company deleted"}`), nil
 	}
 }
+
+// MergeCompaniesTool handles merging multiple companies into one
+type MergeCompaniesTool struct {
+	service services.CompanyService
+}
+
+func NewMergeCompaniesTool(service services.CompanyService) *MergeCompaniesTool {
+	return &MergeCompaniesTool{service: service}
+}
+
+func (t *MergeCompaniesTool) GetTool() mcp.Tool {
+	return mcp.NewTool("merge_companies",
+		mcp.WithDescription("Merge multiple companies into one. All invoices from source companies will be moved to the target company. Use dry_run to preview the merge first."),
+		mcp.WithNumber("target_id", mcp.Required(), mcp.Description("ID of the company to keep")),
+		mcp.WithArray("source_ids", mcp.Required(), mcp.Description("IDs of companies to merge into target (will be deleted)"), mcp.Items(map[string]any{"type": "number"})),
+		mcp.WithBoolean("dry_run", mcp.Description("Preview the merge without changing anything: returns the same result, including the source companies and the invoices that would be moved (default: false)")),
+	)
+}
+
+func (t *MergeCompaniesTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		targetID, err := getUintArg(args, "target_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if targetID == 0 {
+			return mcp.NewToolResultError("target_id is required"), nil
+		}
+
+		// Get source IDs from array
+		sourceIDsRaw, ok := args["source_ids"].([]interface{})
+		if !ok || len(sourceIDsRaw) == 0 {
+			return mcp.NewToolResultError("source_ids is required and must be a non-empty array"), nil
+		}
+
+		sourceIDs, err := getUintArrayArg(args, "source_ids")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		if len(sourceIDs) == 0 {
+			return mcp.NewToolResultError("source_ids must contain valid IDs"), nil
+		}
+
+		merge, err := t.service.MergeCompanies(userID, targetID, sourceIDs, getBoolArg(args, "dry_run", false))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to merge companies: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(map[string]interface{}{
+			"company":           merge.Target,
+			"sources":           merge.Sources,
+			"merged_count":      len(merge.Sources),
+			"invoices_affected": merge.InvoicesAffected,
+			"dry_run":           merge.DryRun,
+		})
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/rxtech-lab/invoice-management/internal/models"
 	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
 )
 
 // AddInvoiceItemTool handles adding items to invoices
@@ -26,7 +27,12 @@ func (t *AddInvoiceItemTool) GetTool() mcp.Tool {
 		mcp.WithNumber("invoice_id", mcp.Required(), mcp.Description("Invoice ID")),
 		mcp.WithString("description", mcp.Required(), mcp.Description("Item description")),
 		mcp.WithNumber("quantity", mcp.Description("Quantity (default 1)")),
+		mcp.WithString("unit", mcp.Description("Unit of measure of the quantity (e.g., hour, kg, pcs)")),
 		mcp.WithNumber("unit_price", mcp.Description("Unit price")),
+		mcp.WithString("currency", mcp.Description("Currency of the item if it differs from the invoice currency (e.g., HKD for a surcharge on a USD invoice)")),
+		mcp.WithNumber("category_id", mcp.Description("Category of the item if it differs from the invoice category (e.g., water on a combined utility bill)")),
+		mcp.WithString("discount_type", mcp.Description("Item discount type: percent or fixed")),
+		mcp.WithNumber("discount_value", mcp.Description("Item discount: a percentage (0-100) or an amount in the item currency, depending on discount_type")),
 	)
 }
 
@@ -36,9 +42,15 @@ func (t *AddInvoiceItemTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
-		invoiceID := getUintArg(args, "invoice_id")
+		invoiceID, err := getUintArg(args, "invoice_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if invoiceID == 0 {
 			return mcp.NewToolResultError("invoice_id is required"), nil
 		}
@@ -48,13 +60,34 @@ func (t *AddInvoiceItemTool) GetHandler() server.ToolHandlerFunc {
 			return mcp.NewToolResultError("description is required"), nil
 		}
 
-		quantity := getFloatArg(args, "quantity", 1)
-		unitPrice := getFloatArg(args, "unit_price", 0)
+		unitPrice, err := getFloatArg(args, "unit_price", 0)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		quantity, err := getFloatPtrArg(args, "quantity")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		categoryID, err := getUintPtrArg(args, "category_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		discountType, discountValue, err := getDiscountArgs(args, "", 0)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		currency, _ := args["currency"].(string)
 
 		item := &models.InvoiceItem{
-			Description: description,
-			Quantity:    quantity,
-			UnitPrice:   unitPrice,
+			Description:   description,
+			Quantity:      models.ItemQuantity(quantity, unitPrice),
+			Unit:          getStringArg(args, "unit"),
+			UnitPrice:     unitPrice,
+			Currency:      currency,
+			CategoryID:    categoryID,
+			DiscountType:  discountType,
+			DiscountValue: discountValue,
 		}
 
 		if err := t.service.AddInvoiceItem(userID, invoiceID, item); err != nil {
@@ -66,6 +99,89 @@ func (t *AddInvoiceItemTool) GetHandler() server.ToolHandlerFunc {
 	}
 }
 
+// AddInvoiceItemsTool handles adding several items to an invoice at once
+type AddInvoiceItemsTool struct {
+	service services.InvoiceService
+}
+
+func NewAddInvoiceItemsTool(service services.InvoiceService) *AddInvoiceItemsTool {
+	return &AddInvoiceItemsTool{service: service}
+}
+
+func (t *AddInvoiceItemsTool) GetTool() mcp.Tool {
+	return mcp.NewTool("add_invoice_items",
+		mcp.WithDescription(`Add several items to an invoice at once, recomputing the invoice total a single time.
+Prefer this over repeated add_invoice_item calls. If any item is invalid, none are added.
+Returns the created items with their IDs and target amounts.`),
+		mcp.WithNumber("invoice_id", mcp.Required(), mcp.Description("Invoice ID")),
+		mcp.WithArray("items", mcp.Required(), mcp.Description("Items to add, in the same format as create_invoice items"),
+			mcp.Items(map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"description":    map[string]any{"type": "string"},
+					"quantity":       map[string]any{"type": "number"},
+					"unit":           map[string]any{"type": "string"},
+					"unit_price":     map[string]any{"type": "number"},
+					"currency":       map[string]any{"type": "string"},
+					"category_id":    map[string]any{"type": "number"},
+					"discount_type":  map[string]any{"type": "string", "enum": []string{"percent", "fixed"}},
+					"discount_value": map[string]any{"type": "number"},
+				},
+				"required": []string{"description", "unit_price"},
+			})),
+	)
+}
+
+func (t *AddInvoiceItemsTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		invoiceID, err := getUintArg(args, "invoice_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if invoiceID == 0 {
+			return mcp.NewToolResultError("invoice_id is required"), nil
+		}
+
+		itemsRaw, _ := args["items"].([]interface{})
+		var items []models.InvoiceItem
+		for i, itemRaw := range itemsRaw {
+			itemMap, ok := itemRaw.(map[string]interface{})
+			if !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("item %d must be an object", i+1)), nil
+			}
+			item, err := getItemArg(itemMap)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("item %d: %v", i+1, err)), nil
+			}
+			if item.Description == "" {
+				return mcp.NewToolResultError(fmt.Sprintf("item %d: description is required", i+1)), nil
+			}
+			items = append(items, item)
+		}
+		if len(items) == 0 {
+			return mcp.NewToolResultError("items is required"), nil
+		}
+
+		if err := t.service.AddInvoiceItems(userID, invoiceID, items); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to add items: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(map[string]interface{}{
+			"items": items,
+		})
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
 // UpdateInvoiceItemTool handles updating invoice items
 type UpdateInvoiceItemTool struct {
 	service services.InvoiceService
@@ -81,8 +197,13 @@ func (t *UpdateInvoiceItemTool) GetTool() mcp.Tool {
 		mcp.WithNumber("item_id", mcp.Required(), mcp.Description("Item ID")),
 		mcp.WithString("description", mcp.Description("Item description")),
 		mcp.WithNumber("quantity", mcp.Description("Quantity")),
+		mcp.WithString("unit", mcp.Description("Unit of measure of the quantity (omit to keep the current one, empty string to clear it)")),
 		mcp.WithNumber("unit_price", mcp.Description("Unit price")),
-		mcp.WithNumber("target_amount", mcp.Description("Manual override for USD amount (optional, auto-calculated if not provided)")),
+		mcp.WithString("currency", mcp.Description("Currency of the item (omit to keep the current one, empty string to use the invoice currency)")),
+		mcp.WithNumber("category_id", mcp.Description("Category of the item (omit to keep the current one, 0 to use the invoice category)")),
+		mcp.WithString("discount_type", mcp.Description("Item discount type: percent or fixed (omit to keep the current discount, empty string to remove it)")),
+		mcp.WithNumber("discount_value", mcp.Description("Item discount: a percentage (0-100) or an amount in the item currency (omit to keep the current value)")),
+		mcp.WithNumber("target_amount", mcp.Description("Manual override for the base currency amount (optional, auto-calculated if not provided)")),
 	)
 }
 
@@ -92,27 +213,64 @@ func (t *UpdateInvoiceItemTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
-		itemID := getUintArg(args, "item_id")
+		itemID, err := getUintArg(args, "item_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if itemID == 0 {
 			return mcp.NewToolResultError("item_id is required"), nil
 		}
 
 		description, _ := args["description"].(string)
-		quantity := getFloatArg(args, "quantity", 0)
-		unitPrice := getFloatArg(args, "unit_price", 0)
+		quantity, err := getFloatArg(args, "quantity", 0)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		unitPrice, err := getFloatArg(args, "unit_price", 0)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 
 		// Handle optional target_amount override
-		var targetAmountOverride *float64
-		if targetAmount, ok := args["target_amount"].(float64); ok {
-			targetAmountOverride = &targetAmount
+		targetAmountOverride, err := getFloatPtrArg(args, "target_amount")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		existing, err := t.service.GetInvoiceItem(userID, itemID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to update item: %v", err)), nil
+		}
+		currency := existing.Currency
+		if value, ok := args["currency"].(string); ok {
+			currency = value
+		}
+		unit := existing.Unit
+		if value, ok := args["unit"].(string); ok {
+			unit = value
+		}
+		categoryID := existing.CategoryID
+		if _, ok := args["category_id"]; ok {
+			if categoryID, err = getUintPtrArg(args, "category_id"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 		}
 
 		item := &models.InvoiceItem{
 			Description: description,
 			Quantity:    quantity,
+			Unit:        unit,
 			UnitPrice:   unitPrice,
+			Currency:    currency,
+			CategoryID:  categoryID,
+		}
+		if item.DiscountType, item.DiscountValue, err = getDiscountArgs(args, existing.DiscountType, existing.DiscountValue); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
 		}
 
 		if err := t.service.UpdateInvoiceItem(userID, itemID, item, targetAmountOverride, false); err != nil {
@@ -147,9 +305,15 @@ func (t *DeleteInvoiceItemTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
-		itemID := getUintArg(args, "item_id")
+		itemID, err := getUintArg(args, "item_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if itemID == 0 {
 			return mcp.NewToolResultError("item_id is required"), nil
 		}
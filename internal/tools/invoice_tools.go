@@ -3,13 +3,16 @@ package tools
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/rxtech-lab/invoice-management/internal/models"
 	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
 )
 
 // CreateInvoiceTool handles invoice creation
@@ -27,7 +30,7 @@ func (t *CreateInvoiceTool) GetTool() mcp.Tool {
 		mcp.WithString("title", mcp.Required(), mcp.Description("Invoice title")),
 		mcp.WithString("description", mcp.Description("Invoice description")),
 		mcp.WithNumber("receiver_id", mcp.Description("Receiver ID")),
-		mcp.WithString("currency", mcp.Description("Currency code (default: USD)")),
+		mcp.WithString("currency", mcp.Description("Currency code (default: the category's default currency, else USD)")),
 		mcp.WithNumber("category_id", mcp.Description("Category ID")),
 		mcp.WithNumber("company_id", mcp.Description("Company ID")),
 		mcp.WithString("invoice_started_at", mcp.Description("Billing cycle start (RFC3339)")),
@@ -35,17 +38,28 @@ func (t *CreateInvoiceTool) GetTool() mcp.Tool {
 		mcp.WithString("original_download_link", mcp.Description("Link to original invoice file")),
 		mcp.WithString("status", mcp.Description("Status: paid, unpaid, overdue (default: paid). Please justify the status base on the pdf file and the invoice items.")),
 		mcp.WithString("due_date", mcp.Description("Due date (RFC3339)")),
-		mcp.WithArray("items", mcp.Description("Invoice items array. Each item should have: description (string, required), quantity (number, default 1), unit_price (number, required). Example: [{\"description\": \"Service\", \"quantity\": 1, \"unit_price\": 100}]"),
+		mcp.WithString("payment_method", mcp.Description("Card or account the invoice was paid with (e.g. 'Amex Gold'). Reuse a name from list_payment_methods where one fits")),
+		mcp.WithString("discount_type", mcp.Description("Invoice discount type: percent or fixed. Applied after summing the items, which can have their own discounts")),
+		mcp.WithNumber("discount_value", mcp.Description("Invoice discount: a percentage (0-100) or an amount in the invoice currency, depending on discount_type")),
+		mcp.WithNumber("tax_rate", mcp.Description("Tax percentage the invoice was charged at, e.g. 20 for 20% VAT (default: the category's default tax rate; 0 overrides it). Recorded for reference, amounts are not changed by it")),
+		mcp.WithBoolean("is_draft", mcp.Description("Create a placeholder draft, left out of statistics and list_invoices until finalize_invoice is called (default: false). Drafts are only checked for duplicates against other drafts")),
+		mcp.WithArray("items", mcp.Description("Invoice items array. Each item should have: description (string, required), quantity (number, default 1), unit (string, optional, e.g. hour or kg), unit_price (number, required), currency (string, optional, defaults to the invoice currency), category_id (number, optional, for invoices split across categories, defaults to the invoice category), discount_type (string, optional, percent or fixed) and discount_value (number, optional). Example: [{\"description\": \"Service\", \"quantity\": 1, \"unit_price\": 100}]"),
 			mcp.Items(map[string]any{
 				"type": "object",
 				"properties": map[string]any{
-					"description": map[string]any{"type": "string"},
-					"quantity":    map[string]any{"type": "number"},
-					"unit_price":  map[string]any{"type": "number"},
+					"description":    map[string]any{"type": "string"},
+					"quantity":       map[string]any{"type": "number"},
+					"unit":           map[string]any{"type": "string"},
+					"unit_price":     map[string]any{"type": "number"},
+					"currency":       map[string]any{"type": "string"},
+					"category_id":    map[string]any{"type": "number"},
+					"discount_type":  map[string]any{"type": "string", "enum": []string{"percent", "fixed"}},
+					"discount_value": map[string]any{"type": "number"},
 				},
 				"required": []string{"description", "unit_price"},
 			})),
 		mcp.WithArray("tags", mcp.Description("Tag names to assign to the invoice (e.g., ['travel', 'business', 'Q1-2024']). Tags will be created if they don't exist."), mcp.Items(map[string]any{"type": "string"})),
+		mcp.WithNumber("expected_amount", mcp.Description("Total printed on the source document, in the invoice currency. The invoice is still created when the items don't add up to it, with a warning in warnings giving both amounts: check the items against the document")),
 	)
 }
 
@@ -55,18 +69,27 @@ func (t *CreateInvoiceTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
 		title, _ := args["title"].(string)
 		description, _ := args["description"].(string)
 		currency, _ := args["currency"].(string)
-		if currency == "" {
-			currency = "USD"
-		}
 
-		categoryID := getUintPtrArg(args, "category_id")
-		companyID := getUintPtrArg(args, "company_id")
-		receiverID := getUintPtrArg(args, "receiver_id")
+		categoryID, err := getUintPtrArg(args, "category_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		companyID, err := getUintPtrArg(args, "company_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		receiverID, err := getUintPtrArg(args, "receiver_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		originalDownloadLink, _ := args["original_download_link"].(string)
 
 		statusStr, _ := args["status"].(string)
@@ -78,6 +101,14 @@ func (t *CreateInvoiceTool) GetHandler() server.ToolHandlerFunc {
 		invoiceStartedAt := parseTimeArg(args, "invoice_started_at")
 		invoiceEndedAt := parseTimeArg(args, "invoice_ended_at")
 		dueDate := parseTimeArg(args, "due_date")
+		discountType, discountValue, err := getDiscountArgs(args, "", 0)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		taxRate, err := getFloatPtrArg(args, "tax_rate")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 
 		// Create invoice with items - amount is calculated from items
 		invoice := &models.Invoice{
@@ -92,30 +123,44 @@ func (t *CreateInvoiceTool) GetHandler() server.ToolHandlerFunc {
 			OriginalDownloadLink: originalDownloadLink,
 			Status:               status,
 			DueDate:              dueDate,
+			DiscountType:         discountType,
+			DiscountValue:        discountValue,
+			TaxRate:              taxRate,
+			PaymentMethod:        getStringArg(args, "payment_method"),
+			IsDraft:              getBoolArg(args, "is_draft", false),
 		}
 
 		// Parse and add items if provided
 		if itemsRaw, ok := args["items"].([]interface{}); ok && len(itemsRaw) > 0 {
 			for _, itemRaw := range itemsRaw {
 				if itemMap, ok := itemRaw.(map[string]interface{}); ok {
-					item := models.InvoiceItem{
-						Description: getStringFromMap(itemMap, "description"),
-						Quantity:    getFloatFromMap(itemMap, "quantity", 1),
-						UnitPrice:   getFloatFromMap(itemMap, "unit_price", 0),
-					}
-					if item.Quantity == 0 {
-						item.Quantity = 1
+					item, err := getItemArg(itemMap)
+					if err != nil {
+						return mcp.NewToolResultError(err.Error()), nil
 					}
 					invoice.Items = append(invoice.Items, item)
 				}
 			}
 		}
 
+		expectedAmount, err := getFloatPtrArg(args, "expected_amount")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
 		createResult, err := t.service.CreateInvoice(userID, invoice)
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to create invoice: %v", err)), nil
 		}
 
+		// Items not adding up to the expected amount are reported, not rejected
+		var warnings []string
+		if expectedAmount != nil {
+			if warning := services.ExpectedAmountWarning(createResult.Invoice, *expectedAmount); warning != "" {
+				warnings = append(warnings, warning)
+			}
+		}
+
 		// If duplicate found, return existing invoice with message
 		if createResult.IsDuplicate {
 			response := map[string]interface{}{
@@ -123,6 +168,9 @@ func (t *CreateInvoiceTool) GetHandler() server.ToolHandlerFunc {
 				"is_duplicate": true,
 				"message":      createResult.Message,
 			}
+			if len(warnings) > 0 {
+				response["warnings"] = warnings
+			}
 			result, _ := json.Marshal(response)
 			return mcp.NewToolResultText(string(result)), nil
 		}
@@ -144,7 +192,10 @@ func (t *CreateInvoiceTool) GetHandler() server.ToolHandlerFunc {
 		}
 
 		created, _ := t.service.GetInvoiceByID(userID, createResult.Invoice.ID)
-		result, _ := json.Marshal(created)
+		result, _ := json.Marshal(struct {
+			*models.Invoice
+			Warnings []string `json:"warnings,omitempty"`
+		}{created, warnings})
 		return mcp.NewToolResultText(string(result)), nil
 	}
 }
@@ -160,16 +211,24 @@ func NewListInvoicesTool(service services.InvoiceService) *ListInvoicesTool {
 
 func (t *ListInvoicesTool) GetTool() mcp.Tool {
 	return mcp.NewTool("list_invoices",
-		mcp.WithDescription("List invoices with filtering and sorting"),
+		mcp.WithDescription("List invoices with filtering and sorting. The response includes total_amount and total_target_amount (in the base currency) summed over all matching invoices, not just the current page."),
 		mcp.WithString("keyword", mcp.Description("Search keyword")),
 		mcp.WithNumber("category_id", mcp.Description("Filter by category ID")),
 		mcp.WithNumber("company_id", mcp.Description("Filter by company ID")),
 		mcp.WithNumber("receiver_id", mcp.Description("Filter by receiver ID")),
+		mcp.WithNumber("organization_id", mcp.Description("List the invoices of this organization (all members' invoices) instead of your own; totals are converted to your base currency")),
 		mcp.WithString("status", mcp.Description("Filter by status: paid, unpaid, overdue")),
-		mcp.WithString("sort_by", mcp.Description("Sort by: created_at, amount, due_date, title")),
+		mcp.WithString("payment_method", mcp.Description("Filter by exact payment method (see list_payment_methods); an empty string matches invoices without one")),
+		mcp.WithBoolean("include_drafts", mcp.Description("Include draft invoices, which are left out by default")),
+		mcp.WithString("sort_by", mcp.Description("Sort by: created_at, updated_at, amount, due_date, title, or several comma-separated (e.g. 'amount,created_at')")),
 		mcp.WithString("sort_order", mcp.Description("Sort order: asc, desc")),
 		mcp.WithNumber("limit", mcp.Description("Maximum results (default 50)")),
 		mcp.WithNumber("offset", mcp.Description("Offset for pagination")),
+		mcp.WithString("cursor", mcp.Description("Cursor from a previous next_cursor for keyset pagination (ignores offset and sorting)")),
+		mcp.WithString("cursor_direction", mcp.Description("Keyset pagination direction: desc (default), asc. Set without cursor to start cursor pagination")),
+		mcp.WithNumber("min_amount", mcp.Description("Only include invoices with an amount of at least this value (inclusive)")),
+		mcp.WithNumber("max_amount", mcp.Description("Only include invoices with an amount of at most this value (inclusive)")),
+		mcp.WithString("amount_field", mcp.Description("Amount compared by min_amount/max_amount: target_amount (default, base currency so invoices in different currencies compare fairly) or amount (raw invoice currency)")),
 	)
 }
 
@@ -179,41 +238,65 @@ func (t *ListInvoicesTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
 		opts := services.InvoiceListOptions{
 			Keyword:   getStringArg(args, "keyword"),
 			SortBy:    getStringArg(args, "sort_by"),
 			SortOrder: getStringArg(args, "sort_order"),
-			Limit:     getIntArg(args, "limit", 50),
-			Offset:    getIntArg(args, "offset", 0),
+
+			Cursor:          getStringArg(args, "cursor"),
+			CursorDirection: getStringArg(args, "cursor_direction"),
+
+			AmountField: getStringArg(args, "amount_field"),
+
+			IncludeDrafts: getBoolArg(args, "include_drafts", false),
 		}
 
-		if categoryID := getUintPtrArg(args, "category_id"); categoryID != nil {
-			opts.CategoryID = categoryID
+		var err error
+		if opts.Limit, opts.Offset, err = getPaginationArgs(args, 50); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
 		}
-		if companyID := getUintPtrArg(args, "company_id"); companyID != nil {
-			opts.CompanyID = companyID
+		if err := getInvoiceFilterArgs(args, &opts); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
 		}
-		if receiverID := getUintPtrArg(args, "receiver_id"); receiverID != nil {
-			opts.ReceiverID = receiverID
+		if opts.OrganizationID, err = getUintPtrArg(args, "organization_id"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
 		}
 		if statusStr := getStringArg(args, "status"); statusStr != "" {
 			status := models.InvoiceStatus(statusStr)
 			opts.Status = &status
 		}
+		if paymentMethod, ok := args["payment_method"].(string); ok {
+			opts.PaymentMethod = &paymentMethod
+		}
+
+		if opts.Cursor != "" || opts.CursorDirection != "" {
+			// Cursor mode ignores offset
+			opts.Offset = 0
+		}
 
-		invoices, total, err := t.service.ListInvoices(userID, opts)
+		page, err := t.service.ListInvoicesPage(userID, opts)
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to list invoices: %v", err)), nil
 		}
 
-		result, _ := json.Marshal(map[string]interface{}{
-			"data":   invoices,
-			"total":  total,
-			"limit":  opts.Limit,
-			"offset": opts.Offset,
-		})
+		response := map[string]interface{}{
+			"data":                page.Invoices,
+			"total":               page.Total,
+			"total_amount":        page.TotalAmount,
+			"total_target_amount": page.TotalTargetAmount,
+			"limit":               opts.Limit,
+			"offset":              opts.Offset,
+		}
+		if page.NextCursor != "" {
+			response["next_cursor"] = page.NextCursor
+		}
+
+		result, _ := json.Marshal(response)
 		return mcp.NewToolResultText(string(result)), nil
 	}
 }
@@ -231,6 +314,7 @@ func (t *GetInvoiceTool) GetTool() mcp.Tool {
 	return mcp.NewTool("get_invoice",
 		mcp.WithDescription("Get an invoice by ID with all details"),
 		mcp.WithNumber("invoice_id", mcp.Required(), mcp.Description("Invoice ID")),
+		mcp.WithBoolean("include_amount_in_words", mcp.Description("Also return the amount spelled out in amount_in_words, e.g. \"One Hundred Twenty Three Dollars and 45/100\" (default false)")),
 	)
 }
 
@@ -240,9 +324,15 @@ func (t *GetInvoiceTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
-		invoiceID := getUintArg(args, "invoice_id")
+		invoiceID, err := getUintArg(args, "invoice_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if invoiceID == 0 {
 			return mcp.NewToolResultError("invoice_id is required"), nil
 		}
@@ -252,11 +342,75 @@ func (t *GetInvoiceTool) GetHandler() server.ToolHandlerFunc {
 			return mcp.NewToolResultError(fmt.Sprintf("Invoice not found: %v", err)), nil
 		}
 
+		if getBoolArg(args, "include_amount_in_words", false) && !invoice.AmountCurrencyMixed {
+			result, _ := json.Marshal(struct {
+				*models.Invoice
+				AmountInWords string `json:"amount_in_words,omitempty"`
+			}{invoice, utils.AmountInWords(invoice.Amount, invoice.Currency)})
+			return mcp.NewToolResultText(string(result)), nil
+		}
+
 		result, _ := json.Marshal(invoice)
 		return mcp.NewToolResultText(string(result)), nil
 	}
 }
 
+// LookupInvoiceTool finds invoices by number or original link
+type LookupInvoiceTool struct {
+	service services.InvoiceService
+}
+
+func NewLookupInvoiceTool(service services.InvoiceService) *LookupInvoiceTool {
+	return &LookupInvoiceTool{service: service}
+}
+
+func (t *LookupInvoiceTool) GetTool() mcp.Tool {
+	return mcp.NewTool("lookup_invoice",
+		mcp.WithDescription("Find invoices by invoice number or original download link instead of ID, e.g. when reconciling against a vendor document. A number matches one of your own invoices (invoices from before numbering go by their ID); a link can match several, returned newest first. Give exactly one of number and link."),
+		mcp.WithString("number", mcp.Description("Invoice number, e.g. INV-2024-0001")),
+		mcp.WithString("link", mcp.Description("Original download link, matched exactly")),
+	)
+}
+
+func (t *LookupInvoiceTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		number := strings.TrimSpace(getStringArg(args, "number"))
+		link := strings.TrimSpace(getStringArg(args, "link"))
+		if (number == "") == (link == "") {
+			return mcp.NewToolResultError("Exactly one of number and link is required"), nil
+		}
+
+		var invoices []models.Invoice
+		var err error
+		if number != "" {
+			var invoice *models.Invoice
+			if invoice, err = t.service.GetByNumber(userID, number); err == nil {
+				invoices = []models.Invoice{*invoice}
+			}
+		} else {
+			invoices, err = t.service.GetByOriginalLink(userID, link)
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Lookup failed: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(map[string]interface{}{
+			"data":  invoices,
+			"count": len(invoices),
+		})
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
 // UpdateInvoiceTool handles invoice updates
 type UpdateInvoiceTool struct {
 	service services.InvoiceService
@@ -279,7 +433,12 @@ func (t *UpdateInvoiceTool) GetTool() mcp.Tool {
 		mcp.WithString("original_download_link", mcp.Description("Link to original invoice file")),
 		mcp.WithString("status", mcp.Description("Status: paid, unpaid, overdue")),
 		mcp.WithString("due_date", mcp.Description("Due date (RFC3339)")),
+		mcp.WithString("payment_method", mcp.Description("Card or account the invoice was paid with (omit to keep the current one, empty string to clear it)")),
+		mcp.WithString("discount_type", mcp.Description("Invoice discount type: percent or fixed (omit to keep the current discount, empty string to remove it)")),
+		mcp.WithNumber("discount_value", mcp.Description("Invoice discount: a percentage (0-100) or an amount in the invoice currency (omit to keep the current value)")),
+		mcp.WithNumber("tax_rate", mcp.Description("Tax percentage the invoice was charged at (omit to keep the current value)")),
 		mcp.WithArray("tags", mcp.Description("Tag names to assign to the invoice (replaces existing tags). Pass empty array to remove all tags."), mcp.Items(map[string]any{"type": "string"})),
+		mcp.WithNumber("version", mcp.Description("Version of the invoice the update is based on (from get_invoice). The update fails if the invoice has changed since; omit to update the current version")),
 	)
 }
 
@@ -289,9 +448,15 @@ func (t *UpdateInvoiceTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
-		invoiceID := getUintArg(args, "invoice_id")
+		invoiceID, err := getUintArg(args, "invoice_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if invoiceID == 0 {
 			return mcp.NewToolResultError("invoice_id is required"), nil
 		}
@@ -306,18 +471,59 @@ func (t *UpdateInvoiceTool) GetHandler() server.ToolHandlerFunc {
 
 		dueDate := parseTimeArg(args, "due_date")
 
+		receiverID, err := getUintPtrArg(args, "receiver_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		categoryID, err := getUintPtrArg(args, "category_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		companyID, err := getUintPtrArg(args, "company_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		current, err := t.service.GetInvoiceByID(userID, invoiceID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to update invoice: %v", err)), nil
+		}
+		version, err := getIntArg(args, "version", current.Version)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		discountType, discountValue, err := getDiscountArgs(args, current.DiscountType, current.DiscountValue)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		paymentMethod := current.PaymentMethod
+		if v, ok := args["payment_method"].(string); ok {
+			paymentMethod = v
+		}
+		taxRate := current.TaxRate
+		if value, err := getFloatPtrArg(args, "tax_rate"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		} else if value != nil {
+			taxRate = value
+		}
+
 		// Note: Amount is not set here - it's calculated from invoice items
 		invoice := &models.Invoice{
 			ID:                   invoiceID,
 			Title:                title,
 			Description:          description,
-			ReceiverID:           getUintPtrArg(args, "receiver_id"),
+			ReceiverID:           receiverID,
 			Currency:             currency,
-			CategoryID:           getUintPtrArg(args, "category_id"),
-			CompanyID:            getUintPtrArg(args, "company_id"),
+			CategoryID:           categoryID,
+			CompanyID:            companyID,
 			OriginalDownloadLink: originalDownloadLink,
 			Status:               status,
 			DueDate:              dueDate,
+			DiscountType:         discountType,
+			DiscountValue:        discountValue,
+			TaxRate:              taxRate,
+			PaymentMethod:        paymentMethod,
+			Version:              version,
 		}
 
 		if err := t.service.UpdateInvoice(userID, invoice); err != nil {
@@ -366,9 +572,15 @@ func (t *DeleteInvoiceTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
-		invoiceID := getUintArg(args, "invoice_id")
+		invoiceID, err := getUintArg(args, "invoice_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if invoiceID == 0 {
 			return mcp.NewToolResultError("invoice_id is required"), nil
 		}
@@ -392,8 +604,9 @@ func NewSearchInvoicesTool(service services.InvoiceService) *SearchInvoicesTool
 
 func (t *SearchInvoicesTool) GetTool() mcp.Tool {
 	return mcp.NewTool("search_invoices",
-		mcp.WithDescription("Full-text search across invoices"),
+		mcp.WithDescription("Full-text search across invoices by title, description, and category, company, or receiver name"),
 		mcp.WithString("query", mcp.Required(), mcp.Description("Search query")),
+		mcp.WithBoolean("highlight", mcp.Description("Include matched_fields and highlighted title/description snippets explaining each match (default false)")),
 	)
 }
 
@@ -403,6 +616,9 @@ func (t *SearchInvoicesTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
 		query, _ := args["query"].(string)
@@ -410,7 +626,7 @@ func (t *SearchInvoicesTool) GetHandler() server.ToolHandlerFunc {
 			return mcp.NewToolResultError("query is required"), nil
 		}
 
-		invoices, err := t.service.SearchInvoices(userID, query)
+		invoices, err := t.service.SearchInvoices(userID, query, getBoolArg(args, "highlight", false))
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Search failed: %v", err)), nil
 		}
@@ -423,6 +639,272 @@ func (t *SearchInvoicesTool) GetHandler() server.ToolHandlerFunc {
 	}
 }
 
+// FindIncompleteInvoicesTool handles finding invoices with missing links
+type FindIncompleteInvoicesTool struct {
+	service services.InvoiceService
+}
+
+func NewFindIncompleteInvoicesTool(service services.InvoiceService) *FindIncompleteInvoicesTool {
+	return &FindIncompleteInvoicesTool{service: service}
+}
+
+func (t *FindIncompleteInvoicesTool) GetTool() mcp.Tool {
+	return mcp.NewTool("find_incomplete_invoices",
+		mcp.WithDescription("Find invoices missing a category, company, and/or receiver so they can be cleaned up. Selected flags are combined with OR; at least one must be true."),
+		mcp.WithBoolean("missing_category", mcp.Description("Include invoices without a category")),
+		mcp.WithBoolean("missing_company", mcp.Description("Include invoices without a company")),
+		mcp.WithBoolean("missing_receiver", mcp.Description("Include invoices without a receiver")),
+	)
+}
+
+func (t *FindIncompleteInvoicesTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		filter := services.IncompleteFilter{
+			MissingCategory: getBoolArg(args, "missing_category", false),
+			MissingCompany:  getBoolArg(args, "missing_company", false),
+			MissingReceiver: getBoolArg(args, "missing_receiver", false),
+		}
+		if !filter.MissingCategory && !filter.MissingCompany && !filter.MissingReceiver {
+			return mcp.NewToolResultError("At least one of missing_category, missing_company, or missing_receiver must be true"), nil
+		}
+
+		invoices, err := t.service.ListIncomplete(userID, filter)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to find incomplete invoices: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(map[string]interface{}{
+			"data":  invoices,
+			"count": len(invoices),
+		})
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
+// FindSimilarInvoicesTool finds past invoices related to an invoice for context
+type FindSimilarInvoicesTool struct {
+	service services.InvoiceService
+}
+
+func NewFindSimilarInvoicesTool(service services.InvoiceService) *FindSimilarInvoicesTool {
+	return &FindSimilarInvoicesTool{service: service}
+}
+
+func (t *FindSimilarInvoicesTool) GetTool() mcp.Tool {
+	return mcp.NewTool("find_similar_invoices",
+		mcp.WithDescription("Find the user's other invoices most similar to an invoice, best first, e.g. to show past bills from the same vendor for context. Candidates score 3 for the same receiver, up to 2 for the share of title words in common, and up to 1 for a base-currency amount within ±10% (more the closer it is); invoices scoring 0 are left out and ties go to the most recent."),
+		mcp.WithNumber("invoice_id", mcp.Required(), mcp.Description("Invoice to find similar invoices for")),
+		mcp.WithNumber("limit", mcp.Description(fmt.Sprintf("Maximum number of invoices to return (default %d, max %d)", services.DefaultSimilarLimit, services.MaxSimilarLimit))),
+	)
+}
+
+func (t *FindSimilarInvoicesTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		invoiceID, err := getUintArg(args, "invoice_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if invoiceID == 0 {
+			return mcp.NewToolResultError("invoice_id is required"), nil
+		}
+
+		limit, err := getIntArg(args, "limit", services.DefaultSimilarLimit)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		invoices, err := t.service.FindSimilar(userID, invoiceID, limit)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to find similar invoices: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(map[string]interface{}{
+			"data":  invoices,
+			"count": len(invoices),
+		})
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
+// ReconcileStatementTool proposes the invoices the lines of a bank statement pay
+type ReconcileStatementTool struct {
+	service services.InvoiceService
+}
+
+func NewReconcileStatementTool(service services.InvoiceService) *ReconcileStatementTool {
+	return &ReconcileStatementTool{service: service}
+}
+
+func (t *ReconcileStatementTool) GetTool() mcp.Tool {
+	return mcp.NewTool("reconcile_statement",
+		mcp.WithDescription(fmt.Sprintf(`Match the lines of a bank or card statement (e.g. read from a downloaded CSV) to the user's invoices. Read-only: nothing is changed.
+
+A line matches an invoice whose base-currency amount is within %.0f%% of the line amount (sign ignored) and whose date (paid date, else due date, else creation date) is at most %d days from the line date; drafts are left out.
+
+Returns:
+- matched: lines with exactly one invoice that no other line matches, in match
+- ambiguous: lines with several candidate invoices, or sharing one with another line, in candidates (closest date first)
+- unmatched: lines matching no invoice, candidates for create_invoice
+
+Confirm the proposals with the user before acting on them, e.g. with update_invoice_status to mark matched unpaid invoices paid.`,
+			services.ReconcileAmountTolerance*100, services.ReconcileDateWindowDays)),
+		mcp.WithArray("lines", mcp.Required(), mcp.Description(fmt.Sprintf("Statement lines, at most %d", services.MaxReconcileLines)),
+			mcp.Items(map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"date":        map[string]any{"type": "string", "description": "Booking date, YYYY-MM-DD or RFC3339"},
+					"amount":      map[string]any{"type": "number", "description": "Amount in the user's base currency"},
+					"description": map[string]any{"type": "string"},
+				},
+				"required": []string{"date", "amount"},
+			})),
+	)
+}
+
+func (t *ReconcileStatementTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		linesRaw, _ := args["lines"].([]interface{})
+		lines := make([]services.StatementLine, 0, len(linesRaw))
+		for i, lineRaw := range linesRaw {
+			lineMap, ok := lineRaw.(map[string]interface{})
+			if !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("line %d must be an object", i+1)), nil
+			}
+			line, err := getStatementLineArg(lineMap)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("line %d: %v", i+1, err)), nil
+			}
+			lines = append(lines, line)
+		}
+		if len(lines) == 0 {
+			return mcp.NewToolResultError("lines is required"), nil
+		}
+
+		reconciled, err := t.service.Reconcile(userID, lines)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to reconcile statement: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(reconciled)
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
+// getStatementLineArg reads a statement line of reconcile_statement, whose date is a date or an
+// RFC3339 timestamp
+func getStatementLineArg(args map[string]interface{}) (services.StatementLine, error) {
+	line := services.StatementLine{Description: getStringArg(args, "description")}
+
+	value := getStringArg(args, "date")
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		if date, err = time.Parse(time.RFC3339, value); err != nil {
+			return line, &ArgError{Key: "date", Value: value, Want: "a date (YYYY-MM-DD) or an RFC3339 timestamp"}
+		}
+	}
+	line.Date = date
+
+	amount, ok, err := getNumberArg(args, "amount")
+	if err != nil {
+		return line, err
+	}
+	if !ok {
+		return line, fmt.Errorf("amount is required")
+	}
+	line.Amount = amount
+	return line, nil
+}
+
+// defaultUpcomingDays is how far ahead list_upcoming_invoices looks when days isn't given
+const defaultUpcomingDays = 7
+
+// ListUpcomingInvoicesTool lists unpaid invoices coming due soon
+type ListUpcomingInvoicesTool struct {
+	service services.InvoiceService
+}
+
+func NewListUpcomingInvoicesTool(service services.InvoiceService) *ListUpcomingInvoicesTool {
+	return &ListUpcomingInvoicesTool{service: service}
+}
+
+func (t *ListUpcomingInvoicesTool) GetTool() mcp.Tool {
+	return mcp.NewTool("list_upcoming_invoices",
+		mcp.WithDescription("List unpaid invoices due between now and the given number of days from now, soonest first, for cash-flow planning (e.g. \"what's due this week\"). Invoices without a due date are left out. The response includes the count and total_due, the summed amount in the base currency."),
+		mcp.WithNumber("days", mcp.Description(fmt.Sprintf("How many days ahead to look (default: %d)", defaultUpcomingDays))),
+	)
+}
+
+func (t *ListUpcomingInvoicesTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		days, err := getIntArg(args, "days", defaultUpcomingDays)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if days <= 0 {
+			return mcp.NewToolResultError("days must be a positive number"), nil
+		}
+
+		now := time.Now()
+		until := now.AddDate(0, 0, days)
+		status := models.InvoiceStatusUnpaid
+		page, err := t.service.ListInvoicesPage(userID, services.InvoiceListOptions{
+			Status:    &status,
+			DueAfter:  &now,
+			DueBefore: &until,
+			SortBy:    "due_date",
+			SortOrder: "asc",
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list upcoming invoices: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(map[string]interface{}{
+			"data":      page.Invoices,
+			"count":     page.Total,
+			"total_due": page.TotalTargetAmount,
+			"due_after": now,
+			"due_by":    until,
+		})
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
 // UpdateInvoiceStatusTool handles status updates
 type UpdateInvoiceStatusTool struct {
 	service services.InvoiceService
@@ -446,9 +928,15 @@ func (t *UpdateInvoiceStatusTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
-		invoiceID := getUintArg(args, "invoice_id")
+		invoiceID, err := getUintArg(args, "invoice_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if invoiceID == 0 {
 			return mcp.NewToolResultError("invoice_id is required"), nil
 		}
@@ -469,27 +957,528 @@ func (t *UpdateInvoiceStatusTool) GetHandler() server.ToolHandlerFunc {
 	}
 }
 
-// Helper functions
-func getStringArg(args map[string]interface{}, key string) string {
-	if v, ok := args[key].(string); ok {
-		return v
-	}
-	return ""
+// FinalizeInvoiceTool turns a draft invoice into a regular one
+type FinalizeInvoiceTool struct {
+	service services.InvoiceService
 }
 
-func getFloatArg(args map[string]interface{}, key string, defaultVal float64) float64 {
-	if v, ok := args[key].(float64); ok {
-		return v
-	}
-	return defaultVal
+func NewFinalizeInvoiceTool(service services.InvoiceService) *FinalizeInvoiceTool {
+	return &FinalizeInvoiceTool{service: service}
 }
 
-func getUintPtrArg(args map[string]interface{}, key string) *uint {
-	if v, ok := args[key].(float64); ok && v > 0 {
-		id := uint(v)
-		return &id
-	}
-	return nil
+func (t *FinalizeInvoiceTool) GetTool() mcp.Tool {
+	return mcp.NewTool("finalize_invoice",
+		mcp.WithDescription("Finalize a draft invoice created with is_draft, so it counts towards statistics and shows up in list_invoices"),
+		mcp.WithNumber("invoice_id", mcp.Required(), mcp.Description("ID of the draft invoice")),
+	)
+}
+
+func (t *FinalizeInvoiceTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		invoiceID, err := getUintArg(args, "invoice_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if invoiceID == 0 {
+			return mcp.NewToolResultError("invoice_id is required"), nil
+		}
+
+		if err := t.service.FinalizeInvoice(userID, invoiceID); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to finalize invoice: %v", err)), nil
+		}
+
+		finalized, _ := t.service.GetInvoiceByID(userID, invoiceID)
+		result, _ := json.Marshal(finalized)
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
+// LinkInvoicesTool links an invoice to a related invoice, e.g. a credit note to the invoice it refunds
+type LinkInvoicesTool struct {
+	service services.InvoiceService
+}
+
+func NewLinkInvoicesTool(service services.InvoiceService) *LinkInvoicesTool {
+	return &LinkInvoicesTool{service: service}
+}
+
+func (t *LinkInvoicesTool) GetTool() mcp.Tool {
+	return mcp.NewTool("link_invoices",
+		mcp.WithDescription("Link an invoice to the invoice it relates to, e.g. a vendor's credit note or refund to the original invoice. Refunds and credit notes without a category or company take the original invoice's, and invoice_statistics with net_refunds subtracts them from the totals. Pass related_invoice_id 0 to remove the link."),
+		mcp.WithNumber("invoice_id", mcp.Required(), mcp.Description("ID of the refund, credit note, or correcting invoice")),
+		mcp.WithNumber("related_invoice_id", mcp.Required(), mcp.Description("ID of the original invoice, or 0 to remove the link")),
+		mcp.WithString("relation_type", mcp.Description("How invoice_id relates to the original: refund, credit_note, or correction. Required unless removing the link")),
+	)
+}
+
+func (t *LinkInvoicesTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		invoiceID, err := getUintArg(args, "invoice_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if invoiceID == 0 {
+			return mcp.NewToolResultError("invoice_id is required"), nil
+		}
+		relatedInvoiceID, err := getUintArg(args, "related_invoice_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		relationType := models.InvoiceRelationType(getStringArg(args, "relation_type"))
+
+		if err := t.service.LinkInvoices(userID, invoiceID, relatedInvoiceID, relationType); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to link invoices: %v", err)), nil
+		}
+
+		updated, _ := t.service.GetInvoiceByID(userID, invoiceID)
+		result, _ := json.Marshal(updated)
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
+// RecalculateInvoiceTotalsTool repairs invoice totals that drifted from their items
+type RecalculateInvoiceTotalsTool struct {
+	service services.InvoiceService
+}
+
+func NewRecalculateInvoiceTotalsTool(service services.InvoiceService) *RecalculateInvoiceTotalsTool {
+	return &RecalculateInvoiceTotalsTool{service: service}
+}
+
+func (t *RecalculateInvoiceTotalsTool) GetTool() mcp.Tool {
+	return mcp.NewTool("recalculate_invoice_totals",
+		mcp.WithDescription("Maintenance: recompute invoice totals from their items (item target amounts at the current FX rates, then the invoice amount) to repair totals that drifted, e.g. after manual database edits. Returns the totals before and after. Without invoice_id, every invoice is recalculated and only the corrected ones are listed."),
+		mcp.WithNumber("invoice_id", mcp.Description("Invoice to recalculate; omit to recalculate all invoices")),
+	)
+}
+
+func (t *RecalculateInvoiceTotalsTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		invoiceID, err := getUintArg(args, "invoice_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if invoiceID != 0 {
+			recalculation, err := t.service.RecalculateTotals(userID, invoiceID)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to recalculate totals: %v", err)), nil
+			}
+			result, _ := json.Marshal(recalculation)
+			return mcp.NewToolResultText(string(result)), nil
+		}
+
+		recalculations, err := t.service.RecalculateAllTotals(userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to recalculate totals: %v", err)), nil
+		}
+		corrected := make([]services.TotalsRecalculation, 0)
+		for _, recalculation := range recalculations {
+			if recalculation.Changed() {
+				corrected = append(corrected, recalculation)
+			}
+		}
+
+		result, _ := json.Marshal(map[string]interface{}{
+			"checked":   len(recalculations),
+			"corrected": corrected,
+		})
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
+// RefreshFXRatesTool re-prices the invoices in a currency at the current FX rates
+type RefreshFXRatesTool struct {
+	service services.InvoiceService
+}
+
+func NewRefreshFXRatesTool(service services.InvoiceService) *RefreshFXRatesTool {
+	return &RefreshFXRatesTool{service: service}
+}
+
+func (t *RefreshFXRatesTool) GetTool() mcp.Tool {
+	return mcp.NewTool("refresh_fx_rates",
+		mcp.WithDescription("Re-price every invoice in a currency at the current FX rates, e.g. after rates moved significantly: recalculates each item's target amount and rate and updates the invoice totals. Items with a manual target amount override keep it unless include_overrides is true. Returns the number of invoices re-priced."),
+		mcp.WithString("currency", mcp.Required(), mcp.Description("Invoice currency to re-price (e.g. EUR)")),
+		mcp.WithBoolean("include_overrides", mcp.Description("Also re-price items whose target amount was overridden by hand (default: false)")),
+	)
+}
+
+func (t *RefreshFXRatesTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		currency := getStringArg(args, "currency")
+		if currency == "" {
+			return mcp.NewToolResultError("currency is required"), nil
+		}
+
+		refreshed, err := t.service.RefreshFXForCurrency(userID, currency, getBoolArg(args, "include_overrides", false))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to refresh FX rates after re-pricing %d invoices: %v", refreshed, err)), nil
+		}
+
+		result, _ := json.Marshal(map[string]interface{}{
+			"currency":           strings.ToUpper(strings.TrimSpace(currency)),
+			"invoices_refreshed": refreshed,
+		})
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
+// ResetItemFXTool drops manual target amount overrides so items follow live FX again
+type ResetItemFXTool struct {
+	service services.InvoiceService
+}
+
+func NewResetItemFXTool(service services.InvoiceService) *ResetItemFXTool {
+	return &ResetItemFXTool{service: service}
+}
+
+func (t *ResetItemFXTool) GetTool() mcp.Tool {
+	return mcp.NewTool("reset_item_fx",
+		mcp.WithDescription("Move items' base-currency amounts back to automatic FX: manual target amount overrides are dropped and each item's target amount and rate recalculated at the current FX rate, then the invoice total is updated. Resets every item of the invoice, or only item_id when given. Returns the updated invoice."),
+		mcp.WithNumber("invoice_id", mcp.Required(), mcp.Description("Invoice whose items to reset")),
+		mcp.WithNumber("item_id", mcp.Description("Only reset this item of the invoice")),
+	)
+}
+
+func (t *ResetItemFXTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		invoiceID, err := getUintArg(args, "invoice_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if invoiceID == 0 {
+			return mcp.NewToolResultError("invoice_id is required"), nil
+		}
+		itemID, err := getUintArg(args, "item_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		if itemID != 0 {
+			item, err := t.service.GetInvoiceItem(userID, itemID)
+			if err != nil || item.InvoiceID != invoiceID {
+				return mcp.NewToolResultError(fmt.Sprintf("Item %d not found on invoice %d", itemID, invoiceID)), nil
+			}
+			err = t.service.ClearItemTargetOverride(userID, itemID)
+		} else {
+			err = t.service.ClearTargetOverrides(userID, invoiceID)
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to reset item FX: %v", err)), nil
+		}
+
+		invoice, err := t.service.GetInvoiceByID(userID, invoiceID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get invoice: %v", err)), nil
+		}
+		result, _ := json.Marshal(invoice)
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
+// ExplainInvoiceTotalTool explains how an invoice's totals derive from its items
+type ExplainInvoiceTotalTool struct {
+	service services.InvoiceService
+}
+
+func NewExplainInvoiceTotalTool(service services.InvoiceService) *ExplainInvoiceTotalTool {
+	return &ExplainInvoiceTotalTool{service: service}
+}
+
+func (t *ExplainInvoiceTotalTool) GetTool() mcp.Tool {
+	return mcp.NewTool("explain_invoice_total",
+		mcp.WithDescription("Explain how an invoice's base-currency total is derived: per item the amount and currency, the FX rate used and the date it applied, and the resulting base-currency amount, plus the summed total. Flags items converted by a manual override or at a stale rate, and lists mismatches where the stored invoice amount or an item's base-currency amount differs from the one recomputed from the items. Read-only; recalculate_invoice_totals repairs mismatches."),
+		mcp.WithNumber("invoice_id", mcp.Required(), mcp.Description("Invoice to explain")),
+	)
+}
+
+func (t *ExplainInvoiceTotalTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		invoiceID, err := getUintArg(args, "invoice_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if invoiceID == 0 {
+			return mcp.NewToolResultError("invoice_id is required"), nil
+		}
+
+		explanation, err := t.service.ExplainTotal(userID, invoiceID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to explain invoice total: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(explanation)
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
+// CleanupOrphansTool finds and repairs broken relations in the user's data
+type CleanupOrphansTool struct {
+	service services.InvoiceService
+}
+
+func NewCleanupOrphansTool(service services.InvoiceService) *CleanupOrphansTool {
+	return &CleanupOrphansTool{service: service}
+}
+
+func (t *CleanupOrphansTool) GetTool() mcp.Tool {
+	return mcp.NewTool("cleanup_orphans",
+		mcp.WithDescription("Maintenance: find broken relations in the user's data and report their counts: tag mappings pointing at deleted invoices, items left on deleted invoices, and invoices referencing a deleted category, company, or receiver. Runs as a dry run unless dry_run is false, in which case the mappings and items are deleted and the references cleared in one transaction. Only the user's own data is checked."),
+		mcp.WithBoolean("dry_run", mcp.Description("Only report what would be cleaned up (default: true)")),
+	)
+}
+
+func (t *CleanupOrphansTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		report, err := t.service.CleanupOrphans(userID, getBoolArg(args, "dry_run", true))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to clean up orphans: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(report)
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
+// PreviewCurrencyConversionTool previews an invoice currency change without saving it
+type PreviewCurrencyConversionTool struct {
+	service services.InvoiceService
+}
+
+func NewPreviewCurrencyConversionTool(service services.InvoiceService) *PreviewCurrencyConversionTool {
+	return &PreviewCurrencyConversionTool{service: service}
+}
+
+func (t *PreviewCurrencyConversionTool) GetTool() mcp.Tool {
+	return mcp.NewTool("preview_currency_conversion",
+		mcp.WithDescription("Preview what an invoice's base-currency item amounts and total would become if its currency were changed. Read-only: nothing is saved; use update_invoice to apply the change."),
+		mcp.WithNumber("invoice_id", mcp.Required(), mcp.Description("Invoice ID")),
+		mcp.WithString("currency", mcp.Required(), mcp.Description("Proposed invoice currency (e.g., EUR)")),
+	)
+}
+
+func (t *PreviewCurrencyConversionTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		invoiceID, err := getUintArg(args, "invoice_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if invoiceID == 0 {
+			return mcp.NewToolResultError("invoice_id is required"), nil
+		}
+		currency := getStringArg(args, "currency")
+		if currency == "" {
+			return mcp.NewToolResultError("currency is required"), nil
+		}
+
+		preview, err := t.service.PreviewCurrencyChange(ctx, userID, invoiceID, currency)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to preview conversion: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(preview)
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
+// CloneInvoiceTool handles invoice cloning
+type CloneInvoiceTool struct {
+	service services.InvoiceService
+}
+
+func NewCloneInvoiceTool(service services.InvoiceService) *CloneInvoiceTool {
+	return &CloneInvoiceTool{service: service}
+}
+
+func (t *CloneInvoiceTool) GetTool() mcp.Tool {
+	return mcp.NewTool("clone_invoice",
+		mcp.WithDescription(`Create a new invoice from an existing one (e.g. next month's rent).
+Copies title, description, items, category, company, receiver, tags, currency, and dates.
+The clone is unpaid unless a status is given. Override the dates to place it in a new period;
+a clone that matches an existing invoice (same amount, dates, and receiver) is reported as a duplicate.
+With target_currency the clone is re-billed in another currency: item unit prices are converted at the
+current FX rate, so the item amounts change rather than just being relabeled.`),
+		mcp.WithNumber("invoice_id", mcp.Required(), mcp.Description("ID of the invoice to clone")),
+		mcp.WithString("title", mcp.Description("New title (default: source title)")),
+		mcp.WithString("status", mcp.Description("Status: paid, unpaid, or overdue (default: unpaid)")),
+		mcp.WithString("invoice_started_at", mcp.Description("Start date (ISO 8601, default: source start date)")),
+		mcp.WithString("invoice_ended_at", mcp.Description("End date (ISO 8601, default: source end date)")),
+		mcp.WithString("due_date", mcp.Description("Due date (ISO 8601, default: source due date)")),
+		mcp.WithString("target_currency", mcp.Description("Currency to re-bill the clone in, converting the item amounts (default: source currency)")),
+	)
+}
+
+func (t *CloneInvoiceTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		invoiceID, err := getUintArg(args, "invoice_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if invoiceID == 0 {
+			return mcp.NewToolResultError("invoice_id is required"), nil
+		}
+
+		overrides := services.CloneOptions{
+			InvoiceStartedAt: parseTimeArg(args, "invoice_started_at"),
+			InvoiceEndedAt:   parseTimeArg(args, "invoice_ended_at"),
+			DueDate:          parseTimeArg(args, "due_date"),
+		}
+		if title := getStringArg(args, "title"); title != "" {
+			overrides.Title = &title
+		}
+		if statusStr := getStringArg(args, "status"); statusStr != "" {
+			status := models.InvoiceStatus(statusStr)
+			overrides.Status = &status
+		}
+		if currency := getStringArg(args, "target_currency"); currency != "" {
+			overrides.TargetCurrency = &currency
+		}
+
+		clone, err := t.service.CloneInvoice(userID, invoiceID, overrides)
+		if err != nil {
+			var duplicateErr *services.DuplicateInvoiceError
+			if errors.As(err, &duplicateErr) {
+				response := map[string]interface{}{
+					"invoice":      duplicateErr.Invoice,
+					"is_duplicate": true,
+					"message":      err.Error(),
+				}
+				result, _ := json.Marshal(response)
+				return mcp.NewToolResultText(string(result)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to clone invoice: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(clone)
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
+// Helper functions
+func getStringArg(args map[string]interface{}, key string) string {
+	if v, ok := args[key].(string); ok {
+		return v
+	}
+	return ""
+}
+
+func getFloatArg(args map[string]interface{}, key string, defaultVal float64) (float64, error) {
+	value, ok, err := getNumberArg(args, key)
+	if err != nil || !ok {
+		return defaultVal, err
+	}
+	return value, nil
+}
+
+// getUintPtrArg reads an optional ID argument; a missing argument or 0 is nil
+func getUintPtrArg(args map[string]interface{}, key string) (*uint, error) {
+	id, err := getUintArg(args, key)
+	if err != nil || id == 0 {
+		return nil, err
+	}
+	return &id, nil
+}
+
+func getFloatPtrArg(args map[string]interface{}, key string) (*float64, error) {
+	value, ok, err := getNumberArg(args, key)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return &value, nil
+}
+
+// getTimeArg reads an optional RFC3339 timestamp argument, reporting one that does not parse
+func getTimeArg(args map[string]interface{}, key string) (*time.Time, error) {
+	value := getStringArg(args, key)
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, &ArgError{Key: key, Value: value, Want: "an RFC3339 timestamp (e.g. 2023-03-01T00:00:00Z)"}
+	}
+	return &t, nil
 }
 
 func parseTimeArg(args map[string]interface{}, key string) *time.Time {
@@ -502,6 +1491,63 @@ func parseTimeArg(args map[string]interface{}, key string) *time.Time {
 	return nil
 }
 
+// getDiscountArgs reads discount_type and discount_value, keeping the current discount for
+// omitted arguments. An empty discount_type removes the discount.
+func getDiscountArgs(args map[string]interface{}, currentType models.DiscountType, currentValue float64) (models.DiscountType, float64, error) {
+	discountType := currentType
+	if value, ok := args["discount_type"].(string); ok {
+		discountType = models.DiscountType(value)
+	}
+	if discountType == "" {
+		return "", 0, nil
+	}
+	discountValue, err := getFloatArg(args, "discount_value", currentValue)
+	return discountType, discountValue, err
+}
+
+// getInvoiceFilterArgs reads the category_id, company_id, receiver_id, min_amount, and max_amount
+// invoice filters into opts
+func getInvoiceFilterArgs(args map[string]interface{}, opts *services.InvoiceListOptions) error {
+	var err error
+	if opts.CategoryID, err = getUintPtrArg(args, "category_id"); err != nil {
+		return err
+	}
+	if opts.CompanyID, err = getUintPtrArg(args, "company_id"); err != nil {
+		return err
+	}
+	if opts.ReceiverID, err = getUintPtrArg(args, "receiver_id"); err != nil {
+		return err
+	}
+	if opts.MinAmount, err = getFloatPtrArg(args, "min_amount"); err != nil {
+		return err
+	}
+	opts.MaxAmount, err = getFloatPtrArg(args, "max_amount")
+	return err
+}
+
+// getItemArg reads an invoice item given as an object in an items array argument
+func getItemArg(itemMap map[string]interface{}) (models.InvoiceItem, error) {
+	item := models.InvoiceItem{
+		Description: getStringFromMap(itemMap, "description"),
+		Unit:        getStringFromMap(itemMap, "unit"),
+		Currency:    getStringFromMap(itemMap, "currency"),
+	}
+	var err error
+	if item.UnitPrice, err = getFloatArg(itemMap, "unit_price", 0); err != nil {
+		return item, err
+	}
+	if item.CategoryID, err = getUintPtrArg(itemMap, "category_id"); err != nil {
+		return item, err
+	}
+	quantity, err := getFloatPtrArg(itemMap, "quantity")
+	if err != nil {
+		return item, err
+	}
+	item.Quantity = models.ItemQuantity(quantity, item.UnitPrice)
+	item.DiscountType, item.DiscountValue, err = getDiscountArgs(itemMap, "", 0)
+	return item, err
+}
+
 // getStringFromMap extracts a string value from a map
 func getStringFromMap(m map[string]interface{}, key string) string {
 	if v, ok := m[key].(string); ok {
@@ -510,10 +1556,81 @@ func getStringFromMap(m map[string]interface{}, key string) string {
 	return ""
 }
 
-// getFloatFromMap extracts a float64 value from a map with a default value
-func getFloatFromMap(m map[string]interface{}, key string, defaultVal float64) float64 {
-	if v, ok := m[key].(float64); ok {
-		return v
+// ListPaymentMethodsTool handles listing the payment methods used on invoices
+type ListPaymentMethodsTool struct {
+	service services.InvoiceService
+}
+
+func NewListPaymentMethodsTool(service services.InvoiceService) *ListPaymentMethodsTool {
+	return &ListPaymentMethodsTool{service: service}
+}
+
+func (t *ListPaymentMethodsTool) GetTool() mcp.Tool {
+	return mcp.NewTool("list_payment_methods",
+		mcp.WithDescription("List the distinct payment methods (cards or accounts) recorded on the user's invoices, sorted by name. Use it to reuse existing names when setting payment_method and to filter list_invoices by method."),
+	)
+}
+
+func (t *ListPaymentMethodsTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
+
+		methods, err := t.service.ListPaymentMethods(userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list payment methods: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(map[string]interface{}{
+			"payment_methods": methods,
+		})
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
+// InvoiceStatusCountsTool handles counting invoices per status
+type InvoiceStatusCountsTool struct {
+	service services.InvoiceService
+}
+
+func NewInvoiceStatusCountsTool(service services.InvoiceService) *InvoiceStatusCountsTool {
+	return &InvoiceStatusCountsTool{service: service}
+}
+
+func (t *InvoiceStatusCountsTool) GetTool() mcp.Tool {
+	return mcp.NewTool("invoice_status_counts",
+		mcp.WithDescription("Count the user's invoices per status (paid, unpaid, overdue), with zero for statuses no invoice has, plus the total. Drafts are not counted. A quick answer to questions like 'how many invoices are still unpaid?' without listing them."),
+	)
+}
+
+func (t *InvoiceStatusCountsTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
+
+		counts, err := t.service.CountByStatus(userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to count invoices: %v", err)), nil
+		}
+
+		var total int64
+		for _, count := range counts {
+			total += count
+		}
+		result, _ := json.Marshal(map[string]interface{}{
+			"counts": counts,
+			"total":  total,
+		})
+		return mcp.NewToolResultText(string(result)), nil
 	}
-	return defaultVal
 }
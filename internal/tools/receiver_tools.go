@@ -9,6 +9,7 @@ import (
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/rxtech-lab/invoice-management/internal/models"
 	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
 )
 
 // CreateReceiverTool handles receiver creation
@@ -25,6 +26,11 @@ func (t *CreateReceiverTool) GetTool() mcp.Tool {
 		mcp.WithDescription("Create a new invoice receiver. If a receiver with the same name (or alias) already exists, returns the existing receiver instead of creating a duplicate."),
 		mcp.WithString("name", mcp.Required(), mcp.Description("Receiver name")),
 		mcp.WithBoolean("is_organization", mcp.Description("Whether the receiver is an organization (default: false)")),
+		mcp.WithString("email", mcp.Description("Contact email, e.g. billing@example.com")),
+		mcp.WithString("phone", mcp.Description("Phone number, e.g. +1 555-0100")),
+		mcp.WithString("address", mcp.Description("Postal address")),
+		mcp.WithString("bank_account", mcp.Description("Bank account details for payments (e.g. IBAN)")),
+		mcp.WithString("tax_id", mcp.Description("Tax ID or VAT number")),
 	)
 }
 
@@ -34,6 +40,9 @@ func (t *CreateReceiverTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
 		name, _ := args["name"].(string)
@@ -52,9 +61,19 @@ func (t *CreateReceiverTool) GetHandler() server.ToolHandlerFunc {
 		}
 
 		// No duplicate found, proceed with creation
+		email, _ := args["email"].(string)
+		phone, _ := args["phone"].(string)
+		address, _ := args["address"].(string)
+		bankAccount, _ := args["bank_account"].(string)
+		taxID, _ := args["tax_id"].(string)
 		receiver := &models.InvoiceReceiver{
 			Name:           name,
 			IsOrganization: isOrganization,
+			Email:          email,
+			Phone:          phone,
+			Address:        address,
+			BankAccount:    bankAccount,
+			TaxID:          taxID,
 		}
 
 		if err := t.service.CreateReceiver(userID, receiver); err != nil {
@@ -90,11 +109,16 @@ func (t *ListReceiversTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
 		keyword, _ := args["keyword"].(string)
-		limit := getIntArg(args, "limit", 50)
-		offset := getIntArg(args, "offset", 0)
+		limit, offset, err := getPaginationArgs(args, 50)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 
 		receivers, total, err := t.service.ListReceivers(userID, keyword, limit, offset)
 		if err != nil {
@@ -133,9 +157,15 @@ func (t *GetReceiverTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
-		receiverID := getUintArg(args, "receiver_id")
+		receiverID, err := getUintArg(args, "receiver_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if receiverID == 0 {
 			return mcp.NewToolResultError("receiver_id is required"), nil
 		}
@@ -161,11 +191,16 @@ func NewUpdateReceiverTool(service services.ReceiverService) *UpdateReceiverTool
 
 func (t *UpdateReceiverTool) GetTool() mcp.Tool {
 	return mcp.NewTool("update_receiver",
-		mcp.WithDescription("Update an existing receiver. Can modify name, organization status, and alternative names (aliases)."),
+		mcp.WithDescription("Update an existing receiver. Can modify name, organization status, alternative names (aliases), and contact and bank details (an empty string clears a detail)."),
 		mcp.WithNumber("receiver_id", mcp.Required(), mcp.Description("Receiver ID")),
 		mcp.WithString("name", mcp.Description("Receiver name")),
 		mcp.WithBoolean("is_organization", mcp.Description("Whether the receiver is an organization")),
 		mcp.WithArray("other_names", mcp.Description("Alternative names/aliases for the receiver (from merged receivers)"), mcp.Items(map[string]any{"type": "string"})),
+		mcp.WithString("email", mcp.Description("Contact email, e.g. billing@example.com")),
+		mcp.WithString("phone", mcp.Description("Phone number, e.g. +1 555-0100")),
+		mcp.WithString("address", mcp.Description("Postal address")),
+		mcp.WithString("bank_account", mcp.Description("Bank account details for payments (e.g. IBAN)")),
+		mcp.WithString("tax_id", mcp.Description("Tax ID or VAT number")),
 	)
 }
 
@@ -175,9 +210,15 @@ func (t *UpdateReceiverTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
-		receiverID := getUintArg(args, "receiver_id")
+		receiverID, err := getUintArg(args, "receiver_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if receiverID == 0 {
 			return mcp.NewToolResultError("receiver_id is required"), nil
 		}
@@ -207,6 +248,23 @@ func (t *UpdateReceiverTool) GetHandler() server.ToolHandlerFunc {
 			existing.OtherNames = otherNames
 		}
 
+		// Contact details are updated when present; an empty string clears them
+		if email, ok := args["email"].(string); ok {
+			existing.Email = email
+		}
+		if phone, ok := args["phone"].(string); ok {
+			existing.Phone = phone
+		}
+		if address, ok := args["address"].(string); ok {
+			existing.Address = address
+		}
+		if bankAccount, ok := args["bank_account"].(string); ok {
+			existing.BankAccount = bankAccount
+		}
+		if taxID, ok := args["tax_id"].(string); ok {
+			existing.TaxID = taxID
+		}
+
 		if err := t.service.UpdateReceiver(userID, existing); err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to update receiver: %v", err)), nil
 		}
@@ -228,8 +286,9 @@ func NewDeleteReceiverTool(service services.ReceiverService) *DeleteReceiverTool
 
 func (t *DeleteReceiverTool) GetTool() mcp.Tool {
 	return mcp.NewTool("delete_receiver",
-		mcp.WithDescription("Delete a receiver"),
+		mcp.WithDescription("Delete a receiver. Fails with the number of affected invoices while invoices reference it; pass force to remove the receiver from them and delete it anyway"),
 		mcp.WithNumber("receiver_id", mcp.Required(), mcp.Description("Receiver ID")),
+		mcp.WithBoolean("force", mcp.Description("Remove the receiver from the invoices referencing it instead of failing (default: false)")),
 	)
 }
 
@@ -239,14 +298,20 @@ func (t *DeleteReceiverTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
-		receiverID := getUintArg(args, "receiver_id")
+		receiverID, err := getUintArg(args, "receiver_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if receiverID == 0 {
 			return mcp.NewToolResultError("receiver_id is required"), nil
 		}
 
-		if err := t.service.DeleteReceiver(userID, receiverID); err != nil {
+		if err := t.service.DeleteReceiver(userID, receiverID, getBoolArg(args, "force", false)); err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to delete receiver: %v", err)), nil
 		}
 
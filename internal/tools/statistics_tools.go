@@ -9,6 +9,7 @@ import (
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/rxtech-lab/invoice-management/internal/models"
 	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
 )
 
 // InvoiceStatisticsTool handles invoice statistics queries
@@ -31,21 +32,48 @@ EXAMPLE QUERIES:
 - "Show electricity invoices last month" → invoice_statistics(period: "last_month", keyword: "electricity")
 - "Compare spending by category" → invoice_statistics(period: "last_month", group_by: "category")
 - "Which company did I pay most to?" → invoice_statistics(period: "last_year", group_by: "company")
+- "Top 5 vendors this year, everyone else combined" → invoice_statistics(period: "last_year", group_by: "company", top_n: 5, include_others: true)
 - "Daily electricity costs last month" → invoice_statistics(period: "last_month", keyword: "electricity", group_by: "day")
+- "Quarterly spending over the past year" → invoice_statistics(period: "last_year", group_by: "quarter")
 - "Highest electricity bill last year" → invoice_statistics(period: "last_year", keyword: "electricity", include_aggregations: true)
-
-PERIODS: last_day, last_week, last_month, last_year, or custom days
-GROUPING: day (for charts), week, month, category, company, receiver
-FILTERS: category_id, company_id, receiver_id, status (paid/unpaid/overdue), keyword`),
+- "What was my smallest bill last month?" → invoice_statistics(period: "last_month", include_aggregations: true) (see min_invoice)
+- "What's my average daily spend this week?" → invoice_statistics(period: "last_week") (see daily_average and projected_month_end)
+- "Spending by vendor after refunds" → invoice_statistics(period: "last_year", group_by: "company", net_refunds: true)
+- "How much went on each card this month?" → invoice_statistics(period: "last_month", group_by: "payment_method")
+- "Travel spending except flights" → invoice_statistics(period: "last_year", keyword: "travel", exclude_keyword: "flight")
+- "How much did I spend in March 2023?" → invoice_statistics(start_date: "2023-03-01T00:00:00Z", end_date: "2023-03-31T23:59:59Z")
+- "Travel spending by category this year" → invoice_statistics(period: "last_year", tag_ids: [<travel tag ID>], group_by: "category")
+
+PERIODS: last_day, last_week, last_month, last_year, or custom days; or an explicit start_date and end_date (RFC3339, set
+together), which take precedence over period and days
+GROUPING: day (for charts), week, month, quarter, category, company, receiver, payment_method
+(invoices without a payment method are grouped as "Unspecified")
+FILTERS: category_id, company_id, receiver_id, status (paid/unpaid/overdue), keyword, exclude_keyword, tag_ids (invoices
+with any of the tags, or all of them with tag_match: "all"; see list_tags for IDs)
+DATE FIELD: which invoice date the period and day/week/month/quarter grouping use. By default the due date, falling back to
+the creation date; use "invoice_started_at" to count spend in its billing period. Invoices without the chosen date
+are left out and counted in excluded_count.
+REFUNDS: with net_refunds, invoices linked as a refund or credit note (see link_invoices) count as negative amounts.
+TIMEZONE: day grouping uses the user's timezone setting, or the timezone argument, so late-night spend lands on the local day.`),
 		mcp.WithString("period", mcp.Description("Natural time period: 'last_day', 'last_week', 'last_month', 'last_year'. Default: 'last_month'")),
 		mcp.WithNumber("days", mcp.Description("Custom days lookback (e.g., 90 for last 90 days)")),
+		mcp.WithString("start_date", mcp.Description("Start of an explicit date range (RFC3339, e.g. '2023-03-01T00:00:00Z'); requires end_date and overrides period/days")),
+		mcp.WithString("end_date", mcp.Description("End of an explicit date range (RFC3339, e.g. '2023-03-31T23:59:59Z'); requires start_date")),
 		mcp.WithNumber("category_id", mcp.Description("Filter by category ID")),
 		mcp.WithNumber("company_id", mcp.Description("Filter by company ID")),
 		mcp.WithNumber("receiver_id", mcp.Description("Filter by receiver ID")),
+		mcp.WithArray("tag_ids", mcp.Description("Only count invoices with these tag IDs (see tag_match)"), mcp.Items(map[string]any{"type": "number"})),
+		mcp.WithString("tag_match", mcp.Description("With tag_ids: 'any' (invoices with at least one of the tags, default) or 'all' (invoices with every tag)")),
 		mcp.WithString("status", mcp.Description("Filter by status: 'paid', 'unpaid', 'overdue'")),
 		mcp.WithString("keyword", mcp.Description("Search keyword for title/description (e.g., 'electricity', 'consulting')")),
-		mcp.WithString("group_by", mcp.Description("Group results by: 'day' (for charts), 'week', 'month', 'category', 'company', 'receiver'")),
-		mcp.WithBoolean("include_aggregations", mcp.Description("Include min/max/avg amounts and references to max invoice (default: false)")),
+		mcp.WithString("exclude_keyword", mcp.Description("Leave out invoices whose title/description contains this keyword (e.g., 'flight'); combined with keyword using AND")),
+		mcp.WithString("group_by", mcp.Description("Group results by: 'day' (for charts), 'week', 'month', 'quarter', 'category', 'company', 'receiver', 'payment_method'")),
+		mcp.WithBoolean("include_aggregations", mcp.Description("Include min/max/avg amounts and references to the max and min invoice, plus the max/min day, category, or company for those groupings (default: false)")),
+		mcp.WithString("date_field", mcp.Description("Date to filter and group on: 'created_at', 'invoice_started_at' (billing period), or 'due_date'. Default: due date, falling back to created_at")),
+		mcp.WithNumber("top_n", mcp.Description("For category, company, receiver, or payment_method grouping: only return the N groups with the highest amount")),
+		mcp.WithBoolean("include_others", mcp.Description("With top_n: sum the remaining groups into a single 'Other' breakdown item (default: false)")),
+		mcp.WithBoolean("net_refunds", mcp.Description("Subtract refunds and credit notes from the totals instead of counting them as spend (default: false)")),
+		mcp.WithString("timezone", mcp.Description("IANA timezone days are grouped in for group_by 'day' (e.g., 'Asia/Hong_Kong'). Default: the user's timezone setting (UTC unless set)")),
 	)
 }
 
@@ -55,17 +83,48 @@ func (t *InvoiceStatisticsTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
 
 		// Build options
 		opts := services.StatisticsOptions{
 			Period:              services.PeriodLastMonth, // Default
-			CategoryID:          getUintPtrArg(args, "category_id"),
-			CompanyID:           getUintPtrArg(args, "company_id"),
-			ReceiverID:          getUintPtrArg(args, "receiver_id"),
 			Keyword:             getStringArg(args, "keyword"),
+			ExcludeKeyword:      getStringArg(args, "exclude_keyword"),
 			IncludeAggregations: getBoolArg(args, "include_aggregations", false),
+			OthersBucket:        getBoolArg(args, "include_others", false),
+			NetRefunds:          getBoolArg(args, "net_refunds", false),
+			Timezone:            getStringArg(args, "timezone"),
+		}
+
+		var err error
+		if opts.CategoryID, err = getUintPtrArg(args, "category_id"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if opts.CompanyID, err = getUintPtrArg(args, "company_id"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if opts.ReceiverID, err = getUintPtrArg(args, "receiver_id"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if opts.TagIDs, err = getUintArrayArg(args, "tag_ids"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if opts.Limit, err = getIntArg(args, "top_n", 0); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		days, err := getIntArg(args, "days", 0)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if opts.StartDate, err = getTimeArg(args, "start_date"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if opts.EndDate, err = getTimeArg(args, "end_date"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
 		}
 
 		// Handle status parameter
@@ -85,6 +144,13 @@ func (t *InvoiceStatisticsTool) GetHandler() server.ToolHandlerFunc {
 			}
 		}
 
+		if tagMatch := getStringArg(args, "tag_match"); tagMatch != "" {
+			if tagMatch != services.TagMatchAny && tagMatch != services.TagMatchAll {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid tag_match '%s'. Valid values: any, all", tagMatch)), nil
+			}
+			opts.TagMatch = tagMatch
+		}
+
 		// Handle period parameter
 		periodStr := getStringArg(args, "period")
 		if periodStr != "" {
@@ -100,11 +166,15 @@ func (t *InvoiceStatisticsTool) GetHandler() server.ToolHandlerFunc {
 			default:
 				return mcp.NewToolResultError(fmt.Sprintf("Invalid period '%s'. Valid values: last_day, last_week, last_month, last_year", periodStr)), nil
 			}
-		} else if days := getIntArg(args, "days", 0); days > 0 {
+		} else if days > 0 {
 			// Use custom days if period not specified
 			opts.Period = services.PeriodCustom
 			opts.Days = days
 		}
+		if opts.StartDate != nil || opts.EndDate != nil {
+			// An explicit date range replaces the period
+			opts.Period = services.PeriodCustom
+		}
 
 		// Handle group_by parameter
 		groupByStr := getStringArg(args, "group_by")
@@ -116,14 +186,33 @@ func (t *InvoiceStatisticsTool) GetHandler() server.ToolHandlerFunc {
 				opts.GroupBy = services.GroupByWeek
 			case "month":
 				opts.GroupBy = services.GroupByMonth
+			case "quarter":
+				opts.GroupBy = services.GroupByQuarter
 			case "category":
 				opts.GroupBy = services.GroupByCategory
 			case "company":
 				opts.GroupBy = services.GroupByCompany
 			case "receiver":
 				opts.GroupBy = services.GroupByReceiver
+			case "payment_method":
+				opts.GroupBy = services.GroupByPaymentMethod
 			default:
-				return mcp.NewToolResultError(fmt.Sprintf("Invalid group_by '%s'. Valid values: day, week, month, category, company, receiver", groupByStr)), nil
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid group_by '%s'. Valid values: day, week, month, quarter, category, company, receiver, payment_method", groupByStr)), nil
+			}
+		}
+
+		// Handle date_field parameter
+		dateFieldStr := getStringArg(args, "date_field")
+		if dateFieldStr != "" {
+			switch dateFieldStr {
+			case "created_at":
+				opts.DateField = services.DateFieldCreatedAt
+			case "invoice_started_at":
+				opts.DateField = services.DateFieldInvoiceStartedAt
+			case "due_date":
+				opts.DateField = services.DateFieldDueDate
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid date_field '%s'. Valid values: created_at, invoice_started_at, due_date", dateFieldStr)), nil
 			}
 		}
 
@@ -183,10 +272,12 @@ EXAMPLE QUERIES:
 - "AWS bills in 2024" → keyword: "AWS"
 - "Show all food and dining expenses" → category_name: "food"
 - "What did I pay to John?" → receiver_name: "John"
+- "All travel except flights" → tag_names: ["travel"], exclude_keyword: "flight"
 
 Searches across: invoice title, description, category name, company name, receiver name, tag names.
 Returns: matched invoices, total amount, aggregation stats (min/max/avg).`),
 		mcp.WithString("keyword", mcp.Description("Search keyword for title, description")),
+		mcp.WithString("exclude_keyword", mcp.Description("Leave out invoices whose title or description contains this keyword; combined with keyword using AND")),
 		mcp.WithString("category_name", mcp.Description("Filter by category name (partial match)")),
 		mcp.WithString("company_name", mcp.Description("Filter by company name (partial match)")),
 		mcp.WithString("receiver_name", mcp.Description("Filter by receiver name (partial match)")),
@@ -205,16 +296,26 @@ func (t *AdvancedInvoiceSearchTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
 
 		// Extract parameters
 		keyword := getStringArg(args, "keyword")
+		excludeKeyword := getStringArg(args, "exclude_keyword")
 		categoryName := getStringArg(args, "category_name")
 		companyName := getStringArg(args, "company_name")
 		receiverName := getStringArg(args, "receiver_name")
-		limit := getIntArg(args, "limit", 50)
-		offset := getIntArg(args, "offset", 0)
+		limit, offset, err := getPaginationArgs(args, 50)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		days, err := getIntArg(args, "days", 0)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		groupByDay := getBoolArg(args, "group_by_day", false)
 
 		// Extract tag names
@@ -263,15 +364,16 @@ func (t *AdvancedInvoiceSearchTool) GetHandler() server.ToolHandlerFunc {
 
 		// Build invoice list options
 		invoiceOpts := services.InvoiceListOptions{
-			Keyword:    keyword,
-			CategoryID: categoryID,
-			CompanyID:  companyID,
-			ReceiverID: receiverID,
-			TagIDs:     tagIDs,
-			Limit:      limit,
-			Offset:     offset,
-			SortBy:     "due_date",
-			SortOrder:  "desc",
+			Keyword:        keyword,
+			ExcludeKeyword: excludeKeyword,
+			CategoryID:     categoryID,
+			CompanyID:      companyID,
+			ReceiverID:     receiverID,
+			TagIDs:         tagIDs,
+			Limit:          limit,
+			Offset:         offset,
+			SortBy:         "due_date",
+			SortOrder:      "desc",
 		}
 
 		// Get matching invoices
@@ -320,13 +422,14 @@ func (t *AdvancedInvoiceSearchTool) GetHandler() server.ToolHandlerFunc {
 		// If group by day is requested, get statistics
 		if groupByDay {
 			statsOpts := services.StatisticsOptions{
-				Period:     services.PeriodLastMonth, // Default
-				CategoryID: categoryID,
-				CompanyID:  companyID,
-				ReceiverID: receiverID,
-				TagIDs:     tagIDs,
-				Keyword:    keyword,
-				GroupBy:    services.GroupByDay,
+				Period:         services.PeriodLastMonth, // Default
+				CategoryID:     categoryID,
+				CompanyID:      companyID,
+				ReceiverID:     receiverID,
+				TagIDs:         tagIDs,
+				Keyword:        keyword,
+				ExcludeKeyword: excludeKeyword,
+				GroupBy:        services.GroupByDay,
 			}
 
 			// Handle period parameter
@@ -340,7 +443,7 @@ func (t *AdvancedInvoiceSearchTool) GetHandler() server.ToolHandlerFunc {
 				case "last_year":
 					statsOpts.Period = services.PeriodLastYear
 				}
-			} else if days := getIntArg(args, "days", 0); days > 0 {
+			} else if days > 0 {
 				statsOpts.Period = services.PeriodCustom
 				statsOpts.Days = days
 			}
@@ -355,3 +458,442 @@ func (t *AdvancedInvoiceSearchTool) GetHandler() server.ToolHandlerFunc {
 		return mcp.NewToolResultText(string(result)), nil
 	}
 }
+
+// ReceiverDetailTool handles statistics for a single receiver
+type ReceiverDetailTool struct {
+	service services.AnalyticsService
+}
+
+func NewReceiverDetailTool(service services.AnalyticsService) *ReceiverDetailTool {
+	return &ReceiverDetailTool{service: service}
+}
+
+func (t *ReceiverDetailTool) GetTool() mcp.Tool {
+	return mcp.NewTool("receiver_detail",
+		mcp.WithDescription(`Get a full picture of one receiver (vendor): total billed, paid vs unpaid, invoice count,
+first and last invoice date, average amount, and the 5 largest invoices. All amounts are in the user's base currency (USD unless configured).
+
+EXAMPLE QUERIES:
+- "How much have I paid Marriott this year?" → receiver_detail(receiver_id: 3, period: "last_year")
+- "What are the biggest invoices from this vendor?" → receiver_detail(receiver_id: 3)`),
+		mcp.WithNumber("receiver_id", mcp.Required(), mcp.Description("Receiver ID")),
+		mcp.WithString("period", mcp.Description("Natural time period: 'last_day', 'last_week', 'last_month', 'last_year'. Default: 'last_month'")),
+	)
+}
+
+func (t *ReceiverDetailTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		receiverID, err := getUintArg(args, "receiver_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if receiverID == 0 {
+			return mcp.NewToolResultError("receiver_id is required"), nil
+		}
+
+		period := services.PeriodLastMonth
+		if periodStr := getStringArg(args, "period"); periodStr != "" {
+			switch periodStr {
+			case "last_day":
+				period = services.PeriodLastDay
+			case "last_week":
+				period = services.PeriodLastWeek
+			case "last_month":
+				period = services.PeriodLastMonth
+			case "last_year":
+				period = services.PeriodLastYear
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid period '%s'. Valid values: last_day, last_week, last_month, last_year", periodStr)), nil
+			}
+		}
+
+		detail, err := t.service.GetReceiverDetail(userID, receiverID, period)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get receiver detail: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(detail)
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
+// CompanyBreakdownTool rolls a company's spending up across its receivers
+type CompanyBreakdownTool struct {
+	service services.AnalyticsService
+}
+
+func NewCompanyBreakdownTool(service services.AnalyticsService) *CompanyBreakdownTool {
+	return &CompanyBreakdownTool{service: service}
+}
+
+func (t *CompanyBreakdownTool) GetTool() mcp.Tool {
+	return mcp.NewTool("company_breakdown",
+		mcp.WithDescription(`Roll a company up across its receivers: the company's total for the period, split by receiver
+and by category. Invoices of the company without a receiver are grouped under "Unspecified". All amounts are in the user's base currency (USD unless configured).
+
+EXAMPLE QUERIES:
+- "How much did Acme cost us last year, per vendor?" → company_breakdown(company_id: 2, period: "last_year")
+- "What categories does this company's spending fall into?" → company_breakdown(company_id: 2)`),
+		mcp.WithNumber("company_id", mcp.Required(), mcp.Description("Company ID")),
+		mcp.WithString("period", mcp.Description("Natural time period: 'last_day', 'last_week', 'last_month', 'last_year'. Default: 'last_month'")),
+	)
+}
+
+func (t *CompanyBreakdownTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		companyID, err := getUintArg(args, "company_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if companyID == 0 {
+			return mcp.NewToolResultError("company_id is required"), nil
+		}
+
+		period := services.PeriodLastMonth
+		if periodStr := getStringArg(args, "period"); periodStr != "" {
+			switch periodStr {
+			case "last_day":
+				period = services.PeriodLastDay
+			case "last_week":
+				period = services.PeriodLastWeek
+			case "last_month":
+				period = services.PeriodLastMonth
+			case "last_year":
+				period = services.PeriodLastYear
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid period '%s'. Valid values: last_day, last_week, last_month, last_year", periodStr)), nil
+			}
+		}
+
+		breakdown, err := t.service.GetCompanyBreakdown(userID, companyID, period)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get company breakdown: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(breakdown)
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
+// CurrencyExposureTool reports spending grouped by invoice currency
+type CurrencyExposureTool struct {
+	service services.AnalyticsService
+}
+
+func NewCurrencyExposureTool(service services.AnalyticsService) *CurrencyExposureTool {
+	return &CurrencyExposureTool{service: service}
+}
+
+func (t *CurrencyExposureTool) GetTool() mcp.Tool {
+	return mcp.NewTool("currency_exposure",
+		mcp.WithDescription(`Show FX exposure: the period's invoices grouped by their currency.
+For each currency returns the summed original amount (in that currency), the target_amount in the user's base currency (USD unless configured) with its paid/unpaid split, the invoice count, and its percentage of the total base-currency value.
+
+EXAMPLE QUERIES:
+- "How much of my spending is in foreign currencies?" → currency_exposure(period: "1y")
+- "What's my EUR exposure this month?" → currency_exposure(period: "1m")`),
+		mcp.WithString("period", mcp.Description("Time period: '7d', '1m', or '1y'. Default: '1m'")),
+	)
+}
+
+func (t *CurrencyExposureTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+
+		period := services.Period1Month
+		if periodStr := getStringArg(args, "period"); periodStr != "" {
+			switch services.AnalyticsPeriod(periodStr) {
+			case services.Period7Days, services.Period1Month, services.Period1Year:
+				period = services.AnalyticsPeriod(periodStr)
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid period '%s'. Valid values: 7d, 1m, 1y", periodStr)), nil
+			}
+		}
+
+		exposure, err := t.service.GetCurrencyExposure(userID, period)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get currency exposure: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(exposure)
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
+// ForecastSpendingTool projects spending for the next period from recent history
+type ForecastSpendingTool struct {
+	service services.AnalyticsService
+}
+
+func NewForecastSpendingTool(service services.AnalyticsService) *ForecastSpendingTool {
+	return &ForecastSpendingTool{service: service}
+}
+
+func (t *ForecastSpendingTool) GetTool() mcp.Tool {
+	return mcp.NewTool("forecast_spending",
+		mcp.WithDescription(`Project total spending for the next period as the moving average of the last N equivalent periods.
+Returns projected_total, the sampled windows, and min/max of those windows as a rough range. All amounts are in the user's base currency (USD unless configured).
+
+This is a heuristic, not a statistical forecast: it assumes spending recurs at a steady rate and ignores trends, seasonality, and one-off purchases.
+
+EXAMPLE QUERIES:
+- "How much will I probably spend next month?" → forecast_spending(period: "1m")
+- "Estimate next week's spending from the last 8 weeks" → forecast_spending(period: "7d", windows: 8)`),
+		mcp.WithString("period", mcp.Description("Window length: '7d', '1m', or '1y'. Default: '1m'")),
+		mcp.WithNumber("windows", mcp.Description(fmt.Sprintf("Number of past windows to average (default: %d, max: %d)", services.DefaultForecastWindows, services.MaxForecastWindows))),
+	)
+}
+
+func (t *ForecastSpendingTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+
+		period := services.Period1Month
+		if periodStr := getStringArg(args, "period"); periodStr != "" {
+			switch services.AnalyticsPeriod(periodStr) {
+			case services.Period7Days, services.Period1Month, services.Period1Year:
+				period = services.AnalyticsPeriod(periodStr)
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid period '%s'. Valid values: 7d, 1m, 1y", periodStr)), nil
+			}
+		}
+
+		windows, err := getIntArg(args, "windows", services.DefaultForecastWindows)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		forecast, err := t.service.ForecastNextPeriodWithWindows(userID, period, windows)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to forecast spending: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(forecast)
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
+// DetectSpendingAnomaliesTool flags unusually large invoices compared to their category or receiver
+type DetectSpendingAnomaliesTool struct {
+	service services.AnalyticsService
+}
+
+func NewDetectSpendingAnomaliesTool(service services.AnalyticsService) *DetectSpendingAnomaliesTool {
+	return &DetectSpendingAnomaliesTool{service: service}
+}
+
+func (t *DetectSpendingAnomaliesTool) GetTool() mcp.Tool {
+	return mcp.NewTool("detect_spending_anomalies",
+		mcp.WithDescription(`Flag unusually large invoices: within each category (or receiver), invoices whose amount is above mean + k * standard deviation of that group over the period.
+Returns the anomalies (largest z_score first) and the baseline (sample size, mean, std_dev, threshold) of every evaluated group. Groups with fewer than min_samples invoices are skipped and counted in skipped_groups. Amounts are in the user's base currency (USD unless configured); refunds and credit notes are ignored.
+
+EXAMPLE QUERIES:
+- "Were any of my bills unusually high this year?" → detect_spending_anomalies(period: "1y")
+- "Which vendor charged me more than usual?" → detect_spending_anomalies(group_by: "receiver")`),
+		mcp.WithString("period", mcp.Description("Period sampled and checked: '7d', '1m', or '1y'. Default: '1y'")),
+		mcp.WithString("group_by", mcp.Description("Compare invoices within each 'category' (default) or 'receiver'")),
+		mcp.WithNumber("k", mcp.Description(fmt.Sprintf("Standard deviations above the mean that count as unusual; must be greater than 0 (default: %g)", services.DefaultAnomalyThreshold))),
+		mcp.WithNumber("min_samples", mcp.Description(fmt.Sprintf("Minimum invoices in a group before it is checked (default: %d)", services.DefaultAnomalyMinSamples))),
+	)
+}
+
+func (t *DetectSpendingAnomaliesTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+
+		period := services.Period1Year
+		if periodStr := getStringArg(args, "period"); periodStr != "" {
+			switch services.AnalyticsPeriod(periodStr) {
+			case services.Period7Days, services.Period1Month, services.Period1Year:
+				period = services.AnalyticsPeriod(periodStr)
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid period '%s'. Valid values: 7d, 1m, 1y", periodStr)), nil
+			}
+		}
+
+		// The service reads a zero k or min_samples as unset, so explicit zeros are rejected here
+		// rather than silently replaced by the defaults
+		k, err := getFloatArg(args, "k", services.DefaultAnomalyThreshold)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if k <= 0 {
+			return mcp.NewToolResultError("k must be greater than 0"), nil
+		}
+		minSamples, err := getIntArg(args, "min_samples", services.DefaultAnomalyMinSamples)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if minSamples < 2 {
+			return mcp.NewToolResultError("min_samples must be at least 2"), nil
+		}
+
+		anomalies, err := t.service.DetectAnomalies(userID, period, services.AnomalyOptions{
+			GroupBy:    services.AnomalyGroupBy(getStringArg(args, "group_by")),
+			K:          k,
+			MinSamples: minSamples,
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to detect spending anomalies: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(anomalies)
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
+// MonthlyTrendTool reports month-by-month totals for the last N months
+type MonthlyTrendTool struct {
+	service services.AnalyticsService
+}
+
+func NewMonthlyTrendTool(service services.AnalyticsService) *MonthlyTrendTool {
+	return &MonthlyTrendTool{service: service}
+}
+
+func (t *MonthlyTrendTool) GetTool() mcp.Tool {
+	return mcp.NewTool("monthly_trend",
+		mcp.WithDescription(`Get one row per calendar month for the last N months including the current one, oldest first: month (YYYY-MM), total_amount, paid_amount, unpaid_amount (unpaid and overdue), and invoice_count.
+Months without invoices are included with zeros. Months follow the user's timezone setting and invoices are placed by due date, falling back to creation date. All amounts are item target amounts in the user's base currency (USD unless configured).
+
+EXAMPLE QUERIES:
+- "Show my last 12 months month by month" → monthly_trend(months: 12)
+- "How did spending develop over the last two years?" → monthly_trend(months: 24)`),
+		mcp.WithNumber("months", mcp.Description(fmt.Sprintf("Number of months (default: %d, max: %d)", services.DefaultTrendMonths, services.MaxTrendMonths))),
+	)
+}
+
+func (t *MonthlyTrendTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		months, err := getIntArg(args, "months", services.DefaultTrendMonths)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if months < 1 {
+			return mcp.NewToolResultError("months must be at least 1"), nil
+		}
+
+		points, err := t.service.GetMonthlyTrend(userID, months)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get monthly trend: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(map[string]interface{}{"data": points})
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
+// SpendingByWeekdayTool reports spending summed by day of the week
+type SpendingByWeekdayTool struct {
+	service services.AnalyticsService
+}
+
+func NewSpendingByWeekdayTool(service services.AnalyticsService) *SpendingByWeekdayTool {
+	return &SpendingByWeekdayTool{service: service}
+}
+
+func (t *SpendingByWeekdayTool) GetTool() mcp.Tool {
+	return mcp.NewTool("spending_by_weekday",
+		mcp.WithDescription(`Show the period's spending by day of the week.
+Returns all seven weekdays in Monday-first order, each with the summed amount in the user's base currency (USD unless configured) and the invoice count; days without invoices have zero amounts. Invoices are placed on the weekday their date falls on in the user's timezone.
+
+EXAMPLE QUERIES:
+- "Which day of the week do I spend the most on?" → spending_by_weekday(period: "1y")
+- "Do my bills cluster on Mondays?" → spending_by_weekday(period: "1y", date_field: "due_date")`),
+		mcp.WithString("period", mcp.Description("Time period: '7d', '1m', or '1y'. Default: '1m'")),
+		mcp.WithString("date_field", mcp.Description("Date to place invoices by: 'created_at', 'invoice_started_at' (billing period), or 'due_date'. Default: due date, falling back to created_at")),
+	)
+}
+
+func (t *SpendingByWeekdayTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+
+		period := services.Period1Month
+		if periodStr := getStringArg(args, "period"); periodStr != "" {
+			switch services.AnalyticsPeriod(periodStr) {
+			case services.Period7Days, services.Period1Month, services.Period1Year:
+				period = services.AnalyticsPeriod(periodStr)
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid period '%s'. Valid values: 7d, 1m, 1y", periodStr)), nil
+			}
+		}
+
+		dateField := services.DateFieldDefault
+		if dateFieldStr := getStringArg(args, "date_field"); dateFieldStr != "" {
+			switch services.StatisticsDateField(dateFieldStr) {
+			case services.DateFieldCreatedAt, services.DateFieldInvoiceStartedAt, services.DateFieldDueDate:
+				dateField = services.StatisticsDateField(dateFieldStr)
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid date_field '%s'. Valid values: created_at, invoice_started_at, due_date", dateFieldStr)), nil
+			}
+		}
+
+		spending, err := t.service.GetByWeekday(userID, period, dateField)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get spending by weekday: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(spending)
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/rxtech-lab/invoice-management/internal/models"
 	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
 )
 
 // CreateTagTool handles tag creation
@@ -24,7 +26,8 @@ func (t *CreateTagTool) GetTool() mcp.Tool {
 	return mcp.NewTool("create_tag",
 		mcp.WithDescription("Create a new invoice tag"),
 		mcp.WithString("name", mcp.Required(), mcp.Description("Tag name"), mcp.MaxLength(100)),
-		mcp.WithString("color", mcp.Description("Hex color code (e.g., #FF5733). Please use different colors for different tags.")),
+		mcp.WithString("color", mcp.Description("Hex color code (e.g., #FF5733). If omitted, an unused color from a built-in palette is assigned.")),
+		mcp.WithNumber("parent_id", mcp.Description("ID of the tag to nest this tag under. Omit for a top-level tag.")),
 	)
 }
 
@@ -34,19 +37,26 @@ func (t *CreateTagTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
 		name, _ := args["name"].(string)
 		color, _ := args["color"].(string)
 
-		if color == "" {
-			color = "#6B7280" // Default gray color
+		parentID, err := getUintArg(args, "parent_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
 		}
 
 		tag := &models.InvoiceTag{
 			Name:  name,
 			Color: color,
 		}
+		if parentID != 0 {
+			tag.ParentID = &parentID
+		}
 
 		if err := t.service.CreateTag(userID, tag); err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to create tag: %v", err)), nil
@@ -81,11 +91,16 @@ func (t *ListTagsTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
 		keyword, _ := args["keyword"].(string)
-		limit := getIntArg(args, "limit", 50)
-		offset := getIntArg(args, "offset", 0)
+		limit, offset, err := getPaginationArgs(args, 50)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 
 		tags, total, err := t.service.ListTags(userID, keyword, limit, offset)
 		if err != nil {
@@ -124,9 +139,15 @@ func (t *GetTagTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
-		tagID := getUintArg(args, "tag_id")
+		tagID, err := getUintArg(args, "tag_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if tagID == 0 {
 			return mcp.NewToolResultError("tag_id is required"), nil
 		}
@@ -156,6 +177,7 @@ func (t *UpdateTagTool) GetTool() mcp.Tool {
 		mcp.WithNumber("tag_id", mcp.Required(), mcp.Description("Tag ID")),
 		mcp.WithString("name", mcp.Description("New tag name")),
 		mcp.WithString("color", mcp.Description("New hex color code")),
+		mcp.WithNumber("parent_id", mcp.Description("ID of the tag to nest this tag under (omit to keep the current parent, 0 to make it a top-level tag). A tag can't be nested under itself or one of its descendants.")),
 	)
 }
 
@@ -165,9 +187,15 @@ func (t *UpdateTagTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
-		tagID := getUintArg(args, "tag_id")
+		tagID, err := getUintArg(args, "tag_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if tagID == 0 {
 			return mcp.NewToolResultError("tag_id is required"), nil
 		}
@@ -185,6 +213,16 @@ func (t *UpdateTagTool) GetHandler() server.ToolHandlerFunc {
 		if color, ok := args["color"].(string); ok && color != "" {
 			tag.Color = color
 		}
+		if _, ok := args["parent_id"]; ok {
+			parentID, err := getUintArg(args, "parent_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			tag.ParentID = nil
+			if parentID != 0 {
+				tag.ParentID = &parentID
+			}
+		}
 
 		if err := t.service.UpdateTag(userID, tag); err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to update tag: %v", err)), nil
@@ -217,9 +255,15 @@ func (t *DeleteTagTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
-		tagID := getUintArg(args, "tag_id")
+		tagID, err := getUintArg(args, "tag_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if tagID == 0 {
 			return mcp.NewToolResultError("tag_id is required"), nil
 		}
@@ -255,10 +299,19 @@ func (t *AddTagToInvoiceTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
-		invoiceID := getUintArg(args, "invoice_id")
-		tagID := getUintArg(args, "tag_id")
+		invoiceID, err := getUintArg(args, "invoice_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		tagID, err := getUintArg(args, "tag_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 
 		if invoiceID == 0 {
 			return mcp.NewToolResultError("invoice_id is required"), nil
@@ -298,10 +351,19 @@ func (t *RemoveTagFromInvoiceTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
-		invoiceID := getUintArg(args, "invoice_id")
-		tagID := getUintArg(args, "tag_id")
+		invoiceID, err := getUintArg(args, "invoice_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		tagID, err := getUintArg(args, "tag_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 
 		if invoiceID == 0 {
 			return mcp.NewToolResultError("invoice_id is required"), nil
@@ -342,15 +404,23 @@ func (t *SearchInvoicesByTagsTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
-		tagID := getUintArg(args, "tag_id")
+		tagID, err := getUintArg(args, "tag_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if tagID == 0 {
 			return mcp.NewToolResultError("tag_id is required"), nil
 		}
 
-		limit := getIntArg(args, "limit", 50)
-		offset := getIntArg(args, "offset", 0)
+		limit, offset, err := getPaginationArgs(args, 50)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 
 		invoices, total, err := t.service.GetInvoicesByTagID(userID, tagID, limit, offset)
 		if err != nil {
@@ -367,6 +437,225 @@ func (t *SearchInvoicesByTagsTool) GetHandler() server.ToolHandlerFunc {
 	}
 }
 
+// BulkTagInvoicesTool handles adding tags to every invoice matching a filter
+type BulkTagInvoicesTool struct {
+	service services.InvoiceService
+}
+
+func NewBulkTagInvoicesTool(service services.InvoiceService) *BulkTagInvoicesTool {
+	return &BulkTagInvoicesTool{service: service}
+}
+
+func (t *BulkTagInvoicesTool) GetTool() mcp.Tool {
+	return mcp.NewTool("bulk_tag_invoices",
+		mcp.WithDescription("Add tags to every invoice matching the filters (same filters as list_invoices, without pagination). Invoices that already have a tag are left unchanged. Use list_invoices first with the same filters to preview which invoices will be tagged."),
+		mcp.WithArray("tag_ids", mcp.Required(), mcp.Description("IDs of tags to add"), mcp.Items(map[string]any{"type": "number"})),
+		mcp.WithString("keyword", mcp.Description("Search keyword")),
+		mcp.WithNumber("category_id", mcp.Description("Filter by category ID")),
+		mcp.WithNumber("company_id", mcp.Description("Filter by company ID")),
+		mcp.WithNumber("receiver_id", mcp.Description("Filter by receiver ID")),
+		mcp.WithString("status", mcp.Description("Filter by status: paid, unpaid, overdue")),
+		mcp.WithNumber("min_amount", mcp.Description("Only include invoices with an amount of at least this value (inclusive)")),
+		mcp.WithNumber("max_amount", mcp.Description("Only include invoices with an amount of at most this value (inclusive)")),
+		mcp.WithString("amount_field", mcp.Description("Amount compared by min_amount/max_amount: target_amount (default) or amount")),
+	)
+}
+
+func (t *BulkTagInvoicesTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+
+		tagIDsRaw, ok := args["tag_ids"].([]interface{})
+		if !ok || len(tagIDsRaw) == 0 {
+			return mcp.NewToolResultError("tag_ids is required and must be a non-empty array"), nil
+		}
+
+		tagIDs, err := getUintArrayArg(args, "tag_ids")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		if len(tagIDs) == 0 {
+			return mcp.NewToolResultError("tag_ids must contain valid IDs"), nil
+		}
+
+		opts := services.InvoiceListOptions{
+			Keyword:     getStringArg(args, "keyword"),
+			AmountField: getStringArg(args, "amount_field"),
+		}
+		if err := getInvoiceFilterArgs(args, &opts); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if statusStr := getStringArg(args, "status"); statusStr != "" {
+			status := models.InvoiceStatus(statusStr)
+			opts.Status = &status
+		}
+
+		affectedCount, err := t.service.BulkAddTags(userID, opts, tagIDs)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to tag invoices: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(map[string]interface{}{
+			"success":           true,
+			"affected_invoices": affectedCount,
+		})
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
+// TagUsageTool handles listing tags with their usage
+type TagUsageTool struct {
+	service services.TagService
+}
+
+func NewTagUsageTool(service services.TagService) *TagUsageTool {
+	return &TagUsageTool{service: service}
+}
+
+func (t *TagUsageTool) GetTool() mcp.Tool {
+	return mcp.NewTool("tag_usage",
+		mcp.WithDescription("List all tags with the number of invoices carrying each and when each was last used (the creation date of its newest invoice), most used first. Tags with invoice_count 0 are unused; remove them with cleanup_unused_tags."),
+	)
+}
+
+func (t *TagUsageTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
+
+		usage, err := t.service.GetTagUsage(userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get tag usage: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(map[string]interface{}{
+			"tags":  usage,
+			"total": len(usage),
+		})
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
+// TagSpendingTool reports the period's spending grouped by tag
+type TagSpendingTool struct {
+	service services.AnalyticsService
+}
+
+func NewTagSpendingTool(service services.AnalyticsService) *TagSpendingTool {
+	return &TagSpendingTool{service: service}
+}
+
+func (t *TagSpendingTool) GetTool() mcp.Tool {
+	return mcp.NewTool("tag_spending",
+		mcp.WithDescription(`Show the period's spending grouped by tag: each tag's total, paid and unpaid amounts in the user's base currency (USD unless configured) and its invoice count.
+With include_children, a parent tag's totals also include the invoices carrying any of its descendant tags, counting each invoice once.
+
+EXAMPLE QUERIES:
+- "How much did I spend per tag this month?" → tag_spending(period: "1m")
+- "Total travel spending including flights and hotels" → tag_spending(period: "1y", include_children: true)`),
+		mcp.WithString("period", mcp.Description("Time period: '7d', '1m', or '1y'. Default: '1m'")),
+		mcp.WithBoolean("include_children", mcp.Description("Roll each tag's descendant tags up into its totals (default: false)")),
+	)
+}
+
+func (t *TagSpendingTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+
+		period := services.Period1Month
+		if periodStr := getStringArg(args, "period"); periodStr != "" {
+			switch services.AnalyticsPeriod(periodStr) {
+			case services.Period7Days, services.Period1Month, services.Period1Year:
+				period = services.AnalyticsPeriod(periodStr)
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid period '%s'. Valid values: 7d, 1m, 1y", periodStr)), nil
+			}
+		}
+
+		getByTag := t.service.GetByTag
+		if getBoolArg(args, "include_children", false) {
+			getByTag = t.service.GetByTagWithChildren
+		}
+		spending, err := getByTag(userID, period)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get tag spending: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(spending)
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
+// CleanupUnusedTagsTool handles deleting tags no invoice carries
+type CleanupUnusedTagsTool struct {
+	service services.TagService
+}
+
+func NewCleanupUnusedTagsTool(service services.TagService) *CleanupUnusedTagsTool {
+	return &CleanupUnusedTagsTool{service: service}
+}
+
+func (t *CleanupUnusedTagsTool) GetTool() mcp.Tool {
+	return mcp.NewTool("cleanup_unused_tags",
+		mcp.WithDescription("Delete every tag that no invoice carries (see tag_usage), along with its leftover mappings to deleted invoices. Tags in use and parent tags are never deleted."),
+		mcp.WithString("older_than", mcp.Description("Only delete unused tags created before this time (RFC3339, e.g. '2024-01-01T00:00:00Z'). Default: all unused tags")),
+	)
+}
+
+func (t *CleanupUnusedTagsTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		olderThan, err := getTimeArg(args, "older_than")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		var before time.Time
+		if olderThan != nil {
+			before = *olderThan
+		}
+
+		deleted, err := t.service.DeleteUnusedTags(userID, before)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to clean up tags: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(map[string]interface{}{
+			"deleted_count": deleted,
+		})
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
 // MergeReceiversTool handles merging multiple receivers into one
 type MergeReceiversTool struct {
 	service services.ReceiverService
@@ -378,9 +667,10 @@ func NewMergeReceiversTool(service services.ReceiverService) *MergeReceiversTool
 
 func (t *MergeReceiversTool) GetTool() mcp.Tool {
 	return mcp.NewTool("merge_receivers",
-		mcp.WithDescription("Merge multiple receivers into one. All invoices from source receivers will be moved to the target receiver."),
+		mcp.WithDescription("Merge multiple receivers into one. All invoices from source receivers will be moved to the target receiver. Use dry_run to preview the merge first."),
 		mcp.WithNumber("target_id", mcp.Required(), mcp.Description("ID of the receiver to keep")),
 		mcp.WithArray("source_ids", mcp.Required(), mcp.Description("IDs of receivers to merge into target (will be deleted)"), mcp.Items(map[string]any{"type": "number"})),
+		mcp.WithBoolean("dry_run", mcp.Description("Preview the merge without changing anything: returns the same result, including the source receivers and the invoices that would be moved (default: false)")),
 	)
 }
 
@@ -390,9 +680,15 @@ func (t *MergeReceiversTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
-		targetID := getUintArg(args, "target_id")
+		targetID, err := getUintArg(args, "target_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		if targetID == 0 {
 			return mcp.NewToolResultError("target_id is required"), nil
 		}
@@ -403,26 +699,26 @@ func (t *MergeReceiversTool) GetHandler() server.ToolHandlerFunc {
 			return mcp.NewToolResultError("source_ids is required and must be a non-empty array"), nil
 		}
 
-		sourceIDs := make([]uint, 0, len(sourceIDsRaw))
-		for _, v := range sourceIDsRaw {
-			if id, ok := v.(float64); ok && id > 0 {
-				sourceIDs = append(sourceIDs, uint(id))
-			}
+		sourceIDs, err := getUintArrayArg(args, "source_ids")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
 		}
 
 		if len(sourceIDs) == 0 {
 			return mcp.NewToolResultError("source_ids must contain valid IDs"), nil
 		}
 
-		receiver, affectedCount, err := t.service.MergeReceivers(userID, targetID, sourceIDs)
+		merge, err := t.service.MergeReceivers(userID, targetID, sourceIDs, getBoolArg(args, "dry_run", false))
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to merge receivers: %v", err)), nil
 		}
 
 		result, _ := json.Marshal(map[string]interface{}{
-			"receiver":          receiver,
-			"merged_count":      len(sourceIDs),
-			"invoices_affected": affectedCount,
+			"receiver":          merge.Target,
+			"sources":           merge.Sources,
+			"merged_count":      len(merge.Sources),
+			"invoices_affected": merge.InvoicesAffected,
+			"dry_run":           merge.DryRun,
 		})
 		return mcp.NewToolResultText(string(result)), nil
 	}
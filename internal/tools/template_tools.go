@@ -0,0 +1,231 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/invoice-management/internal/models"
+	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
+)
+
+// CreateInvoiceTemplateTool handles invoice template creation
+type CreateInvoiceTemplateTool struct {
+	service services.TemplateService
+}
+
+func NewCreateInvoiceTemplateTool(service services.TemplateService) *CreateInvoiceTemplateTool {
+	return &CreateInvoiceTemplateTool{service: service}
+}
+
+func (t *CreateInvoiceTemplateTool) GetTool() mcp.Tool {
+	return mcp.NewTool("create_invoice_template",
+		mcp.WithDescription(`Save reusable invoice defaults under a name (e.g. "Monthly consulting").
+Templates never create invoices on their own; use apply_invoice_template to create an invoice from one.`),
+		mcp.WithString("name", mcp.Required(), mcp.Description("Template name")),
+		mcp.WithString("title", mcp.Description("Default invoice title")),
+		mcp.WithString("description", mcp.Description("Default invoice description")),
+		mcp.WithString("currency", mcp.Description("Currency code (default: USD)")),
+		mcp.WithNumber("category_id", mcp.Description("Default category ID")),
+		mcp.WithNumber("company_id", mcp.Description("Default company ID")),
+		mcp.WithNumber("receiver_id", mcp.Description("Default receiver ID")),
+		mcp.WithArray("items", mcp.Description("Default invoice items, in the same format as create_invoice items"),
+			mcp.Items(map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"description":    map[string]any{"type": "string"},
+					"quantity":       map[string]any{"type": "number"},
+					"unit":           map[string]any{"type": "string"},
+					"unit_price":     map[string]any{"type": "number"},
+					"currency":       map[string]any{"type": "string"},
+					"category_id":    map[string]any{"type": "number"},
+					"discount_type":  map[string]any{"type": "string", "enum": []string{"percent", "fixed"}},
+					"discount_value": map[string]any{"type": "number"},
+				},
+				"required": []string{"description", "unit_price"},
+			})),
+		mcp.WithArray("tags", mcp.Description("Tag names applied to invoices created from the template"), mcp.Items(map[string]any{"type": "string"})),
+	)
+}
+
+func (t *CreateInvoiceTemplateTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		template := &models.InvoiceTemplate{
+			Name:        getStringArg(args, "name"),
+			Title:       getStringArg(args, "title"),
+			Description: getStringArg(args, "description"),
+			Currency:    getStringArg(args, "currency"),
+		}
+		var err error
+		if template.CategoryID, err = getUintPtrArg(args, "category_id"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if template.CompanyID, err = getUintPtrArg(args, "company_id"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if template.ReceiverID, err = getUintPtrArg(args, "receiver_id"); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		if itemsRaw, ok := args["items"].([]interface{}); ok {
+			for _, itemRaw := range itemsRaw {
+				if itemMap, ok := itemRaw.(map[string]interface{}); ok {
+					item, err := getItemArg(itemMap)
+					if err != nil {
+						return mcp.NewToolResultError(err.Error()), nil
+					}
+					template.Items = append(template.Items, models.InvoiceTemplateItem{
+						Description:   item.Description,
+						Quantity:      item.Quantity,
+						Unit:          item.Unit,
+						UnitPrice:     item.UnitPrice,
+						Currency:      item.Currency,
+						CategoryID:    item.CategoryID,
+						DiscountType:  item.DiscountType,
+						DiscountValue: item.DiscountValue,
+					})
+				}
+			}
+		}
+
+		if tagsRaw, ok := args["tags"].([]interface{}); ok {
+			for _, v := range tagsRaw {
+				if name, ok := v.(string); ok {
+					template.Tags = append(template.Tags, name)
+				}
+			}
+		}
+
+		if err := t.service.CreateTemplate(userID, template); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create invoice template: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(template)
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
+// ListInvoiceTemplatesTool handles listing invoice templates
+type ListInvoiceTemplatesTool struct {
+	service services.TemplateService
+}
+
+func NewListInvoiceTemplatesTool(service services.TemplateService) *ListInvoiceTemplatesTool {
+	return &ListInvoiceTemplatesTool{service: service}
+}
+
+func (t *ListInvoiceTemplatesTool) GetTool() mcp.Tool {
+	return mcp.NewTool("list_invoice_templates",
+		mcp.WithDescription("List the user's invoice templates with their default title, items, category, company, receiver, currency, and tags"),
+	)
+}
+
+func (t *ListInvoiceTemplatesTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
+
+		templates, err := t.service.ListTemplates(userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list invoice templates: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(map[string]interface{}{
+			"templates": templates,
+		})
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
+
+// ApplyInvoiceTemplateTool handles creating an invoice from a template
+type ApplyInvoiceTemplateTool struct {
+	service services.InvoiceService
+}
+
+func NewApplyInvoiceTemplateTool(service services.InvoiceService) *ApplyInvoiceTemplateTool {
+	return &ApplyInvoiceTemplateTool{service: service}
+}
+
+func (t *ApplyInvoiceTemplateTool) GetTool() mcp.Tool {
+	return mcp.NewTool("apply_invoice_template",
+		mcp.WithDescription(`Create an invoice from a template, copying its title, description, items, category,
+company, receiver, currency, and tags. The invoice is unpaid unless a status is given.
+Like create_invoice, an invoice matching an existing one (same amount, dates, and receiver) is
+not created; the existing invoice is returned with is_duplicate set.`),
+		mcp.WithNumber("template_id", mcp.Required(), mcp.Description("ID of the template to apply")),
+		mcp.WithString("title", mcp.Description("Invoice title (default: template title)")),
+		mcp.WithString("status", mcp.Description("Status: paid, unpaid, or overdue (default: unpaid)")),
+		mcp.WithString("invoice_started_at", mcp.Description("Billing cycle start (ISO 8601)")),
+		mcp.WithString("invoice_ended_at", mcp.Description("Billing cycle end (ISO 8601)")),
+		mcp.WithString("due_date", mcp.Description("Due date (ISO 8601)")),
+	)
+}
+
+func (t *ApplyInvoiceTemplateTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		templateID, err := getUintArg(args, "template_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if templateID == 0 {
+			return mcp.NewToolResultError("template_id is required"), nil
+		}
+
+		overrides := services.CloneOptions{
+			InvoiceStartedAt: parseTimeArg(args, "invoice_started_at"),
+			InvoiceEndedAt:   parseTimeArg(args, "invoice_ended_at"),
+			DueDate:          parseTimeArg(args, "due_date"),
+		}
+		if title := getStringArg(args, "title"); title != "" {
+			overrides.Title = &title
+		}
+		if statusStr := getStringArg(args, "status"); statusStr != "" {
+			status := models.InvoiceStatus(statusStr)
+			overrides.Status = &status
+		}
+
+		createResult, err := t.service.CreateFromTemplate(userID, templateID, overrides)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to apply invoice template: %v", err)), nil
+		}
+
+		if createResult.IsDuplicate {
+			response := map[string]interface{}{
+				"invoice":      createResult.Invoice,
+				"is_duplicate": true,
+				"message":      createResult.Message,
+			}
+			result, _ := json.Marshal(response)
+			return mcp.NewToolResultText(string(result)), nil
+		}
+
+		result, _ := json.Marshal(createResult.Invoice)
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/rxtech-lab/invoice-management/internal/services"
+	"github.com/rxtech-lab/invoice-management/internal/utils"
 )
 
 // GetPresignedURLTool handles getting presigned URLs for file uploads
@@ -33,6 +35,9 @@ func (t *GetPresignedURLTool) GetHandler() server.ToolHandlerFunc {
 		if userID == "" {
 			return mcp.NewToolResultError("Authentication required"), nil
 		}
+		if result := requireScope(ctx, utils.ScopeInvoicesWrite); result != nil {
+			return result, nil
+		}
 
 		args := getArgsMap(request.Params.Arguments)
 		filename, _ := args["filename"].(string)
@@ -59,3 +64,56 @@ func (t *GetPresignedURLTool) GetHandler() server.ToolHandlerFunc {
 		return mcp.NewToolResultText(string(result)), nil
 	}
 }
+
+// ExtractInvoiceFromPDFTool drafts invoice fields from an uploaded PDF
+type ExtractInvoiceFromPDFTool struct {
+	service services.PDFService
+}
+
+func NewExtractInvoiceFromPDFTool(service services.PDFService) *ExtractInvoiceFromPDFTool {
+	return &ExtractInvoiceFromPDFTool{service: service}
+}
+
+func (t *ExtractInvoiceFromPDFTool) GetTool() mcp.Tool {
+	return mcp.NewTool("extract_invoice_from_pdf",
+		mcp.WithDescription("Read the total amount, currency, invoice date, due date, and vendor name from an uploaded PDF. "+
+			"Returns a draft with a confidence score (0-1) per field and does NOT create anything: "+
+			"confirm the values with the user, then call create_invoice. "+
+			"Only text-based PDFs are supported; scanned documents without a text layer return an error."),
+		mcp.WithString("key", mcp.Required(), mcp.Description("File key returned by an upload (e.g. invoices/<user>/<id>.pdf)")),
+	)
+}
+
+func (t *ExtractInvoiceFromPDFTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		userID := getUserIDFromContext(ctx)
+		if userID == "" {
+			return mcp.NewToolResultError("Authentication required"), nil
+		}
+		if result := requireScope(ctx, utils.ScopeInvoicesRead); result != nil {
+			return result, nil
+		}
+
+		args := getArgsMap(request.Params.Arguments)
+		key, _ := args["key"].(string)
+		if key == "" {
+			return mcp.NewToolResultError("key is required"), nil
+		}
+
+		// Uploaded files are stored under the uploading user's prefix
+		if !strings.HasPrefix(key, fmt.Sprintf("invoices/%s/", userID)) {
+			return mcp.NewToolResultError("File not found"), nil
+		}
+
+		extracted, err := t.service.ExtractFields(ctx, key)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to extract invoice from PDF: %v", err)), nil
+		}
+
+		result, _ := json.Marshal(map[string]interface{}{
+			"draft":        extracted,
+			"instructions": "This is a draft only; nothing was created. Confirm the fields with the user (especially those with low confidence), then call create_invoice with original_download_link set to the key.",
+		})
+		return mcp.NewToolResultText(string(result)), nil
+	}
+}
@@ -0,0 +1,112 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// currencyWords holds the singular and plural names of the major unit of currencies spelled out
+// by AmountInWords; other currencies use their code
+var currencyWords = map[string][2]string{
+	"USD": {"Dollar", "Dollars"},
+	"EUR": {"Euro", "Euros"},
+	"GBP": {"Pound", "Pounds"},
+	"AUD": {"Australian Dollar", "Australian Dollars"},
+	"CAD": {"Canadian Dollar", "Canadian Dollars"},
+	"HKD": {"Hong Kong Dollar", "Hong Kong Dollars"},
+	"JPY": {"Yen", "Yen"},
+	"CHF": {"Franc", "Francs"},
+	"CNY": {"Yuan", "Yuan"},
+}
+
+var (
+	smallNumberWords = []string{
+		"Zero", "One", "Two", "Three", "Four", "Five", "Six", "Seven", "Eight", "Nine", "Ten",
+		"Eleven", "Twelve", "Thirteen", "Fourteen", "Fifteen", "Sixteen", "Seventeen", "Eighteen", "Nineteen",
+	}
+	tensWords  = []string{"", "", "Twenty", "Thirty", "Forty", "Fifty", "Sixty", "Seventy", "Eighty", "Ninety"}
+	scaleWords = []string{"", "Thousand", "Million", "Billion", "Trillion", "Quadrillion"}
+)
+
+// maxAmountInWords is the largest whole amount AmountInWords spells out (just under a quintillion)
+const maxAmountInWords = 1e18
+
+// AmountInWords spells out amount in currency the way it is written on cheques and invoices,
+// e.g. "One Hundred Twenty Three Dollars and 45/100". The minor unit is written as a fraction
+// with the currency's precision and left out for currencies without one. Negative amounts
+// (refunds) are prefixed with "Minus". Returns an empty string for amounts that are not finite
+// or too large to spell out.
+func AmountInWords(amount float64, currency string) string {
+	currency = strings.ToUpper(currency)
+	precision := CurrencyPrecision(currency)
+	scale := math.Pow10(precision)
+
+	minorTotal := math.Round(math.Abs(amount) * scale)
+	if math.IsNaN(minorTotal) || minorTotal/scale >= maxAmountInWords {
+		return ""
+	}
+	whole := uint64(minorTotal / scale)
+	minor := uint64(minorTotal - float64(whole)*scale)
+
+	unit := currency
+	if names, ok := currencyWords[currency]; ok {
+		unit = names[1]
+		if whole == 1 {
+			unit = names[0]
+		}
+	}
+
+	var words strings.Builder
+	if amount < 0 && minorTotal > 0 {
+		words.WriteString("Minus ")
+	}
+	words.WriteString(integerInWords(whole))
+	words.WriteString(" ")
+	words.WriteString(unit)
+	if precision > 0 {
+		fmt.Fprintf(&words, " and %0*d/%d", precision, minor, uint64(scale))
+	}
+	return words.String()
+}
+
+// integerInWords spells out n in English words without "and" or hyphens (e.g. "One Hundred Twenty Three")
+func integerInWords(n uint64) string {
+	if n == 0 {
+		return smallNumberWords[0]
+	}
+
+	var groups []string
+	for scaleIndex := 0; n > 0; scaleIndex++ {
+		group := n % 1000
+		n /= 1000
+		if group == 0 {
+			continue
+		}
+		words := hundredsInWords(group)
+		if scaleWords[scaleIndex] != "" {
+			words += " " + scaleWords[scaleIndex]
+		}
+		groups = append([]string{words}, groups...)
+	}
+	return strings.Join(groups, " ")
+}
+
+// hundredsInWords spells out n between 1 and 999
+func hundredsInWords(n uint64) string {
+	var parts []string
+	if n >= 100 {
+		parts = append(parts, smallNumberWords[n/100], "Hundred")
+		n %= 100
+	}
+	switch {
+	case n >= 20:
+		parts = append(parts, tensWords[n/10])
+		if n%10 != 0 {
+			parts = append(parts, smallNumberWords[n%10])
+		}
+	case n > 0:
+		parts = append(parts, smallNumberWords[n])
+	}
+	return strings.Join(parts, " ")
+}
@@ -18,6 +18,9 @@ const MCPAuthenticatedUserContextKey = "mcp_authenticated_user"
 // AuthorizationHeaderContextKey is the context key for storing the Authorization header
 const AuthorizationHeaderContextKey = "authorization_header"
 
+// RequestIDContextKey is the context key for storing the request ID used to correlate log lines
+const RequestIDContextKey = "request_id"
+
 // WithAuthenticatedUser stores an authenticated user in the context
 func WithAuthenticatedUser(ctx context.Context, user *AuthenticatedUser) context.Context {
 	return context.WithValue(ctx, MCPAuthenticatedUserContextKey, user)
@@ -106,3 +109,14 @@ func GetAuthorizationHeader(ctx context.Context) (string, bool) {
 	header, ok := ctx.Value(AuthorizationHeaderContextKey).(string)
 	return header, ok
 }
+
+// WithRequestID stores the request ID in the context
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, RequestIDContextKey, requestID)
+}
+
+// GetRequestID returns the request ID if present, empty string otherwise
+func GetRequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(RequestIDContextKey).(string)
+	return requestID
+}
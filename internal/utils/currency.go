@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"math"
+	"strings"
+
+	"golang.org/x/text/currency"
+)
+
+// DefaultCurrencyPrecision is the number of decimals used for currencies not in currencyPrecision
+const DefaultCurrencyPrecision = 2
+
+// currencyPrecision lists the ISO 4217 minor-unit decimals of currencies that don't use two
+var currencyPrecision = map[string]int{
+	// No minor unit
+	"CLP": 0,
+	"ISK": 0,
+	"JPY": 0,
+	"KRW": 0,
+	"PYG": 0,
+	"UGX": 0,
+	"VND": 0,
+	"XAF": 0,
+	"XOF": 0,
+	// Three decimals
+	"BHD": 3,
+	"IQD": 3,
+	"JOD": 3,
+	"KWD": 3,
+	"LYD": 3,
+	"OMR": 3,
+	"TND": 3,
+}
+
+// CurrencyPrecision returns the number of decimals amounts in currency are rounded to
+func CurrencyPrecision(currency string) int {
+	if precision, ok := currencyPrecision[strings.ToUpper(currency)]; ok {
+		return precision
+	}
+	return DefaultCurrencyPrecision
+}
+
+// RoundToCurrency rounds amount half away from zero to the precision of currency
+func RoundToCurrency(amount float64, currency string) float64 {
+	scale := math.Pow10(CurrencyPrecision(currency))
+	return math.Round(amount*scale) / scale
+}
+
+// IsISOCurrency reports whether code (in any case) is an ISO 4217 currency known to the currency
+// data bundled with the server
+func IsISOCurrency(code string) bool {
+	_, err := currency.ParseISO(strings.TrimSpace(code))
+	return err == nil
+}
@@ -0,0 +1,85 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// DefaultLocale is the locale FormatCurrency uses when none or an unknown one is given
+const DefaultLocale = "en-US"
+
+// symbolAfterLanguages lists the languages that write the currency symbol after the number
+// (e.g. "1.234,56 €" in German); other languages write it before
+var symbolAfterLanguages = map[string]bool{
+	"cs": true,
+	"da": true,
+	"de": true,
+	"es": true,
+	"fi": true,
+	"fr": true,
+	"it": true,
+	"nb": true,
+	"pl": true,
+	"pt": true,
+	"ru": true,
+	"sv": true,
+}
+
+// IsValidLocale reports whether locale is a well-formed BCP 47 language tag (e.g. "de-DE")
+func IsValidLocale(locale string) bool {
+	_, err := language.Parse(locale)
+	return err == nil
+}
+
+// FormatCurrency formats amount in currency for display in locale, with the locale's grouping
+// and decimal separators, the currency symbol, and the currency's decimals (see
+// CurrencyPrecision), e.g. "$1,234.56" in en-US, "1.234,56 $" in de-DE, and "¥1,235" for JPY.
+// Currencies without a known symbol use their code. An empty or invalid locale uses DefaultLocale.
+func FormatCurrency(amount float64, currency string, locale string) string {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	tag, err := language.Parse(locale)
+	if locale == "" || err != nil {
+		tag = language.MustParse(DefaultLocale)
+	}
+
+	precision := CurrencyPrecision(currency)
+	amount = RoundToCurrency(amount, currency)
+	negative := amount < 0
+	if negative {
+		amount = -amount
+	}
+
+	printer := message.NewPrinter(tag)
+	digits := printer.Sprint(number.Decimal(amount, number.Scale(precision)))
+	symbol := currencySymbol(printer, currency)
+
+	var formatted string
+	if base, _ := tag.Base(); symbolAfterLanguages[base.String()] {
+		formatted = digits + " " + symbol
+	} else if last, _ := utf8.DecodeLastRuneInString(symbol); unicode.IsLetter(last) {
+		// Codes and lettered symbols are separated from the number, e.g. "BHD 12.345"
+		formatted = symbol + " " + digits
+	} else {
+		formatted = symbol + digits
+	}
+	if negative {
+		return "-" + formatted
+	}
+	return formatted
+}
+
+// currencySymbol returns the symbol printer's locale uses for currency, or the currency code
+// when it isn't an ISO 4217 currency
+func currencySymbol(printer *message.Printer, code string) string {
+	unit, err := currency.ParseISO(code)
+	if err != nil {
+		return code
+	}
+	return printer.Sprint(currency.Symbol(unit))
+}
@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// diffAlwaysIgnored are bookkeeping fields that change on every write and are never reported
+var diffAlwaysIgnored = map[string]bool{
+	"id":         true,
+	"created_at": true,
+	"updated_at": true,
+}
+
+// DiffFields compares the JSON representations of two values field by field and returns
+// the changed top-level fields as {"field": {"from": old, "to": new}}.
+// Either side may be nil: a nil before reports every field of after with only "to" (a create),
+// a nil after reports every field of before with only "from" (a delete).
+// Nested objects and arrays (e.g. preloaded relations) are skipped, as are id, created_at,
+// updated_at, and any extra ignored fields. An empty result means nothing changed.
+func DiffFields(before, after interface{}, ignored ...string) (map[string]interface{}, error) {
+	beforeFields, err := toFieldMap(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := toFieldMap(after)
+	if err != nil {
+		return nil, err
+	}
+
+	skip := make(map[string]bool, len(ignored))
+	for _, field := range ignored {
+		skip[field] = true
+	}
+
+	hasBefore, hasAfter := !isNil(before), !isNil(after)
+	diff := make(map[string]interface{})
+	record := func(field string) {
+		if diffAlwaysIgnored[field] || skip[field] {
+			return
+		}
+		from, hasFrom := beforeFields[field]
+		to, hasTo := afterFields[field]
+		if isNested(from) || isNested(to) || (hasFrom && hasTo && reflect.DeepEqual(from, to)) {
+			return
+		}
+
+		change := make(map[string]interface{}, 2)
+		if hasBefore {
+			change["from"] = from
+		}
+		if hasAfter {
+			change["to"] = to
+		}
+		diff[field] = change
+	}
+
+	for field := range beforeFields {
+		record(field)
+	}
+	for field := range afterFields {
+		if _, seen := beforeFields[field]; !seen {
+			record(field)
+		}
+	}
+	return diff, nil
+}
+
+// toFieldMap decodes the JSON representation of a struct into its top-level fields
+func toFieldMap(value interface{}) (map[string]interface{}, error) {
+	fields := make(map[string]interface{})
+	if isNil(value) {
+		return fields, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// isNested reports whether a decoded JSON value is an object or array
+func isNested(value interface{}) bool {
+	switch value.(type) {
+	case map[string]interface{}, []interface{}:
+		return true
+	}
+	return false
+}
+
+// isNil reports whether value is nil or a nil pointer
+func isNil(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	v := reflect.ValueOf(value)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
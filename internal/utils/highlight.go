@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Markers wrapped around matched terms in highlighted snippets (bold in Markdown)
+const (
+	HighlightStart = "**"
+	HighlightEnd   = "**"
+)
+
+// snippetEllipsis marks text cut from either end of a snippet
+const snippetEllipsis = "…"
+
+// ContainsFold reports whether term occurs in text, ignoring case
+func ContainsFold(text, term string) bool {
+	return term != "" && indexFold([]rune(text), []rune(term), 0) >= 0
+}
+
+// HighlightSnippet returns the text around the first case-insensitive match of term, keeping up
+// to context runes on either side (cut back to whole words), with every match in the snippet
+// wrapped in HighlightStart and HighlightEnd. Returns false when term does not occur in text.
+func HighlightSnippet(text, term string, context int) (string, bool) {
+	runes := []rune(text)
+	termRunes := []rune(term)
+	first := indexFold(runes, termRunes, 0)
+	if len(termRunes) == 0 || first < 0 {
+		return "", false
+	}
+
+	// Cut at word boundaries so the snippet doesn't start or end mid-word
+	start := max(first-context, 0)
+	if start > 0 && !unicode.IsSpace(runes[start-1]) {
+		for i := start; i < first; i++ {
+			if unicode.IsSpace(runes[i]) {
+				start = i + 1
+				break
+			}
+		}
+	}
+	matchEnd := first + len(termRunes)
+	end := min(matchEnd+context, len(runes))
+	if end < len(runes) {
+		for i := end; i > matchEnd; i-- {
+			if unicode.IsSpace(runes[i]) {
+				end = i
+				break
+			}
+		}
+	}
+
+	var snippet strings.Builder
+	if start > 0 {
+		snippet.WriteString(snippetEllipsis)
+	}
+	for pos := start; pos < end; {
+		match := indexFold(runes[:end], termRunes, pos)
+		if match < 0 {
+			snippet.WriteString(string(runes[pos:end]))
+			break
+		}
+		snippet.WriteString(string(runes[pos:match]))
+		snippet.WriteString(HighlightStart)
+		snippet.WriteString(string(runes[match : match+len(termRunes)]))
+		snippet.WriteString(HighlightEnd)
+		pos = match + len(termRunes)
+	}
+	if end < len(runes) {
+		snippet.WriteString(snippetEllipsis)
+	}
+	return strings.TrimSpace(snippet.String()), true
+}
+
+// indexFold returns the index of the first case-insensitive match of term in text at or after
+// from, or -1. Comparing rune by rune keeps the index valid in the original text.
+func indexFold(text, term []rune, from int) int {
+	if len(term) == 0 {
+		return -1
+	}
+	for i := from; i+len(term) <= len(text); i++ {
+		matched := true
+		for j := range term {
+			if unicode.ToLower(text[i+j]) != unicode.ToLower(term[j]) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return i
+		}
+	}
+	return -1
+}
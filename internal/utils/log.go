@@ -0,0 +1,17 @@
+package utils
+
+import (
+	"context"
+	"log"
+)
+
+// Logf logs a message prefixed with the request ID from the context, if any,
+// so service-layer warnings can be traced back to the request that caused them
+func Logf(ctx context.Context, format string, args ...interface{}) {
+	if requestID := GetRequestID(ctx); requestID != "" {
+		// The ID goes in as an argument, so a client-supplied ID can't inject format verbs
+		log.Printf("[request_id=%s] "+format, append([]interface{}{requestID}, args...)...)
+		return
+	}
+	log.Printf(format, args...)
+}
@@ -0,0 +1,36 @@
+package utils
+
+import "os"
+
+// OAuth scopes enforced on invoice operations
+const (
+	ScopeInvoicesRead  = "invoices:read"
+	ScopeInvoicesWrite = "invoices:write"
+)
+
+// StrictScopesEnvVar is the environment variable that enables strict scope enforcement.
+// When set to "true", tokens without a scopes claim are no longer treated as full-access.
+const StrictScopesEnvVar = "AUTH_STRICT_SCOPES"
+
+// StrictScopesEnabled reports whether strict scope enforcement is enabled
+func StrictScopesEnabled() bool {
+	return os.Getenv(StrictScopesEnvVar) == "true"
+}
+
+// UserHasScope checks if the user is granted a scope.
+// Users without any scopes (e.g. tokens without a scopes claim or API key users) are
+// treated as full-access unless strict scope enforcement is enabled.
+func UserHasScope(user *AuthenticatedUser, scope string) bool {
+	if user == nil {
+		return false
+	}
+	if len(user.Scopes) == 0 {
+		return !StrictScopesEnabled()
+	}
+	for _, userScope := range user.Scopes {
+		if userScope == scope {
+			return true
+		}
+	}
+	return false
+}